@@ -1,9 +1,19 @@
 package grpcclient
 
 import (
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/metrics"
+	"github.com/wlcy/tron/explorer/lib/trace"
+	"golang.org/x/net/context"
 	"google.golang.org/grpc"
 )
 
+var (
+	grpcDuration = metrics.NewHistogramVec("explorer_grpc_request_duration_seconds", "Latency of gRPC calls to tron nodes.", nil, "method", "node")
+	grpcRequests = metrics.NewCounterVec("explorer_grpc_requests_total", "gRPC calls to tron nodes by result code.", "method", "node", "code")
+)
+
 type _conn struct {
 	c          *grpc.ClientConn
 	serverAddr string
@@ -11,7 +21,7 @@ type _conn struct {
 
 // Connect 尝试建立连接
 func (c *_conn) Connect() (err error) {
-	c.c, err = grpc.Dial(c.serverAddr, grpc.WithInsecure())
+	c.c, err = grpc.Dial(c.serverAddr, grpc.WithInsecure(), grpc.WithUnaryInterceptor(c.observeInterceptor))
 	if nil != err {
 		return err
 	}
@@ -28,3 +38,25 @@ func (c *_conn) GetState() string {
 func (c *_conn) Target() string {
 	return c.c.Target()
 }
+
+// Close 关闭连接
+func (c *_conn) Close() error {
+	if nil == c.c {
+		return nil
+	}
+	return c.c.Close()
+}
+
+// observeInterceptor 统计每个节点每个方法的调用耗时和结果，ctx中有span时记录为子span
+func (c *_conn) observeInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	start := time.Now()
+	span := trace.StartClient(ctx, "grpc "+method, "rpc.system", "grpc", "rpc.method", method, "net.peer", c.serverAddr)
+	err := invoker(ctx, method, req, reply, cc, opts...)
+	code := grpc.Code(err).String()
+	grpcDuration.With(method, c.serverAddr).ObserveSince(start)
+	grpcRequests.With(method, c.serverAddr, code).Inc()
+	span.SetAttr("rpc.grpc.status_code", code)
+	span.SetError(err)
+	span.End()
+	return err
+}
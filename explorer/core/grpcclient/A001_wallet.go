@@ -1,7 +1,6 @@
 package grpcclient
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/tronprotocol/grpc-gateway/api"
@@ -24,7 +23,7 @@ func NewWallet(serverAddr string) *Wallet {
 
 // GetRandomWallet ...
 func GetRandomWallet() *Wallet {
-	serverAddr := fmt.Sprintf("%v:%v", utils.GetRandFullNodeAddr(), utils.DefaultGrpPort)
+	serverAddr := utils.GetRandFullNodeEndpoint()
 	ret := &Wallet{}
 	ret.serverAddr = serverAddr
 	ret.Connect()
@@ -1,8 +1,6 @@
 package grpcclient
 
 import (
-	"fmt"
-
 	"github.com/tronprotocol/grpc-gateway/api"
 	"github.com/tronprotocol/grpc-gateway/core"
 	"github.com/wlcy/tron/explorer/core/utils"
@@ -23,7 +21,7 @@ func NewWalletSolidity(serverAddr string) *WalletSolidity {
 
 // GetRandomSolidity ...
 func GetRandomSolidity() *WalletSolidity {
-	serverAddr := fmt.Sprintf("%v:%v", utils.GetRandSolidityNodeAddr(), utils.DefaultGrpPort)
+	serverAddr := utils.GetRandSolidityNodeEndpoint()
 	ret := &WalletSolidity{}
 	ret.serverAddr = serverAddr
 	ret.Connect()
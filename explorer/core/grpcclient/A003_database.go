@@ -1,8 +1,6 @@
 package grpcclient
 
 import (
-	"fmt"
-
 	"github.com/tronprotocol/grpc-gateway/api"
 	"github.com/tronprotocol/grpc-gateway/core"
 	"github.com/wlcy/tron/explorer/core/utils"
@@ -24,7 +22,7 @@ func NewDatabase(serverAddr string) *Database {
 
 // GetRandomDatabase ...
 func GetRandomDatabase() *Database {
-	serverAddr := fmt.Sprintf("%v:%v", utils.GetRandFullNodeAddr(), utils.DefaultGrpPort)
+	serverAddr := utils.GetRandFullNodeEndpoint()
 	ret := &Database{}
 	ret.serverAddr = serverAddr
 	ret.Connect()
@@ -1,7 +1,6 @@
 package grpcclient
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/tronprotocol/grpc-gateway/api"
@@ -24,7 +23,7 @@ func NewWalletExt(serverAddr string) *WalletExt {
 
 // GetRandomWalletExt ...
 func GetRandomWalletExt() *WalletExt {
-	serverAddr := fmt.Sprintf("%v:%v", utils.GetRandSolidityNodeAddr(), utils.DefaultGrpPort)
+	serverAddr := utils.GetRandSolidityNodeEndpoint()
 	ret := &WalletExt{}
 	ret.serverAddr = serverAddr
 	ret.Connect()
@@ -0,0 +1,158 @@
+package grpcclient
+
+import (
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/tronprotocol/grpc-gateway/core"
+	"github.com/wlcy/tron/explorer/core/utils"
+)
+
+// DiscoverFullNodes 从 Wallet.ListNodes 发现新的full node，能正常获取最新块的节点加入当前网络的节点列表
+//	最多检查 maxProbe 个新节点，返回新加入的节点
+func DiscoverFullNodes(maxProbe int) ([]string, error) {
+	wallet := GetRandomWallet()
+	defer wallet.Close()
+	nodes, err := wallet.ListNodes()
+	if nil != err {
+		return nil, err
+	}
+
+	network := utils.CurrentNetwork()
+	known := make(map[string]bool)
+	for _, node := range network.FullNodes {
+		known[utils.NodeEndpoint(node)] = true
+	}
+	added := make([]string, 0)
+	probed := 0
+	for _, node := range nodes {
+		if probed >= maxProbe {
+			break
+		}
+		if nil == node.Address || len(node.Address.Host) == 0 {
+			continue
+		}
+		//ListNodes 返回的是p2p端口，grpc使用当前网络的端口
+		host := string(node.Address.Host)
+		if known[utils.NodeEndpoint(host)] {
+			continue
+		}
+		known[utils.NodeEndpoint(host)] = true
+		probed++
+		if probeFullNode(utils.NodeEndpoint(host)) {
+			added = append(added, utils.AddFullNodes(host)...)
+		}
+	}
+	return added, nil
+}
+
+// PingFullNode 从随机位置开始依次检查当前网络的full node，返回第一个能获取最新块的节点
+func PingFullNode() (string, error) {
+	network := utils.CurrentNetwork()
+	if len(network.FullNodes) == 0 {
+		return "", fmt.Errorf("no full node configured for network [%v]", network.Name)
+	}
+	start := rand.Intn(len(network.FullNodes))
+	for i := range network.FullNodes {
+		endpoint := utils.NodeEndpoint(network.FullNodes[(start+i)%len(network.FullNodes)])
+		if probeFullNode(endpoint) {
+			return endpoint, nil
+		}
+	}
+	return "", fmt.Errorf("none of %v full nodes of network [%v] is reachable", len(network.FullNodes), network.Name)
+}
+
+func probeFullNode(endpoint string) bool {
+	wallet := NewWallet(endpoint)
+	if err := wallet.Connect(); nil != err {
+		return false
+	}
+	defer wallet.Close()
+	block, err := wallet.GetNowBlock()
+	return nil == err && nil != block && nil != block.BlockHeader
+}
+
+// NodeStatus 节点检查结果
+type NodeStatus struct {
+	Endpoint  string // host:port
+	Type      string // full 或 solidity
+	Reachable bool   // 能否获取最新块
+	BlockID   int64  // 最新块高度
+	Latency   int64  // 获取最新块的耗时，单位毫秒
+	Error     string // 失败原因
+}
+
+// ProbeNodes 并发检查当前网络的全部full node和solidity node，按配置的顺序返回
+func ProbeNodes() []*NodeStatus {
+	network := utils.CurrentNetwork()
+	ret := make([]*NodeStatus, 0, len(network.FullNodes)+len(network.SolidityNodes))
+	for _, node := range network.FullNodes {
+		ret = append(ret, &NodeStatus{Endpoint: utils.NodeEndpoint(node), Type: "full"})
+	}
+	for _, node := range network.SolidityNodes {
+		ret = append(ret, &NodeStatus{Endpoint: utils.NodeEndpoint(node), Type: "solidity"})
+	}
+	var wg sync.WaitGroup
+	for _, status := range ret {
+		wg.Add(1)
+		go func(status *NodeStatus) {
+			defer wg.Done()
+			probeNodeStatus(status)
+		}(status)
+	}
+	wg.Wait()
+	return ret
+}
+
+func probeNodeStatus(status *NodeStatus) {
+	start := time.Now()
+	var block *core.Block
+	var err error
+	if status.Type == "solidity" {
+		solidity := NewWalletSolidity(status.Endpoint)
+		if err = solidity.Connect(); nil == err {
+			block, err = solidity.GetNowBlock()
+			solidity.Close()
+		}
+	} else {
+		wallet := NewWallet(status.Endpoint)
+		if err = wallet.Connect(); nil == err {
+			block, err = wallet.GetNowBlock()
+			wallet.Close()
+		}
+	}
+	status.Latency = time.Since(start).Nanoseconds() / 1e6
+	if nil != err {
+		status.Error = err.Error()
+		return
+	}
+	if nil == block || nil == block.BlockHeader || nil == block.BlockHeader.RawData {
+		status.Error = "empty block"
+		return
+	}
+	status.Reachable = true
+	status.BlockID = block.BlockHeader.RawData.Number
+}
+
+// CheckGenesisBlock 检查full node的创世块hash是否与当前网络配置一致，避免连到其他网络的节点
+func CheckGenesisBlock() error {
+	network := utils.CurrentNetwork()
+	if network.GenesisBlockHash == "" {
+		return nil
+	}
+	wallet := GetRandomWallet()
+	defer wallet.Close()
+	block, err := wallet.GetBlockByNum(0)
+	if nil != err {
+		return err
+	}
+	if nil == block || nil == block.BlockHeader {
+		return fmt.Errorf("genesis block of node [%v] not found", wallet.serverAddr)
+	}
+	if hash := utils.HexEncode(utils.CalcBlockHash(block)); hash != network.GenesisBlockHash {
+		return fmt.Errorf("genesis block [%v] of node [%v] not match network [%v] genesisBlockHash [%v]", hash, wallet.serverAddr, network.Name, network.GenesisBlockHash)
+	}
+	return nil
+}
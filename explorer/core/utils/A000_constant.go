@@ -9,17 +9,17 @@ func init() {
 	rand.Seed(time.Now().Unix())
 }
 
-// GetRandSolidityNodeAddr 随机获取一个solidity node ip
+// GetRandSolidityNodeAddr 随机获取当前网络的一个solidity node ip，不含端口，需要端口时使用 GetRandSolidityNodeEndpoint
 func GetRandSolidityNodeAddr() string {
-	return SolidityNodeList[rand.Int31n(int32(len(SolidityNodeList)))]
+	return nodeHost(GetRandSolidityNodeEndpoint())
 }
 
-// GetRandFullNodeAddr 随机获取一个full node ip
+// GetRandFullNodeAddr 随机获取当前网络的一个full node ip，不含端口，需要端口时使用 GetRandFullNodeEndpoint
 func GetRandFullNodeAddr() string {
-	return FullNodeList[rand.Int31n(int32(len(FullNodeList)))]
+	return nodeHost(GetRandFullNodeEndpoint())
 }
 
-// 地址前缀 测试/主网
+// 地址前缀 测试/主网，当前网络的前缀使用 AddressPrefix()
 const (
 	AddressPrefixTest = "a0" //a0 + address
 	AddressPrefixMain = "41" //41 + address
@@ -31,7 +31,7 @@ const (
 // Node List info from:
 // https://github.com/tronprotocol/Documentation/blob/master/TRX_CN/Official_Public_Node.md
 
-// SolidityNodeList Solidity节点列表
+// SolidityNodeList 内置的主网Solidity节点列表，配置文件中没有配置节点时使用
 var SolidityNodeList = []string{
 	"39.105.66.80",   // good
 	"47.254.39.153",  // good
@@ -46,7 +46,7 @@ var SolidityNodeList = []string{
 	// "18.231.123.107", // time out happen +++++
 }
 
-// FullNodeList Full节点列表
+// FullNodeList 内置的主网Full节点列表，配置文件中没有配置节点时使用
 var FullNodeList = []string{
 	"54.236.37.243", // not fully implement
 	"52.53.189.99",  // not fully implement
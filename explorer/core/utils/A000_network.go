@@ -0,0 +1,137 @@
+package utils
+
+import (
+	"fmt"
+	"math/rand"
+	"net"
+	"sync"
+)
+
+// Network 网络配置，主网、测试网或私有链，启动时按配置文件中的 [network.名称] 设置
+type Network struct {
+	Name                string
+	FullNodes           []string // 节点地址 host 或 host:port，未带端口时使用 GrpcPort
+	SolidityNodes       []string
+	GrpcPort            int
+	AddressPrefix       string // 地址前缀 hex，主网和shasta为41
+	MaintenanceInterval int64  // 维护周期，单位毫秒
+	GenesisBlockHash    string // 创世块hash，非空时启动检查节点是否属于该网络
+	GenesisTime         int64  // 创世块时间，单位毫秒
+}
+
+// DefaultMaintenanceInterval 默认的维护周期，6小时
+const DefaultMaintenanceInterval = 6 * 60 * 60 * 1000
+
+var currentNetwork = &Network{
+	Name:                "mainnet",
+	FullNodes:           FullNodeList,
+	SolidityNodes:       SolidityNodeList,
+	GrpcPort:            DefaultGrpPort,
+	AddressPrefix:       AddressPrefixMain,
+	MaintenanceInterval: DefaultMaintenanceInterval,
+}
+var networkMutex sync.RWMutex
+
+// SetNetwork 设置当前网络，未设置时为内置节点列表的主网
+func SetNetwork(network *Network) {
+	network = copyNetwork(network)
+	if network.GrpcPort == 0 {
+		network.GrpcPort = DefaultGrpPort
+	}
+	if network.AddressPrefix == "" {
+		network.AddressPrefix = AddressPrefixMain
+	}
+	if network.MaintenanceInterval <= 0 {
+		network.MaintenanceInterval = DefaultMaintenanceInterval
+	}
+	networkMutex.Lock()
+	currentNetwork = network
+	networkMutex.Unlock()
+}
+
+// CurrentNetwork 返回当前网络配置的副本
+func CurrentNetwork() *Network {
+	networkMutex.RLock()
+	defer networkMutex.RUnlock()
+	return copyNetwork(currentNetwork)
+}
+
+// AddressPrefix 当前网络的地址前缀
+func AddressPrefix() string {
+	networkMutex.RLock()
+	defer networkMutex.RUnlock()
+	return currentNetwork.AddressPrefix
+}
+
+// AddFullNodes 添加发现的full node，已存在的忽略，返回新加入的节点
+func AddFullNodes(nodes ...string) []string {
+	networkMutex.Lock()
+	defer networkMutex.Unlock()
+	known := make(map[string]bool)
+	for _, node := range currentNetwork.FullNodes {
+		known[nodeEndpoint(node, currentNetwork.GrpcPort)] = true
+	}
+	added := make([]string, 0)
+	fullNodes := append([]string(nil), currentNetwork.FullNodes...)
+	for _, node := range nodes {
+		endpoint := nodeEndpoint(node, currentNetwork.GrpcPort)
+		if known[endpoint] {
+			continue
+		}
+		known[endpoint] = true
+		fullNodes = append(fullNodes, node)
+		added = append(added, node)
+	}
+	//替换切片而不是追加，CurrentNetwork 返回的副本不受影响
+	currentNetwork.FullNodes = fullNodes
+	return added
+}
+
+// GetRandFullNodeEndpoint 随机获取一个full node的 host:port
+func GetRandFullNodeEndpoint() string {
+	networkMutex.RLock()
+	defer networkMutex.RUnlock()
+	return nodeEndpoint(randNode(currentNetwork.FullNodes), currentNetwork.GrpcPort)
+}
+
+// GetRandSolidityNodeEndpoint 随机获取一个solidity node的 host:port
+func GetRandSolidityNodeEndpoint() string {
+	networkMutex.RLock()
+	defer networkMutex.RUnlock()
+	return nodeEndpoint(randNode(currentNetwork.SolidityNodes), currentNetwork.GrpcPort)
+}
+
+// NodeEndpoint 节点的 host:port，未带端口时使用当前网络的grpc端口
+func NodeEndpoint(node string) string {
+	networkMutex.RLock()
+	defer networkMutex.RUnlock()
+	return nodeEndpoint(node, currentNetwork.GrpcPort)
+}
+
+func nodeEndpoint(node string, port int) string {
+	if _, _, err := net.SplitHostPort(node); err == nil {
+		return node
+	}
+	return net.JoinHostPort(node, fmt.Sprint(port))
+}
+
+func nodeHost(node string) string {
+	if host, _, err := net.SplitHostPort(node); err == nil {
+		return host
+	}
+	return node
+}
+
+func randNode(nodes []string) string {
+	if len(nodes) == 0 {
+		return ""
+	}
+	return nodes[rand.Int31n(int32(len(nodes)))]
+}
+
+func copyNetwork(network *Network) *Network {
+	ret := *network
+	ret.FullNodes = append([]string(nil), network.FullNodes...)
+	ret.SolidityNodes = append([]string(nil), network.SolidityNodes...)
+	return &ret
+}
@@ -0,0 +1,32 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestNetwork(t *testing.T) {
+	defer SetNetwork(CurrentNetwork())
+	SetNetwork(&Network{Name: "private", FullNodes: []string{"10.0.0.1", "10.0.0.2:50061"}, SolidityNodes: []string{"10.0.0.3"}, GrpcPort: 50052})
+
+	network := CurrentNetwork()
+	if network.AddressPrefix != AddressPrefixMain || network.MaintenanceInterval != DefaultMaintenanceInterval {
+		t.Errorf("network defaults:%+v", network)
+	}
+	if NodeEndpoint("10.0.0.1") != "10.0.0.1:50052" || NodeEndpoint("10.0.0.2:50061") != "10.0.0.2:50061" {
+		t.Errorf("NodeEndpoint:%v %v", NodeEndpoint("10.0.0.1"), NodeEndpoint("10.0.0.2:50061"))
+	}
+	if endpoint := GetRandSolidityNodeEndpoint(); endpoint != "10.0.0.3:50052" {
+		t.Errorf("GetRandSolidityNodeEndpoint:%v", endpoint)
+	}
+	if addr := GetRandSolidityNodeAddr(); addr != "10.0.0.3" {
+		t.Errorf("GetRandSolidityNodeAddr:%v", addr)
+	}
+
+	added := AddFullNodes("10.0.0.1", "10.0.0.2:50061", "10.0.0.4", "10.0.0.4")
+	if len(added) != 1 || added[0] != "10.0.0.4" {
+		t.Errorf("AddFullNodes:%v", added)
+	}
+	if len(network.FullNodes) != 2 || len(CurrentNetwork().FullNodes) != 3 {
+		t.Errorf("full nodes:%v %v", network.FullNodes, CurrentNetwork().FullNodes)
+	}
+}
@@ -1,7 +1,9 @@
 package utils
 
 import (
+	"crypto/aes"
 	"encoding/json"
+	"strings"
 
 	ethcrypto "github.com/ethereum/go-ethereum/crypto"
 	"github.com/satori/go.uuid"
@@ -97,3 +99,29 @@ func readPrivateKeyStorage(password string, content string) (storage *KeyStorage
 
 	return storage, true, nil
 }
+
+// ReadPrivateKeyStorage 读取私钥加密数据并解密，返回hex私钥和base58地址
+//	content: genPrivateKeyStoreage 生成的hex字符串，可以带首尾空白
+func ReadPrivateKeyStorage(password, content string) (hexPrivKey, base58Addr string, err error) {
+	content = strings.TrimSpace(content)
+	storage := new(KeyStorage)
+	if err = json.Unmarshal(HexDecode(content), storage); nil != err {
+		return "", "", err
+	}
+	if len(HexDecode(storage.Key)) <= aes.BlockSize {
+		return "", "", ErrorDecrypt
+	}
+	storage, result, err := readPrivateKeyStorage(password, content)
+	if nil != err {
+		return "", "", err
+	}
+	if !result {
+		return "", "", ErrorDecrypt
+	}
+	return storage.privateHexKey, storage.Address, nil
+}
+
+// GenPrivateKeyStorage 使用密码对hex私钥进行加密，返回可以写入文件的hex字符串
+func GenPrivateKeyStorage(password, hexPrivKey string) (string, error) {
+	return genPrivateKeyStoreage(password, hexPrivKey)
+}
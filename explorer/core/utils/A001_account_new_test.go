@@ -29,3 +29,24 @@ func TestStorage(t *testing.T) {
 
 	fmt.Printf("%v\n%#v\n", result, storage)
 }
+
+func TestReadPrivateKeyStorage(t *testing.T) {
+	privKey, _, _, base58Addr, err := newAccount()
+	if nil != err {
+		t.Fatal(err)
+	}
+	storageStr, err := GenPrivateKeyStorage("Very1Strange2Pass3Word4", privKey)
+	if nil != err {
+		t.Fatal(err)
+	}
+	key, addr, err := ReadPrivateKeyStorage("Very1Strange2Pass3Word4", storageStr+"\n")
+	if nil != err || key != privKey || addr != base58Addr {
+		t.Errorf("ReadPrivateKeyStorage:%v %v", addr, err)
+	}
+	if _, _, err := ReadPrivateKeyStorage("wrong password", storageStr); nil == err {
+		t.Errorf("ReadPrivateKeyStorage wrong password")
+	}
+	if _, _, err := ReadPrivateKeyStorage("Very1Strange2Pass3Word4", "7b7d"); nil == err {
+		t.Errorf("ReadPrivateKeyStorage empty key")
+	}
+}
@@ -61,8 +61,8 @@ func GetTronHexAddress(in string) (out string, err error) {
 	sha3Hash.Write(rawPubKey)
 	hashRet := sha3Hash.Sum(nil)
 
-	hashRetStr := HexEncode(hashRet)                              // covert to hex string
-	out = fmt.Sprintf("%s%s", AddressPrefixMain, hashRetStr[24:]) // address prefix + hash remove first 24 length
+	hashRetStr := HexEncode(hashRet)                            // covert to hex string
+	out = fmt.Sprintf("%s%s", AddressPrefix(), hashRetStr[24:]) // address prefix + hash remove first 24 length
 
 	return
 }
@@ -0,0 +1,40 @@
+package utils
+
+import (
+	"fmt"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+// tronMessageHeader TronWeb 签名消息的前缀
+const tronMessageHeader = "\x19TRON Signed Message:\n"
+
+// HashTronMessage TronWeb trx.sign(hexMessage) 的消息hash
+//	keccak256(前缀 + "32" + 消息字节)，前缀中的长度固定为32
+func HashTronMessage(message []byte) []byte {
+	return ethcrypto.Keccak256([]byte(tronMessageHeader+"32"), message)
+}
+
+// HashTronMessageV2 TronWeb trx.signMessageV2(message) 的消息hash
+//	keccak256(前缀 + 消息长度 + 消息)
+func HashTronMessageV2(message []byte) []byte {
+	return ethcrypto.Keccak256([]byte(fmt.Sprintf("%v%v", tronMessageHeader, len(message))), message)
+}
+
+// RecoverTronMessageAddress 从消息签名中恢复签名账户的base58地址
+//	hash: 消息hash
+//	sign: 65字节 r+s+v，v 为 0/1 或 27/28
+func RecoverTronMessageAddress(hash, sign []byte) (string, error) {
+	if len(sign) != 65 {
+		return "", ErrorInvalidSign
+	}
+	sig := append([]byte{}, sign...)
+	if sig[64] >= 27 {
+		sig[64] -= 27
+	}
+	pubKey, err := ethcrypto.Ecrecover(hash, sig)
+	if nil != err {
+		return "", err
+	}
+	return GetTronBase58Address(HexEncode(pubKey))
+}
@@ -0,0 +1,30 @@
+package utils
+
+import (
+	"testing"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+)
+
+func TestRecoverTronMessageAddress(t *testing.T) {
+	privKey, _, _, base58Addr, err := newAccount()
+	if nil != err {
+		t.Fatal(err)
+	}
+	priv, _ := getPrivateKey(privKey)
+	message := []byte("0d3a0f4c1e8b9a7d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d")
+	for _, hash := range [][]byte{HashTronMessage(message), HashTronMessageV2(message)} {
+		sign, err := ethcrypto.Sign(hash, priv)
+		if nil != err {
+			t.Fatal(err)
+		}
+		sign[64] += 27 // TronWeb 返回的 v 为 27/28
+		addr, err := RecoverTronMessageAddress(hash, sign)
+		if nil != err || addr != base58Addr {
+			t.Errorf("RecoverTronMessageAddress:%v %v, want %v", addr, err, base58Addr)
+		}
+	}
+	if _, err := RecoverTronMessageAddress(HashTronMessage(message), make([]byte, 64)); err != ErrorInvalidSign {
+		t.Errorf("RecoverTronMessageAddress short sign:%v", err)
+	}
+}
@@ -0,0 +1,918 @@
+package config
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/cron"
+)
+
+/*
+	类型化的服务配置，按 默认值 < 配置文件 < 环境变量 < 启动参数 的顺序覆盖
+	字段标签：
+		toml    配置文件中的名称，* 表示该分组下的所有子表，如 [price.coingecko]
+		default 默认值
+		env     额外读取的环境变量，所有配置项都可以用 EXPLORER_分组_名称 覆盖，如 EXPLORER_MYSQL_PASS
+		secret  密码等敏感配置，打印时隐藏，不要写在配置文件中，通过环境变量配置
+		reload  可以热加载的分组，收到SIGHUP或配置文件修改后生效，其他分组修改后需要重启
+*/
+
+//EnvPrefix 环境变量前缀
+const EnvPrefix = "EXPLORER_"
+
+//Config 服务配置
+type Config struct {
+	Server    ServerConfig              `toml:"server"`
+	Mysql     MysqlConfig               `toml:"mysql"`
+	Redis     RedisConfig               `toml:"Redis"`
+	Common    CommonConfig              `toml:"common"`
+	Network   map[string]*NetworkConfig `toml:"network"`
+	Token     TokenConfig               `toml:"token"`
+	Buffer    BufferConfig              `toml:"buffer"`
+	Task      TaskConfig                `toml:"task"`
+	RateLimit RateLimitConfig           `toml:"ratelimit" reload:"true"`
+	Reward    RewardConfig              `toml:"reward" reload:"true"`
+	Monitor   MonitorConfig             `toml:"monitor" reload:"true"`
+	Health    HealthConfig              `toml:"health" reload:"true"`
+	Trace     TraceConfig               `toml:"trace" reload:"true"`
+	Price     PriceConfig               `toml:"price"`
+	TokenMeta TokenMetaConfig           `toml:"tokenMeta"`
+	Auth      AuthConfig                `toml:"auth"`
+	Faucet    FaucetConfig              `toml:"faucet" reload:"true"`
+}
+
+//ServerConfig http服务配置
+type ServerConfig struct {
+	Address         string        `toml:"address" default:":20110"`               // http服务监听地址
+	AdminAddress    string        `toml:"adminAddress" default:"127.0.0.1:20111"` // 管理接口监听地址，为空时不启动，不要对公网开放
+	Objectpool      int           `toml:"objectpool" default:"10"`                // http服务对象池大小
+	ShutdownTimeout time.Duration `toml:"shutdownTimeout" default:"30s"`          // 退出时等待定时任务结束和处理中的请求完成的最长时间
+	TrustedProxies  []string      `toml:"trustedProxies"`                         // 可信的反向代理IP或网段，只有对端地址在其中时才读取 X-Forwarded-For
+}
+
+//MysqlConfig mysql连接配置
+type MysqlConfig struct {
+	Host     string `toml:"host" default:"127.0.0.1"`
+	Port     string `toml:"port" default:"3306"`
+	User     string `toml:"user" default:"tron"`
+	Pass     string `toml:"pass" secret:"true"`
+	Protocol string `toml:"protocol" default:"tcp"`
+	Schema   string `toml:"schema" default:"tron"`
+	Charset  string `toml:"charset" default:"utf8"`
+}
+
+//RedisConfig redis连接配置
+type RedisConfig struct {
+	Host     string `toml:"host" default:"127.0.0.1:6379"`
+	Pass     string `toml:"pass" secret:"true"`
+	Index    int    `toml:"index" default:"0"`
+	Poolsize int    `toml:"poolsize" default:"10"`
+}
+
+//CommonConfig 通用配置，netType为使用的网络，对应 [network.名称]
+type CommonConfig struct {
+	HttpWebKey string `toml:"httpWebKey" secret:"true"`
+	NetType    string `toml:"netType" default:"testnet"`
+}
+
+//NetworkConfig 网络配置，内置 mainnet 和 testnet(shasta)，私有链在配置文件中增加 [network.名称]
+//
+//	节点地址为 host 或 host:port，未带端口时使用grpcPort
+type NetworkConfig struct {
+	FullNodes           []string      `toml:"fullNodes"`
+	SolidityNodes       []string      `toml:"solidityNodes"`
+	GrpcPort            int           `toml:"grpcPort" default:"50051"`
+	AddressPrefix       string        `toml:"addressPrefix" default:"41"`
+	MaintenanceInterval time.Duration `toml:"maintenanceInterval" default:"6h"`
+	GenesisBlockHash    string        `toml:"genesisBlockHash"`               // 非空时启动检查节点的创世块
+	GenesisTime         int64         `toml:"genesisTime"`                    // 创世块时间，单位毫秒
+	Discover            bool          `toml:"discover" default:"false"`       // 从 Wallet.ListNodes 发现更多full node
+	DiscoverInterval    time.Duration `toml:"discoverInterval" default:"10m"` // 发现节点的间隔
+	DiscoverMaxProbe    int           `toml:"discoverMaxProbe" default:"20"`  // 每次最多检查的新节点数
+}
+
+//TokenConfig 通证logo和模板路径
+type TokenConfig struct {
+	DefaultPath       string `toml:"defaultPath" default:"/data/images/tokenLogo"`
+	TokenTemplate     string `toml:"tokenTemplate" default:"/data/images/tokenTemplate/"`
+	ImgURL            string `toml:"imgURL" default:"http://coin.top/tokenLogo"`
+	TokenTemplateFile string `toml:"tokenTemplateFile" default:"http://coin.top/tokenTemplate/TronscanTokenInformationSubmissionTemplate.xlsx"`
+}
+
+//BufferConfig 区块缓存大小
+type BufferConfig struct {
+	MaxNodeErr              int   `toml:"maxNodeErr" default:"3"`               // 单个node连接允许的最大错误数
+	MaxUnconfirmedBlockRead int64 `toml:"maxUnconfirmedBlockRead" default:"50"` // 需要缓存的最新的unconfirmed block的数量
+	MaxBlockInMemory        int64 `toml:"maxBlockInMemory" default:"5000"`      // 内存中最大的confirmed block数量
+	MaxConfirmedTrx         int   `toml:"maxConfirmedTrx" default:"30000"`      // 内存中最大的confirmed transaction数量
+}
+
+//TaskConfig 定时任务的cron表达式，如 "@every 3m"、"1 0 0 * * *"(秒 分 时 日 月 周)，兼容原来的 "90s"、"5m" 和整数秒
+//	多个实例时每次执行只有一个实例获得锁，执行记录保存在 wlcy_task_run
+type TaskConfig struct {
+	Timezone               string        `toml:"timezone" default:"UTC"`                      // cron表达式使用的时区
+	LockTTL                time.Duration `toml:"lockTTL" default:"10m"`                       // 执行期间定期续期，实例异常退出后其他实例最多等待这么久
+	HistoryKeepDays        int           `toml:"historyKeepDays" default:"30"`                // 执行记录保留天数
+	TodayReport            string        `toml:"todayReport" default:"@every 3m"`             // 缓存今日统计
+	YesterdayReport        string        `toml:"yesterdayReport" default:"1 0 0 * * *"`       // 每天零点保存昨日统计
+	AssetIssueParticipated string        `toml:"assetIssueParticipated" default:"@every 30m"` // 同步通证参与情况
+	VoteWitnessRanking     string        `toml:"voteWitnessRanking"`                          // 维护周期后1分钟更新候选人排名，为空时按网络的维护周期生成
+	VoteCycleArchive       string        `toml:"voteCycleArchive" default:"@every 1m"`
+	WitnessReward          string        `toml:"witnessReward" default:"@every 5m"`
+	WitnessMissedSlot      string        `toml:"witnessMissedSlot" default:"@every 1m"`
+	Proposal               string        `toml:"proposal" default:"@every 1m"`
+	Exchange               string        `toml:"exchange" default:"@every 1m"`
+	APIKeyUsage            string        `toml:"apiKeyUsage" default:"@every 5m"`
+}
+
+//RateLimitConfig 匿名访问按IP限流，rate为每秒请求数，burst为允许的突发请求数，dailyQuota为每日请求上限，0表示不限
+//	带api key的请求同时按IP以 keyedIPRate、keyedIPBurst 限流，应不低于单个key的额度
+type RateLimitConfig struct {
+	Enable              bool   `toml:"enable" default:"false"`
+	AnonymousRate       int64  `toml:"anonymousRate" default:"5"`
+	AnonymousBurst      int64  `toml:"anonymousBurst" default:"20"`
+	AnonymousDailyQuota int64  `toml:"anonymousDailyQuota" default:"100000"`
+	KeyedIPRate         int64  `toml:"keyedIPRate" default:"100"`
+	KeyedIPBurst        int64  `toml:"keyedIPBurst" default:"500"`
+	AdminKey            string `toml:"adminKey" secret:"true"`
+}
+
+//RewardConfig 奖励估算，payoutRatio为超级代表分给投票人的默认比例(0-100)，estimateCycles为估算时参与平均的轮次数
+type RewardConfig struct {
+	PayoutRatio    int64 `toml:"payoutRatio" default:"80"`
+	EstimateCycles int64 `toml:"estimateCycles" default:"4"`
+}
+
+//MonitorConfig 出块监控，连续丢块missedThreshold次时告警
+type MonitorConfig struct {
+	WatchWitness    []string `toml:"watchWitness"`
+	MissedThreshold int64    `toml:"missedThreshold" default:"3"`
+	Webhook         string   `toml:"webhook"`
+}
+
+//HealthConfig 就绪检查，同步落后超过maxSyncLag个块或单项检查超过timeout时 /readyz 返回503
+type HealthConfig struct {
+	MaxSyncLag int64         `toml:"maxSyncLag" default:"100"`
+	Timeout    time.Duration `toml:"timeout" default:"3s"`
+}
+
+//TraceConfig 链路追踪，exporter为 stdout 或 otlp，otlp时endpoint为collector的 OTLP/HTTP 地址，samplePercent为请求的采样比例(0-100)
+type TraceConfig struct {
+	Enable        bool   `toml:"enable" default:"false"`
+	Exporter      string `toml:"exporter" default:"stdout"`
+	Endpoint      string `toml:"endpoint" default:"http://127.0.0.1:4318"`
+	SamplePercent int64  `toml:"samplePercent" default:"10"`
+	ServiceName   string `toml:"serviceName" default:"explorer"`
+}
+
+//PriceConfig 行情配置，providers中 exchange 为链上交易对，fixture 为本地文件，其他名称为 [price.名称] 配置的json接口
+type PriceConfig struct {
+	Providers    []string                `toml:"providers"`
+	StaleSeconds int64                   `toml:"staleSeconds" default:"600"`
+	USDToken     string                  `toml:"usdToken"`
+	Fixture      string                  `toml:"fixture"`
+	Sources      map[string]*PriceSource `toml:"*"`
+}
+
+//TokenMetaConfig 通证资料，storage为logo存储方式 local 或 s3，localPath和baseURL为空时使用 [token] 的配置
+type TokenMetaConfig struct {
+	Storage           string      `toml:"storage" default:"local"`
+	LocalPath         string      `toml:"localPath"`
+	BaseURL           string      `toml:"baseURL"`
+	LogoSizes         []int64     `toml:"logoSizes" default:"256,64"`
+	MaxBytes          int64       `toml:"maxBytes" default:"1048576"`
+	SignExpireSeconds int64       `toml:"signExpireSeconds" default:"600"`
+	S3                TokenMetaS3 `toml:"s3"`
+}
+
+//TokenMetaS3 S3兼容的对象存储
+type TokenMetaS3 struct {
+	Endpoint  string `toml:"endpoint"`
+	Region    string `toml:"region"`
+	Bucket    string `toml:"bucket"`
+	AccessKey string `toml:"accessKey" secret:"true"`
+	SecretKey string `toml:"secretKey" secret:"true"`
+	BaseURL   string `toml:"baseURL"`
+}
+
+//AuthConfig 登录认证，keys为jwt签名密钥，格式 kid:secret
+type AuthConfig struct {
+	Keys                []string `toml:"keys" secret:"true"`
+	ActiveKey           string   `toml:"activeKey"`
+	AccessTokenSeconds  int64    `toml:"accessTokenSeconds" default:"900"`
+	RefreshTokenSeconds int64    `toml:"refreshTokenSeconds" default:"604800"`
+	NonceSeconds        int64    `toml:"nonceSeconds" default:"300"`
+}
+
+//FaucetConfig 测试网水龙头，amount和dailyBudget单位为sun
+type FaucetConfig struct {
+	Enable                 bool   `toml:"enable" default:"false"`
+	Keystore               string `toml:"keystore"`
+	Password               string `toml:"password" secret:"true" env:"FAUCET_KEYSTORE_PASSWORD"`
+	Amount                 int64  `toml:"amount" default:"10000000000"`
+	DailyBudget            int64  `toml:"dailyBudget" default:"1000000000000"`
+	AddressCooldownSeconds int64  `toml:"addressCooldownSeconds" default:"86400"`
+	IPCooldownSeconds      int64  `toml:"ipCooldownSeconds" default:"3600"`
+	Captcha                string `toml:"captcha"`
+	CaptchaURL             string `toml:"captchaURL" default:"https://www.google.com/recaptcha/api/siteverify"`
+	CaptchaSecret          string `toml:"captchaSecret" secret:"true"`
+}
+
+//PriceSource json行情接口，Path为价格在返回json中的路径，用.分隔
+type PriceSource struct {
+	URL      string `toml:"url"`
+	Path     string `toml:"path"`
+	Symbol   string `toml:"symbol" default:"TRX"`
+	Currency string `toml:"currency" default:"USD"`
+}
+
+//Overrides 启动参数中的配置覆盖，格式 分组.名称=值，可以重复，如 -set mysql.host=127.0.0.1
+type Overrides []string
+
+//String ...
+func (o *Overrides) String() string {
+	return strings.Join(*o, ",")
+}
+
+//Set ...
+func (o *Overrides) Set(value string) error {
+	if !strings.Contains(value, "=") {
+		return fmt.Errorf("config override [%v] should be key=value", value)
+	}
+	*o = append(*o, value)
+	return nil
+}
+
+var current atomic.Value
+var defaultOnce sync.Once
+var defaultConfig *Config
+
+//Get 当前生效的配置，LoadConfig 之前返回默认配置，返回值不要修改
+func Get() *Config {
+	if conf, ok := current.Load().(*Config); ok {
+		return conf
+	}
+	defaultOnce.Do(func() {
+		defaultConfig = NewConfig()
+	})
+	return defaultConfig
+}
+
+func setCurrent(conf *Config) {
+	current.Store(conf)
+}
+
+//SetCurrent 替换当前生效的配置，用于测试
+func SetCurrent(conf *Config) {
+	setCurrent(conf)
+}
+
+//NewConfig 创建默认配置
+func NewConfig() *Config {
+	conf := &Config{}
+	if err := setDefaults(reflect.ValueOf(conf).Elem()); err != nil {
+		panic(err)
+	}
+	conf.Network = builtinNetworks()
+	return conf
+}
+
+//builtinNetworks 内置的网络，配置文件中同名的 [network.名称] 只覆盖配置了的项
+func builtinNetworks() map[string]*NetworkConfig {
+	mainnet, testnet := &NetworkConfig{}, &NetworkConfig{}
+	setDefaults(reflect.ValueOf(mainnet).Elem())
+	setDefaults(reflect.ValueOf(testnet).Elem())
+	mainnet.FullNodes = append([]string(nil), utils.FullNodeList...)
+	mainnet.SolidityNodes = append([]string(nil), utils.SolidityNodeList...)
+	testnet.FullNodes = []string{"grpc.shasta.trongrid.io:50051"}
+	testnet.SolidityNodes = []string{"grpc.shasta.trongrid.io:50052"}
+	return map[string]*NetworkConfig{"mainnet": mainnet, "testnet": testnet}
+}
+
+//CurrentNetwork common.netType 对应的网络配置
+func (c *Config) CurrentNetwork() *NetworkConfig {
+	return c.Network[c.Common.NetType]
+}
+
+//VoteWitnessRankingSpec 更新候选人排名的cron表达式，未配置时按当前网络的 maintenanceInterval 生成
+func (c *Config) VoteWitnessRankingSpec() string {
+	if c.Task.VoteWitnessRanking != "" {
+		return c.Task.VoteWitnessRanking
+	}
+	network := c.CurrentNetwork()
+	if network == nil {
+		return ""
+	}
+	loc, err := time.LoadLocation(c.Task.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	return maintenanceSpec(network.MaintenanceInterval, loc)
+}
+
+//maintenanceSpec 每个维护周期后1分钟执行的cron表达式，维护周期从UTC 0点开始计算，时间按loc换算
+//	周期不是整分钟、不能整除一天或无法用一个cron表达式表示时按间隔执行，不再延后1分钟
+func maintenanceSpec(interval time.Duration, loc *time.Location) string {
+	day := 24 * time.Hour
+	if interval >= time.Minute && interval%time.Minute == 0 && day%interval == 0 {
+		hours, minutes := make(map[int]bool), make(map[int]bool)
+		start := time.Date(2018, 1, 1, 0, 1, 0, 0, time.UTC)
+		for t := start; t.Before(start.Add(day)); t = t.Add(interval) {
+			local := t.In(loc)
+			hours[local.Hour()] = true
+			minutes[local.Minute()] = true
+		}
+		if len(hours)*len(minutes) == int(day/interval) {
+			hourField := joinSortedInts(hours)
+			if len(hours) == 24 {
+				hourField = "*"
+			}
+			return fmt.Sprintf("0 %v %v * * *", joinSortedInts(minutes), hourField)
+		}
+	}
+	return fmt.Sprintf("@every %v", interval)
+}
+
+//runsEvery 从某天0点开始至少一天内，相邻两次执行的间隔是否都等于interval
+func runsEvery(schedule cron.Schedule, interval time.Duration, loc *time.Location) bool {
+	t := schedule.Next(time.Date(2018, 1, 1, 0, 0, 0, 0, loc))
+	end := t.Add(24 * time.Hour)
+	if end.Before(t.Add(2 * interval)) {
+		end = t.Add(2 * interval)
+	}
+	for next := schedule.Next(t); !next.After(end); t, next = next, schedule.Next(next) {
+		if next.Sub(t) != interval {
+			return false
+		}
+	}
+	return true
+}
+
+func joinSortedInts(set map[int]bool) string {
+	values := make([]int, 0, len(set))
+	for value := range set {
+		values = append(values, value)
+	}
+	sort.Ints(values)
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, strconv.Itoa(value))
+	}
+	return strings.Join(items, ",")
+}
+
+//FaucetEnabled 是否启用测试网水龙头，netType为mainnet时不启用
+func (c *Config) FaucetEnabled() bool {
+	return c.Faucet.Enable && c.Common.NetType != "mainnet"
+}
+
+//Load 加载配置，confFile为空时只使用默认值、环境变量和overrides，不做校验
+//
+//	配置文件中不认识的配置项记录在返回的 unknown 中
+func Load(confFile string, overrides []string) (conf *Config, unknown []string, err error) {
+	conf = NewConfig()
+	if confFile != "" {
+		tree, err := toml.LoadFile(confFile)
+		if err != nil {
+			return nil, nil, fmt.Errorf("open config file [%v] failed:[%v]", confFile, err)
+		}
+		if unknown, err = conf.Decode(tree.ToMap()); err != nil {
+			return nil, unknown, err
+		}
+	}
+	if err = conf.ApplyEnv(os.LookupEnv); err != nil {
+		return nil, unknown, err
+	}
+	for _, override := range overrides {
+		kv := strings.SplitN(override, "=", 2)
+		if len(kv) != 2 {
+			return nil, unknown, fmt.Errorf("config override [%v] should be key=value", override)
+		}
+		if err = conf.Set(strings.TrimSpace(kv[0]), kv[1]); err != nil {
+			return nil, unknown, err
+		}
+	}
+	return conf, unknown, nil
+}
+
+//Decode 按toml标签读取配置文件内容，返回不认识的配置项
+func (c *Config) Decode(values map[string]interface{}) ([]string, error) {
+	unknown := make([]string, 0)
+	err := decodeStruct(reflect.ValueOf(c).Elem(), values, "", &unknown)
+	return unknown, err
+}
+
+//ApplyEnv 用环境变量覆盖配置，lookup 一般为 os.LookupEnv
+func (c *Config) ApplyEnv(lookup func(string) (string, bool)) error {
+	return walkFields(reflect.ValueOf(c).Elem(), "", func(path string, field reflect.StructField, value reflect.Value) error {
+		names := []string{EnvName(path)}
+		if name := field.Tag.Get("env"); name != "" {
+			names = append([]string{name}, names...)
+		}
+		for _, name := range names {
+			if env, ok := lookup(name); ok {
+				if err := setValue(value, env); err != nil {
+					return fmt.Errorf("env %v: %v", name, err)
+				}
+			}
+		}
+		return nil
+	})
+}
+
+//EnvName 配置项对应的环境变量名，如 mysql.pass 为 EXPLORER_MYSQL_PASS
+func EnvName(path string) string {
+	return EnvPrefix + strings.ToUpper(strings.Replace(path, ".", "_", -1))
+}
+
+//Set 按路径修改配置项，如 Set("mysql.host", "127.0.0.1")，路径不区分大小写
+func (c *Config) Set(path, value string) error {
+	found := false
+	err := walkFields(reflect.ValueOf(c).Elem(), "", func(fieldPath string, field reflect.StructField, fieldValue reflect.Value) error {
+		if !strings.EqualFold(fieldPath, path) {
+			return nil
+		}
+		found = true
+		if err := setValue(fieldValue, value); err != nil {
+			return fmt.Errorf("%v: %v", fieldPath, err)
+		}
+		return nil
+	})
+	if err == nil && !found {
+		err = fmt.Errorf("unknown config item [%v]", path)
+	}
+	return err
+}
+
+//String 按 分组.名称=值 逐行输出，隐藏敏感配置
+func (c *Config) String() string {
+	lines := make([]string, 0)
+	walkFields(reflect.ValueOf(c).Elem(), "", func(path string, field reflect.StructField, value reflect.Value) error {
+		if field.Tag.Get("secret") == "true" {
+			if isZero(value) {
+				lines = append(lines, path+"=")
+			} else {
+				lines = append(lines, path+"=******")
+			}
+			return nil
+		}
+		lines = append(lines, fmt.Sprintf("%v=%v", path, formatValue(value)))
+		return nil
+	})
+	return strings.Join(lines, "\n")
+}
+
+//Validate 校验配置，返回全部错误
+func (c *Config) Validate() error {
+	errs := make([]string, 0)
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Sprintf(format, args...))
+		}
+	}
+
+	check(c.Server.Address != "", "server.address is empty")
+	check(c.Server.AdminAddress != c.Server.Address, "server.adminAddress should be different from server.address")
+	check(c.Server.Objectpool > 0, "server.objectpool [%v] should be positive", c.Server.Objectpool)
+	_, err := c.Server.ParseTrustedProxies()
+	check(err == nil, "%v", err)
+	check(c.Mysql.Host != "" && c.Mysql.Port != "" && c.Mysql.User != "" && c.Mysql.Schema != "", "mysql host, port, user and schema should not be empty")
+	check(c.Redis.Host != "", "Redis.host is empty")
+	check(c.Redis.Index >= 0, "Redis.index [%v] should not be negative", c.Redis.Index)
+	check(c.Redis.Poolsize > 0, "Redis.poolsize [%v] should be positive", c.Redis.Poolsize)
+	errs = append(errs, c.validateNetwork()...)
+
+	check(c.Buffer.MaxNodeErr > 0, "buffer.maxNodeErr [%v] should be positive", c.Buffer.MaxNodeErr)
+	check(c.Buffer.MaxUnconfirmedBlockRead > 0, "buffer.maxUnconfirmedBlockRead [%v] should be positive", c.Buffer.MaxUnconfirmedBlockRead)
+	check(c.Buffer.MaxBlockInMemory > 0, "buffer.maxBlockInMemory [%v] should be positive", c.Buffer.MaxBlockInMemory)
+	check(c.Buffer.MaxConfirmedTrx > 0, "buffer.maxConfirmedTrx [%v] should be positive", c.Buffer.MaxConfirmedTrx)
+	taskLocation, err := time.LoadLocation(c.Task.Timezone)
+	check(err == nil, "task.timezone [%v] is invalid", c.Task.Timezone)
+	check(c.Task.LockTTL > 0, "task.lockTTL [%v] should be positive", c.Task.LockTTL)
+	check(c.Task.HistoryKeepDays > 0, "task.historyKeepDays [%v] should be positive", c.Task.HistoryKeepDays)
+	walkFields(reflect.ValueOf(&c.Task).Elem(), "task", func(path string, field reflect.StructField, value reflect.Value) error {
+		if value.Kind() == reflect.String && field.Name != "Timezone" && field.Name != "VoteWitnessRanking" {
+			_, err := cron.Parse(value.String(), taskLocation)
+			check(err == nil, "%v:%v", path, err)
+		}
+		return nil
+	})
+	//候选人排名在每个维护周期更新一次，配置的表达式必须和维护周期一致
+	if network := c.CurrentNetwork(); network != nil && network.MaintenanceInterval > 0 && taskLocation != nil {
+		spec := c.VoteWitnessRankingSpec()
+		schedule, err := cron.Parse(spec, taskLocation)
+		check(err == nil, "task.voteWitnessRanking:%v", err)
+		check(err != nil || runsEvery(schedule, network.MaintenanceInterval, taskLocation),
+			"task.voteWitnessRanking [%v] should run once every network.%v.maintenanceInterval [%v]", spec, c.Common.NetType, network.MaintenanceInterval)
+	}
+
+	if c.RateLimit.Enable {
+		check(c.RateLimit.AnonymousRate > 0 && c.RateLimit.AnonymousBurst > 0, "ratelimit anonymousRate [%v] and anonymousBurst [%v] should be positive",
+			c.RateLimit.AnonymousRate, c.RateLimit.AnonymousBurst)
+		check(c.RateLimit.KeyedIPRate > 0 && c.RateLimit.KeyedIPBurst > 0, "ratelimit keyedIPRate [%v] and keyedIPBurst [%v] should be positive",
+			c.RateLimit.KeyedIPRate, c.RateLimit.KeyedIPBurst)
+	}
+	check(c.RateLimit.AnonymousDailyQuota >= 0, "ratelimit.anonymousDailyQuota [%v] should not be negative", c.RateLimit.AnonymousDailyQuota)
+	check(c.Reward.PayoutRatio >= 0 && c.Reward.PayoutRatio <= 100, "reward.payoutRatio [%v] should be 0-100", c.Reward.PayoutRatio)
+	check(c.Reward.EstimateCycles > 0, "reward.estimateCycles [%v] should be positive", c.Reward.EstimateCycles)
+	check(c.Monitor.MissedThreshold > 0, "monitor.missedThreshold [%v] should be positive", c.Monitor.MissedThreshold)
+	check(c.Server.ShutdownTimeout > 0, "server.shutdownTimeout [%v] should be positive", c.Server.ShutdownTimeout)
+	check(c.Health.MaxSyncLag > 0, "health.maxSyncLag [%v] should be positive", c.Health.MaxSyncLag)
+	check(c.Health.Timeout > 0, "health.timeout [%v] should be positive", c.Health.Timeout)
+	if c.Trace.Enable {
+		check(c.Trace.Exporter == "stdout" || c.Trace.Exporter == "otlp", "trace.exporter [%v] should be stdout or otlp", c.Trace.Exporter)
+		check(c.Trace.Exporter != "otlp" || c.Trace.Endpoint != "", "trace.endpoint is empty")
+	}
+	check(c.Trace.SamplePercent >= 0 && c.Trace.SamplePercent <= 100, "trace.samplePercent [%v] should be 0-100", c.Trace.SamplePercent)
+
+	for _, name := range c.Price.Providers {
+		if name == "exchange" || name == "fixture" {
+			continue
+		}
+		source, ok := c.Price.Sources[name]
+		check(ok && source.URL != "" && source.Path != "", "price provider [%v] url or path is empty, should be configured in [price.%v]", name, name)
+	}
+	check(c.Price.StaleSeconds > 0, "price.staleSeconds [%v] should be positive", c.Price.StaleSeconds)
+
+	switch c.TokenMeta.Storage {
+	case "local":
+	case "s3":
+		check(c.TokenMeta.S3.Endpoint != "" && c.TokenMeta.S3.Bucket != "", "tokenMeta s3 endpoint or bucket is empty")
+	default:
+		errs = append(errs, fmt.Sprintf("tokenMeta.storage [%v] should be local or s3", c.TokenMeta.Storage))
+	}
+	check(len(c.TokenMeta.LogoSizes) > 0, "tokenMeta.logoSizes is empty")
+	for _, size := range c.TokenMeta.LogoSizes {
+		check(size > 0, "tokenMeta.logoSizes [%v] should be positive", size)
+	}
+	check(c.TokenMeta.MaxBytes > 0, "tokenMeta.maxBytes [%v] should be positive", c.TokenMeta.MaxBytes)
+
+	keys, err := c.Auth.ParseKeys()
+	check(err == nil, "%v", err)
+	if _, ok := keys[c.Auth.ActiveKey]; err == nil && len(keys) > 0 && !ok {
+		errs = append(errs, fmt.Sprintf("auth.activeKey [%v] not in keys", c.Auth.ActiveKey))
+	}
+	check(c.Auth.AccessTokenSeconds > 0 && c.Auth.RefreshTokenSeconds > 0 && c.Auth.NonceSeconds > 0, "auth token seconds should be positive")
+
+	check(!c.Faucet.Enable || c.Faucet.Keystore != "", "faucet.keystore not configured")
+	check(!c.Faucet.Enable || ((c.Faucet.Captcha == "recaptcha" || c.Faucet.Captcha == "hcaptcha") && c.Faucet.CaptchaURL != "" && c.Faucet.CaptchaSecret != ""),
+		"faucet.captcha should be recaptcha or hcaptcha with captchaURL and captchaSecret when faucet is enabled")
+	check(c.Faucet.Amount > 0 && c.Faucet.DailyBudget >= c.Faucet.Amount, "faucet amount [%v] or dailyBudget [%v] invalid", c.Faucet.Amount, c.Faucet.DailyBudget)
+
+	if len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n\t%v", strings.Join(errs, "\n\t"))
+	}
+	return nil
+}
+
+//validateNetwork 校验common.netType对应的网络配置
+func (c *Config) validateNetwork() []string {
+	errs := make([]string, 0)
+	check := func(ok bool, format string, args ...interface{}) {
+		if !ok {
+			errs = append(errs, fmt.Sprintf(format, args...))
+		}
+	}
+	if network := c.CurrentNetwork(); network == nil {
+		errs = append(errs, fmt.Sprintf("common.netType [%v] not found in network, should be %v or configured in [network.%v]",
+			c.Common.NetType, strings.Join(sortedKeys(c.Network), "/"), c.Common.NetType))
+	} else {
+		path := "network." + c.Common.NetType
+		check(len(network.FullNodes) > 0 && len(network.SolidityNodes) > 0, "%v fullNodes and solidityNodes should not be empty", path)
+		check(network.GrpcPort > 0 && network.GrpcPort < 65536, "%v.grpcPort [%v] invalid", path, network.GrpcPort)
+		check(network.AddressPrefix == utils.AddressPrefixMain || network.AddressPrefix == utils.AddressPrefixTest,
+			"%v.addressPrefix [%v] should be %v or %v", path, network.AddressPrefix, utils.AddressPrefixMain, utils.AddressPrefixTest)
+		check(network.MaintenanceInterval > 0, "%v.maintenanceInterval should be positive", path)
+		check(!network.Discover || (network.DiscoverInterval > 0 && network.DiscoverMaxProbe > 0), "%v discoverInterval and discoverMaxProbe should be positive", path)
+	}
+	return errs
+}
+
+//ParseKeys 解析 kid:secret 格式的密钥，secret至少32个字符
+func (a *AuthConfig) ParseKeys() (map[string]string, error) {
+	keys := make(map[string]string)
+	for _, key := range a.Keys {
+		kidSecret := strings.SplitN(key, ":", 2)
+		if len(kidSecret) != 2 || kidSecret[0] == "" || len(kidSecret[1]) < 32 {
+			return nil, fmt.Errorf("auth key [%v] invalid, should be kid:secret and secret at least 32 characters", kidSecret[0])
+		}
+		keys[kidSecret[0]] = kidSecret[1]
+	}
+	return keys, nil
+}
+
+//ParseTrustedProxies 解析可信的反向代理，单个IP按 /32 或 /128 处理
+func (s *ServerConfig) ParseTrustedProxies() ([]*net.IPNet, error) {
+	proxies := make([]*net.IPNet, 0, len(s.TrustedProxies))
+	for _, proxy := range s.TrustedProxies {
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return nil, fmt.Errorf("server.trustedProxies [%v] invalid", proxy)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				ip, bits = ip.To4(), 8*net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("server.trustedProxies [%v] invalid", proxy)
+		}
+		proxies = append(proxies, ipNet)
+	}
+	return proxies, nil
+}
+
+//mergeReload 从新配置中取可以热加载的分组，返回合并后的配置和修改了但需要重启才生效的分组
+func mergeReload(old, loaded *Config) (*Config, []string) {
+	merged := *old
+	restart := make([]string, 0)
+	mergedValue, loadedValue := reflect.ValueOf(&merged).Elem(), reflect.ValueOf(loaded).Elem()
+	for i := 0; i < mergedValue.NumField(); i++ {
+		field := mergedValue.Type().Field(i)
+		if reflect.DeepEqual(mergedValue.Field(i).Interface(), loadedValue.Field(i).Interface()) {
+			continue
+		}
+		if field.Tag.Get("reload") == "true" {
+			mergedValue.Field(i).Set(loadedValue.Field(i))
+		} else {
+			restart = append(restart, field.Tag.Get("toml"))
+		}
+	}
+	return &merged, restart
+}
+
+//walkFields 遍历结构体中的配置项，fn的path为 分组.名称
+func walkFields(v reflect.Value, prefix string, fn func(path string, field reflect.StructField, value reflect.Value) error) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := field.Tag.Get("toml")
+		if name == "" {
+			continue
+		}
+		path := joinPath(prefix, name)
+		if name == "*" {
+			path = prefix
+		}
+		if field.Type.Kind() == reflect.Map {
+			//map的值为结构体指针，按 分组.键.名称 遍历
+			for _, key := range sortedKeys(v.Field(i).Interface()) {
+				elem := v.Field(i).MapIndex(reflect.ValueOf(key)).Elem()
+				if err := walkFields(elem, joinPath(path, key), fn); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if field.Type.Kind() == reflect.Struct {
+			if err := walkFields(v.Field(i), path, fn); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := fn(path, field, v.Field(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setDefaults(v reflect.Value) error {
+	return walkFields(v, "", func(path string, field reflect.StructField, value reflect.Value) error {
+		if def, ok := field.Tag.Lookup("default"); ok {
+			if err := setValue(value, def); err != nil {
+				return fmt.Errorf("default of %v: %v", path, err)
+			}
+		}
+		return nil
+	})
+}
+
+func decodeStruct(v reflect.Value, values map[string]interface{}, prefix string, unknown *[]string) error {
+	known := make(map[string]bool)
+	var tables reflect.Value
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		name := field.Tag.Get("toml")
+		if name == "*" {
+			tables = v.Field(i)
+			continue
+		}
+		known[name] = true
+		raw, ok := values[name]
+		if !ok {
+			continue
+		}
+		path := joinPath(prefix, name)
+		if field.Type.Kind() == reflect.Struct || field.Type.Kind() == reflect.Map {
+			sub, ok := raw.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("%v should be a table", path)
+			}
+			var err error
+			if field.Type.Kind() == reflect.Map {
+				err = decodeTables(v.Field(i), sub, path, sortedKeys(sub), unknown)
+			} else {
+				err = decodeStruct(v.Field(i), sub, path, unknown)
+			}
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		if err := setValue(v.Field(i), raw); err != nil {
+			return fmt.Errorf("%v: %v", path, err)
+		}
+	}
+	rest := make([]string, 0)
+	for _, name := range sortedKeys(values) {
+		if known[name] {
+			continue
+		}
+		if _, isTable := values[name].(map[string]interface{}); !tables.IsValid() || !isTable {
+			*unknown = append(*unknown, joinPath(prefix, name))
+			continue
+		}
+		rest = append(rest, name)
+	}
+	if len(rest) > 0 {
+		return decodeTables(tables, values, prefix, rest, unknown)
+	}
+	return nil
+}
+
+//decodeTables 读取子表到 map[string]*结构体，已存在的项只覆盖配置了的字段
+func decodeTables(m reflect.Value, values map[string]interface{}, prefix string, names []string, unknown *[]string) error {
+	if m.IsNil() {
+		m.Set(reflect.MakeMap(m.Type()))
+	}
+	for _, name := range names {
+		path := joinPath(prefix, name)
+		sub, ok := values[name].(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%v should be a table", path)
+		}
+		elem := m.MapIndex(reflect.ValueOf(name))
+		if !elem.IsValid() {
+			elem = reflect.New(m.Type().Elem().Elem())
+			if err := setDefaults(elem.Elem()); err != nil {
+				return err
+			}
+		}
+		if err := decodeStruct(elem.Elem(), sub, path, unknown); err != nil {
+			return err
+		}
+		m.SetMapIndex(reflect.ValueOf(name), elem)
+	}
+	return nil
+}
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+//setValue 设置配置项，raw为配置文件中的值或环境变量、启动参数中的字符串
+//
+//	列表可以用逗号分隔的字符串配置，时长可以用整数秒配置
+func setValue(v reflect.Value, raw interface{}) error {
+	if v.Type() == durationType {
+		switch value := raw.(type) {
+		case int64:
+			v.SetInt(value * int64(time.Second))
+			return nil
+		case string:
+			if seconds, err := strconv.ParseInt(value, 10, 64); err == nil {
+				v.SetInt(seconds * int64(time.Second))
+				return nil
+			}
+			duration, err := time.ParseDuration(value)
+			if err != nil {
+				return fmt.Errorf("invalid duration [%v]", value)
+			}
+			v.SetInt(int64(duration))
+			return nil
+		}
+		return fmt.Errorf("invalid duration [%v]", raw)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		value, ok := raw.(string)
+		if !ok {
+			return fmt.Errorf("should be a string, got [%v]", raw)
+		}
+		v.SetString(value)
+	case reflect.Bool:
+		switch value := raw.(type) {
+		case bool:
+			v.SetBool(value)
+		case string:
+			b, err := strconv.ParseBool(value)
+			if err != nil {
+				return fmt.Errorf("should be true or false, got [%v]", value)
+			}
+			v.SetBool(b)
+		default:
+			return fmt.Errorf("should be true or false, got [%v]", raw)
+		}
+	case reflect.Int, reflect.Int64:
+		i, err := toInt64(raw)
+		if err != nil {
+			return err
+		}
+		v.SetInt(i)
+	case reflect.Slice:
+		items, err := toList(raw)
+		if err != nil {
+			return err
+		}
+		slice := reflect.MakeSlice(v.Type(), len(items), len(items))
+		for i, item := range items {
+			if err := setValue(slice.Index(i), item); err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	default:
+		return fmt.Errorf("unsupported config type %v", v.Type())
+	}
+	return nil
+}
+
+func toInt64(raw interface{}) (int64, error) {
+	switch value := raw.(type) {
+	case int64:
+		return value, nil
+	case float64:
+		if value == float64(int64(value)) {
+			return int64(value), nil
+		}
+	case string:
+		if i, err := strconv.ParseInt(strings.TrimSpace(value), 10, 64); err == nil {
+			return i, nil
+		}
+	}
+	return 0, fmt.Errorf("should be an integer, got [%v]", raw)
+}
+
+//toList 数组或逗号分隔的字符串，忽略空项
+func toList(raw interface{}) ([]interface{}, error) {
+	switch value := raw.(type) {
+	case []interface{}:
+		return value, nil
+	case string:
+		items := make([]interface{}, 0)
+		for _, item := range strings.Split(value, ",") {
+			if item = strings.TrimSpace(item); item != "" {
+				items = append(items, item)
+			}
+		}
+		return items, nil
+	}
+	return nil, fmt.Errorf("should be an array or comma separated string, got [%v]", raw)
+}
+
+func formatValue(v reflect.Value) string {
+	if v.Kind() == reflect.Slice {
+		items := make([]string, 0, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			items = append(items, fmt.Sprint(v.Index(i).Interface()))
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(v.Interface())
+}
+
+func isZero(v reflect.Value) bool {
+	if v.Kind() == reflect.Slice {
+		return v.Len() == 0
+	}
+	return v.Interface() == reflect.Zero(v.Type()).Interface()
+}
+
+func joinPath(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+func sortedKeys(m interface{}) []string {
+	keys := make([]string, 0)
+	for _, key := range reflect.ValueOf(m).MapKeys() {
+		keys = append(keys, key.String())
+	}
+	sort.Strings(keys)
+	return keys
+}
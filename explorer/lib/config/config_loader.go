@@ -9,14 +9,23 @@
 package config
 
 import (
+	"crypto/rand"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/health"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/mysql"
 	"github.com/wlcy/tron/explorer/lib/redis"
-	"github.com/wlcy/tron/explorer/lib/util"
-
-	"github.com/pelletier/go-toml"
+	"github.com/wlcy/tron/explorer/lib/storage"
+	"github.com/wlcy/tron/explorer/lib/trace"
 )
 
 //配置信息
@@ -30,91 +39,308 @@ var TokenTemplateFile string
 
 var HttpWebKey, NetType string
 
+//行情配置
+var PriceProviders []string
+var PriceSources map[string]*PriceSource
+var PriceStaleSeconds int64
+var PriceUSDToken string
+var PriceFixture string
+
+//通证资料配置
+var TokenLogoStorage storage.Storage
+var TokenLogoSizes []int64
+var TokenLogoMaxBytes int64
+var TokenMetaSignExpireSeconds int64
+
+//登录认证配置
+var AuthKeys map[string]string
+var AuthActiveKey string
+var AuthAccessTokenSeconds, AuthRefreshTokenSeconds, AuthNonceSeconds int64
+
 // LoadConfig read config from file and init dspFrontServer run environment variable
-//  call before Start pool.Server()
-// 	if return is not nil, should not start pool.Server()
-func LoadConfig(confFile string) error {
-	config, err := toml.LoadFile(confFile)
+//
+//	 call before Start pool.Server()
+//		if return is not nil, should not start pool.Server()
+//		overrides 为启动参数中的配置覆盖，格式 分组.名称=值
+func LoadConfig(confFile string, overrides ...string) error {
+	conf, unknown, err := Load(confFile, overrides)
 	if nil != err {
-		log.Errorf("open config file [%v] failed[%v]!", confFile, err)
+		log.Errorf("load config file [%v] failed[%v]!", confFile, err)
 		return err
 	}
-
-	if err = initRedis(config); nil != err {
-		log.Errorf("get Redis config failed[%v]!", err)
-		return err
+	for _, item := range unknown {
+		log.Warnf("unknown config item [%v] in [%v]", item, confFile)
 	}
-
-	if err = initDB(config); nil != err {
-		log.Errorf("get db config failed:[%v]!", err)
+	if err = conf.Validate(); nil != err {
+		log.Errorf("%v", err)
 		return err
 	}
+	log.Infof("config loaded from [%v]:\n%v", confFile, conf)
 
-	if err = initToken(config); nil != err {
-		log.Errorf("get token config failed:[%v]!", err)
-		return err
-	}
-	if err = initCommon(config); nil != err {
-		log.Errorf("get common config failed:[%v]!", err)
-		return err
+	setCurrent(conf)
+	loadedFile, loadedOverrides = confFile, overrides
+	for _, item := range []struct {
+		name string
+		fn   func(*Config) error
+	}{
+		{"Redis", initRedis},
+		{"db", initDB},
+		{"network", initNetwork},
+		{"token", initToken},
+		{"common", initCommon},
+		{"health", initHealth},
+		{"trace", initTrace},
+		{"price", initPrice},
+		{"tokenMeta", initTokenMeta},
+		{"auth", initAuth},
+	} {
+		if err = item.fn(conf); nil != err {
+			log.Errorf("get %v config failed:[%v]!", item.name, err)
+			return err
+		}
 	}
 
 	return nil
 }
 
 // initRedis 初始化Redis连接
-func initRedis(config *toml.Tree) error {
+func initRedis(conf *Config) error {
+	RedisCli = redis.NewClient(conf.Redis.Host, conf.Redis.Pass, conf.Redis.Index, conf.Redis.Poolsize)
+	return nil
+}
 
-	redisInfo := struct {
-		Addr     string
-		Password string
-		Db       int
-		PoolSize int
-	}{}
-	redisInfo.Addr = config.GetDefault("Redis.host", "127.0.0.1:6379").(string)
-	redisInfo.Password = config.GetDefault("Redis.pass", "127.0.0.1:6379").(string)
-	redisInfo.Db = int(util.ToInt64(config.GetDefault("Redis.index", 0)))
-	redisInfo.PoolSize = int(util.ToInt64(config.GetDefault("Redis.poolSize", 10)))
+//initDB 初始化DB baseAdapter.loadAdxTemplateData use
+func initDB(conf *Config) error {
+	mysql.Initialize(conf.Mysql.Host, conf.Mysql.Port, conf.Mysql.Schema, conf.Mysql.User, conf.Mysql.Pass)
+	return nil
+}
 
-	RedisCli = redis.NewClient(redisInfo.Addr, redisInfo.Password, redisInfo.Db, redisInfo.PoolSize)
+//initNetwork 按common.netType设置当前网络的节点列表、地址前缀和维护周期
+func initNetwork(conf *Config) error {
+	network := conf.CurrentNetwork()
+	if network == nil {
+		return fmt.Errorf("network [%v] not configured", conf.Common.NetType)
+	}
+	utils.SetNetwork(&utils.Network{
+		Name:                conf.Common.NetType,
+		FullNodes:           network.FullNodes,
+		SolidityNodes:       network.SolidityNodes,
+		GrpcPort:            network.GrpcPort,
+		AddressPrefix:       network.AddressPrefix,
+		MaintenanceInterval: int64(network.MaintenanceInterval / time.Millisecond),
+		GenesisBlockHash:    network.GenesisBlockHash,
+		GenesisTime:         network.GenesisTime,
+	})
+	return nil
+}
+
+//SetupNetwork 只加载网络配置，用于不需要完整配置的同步程序，confFile为空时使用内置网络
+//	overrides 格式同 LoadConfig，如 common.netType=testnet
+func SetupNetwork(confFile string, overrides ...string) error {
+	conf, _, err := Load(confFile, overrides)
+	if nil != err {
+		return err
+	}
+	if errs := conf.validateNetwork(); len(errs) > 0 {
+		return fmt.Errorf("invalid config:\n\t%v", strings.Join(errs, "\n\t"))
+	}
+	return initNetwork(conf)
+}
 
+//initToken 初始化token参数
+func initToken(conf *Config) error {
+	DefaultPath = conf.Token.DefaultPath
+	TokenTemplate = conf.Token.TokenTemplate
+	ImgURL = conf.Token.ImgURL
+	TokenTemplateFile = conf.Token.TokenTemplateFile
 	return nil
 }
 
-//initDB 初始化DB baseAdapter.loadAdxTemplateData use
-func initDB(config *toml.Tree) error {
-	const NodeName = "mysql"
-	host := config.GetDefault(fmt.Sprintf("%v.host", NodeName), "127.0.0.1").(string)
-	port := config.GetDefault(fmt.Sprintf("%v.port", NodeName), "3306").(string)
-	schema := config.GetDefault(fmt.Sprintf("%v.schema", NodeName), "tron").(string)
-	user := config.GetDefault(fmt.Sprintf("%v.user", NodeName), "tron").(string)
-	passwd := config.GetDefault(fmt.Sprintf("%v.pass", NodeName), "tron").(string)
+//initCommon 初始化common参数
+func initCommon(conf *Config) error {
+	HttpWebKey = conf.Common.HttpWebKey
+	NetType = conf.Common.NetType
+	return nil
+}
 
-	log.Debugf("host:%v, port:%v, schema:%v, user:%v, passwd:%v", host, port, schema, user, passwd)
+//initHealth 设置就绪检查的超时时间，同步落后的块数在检查时读取
+func initHealth(conf *Config) error {
+	health.SetTimeout(conf.Health.Timeout)
+	return nil
+}
 
-	mysql.Initialize(host, port, schema, user, passwd)
+//initTrace 按配置替换当前的Tracer，未启用时停止追踪
+func initTrace(conf *Config) error {
+	if !conf.Trace.Enable {
+		trace.Setup(trace.Options{})
+		return nil
+	}
+	exporter, err := trace.NewExporter(conf.Trace.Exporter, conf.Trace.Endpoint)
+	if err != nil {
+		return err
+	}
+	trace.Setup(trace.Options{Service: conf.Trace.ServiceName, Exporter: exporter, SamplePercent: conf.Trace.SamplePercent})
+	return nil
+}
 
+//initPrice 初始化行情参数，providers为行情来源，exchange为链上交易对，fixture为本地文件，其他名称为 [price.名称] 配置的json接口
+func initPrice(conf *Config) error {
+	PriceProviders = conf.Price.Providers
+	PriceSources = make(map[string]*PriceSource)
+	for _, name := range PriceProviders {
+		if source, ok := conf.Price.Sources[name]; ok {
+			PriceSources[name] = source
+		}
+	}
+	PriceStaleSeconds = conf.Price.StaleSeconds
+	PriceUSDToken = conf.Price.USDToken
+	PriceFixture = conf.Price.Fixture
 	return nil
 }
 
-//initToken 初始化token参数
-func initToken(config *toml.Tree) error {
-	const NodeName = "token"
-	DefaultPath = config.GetDefault(fmt.Sprintf("%v.defaultPath", NodeName), "/data/images/tokenLogo").(string)
-	TokenTemplate = config.GetDefault(fmt.Sprintf("%v.tokenTemplate", NodeName), "/data/images/tokenTemplate/").(string)
-	ImgURL = config.GetDefault(fmt.Sprintf("%v.imgURL", NodeName), "http://coin.top/tokenLogo").(string)
-	TokenTemplateFile = config.GetDefault(fmt.Sprintf("%v.tokenTemplateFile", NodeName), "http://coin.top/tokenTemplate/TronscanTokenInformationSubmissionTemplate.xlsx").(string)
-	log.Printf("defaultPath:[%v], tokenTemplate:[%v],imgURL:[%v],tokenTemplateFile:[%v]", DefaultPath, TokenTemplate, ImgURL, TokenTemplateFile)
+//initTokenMeta 初始化通证资料参数，storage为logo存储方式 local 或 s3，logoSizes为logo缩放后的边长
+//signExpireSeconds为提交资料时签名交易的有效期
+func initTokenMeta(conf *Config) error {
+	meta := conf.TokenMeta
+	switch meta.Storage {
+	case "local":
+		localPath, baseURL := meta.LocalPath, meta.BaseURL
+		if localPath == "" {
+			localPath = conf.Token.DefaultPath
+		}
+		if baseURL == "" {
+			baseURL = conf.Token.ImgURL
+		}
+		TokenLogoStorage = storage.NewLocalStorage(localPath, baseURL)
+	case "s3":
+		TokenLogoStorage = storage.NewS3Storage(meta.S3.Endpoint, meta.S3.Region, meta.S3.Bucket, meta.S3.AccessKey, meta.S3.SecretKey, meta.S3.BaseURL)
+	default:
+		return fmt.Errorf("tokenMeta storage [%v] not support", meta.Storage)
+	}
+	TokenLogoSizes = meta.LogoSizes
+	TokenLogoMaxBytes = meta.MaxBytes
+	TokenMetaSignExpireSeconds = meta.SignExpireSeconds
+	return nil
+}
 
+//initAuth 初始化登录认证参数，keys为jwt签名密钥，格式 kid:secret，activeKey为签发token使用的密钥
+//轮换密钥时新增密钥并修改activeKey，旧密钥保留到其签发的token过期后再删除
+//没有配置keys时使用 common.httpWebKey，都没有配置时生成随机密钥，重启后已签发的token失效
+func initAuth(conf *Config) error {
+	keys, err := conf.Auth.ParseKeys()
+	if err != nil {
+		return err
+	}
+	AuthKeys = keys
+	AuthActiveKey = conf.Auth.ActiveKey
+	if len(AuthKeys) == 0 {
+		AuthActiveKey = "default"
+		AuthKeys[AuthActiveKey] = conf.Common.HttpWebKey
+		if conf.Common.HttpWebKey == "" {
+			secret := make([]byte, 32)
+			if _, err := rand.Read(secret); err != nil {
+				return err
+			}
+			AuthKeys[AuthActiveKey] = hex.EncodeToString(secret)
+			log.Errorf("auth keys not configured, use random key, tokens will be invalid after restart")
+		}
+	}
+	if _, ok := AuthKeys[AuthActiveKey]; !ok {
+		return fmt.Errorf("auth activeKey [%v] not in keys", AuthActiveKey)
+	}
+	AuthAccessTokenSeconds = conf.Auth.AccessTokenSeconds
+	AuthRefreshTokenSeconds = conf.Auth.RefreshTokenSeconds
+	AuthNonceSeconds = conf.Auth.NonceSeconds
 	return nil
 }
 
-//initCommon 初始化common参数
-func initCommon(config *toml.Tree) error {
-	const NodeName = "common"
-	HttpWebKey = config.GetDefault(fmt.Sprintf("%v.httpWebKey", NodeName), "WoiYeI5brZy4S8wQfVz7M5BczMkIhnugYW5QIibNgnWsAsktgHn5").(string)
-	NetType = config.GetDefault(fmt.Sprintf("%v.netType", NodeName), "testnet").(string)
-	log.Printf("HttpWebKey:[%v], NetType:[%v]", HttpWebKey, NetType)
+//reloadInits 热加载后需要重新初始化的分组，其他可热加载的分组在使用时通过 Get() 读取
+var reloadInits = map[string]func(*Config) error{
+	"health": initHealth,
+	"trace":  initTrace,
+}
+
+var reloadMutex sync.Mutex
+var reloadHooks []func(*Config)
 
+//启动时加载的配置文件和覆盖参数
+var loadedFile string
+var loadedOverrides []string
+
+//OnReload 注册热加载后的回调，参数为新的配置
+func OnReload(fn func(*Config)) {
+	reloadMutex.Lock()
+	reloadHooks = append(reloadHooks, fn)
+	reloadMutex.Unlock()
+}
+
+//Reload 重新加载配置文件，只有标记了reload的分组生效，其他分组的修改记录日志，重启后生效
+//
+//	加载或校验失败时保留原来的配置
+func Reload(confFile string, overrides ...string) error {
+	reloadMutex.Lock()
+	defer reloadMutex.Unlock()
+	loaded, unknown, err := Load(confFile, overrides)
+	if err == nil {
+		err = loaded.Validate()
+	}
+	if err != nil {
+		log.Errorf("reload config [%v] failed, keep current config:[%v]", confFile, err)
+		return err
+	}
+	for _, item := range unknown {
+		log.Warnf("unknown config item [%v] in [%v]", item, confFile)
+	}
+	merged, restart := mergeReload(Get(), loaded)
+	if len(restart) > 0 {
+		log.Warnf("config %v changed, restart required to take effect", restart)
+	}
+	setCurrent(merged)
+	for name, fn := range reloadInits {
+		if err := fn(merged); err != nil {
+			log.Errorf("reload %v config failed:[%v]", name, err)
+		}
+	}
+	for _, hook := range reloadHooks {
+		hook(merged)
+	}
+	log.Infof("config reloaded from [%v]", confFile)
 	return nil
 }
+
+//ReloadLoaded 按启动时的配置文件和覆盖参数重新加载，用于管理接口
+func ReloadLoaded() error {
+	return Reload(loadedFile, loadedOverrides...)
+}
+
+//Watch 收到SIGHUP或配置文件修改后重新加载，interval为检查文件修改时间的间隔，0不检查
+func Watch(confFile string, interval time.Duration, overrides ...string) {
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, syscall.SIGHUP)
+	var tick <-chan time.Time
+	if interval > 0 {
+		tick = time.Tick(interval)
+	}
+	modTime := fileModTime(confFile)
+	go func() {
+		for {
+			select {
+			case <-sigCh:
+			case <-tick:
+				if mt := fileModTime(confFile); mt.Equal(modTime) {
+					continue
+				}
+			}
+			modTime = fileModTime(confFile)
+			Reload(confFile, overrides...)
+		}
+	}()
+}
+
+func fileModTime(file string) time.Time {
+	stat, err := os.Stat(file)
+	if err != nil {
+		return time.Time{}
+	}
+	return stat.ModTime()
+}
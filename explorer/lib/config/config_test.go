@@ -0,0 +1,221 @@
+package config
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testConfig = `
+[server]
+address = ":8080"
+
+[mysql]
+host = "10.0.0.1"
+pass = "in-file"
+
+[Redis]
+poolsize = 20
+
+[common]
+netType = "private"
+
+[network.private]
+fullNodes = ["10.0.0.2", "10.0.0.3:50061"]
+solidityNodes = "10.0.0.4, 10.0.0.5"
+maintenanceInterval = "10m"
+
+[network.mainnet]
+grpcPort = 50052
+
+[task]
+exchange = "30s"
+proposal = "0 */2 * * * *"
+
+[price]
+providers = "coingecko,exchange"
+
+[price.coingecko]
+url = "https://api.coingecko.com/api/v3/simple/price?ids=tron&vs_currencies=usd"
+path = "tron.usd"
+
+[tokenMeta]
+logoSizes = [128, 32]
+
+[faucet]
+amount = 100
+dailyBudget = 1000
+unknownKey = 1
+`
+
+func writeTestConfig(t *testing.T, content string) string {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "config.toml")
+	if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestLoad(t *testing.T) {
+	file := writeTestConfig(t, testConfig)
+	defer os.RemoveAll(filepath.Dir(file))
+	os.Setenv("EXPLORER_MYSQL_PASS", "from-env")
+	os.Setenv("FAUCET_KEYSTORE_PASSWORD", "faucet-pass")
+	os.Setenv("EXPLORER_NETWORK_PRIVATE_GENESISBLOCKHASH", "0000")
+	defer os.Unsetenv("EXPLORER_NETWORK_PRIVATE_GENESISBLOCKHASH")
+	defer os.Unsetenv("EXPLORER_MYSQL_PASS")
+	defer os.Unsetenv("FAUCET_KEYSTORE_PASSWORD")
+
+	conf, unknown, err := Load(file, []string{"server.objectpool=5", "Ratelimit.Enable=true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(unknown) != 1 || unknown[0] != "faucet.unknownKey" {
+		t.Errorf("unknown:%v", unknown)
+	}
+	if conf.Server.Address != ":8080" || conf.Server.Objectpool != 5 || !conf.RateLimit.Enable {
+		t.Errorf("server:%+v ratelimit:%+v", conf.Server, conf.RateLimit)
+	}
+	//环境变量覆盖配置文件，未配置的使用默认值
+	if conf.Mysql.Host != "10.0.0.1" || conf.Mysql.Pass != "from-env" || conf.Mysql.Port != "3306" || conf.Faucet.Password != "faucet-pass" {
+		t.Errorf("mysql:%+v faucet password:%v", conf.Mysql, conf.Faucet.Password)
+	}
+	if conf.Redis.Poolsize != 20 || conf.Redis.Host != "127.0.0.1:6379" {
+		t.Errorf("redis:%+v", conf.Redis)
+	}
+	network := conf.CurrentNetwork()
+	if network == nil || strings.Join(network.FullNodes, ",") != "10.0.0.2,10.0.0.3:50061" || strings.Join(network.SolidityNodes, ",") != "10.0.0.4,10.0.0.5" ||
+		network.MaintenanceInterval != 10*time.Minute || network.GrpcPort != 50051 || network.AddressPrefix != "41" || network.GenesisBlockHash != "0000" {
+		t.Errorf("network:%+v", network)
+	}
+	//内置网络只覆盖配置了的项
+	if mainnet := conf.Network["mainnet"]; mainnet.GrpcPort != 50052 || len(mainnet.FullNodes) == 0 || conf.Network["testnet"] == nil {
+		t.Errorf("mainnet:%+v", mainnet)
+	}
+	if conf.Task.Exchange != "30s" || conf.Task.Proposal != "0 */2 * * * *" || conf.Task.TodayReport != "@every 3m" || conf.Task.LockTTL != 10*time.Minute {
+		t.Errorf("task:%+v", conf.Task)
+	}
+	if len(conf.TokenMeta.LogoSizes) != 2 || conf.TokenMeta.LogoSizes[0] != 128 || conf.TokenMeta.LogoSizes[1] != 32 {
+		t.Errorf("logoSizes:%v", conf.TokenMeta.LogoSizes)
+	}
+	source := conf.Price.Sources["coingecko"]
+	if source == nil || source.Path != "tron.usd" || source.Symbol != "TRX" || source.Currency != "USD" {
+		t.Errorf("price source:%+v", source)
+	}
+	if err := conf.Validate(); err != nil {
+		t.Errorf("Validate:%v", err)
+	}
+
+	if _, _, err := Load(file, []string{"mysql.unknown=1"}); err == nil {
+		t.Errorf("override unknown item")
+	}
+	if _, _, err := Load(file, []string{"server.objectpool=many"}); err == nil || !strings.Contains(err.Error(), "server.objectpool") {
+		t.Errorf("override invalid value:%v", err)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	conf := NewConfig()
+	if err := conf.Validate(); err != nil {
+		t.Fatalf("default config:%v", err)
+	}
+	conf.Common.NetType = "devnet"
+	conf.Price.Providers = []string{"binance"}
+	conf.Auth.Keys = []string{"k1:short"}
+	conf.Faucet.Enable = true
+	conf.Task.Exchange = "* * *"
+	conf.Server.TrustedProxies = []string{"10.0.0.0/8", "proxy"}
+	err := conf.Validate()
+	if err == nil {
+		t.Fatal("invalid config passed")
+	}
+	for _, item := range []string{"common.netType [devnet]", "price provider [binance]", "auth key [k1]", "faucet.keystore", "faucet.captcha", "task.exchange:invalid cron spec", "server.trustedProxies [proxy]"} {
+		if !strings.Contains(err.Error(), item) {
+			t.Errorf("Validate should report %v:%v", item, err)
+		}
+	}
+}
+
+func TestVoteWitnessRankingSpec(t *testing.T) {
+	shanghai := time.FixedZone("CST", 8*3600)
+	cases := []struct {
+		interval time.Duration
+		loc      *time.Location
+		want     string
+	}{
+		{6 * time.Hour, time.UTC, "0 1 0,6,12,18 * * *"},
+		{6 * time.Hour, shanghai, "0 1 2,8,14,20 * * *"},
+		{10 * time.Minute, time.UTC, "0 1,11,21,31,41,51 * * * *"},
+		{90 * time.Minute, time.UTC, "@every 1h30m0s"},
+		{7 * time.Hour, time.UTC, "@every 7h0m0s"},
+	}
+	for _, c := range cases {
+		if got := maintenanceSpec(c.interval, c.loc); got != c.want {
+			t.Errorf("maintenanceSpec(%v, %v) = %v, want %v", c.interval, c.loc, got, c.want)
+		}
+	}
+
+	conf := NewConfig()
+	if spec := conf.VoteWitnessRankingSpec(); spec != "0 1 0,6,12,18 * * *" {
+		t.Errorf("default spec:%v", spec)
+	}
+	conf.CurrentNetwork().MaintenanceInterval = 10 * time.Minute
+	if err := conf.Validate(); err != nil {
+		t.Errorf("derived spec should match maintenance interval:%v", err)
+	}
+	conf.Task.VoteWitnessRanking = "0 1 0,6,12,18 * * *"
+	if err := conf.Validate(); err == nil || !strings.Contains(err.Error(), "task.voteWitnessRanking") {
+		t.Errorf("Validate should report task.voteWitnessRanking:%v", err)
+	}
+}
+
+func TestParseTrustedProxies(t *testing.T) {
+	server := &ServerConfig{TrustedProxies: []string{"127.0.0.1", "10.0.0.0/8", "::1"}}
+	proxies, err := server.ParseTrustedProxies()
+	if err != nil || len(proxies) != 3 {
+		t.Fatalf("ParseTrustedProxies:%v %v", proxies, err)
+	}
+	if !proxies[0].Contains(net.ParseIP("127.0.0.1")) || proxies[0].Contains(net.ParseIP("127.0.0.2")) ||
+		!proxies[1].Contains(net.ParseIP("10.1.2.3")) || !proxies[2].Contains(net.ParseIP("::1")) {
+		t.Errorf("ParseTrustedProxies:%v", proxies)
+	}
+}
+
+func TestString(t *testing.T) {
+	conf := NewConfig()
+	conf.Mysql.Pass = "secret"
+	out := conf.String()
+	if strings.Contains(out, "=secret") || !strings.Contains(out, "mysql.pass=******") || !strings.Contains(out, "Redis.pass=\n") {
+		t.Errorf("String should mask secrets:%v", out)
+	}
+	if !strings.Contains(out, "task.exchange=@every 1m") || !strings.Contains(out, "tokenMeta.logoSizes=256,64") || !strings.Contains(out, "network.testnet.grpcPort=50051") {
+		t.Errorf("String:%v", out)
+	}
+}
+
+func TestMergeReload(t *testing.T) {
+	old := NewConfig()
+	loaded := NewConfig()
+	loaded.Mysql.Host = "10.0.0.1"
+	loaded.Reward.PayoutRatio = 50
+	loaded.Faucet.Amount = 1
+
+	merged, restart := mergeReload(old, loaded)
+	if merged.Mysql.Host != old.Mysql.Host || merged.Reward.PayoutRatio != 50 || merged.Faucet.Amount != 1 {
+		t.Errorf("merged:%+v %+v %+v", merged.Mysql, merged.Reward, merged.Faucet)
+	}
+	if len(restart) != 1 || restart[0] != "mysql" {
+		t.Errorf("restart:%v", restart)
+	}
+	if old.Reward.PayoutRatio != 80 {
+		t.Errorf("old config changed")
+	}
+}
@@ -0,0 +1,253 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestParse(t *testing.T) {
+	base := time.Date(2018, 10, 9, 23, 59, 30, 500, time.UTC) // 周二
+	for _, c := range []struct {
+		spec string
+		next string
+	}{
+		{"1 0 * * *", "2018-10-10 00:01:00"},
+		{"1 0 0 * * *", "2018-10-10 00:00:01"},
+		{"0 1 0,6,12,18 * * *", "2018-10-10 00:01:00"},
+		{"*/15 * * * *", "2018-10-10 00:00:00"},
+		{"0 9-17/4 * * 1-5", "2018-10-10 09:00:00"},
+		{"0 0 1 * *", "2018-11-01 00:00:00"},
+		{"0 0 13 * 5", "2018-10-12 00:00:00"},
+		{"0 0 * * 7", "2018-10-14 00:00:00"},
+		{"0 0 29 2 *", "2020-02-29 00:00:00"},
+		{"@daily", "2018-10-10 00:00:00"},
+		{"@hourly", "2018-10-10 00:00:00"},
+		{"@every 3m", "2018-10-10 00:00:00"},
+		{"@every 1h", "2018-10-10 00:00:00"},
+		{"5m", "2018-10-10 00:00:00"},
+		{"120", "2018-10-10 00:00:00"},
+	} {
+		schedule, err := Parse(c.spec, nil)
+		if err != nil {
+			t.Errorf("[%v]:%v", c.spec, err)
+			continue
+		}
+		if next := schedule.Next(base).Format("2006-01-02 15:04:05"); next != c.next {
+			t.Errorf("[%v] next:%v, expected:%v", c.spec, next, c.next)
+		}
+	}
+
+	loc := time.FixedZone("UTC+8", 8*3600)
+	schedule, _ := Parse("0 8 * * *", loc)
+	if next := schedule.Next(base); !next.Equal(time.Date(2018, 10, 10, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("next in location:%v", next)
+	}
+	if next := schedule.Next(base); next.Location() != time.UTC {
+		t.Errorf("next should keep location of t:%v", next.Location())
+	}
+	if schedule, _ := Parse("0 0 30 2 *", nil); !schedule.Next(base).IsZero() {
+		t.Errorf("feb 30 should never run")
+	}
+
+	for _, spec := range []string{"", "* * * *", "60 * * * *", "* 24 * * *", "* * 0 * *", "* * * 13 *", "* * * * 8",
+		"*/0 * * * *", "5-1 * * * *", "a * * * *", "@every 1ms", "@every x", "@weekday", "0", "-5m"} {
+		if _, err := Parse(spec, nil); err == nil {
+			t.Errorf("[%v] should be invalid", spec)
+		}
+	}
+}
+
+type memLocker struct {
+	mutex sync.Mutex
+	locks map[string]string
+}
+
+func (l *memLocker) TryLock(key, token string, ttl time.Duration) (bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if _, ok := l.locks[key]; ok {
+		return false, nil
+	}
+	l.locks[key] = token
+	return true, nil
+}
+
+func (l *memLocker) Refresh(key, token string, ttl time.Duration) (bool, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	return l.locks[key] == token, nil
+}
+
+func (l *memLocker) Unlock(key, token string) error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	if l.locks[key] == token {
+		delete(l.locks, key)
+	}
+	return nil
+}
+
+type memStore struct {
+	mutex sync.Mutex
+	runs  []*Run
+}
+
+func (s *memStore) SaveRun(run *Run) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.runs = append(s.runs, run)
+	return nil
+}
+
+func (s *memStore) LastScheduled(job string) (int64, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	var last int64
+	for _, run := range s.runs {
+		if run.Job == job && (run.Trigger == TriggerSchedule || run.Trigger == TriggerCatchUp) && run.ScheduledTime > last {
+			last = run.ScheduledTime
+		}
+	}
+	return last, nil
+}
+
+func (s *memStore) byTrigger(trigger string) []*Run {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ret := make([]*Run, 0)
+	for _, run := range s.runs {
+		if run.Trigger == trigger {
+			ret = append(ret, run)
+		}
+	}
+	return ret
+}
+
+func TestSchedulerSingleRunAcrossInstances(t *testing.T) {
+	locker := &memLocker{locks: make(map[string]string)}
+	store := &memStore{}
+	var mutex sync.Mutex
+	count := 0
+	job := Job{Name: "report", Spec: "@every 1s", Run: func(ctx context.Context) error {
+		mutex.Lock()
+		count++
+		mutex.Unlock()
+		time.Sleep(50 * time.Millisecond)
+		return nil
+	}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	var wg sync.WaitGroup
+	for _, instance := range []string{"a", "b", "c"} {
+		s := New(Options{Locker: locker, Store: store, Instance: instance})
+		if err := s.Add(job); err != nil {
+			t.Fatal(err)
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			s.Run(ctx)
+		}()
+	}
+	time.Sleep(2500 * time.Millisecond)
+	cancel()
+	wg.Wait()
+
+	runs := store.byTrigger(TriggerSchedule)
+	if len(runs) < 2 || len(runs) > 3 || len(runs) != count {
+		t.Fatalf("runs:%v count:%v", len(runs), count)
+	}
+	slots := make(map[int64]bool)
+	for _, run := range runs {
+		if slots[run.ScheduledTime] {
+			t.Errorf("slot %v executed more than once", run.ScheduledTime)
+		}
+		slots[run.ScheduledTime] = true
+		if run.Status != StatusSuccess || run.Duration < 50 {
+			t.Errorf("run:%+v", run)
+		}
+	}
+	if len(locker.locks) != 0 {
+		t.Errorf("locks should be released:%v", locker.locks)
+	}
+}
+
+func TestSchedulerCatchUpTriggerAndShutdown(t *testing.T) {
+	store := &memStore{}
+	//上次执行是两天前，启动后补执行一次
+	store.SaveRun(&Run{Job: "daily", Trigger: TriggerSchedule, ScheduledTime: time.Now().Add(-48*time.Hour).UnixNano() / 1e6})
+
+	s := New(Options{Store: store})
+	release := make(chan struct{})
+	s.Add(Job{Name: "daily", Spec: "@daily", Run: func(ctx context.Context) error { return nil }})
+	s.Add(Job{Name: "slow", Spec: "@yearly", Run: func(ctx context.Context) error {
+		<-release
+		return errors.New("node unavailable")
+	}})
+	s.Add(Job{Name: "panic", Spec: "@yearly", Run: func(ctx context.Context) error { panic("nil map") }})
+	flushed := make(chan string, 2)
+	s.Add(Job{Name: "flush", Spec: "@yearly", OnStop: true, Run: func(ctx context.Context) error {
+		flushed <- "flush"
+		return nil
+	}})
+	if err := s.Add(Job{Name: "daily", Spec: "@daily"}); err == nil {
+		t.Errorf("duplicate job should fail")
+	}
+	if err := s.Add(Job{Name: "bad", Spec: "* * *"}); err == nil {
+		t.Errorf("invalid spec should fail")
+	}
+	if err := s.Trigger("slow"); err != ErrNotStarted {
+		t.Errorf("trigger before run:%v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+	time.Sleep(50 * time.Millisecond)
+	if err := s.Trigger("slow"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Trigger("slow"); err != ErrJobRunning {
+		t.Errorf("trigger running job:%v", err)
+	}
+	if err := s.Trigger("unknown"); err != ErrUnknownJob {
+		t.Errorf("trigger unknown job:%v", err)
+	}
+	s.Trigger("panic")
+	time.Sleep(100 * time.Millisecond)
+	for _, status := range s.Jobs() {
+		if status.Name == "slow" && (!status.Running || status.LastRun.Status != StatusRunning) {
+			t.Errorf("slow should be running:%+v", status)
+		}
+		if status.Name == "daily" && status.Next == 0 {
+			t.Errorf("daily should have next run time")
+		}
+	}
+	close(release)
+	cancel()
+	<-done
+
+	catchUp := store.byTrigger(TriggerCatchUp)
+	if len(catchUp) != 1 || catchUp[0].ScheduledTime <= time.Now().Add(-25*time.Hour).UnixNano()/1e6 {
+		t.Errorf("catch up runs:%+v", catchUp)
+	}
+	manual := make(map[string]*Run)
+	for _, run := range store.byTrigger(TriggerManual) {
+		manual[run.Job] = run
+	}
+	if manual["slow"] == nil || manual["slow"].Status != StatusFailed || manual["slow"].Error != "node unavailable" {
+		t.Errorf("slow run:%+v", manual["slow"])
+	}
+	if manual["panic"] == nil || manual["panic"].Error != "panic:nil map" {
+		t.Errorf("panic run:%+v", manual["panic"])
+	}
+	if shutdown := store.byTrigger(TriggerShutdown); len(shutdown) != 1 || len(flushed) != 1 {
+		t.Errorf("flush should run once on shutdown:%v", len(shutdown))
+	}
+}
@@ -0,0 +1,51 @@
+package cron
+
+import (
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/redis"
+)
+
+//RedisLocker 基于redis SET NX 的锁，续期和释放时用lua脚本比较token，避免释放其他实例的锁
+type RedisLocker struct {
+	client *redis.TronRedis
+}
+
+//NewRedisLocker 使用client加锁
+func NewRedisLocker(client *redis.TronRedis) *RedisLocker {
+	return &RedisLocker{client: client}
+}
+
+//refreshScript KEYS[1] 锁的key，ARGV 依次为 token、毫秒过期时间，返回1表示续期成功
+const refreshScript = `
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("pexpire", KEYS[1], ARGV[2])
+end
+return 0`
+
+//unlockScript KEYS[1] 锁的key，ARGV[1] token
+const unlockScript = `
+if redis.call("get", KEYS[1]) == ARGV[1] then
+	return redis.call("del", KEYS[1])
+end
+return 0`
+
+//TryLock 加锁，锁已被持有时返回false
+func (l *RedisLocker) TryLock(key, token string, ttl time.Duration) (bool, error) {
+	return l.client.SetNX(key, token, ttl).Result()
+}
+
+//Refresh 续期，锁已过期或被其他实例持有时返回false
+func (l *RedisLocker) Refresh(key, token string, ttl time.Duration) (bool, error) {
+	ret, err := l.client.Eval(refreshScript, []string{key}, token, int64(ttl/time.Millisecond)).Result()
+	if err != nil {
+		return false, err
+	}
+	refreshed, _ := ret.(int64)
+	return refreshed == 1, nil
+}
+
+//Unlock 释放锁
+func (l *RedisLocker) Unlock(key, token string) error {
+	return l.client.Eval(unlockScript, []string{key}, token).Err()
+}
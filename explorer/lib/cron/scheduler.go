@@ -0,0 +1,457 @@
+package cron
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/metrics"
+)
+
+/*
+	定时任务调度
+	多个web实例同时运行时，每次执行前获取分布式锁，并检查同一执行时间是否已被其他实例执行过，保证每个执行时间只执行一次：
+	1. 本实例正在执行该任务时跳过
+	2. 获取锁失败说明其他实例正在执行，跳过；执行期间按 lockTTL/3 续期
+	3. 历史记录中最后一次执行时间不早于本次执行时间时跳过
+	4. 执行任务，panic作为错误记录，保存执行记录后释放锁
+	启动时如果上次执行时间之后有错过的执行时间(如服务重启期间)，立即补执行一次
+	手动触发不检查执行时间，服务退出时 OnStop 的任务再执行一次
+*/
+
+//触发方式
+const (
+	TriggerSchedule = "schedule" // 按计划执行
+	TriggerCatchUp  = "catchup"  // 启动后补执行错过的计划
+	TriggerManual   = "manual"   // 手动触发
+	TriggerShutdown = "shutdown" // 服务退出时执行
+)
+
+//执行结果
+const (
+	StatusRunning = "running"
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+)
+
+var (
+	ErrUnknownJob = errors.New("unknown job")
+	ErrJobRunning = errors.New("job is running")
+	ErrJobLocked  = errors.New("job is running on another instance")
+	ErrNotStarted = errors.New("scheduler is not running")
+
+	errSkipped = errors.New("job already executed")
+)
+
+var (
+	taskRuns     = metrics.NewCounterVec("explorer_task_runs_total", "Scheduled task runs by job and status.", "job", "status")
+	taskDuration = metrics.NewHistogramVec("explorer_task_duration_seconds", "Latency of scheduled task runs.", []float64{.1, .5, 1, 5, 10, 30, 60, 300, 900}, "job")
+)
+
+var logger = log.Named("cron")
+
+//Job 定时任务
+type Job struct {
+	Name   string
+	Spec   string                          // cron表达式，见 Parse
+	Run    func(ctx context.Context) error // ctx在服务退出时取消
+	OnStop bool                            // 服务退出时再执行一次，如把缓存的数据写入数据库
+	Quiet  bool                            // 频繁执行且通常无事可做的任务，开始和结束日志使用Debug级别
+}
+
+//Run 一次执行记录，时间为毫秒
+type Run struct {
+	ID            int64  `json:"id"`
+	Job           string `json:"job"`
+	Trigger       string `json:"trigger"`
+	ScheduledTime int64  `json:"scheduledTime"`
+	StartTime     int64  `json:"startTime"`
+	EndTime       int64  `json:"endTime"`
+	Duration      int64  `json:"duration"`
+	Status        string `json:"status"`
+	Error         string `json:"error"`
+	Instance      string `json:"instance"`
+}
+
+//Locker 分布式锁，token用于区分持有者，只有持有者可以续期和释放
+type Locker interface {
+	TryLock(key, token string, ttl time.Duration) (bool, error)
+	Refresh(key, token string, ttl time.Duration) (bool, error)
+	Unlock(key, token string) error
+}
+
+//Store 执行记录的存储
+type Store interface {
+	//SaveRun 保存执行记录
+	SaveRun(run *Run) error
+	//LastScheduled 任务最后一次按计划(含补执行)执行的时间，没有时返回0
+	LastScheduled(job string) (int64, error)
+}
+
+//JobStatus 任务状态
+type JobStatus struct {
+	Name    string `json:"name"`
+	Spec    string `json:"spec"`
+	Next    int64  `json:"next"`
+	Running bool   `json:"running"`
+	LastRun *Run   `json:"lastRun"`
+}
+
+type entry struct {
+	job      Job
+	schedule Schedule
+	next     time.Time
+	running  bool
+	lastRun  *Run
+}
+
+//Scheduler 定时任务调度
+type Scheduler struct {
+	mutex    sync.Mutex
+	entries  map[string]*entry
+	names    []string
+	locker   Locker
+	store    Store
+	loc      *time.Location
+	lockTTL  time.Duration
+	instance string
+	ctx      context.Context
+	stopped  bool
+	wg       sync.WaitGroup
+}
+
+//Options 调度配置，Locker为nil时不加锁，Store为nil时不保存执行记录也不补执行
+type Options struct {
+	Locker   Locker
+	Store    Store
+	Location *time.Location // cron表达式使用的时区，默认UTC
+	LockTTL  time.Duration  // 锁的过期时间，实例异常退出后其他实例最多等待这么久，默认10分钟
+	Instance string         // 实例名称，记录在执行记录中，默认为主机名
+}
+
+//New 创建调度
+func New(opts Options) *Scheduler {
+	if opts.Location == nil {
+		opts.Location = time.UTC
+	}
+	if opts.LockTTL <= 0 {
+		opts.LockTTL = 10 * time.Minute
+	}
+	if opts.Instance == "" {
+		hostname, _ := os.Hostname()
+		opts.Instance = fmt.Sprintf("%v-%v", hostname, os.Getpid())
+	}
+	return &Scheduler{
+		entries:  make(map[string]*entry),
+		locker:   opts.Locker,
+		store:    opts.Store,
+		loc:      opts.Location,
+		lockTTL:  opts.LockTTL,
+		instance: opts.Instance,
+	}
+}
+
+//Add 添加任务，需要在Run之前调用
+func (s *Scheduler) Add(job Job) error {
+	schedule, err := Parse(job.Spec, s.loc)
+	if err != nil {
+		return fmt.Errorf("job [%v]:%v", job.Name, err)
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if _, ok := s.entries[job.Name]; ok {
+		return fmt.Errorf("job [%v] already exists", job.Name)
+	}
+	s.entries[job.Name] = &entry{job: job, schedule: schedule}
+	s.names = append(s.names, job.Name)
+	return nil
+}
+
+//Run 按计划执行任务，ctx取消后等待执行中的任务结束，再执行 OnStop 的任务
+func (s *Scheduler) Run(ctx context.Context) {
+	s.mutex.Lock()
+	s.ctx = ctx
+	for _, name := range s.names {
+		e := s.entries[name]
+		s.wg.Add(1)
+		go func() {
+			defer s.wg.Done()
+			s.loop(ctx, e)
+		}()
+	}
+	s.mutex.Unlock()
+
+	<-ctx.Done()
+	s.mutex.Lock()
+	s.stopped = true
+	s.mutex.Unlock()
+	s.wg.Wait()
+
+	for _, name := range s.names {
+		e := s.entries[name]
+		if !e.job.OnStop {
+			continue
+		}
+		if err := s.execute(context.Background(), e, TriggerShutdown, time.Now()); err != nil {
+			logger.Warnf("job [%v] run on shutdown failed:%v", name, err)
+		}
+	}
+}
+
+//loop 先补执行错过的计划，再按计划执行
+func (s *Scheduler) loop(ctx context.Context, e *entry) {
+	if slot := s.missedSlot(e); !slot.IsZero() {
+		logger.Infof("job [%v] catch up missed run at %v", e.job.Name, slot)
+		s.runSlot(ctx, e, TriggerCatchUp, slot)
+	}
+	for {
+		next := e.schedule.Next(time.Now())
+		s.mutex.Lock()
+		e.next = next
+		s.mutex.Unlock()
+		if next.IsZero() {
+			logger.Warnf("job [%v] spec [%v] has no next run time", e.job.Name, e.job.Spec)
+			return
+		}
+		if !lifecycle.Wait(ctx, next.Sub(time.Now())) {
+			return
+		}
+		s.runSlot(ctx, e, TriggerSchedule, next)
+	}
+}
+
+//maxCatchUpSteps 查找错过的执行时间时的最大步数
+const maxCatchUpSteps = 100000
+
+//missedSlot 上次执行之后、当前时间之前最近的一个执行时间，没有错过时返回零值
+func (s *Scheduler) missedSlot(e *entry) time.Time {
+	if s.store == nil {
+		return time.Time{}
+	}
+	last, err := s.store.LastScheduled(e.job.Name)
+	if err != nil {
+		logger.Warnf("job [%v] query last run failed:%v", e.job.Name, err)
+		return time.Time{}
+	}
+	if last == 0 {
+		return time.Time{}
+	}
+	now := time.Now()
+	var slot time.Time
+	for t, i := time.Unix(0, last*1e6), 0; i < maxCatchUpSteps; i++ {
+		t = e.schedule.Next(t)
+		if t.IsZero() || t.After(now) {
+			break
+		}
+		slot = t
+	}
+	return slot
+}
+
+func (s *Scheduler) runSlot(ctx context.Context, e *entry, trigger string, slot time.Time) {
+	switch err := s.execute(ctx, e, trigger, slot); err {
+	case nil:
+	case errSkipped, ErrJobLocked:
+		logger.Debugf("job [%v] at %v skipped:%v", e.job.Name, slot, err)
+	default:
+		logger.Warnf("job [%v] at %v not executed:%v", e.job.Name, slot, err)
+	}
+}
+
+//Trigger 手动触发任务，在后台执行
+func (s *Scheduler) Trigger(name string) error {
+	s.mutex.Lock()
+	e, ok := s.entries[name]
+	ctx, stopped := s.ctx, s.stopped
+	s.mutex.Unlock()
+	if !ok {
+		return ErrUnknownJob
+	}
+	if ctx == nil || stopped {
+		return ErrNotStarted
+	}
+	now := time.Now()
+	release, err := s.acquire(e, TriggerManual, now)
+	if err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	if s.stopped {
+		s.mutex.Unlock()
+		release()
+		return ErrNotStarted
+	}
+	s.wg.Add(1)
+	s.mutex.Unlock()
+	go func() {
+		defer s.wg.Done()
+		s.run(ctx, e, TriggerManual, now, release)
+	}()
+	return nil
+}
+
+//Jobs 全部任务的状态
+func (s *Scheduler) Jobs() []*JobStatus {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	ret := make([]*JobStatus, 0, len(s.names))
+	for _, name := range s.names {
+		e := s.entries[name]
+		status := &JobStatus{Name: name, Spec: e.job.Spec, Running: e.running, LastRun: e.lastRun}
+		if !e.next.IsZero() {
+			status.Next = e.next.UnixNano() / 1e6
+		}
+		ret = append(ret, status)
+	}
+	sort.Slice(ret, func(i, j int) bool { return ret[i].Name < ret[j].Name })
+	return ret
+}
+
+func (s *Scheduler) execute(ctx context.Context, e *entry, trigger string, slot time.Time) error {
+	release, err := s.acquire(e, trigger, slot)
+	if err != nil {
+		return err
+	}
+	s.run(ctx, e, trigger, slot, release)
+	return nil
+}
+
+//acquire 标记为执行中并获取锁，按计划执行时检查该执行时间是否已执行过
+func (s *Scheduler) acquire(e *entry, trigger string, slot time.Time) (func(), error) {
+	s.mutex.Lock()
+	if e.running {
+		s.mutex.Unlock()
+		return nil, ErrJobRunning
+	}
+	e.running = true
+	s.mutex.Unlock()
+	done := func() {
+		s.mutex.Lock()
+		e.running = false
+		s.mutex.Unlock()
+	}
+
+	key := "explorer:task:lock:" + e.job.Name
+	token := fmt.Sprintf("%v:%v", s.instance, time.Now().UnixNano())
+	if s.locker != nil {
+		ok, err := s.locker.TryLock(key, token, s.lockTTL)
+		if err != nil || !ok {
+			done()
+			if err == nil {
+				err = ErrJobLocked
+			}
+			return nil, err
+		}
+	}
+	unlock := func() {
+		if s.locker != nil {
+			if err := s.locker.Unlock(key, token); err != nil {
+				logger.Warnf("job [%v] unlock failed:%v", e.job.Name, err)
+			}
+		}
+		done()
+	}
+
+	if s.store != nil && (trigger == TriggerSchedule || trigger == TriggerCatchUp) {
+		last, err := s.store.LastScheduled(e.job.Name)
+		if err != nil {
+			unlock()
+			return nil, err
+		}
+		if last >= slot.UnixNano()/1e6 {
+			unlock()
+			return nil, errSkipped
+		}
+	}
+
+	stopRefresh := make(chan struct{})
+	go s.refresh(e.job.Name, key, token, stopRefresh)
+	return func() {
+		close(stopRefresh)
+		unlock()
+	}, nil
+}
+
+//refresh 执行期间定期续期锁
+func (s *Scheduler) refresh(name, key, token string, stop chan struct{}) {
+	if s.locker == nil {
+		return
+	}
+	ticker := time.NewTicker(s.lockTTL / 3)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			if ok, err := s.locker.Refresh(key, token, s.lockTTL); err != nil || !ok {
+				logger.Warnf("job [%v] refresh lock failed, ok:%v err:%v", name, ok, err)
+			}
+		}
+	}
+}
+
+//run 执行任务并保存执行记录，结束后调用release
+func (s *Scheduler) run(ctx context.Context, e *entry, trigger string, slot time.Time, release func()) {
+	defer release()
+	start := time.Now()
+	run := &Run{
+		Job:           e.job.Name,
+		Trigger:       trigger,
+		ScheduledTime: slot.UnixNano() / 1e6,
+		StartTime:     start.UnixNano() / 1e6,
+		Status:        StatusRunning,
+		Instance:      s.instance,
+	}
+	s.mutex.Lock()
+	e.lastRun = run
+	s.mutex.Unlock()
+	if e.job.Quiet {
+		logger.Debugf("job [%v] start, trigger:%v", e.job.Name, trigger)
+	} else {
+		logger.Infof("job [%v] start, trigger:%v", e.job.Name, trigger)
+	}
+
+	err := safeRun(ctx, e.job.Run)
+
+	finished := *run
+	finished.EndTime = time.Now().UnixNano() / 1e6
+	finished.Duration = finished.EndTime - finished.StartTime
+	finished.Status = StatusSuccess
+	if err != nil {
+		finished.Status = StatusFailed
+		finished.Error = err.Error()
+		logger.Errorf("job [%v] failed, costTime=%v, err:%v", e.job.Name, time.Since(start), err)
+	} else if e.job.Quiet {
+		logger.Debugf("job [%v] end, costTime=%v", e.job.Name, time.Since(start))
+	} else {
+		logger.Infof("job [%v] end, costTime=%v", e.job.Name, time.Since(start))
+	}
+	taskRuns.With(e.job.Name, finished.Status).Inc()
+	taskDuration.With(e.job.Name).ObserveSince(start)
+
+	if s.store != nil {
+		if err := s.store.SaveRun(&finished); err != nil {
+			logger.Errorf("job [%v] save run failed:%v", e.job.Name, err)
+		}
+	}
+	s.mutex.Lock()
+	e.lastRun = &finished
+	s.mutex.Unlock()
+}
+
+//safeRun 执行任务，panic作为错误返回
+func safeRun(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic:%v", r)
+		}
+	}()
+	return fn(ctx)
+}
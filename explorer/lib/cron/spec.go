@@ -0,0 +1,207 @@
+package cron
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+//cron表达式
+//	分 时 日 月 周          如 "1 0 * * *" 每天0点1分
+//	秒 分 时 日 月 周       如 "30 1 0 * * *" 每天0点1分30秒
+//每段支持 *、数字、a-b、*/n、a-b/n 以及用逗号分隔的列表，周的取值为0-6，0和7都表示周日
+//日和周都不为*时，满足其一即执行，同标准cron
+//另外支持 @every 3m(按间隔执行，执行时间对齐到间隔的整数倍，多个实例的执行时间相同)、@hourly、@daily、@midnight、@weekly、@monthly、@yearly
+//兼容原来按间隔配置的任务，"90s"、"5m" 等时长和整数秒等同于 @every
+
+//Schedule 执行时间
+type Schedule interface {
+	//Next t之后的下一次执行时间
+	Next(t time.Time) time.Time
+}
+
+//Parse 解析cron表达式，时间按loc计算，loc为nil时使用UTC
+func Parse(spec string, loc *time.Location) (Schedule, error) {
+	if loc == nil {
+		loc = time.UTC
+	}
+	spec = strings.TrimSpace(spec)
+	if seconds, err := strconv.ParseInt(spec, 10, 64); err == nil {
+		spec = fmt.Sprintf("@every %vs", seconds)
+	} else if _, err := time.ParseDuration(spec); err == nil {
+		spec = "@every " + spec
+	}
+	if strings.HasPrefix(spec, "@every ") {
+		d, err := time.ParseDuration(strings.TrimSpace(spec[len("@every "):]))
+		if err != nil || d < time.Second {
+			return nil, fmt.Errorf("invalid cron spec [%v], interval should be at least 1s", spec)
+		}
+		return everySchedule(d), nil
+	}
+	if descriptor, ok := descriptors[spec]; ok {
+		spec = descriptor
+	}
+
+	fields := strings.Fields(spec)
+	switch len(fields) {
+	case 5:
+		fields = append([]string{"0"}, fields...)
+	case 6:
+	default:
+		return nil, fmt.Errorf("invalid cron spec [%v], should have 5 or 6 fields", spec)
+	}
+	s := &specSchedule{loc: loc}
+	var err error
+	for i, field := range fields {
+		if s.fields[i], err = parseField(field, fieldRanges[i]); err != nil {
+			return nil, fmt.Errorf("invalid cron spec [%v]:%v", spec, err)
+		}
+	}
+	//7 和 0 都是周日
+	if s.fields[dowField]&(1<<7) != 0 {
+		s.fields[dowField] |= 1
+	}
+	s.domStar = fields[domField] == "*" || strings.HasPrefix(fields[domField], "*/")
+	s.dowStar = fields[dowField] == "*" || strings.HasPrefix(fields[dowField], "*/")
+	return s, nil
+}
+
+var descriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+const (
+	secondField = iota
+	minuteField
+	hourField
+	domField
+	monthField
+	dowField
+)
+
+type fieldRange struct {
+	name     string
+	min, max int
+}
+
+var fieldRanges = [6]fieldRange{
+	{"second", 0, 59},
+	{"minute", 0, 59},
+	{"hour", 0, 23},
+	{"day of month", 1, 31},
+	{"month", 1, 12},
+	{"day of week", 0, 7},
+}
+
+//parseField 解析一段，返回按位表示的取值集合
+func parseField(field string, r fieldRange) (uint64, error) {
+	var bits uint64
+	for _, item := range strings.Split(field, ",") {
+		step := 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			var err error
+			if step, err = strconv.Atoi(item[i+1:]); err != nil || step <= 0 {
+				return 0, fmt.Errorf("invalid %v step [%v]", r.name, item)
+			}
+			item = item[:i]
+		}
+		start, end := r.min, r.max
+		switch {
+		case item == "*":
+		case strings.Contains(item, "-"):
+			parts := strings.SplitN(item, "-", 2)
+			var err1, err2 error
+			start, err1 = strconv.Atoi(parts[0])
+			end, err2 = strconv.Atoi(parts[1])
+			if err1 != nil || err2 != nil {
+				return 0, fmt.Errorf("invalid %v range [%v]", r.name, item)
+			}
+		default:
+			value, err := strconv.Atoi(item)
+			if err != nil {
+				return 0, fmt.Errorf("invalid %v [%v]", r.name, item)
+			}
+			start, end = value, value
+			if step > 1 {
+				end = r.max
+			}
+		}
+		if start < r.min || end > r.max || start > end {
+			return 0, fmt.Errorf("%v [%v] out of range %v-%v", r.name, item, r.min, r.max)
+		}
+		for v := start; v <= end; v += step {
+			bits |= 1 << uint(v)
+		}
+	}
+	return bits, nil
+}
+
+//everySchedule 按固定间隔执行
+type everySchedule time.Duration
+
+func (d everySchedule) Next(t time.Time) time.Time {
+	return t.Truncate(time.Duration(d)).Add(time.Duration(d))
+}
+
+//specSchedule 按cron表达式执行
+type specSchedule struct {
+	fields  [6]uint64
+	domStar bool
+	dowStar bool
+	loc     *time.Location
+}
+
+//maxSearchYears 找不到执行时间(如2月30日)时的最大搜索年数
+const maxSearchYears = 5
+
+func (s *specSchedule) Next(t time.Time) time.Time {
+	origLoc := t.Location()
+	t = t.In(s.loc).Add(time.Second - time.Duration(t.Nanosecond()))
+	limit := t.Year() + maxSearchYears
+
+	for t.Year() <= limit {
+		if !s.match(monthField, int(t.Month())) {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.dayMatch(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.match(hourField, t.Hour()) {
+			t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour()+1, 0, 0, 0, s.loc)
+			continue
+		}
+		if !s.match(minuteField, t.Minute()) {
+			t = t.Truncate(time.Minute).Add(time.Minute)
+			continue
+		}
+		if !s.match(secondField, t.Second()) {
+			t = t.Add(time.Second)
+			continue
+		}
+		return t.In(origLoc)
+	}
+	return time.Time{}
+}
+
+func (s *specSchedule) match(field, value int) bool {
+	return s.fields[field]&(1<<uint(value)) != 0
+}
+
+//dayMatch 日和周都有限制时满足其一即可
+func (s *specSchedule) dayMatch(t time.Time) bool {
+	domMatch := s.match(domField, t.Day())
+	dowMatch := s.match(dowField, int(t.Weekday()))
+	if s.domStar || s.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,206 @@
+package health
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+/*
+	存活和就绪检查
+	/healthz 进程存活即返回200，/readyz 执行全部注册的检查，任一检查失败返回503，用于编排系统摘除节点
+	返回结果为json，每项检查包括状态、耗时、错误信息以及检查相关的数据
+*/
+
+//检查状态
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+//DefaultTimeout 单项检查的默认超时时间
+const DefaultTimeout = 3 * time.Second
+
+//CheckFunc 检查函数，返回检查相关的数据(如块高、节点地址)和错误
+type CheckFunc func() (interface{}, error)
+
+//Result 单项检查结果
+type Result struct {
+	Status    string      `json:"status"`
+	LatencyMs int64       `json:"latency_ms"`
+	Error     string      `json:"error,omitempty"`
+	Detail    interface{} `json:"detail,omitempty"`
+}
+
+//Report 全部检查结果
+type Report struct {
+	Status string             `json:"status"`
+	Checks map[string]*Result `json:"checks,omitempty"`
+}
+
+//Checker 检查项集合
+type Checker struct {
+	mutex   sync.RWMutex
+	checks  map[string]CheckFunc
+	timeout time.Duration
+}
+
+//NewChecker 创建检查项集合，timeout为单项检查的超时时间
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return &Checker{checks: make(map[string]CheckFunc), timeout: timeout}
+}
+
+var std = NewChecker(DefaultTimeout)
+
+//Register 注册就绪检查，同名的检查会被覆盖
+func Register(name string, fn CheckFunc) {
+	std.Register(name, fn)
+}
+
+//SetTimeout 设置单项检查的超时时间
+func SetTimeout(timeout time.Duration) {
+	std.SetTimeout(timeout)
+}
+
+//Check 执行全部注册的检查
+func Check() *Report {
+	return std.Check()
+}
+
+//RegisterHandlers 在mux上注册 /healthz 和 /readyz
+func RegisterHandlers(mux *http.ServeMux) {
+	mux.Handle("/healthz", LivenessHandler())
+	mux.Handle("/readyz", std.Handler())
+}
+
+//LivenessHandler 存活检查，进程能响应请求即为存活
+func LivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, &Report{Status: StatusOK})
+	})
+}
+
+//ReadinessHandler 就绪检查
+func ReadinessHandler() http.Handler {
+	return std.Handler()
+}
+
+//Register 注册就绪检查，同名的检查会被覆盖
+func (c *Checker) Register(name string, fn CheckFunc) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.checks[name] = fn
+}
+
+//SetTimeout 设置单项检查的超时时间
+func (c *Checker) SetTimeout(timeout time.Duration) {
+	if timeout <= 0 {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.timeout = timeout
+}
+
+//Check 并发执行全部检查，超时的检查记为失败
+func (c *Checker) Check() *Report {
+	c.mutex.RLock()
+	names := make([]string, 0, len(c.checks))
+	for name := range c.checks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	checks := make([]CheckFunc, 0, len(names))
+	for _, name := range names {
+		checks = append(checks, c.checks[name])
+	}
+	timeout := c.timeout
+	c.mutex.RUnlock()
+
+	results := make([]*Result, len(checks))
+	var wg sync.WaitGroup
+	for i, fn := range checks {
+		wg.Add(1)
+		go func(i int, fn CheckFunc) {
+			defer wg.Done()
+			results[i] = runCheck(fn, timeout)
+		}(i, fn)
+	}
+	wg.Wait()
+
+	report := &Report{Status: StatusOK, Checks: make(map[string]*Result, len(names))}
+	for i, name := range names {
+		report.Checks[name] = results[i]
+		if results[i].Status != StatusOK {
+			report.Status = StatusFail
+		}
+	}
+	return report
+}
+
+//Handler 就绪检查接口，全部通过返回200，否则返回503
+func (c *Checker) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeReport(w, c.Check())
+	})
+}
+
+//SyncLag 检查同步落后的块数，latest为节点的最新块，synced为已同步的块，落后超过maxLag时返回错误
+func SyncLag(synced, latest, maxLag int64) (interface{}, error) {
+	detail := map[string]int64{"synced": synced, "latest": latest, "lag": latest - synced, "max_lag": maxLag}
+	if latest <= 0 {
+		return detail, fmt.Errorf("latest block of node unknown")
+	}
+	if latest-synced > maxLag {
+		return detail, fmt.Errorf("sync lag [%v] blocks exceeds [%v]", latest-synced, maxLag)
+	}
+	return detail, nil
+}
+
+func runCheck(fn CheckFunc, timeout time.Duration) *Result {
+	type ret struct {
+		detail interface{}
+		err    error
+	}
+	start := time.Now()
+	done := make(chan ret, 1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				done <- ret{err: fmt.Errorf("check panic:%v", r)}
+			}
+		}()
+		detail, err := fn()
+		done <- ret{detail, err}
+	}()
+
+	result := &Result{Status: StatusOK}
+	select {
+	case r := <-done:
+		result.Detail = r.detail
+		if r.err != nil {
+			result.Status = StatusFail
+			result.Error = r.err.Error()
+		}
+	case <-time.After(timeout):
+		result.Status = StatusFail
+		result.Error = fmt.Sprintf("timeout after %v", timeout)
+	}
+	result.LatencyMs = time.Since(start).Nanoseconds() / 1e6
+	return result
+}
+
+func writeReport(w http.ResponseWriter, report *Report) {
+	w.Header().Set("Content-Type", "application/json; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	if report.Status != StatusOK {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}
+	json.NewEncoder(w).Encode(report)
+}
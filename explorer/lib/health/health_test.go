@@ -0,0 +1,61 @@
+package health
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestChecker(t *testing.T) {
+	checker := NewChecker(50 * time.Millisecond)
+	checker.Register("mysql", func() (interface{}, error) { return nil, nil })
+	checker.Register("redis", func() (interface{}, error) { return nil, errors.New("connection refused") })
+	checker.Register("node", func() (interface{}, error) {
+		time.Sleep(time.Second)
+		return nil, nil
+	})
+	checker.Register("sync", func() (interface{}, error) { return SyncLag(90, 100, 20) })
+	checker.Register("panic", func() (interface{}, error) { panic("boom") })
+
+	w := httptest.NewRecorder()
+	checker.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/readyz", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Errorf("code:%v", w.Code)
+	}
+	report := &Report{}
+	if err := json.Unmarshal(w.Body.Bytes(), report); err != nil {
+		t.Fatal(err)
+	}
+	expected := map[string]string{"mysql": StatusOK, "redis": StatusFail, "node": StatusFail, "sync": StatusOK, "panic": StatusFail}
+	for name, status := range expected {
+		if result := report.Checks[name]; result == nil || result.Status != status {
+			t.Errorf("check [%v]:%+v", name, result)
+		}
+	}
+	if report.Checks["redis"].Error != "connection refused" || report.Checks["sync"].Detail == nil {
+		t.Errorf("report:%s", w.Body.String())
+	}
+
+	checker = NewChecker(0)
+	checker.Register("sync", func() (interface{}, error) { return SyncLag(100, 100, 20) })
+	w = httptest.NewRecorder()
+	checker.Handler().ServeHTTP(w, httptest.NewRequest("GET", "/readyz", nil))
+	if w.Code != http.StatusOK {
+		t.Errorf("code:%v body:%s", w.Code, w.Body.String())
+	}
+}
+
+func TestSyncLag(t *testing.T) {
+	if _, err := SyncLag(79, 100, 20); err == nil {
+		t.Errorf("lag 21 should fail")
+	}
+	if _, err := SyncLag(80, 100, 20); err != nil {
+		t.Errorf("lag 20:%v", err)
+	}
+	if _, err := SyncLag(80, 0, 20); err == nil {
+		t.Errorf("unknown latest should fail")
+	}
+}
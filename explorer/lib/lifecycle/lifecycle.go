@@ -0,0 +1,277 @@
+package lifecycle
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+)
+
+/*
+	服务生命周期管理
+	组件通过 Append 注册启动和停止函数，后台任务通过 Go 启动，任务在 Context 取消后应尽快结束并写完缓存的数据
+	Run 按注册顺序启动组件，收到 SIGINT/SIGTERM 或调用 Shutdown、Fail 后依次：
+	1. 取消 Context，通知后台任务退出，同时停止 Server 组件，如http服务停止接收新请求并等待处理中的请求完成
+	2. 等待后台任务和 Server 组件结束
+	3. 按注册的逆序停止其他组件，如关闭数据库连接
+	以上步骤共用 timeout，超时后不再等待；退出过程中再次收到信号时立即退出
+*/
+
+//退出码
+const (
+	ExitOK      = 0 // 正常退出
+	ExitFailure = 1 // 启动失败或运行中出错
+	ExitTimeout = 2 // 退出超时，可能有未写完的数据
+)
+
+//Hook 组件的启动和停止函数，都可以为nil
+//	Start 应尽快返回，长时间运行的逻辑使用 Go 启动；Stop 在ctx超时后应放弃等待
+//	Server 为接收外部请求的组件，退出时与后台任务同时停止，不等待后台任务结束
+type Hook struct {
+	Name   string
+	Start  func(ctx context.Context) error
+	Stop   func(ctx context.Context) error
+	Server bool
+}
+
+//Manager 生命周期管理
+type Manager struct {
+	mutex    sync.Mutex
+	hooks    []Hook
+	ctx      context.Context
+	cancel   context.CancelFunc
+	wg       sync.WaitGroup
+	running  map[string]int // 运行中的后台任务
+	errs     []error
+	shutdown chan struct{}
+	once     sync.Once
+}
+
+var logger = log.Named("lifecycle")
+
+//New 创建生命周期管理
+func New() *Manager {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Manager{
+		ctx:      ctx,
+		cancel:   cancel,
+		running:  make(map[string]int),
+		shutdown: make(chan struct{}),
+	}
+}
+
+var std = New()
+
+//Append 注册组件
+func Append(hook Hook) {
+	std.Append(hook)
+}
+
+//Context 服务退出时取消的context
+func Context() context.Context {
+	return std.Context()
+}
+
+//Go 启动后台任务，任务应在ctx取消后返回
+func Go(name string, fn func(ctx context.Context)) {
+	std.Go(name, fn)
+}
+
+//Shutdown 主动退出，如同步程序完成了指定的任务
+func Shutdown() {
+	std.Shutdown()
+}
+
+//Fail 组件运行出错，记录错误并退出，退出码为 ExitFailure
+func Fail(name string, err error) {
+	std.Fail(name, err)
+}
+
+//Run 启动全部组件并等待退出，返回退出码
+func Run(timeout time.Duration) int {
+	return std.Run(timeout)
+}
+
+//Append 注册组件，Run之后注册的组件不会启动
+func (m *Manager) Append(hook Hook) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.hooks = append(m.hooks, hook)
+}
+
+//Context 服务退出时取消的context
+func (m *Manager) Context() context.Context {
+	return m.ctx
+}
+
+//Go 启动后台任务，任务panic时记录错误并退出
+func (m *Manager) Go(name string, fn func(ctx context.Context)) {
+	m.mutex.Lock()
+	m.running[name]++
+	m.mutex.Unlock()
+	m.wg.Add(1)
+	go func() {
+		defer func() {
+			if r := recover(); r != nil {
+				m.Fail(name, fmt.Errorf("panic:%v", r))
+			}
+			m.mutex.Lock()
+			if m.running[name]--; m.running[name] == 0 {
+				delete(m.running, name)
+			}
+			m.mutex.Unlock()
+			m.wg.Done()
+		}()
+		fn(m.ctx)
+	}()
+}
+
+//Shutdown 主动退出
+func (m *Manager) Shutdown() {
+	m.once.Do(func() { close(m.shutdown) })
+}
+
+//Fail 记录错误并退出
+func (m *Manager) Fail(name string, err error) {
+	logger.With("component", name).Errorf("%v failed:%v", name, err)
+	m.mutex.Lock()
+	m.errs = append(m.errs, fmt.Errorf("%v:%v", name, err))
+	m.mutex.Unlock()
+	m.Shutdown()
+}
+
+//Run 启动全部组件并等待退出，返回退出码
+func (m *Manager) Run(timeout time.Duration) int {
+	sigs := make(chan os.Signal, 2)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	m.mutex.Lock()
+	hooks := append([]Hook(nil), m.hooks...)
+	m.mutex.Unlock()
+
+	started := 0
+	for _, hook := range hooks {
+		if hook.Start != nil {
+			if err := hook.Start(m.ctx); err != nil {
+				m.Fail(hook.Name, err)
+				break
+			}
+		}
+		started++
+	}
+
+	select {
+	case sig := <-sigs:
+		logger.Infof("received signal [%v], shutting down", sig)
+	case <-m.shutdown:
+		logger.Info("shutting down")
+	}
+	go func() {
+		sig := <-sigs
+		logger.Errorf("received signal [%v] again, exit now", sig)
+		os.Exit(ExitTimeout)
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	code := m.stop(ctx, hooks[:started])
+	if code == ExitOK {
+		logger.Info("shutdown completed")
+	}
+	return code
+}
+
+func (m *Manager) stop(ctx context.Context, hooks []Hook) int {
+	m.cancel()
+
+	//Server 组件不依赖后台任务，与等待后台任务并行停止，各自都有完整的 timeout
+	servers := make(chan bool, len(hooks))
+	serverCount := 0
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if hooks[i].Server {
+			serverCount++
+			go func(hook Hook) { servers <- stopHook(ctx, hook) }(hooks[i])
+		}
+	}
+
+	done := make(chan struct{})
+	go func() {
+		m.wg.Wait()
+		close(done)
+	}()
+	timeout := false
+	select {
+	case <-done:
+	case <-ctx.Done():
+		timeout = true
+		logger.Errorf("wait background tasks timeout, running:%v", m.runningTasks())
+	}
+	for i := 0; i < serverCount; i++ {
+		if <-servers {
+			timeout = true
+		}
+	}
+
+	for i := len(hooks) - 1; i >= 0; i-- {
+		if !hooks[i].Server && stopHook(ctx, hooks[i]) {
+			timeout = true
+		}
+	}
+
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	switch {
+	case len(m.errs) > 0:
+		return ExitFailure
+	case timeout || ctx.Err() != nil:
+		return ExitTimeout
+	}
+	return ExitOK
+}
+
+//stopHook 停止组件，返回是否超时
+func stopHook(ctx context.Context, hook Hook) bool {
+	if hook.Stop == nil {
+		return false
+	}
+	err := hook.Stop(ctx)
+	if err != nil {
+		logger.With("component", hook.Name).Errorf("stop %v failed:%v", hook.Name, err)
+	}
+	return err == context.DeadlineExceeded
+}
+
+func (m *Manager) runningTasks() []string {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	names := make([]string, 0, len(m.running))
+	for name := range m.running {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//Wait 等待d，ctx取消时返回false，用于后台任务的循环
+func Wait(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	}
+}
+
+//Stopping 服务是否正在退出
+func Stopping() bool {
+	return std.ctx.Err() != nil
+}
@@ -0,0 +1,94 @@
+package lifecycle
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	m := New()
+	var mutex sync.Mutex
+	events := make([]string, 0)
+	record := func(event string) {
+		mutex.Lock()
+		events = append(events, event)
+		mutex.Unlock()
+	}
+	httpStopped := make(chan struct{})
+	hook := func(name string) Hook {
+		return Hook{
+			Name: name,
+			Start: func(ctx context.Context) error {
+				record("start " + name)
+				return nil
+			},
+			Stop: func(ctx context.Context) error {
+				record("stop " + name)
+				if name == "http" {
+					close(httpStopped)
+				}
+				return nil
+			},
+		}
+	}
+	m.Append(hook("db"))
+	http := hook("http")
+	http.Server = true
+	m.Append(http)
+	//http服务不等待后台任务结束，任务在http停止后才结束
+	m.Go("task", func(ctx context.Context) {
+		for Wait(ctx, time.Millisecond) {
+		}
+		<-httpStopped
+		record("flush task")
+	})
+	go m.Shutdown()
+
+	if code := m.Run(time.Second); code != ExitOK {
+		t.Errorf("exit code:%v", code)
+	}
+	if strings.Join(events, ",") != "start db,start http,stop http,flush task,stop db" {
+		t.Errorf("events:%v", events)
+	}
+}
+
+func TestRunFailure(t *testing.T) {
+	m := New()
+	stopped := false
+	m.Append(Hook{Name: "db", Stop: func(ctx context.Context) error {
+		stopped = true
+		return nil
+	}})
+	m.Append(Hook{Name: "http", Start: func(ctx context.Context) error { return errors.New("address in use") }})
+	m.Append(Hook{Name: "never", Stop: func(ctx context.Context) error {
+		t.Errorf("hook not started should not be stopped")
+		return nil
+	}})
+	if code := m.Run(time.Second); code != ExitFailure || !stopped {
+		t.Errorf("exit code:%v stopped:%v", code, stopped)
+	}
+
+	m = New()
+	m.Go("task", func(ctx context.Context) { panic("boom") })
+	if code := m.Run(time.Second); code != ExitFailure {
+		t.Errorf("panic exit code:%v", code)
+	}
+}
+
+func TestRunTimeout(t *testing.T) {
+	m := New()
+	block := make(chan struct{})
+	defer close(block)
+	m.Go("stuck", func(ctx context.Context) { <-block })
+	go m.Shutdown()
+	if code := m.Run(10 * time.Millisecond); code != ExitTimeout {
+		t.Errorf("exit code:%v", code)
+	}
+	if running := m.runningTasks(); len(running) != 1 || running[0] != "stuck" {
+		t.Errorf("running:%v", running)
+	}
+}
@@ -0,0 +1,150 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+)
+
+//Encoder 把日志编码后写入buf，每条日志一行
+type Encoder interface {
+	Encode(buf *bytes.Buffer, record *Record)
+}
+
+//ConsoleEncoder 文本格式，与原来的日志格式一致，字段以 key=value 追加在行尾
+//	2018/10/10 10:00:00.000000 /path/file.go:12: [INFO] message key=value
+type ConsoleEncoder struct{}
+
+//Encode ...
+func (e *ConsoleEncoder) Encode(buf *bytes.Buffer, record *Record) {
+	buf.WriteString(record.Time.Format("2006/01/02 15:04:05.000000"))
+	buf.WriteByte(' ')
+	buf.WriteString(record.Caller)
+	buf.WriteString(":  [")
+	buf.WriteString(record.Level.String())
+	buf.WriteString("] ")
+	buf.WriteString(record.Message)
+	for _, field := range record.Fields {
+		buf.WriteByte(' ')
+		buf.WriteString(field.Key)
+		buf.WriteByte('=')
+		value := fieldString(field.Value)
+		if value == "" || bytes.ContainsAny([]byte(value), " =\"\n\t") {
+			value = strconv.Quote(value)
+		}
+		buf.WriteString(value)
+	}
+	buf.WriteByte('\n')
+}
+
+//JSONEncoder json格式，一行一个对象，便于日志中心按字段查询
+//	{"time":"2018-10-10T10:00:00.000000+08:00","level":"info","logger":"fullnode","caller":"/path/file.go:12","msg":"message","key":"value"}
+type JSONEncoder struct{}
+
+//json中的保留字段，日志字段与之重名时加 fields. 前缀
+var jsonReservedKeys = map[string]bool{"time": true, "level": true, "logger": true, "caller": true, "msg": true}
+
+//Encode ...
+func (e *JSONEncoder) Encode(buf *bytes.Buffer, record *Record) {
+	buf.WriteString(`{"time":`)
+	writeJSONValue(buf, record.Time.Format("2006-01-02T15:04:05.000000Z07:00"))
+	buf.WriteString(`,"level":`)
+	writeJSONValue(buf, record.Level.Name())
+	if record.Logger != "" {
+		buf.WriteString(`,"logger":`)
+		writeJSONValue(buf, record.Logger)
+	}
+	buf.WriteString(`,"caller":`)
+	writeJSONValue(buf, record.Caller)
+	buf.WriteString(`,"msg":`)
+	writeJSONValue(buf, record.Message)
+	for _, field := range record.Fields {
+		key := field.Key
+		if jsonReservedKeys[key] {
+			key = "fields." + key
+		}
+		buf.WriteByte(',')
+		writeJSONValue(buf, key)
+		buf.WriteByte(':')
+		switch value := field.Value.(type) {
+		case error:
+			writeJSONValue(buf, value.Error())
+		case fmt.Stringer:
+			writeJSONValue(buf, value.String())
+		default:
+			writeJSONValue(buf, value)
+		}
+	}
+	buf.WriteString("}\n")
+}
+
+func writeJSONValue(buf *bytes.Buffer, value interface{}) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		data, _ = json.Marshal(fmt.Sprintf("%+v", value))
+	}
+	buf.Write(data)
+}
+
+func fieldString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case error:
+		return v.Error()
+	case time.Duration:
+		return v.String()
+	}
+	return fmt.Sprint(value)
+}
+
+//当前的输出和编码方式
+var (
+	outputMutex sync.Mutex
+	output      io.Writer = os.Stderr
+	encoder     Encoder   = &ConsoleEncoder{}
+	bufPool               = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+)
+
+//SetOutput 修改日志输出，StartLogRotator 切换文件时会调用
+func SetOutput(w io.Writer) {
+	outputMutex.Lock()
+	output = w
+	outputMutex.Unlock()
+}
+
+//SetEncoder 修改日志编码方式
+func SetEncoder(e Encoder) {
+	outputMutex.Lock()
+	encoder = e
+	outputMutex.Unlock()
+}
+
+//NewEncoder 按名称创建编码方式，console 或 json
+func NewEncoder(format string) (Encoder, error) {
+	switch format {
+	case "", "console", "text":
+		return &ConsoleEncoder{}, nil
+	case "json":
+		return &JSONEncoder{}, nil
+	}
+	return nil, fmt.Errorf("unknown log format [%v]", format)
+}
+
+func writeRecord(record *Record) {
+	buf := bufPool.Get().(*bytes.Buffer)
+	buf.Reset()
+	outputMutex.Lock()
+	encoder.Encode(buf, record)
+	_, err := output.Write(buf.Bytes())
+	outputMutex.Unlock()
+	bufPool.Put(buf)
+	if err != nil {
+		fmt.Println("*** log message error. ***", err)
+	}
+}
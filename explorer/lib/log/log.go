@@ -5,7 +5,10 @@ package log
 import (
 	"fmt"
 	golog "log"
+	"os"
 	"strings"
+	"sync/atomic"
+	"time"
 )
 
 //Level 日志的级别
@@ -37,51 +40,71 @@ func isLogLevelValid(level Level) bool {
 	return false
 }
 
+//CurrentLevel 当前的日志等级
+func CurrentLevel() Level {
+	return Level(atomic.LoadInt32(&currentlogLevel))
+}
+
+//日志级别的名称
+var levelNames = map[Level]string{
+	ALL:   "ALL",
+	MORE:  "MORE",
+	SQL:   "SQL",
+	DEBUG: "DEBUG",
+	INFO:  "INFO",
+	WARN:  "WARN",
+	ERROR: "ERROR",
+	FATAL: "FATAL",
+	OFF:   "OFF",
+}
+
+//String 大写的级别名称，用于文本日志
+func (level Level) String() string {
+	if name, ok := levelNames[level]; ok {
+		return name
+	}
+	return fmt.Sprintf("LEVEL(%d)", int(level))
+}
+
+//Name 小写的级别名称，用于json日志
+func (level Level) Name() string {
+	return strings.ToLower(level.String())
+}
+
 //Str2Level 字符转LogLevel
 func Str2Level(level string) (ret Level) {
-	ret = INFO
-
-	level = strings.ToLower(level)
-	switch level {
-	case "all":
-		ret = ALL
-	case "more":
-		ret = MORE
-	case "sql":
-		ret = SQL
-	case "debug":
-		ret = DEBUG
-	case "info":
-		ret = INFO
-	case "warn":
-		ret = WARN
-	case "error":
-		ret = ERROR
-	case "fatal":
-		ret = FATAL
-	case "off":
-		ret = OFF
-	default:
+	ret, ok := ParseLevel(level)
+	if !ok {
 		ret = INFO
 	}
 	return ret
 }
 
+//ParseLevel 字符转LogLevel，不是有效的级别时返回false
+func ParseLevel(level string) (Level, bool) {
+	level = strings.ToUpper(level)
+	for ret, name := range levelNames {
+		if name == level {
+			return ret, true
+		}
+	}
+	return INFO, false
+}
+
 //isLogShouldRecord 判断日志是否应该被记录
 func isLogShouldRecord(level Level) bool {
 	//fmt.Printf("currentlogLevel:[%v] level:[%v]\n", currentlogLevel, level)
-	return level >= currentlogLevel
+	return level >= CurrentLevel()
 }
 
-//当前的日志等级
-var currentlogLevel = Level(defaultLogLevel)
+//当前的日志等级，运行中可以通过管理接口修改，使用atomic读写
+var currentlogLevel = int32(defaultLogLevel)
 
 //ChangeLogLevel 更改当前的日志等级
 func ChangeLogLevel(level Level) bool {
 	if isLogLevelValid(level) {
-		var oldLevel = currentlogLevel
-		currentlogLevel = level
-		Infof("change log level from [%v] to [%v] ", oldLevel, currentlogLevel)
+		var oldLevel = Level(atomic.SwapInt32(&currentlogLevel, int32(level)))
+		Infof("change log level from [%v] to [%v] ", oldLevel, level)
 
 		return true
 	}
@@ -94,119 +117,125 @@ func Output(stackLevel int, msg string) {
 	golog.Output(stackLevel+1, msg)
 }
 
+//Print 不受日志级别限制的输出
 func Print(args ...interface{}) {
-	golog.Print(args...)
+	writePrint(fmt.Sprint(args...))
 }
 
 func Printf(format string, args ...interface{}) {
-	golog.Println(args...)
+	writePrint(fmt.Sprintf(format, args...))
 }
 
 func Println(args ...interface{}) {
-	golog.Println(args...)
+	writePrint(fmt.Sprintln(args...))
 }
 
 func Fatal(args ...interface{}) {
-	golog.Fatal(args...)
+	std.output(1, FATAL, fmt.Sprint(args...))
+	os.Exit(1)
 }
 
 func Fatalf(format string, args ...interface{}) {
-	golog.Fatalf(format, args...)
+	std.output(1, FATAL, fmt.Sprintf(format, args...))
+	os.Exit(1)
 }
 
 func Fatalln(args ...interface{}) {
-	golog.Fatalln(args...)
+	std.output(1, FATAL, fmt.Sprintln(args...))
+	os.Exit(1)
 }
 
 func Panic(args ...interface{}) {
-	golog.Panic(args...)
+	msg := fmt.Sprint(args...)
+	std.output(1, FATAL, msg)
+	panic(msg)
 }
 func Panicf(format string, args ...interface{}) {
-	golog.Panicf(format, args...)
+	msg := fmt.Sprintf(format, args...)
+	std.output(1, FATAL, msg)
+	panic(msg)
 }
 
 func Panicln(args ...interface{}) {
-	golog.Panicln(args...)
+	msg := fmt.Sprintln(args...)
+	std.output(1, FATAL, msg)
+	panic(msg)
 }
 
 func More(args ...interface{}) {
-	outputLogMessage(MORE, " [MORE] "+fmt.Sprint(args...))
+	std.output(1, MORE, fmt.Sprint(args...))
 }
 
 func Moref(format string, args ...interface{}) {
-	outputLogMessage(MORE, " [MORE] "+fmt.Sprintf(format, args...))
+	std.output(1, MORE, fmt.Sprintf(format, args...))
 }
 
 func Moreln(args ...interface{}) {
-	outputLogMessage(MORE, " [MORE] "+fmt.Sprintln(args...))
+	std.output(1, MORE, fmt.Sprintln(args...))
 }
 
 func Sql(args ...interface{}) {
-	outputLogMessage(SQL, " [SQL] "+fmt.Sprint(args...))
+	std.output(1, SQL, fmt.Sprint(args...))
 }
 
 func Sqlf(format string, args ...interface{}) {
-	outputLogMessage(SQL, " [SQL] "+fmt.Sprintf(format, args...))
+	std.output(1, SQL, fmt.Sprintf(format, args...))
 }
 
 func Sqlln(args ...interface{}) {
-	outputLogMessage(SQL, " [SQL] "+fmt.Sprintln(args...))
+	std.output(1, SQL, fmt.Sprintln(args...))
 }
 
 func Debug(args ...interface{}) {
-	outputLogMessage(DEBUG, " [DEBUG] "+fmt.Sprint(args...))
+	std.output(1, DEBUG, fmt.Sprint(args...))
 }
 
 func Debugf(format string, args ...interface{}) {
-	outputLogMessage(DEBUG, " [DEBUG] "+fmt.Sprintf(format, args...))
+	std.output(1, DEBUG, fmt.Sprintf(format, args...))
 }
 
 func Debugln(args ...interface{}) {
-	outputLogMessage(DEBUG, " [DEBUG] "+fmt.Sprintln(args...))
+	std.output(1, DEBUG, fmt.Sprintln(args...))
 }
 
 func Info(args ...interface{}) {
-	outputLogMessage(INFO, " [INFO] "+fmt.Sprint(args...))
+	std.output(1, INFO, fmt.Sprint(args...))
 }
 
 func Infof(format string, args ...interface{}) {
-	outputLogMessage(INFO, " [INFO] "+fmt.Sprintf(format, args...))
+	std.output(1, INFO, fmt.Sprintf(format, args...))
 }
 
 func Infoln(args ...interface{}) {
-	outputLogMessage(INFO, " [INFO] "+fmt.Sprintln(args...))
+	std.output(1, INFO, fmt.Sprintln(args...))
 }
 
 func Warn(args ...interface{}) {
-	outputLogMessage(WARN, " [WARN] "+fmt.Sprint(args...))
+	std.output(1, WARN, fmt.Sprint(args...))
 }
 
 func Warnf(format string, args ...interface{}) {
-	outputLogMessage(WARN, " [WARN] "+fmt.Sprintf(format, args...))
+	std.output(1, WARN, fmt.Sprintf(format, args...))
 }
 
 func Warnln(args ...interface{}) {
-	outputLogMessage(WARN, " [WARN] "+fmt.Sprintln(args...))
+	std.output(1, WARN, fmt.Sprintln(args...))
 }
 
 func Error(args ...interface{}) {
-	outputLogMessage(ERROR, " [ERROR] "+fmt.Sprint(args...))
+	std.output(1, ERROR, fmt.Sprint(args...))
 }
 
 func Errorf(format string, args ...interface{}) {
-	outputLogMessage(ERROR, " [ERROR] "+fmt.Sprintf(format, args...))
+	std.output(1, ERROR, fmt.Sprintf(format, args...))
 }
 
 func Errorln(args ...interface{}) {
-	outputLogMessage(ERROR, " [ERROR] "+fmt.Sprintln(args...))
+	std.output(1, ERROR, fmt.Sprintln(args...))
 }
 
-//outputLogMessage 真实的日志输出
-func outputLogMessage(level Level, message string) {
-	if isLogShouldRecord(level) {
-		err := golog.Output(3, message)
-		if err != nil {
-			fmt.Println("*** log message error. ***", err)
-		}
-	}
+//writePrint Print系列的输出，不按级别过滤，以INFO级别编码
+func writePrint(msg string) {
+	pkg, caller := callerInfo(2)
+	writeRecord(&Record{Time: time.Now(), Level: INFO, Logger: pkg, Caller: caller, Message: strings.TrimSuffix(msg, "\n")})
 }
@@ -6,139 +6,350 @@ package log
 
 /*
 	日志文件拆分写
+	RotateWriter 写入时按大小和时间判断是否滚动，不需要定时检查
+	滚动后的文件可以gzip压缩，并按保留时长和个数清理
 */
 
 import (
+	"compress/gzip"
 	"fmt"
-	"log"
+	"io"
+	"io/ioutil"
+	golog "log"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
 	"time"
 )
 
-// StartLogRotator 启动记录日志文件
-func StartLogRotator(baseFileName string, maxSize int64, logger *log.Logger, rotatorMinute []int, timeRotate bool) {
-	defer func() {
-		if panicErr := recover(); panicErr != nil {
-			fmt.Printf("StartLogRotator panic:[%v]\n", panicErr)
+//滚动文件名中的时间格式
+const rotateTimeFormat = "20060102T150405.000"
+
+//RotateOptions 日志文件滚动参数
+type RotateOptions struct {
+	Filename      string        // 当前写入的文件，滚动后改名为 name-20060102T150405.000.ext
+	MaxSize       int64         // 单个文件最大字节数，0 不按大小滚动
+	Interval      time.Duration // 按周期滚动，如 time.Hour，0 不按周期滚动
+	RotateMinutes []int         // 每小时的这些分钟滚动，如 0,30
+	MaxAge        time.Duration // 滚动文件的保留时长，0 不限
+	MaxBackups    int           // 滚动文件的保留个数，0 不限
+	Compress      bool          // 滚动后gzip压缩
+}
+
+//RotateWriter 按大小和时间滚动的日志文件，可以在多个goroutine中共用
+type RotateWriter struct {
+	opts RotateOptions
+
+	mutex      sync.Mutex
+	file       *os.File
+	size       int64
+	nextRotate time.Time
+
+	millCh    chan struct{}
+	millOnce  sync.Once
+	millMutex sync.Mutex
+}
+
+//NewRotateWriter 打开日志文件，文件已存在时追加
+func NewRotateWriter(opts *RotateOptions) (*RotateWriter, error) {
+	if opts == nil || opts.Filename == "" {
+		return nil, fmt.Errorf("log file name is empty")
+	}
+	for _, minute := range opts.RotateMinutes {
+		if minute < 0 || minute > 59 {
+			return nil, fmt.Errorf("invalid rotate minute [%v]", minute)
 		}
-	}()
+	}
+	w := &RotateWriter{opts: *opts}
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if err := w.openFile(time.Now()); err != nil {
+		return nil, err
+	}
+	return w, nil
+}
 
-	LogBaseName = baseFileName
-	LogMaxSize = maxSize
-	Logger = logger
-	if nil != rotatorMinute && len(rotatorMinute) > 0 {
-		LogRotatorMinute = rotatorMinute
-	} else {
-		NeedCheckTime = timeRotate
+//Write 写入日志，写入前超过大小或到达滚动时间时先滚动
+func (w *RotateWriter) Write(p []byte) (int, error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	now := time.Now()
+	if w.file == nil {
+		if err := w.openFile(now); err != nil {
+			return 0, err
+		}
 	}
+	sizeExceeded := w.opts.MaxSize > 0 && w.size > 0 && w.size+int64(len(p)) > w.opts.MaxSize
+	timeReached := !w.nextRotate.IsZero() && !now.Before(w.nextRotate)
+	if sizeExceeded || timeReached {
+		if err := w.rotate(now); err != nil {
+			return 0, err
+		}
+	}
+	n, err := w.file.Write(p)
+	w.size += int64(n)
+	return n, err
+}
+
+//Rotate 立即滚动
+func (w *RotateWriter) Rotate() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.rotate(time.Now())
+}
 
-	initLog()
+//Reopen 关闭后重新打开日志文件，用于外部logrotate移走文件之后
+func (w *RotateWriter) Reopen() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	w.closeFile()
+	return w.openFile(time.Now())
+}
 
+//ReopenOnSignal 收到信号时重新打开日志文件，默认为SIGHUP
+func (w *RotateWriter) ReopenOnSignal(sigs ...os.Signal) {
+	if len(sigs) == 0 {
+		sigs = []os.Signal{syscall.SIGHUP}
+	}
+	ch := make(chan os.Signal, 1)
+	signal.Notify(ch, sigs...)
 	go func() {
-		for {
-			fileChecker()
-			time.Sleep(time.Second * time.Duration(LogCheckInterval))
+		for range ch {
+			if err := w.Reopen(); err != nil {
+				fmt.Fprintf(os.Stderr, "reopen log file [%v] failed:[%v]\n", w.opts.Filename, err)
+			}
 		}
 	}()
 }
 
-func initLog() error {
-	outputFile, err := openFile(LogBaseName)
-	if nil == outputFile {
+//Close 关闭日志文件
+func (w *RotateWriter) Close() error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	return w.closeFile()
+}
+
+func (w *RotateWriter) openFile(now time.Time) error {
+	if dir := filepath.Dir(w.opts.Filename); dir != "" {
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return err
+		}
+	}
+	file, err := os.OpenFile(w.opts.Filename, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0660)
+	if err != nil {
 		return err
 	}
-	setLoggerOutput(outputFile)
-	LoggerFileObj = outputFile
+	stat, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+	w.file = file
+	w.size = stat.Size()
+	w.nextRotate = nextRotateTime(now, w.opts.Interval, w.opts.RotateMinutes)
 	return nil
 }
 
-func setLoggerOutput(outputFile *os.File) {
-	if nil == Logger {
-		log.SetOutput(outputFile)
-	} else {
-		Logger.SetOutput(outputFile)
+func (w *RotateWriter) closeFile() error {
+	if w.file == nil {
+		return nil
 	}
+	err := w.file.Close()
+	w.file = nil
+	return err
 }
 
-// Logger ...
-var Logger *log.Logger
-
-// LoggerFileObj ...
-var LoggerFileObj *os.File
-
-// LogBaseName ...
-var LogBaseName string // 文件名
-
-// LogMaxSize ...
-var LogMaxSize int64 = 512 * 1024 * 1024 // 文件大小, 默认 500MB
+//rotate 当前文件改名后重新打开，压缩和清理在后台进行
+func (w *RotateWriter) rotate(now time.Time) error {
+	if err := w.closeFile(); err != nil {
+		return err
+	}
+	if _, err := os.Stat(w.opts.Filename); err == nil {
+		if err := os.Rename(w.opts.Filename, backupName(w.opts.Filename, now)); err != nil {
+			return err
+		}
+	}
+	if err := w.openFile(now); err != nil {
+		return err
+	}
+	w.startMill()
+	return nil
+}
 
-// LogCheckInterval ...
-var LogCheckInterval int64 = 5 // 检查周期 默认 1s
+//startMill 通知后台goroutine压缩和清理滚动文件，同时只有一个在运行
+func (w *RotateWriter) startMill() {
+	w.millOnce.Do(func() {
+		w.millCh = make(chan struct{}, 1)
+		go func() {
+			for range w.millCh {
+				if err := w.mill(); err != nil {
+					fmt.Fprintf(os.Stderr, "clean log file [%v] failed:[%v]\n", w.opts.Filename, err)
+				}
+			}
+		}()
+	})
+	select {
+	case w.millCh <- struct{}{}:
+	default:
+	}
+}
 
-// LogRotatorMinute ...
-var LogRotatorMinute = []int{0, 30} // 滚动分钟, 默认整点,半点
+func (w *RotateWriter) mill() error {
+	w.millMutex.Lock()
+	defer w.millMutex.Unlock()
+	backups, err := listBackups(w.opts.Filename)
+	if err != nil {
+		return err
+	}
+	remove, compress := selectBackups(backups, time.Now(), w.opts.MaxAge, w.opts.MaxBackups, w.opts.Compress)
+	for _, backup := range remove {
+		if err := os.Remove(backup.path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	for _, backup := range compress {
+		if err := gzipFile(backup.path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-// NeedCheckTime ...
-var NeedCheckTime = true // 是否需要检测切换时间
+//logBackup 滚动后的文件
+type logBackup struct {
+	path       string
+	rotateTime time.Time
+}
 
-func openFile(baseFileName string) (*os.File, error) {
-	monitorLogFile := fmt.Sprintf("%v_%v", baseFileName, time.Now().Format("20060102150405.000000"))
+//backupName 滚动后的文件名 dir/name-20060102T150405.000.ext
+func backupName(filename string, t time.Time) string {
+	ext := filepath.Ext(filename)
+	prefix := filename[:len(filename)-len(ext)]
+	return fmt.Sprintf("%v-%v%v", prefix, t.Format(rotateTimeFormat), ext)
+}
 
-	outputFile, err := os.OpenFile(monitorLogFile, os.O_CREATE|os.O_RDWR|os.O_APPEND, 0660)
-	if nil != err {
-		fmt.Printf("open log file [%v] failed:[%v]\n", monitorLogFile, err)
+//listBackups 列出滚动文件，包括已压缩的
+func listBackups(filename string) ([]*logBackup, error) {
+	dir := filepath.Dir(filename)
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
 		return nil, err
 	}
-	return outputFile, nil
+	base := filepath.Base(filename)
+	ext := filepath.Ext(base)
+	prefix := base[:len(base)-len(ext)] + "-"
+	backups := make([]*logBackup, 0)
+	for _, file := range files {
+		if file.IsDir() {
+			continue
+		}
+		name := strings.TrimSuffix(file.Name(), ".gz")
+		if !strings.HasPrefix(name, prefix) || !strings.HasSuffix(name, ext) {
+			continue
+		}
+		rotateTime, err := time.ParseInLocation(rotateTimeFormat, name[len(prefix):len(name)-len(ext)], time.Local)
+		if err != nil {
+			continue
+		}
+		backups = append(backups, &logBackup{path: filepath.Join(dir, file.Name()), rotateTime: rotateTime})
+	}
+	return backups, nil
 }
 
-func fileChecker() {
-	defer func() {
-		if panicErr := recover(); panicErr != nil {
-			fmt.Printf("fileChecker() panic:[%v]\n", panicErr)
+//selectBackups 选出需要删除和压缩的滚动文件，超过保留时长或个数的删除，最新的优先保留
+func selectBackups(backups []*logBackup, now time.Time, maxAge time.Duration, maxBackups int, needCompress bool) (remove, compress []*logBackup) {
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].rotateTime.After(backups[j].rotateTime)
+	})
+	for index, backup := range backups {
+		if (maxBackups > 0 && index >= maxBackups) || (maxAge > 0 && now.Sub(backup.rotateTime) > maxAge) {
+			remove = append(remove, backup)
+			continue
 		}
-	}()
-
-	stat, err := LoggerFileObj.Stat()
-	fileSize := LogMaxSize // default fileSize > LogMaxSize, if stat failed, roll file
-	if nil != err {
-		fmt.Printf("get file stat failed:%v\n", err)
-	} else {
-		fileSize = stat.Size()
-	}
-	// fileName := stat.Name()
-	// fmt.Printf("fileName:[%v], size:[%v]\n", fileName, fileSize)
-
-	if checkSize(fileSize) || checkTime(time.Now()) {
-		outputFile, err := openFile(LogBaseName)
-		if err == nil {
-			setLoggerOutput(outputFile)
-			LoggerFileObj.Close()
-			LoggerFileObj = outputFile
+		if needCompress && !strings.HasSuffix(backup.path, ".gz") {
+			compress = append(compress, backup)
 		}
 	}
+	return remove, compress
 }
 
-// check
-//	true: need rotate file
-//	false: do not need rotate file
-func checkSize(fileSize int64) bool {
-	if fileSize >= LogMaxSize {
-		return true
+//gzipFile 压缩为 .gz 后删除原文件
+func gzipFile(path string) error {
+	src, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	dst, err := os.OpenFile(path+".gz", os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0660)
+	if err != nil {
+		src.Close()
+		return err
+	}
+	zw := gzip.NewWriter(dst)
+	if _, err = io.Copy(zw, src); err == nil {
+		err = zw.Close()
 	}
-	return false
+	if closeErr := dst.Close(); err == nil {
+		err = closeErr
+	}
+	src.Close()
+	if err != nil {
+		os.Remove(path + ".gz")
+		return err
+	}
+	return os.Remove(path)
 }
 
-func checkTime(timeNow time.Time) bool {
-	if !NeedCheckTime {
-		return false
+//nextRotateTime 下一次按时间滚动的时间，不按时间滚动时返回零值
+func nextRotateTime(now time.Time, interval time.Duration, minutes []int) time.Time {
+	var next time.Time
+	if interval > 0 {
+		//按本地时间对齐，如 24h 在本地零点滚动
+		_, offset := now.Zone()
+		shift := time.Duration(offset) * time.Second
+		next = now.Add(shift).Truncate(interval).Add(interval).Add(-shift)
 	}
-	if timeNow.Second() == 0 {
-		for _, minute := range LogRotatorMinute {
-			if timeNow.Minute() == minute {
-				return true
-			}
+	hour := now.Truncate(time.Hour)
+	for _, minute := range minutes {
+		candidate := hour.Add(time.Duration(minute) * time.Minute)
+		if !candidate.After(now) {
+			candidate = candidate.Add(time.Hour)
+		}
+		if next.IsZero() || candidate.Before(next) {
+			next = candidate
 		}
 	}
-	return false
+	return next
+}
+
+//StartRotate 日志写入滚动文件，收到SIGHUP时重新打开
+func StartRotate(opts *RotateOptions) (*RotateWriter, error) {
+	w, err := NewRotateWriter(opts)
+	if err != nil {
+		return nil, err
+	}
+	SetOutput(w)
+	golog.SetOutput(w)
+	w.ReopenOnSignal()
+	return w, nil
+}
+
+// StartLogRotator 启动记录日志文件，兼容原来的参数
+//	rotatorMinute 为空且 timeRotate 为 true 时在整点和半点滚动
+func StartLogRotator(baseFileName string, maxSize int64, logger *golog.Logger, rotatorMinute []int, timeRotate bool) {
+	opts := &RotateOptions{Filename: baseFileName, MaxSize: maxSize, RotateMinutes: rotatorMinute}
+	if len(rotatorMinute) == 0 && timeRotate {
+		opts.RotateMinutes = []int{0, 30}
+	}
+	w, err := StartRotate(opts)
+	if err != nil {
+		fmt.Printf("StartLogRotator failed:[%v]\n", err)
+		return
+	}
+	if logger != nil {
+		logger.SetOutput(w)
+	}
 }
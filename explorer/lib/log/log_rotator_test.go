@@ -0,0 +1,93 @@
+package log
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRotateWriter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "rotate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "app.log")
+
+	w, err := NewRotateWriter(&RotateOptions{Filename: filename, MaxSize: 10, MaxBackups: 2, Compress: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	for i := 0; i < 4; i++ {
+		if _, err := w.Write([]byte("0123456789")); err != nil {
+			t.Fatal(err)
+		}
+		//滚动文件名精确到毫秒
+		time.Sleep(2 * time.Millisecond)
+	}
+	if err := w.mill(); err != nil {
+		t.Fatal(err)
+	}
+	backups, _ := listBackups(filename)
+	if len(backups) != 2 {
+		t.Fatalf("backups:%v", len(backups))
+	}
+	for _, backup := range backups {
+		if !strings.HasSuffix(backup.path, ".log.gz") {
+			t.Errorf("backup not compressed:%v", backup.path)
+		}
+	}
+	if data, _ := ioutil.ReadFile(filename); string(data) != "0123456789" {
+		t.Errorf("current file:%q", data)
+	}
+
+	//外部移走文件后重新打开
+	os.Rename(filename, filename+".1")
+	if err := w.Reopen(); err != nil {
+		t.Fatal(err)
+	}
+	w.Write([]byte("reopen"))
+	if data, _ := ioutil.ReadFile(filename); string(data) != "reopen" {
+		t.Errorf("reopen file:%q", data)
+	}
+}
+
+func TestSelectBackups(t *testing.T) {
+	now := time.Date(2018, 10, 10, 12, 0, 0, 0, time.Local)
+	backups := []*logBackup{
+		{path: "app-1.log.gz", rotateTime: now.Add(-72 * time.Hour)},
+		{path: "app-2.log", rotateTime: now.Add(-time.Hour)},
+		{path: "app-3.log.gz", rotateTime: now.Add(-2 * time.Hour)},
+		{path: "app-4.log", rotateTime: now.Add(-3 * time.Hour)},
+	}
+	remove, compress := selectBackups(backups, now, 48*time.Hour, 2, true)
+	if len(remove) != 2 || remove[0].path != "app-4.log" || remove[1].path != "app-1.log.gz" {
+		t.Errorf("remove:%v", remove)
+	}
+	if len(compress) != 1 || compress[0].path != "app-2.log" {
+		t.Errorf("compress:%v", compress)
+	}
+}
+
+func TestNextRotateTime(t *testing.T) {
+	now := time.Date(2018, 10, 10, 10, 40, 0, 0, time.Local)
+	if next := nextRotateTime(now, 0, []int{0, 30}); !next.Equal(time.Date(2018, 10, 10, 11, 0, 0, 0, time.Local)) {
+		t.Errorf("minutes:%v", next)
+	}
+	if next := nextRotateTime(now, 0, []int{50}); !next.Equal(time.Date(2018, 10, 10, 10, 50, 0, 0, time.Local)) {
+		t.Errorf("minutes in hour:%v", next)
+	}
+	if next := nextRotateTime(now, 24*time.Hour, nil); !next.Equal(time.Date(2018, 10, 11, 0, 0, 0, 0, time.Local)) {
+		t.Errorf("interval:%v", next)
+	}
+	if next := nextRotateTime(now, 0, nil); !next.IsZero() {
+		t.Errorf("no time rotate:%v", next)
+	}
+	if name := backupName("/data/log/app.log", now); name != "/data/log/app-20181010T104000.000.log" {
+		t.Errorf("backupName:%v", name)
+	}
+}
@@ -0,0 +1,323 @@
+package log
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+/*
+	结构化日志，日志带名称和key/value字段，通过 Encoder 输出为文本或json
+	Debugf/Infof 等包级函数是对默认 Entry 的封装，日志名称为调用方的包路径
+*/
+
+//Field 日志字段
+type Field struct {
+	Key   string
+	Value interface{}
+}
+
+//Record 一条日志
+type Record struct {
+	Time    time.Time
+	Level   Level
+	Logger  string  // 日志名称，未命名时为调用方的包路径
+	Caller  string  // 调用位置 file:line
+	Message string  // 日志内容
+	Fields  []Field // 按添加顺序排列的字段
+}
+
+//Entry 带名称和字段的日志，With 等方法返回新的 Entry，可以在多个goroutine中共用
+type Entry struct {
+	name   string
+	fields []Field
+}
+
+//常用字段名
+const (
+	RequestIDKey = "request_id"
+	TraceIDKey   = "trace_id"
+)
+
+var std = &Entry{}
+
+//Named 获取指定名称的日志，按名称覆盖日志级别
+func Named(name string) *Entry {
+	return &Entry{name: name}
+}
+
+//With 添加字段，kv为 key1, value1, key2, value2...
+func With(kv ...interface{}) *Entry {
+	return std.With(kv...)
+}
+
+//WithFields 添加字段
+func WithFields(fields ...Field) *Entry {
+	return std.WithFields(fields...)
+}
+
+//FromContext 获取带context中字段的日志
+func FromContext(ctx context.Context) *Entry {
+	return std.WithContext(ctx)
+}
+
+//Named 获取子日志，名称用.连接
+func (l *Entry) Named(name string) *Entry {
+	if l.name != "" {
+		name = l.name + "." + name
+	}
+	return &Entry{name: name, fields: l.fields}
+}
+
+//With 添加字段，kv为 key1, value1, key2, value2...，key不是字符串时忽略该对
+func (l *Entry) With(kv ...interface{}) *Entry {
+	return l.WithFields(kvToFields(kv)...)
+}
+
+//WithFields 添加字段
+func (l *Entry) WithFields(fields ...Field) *Entry {
+	if len(fields) == 0 {
+		return l
+	}
+	merged := make([]Field, 0, len(l.fields)+len(fields))
+	merged = append(merged, l.fields...)
+	merged = append(merged, fields...)
+	return &Entry{name: l.name, fields: merged}
+}
+
+//WithContext 添加context中携带的字段，如 request_id、trace_id
+func (l *Entry) WithContext(ctx context.Context) *Entry {
+	return l.WithFields(ContextFields(ctx)...)
+}
+
+func (l *Entry) Sql(args ...interface{}) {
+	l.output(1, SQL, fmt.Sprint(args...))
+}
+
+func (l *Entry) Sqlf(format string, args ...interface{}) {
+	l.output(1, SQL, fmt.Sprintf(format, args...))
+}
+
+func (l *Entry) Debug(args ...interface{}) {
+	l.output(1, DEBUG, fmt.Sprint(args...))
+}
+
+func (l *Entry) Debugf(format string, args ...interface{}) {
+	l.output(1, DEBUG, fmt.Sprintf(format, args...))
+}
+
+func (l *Entry) Info(args ...interface{}) {
+	l.output(1, INFO, fmt.Sprint(args...))
+}
+
+func (l *Entry) Infof(format string, args ...interface{}) {
+	l.output(1, INFO, fmt.Sprintf(format, args...))
+}
+
+func (l *Entry) Warn(args ...interface{}) {
+	l.output(1, WARN, fmt.Sprint(args...))
+}
+
+func (l *Entry) Warnf(format string, args ...interface{}) {
+	l.output(1, WARN, fmt.Sprintf(format, args...))
+}
+
+func (l *Entry) Error(args ...interface{}) {
+	l.output(1, ERROR, fmt.Sprint(args...))
+}
+
+func (l *Entry) Errorf(format string, args ...interface{}) {
+	l.output(1, ERROR, fmt.Sprintf(format, args...))
+}
+
+//Fatalf 输出日志后退出进程
+func (l *Entry) Fatalf(format string, args ...interface{}) {
+	l.output(1, FATAL, fmt.Sprintf(format, args...))
+	os.Exit(1)
+}
+
+//Enabled 判断该级别的日志是否会输出，用于跳过代价较大的参数计算
+func (l *Entry) Enabled(level Level) bool {
+	name := l.name
+	if name == "" && hasPackageLevels() {
+		name, _ = callerInfo(1)
+	}
+	return isLogShouldRecordFor(name, level)
+}
+
+//output 按级别过滤后编码输出，skip为调用位置相对调用 output 的函数的栈深度，1为调用方
+func (l *Entry) output(skip int, level Level, msg string) {
+	if !hasPackageLevels() && !isLogShouldRecord(level) {
+		return
+	}
+	pkg, caller := callerInfo(skip + 1)
+	name := l.name
+	if name == "" {
+		name = pkg
+	}
+	if !isLogShouldRecordFor(name, level) {
+		return
+	}
+	writeRecord(&Record{
+		Time:    time.Now(),
+		Level:   level,
+		Logger:  name,
+		Caller:  caller,
+		Message: strings.TrimSuffix(msg, "\n"),
+		Fields:  l.fields,
+	})
+}
+
+//callerInfo 返回调用方的包路径和 file:line
+func callerInfo(skip int) (string, string) {
+	pc, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return "", "???:0"
+	}
+	pkg := ""
+	if fn := runtime.FuncForPC(pc); fn != nil {
+		pkg = funcPackage(fn.Name())
+	}
+	return pkg, fmt.Sprintf("%v:%v", file, line)
+}
+
+//funcPackage 从函数全名中取包路径，如 github.com/a/b.(*T).F 返回 github.com/a/b
+func funcPackage(funcName string) string {
+	slash := strings.LastIndex(funcName, "/")
+	if dot := strings.Index(funcName[slash+1:], "."); dot >= 0 {
+		return funcName[:slash+1+dot]
+	}
+	return funcName
+}
+
+func kvToFields(kv []interface{}) []Field {
+	fields := make([]Field, 0, len(kv)/2)
+	for i := 0; i+1 < len(kv); i += 2 {
+		if key, ok := kv[i].(string); ok {
+			fields = append(fields, Field{Key: key, Value: kv[i+1]})
+		}
+	}
+	return fields
+}
+
+type contextKey struct{}
+
+//NewContext 返回携带日志字段的context，FromContext 获取的日志会带上这些字段
+func NewContext(ctx context.Context, kv ...interface{}) context.Context {
+	fields := append(ContextFields(ctx), kvToFields(kv)...)
+	return context.WithValue(ctx, contextKey{}, fields)
+}
+
+//ContextFields context中携带的日志字段
+func ContextFields(ctx context.Context) []Field {
+	if ctx == nil {
+		return nil
+	}
+	fields, _ := ctx.Value(contextKey{}).([]Field)
+	//返回副本，避免多个context共用底层数组
+	return append([]Field(nil), fields...)
+}
+
+//ContextValue context中携带的字段值，如 ContextValue(ctx, RequestIDKey)
+func ContextValue(ctx context.Context, key string) interface{} {
+	fields := ContextFields(ctx)
+	for i := len(fields) - 1; i >= 0; i-- {
+		if fields[i].Key == key {
+			return fields[i].Value
+		}
+	}
+	return nil
+}
+
+//Setup 按启动参数初始化日志，format为console或json，packages为按包覆盖的级别，如 web/service=debug,fullnode=warn
+func Setup(level, format, packages string) error {
+	enc, err := NewEncoder(format)
+	if err != nil {
+		return err
+	}
+	levels, err := ParsePackageLevels(packages)
+	if err != nil {
+		return err
+	}
+	SetEncoder(enc)
+	ResetPackageLevels()
+	for pkg, pkgLevel := range levels {
+		SetPackageLevel(pkg, pkgLevel)
+	}
+	ChangeLogLevel(Str2Level(level))
+	return nil
+}
+
+//按包覆盖的日志级别
+var packageLevels = make(map[string]Level)
+var packageLevelsMutex sync.RWMutex
+
+//SetPackageLevel 覆盖日志名称或包路径后缀为pkg的日志级别，如 web/service、fullnode
+func SetPackageLevel(pkg string, level Level) {
+	packageLevelsMutex.Lock()
+	packageLevels[strings.Trim(pkg, "/")] = level
+	packageLevelsMutex.Unlock()
+}
+
+//ResetPackageLevels 清除全部按包覆盖的级别
+func ResetPackageLevels() {
+	packageLevelsMutex.Lock()
+	packageLevels = make(map[string]Level)
+	packageLevelsMutex.Unlock()
+}
+
+//PackageLevels 全部按包覆盖的级别
+func PackageLevels() map[string]Level {
+	packageLevelsMutex.RLock()
+	levels := make(map[string]Level, len(packageLevels))
+	for pkg, level := range packageLevels {
+		levels[pkg] = level
+	}
+	packageLevelsMutex.RUnlock()
+	return levels
+}
+
+//ParsePackageLevels 解析 web/service=debug,fullnode=warn 格式的配置
+func ParsePackageLevels(conf string) (map[string]Level, error) {
+	levels := make(map[string]Level)
+	for _, item := range strings.Split(conf, ",") {
+		if item = strings.TrimSpace(item); item == "" {
+			continue
+		}
+		kv := strings.SplitN(item, "=", 2)
+		if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+			return nil, fmt.Errorf("invalid package log level [%v]", item)
+		}
+		level, ok := ParseLevel(strings.TrimSpace(kv[1]))
+		if !ok {
+			return nil, fmt.Errorf("invalid package log level [%v]", item)
+		}
+		levels[strings.TrimSpace(kv[0])] = level
+	}
+	return levels, nil
+}
+
+func hasPackageLevels() bool {
+	packageLevelsMutex.RLock()
+	has := len(packageLevels) > 0
+	packageLevelsMutex.RUnlock()
+	return has
+}
+
+//isLogShouldRecordFor 按名称判断日志是否应该被记录，最长匹配的覆盖级别优先
+//	pkg 匹配相同名称、包路径后缀和子日志，如 fullnode 匹配 fullnode.account
+func isLogShouldRecordFor(name string, level Level) bool {
+	packageLevelsMutex.RLock()
+	matched, matchedLen := CurrentLevel(), -1
+	for pkg, pkgLevel := range packageLevels {
+		if (name == pkg || strings.HasSuffix(name, "/"+pkg) || strings.HasPrefix(name, pkg+".")) && len(pkg) > matchedLen {
+			matched, matchedLen = pkgLevel, len(pkg)
+		}
+	}
+	packageLevelsMutex.RUnlock()
+	return level >= matched
+}
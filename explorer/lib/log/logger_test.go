@@ -0,0 +1,129 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func captureOutput(format string) *bytes.Buffer {
+	buf := &bytes.Buffer{}
+	enc, _ := NewEncoder(format)
+	SetEncoder(enc)
+	SetOutput(buf)
+	return buf
+}
+
+func TestJSONEncoder(t *testing.T) {
+	ChangeLogLevel(INFO)
+	ResetPackageLevels()
+	buf := captureOutput("json")
+
+	ctx := NewContext(context.Background(), RequestIDKey, "req-1")
+	FromContext(ctx).With("blockID", 100, "err", errors.New("timeout"), "msg", "dup").Errorf("store block failed")
+	Debugf("skip debug")
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 1 {
+		t.Fatalf("json lines:%v", lines)
+	}
+	record := make(map[string]interface{})
+	if err := json.Unmarshal([]byte(lines[0]), &record); err != nil {
+		t.Fatalf("json unmarshal:%v %v", lines[0], err)
+	}
+	if record["level"] != "error" || record["msg"] != "store block failed" || record[RequestIDKey] != "req-1" ||
+		record["blockID"] != float64(100) || record["err"] != "timeout" || record["fields.msg"] != "dup" {
+		t.Errorf("json record:%v", record)
+	}
+	if logger, _ := record["logger"].(string); !strings.HasSuffix(logger, "lib/log") {
+		t.Errorf("json logger:%v", record["logger"])
+	}
+	if caller, _ := record["caller"].(string); !strings.Contains(caller, "logger_test.go:") {
+		t.Errorf("json caller:%v", record["caller"])
+	}
+}
+
+func TestConsoleEncoder(t *testing.T) {
+	ChangeLogLevel(INFO)
+	ResetPackageLevels()
+	buf := captureOutput("console")
+
+	Named("fullnode").With("blockID", 100, "note", "two words").Infof("store %v blocks", 3)
+	line := buf.String()
+	if !strings.Contains(line, "logger_test.go:") || !strings.HasSuffix(line, `:  [INFO] store 3 blocks blockID=100 note="two words"`+"\n") {
+		t.Errorf("console line:%v", line)
+	}
+}
+
+func TestPackageLevel(t *testing.T) {
+	ChangeLogLevel(INFO)
+	defer ResetPackageLevels()
+	buf := captureOutput("console")
+
+	levels, err := ParsePackageLevels("lib/log=warn, fullnode=debug")
+	if err != nil || levels["lib/log"] != WARN || levels["fullnode"] != DEBUG {
+		t.Fatalf("ParsePackageLevels:%v %v", levels, err)
+	}
+	if _, err := ParsePackageLevels("fullnode"); err == nil {
+		t.Errorf("ParsePackageLevels without level")
+	}
+	if _, err := ParsePackageLevels("fullnode=verbose"); err == nil {
+		t.Errorf("ParsePackageLevels unknown level")
+	}
+	for pkg, level := range levels {
+		SetPackageLevel(pkg, level)
+	}
+	if current := PackageLevels(); len(current) != 2 || current["fullnode"] != DEBUG || CurrentLevel() != INFO {
+		t.Errorf("PackageLevels:%v CurrentLevel:%v", current, CurrentLevel())
+	}
+
+	//本包覆盖为warn，info不输出
+	Infof("skip info")
+	//fullnode及其子日志覆盖为debug
+	Named("fullnode").Named("account").Debugf("account debug")
+	Named("account").Debugf("skip account debug")
+	out := buf.String()
+	if strings.Contains(out, "skip") || !strings.Contains(out, "account debug") {
+		t.Errorf("package level output:%v", out)
+	}
+	if !Named("fullnode").Enabled(DEBUG) || std.Enabled(INFO) {
+		t.Errorf("Enabled")
+	}
+}
+
+//TestChangeLogLevelConcurrent 运行中修改日志级别，用 -race 检查
+func TestChangeLogLevelConcurrent(t *testing.T) {
+	defer ChangeLogLevel(CurrentLevel())
+	SetOutput(&bytes.Buffer{})
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < 100; i++ {
+			Debugf("concurrent debug %v", i)
+		}
+		close(done)
+	}()
+	for _, level := range []Level{DEBUG, WARN, INFO} {
+		ChangeLogLevel(level)
+	}
+	<-done
+	if CurrentLevel() != INFO {
+		t.Errorf("CurrentLevel:%v", CurrentLevel())
+	}
+}
+
+func TestFuncPackage(t *testing.T) {
+	cases := map[string]string{
+		"github.com/wlcy/tron/explorer/web/service.QueryBlocks":         "github.com/wlcy/tron/explorer/web/service",
+		"github.com/wlcy/tron/explorer/web/buffer.(*blockBuffer).load":  "github.com/wlcy/tron/explorer/web/buffer",
+		"github.com/wlcy/tron/explorer/web/buffer.getBlockBuffer.func1": "github.com/wlcy/tron/explorer/web/buffer",
+		"main.main": "main",
+	}
+	for funcName, pkg := range cases {
+		if got := funcPackage(funcName); got != pkg {
+			t.Errorf("funcPackage(%v):%v", funcName, got)
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+)
+
+//ContentType Prometheus text format
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+//ServeMux 采集程序的http服务，已注册 /metrics，其他检查接口可以注册到这里
+var ServeMux = http.NewServeMux()
+
+func init() {
+	ServeMux.Handle("/metrics", Handler())
+}
+
+//Handler 输出 DefaultRegistry 中的指标
+func Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentType)
+		if _, err := DefaultRegistry.WriteTo(w); err != nil {
+			log.Named("metrics").Warnf("write metrics error:[%v]", err)
+		}
+	})
+}
+
+//Serve 在addr上启动 ServeMux，addr为空时不启动，用于没有web服务的采集程序
+func Serve(addr string) {
+	if addr == "" {
+		return
+	}
+	go func() {
+		log.Named("metrics").Infof("metrics listen on [%v]", addr)
+		if err := http.ListenAndServe(addr, ServeMux); err != nil {
+			log.Named("metrics").Errorf("metrics listen on [%v] error:[%v]", addr, err)
+		}
+	}()
+}
@@ -0,0 +1,385 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+/*
+	Prometheus 指标，支持 counter、gauge、histogram，按 text format 0.0.4 输出
+	指标注册到 DefaultRegistry，通过 Handler 暴露给 /metrics
+*/
+
+//DefBuckets 默认的耗时分布区间，单位秒
+var DefBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+//DefaultRegistry 默认的指标集合
+var DefaultRegistry = NewRegistry()
+
+type collector interface {
+	desc() *metricDesc
+	write(w *bufio.Writer)
+}
+
+//Registry 指标集合
+type Registry struct {
+	mutex      sync.RWMutex
+	collectors map[string]collector
+}
+
+//NewRegistry 创建指标集合
+func NewRegistry() *Registry {
+	return &Registry{collectors: make(map[string]collector)}
+}
+
+//register 注册指标，同名指标重复注册时 panic
+func (r *Registry) register(c collector) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	name := c.desc().name
+	if _, ok := r.collectors[name]; ok {
+		panic(fmt.Sprintf("metrics: duplicate metric [%v]", name))
+	}
+	r.collectors[name] = c
+}
+
+//WriteTo 按名称排序输出所有指标
+func (r *Registry) WriteTo(w io.Writer) (int64, error) {
+	r.mutex.RLock()
+	names := make([]string, 0, len(r.collectors))
+	for name := range r.collectors {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	collectors := make([]collector, 0, len(names))
+	for _, name := range names {
+		collectors = append(collectors, r.collectors[name])
+	}
+	r.mutex.RUnlock()
+
+	cw := &countWriter{w: w}
+	bw := bufio.NewWriter(cw)
+	for _, c := range collectors {
+		d := c.desc()
+		fmt.Fprintf(bw, "# HELP %s %s\n", d.name, escapeHelp(d.help))
+		fmt.Fprintf(bw, "# TYPE %s %s\n", d.name, d.typ)
+		c.write(bw)
+	}
+	err := bw.Flush()
+	return cw.n, err
+}
+
+type metricDesc struct {
+	name       string
+	help       string
+	typ        string
+	labelNames []string
+}
+
+//vec 按标签值保存子指标
+type vec struct {
+	*metricDesc
+	mutex    sync.RWMutex
+	children map[string]interface{}
+	labels   map[string][]string
+}
+
+func newVec(name, help, typ string, labelNames []string) *vec {
+	return &vec{
+		metricDesc: &metricDesc{name: name, help: help, typ: typ, labelNames: labelNames},
+		children:   make(map[string]interface{}),
+		labels:     make(map[string][]string),
+	}
+}
+
+func (v *vec) desc() *metricDesc {
+	return v.metricDesc
+}
+
+//get 获取标签值对应的子指标，不存在时通过 create 创建
+func (v *vec) get(labelValues []string, create func() interface{}) interface{} {
+	if len(labelValues) != len(v.labelNames) {
+		panic(fmt.Sprintf("metrics: [%v] expects %v label values, got %v", v.name, len(v.labelNames), len(labelValues)))
+	}
+	key := strings.Join(labelValues, "\xff")
+	v.mutex.RLock()
+	child, ok := v.children[key]
+	v.mutex.RUnlock()
+	if ok {
+		return child
+	}
+	v.mutex.Lock()
+	defer v.mutex.Unlock()
+	if child, ok = v.children[key]; !ok {
+		child = create()
+		v.children[key] = child
+		v.labels[key] = append([]string(nil), labelValues...)
+	}
+	return child
+}
+
+//each 按标签值排序遍历子指标
+func (v *vec) each(fn func(labels string, child interface{})) {
+	v.mutex.RLock()
+	keys := make([]string, 0, len(v.children))
+	for key := range v.children {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	children := make([]interface{}, 0, len(keys))
+	labels := make([][]string, 0, len(keys))
+	for _, key := range keys {
+		children = append(children, v.children[key])
+		labels = append(labels, v.labels[key])
+	}
+	v.mutex.RUnlock()
+	for i, child := range children {
+		fn(formatLabels(v.labelNames, labels[i]), child)
+	}
+}
+
+//Counter 只增不减的计数
+type Counter struct {
+	bits uint64
+}
+
+//Inc 加1
+func (c *Counter) Inc() {
+	c.Add(1)
+}
+
+//Add 增加v，v小于0时忽略
+func (c *Counter) Add(v float64) {
+	if v < 0 {
+		return
+	}
+	addFloat(&c.bits, v)
+}
+
+//Value 当前值
+func (c *Counter) Value() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&c.bits))
+}
+
+//Gauge 可增可减的当前值
+type Gauge struct {
+	bits uint64
+	fn   func() float64
+}
+
+//Set 设置当前值
+func (g *Gauge) Set(v float64) {
+	atomic.StoreUint64(&g.bits, math.Float64bits(v))
+}
+
+//Add 增加v，v可以为负数
+func (g *Gauge) Add(v float64) {
+	addFloat(&g.bits, v)
+}
+
+//Value 当前值，通过 GaugeVec.Func 注册的在读取时计算
+func (g *Gauge) Value() float64 {
+	if g.fn != nil {
+		return g.fn()
+	}
+	return math.Float64frombits(atomic.LoadUint64(&g.bits))
+}
+
+//Histogram 分布统计
+type Histogram struct {
+	buckets []float64
+	counts  []uint64 // 每个区间的数量，最后一个为 +Inf
+	sumBits uint64
+	count   uint64
+}
+
+//Observe 记录一个值
+func (h *Histogram) Observe(v float64) {
+	i := sort.SearchFloat64s(h.buckets, v)
+	atomic.AddUint64(&h.counts[i], 1)
+	addFloat(&h.sumBits, v)
+	atomic.AddUint64(&h.count, 1)
+}
+
+//ObserveSince 记录从start到现在的耗时，单位秒
+func (h *Histogram) ObserveSince(start time.Time) {
+	h.Observe(time.Since(start).Seconds())
+}
+
+//Count 记录的总数
+func (h *Histogram) Count() uint64 {
+	return atomic.LoadUint64(&h.count)
+}
+
+//Sum 记录的值之和
+func (h *Histogram) Sum() float64 {
+	return math.Float64frombits(atomic.LoadUint64(&h.sumBits))
+}
+
+//CounterVec 按标签区分的 Counter
+type CounterVec struct {
+	*vec
+}
+
+//NewCounterVec 创建并注册到 DefaultRegistry
+func NewCounterVec(name, help string, labelNames ...string) *CounterVec {
+	c := &CounterVec{vec: newVec(name, help, "counter", labelNames)}
+	DefaultRegistry.register(c)
+	return c
+}
+
+//With 获取标签值对应的 Counter，标签值按 labelNames 的顺序
+func (c *CounterVec) With(labelValues ...string) *Counter {
+	return c.get(labelValues, func() interface{} { return &Counter{} }).(*Counter)
+}
+
+func (c *CounterVec) write(w *bufio.Writer) {
+	c.each(func(labels string, child interface{}) {
+		writeSample(w, c.name, labels, child.(*Counter).Value())
+	})
+}
+
+//GaugeVec 按标签区分的 Gauge
+type GaugeVec struct {
+	*vec
+}
+
+//NewGaugeVec 创建并注册到 DefaultRegistry
+func NewGaugeVec(name, help string, labelNames ...string) *GaugeVec {
+	g := &GaugeVec{vec: newVec(name, help, "gauge", labelNames)}
+	DefaultRegistry.register(g)
+	return g
+}
+
+//With 获取标签值对应的 Gauge，标签值按 labelNames 的顺序
+func (g *GaugeVec) With(labelValues ...string) *Gauge {
+	return g.get(labelValues, func() interface{} { return &Gauge{} }).(*Gauge)
+}
+
+//Func 注册在输出时计算的 Gauge，适合从缓存中读取的当前值
+func (g *GaugeVec) Func(fn func() float64, labelValues ...string) {
+	g.With(labelValues...).fn = fn
+}
+
+func (g *GaugeVec) write(w *bufio.Writer) {
+	g.each(func(labels string, child interface{}) {
+		writeSample(w, g.name, labels, child.(*Gauge).Value())
+	})
+}
+
+//HistogramVec 按标签区分的 Histogram
+type HistogramVec struct {
+	*vec
+	buckets []float64
+}
+
+//NewHistogramVec 创建并注册到 DefaultRegistry，buckets 为空时使用 DefBuckets
+func NewHistogramVec(name, help string, buckets []float64, labelNames ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefBuckets
+	}
+	buckets = append([]float64(nil), buckets...)
+	sort.Float64s(buckets)
+	h := &HistogramVec{vec: newVec(name, help, "histogram", labelNames), buckets: buckets}
+	DefaultRegistry.register(h)
+	return h
+}
+
+//With 获取标签值对应的 Histogram，标签值按 labelNames 的顺序
+func (h *HistogramVec) With(labelValues ...string) *Histogram {
+	return h.get(labelValues, func() interface{} {
+		return &Histogram{buckets: h.buckets, counts: make([]uint64, len(h.buckets)+1)}
+	}).(*Histogram)
+}
+
+func (h *HistogramVec) write(w *bufio.Writer) {
+	h.each(func(labels string, child interface{}) {
+		histogram := child.(*Histogram)
+		var cumulative uint64
+		for i, upper := range histogram.buckets {
+			cumulative += atomic.LoadUint64(&histogram.counts[i])
+			writeSample(w, h.name+"_bucket", appendLabel(labels, "le", formatFloat(upper)), float64(cumulative))
+		}
+		cumulative += atomic.LoadUint64(&histogram.counts[len(histogram.buckets)])
+		writeSample(w, h.name+"_bucket", appendLabel(labels, "le", "+Inf"), float64(cumulative))
+		writeSample(w, h.name+"_sum", labels, histogram.Sum())
+		writeSample(w, h.name+"_count", labels, float64(cumulative))
+	})
+}
+
+func addFloat(bits *uint64, v float64) {
+	for {
+		old := atomic.LoadUint64(bits)
+		if atomic.CompareAndSwapUint64(bits, old, math.Float64bits(math.Float64frombits(old)+v)) {
+			return
+		}
+	}
+}
+
+func writeSample(w *bufio.Writer, name, labels string, value float64) {
+	w.WriteString(name)
+	if labels != "" {
+		w.WriteString("{" + labels + "}")
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+func formatLabels(names, values []string) string {
+	pairs := make([]string, 0, len(names))
+	for i, name := range names {
+		pairs = append(pairs, name+`="`+escapeLabel(values[i])+`"`)
+	}
+	return strings.Join(pairs, ",")
+}
+
+func appendLabel(labels, name, value string) string {
+	pair := name + `="` + value + `"`
+	if labels == "" {
+		return pair
+	}
+	return labels + "," + pair
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var labelReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+var helpReplacer = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+func escapeLabel(v string) string {
+	return labelReplacer.Replace(v)
+}
+
+func escapeHelp(v string) string {
+	return helpReplacer.Replace(v)
+}
+
+type countWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,70 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestWriteTo(t *testing.T) {
+	old := DefaultRegistry
+	DefaultRegistry = NewRegistry()
+	defer func() { DefaultRegistry = old }()
+
+	requests := NewCounterVec("test_requests_total", "Requests.", "route", "code")
+	requests.With("/api/block", "200").Inc()
+	requests.With("/api/block", "200").Add(2)
+	requests.With("/api/block", "500").Add(-1)
+	requests.With(`a"b`, "200").Inc()
+	height := NewGaugeVec("test_height", "Height\nof block.", "source")
+	height.With("db").Set(100)
+	height.Func(func() float64 { return 105 }, "node")
+	latency := NewHistogramVec("test_latency_seconds", "Latency.", []float64{1, 0.1}, "op")
+	latency.With("select").Observe(0.05)
+	latency.With("select").Observe(0.5)
+	latency.With("select").Observe(3)
+
+	buf := &bytes.Buffer{}
+	if _, err := DefaultRegistry.WriteTo(buf); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP test_height Height\nof block.
+# TYPE test_height gauge
+test_height{source="db"} 100
+test_height{source="node"} 105
+# HELP test_latency_seconds Latency.
+# TYPE test_latency_seconds histogram
+test_latency_seconds_bucket{op="select",le="0.1"} 1
+test_latency_seconds_bucket{op="select",le="1"} 2
+test_latency_seconds_bucket{op="select",le="+Inf"} 3
+test_latency_seconds_sum{op="select"} 3.55
+test_latency_seconds_count{op="select"} 3
+# HELP test_requests_total Requests.
+# TYPE test_requests_total counter
+test_requests_total{route="/api/block",code="200"} 3
+test_requests_total{route="/api/block",code="500"} 0
+test_requests_total{route="a\"b",code="200"} 1
+`
+	if buf.String() != expected {
+		t.Errorf("WriteTo:\n%v", buf.String())
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Errorf("duplicate metric should panic")
+		}
+	}()
+	NewCounterVec("test_requests_total", "Requests.")
+}
+
+func TestHandler(t *testing.T) {
+	w := httptest.NewRecorder()
+	ServeMux.ServeHTTP(w, httptest.NewRequest("GET", "/metrics", nil))
+	if w.Code != 200 || w.Header().Get("Content-Type") != ContentType {
+		t.Errorf("code:%v header:%v", w.Code, w.Header())
+	}
+	if strings.Contains(w.Body.String(), "test_") {
+		t.Errorf("body:%v", w.Body.String())
+	}
+}
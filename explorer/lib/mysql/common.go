@@ -1,6 +1,7 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -159,6 +160,11 @@ func QueryTableDataPages(tableName string, pageSize int) int64 {
 
 //QueryTableData 查询数据库数据
 func QueryTableData(strSQL string) (*TronDBRows, error) {
+	return QueryTableDataContext(context.Background(), strSQL)
+}
+
+//QueryTableDataContext 同 QueryTableData，ctx 中有span时查询记录为子span
+func QueryTableDataContext(ctx context.Context, strSQL string) (*TronDBRows, error) {
 	//获取数据库对象
 	var dbPtr *TronDB
 	var err error
@@ -168,7 +174,7 @@ func QueryTableData(strSQL string) (*TronDBRows, error) {
 	}
 
 	//查询数据集
-	rows, err := dbPtr.Select(strSQL)
+	rows, err := dbPtr.SelectContext(ctx, strSQL)
 	if err != nil {
 		log.Errorf("query database using:[\n%v\n] error:[%v]", strSQL, err)
 		return rows, err
@@ -176,6 +182,22 @@ func QueryTableData(strSQL string) (*TronDBRows, error) {
 	return rows, err
 }
 
+//QueryTableDataStream 流式查询数据库数据，每读到一行调用一次handler，ctx 取消时终止查询
+func QueryTableDataStream(ctx context.Context, strSQL string, handler func(row *TronDBRows) error) error {
+	//获取数据库对象
+	var dbPtr *TronDB
+	var err error
+	if dbPtr, err = GetDatabase(); err != nil {
+		log.Errorf("get database error :[%v]\n", err)
+		return util.NewErrorMsg(util.Error_common_db_not_connected)
+	}
+
+	if err = dbPtr.SelectStream(ctx, strSQL, handler); err != nil {
+		log.Errorf("stream query database using:[\n%v\n] error:[%v]", strSQL, err)
+	}
+	return err
+}
+
 //QueryTablePageData 根据传入的SQL 执行分页查询
 func QueryTablePageData(strSQL string, pageColumnName string, pageIndex int, pagesize int) (*TronDBRows, error) {
 	strLimitSQL := fmt.Sprintf(" %s limit %d, %d ", pageColumnName, pageIndex*pagesize, pagesize)
@@ -380,6 +402,15 @@ func GenSQLPartInStrList(colName string, keys []string, withBrackets bool) strin
 	return strSQL
 }
 
+//sqlStringReplacer 与 mysql_real_escape_string 转义的字符相同
+var sqlStringReplacer = strings.NewReplacer(`\`, `\\`, `'`, `\'`, `"`, `\"`, "\x00", `\0`, "\n", `\n`, "\r", `\r`, "\x1a", `\Z`)
+
+//EscapeString 转义拼接到SQL单引号字符串中的值，用户输入或链上数据（通证名、备注等）拼接SQL前必须转义
+//	依赖连接使用utf8mb4字符集且没有开启 NO_BACKSLASH_ESCAPES
+func EscapeString(value string) string {
+	return sqlStringReplacer.Replace(value)
+}
+
 //GetNextKey 返回某个表的下一个主键ID，适用于AUTO_INCREMENT字段,tableName 支持schema.table结构
 func GetNextKey(schema string, tableName string) (uint64, error) {
 	if len(tableName) == 0 {
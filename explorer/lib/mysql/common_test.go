@@ -8,3 +8,17 @@ func TestDistinct(t *testing.T) {
 	t.Log(ret, "\n")
 	t.Log(distinct, "\n")
 }
+
+func TestEscapeString(t *testing.T) {
+	cases := map[string]string{
+		"IPFS":            "IPFS",
+		"it's":            `it\'s`,
+		`a\' or '1'='1`:   `a\\\' or \'1\'=\'1`,
+		"line\nbreak\x00": `line\nbreak\0`,
+	}
+	for value, want := range cases {
+		if got := EscapeString(value); got != want {
+			t.Errorf("EscapeString(%q) = %q, want %q", value, got, want)
+		}
+	}
+}
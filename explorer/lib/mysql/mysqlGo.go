@@ -1,11 +1,15 @@
 package mysql
 
 import (
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"github.com/wlcy/tron/explorer/lib/metrics"
+	"github.com/wlcy/tron/explorer/lib/trace"
 	util "github.com/wlcy/tron/explorer/lib/util"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -14,6 +18,32 @@ import (
 // 指定列名是否区分大小写
 const ColumnNameIgnoreCase = true
 
+var (
+	queryDuration = metrics.NewHistogramVec("explorer_mysql_query_duration_seconds", "Latency of mysql statements.", nil, "op")
+	queryErrors   = metrics.NewCounterVec("explorer_mysql_query_errors_total", "Failed mysql statements.", "op")
+)
+
+//maxTraceStatement span中记录的SQL最大长度
+const maxTraceStatement = 1024
+
+//beginQuery 开始执行SQL，返回的函数在执行结束时调用，记录耗时、错误数和链路追踪的span，op为 select、stream、exec、transaction
+//	ctx 中有span时记录为子span
+func beginQuery(ctx context.Context, op string, statement string) func(err error) {
+	start := time.Now()
+	if len(statement) > maxTraceStatement {
+		statement = statement[:maxTraceStatement]
+	}
+	span := trace.StartClient(ctx, "mysql "+op, "db.system", "mysql", "db.statement", statement)
+	return func(err error) {
+		queryDuration.With(op).ObserveSince(start)
+		if err != nil {
+			queryErrors.With(op).Inc()
+		}
+		span.SetError(err)
+		span.End()
+	}
+}
+
 //DBRow 查询结果行数据信息（仅包括数据，不包括列名或者列序号）
 type DBRow []string
 
@@ -89,10 +119,17 @@ func (rows *TronDBRows) IsFieldExist(colName string) bool {
 
 //Select 执行查询操作，并返回结果集
 func (db *TronDB) Select(sqlCmd string) (tronRows *TronDBRows, Error error) {
+	return db.SelectContext(context.Background(), sqlCmd)
+}
+
+//SelectContext 同 Select，ctx 用于链路追踪和取消查询
+func (db *TronDB) SelectContext(ctx context.Context, sqlCmd string) (tronRows *TronDBRows, Error error) {
 
 	if len(sqlCmd) == 0 {
 		return nil, errors.New("sqlCmd is nil")
 	}
+	done := beginQuery(ctx, "select", sqlCmd)
+	defer func() { done(Error) }()
 
 	resRows := &TronDBRows{
 		dbResult: make([]DBRow, 0, 10),
@@ -100,7 +137,7 @@ func (db *TronDB) Select(sqlCmd string) (tronRows *TronDBRows, Error error) {
 		index:    -1,
 		rowSize:  0,
 	}
-	rows, err := db.Query(sqlCmd)
+	rows, err := db.QueryContext(ctx, sqlCmd)
 
 	if err != nil {
 		return nil, err
@@ -156,6 +193,63 @@ func (db *TronDB) Select(sqlCmd string) (tronRows *TronDBRows, Error error) {
 	return resRows, nil
 }
 
+//SelectStream 执行查询操作，逐行回调handler，不缓存结果集，用于大数据量导出
+//handler 中的 TronDBRows 只包含当前行，可以直接使用 GetField 取值；handler 返回错误或 ctx 取消时终止查询
+func (db *TronDB) SelectStream(ctx context.Context, sqlCmd string, handler func(row *TronDBRows) error) (err error) {
+
+	if len(sqlCmd) == 0 {
+		return errors.New("sqlCmd is nil")
+	}
+	done := beginQuery(ctx, "stream", sqlCmd)
+	defer func() { done(err) }()
+
+	rows, err := db.QueryContext(ctx, sqlCmd)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	//构造列名数组
+	col, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	curRow := &TronDBRows{
+		dbResult: make([]DBRow, 1),
+		columns:  make(map[string]int, len(col)),
+		index:    0,
+		rowSize:  1,
+	}
+	for k, v := range col {
+		if ColumnNameIgnoreCase {
+			v = strings.ToLower(v)
+		}
+		curRow.columns[v] = k
+	}
+
+	values := make([]sql.RawBytes, len(col))
+	scanArgs := make([]interface{}, len(values))
+	for i := range values {
+		scanArgs[i] = &values[i]
+	}
+
+	for rows.Next() {
+		if err := rows.Scan(scanArgs...); err != nil {
+			return err
+		}
+		res := make(DBRow, 0, len(values))
+		for _, v := range values {
+			res = append(res, string(v))
+		}
+		curRow.dbResult[0] = res
+		if err := handler(curRow); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
+
 //Insert 执行插入操作
 func (db *TronDB) Insert(sqlCmd string) (int64, int64, error) {
 
@@ -163,7 +257,9 @@ func (db *TronDB) Insert(sqlCmd string) (int64, int64, error) {
 		return 0, 0, errors.New("sqlcmd is nil")
 	}
 
+	done := beginQuery(context.Background(), "exec", sqlCmd)
 	res, err := db.Exec(sqlCmd)
+	done(err)
 	if err != nil {
 		return 0, 0, err
 	}
@@ -190,11 +286,13 @@ func (db *TronDB) Delete(sqlCmd string) (int64, int64, error) {
 }
 
 //TransactionDB 批量执行SQL语句（按事务执行）
-func (db *TronDB) TransactionDB(sqlCmd []string) error {
+func (db *TronDB) TransactionDB(sqlCmd []string) (err error) {
 
 	if len(sqlCmd) == 0 {
 		return errors.New("sqlCmd len is 0")
 	}
+	done := beginQuery(context.Background(), "transaction", fmt.Sprintf("%v statements", len(sqlCmd)))
+	defer func() { done(err) }()
 	tx, err := db.Begin()
 
 	if err != nil {
@@ -4,8 +4,11 @@
 package redis
 
 import (
+	"context"
 	"fmt"
+	"strings"
 
+	"github.com/wlcy/tron/explorer/lib/trace"
 	src "gopkg.in/redis.v4"
 )
 
@@ -47,3 +50,46 @@ func NewClient(addr, password string, db, poolSize int) *TronRedis {
 func (r *TronRedis) String() string {
 	return fmt.Sprintf("redis info : host[%v] pass[%v] DB[%v] ", r.Addr, r.Password, r.DB)
 }
+
+//WithContext 返回ctx中有span时将命令记录为子span的客户端，与r共用连接池，ctx中没有span时返回r
+func (r *TronRedis) WithContext(ctx context.Context) *TronRedis {
+	if trace.FromContext(ctx) == nil {
+		return r
+	}
+	client := *r.Client
+	client.WrapProcess(func(process func(cmd src.Cmder) error) func(cmd src.Cmder) error {
+		return func(cmd src.Cmder) error {
+			return TraceCommand(ctx, commandName(cmd), func() error { return process(cmd) })
+		}
+	})
+	ret := *r
+	ret.Client = &client
+	return &ret
+}
+
+//TraceCommand 执行redis命令，ctx中有span时记录为子span "redis <命令名称>"，key不存在不记为错误
+//	用于本包和 github.com/go-redis/redis 客户端的 WrapProcess
+func TraceCommand(ctx context.Context, name string, process func() error) error {
+	span := trace.StartClient(ctx, "redis "+strings.ToLower(name), "db.system", "redis")
+	if span == nil {
+		return process()
+	}
+	err := process()
+	if err == nil || err.Error() != nilReply {
+		span.SetError(err)
+	}
+	span.End()
+	return err
+}
+
+//nilReply key不存在时两个redis客户端返回的错误信息
+const nilReply = "redis: nil"
+
+//commandName 命令名称，cmd.String() 的格式为 "get key: value"
+func commandName(cmd src.Cmder) string {
+	name := cmd.String()
+	if i := strings.IndexAny(name, " :"); i > 0 {
+		name = name[:i]
+	}
+	return strings.ToLower(name)
+}
@@ -0,0 +1,67 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+//LocalStorage 本地文件系统存储，文件保存在root目录下，baseURL为对外访问地址
+type LocalStorage struct {
+	root    string
+	baseURL string
+}
+
+//NewLocalStorage ...
+func NewLocalStorage(root, baseURL string) *LocalStorage {
+	return &LocalStorage{root: root, baseURL: baseURL}
+}
+
+//Put 先写临时文件再改名，避免读到写了一半的文件
+func (s *LocalStorage) Put(key string, data []byte, contentType string) (string, error) {
+	if err := checkKey(key); err != nil {
+		return "", err
+	}
+	if exist, err := s.Exists(key); err != nil || exist {
+		return s.URL(key), err
+	}
+	fileName := filepath.Join(s.root, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(fileName), 0755); err != nil {
+		return "", err
+	}
+	tmpFile, err := ioutil.TempFile(filepath.Dir(fileName), ".tmp_")
+	if err != nil {
+		return "", err
+	}
+	if _, err = tmpFile.Write(data); err == nil {
+		err = tmpFile.Chmod(0644)
+	}
+	if closeErr := tmpFile.Close(); err == nil {
+		err = closeErr
+	}
+	if err == nil {
+		err = os.Rename(tmpFile.Name(), fileName)
+	}
+	if err != nil {
+		os.Remove(tmpFile.Name())
+		return "", err
+	}
+	return s.URL(key), nil
+}
+
+//Exists ...
+func (s *LocalStorage) Exists(key string) (bool, error) {
+	if err := checkKey(key); err != nil {
+		return false, err
+	}
+	_, err := os.Stat(filepath.Join(s.root, filepath.FromSlash(key)))
+	if os.IsNotExist(err) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+//URL ...
+func (s *LocalStorage) URL(key string) string {
+	return joinURL(s.baseURL, key)
+}
@@ -0,0 +1,35 @@
+package storage
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+)
+
+//Storage 按内容寻址的文件存储，相同内容的key相同，重复写入时直接返回访问地址
+type Storage interface {
+	Put(key string, data []byte, contentType string) (string, error) // 保存文件，返回访问地址
+	Exists(key string) (bool, error)                                 // 文件是否已保存
+	URL(key string) string                                           // 文件的访问地址
+}
+
+//ContentKey 以内容的sha256作为文件名，prefix为目录
+func ContentKey(prefix string, data []byte, ext string) string {
+	sum := sha256.Sum256(data)
+	return path.Join(prefix, hex.EncodeToString(sum[:])+ext)
+}
+
+//checkKey key只能是相对路径，不允许 .. 跳出存储目录
+func checkKey(key string) error {
+	if key == "" || strings.HasPrefix(key, "/") || path.Clean(key) != key || strings.HasPrefix(key, "..") {
+		return fmt.Errorf("storage key [%v] invalid", key)
+	}
+	return nil
+}
+
+//joinURL 拼接访问地址
+func joinURL(baseURL, key string) string {
+	return strings.TrimRight(baseURL, "/") + "/" + key
+}
@@ -0,0 +1,194 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+//Exporter 输出结束的span，由Tracer的输出任务串行调用
+type Exporter interface {
+	Export(spans []*SpanData) error
+}
+
+//NewExporter 按名称创建Exporter，stdout 输出json行到标准输出，otlp 以 OTLP/HTTP json 格式发送到endpoint
+func NewExporter(name, endpoint string) (Exporter, error) {
+	switch name {
+	case "stdout":
+		return NewStdoutExporter(os.Stdout), nil
+	case "otlp":
+		return NewOTLPExporter(endpoint)
+	}
+	return nil, fmt.Errorf("unknown trace exporter [%v], should be stdout or otlp", name)
+}
+
+//StdoutExporter 每个span输出一行json
+type StdoutExporter struct {
+	mutex sync.Mutex
+	w     io.Writer
+}
+
+//NewStdoutExporter 输出到w
+func NewStdoutExporter(w io.Writer) *StdoutExporter {
+	return &StdoutExporter{w: w}
+}
+
+//Export 输出json行
+func (e *StdoutExporter) Export(spans []*SpanData) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	enc := json.NewEncoder(e.w)
+	for _, span := range spans {
+		if err := enc.Encode(span); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//OTLPExporter 以 OTLP/HTTP json 格式发送到collector，如 jaeger、otel-collector 的 4318 端口
+type OTLPExporter struct {
+	url    string
+	client *http.Client
+}
+
+//NewOTLPExporter endpoint为collector地址，如 http://127.0.0.1:4318，没有路径时发送到 /v1/traces
+func NewOTLPExporter(endpoint string) (*OTLPExporter, error) {
+	if !strings.HasPrefix(endpoint, "http://") && !strings.HasPrefix(endpoint, "https://") {
+		return nil, fmt.Errorf("invalid otlp endpoint [%v], should start with http:// or https://", endpoint)
+	}
+	url := strings.TrimSuffix(endpoint, "/")
+	if strings.Count(url, "/") == 2 {
+		url += "/v1/traces"
+	}
+	return &OTLPExporter{url: url, client: &http.Client{Timeout: 10 * time.Second}}, nil
+}
+
+//Export 按服务名称分组发送
+func (e *OTLPExporter) Export(spans []*SpanData) error {
+	body, err := json.Marshal(newOTLPRequest(spans))
+	if err != nil {
+		return err
+	}
+	resp, err := e.client.Post(e.url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		msg, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("otlp collector response [%v]:%s", resp.Status, msg)
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+//OTLP ExportTraceServiceRequest 的json格式，只包含用到的字段
+type otlpRequest struct {
+	ResourceSpans []*otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource      `json:"resource"`
+	ScopeSpans []*otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope   `json:"scope"`
+	Spans []*otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              int            `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Status            *otlpStatus    `json:"status,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    int    `json:"code"` // 2 为 STATUS_CODE_ERROR
+	Message string `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string                 `json:"key"`
+	Value map[string]interface{} `json:"value"`
+}
+
+func newOTLPRequest(spans []*SpanData) *otlpRequest {
+	req := &otlpRequest{}
+	services := make(map[string]*otlpScopeSpans)
+	for _, span := range spans {
+		scope, ok := services[span.Service]
+		if !ok {
+			scope = &otlpScopeSpans{Scope: otlpScope{Name: "github.com/wlcy/tron/explorer/lib/trace"}}
+			services[span.Service] = scope
+			req.ResourceSpans = append(req.ResourceSpans, &otlpResourceSpans{
+				Resource:   otlpResource{Attributes: []otlpKeyValue{otlpAttribute("service.name", span.Service)}},
+				ScopeSpans: []*otlpScopeSpans{scope},
+			})
+		}
+		scope.Spans = append(scope.Spans, newOTLPSpan(span))
+	}
+	return req
+}
+
+func newOTLPSpan(span *SpanData) *otlpSpan {
+	ret := &otlpSpan{
+		TraceID:           span.TraceID.String(),
+		SpanID:            span.SpanID.String(),
+		ParentSpanID:      span.ParentID.String(),
+		Name:              span.Name,
+		Kind:              int(span.Kind),
+		StartTimeUnixNano: strconv.FormatInt(span.Start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(span.End.UnixNano(), 10),
+	}
+	for key, value := range span.Attributes {
+		ret.Attributes = append(ret.Attributes, otlpAttribute(key, value))
+	}
+	if span.Error != "" {
+		ret.Status = &otlpStatus{Code: 2, Message: span.Error}
+	}
+	return ret
+}
+
+//otlpAttribute 按值的类型转换为 AnyValue，int64按规范输出为字符串
+func otlpAttribute(key string, value interface{}) otlpKeyValue {
+	var v map[string]interface{}
+	switch value := value.(type) {
+	case string:
+		v = map[string]interface{}{"stringValue": value}
+	case bool:
+		v = map[string]interface{}{"boolValue": value}
+	case int:
+		v = map[string]interface{}{"intValue": strconv.FormatInt(int64(value), 10)}
+	case int64:
+		v = map[string]interface{}{"intValue": strconv.FormatInt(value, 10)}
+	case float64:
+		v = map[string]interface{}{"doubleValue": value}
+	default:
+		v = map[string]interface{}{"stringValue": fmt.Sprint(value)}
+	}
+	return otlpKeyValue{Key: key, Value: v}
+}
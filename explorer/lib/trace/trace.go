@@ -0,0 +1,531 @@
+package trace
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	mrand "math/rand"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/metrics"
+)
+
+/*
+	链路追踪，span 的结构和 OpenTelemetry 一致，通过 Exporter 输出到 stdout 或 OTLP collector
+	http 请求由中间件创建 server span，调用方带 traceparent 请求头时沿用调用方的 trace
+	span 通过 context 传递，Start(ctx, name) 创建子span并返回新的context
+	mysql、redis、grpc 的封装通过 StartClient(ctx, name) 创建子span，ctx 中没有span时不记录
+	未启用或未采样时返回的span为nil，Span 的方法都可以在nil上调用
+*/
+
+//TraceID trace的16字节id
+type TraceID [16]byte
+
+//SpanID span的8字节id
+type SpanID [8]byte
+
+//IsValid 是否为非0的id
+func (id TraceID) IsValid() bool {
+	return id != TraceID{}
+}
+
+//String 32位16进制字符串
+func (id TraceID) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+//MarshalText json中输出为16进制字符串
+func (id TraceID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+//IsValid 是否为非0的id
+func (id SpanID) IsValid() bool {
+	return id != SpanID{}
+}
+
+//String 16位16进制字符串，0值为空字符串
+func (id SpanID) String() string {
+	if !id.IsValid() {
+		return ""
+	}
+	return hex.EncodeToString(id[:])
+}
+
+//MarshalText json中输出为16进制字符串
+func (id SpanID) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+//Kind span类型
+type Kind int
+
+//span类型，取值和 OTLP 的 SpanKind 一致
+const (
+	KindInternal Kind = 1 // 进程内的处理步骤
+	KindServer   Kind = 2 // 处理http请求
+	KindClient   Kind = 3 // 调用mysql、redis、grpc等外部服务
+)
+
+var kindNames = map[Kind]string{
+	KindInternal: "internal",
+	KindServer:   "server",
+	KindClient:   "client",
+}
+
+//String 类型名称
+func (k Kind) String() string {
+	if name, ok := kindNames[k]; ok {
+		return name
+	}
+	return fmt.Sprintf("KIND(%d)", int(k))
+}
+
+//MarshalText json中输出为类型名称
+func (k Kind) MarshalText() ([]byte, error) {
+	return []byte(k.String()), nil
+}
+
+//SpanContext 跨进程传递的span信息
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+	Sampled bool
+}
+
+//IsValid trace id和span id都不为0
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID.IsValid() && sc.SpanID.IsValid()
+}
+
+//Traceparent W3C Trace Context 格式，如 00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01
+func (sc SpanContext) Traceparent() string {
+	flags := "00"
+	if sc.Sampled {
+		flags = "01"
+	}
+	return fmt.Sprintf("00-%v-%v-%v", sc.TraceID, sc.SpanID, flags)
+}
+
+//ParseTraceparent 解析 traceparent 请求头
+func ParseTraceparent(header string) (SpanContext, error) {
+	sc := SpanContext{}
+	parts := strings.Split(strings.TrimSpace(header), "-")
+	if len(parts) < 4 || len(parts[0]) != 2 || parts[0] == "ff" || (parts[0] == "00" && len(parts) != 4) {
+		return sc, fmt.Errorf("invalid traceparent [%v]", header)
+	}
+	flags, err := hex.DecodeString(parts[3])
+	if err != nil || len(flags) != 1 {
+		return sc, fmt.Errorf("invalid traceparent flags [%v]", header)
+	}
+	if n, err := hex.Decode(sc.TraceID[:], []byte(parts[1])); err != nil || n != len(sc.TraceID) || len(parts[1]) != 2*len(sc.TraceID) {
+		return sc, fmt.Errorf("invalid traceparent trace id [%v]", header)
+	}
+	if n, err := hex.Decode(sc.SpanID[:], []byte(parts[2])); err != nil || n != len(sc.SpanID) || len(parts[2]) != 2*len(sc.SpanID) {
+		return sc, fmt.Errorf("invalid traceparent span id [%v]", header)
+	}
+	if !sc.IsValid() {
+		return sc, fmt.Errorf("invalid traceparent zero id [%v]", header)
+	}
+	sc.Sampled = flags[0]&0x01 == 0x01
+	return sc, nil
+}
+
+//SpanData 结束的span，交给 Exporter 输出
+type SpanData struct {
+	Service    string                 `json:"service"`
+	Name       string                 `json:"name"`
+	Kind       Kind                   `json:"kind"`
+	TraceID    TraceID                `json:"trace_id"`
+	SpanID     SpanID                 `json:"span_id"`
+	ParentID   SpanID                 `json:"parent_id"`
+	Start      time.Time              `json:"start"`
+	End        time.Time              `json:"end"`
+	Attributes map[string]interface{} `json:"attributes,omitempty"`
+	Error      string                 `json:"error,omitempty"`
+}
+
+//Span 一次调用或处理步骤
+type Span struct {
+	tracer   *Tracer
+	name     string
+	kind     Kind
+	context  SpanContext
+	parentID SpanID
+	start    time.Time
+
+	mutex sync.Mutex
+	attrs map[string]interface{}
+	err   string
+	ended bool
+}
+
+//SetAttr 设置属性，kv为 key1, value1, key2, value2...，key不是字符串时忽略该对，End之后设置的属性不输出
+func (s *Span) SetAttr(kv ...interface{}) {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.ended {
+		return
+	}
+	for i := 0; i+1 < len(kv); i += 2 {
+		if key, ok := kv[i].(string); ok {
+			s.attrs[key] = kv[i+1]
+		}
+	}
+}
+
+//SetError 记录错误，err为nil时忽略
+func (s *Span) SetError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mutex.Lock()
+	s.err = err.Error()
+	s.mutex.Unlock()
+}
+
+//End 结束span并交给 Exporter，重复调用时忽略
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mutex.Lock()
+	if s.ended {
+		s.mutex.Unlock()
+		return
+	}
+	s.ended = true
+	data := &SpanData{
+		Service:    s.tracer.opts.Service,
+		Name:       s.name,
+		Kind:       s.kind,
+		TraceID:    s.context.TraceID,
+		SpanID:     s.context.SpanID,
+		ParentID:   s.parentID,
+		Start:      s.start,
+		End:        time.Now(),
+		Attributes: s.attrs,
+		Error:      s.err,
+	}
+	s.mutex.Unlock()
+
+	s.tracer.enqueue(data)
+}
+
+//Context span的id，用于传递给下游服务
+func (s *Span) Context() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.context
+}
+
+//TraceID 16进制的trace id，nil时为空字符串
+func (s *Span) TraceID() string {
+	if s == nil {
+		return ""
+	}
+	return s.context.TraceID.String()
+}
+
+//Options 追踪参数
+type Options struct {
+	Service       string        // 服务名称，OTLP中的 service.name
+	Exporter      Exporter      // 为nil时不追踪
+	SamplePercent int64         // 没有上游trace的请求的采样比例，0-100
+	QueueSize     int           // 等待输出的span数，队列满时丢弃
+	BatchSize     int           // 每次输出的最大span数
+	FlushInterval time.Duration // 队列不满时的输出间隔
+}
+
+//默认参数
+const (
+	DefaultQueueSize     = 2048
+	DefaultBatchSize     = 512
+	DefaultFlushInterval = 5 * time.Second
+)
+
+//Tracer 创建span并批量输出
+type Tracer struct {
+	opts    Options
+	queue   chan *SpanData
+	flush   chan chan struct{}
+	stop    chan struct{}
+	stopped chan struct{}
+	once    sync.Once
+}
+
+var logger = log.Named("trace")
+
+var droppedSpans = metrics.NewCounterVec("explorer_trace_spans_dropped_total", "Spans dropped because the export queue is full.")
+
+//NewTracer 创建Tracer并启动输出任务
+func NewTracer(opts Options) *Tracer {
+	if opts.Service == "" {
+		opts.Service = "explorer"
+	}
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = DefaultQueueSize
+	}
+	if opts.BatchSize <= 0 {
+		opts.BatchSize = DefaultBatchSize
+	}
+	if opts.FlushInterval <= 0 {
+		opts.FlushInterval = DefaultFlushInterval
+	}
+	t := &Tracer{
+		opts:    opts,
+		queue:   make(chan *SpanData, opts.QueueSize),
+		flush:   make(chan chan struct{}),
+		stop:    make(chan struct{}),
+		stopped: make(chan struct{}),
+	}
+	go t.run()
+	return t
+}
+
+//std 当前的Tracer，nil表示未启用
+var std atomic.Value
+
+func current() *Tracer {
+	t, _ := std.Load().(*Tracer)
+	return t
+}
+
+//Setup 按参数替换当前的Tracer，Exporter为nil时停止追踪，原来的Tracer输出完队列中的span后退出
+func Setup(opts Options) {
+	var t *Tracer
+	if opts.Exporter != nil {
+		t = NewTracer(opts)
+	}
+	old := current()
+	std.Store(t)
+	if old != nil {
+		go old.Shutdown(context.Background())
+	}
+}
+
+//Enabled 是否启用了追踪
+func Enabled() bool {
+	return current() != nil
+}
+
+//Flush 输出当前Tracer队列中的span
+func Flush(ctx context.Context) error {
+	if t := current(); t != nil {
+		return t.Flush(ctx)
+	}
+	return nil
+}
+
+//Shutdown 输出队列中的span并停止当前Tracer，用于服务退出
+func Shutdown(ctx context.Context) error {
+	if t := current(); t != nil {
+		std.Store((*Tracer)(nil))
+		return t.Shutdown(ctx)
+	}
+	return nil
+}
+
+type spanKey struct{}
+
+//FromContext context中的span
+func FromContext(ctx context.Context) *Span {
+	if ctx == nil {
+		return nil
+	}
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+//ContextWithSpan 返回携带span的context
+func ContextWithSpan(ctx context.Context, span *Span) context.Context {
+	if span == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, spanKey{}, span)
+}
+
+//Start 创建ctx中span的子span，ctx中没有span时创建新的trace
+func Start(ctx context.Context, name string, kv ...interface{}) (context.Context, *Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	t := current()
+	if t == nil {
+		return ctx, nil
+	}
+	span := t.start(FromContext(ctx), SpanContext{}, name, KindInternal, kv)
+	return ContextWithSpan(ctx, span), span
+}
+
+//StartServer 创建处理请求的span，traceparent为调用方传入的请求头，为空或无效时创建新的trace
+//	调用方未采样的请求不追踪
+func StartServer(ctx context.Context, name, traceparent string, kv ...interface{}) (context.Context, *Span) {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	t := current()
+	if t == nil {
+		return ctx, nil
+	}
+	remote := SpanContext{}
+	if traceparent != "" {
+		if sc, err := ParseTraceparent(traceparent); err == nil {
+			remote = sc
+		}
+	}
+	span := t.start(nil, remote, name, KindServer, kv)
+	return ContextWithSpan(ctx, span), span
+}
+
+//StartClient 创建ctx中span的子span，类型为调用外部服务，用于mysql、redis、grpc的封装，ctx中没有span时返回nil
+func StartClient(ctx context.Context, name string, kv ...interface{}) *Span {
+	t := current()
+	if t == nil {
+		return nil
+	}
+	parent := FromContext(ctx)
+	if parent == nil {
+		return nil
+	}
+	return t.start(parent, SpanContext{}, name, KindClient, kv)
+}
+
+func (t *Tracer) start(parent *Span, remote SpanContext, name string, kind Kind, kv []interface{}) *Span {
+	span := &Span{
+		tracer: t,
+		name:   name,
+		kind:   kind,
+		start:  time.Now(),
+		attrs:  make(map[string]interface{}, len(kv)/2),
+	}
+	switch {
+	case parent != nil:
+		span.context.TraceID = parent.context.TraceID
+		span.parentID = parent.context.SpanID
+	case remote.IsValid():
+		if !remote.Sampled {
+			return nil
+		}
+		span.context.TraceID = remote.TraceID
+		span.parentID = remote.SpanID
+	default:
+		if !t.sample() {
+			return nil
+		}
+		randomID(span.context.TraceID[:])
+	}
+	span.context.Sampled = true
+	randomID(span.context.SpanID[:])
+	span.SetAttr(kv...)
+	return span
+}
+
+func (t *Tracer) sample() bool {
+	switch {
+	case t.opts.SamplePercent >= 100:
+		return true
+	case t.opts.SamplePercent <= 0:
+		return false
+	}
+	return mrand.Int63n(100) < t.opts.SamplePercent
+}
+
+func (t *Tracer) enqueue(data *SpanData) {
+	select {
+	case t.queue <- data:
+	default:
+		droppedSpans.With().Inc()
+	}
+}
+
+//Flush 输出队列中的span
+func (t *Tracer) Flush(ctx context.Context) error {
+	done := make(chan struct{})
+	select {
+	case t.flush <- done:
+	case <-t.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+//Shutdown 输出队列中的span并停止输出任务
+func (t *Tracer) Shutdown(ctx context.Context) error {
+	t.once.Do(func() { close(t.stop) })
+	select {
+	case <-t.stopped:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (t *Tracer) run() {
+	defer close(t.stopped)
+	ticker := time.NewTicker(t.opts.FlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*SpanData, 0, t.opts.BatchSize)
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := t.opts.Exporter.Export(batch); err != nil {
+			logger.Warnf("export [%v] spans error:[%v]", len(batch), err)
+		}
+		batch = make([]*SpanData, 0, t.opts.BatchSize)
+	}
+	drain := func() {
+		for {
+			select {
+			case data := <-t.queue:
+				if batch = append(batch, data); len(batch) >= t.opts.BatchSize {
+					export()
+				}
+			default:
+				export()
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case data := <-t.queue:
+			if batch = append(batch, data); len(batch) >= t.opts.BatchSize {
+				export()
+			}
+		case <-ticker.C:
+			export()
+		case done := <-t.flush:
+			drain()
+			close(done)
+		case <-t.stop:
+			drain()
+			return
+		}
+	}
+}
+
+func randomID(buf []byte) {
+	if _, err := rand.Read(buf); err != nil {
+		mrand.Read(buf)
+	}
+}
@@ -0,0 +1,176 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordExporter struct {
+	mutex sync.Mutex
+	spans []*SpanData
+}
+
+func (e *recordExporter) Export(spans []*SpanData) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *recordExporter) byName() map[string]*SpanData {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	ret := make(map[string]*SpanData)
+	for _, span := range e.spans {
+		ret[span.Name] = span
+	}
+	return ret
+}
+
+func setupRecorder(samplePercent int64) *recordExporter {
+	exporter := &recordExporter{}
+	Setup(Options{Service: "test", Exporter: exporter, SamplePercent: samplePercent})
+	return exporter
+}
+
+func TestSpanTree(t *testing.T) {
+	exporter := setupRecorder(100)
+	defer Shutdown(context.Background())
+
+	ctx, root := StartServer(context.Background(), "GET /api/account/:address", "", "http.method", "GET")
+	queryCtx, query := Start(ctx, "service.QueryAccount")
+	mysql := StartClient(queryCtx, "mysql select")
+	mysql.SetError(errors.New("connection refused"))
+	mysql.End()
+	query.End()
+	_, child := Start(ctx, "price")
+	child.End()
+	root.SetAttr("http.status_code", 500)
+	root.End()
+
+	if span := StartClient(context.Background(), "mysql select"); span != nil {
+		t.Errorf("client span without parent should not be created")
+	}
+	if err := Flush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := exporter.byName()
+	if len(spans) != 4 {
+		t.Fatalf("spans:%v", len(spans))
+	}
+	rootData := spans["GET /api/account/:address"]
+	if rootData.Kind != KindServer || rootData.ParentID.IsValid() || rootData.Attributes["http.status_code"] != 500 {
+		t.Errorf("root:%+v", rootData)
+	}
+	for name, parent := range map[string]string{"service.QueryAccount": rootData.Name, "mysql select": "service.QueryAccount", "price": rootData.Name} {
+		if spans[name].TraceID != rootData.TraceID || spans[name].ParentID != spans[parent].SpanID {
+			t.Errorf("span [%v] should be child of [%v]", name, parent)
+		}
+	}
+	if spans["mysql select"].Kind != KindClient || spans["mysql select"].Error != "connection refused" {
+		t.Errorf("mysql:%+v", spans["mysql select"])
+	}
+}
+
+func TestSampling(t *testing.T) {
+	exporter := setupRecorder(0)
+	defer Shutdown(context.Background())
+
+	if _, span := StartServer(context.Background(), "not sampled", ""); span != nil {
+		t.Errorf("span should not be sampled")
+	}
+	if _, span := StartServer(context.Background(), "remote not sampled", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-00"); span != nil {
+		t.Errorf("span should follow remote sampled flag")
+	}
+	_, span := StartServer(context.Background(), "remote", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	if span == nil {
+		t.Fatal("span should follow remote sampled flag")
+	}
+	span.End()
+	Flush(context.Background())
+	data := exporter.byName()["remote"]
+	if data == nil || data.TraceID.String() != "4bf92f3577b34da6a3ce929d0e0e4736" || data.ParentID.String() != "00f067aa0ba902b7" {
+		t.Errorf("remote:%+v", data)
+	}
+
+	Setup(Options{})
+	if Enabled() || StartClient(context.Background(), "disabled") != nil {
+		t.Errorf("tracing should be disabled")
+	}
+}
+
+func TestParseTraceparent(t *testing.T) {
+	sc, err := ParseTraceparent("00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	if err != nil || !sc.Sampled || sc.Traceparent() != "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01" {
+		t.Errorf("parse:%+v %v", sc, err)
+	}
+	for _, header := range []string{
+		"",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7",
+		"00-00000000000000000000000000000000-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e473-00f067aa0ba902b7-01",
+		"00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01-extra",
+		"ff-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01",
+	} {
+		if _, err := ParseTraceparent(header); err == nil {
+			t.Errorf("[%v] should be invalid", header)
+		}
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var body []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/traces" {
+			t.Errorf("path:%v", r.URL.Path)
+		}
+		body, _ = ioutil.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	exporter, err := NewOTLPExporter(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Unix(1539100800, 0)
+	err = exporter.Export([]*SpanData{{
+		Service:    "explorer",
+		Name:       "mysql select",
+		Kind:       KindClient,
+		TraceID:    TraceID{1},
+		SpanID:     SpanID{2},
+		Start:      start,
+		End:        start.Add(time.Millisecond),
+		Attributes: map[string]interface{}{"db.rows": 3},
+		Error:      "timeout",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req := &otlpRequest{}
+	if err := json.Unmarshal(body, req); err != nil {
+		t.Fatal(err)
+	}
+	span := req.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if span.TraceID != "01000000000000000000000000000000" || span.ParentSpanID != "" || span.Kind != 3 ||
+		span.StartTimeUnixNano != "1539100800000000000" || span.Status == nil || span.Status.Code != 2 {
+		t.Errorf("span:%s", body)
+	}
+	if !bytes.Contains(body, []byte(`{"key":"service.name","value":{"stringValue":"explorer"}}`)) ||
+		!bytes.Contains(body, []byte(`{"key":"db.rows","value":{"intValue":"3"}}`)) {
+		t.Errorf("attributes:%s", body)
+	}
+
+	if _, err := NewExporter("zipkin", ""); err == nil {
+		t.Errorf("unknown exporter should fail")
+	}
+}
@@ -0,0 +1,28 @@
+package util
+
+import (
+	"net/http"
+)
+
+// GetHTTPStatus 根据错误码返回对应的HTTP状态码，未列出的错误码按服务器内部错误处理
+func GetHTTPStatus(errCode int) int {
+	switch errCode {
+	case Error_common_parameter_invalid, Error_common_json_object_nil, Error_common_request_json_convert_error,
+		Error_common_request_json_no_data, Error_common_not_suport_parameter, Error_common_object_name_duplicate,
+		Error_user_object_empty, Error_user_object_invalid, Error_user_passwd_invalid, Error_common_captcha_invalid:
+		return http.StatusBadRequest
+	case Error_user_token_invalid, Error_user_passwd_error:
+		return http.StatusUnauthorized
+	case Error_common_organization_different:
+		return http.StatusForbidden
+	case Error_common_no_data, Error_common_request_url_not_suport, Error_common_not_suport_request_url:
+		return http.StatusNotFound
+	case Error_common_data_exist, Error_common_add_exist_data, Error_user_object_exist, Error_user_role_exist, Error_common_task_running:
+		return http.StatusConflict
+	case Error_common_request_rate_limited, Error_common_request_quota_exceeded:
+		return http.StatusTooManyRequests
+	case Error_common_db_not_connected, Error_redis_not_connected, Error_common_budget_exhausted:
+		return http.StatusServiceUnavailable
+	}
+	return http.StatusInternalServerError
+}
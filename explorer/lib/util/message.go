@@ -3,6 +3,7 @@ package util
 import (
 	"fmt"
 	"strconv"
+	"strings"
 	"sync"
 )
 
@@ -22,6 +23,15 @@ const (
 // 错误码以及错误信息的对应字典
 var errorMessageMap map[int]string
 
+// 错误码以及英文错误信息的对应字典
+var errorMessageMapEn map[int]string
+
+//支持的错误信息语言
+const (
+	LangZh = "zh"
+	LangEn = "en"
+)
+
 //消息的锁
 var errorMessageLock sync.Mutex
 
@@ -51,6 +61,11 @@ const (
 	Error_common_not_suport_request_url     = Error_code_module_common + 15
 	Error_common_send_mail                  = Error_code_module_common + 16
 	Error_common_send_sms                   = Error_code_module_common + 17
+	Error_common_request_rate_limited       = Error_code_module_common + 18
+	Error_common_request_quota_exceeded     = Error_code_module_common + 19
+	Error_common_captcha_invalid            = Error_code_module_common + 20
+	Error_common_budget_exhausted           = Error_code_module_common + 21
+	Error_common_task_running               = Error_code_module_common + 22
 
 	Error_user_token_invalid  = Error_code_module_user + 1
 	Error_user_object_empty   = Error_code_module_user + 2
@@ -80,6 +95,48 @@ func GetErrorMsgSleek(errCode int) string {
 	return strMsg
 }
 
+//GetErrorMsgByLang 根据错误ID和语言返回错误信息，没有对应语言的信息时返回中文信息
+func GetErrorMsgByLang(errCode int, lang string) string {
+	if lang == LangEn {
+		if msg, ok := errorMessageMapEn[errCode]; ok {
+			return msg
+		}
+	}
+	return GetErrorMsgSleek(errCode)
+}
+
+//ParseAcceptLanguage 从 Accept-Language 请求头中选出支持的语言，按q值优先，默认英文
+//如 "zh-CN,zh;q=0.9,en;q=0.8" 返回 zh
+func ParseAcceptLanguage(header string) string {
+	lang, weight := LangEn, -1.0
+	for _, part := range strings.Split(header, ",") {
+		fields := strings.Split(strings.TrimSpace(part), ";")
+		tag := strings.ToLower(strings.TrimSpace(fields[0]))
+		q := 1.0
+		for _, param := range fields[1:] {
+			param = strings.TrimSpace(param)
+			if strings.HasPrefix(param, "q=") {
+				if v, err := strconv.ParseFloat(param[2:], 64); err == nil {
+					q = v
+				}
+			}
+		}
+		var candidate string
+		switch {
+		case tag == LangZh || strings.HasPrefix(tag, LangZh+"-"):
+			candidate = LangZh
+		case tag == LangEn || strings.HasPrefix(tag, LangEn+"-"):
+			candidate = LangEn
+		default:
+			continue
+		}
+		if q > weight {
+			lang, weight = candidate, q
+		}
+	}
+	return lang
+}
+
 //GetErrorMessages 返回所有的消息
 func GetErrorMessages() map[int]string {
 	return errorMessageMap
@@ -0,0 +1,55 @@
+package util
+
+import (
+	"net/http"
+	"testing"
+)
+
+func TestParseAcceptLanguage(t *testing.T) {
+	cases := map[string]string{
+		"":                           LangEn,
+		"zh-CN,zh;q=0.9,en;q=0.8":    LangZh,
+		"en-US,en;q=0.9,zh-CN;q=0.8": LangEn,
+		"fr-FR, zh;q=0.5, en;q=0.4":  LangZh,
+		"en;q=0.3, zh-TW;q=0.7":      LangZh,
+		"de-DE":                      LangEn,
+	}
+	for header, want := range cases {
+		if got := ParseAcceptLanguage(header); got != want {
+			t.Errorf("ParseAcceptLanguage(%q) = %v, want %v", header, got, want)
+		}
+	}
+}
+
+func TestErrorMsgEnComplete(t *testing.T) {
+	for code := range errorMessageMap {
+		if _, ok := errorMessageMapEn[code]; !ok {
+			t.Errorf("error code %v has no english message", code)
+		}
+	}
+	if GetErrorMsgByLang(Error_common_no_data, LangEn) != "No matching data" {
+		t.Errorf("english message not used")
+	}
+	if GetErrorMsgByLang(Error_common_no_data, LangZh) != "没有匹配的数据" {
+		t.Errorf("chinese message not used")
+	}
+}
+
+func TestGetHTTPStatus(t *testing.T) {
+	cases := map[int]int{
+		Error_common_parameter_invalid:    http.StatusBadRequest,
+		Error_common_no_data:              http.StatusNotFound,
+		Error_user_token_invalid:          http.StatusUnauthorized,
+		Error_common_request_rate_limited: http.StatusTooManyRequests,
+		Error_common_captcha_invalid:      http.StatusBadRequest,
+		Error_common_budget_exhausted:     http.StatusServiceUnavailable,
+		Error_common_task_running:         http.StatusConflict,
+		Error_common_internal_error:       http.StatusInternalServerError,
+		-1:                                http.StatusInternalServerError,
+	}
+	for code, want := range cases {
+		if got := GetHTTPStatus(code); got != want {
+			t.Errorf("GetHTTPStatus(%v) = %v, want %v", code, got, want)
+		}
+	}
+}
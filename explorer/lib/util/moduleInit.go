@@ -31,6 +31,11 @@ func initErrorMsg() {
 	errorMessageMap[Error_common_not_suport_request_url] = "不支持该请求接口"
 	errorMessageMap[Error_common_send_mail] = "发送邮件失败"
 	errorMessageMap[Error_common_send_sms] = "发送短消息失败"
+	errorMessageMap[Error_common_request_rate_limited] = "请求过于频繁，请稍后再试"
+	errorMessageMap[Error_common_request_quota_exceeded] = "已超出当日请求额度"
+	errorMessageMap[Error_common_captcha_invalid] = "人机验证失败"
+	errorMessageMap[Error_common_budget_exhausted] = "今日额度已用完，请明天再试"
+	errorMessageMap[Error_common_task_running] = "任务正在执行，请稍后再试"
 
 	//user
 	errorMessageMap[Error_user_token_invalid] = "用户登录标识无效"
@@ -46,4 +51,41 @@ func initErrorMsg() {
 	errorMessageMap[Error_redis_data_invalid] = "redis中数据不合法数据"
 	errorMessageMap[Error_redis_read_write_error] = "读写redis时出错"
 
+	//english
+	errorMessageMapEn = make(map[int]string, 100)
+	errorMessageMapEn[Error_common_failure] = "Operation failed"
+	errorMessageMapEn[Error_common_no_data] = "No matching data"
+	errorMessageMapEn[Error_common_db_not_connected] = "Database connection failed"
+	errorMessageMapEn[Error_common_internal_error] = "Internal server error"
+	errorMessageMapEn[Error_common_parameter_invalid] = "Invalid parameter"
+	errorMessageMapEn[Error_common_json_object_nil] = "JSON object is empty"
+	errorMessageMapEn[Error_common_request_json_convert_error] = "Request body is not valid JSON"
+	errorMessageMapEn[Error_common_request_json_no_data] = "Request body has no data"
+	errorMessageMapEn[Error_common_request_url_not_suport] = "Unrecognized or unsupported URL"
+	errorMessageMapEn[Error_common_data_exist] = "Object already exists"
+	errorMessageMapEn[Error_common_add_exist_data] = "Cannot add an object that already exists"
+	errorMessageMapEn[Error_common_organization_different] = "Cannot operate on data of another account"
+	errorMessageMapEn[Error_common_object_name_duplicate] = "Object name must be unique"
+	errorMessageMapEn[Error_common_not_suport_parameter] = "Unsupported request parameter"
+	errorMessageMapEn[Error_common_not_suport_request_url] = "Unsupported API"
+	errorMessageMapEn[Error_common_send_mail] = "Failed to send mail"
+	errorMessageMapEn[Error_common_send_sms] = "Failed to send SMS"
+	errorMessageMapEn[Error_common_request_rate_limited] = "Too many requests, please retry later"
+	errorMessageMapEn[Error_common_request_quota_exceeded] = "Daily request quota exceeded"
+	errorMessageMapEn[Error_common_captcha_invalid] = "Captcha verification failed"
+	errorMessageMapEn[Error_common_budget_exhausted] = "Daily budget exhausted, please retry tomorrow"
+	errorMessageMapEn[Error_common_task_running] = "Task is already running, please retry later"
+
+	errorMessageMapEn[Error_user_token_invalid] = "Invalid credentials"
+	errorMessageMapEn[Error_user_object_empty] = "User information is empty"
+	errorMessageMapEn[Error_user_object_invalid] = "User information contains invalid data"
+	errorMessageMapEn[Error_user_object_exist] = "User already exists"
+	errorMessageMapEn[Error_user_passwd_error] = "Wrong password"
+	errorMessageMapEn[Error_user_passwd_invalid] = "Password does not meet the security policy"
+	errorMessageMapEn[Error_user_role_exist] = "Role already exists"
+
+	errorMessageMapEn[Error_redis_not_connected] = "Redis connection lost"
+	errorMessageMapEn[Error_redis_data_invalid] = "Invalid data in redis"
+	errorMessageMapEn[Error_redis_read_write_error] = "Redis read/write error"
+
 }
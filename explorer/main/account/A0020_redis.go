@@ -22,7 +22,7 @@ func initRedis(redisAddr []string) {
 	_redisCli = redis.NewClient(redisOpt)
 
 	pong, err := _redisCli.Ping().Result()
-	fmt.Printf("redis ping ret:%v, err:%v\n", pong, err)
+	logger.Infof("redis ping ret:%v, err:%v", pong, err)
 }
 
 // redis error ...
@@ -0,0 +1,28 @@
+package main
+
+import (
+	"sync/atomic"
+
+	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/lib/health"
+	"github.com/wlcy/tron/explorer/lib/metrics"
+)
+
+//registerHealthChecks 就绪检查：mysql、redis、至少一个可用的full node，已分析的块落后db中的最新块不超过maxSyncLag
+//	检查接口和 /metrics 在同一个端口
+func registerHealthChecks(maxSyncLag int64) {
+	health.Register("mysql", func() (interface{}, error) {
+		return nil, getMysqlDB().Ping()
+	})
+	health.Register("redis", func() (interface{}, error) {
+		return nil, getRedisClient().Ping().Err()
+	})
+	health.Register("node", func() (interface{}, error) {
+		endpoint, err := grpcclient.PingFullNode()
+		return map[string]string{"endpoint": endpoint}, err
+	})
+	health.Register("sync", func() (interface{}, error) {
+		return health.SyncLag(atomic.LoadInt64(&analyzedBlockID), atomic.LoadInt64(&dbBlockID), maxSyncLag)
+	})
+	health.RegisterHandlers(metrics.ServeMux)
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"sync"
 
 	"github.com/tronprotocol/grpc-gateway/core"
@@ -72,7 +71,7 @@ func anaylzeTransaction(trx *transaction) {
 		case *core.ExchangeWithdrawContract:
 		case *core.ExchangeTransactionContract:
 		default:
-			fmt.Printf("new type:%T-->%v\n", v, v)
+			logger.Warnf("new type:%T-->%v", v, v)
 		}
 	}
 
@@ -1,8 +1,6 @@
 package main
 
 import (
-	"fmt"
-
 	"github.com/tronprotocol/grpc-gateway/core"
 	"github.com/wlcy/tron/explorer/core/utils"
 )
@@ -20,7 +18,7 @@ func loadTransFromDB(blockIDs []int64) []*transaction {
 
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("load transaction from db create transaction failed:%v\n", err)
+		logger.Errorf("load transaction from db create transaction failed:%v", err)
 		return nil
 	}
 	/*
@@ -47,7 +45,7 @@ func loadTransFromDB(blockIDs []int64) []*transaction {
 	sqlstr := "select trx_hash, block_id, contract_type, contract_data, result_data, create_time from transactions where block_id = ?"
 	stmt, err := txn.Prepare(sqlstr)
 	if nil != err {
-		fmt.Printf("prepare store transaction SQL failed:%v\n", err)
+		logger.Errorf("prepare store transaction SQL failed:%v", err)
 		return nil
 	}
 	defer stmt.Close()
@@ -60,7 +58,7 @@ func loadTransFromDB(blockIDs []int64) []*transaction {
 		rows, err := stmt.Query(id)
 
 		if err != nil {
-			fmt.Printf("ERROR: load transaction (block_id:%v) failed:%v\n", id, err)
+			logger.With("blockID", id).Errorf("load transaction failed:%v", err)
 			failedBlockIDs = append(failedBlockIDs, id)
 			if nil != rows {
 				rows.Close()
@@ -73,7 +71,7 @@ func loadTransFromDB(blockIDs []int64) []*transaction {
 
 			err := rows.Scan(&trx.hash, &trx.blockID, &trx.ctxType, &trx.ctxData, &trx.resultData, &trx.createTime)
 			if nil != err {
-				fmt.Printf("scan transaction failed:%v\n", err)
+				logger.Errorf("scan transaction failed:%v", err)
 			}
 			trxList = append(trxList, trx)
 		}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"database/sql"
-	"fmt"
 	"sync"
 	"time"
 
@@ -160,12 +159,12 @@ func getAccount(addrs []string) ([]*account, []string, error) {
 	result = append(result, accountList...)
 	badAddr = append(badAddr, bad...)
 	lock.Unlock()
-	fmt.Printf("***** main routine, working task:%v, current account result count:%v, badAddr:%v, waitCnt:%v\n", workingTaskCnt(), len(result), len(badAddr), waitCnt)
+	logger.Infof("***** main routine, working task:%v, current account result count:%v, badAddr:%v, waitCnt:%v", workingTaskCnt(), len(result), len(badAddr), waitCnt)
 
 	for {
 		workCnt := workingTaskCnt()
 		lock.Lock()
-		fmt.Printf("***** main routine, working task:%v, current account result count:%v (total:%v), badAddr:%v, waitCnt:%v\n", workCnt, len(result), totalTask, len(badAddr), waitCnt)
+		logger.Infof("***** main routine, working task:%v, current account result count:%v (total:%v), badAddr:%v, waitCnt:%v", workCnt, len(result), totalTask, len(badAddr), waitCnt)
 		lock.Unlock()
 
 		if workCnt == 1 {
@@ -225,7 +224,7 @@ func getAccountNet(accc []*account, process *int64, lock *sync.Mutex) {
 
 	lock.Lock()
 	*process = *process + int64(len(accc)-len(restAcc))
-	fmt.Printf("submit accountNet count:%v, current account result count:%v, restAddr:%v, error count:%v, cost:%v\n", len(accc)-len(restAcc), *process, len(restAcc), errCnt, time.Since(ts))
+	logger.Infof("submit accountNet count:%v, current account result count:%v, restAddr:%v, error count:%v, cost:%v", len(accc)-len(restAcc), *process, len(restAcc), errCnt, time.Since(ts))
 	lock.Unlock()
 
 	waitCnt := 3
@@ -233,7 +232,7 @@ func getAccountNet(accc []*account, process *int64, lock *sync.Mutex) {
 	for {
 		workCnt := workingTaskCnt()
 		lock.Lock()
-		fmt.Printf("***** main routine for accountNet, working task:%v, current accountNet result count:%v (total:%v), waitCnt:%v\n", workCnt, *process, totalTask, waitCnt)
+		logger.Infof("***** main routine for accountNet, working task:%v, current accountNet result count:%v (total:%v), waitCnt:%v", workCnt, *process, totalTask, waitCnt)
 		lock.Unlock()
 
 		if workCnt == 1 {
@@ -283,7 +282,7 @@ func getAccountNetF(accc []*account, process *int64, lock *sync.Mutex) {
 
 	lock.Lock()
 	*process = *process + int64(len(accc)-len(restAcc))
-	fmt.Printf("submit accountNet count:%v, current account result count:%v, restAddr:%v, error count:%v, cost:%v\n", len(accc)-len(restAcc), *process, len(restAcc), errCnt, time.Since(ts))
+	logger.Infof("submit accountNet count:%v, current account result count:%v, restAddr:%v, error count:%v, cost:%v", len(accc)-len(restAcc), *process, len(restAcc), errCnt, time.Since(ts))
 	lock.Unlock()
 	if len(restAcc) > 0 {
 		go getAccountNetF(restAcc, process, lock)
@@ -352,7 +351,7 @@ func getAcoountF(addrs []string, result *[]*account, badAddr *[]string, lock *sy
 	lock.Lock()
 	*result = append(*result, accountList...)
 	*badAddr = append(*badAddr, bad...)
-	fmt.Printf("submit account count:%v, current account result count:%v, badAddr:%v, resetAddr:%v, error count:%v, cost:%v\n", len(accountList), len(*result), len(*badAddr), len(restAddr), errCnt, time.Since(ts))
+	logger.Infof("submit account count:%v, current account result count:%v, badAddr:%v, resetAddr:%v, error count:%v, cost:%v", len(accountList), len(*result), len(*badAddr), len(restAddr), errCnt, time.Since(ts))
 	lock.Unlock()
 	if len(restAddr) > 0 {
 		go getAcoountF(restAddr, result, badAddr, lock, wg)
@@ -372,7 +371,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 	ts := time.Now()
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("get db failed:%v\n", err)
+		logger.Errorf("get db failed:%v", err)
 		return false
 	}
 	/*
@@ -404,7 +403,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 			?, ?, ?, ?, ?, ?, ?)`
 	stmtI, err := txn.Prepare(sqlI)
 	if nil != err {
-		fmt.Printf("prepare insert account SQL failed:%v\n", err)
+		logger.Errorf("prepare insert account SQL failed:%v", err)
 		return false
 	}
 	defer stmtI.Close()
@@ -415,7 +414,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 		where address = ?`
 	stmtU, err := txn.Prepare(sqlU)
 	if nil != err {
-		fmt.Printf("prepare update account SQL failed:%v\n", err)
+		logger.Errorf("prepare update account SQL failed:%v", err)
 		return false
 	}
 	defer stmtU.Close()
@@ -423,7 +422,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 	sqlBI := "insert into account_asset_balance (address, token_name, balance) values (?, ?, ?)"
 	stmtBI, err := txn.Prepare(sqlBI)
 	if nil != err {
-		fmt.Printf("prepare insert account_asset_balance SQL failed:%v\n", err)
+		logger.Errorf("prepare insert account_asset_balance SQL failed:%v", err)
 		return false
 	}
 	defer stmtBI.Close()
@@ -431,7 +430,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 	sqlVI := "insert into account_vote_result (address, to_address, vote) values (?, ?, ?)"
 	stmtVI, err := txn.Prepare(sqlVI)
 	if nil != err {
-		fmt.Printf("prepare insert account_vote_result SQL failed:%v\n", err)
+		logger.Errorf("prepare insert account_vote_result SQL failed:%v", err)
 		return false
 	}
 	defer stmtVI.Close()
@@ -515,7 +514,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 		for k, v := range acc.AssetBalance {
 			_, err := stmtBI.Exec(acc.Addr, k, v)
 			if nil != err {
-				fmt.Printf("insert account_asset_balance failed:%v\n", err)
+				logger.Errorf("insert account_asset_balance failed:%v", err)
 			}
 		}
 
@@ -524,7 +523,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 		for _, vote := range acc.raw.Votes {
 			_, err := stmtVI.Exec(acc.Addr, utils.Base58EncodeAddr(vote.VoteAddress), vote.VoteCount)
 			if nil != err {
-				fmt.Printf("insert account_asset_balance failed:%v\n", err)
+				logger.Errorf("insert account_asset_balance failed:%v", err)
 			}
 		}
 
@@ -532,10 +531,10 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 
 	err = txn.Commit()
 	if err != nil {
-		fmt.Printf("connit block failed:%v\n", err)
+		logger.Errorf("connit block failed:%v", err)
 		return false
 	}
-	fmt.Printf("store account OK, cost:%v, insertCnt:%v, updateCnt:%v, errCnt:%v, total source:%v\n", time.Since(ts), insertCnt, updateCnt, errCnt, len(accountList))
+	logger.Infof("store account OK, cost:%v, insertCnt:%v, updateCnt:%v, errCnt:%v, total source:%v", time.Since(ts), insertCnt, updateCnt, errCnt, len(accountList))
 
 	return true
 }
@@ -546,14 +545,14 @@ func updateTrxOwner(trxList []*transaction) bool {
 	ts := time.Now()
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("get db failed:%v\n", err)
+		logger.Errorf("get db failed:%v", err)
 		return false
 	}
 
 	sqlU := "update transactions set owner_address = ? where block_id = ? and trx_hash = ?"
 	stmt, err := txn.Prepare(sqlU)
 	if nil != err {
-		fmt.Printf("prepare update transaction owner address SQL failed:%v\n", err)
+		logger.Errorf("prepare update transaction owner address SQL failed:%v", err)
 		return false
 	}
 	defer stmt.Close()
@@ -563,16 +562,16 @@ func updateTrxOwner(trxList []*transaction) bool {
 		_, err := stmt.Exec(trx.ownerAddr, trx.blockID, trx.hash)
 
 		if nil != err {
-			fmt.Printf("update transaction owner failed:%v, blockID:%v, trx hash:[%v]\n", err, trx.blockID, trx.hash)
+			logger.With("trx_hash", trx.hash, "blockID", trx.blockID).Errorf("update transaction owner failed:%v", err)
 		}
 	}
 
 	err = txn.Commit()
 	if nil != err {
-		fmt.Printf("commit update transaction owner failed:%v\n", err)
+		logger.Errorf("commit update transaction owner failed:%v", err)
 		return false
 	}
-	fmt.Printf("update transaction owner count:%v, cost:%v\n", len(trxList), time.Since(ts))
+	logger.Infof("update transaction owner count:%v, cost:%v", len(trxList), time.Since(ts))
 
 	return true
 
@@ -583,13 +582,13 @@ func getDBMaxBlockID() int64 {
 
 	txn, err := dbb.Begin()
 	if nil != err {
-		fmt.Printf("start db transaction failed:%v\n", err)
+		logger.Errorf("start db transaction failed:%v", err)
 		return 10000000
 	}
 
 	row, err := txn.Query("select max(block_id) from blocks")
 	if nil != err {
-		fmt.Printf("getDBMaxBlockID failed:%v, return 10000000 as default!\n", err)
+		logger.Errorf("getDBMaxBlockID failed:%v, return 10000000 as default!", err)
 		if nil != row {
 			row.Close()
 		}
@@ -1,25 +1,26 @@
 package main
 
 import (
-	"fmt"
+	"context"
 	"time"
 
 	"github.com/tronprotocol/grpc-gateway/core"
 	"github.com/wlcy/tron/explorer/core/grpcclient"
 	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 )
 
-func startWintnessDaemon() {
-	go func() {
-		for {
-			if witnessList, ok := getWitness(); ok {
-				storeWitness(witnessList)
-				time.Sleep(30 * time.Second)
-			} else {
-				time.Sleep(1 * time.Second)
-			}
+func startWintnessDaemon(ctx context.Context) {
+	for {
+		interval := time.Second
+		if witnessList, ok := getWitness(); ok {
+			storeWitness(witnessList)
+			interval = 30 * time.Second
 		}
-	}()
+		if !lifecycle.Wait(ctx, interval) {
+			return
+		}
+	}
 }
 func getWitness() ([]*core.Witness, bool) {
 	client := grpcclient.GetRandomSolidity()
@@ -41,7 +42,7 @@ func storeWitness(witnessList []*core.Witness) (iCnt int64, uCnt int64, eCnt int
 
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("get db failed:%v\n", err)
+		logger.Errorf("get db failed:%v", err)
 		return
 	}
 	/*
@@ -63,7 +64,7 @@ func storeWitness(witnessList []*core.Witness) (iCnt int64, uCnt int64, eCnt int
 	sqlI := "insert into witness (address, vote_count, public_key, url, total_produced, total_missed, latest_block_num, latest_slot_num, is_job) values (?, ?, ?, ?, ?, ?, ?, ?, ?)"
 	stmtI, err := txn.Prepare(sqlI)
 	if nil != err {
-		fmt.Printf("prepare insert witness failed:%v\n", err)
+		logger.Errorf("prepare insert witness failed:%v", err)
 		return
 	}
 	defer stmtI.Close()
@@ -71,7 +72,7 @@ func storeWitness(witnessList []*core.Witness) (iCnt int64, uCnt int64, eCnt int
 	sqlU := "update witness set vote_count = ?, public_key = ?, url = ?, total_produced = ?, total_missed = ?, latest_block_num = ?, latest_slot_num = ?, is_job = ? where address = ?"
 	stmtU, err := txn.Prepare(sqlU)
 	if nil != err {
-		fmt.Printf("prepare update witness failed:%v\n", err)
+		logger.Errorf("prepare update witness failed:%v", err)
 		return
 	}
 	defer stmtU.Close()
@@ -1,9 +1,17 @@
 package main
 
 import (
+	"context"
 	"flag"
-	"fmt"
+	"os"
+	"sync/atomic"
 	"time"
+
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/metrics"
 )
 
 var gIntMaxWorker = flag.Int("worker", 30, "maximum worker for fetch blocks")
@@ -13,9 +21,56 @@ var gMaxBlockID = flag.Int64("max_block_id", 0, "max block num, 0 for current bl
 var gMinBlockID = flag.Int64("start_block", 2200000, "block num start to analyze")
 var gMaxErrCntPerNode = flag.Int("max_err_per_node", 10, "max error before we try to other node")
 var gMaxAccountWorkload = flag.Int("max_account_workload", 200, "max account a node need handle not fork new worker")
+var gLogLevel = flag.String("log_level", "info", "log level")
+var gConfigFile = flag.String("cfgfile", "", "config file for network profiles, default use built-in networks")
+var gNetType = flag.String("net_type", "", "network to synchronize, e.g. mainnet, testnet or a [network.name] in cfgfile, default is common.netType in cfgfile")
+var gLogFormat = flag.String("log_format", "console", "log format, console or json")
+var gLogFile = flag.String("log_file", "", "log file, default is stderr")
+var gLogMaxSize = flag.Int64("log_max_size", 512, "rotate log file when size exceeds, in MB")
+var gLogMaxAge = flag.Int("log_max_age", 7, "days to keep rotated log files, 0 means forever")
+var gLogMaxBackups = flag.Int("log_max_backups", 50, "max count of rotated log files, 0 means no limit")
+var gLogPackages = flag.String("log_packages", "", "log level override by logger name, e.g. \"account=debug\"")
+var gMetricsAddr = flag.String("metrics_addr", ":20121", "listen address for prometheus /metrics and /healthz, /readyz, empty to disable")
+var gShutdownTimeout = flag.Duration("shutdown_timeout", 5*time.Minute, "max time to wait for the analyzing block range to finish after SIGTERM")
+var gMaxSyncLag = flag.Int64("max_sync_lag", 100, "/readyz fails when synchronized block is behind node more than max_sync_lag blocks")
+
+var logger = log.Named("account")
+
+var (
+	syncHeight           = metrics.NewGaugeVec("explorer_sync_height", "Block height of analyzed accounts and of synchronized blocks in db.", "source")
+	analyzedTransactions = metrics.NewCounterVec("explorer_ingest_transactions_total", "Transactions analyzed for account changes.", "result")
+)
+
+//已分析的块和db中的最新块，用于就绪检查
+var analyzedBlockID, dbBlockID int64
+
+func updateSyncHeight(analyzed, db int64) {
+	atomic.StoreInt64(&analyzedBlockID, analyzed)
+	atomic.StoreInt64(&dbBlockID, db)
+	syncHeight.With("synced").Set(float64(analyzed))
+	syncHeight.With("db").Set(float64(db))
+}
 
 func main() {
 	flag.Parse()
+	if err := log.Setup(*gLogLevel, *gLogFormat, *gLogPackages); err != nil {
+		logger.Fatalf("init log failed:%v", err)
+	}
+	if *gLogFile != "" {
+		_, err := log.StartRotate(&log.RotateOptions{Filename: *gLogFile, MaxSize: *gLogMaxSize << 20, Interval: 24 * time.Hour,
+			MaxAge: time.Duration(*gLogMaxAge) * 24 * time.Hour, MaxBackups: *gLogMaxBackups, Compress: true})
+		if err != nil {
+			logger.Fatalf("open log file failed:%v", err)
+		}
+	}
+	overrides := make([]string, 0)
+	if *gNetType != "" {
+		overrides = append(overrides, "common.netType="+*gNetType)
+	}
+	if err := config.SetupNetwork(*gConfigFile, overrides...); err != nil {
+		logger.Fatalf("init network failed:%v", err)
+	}
+	logger.Infof("synchronize network [%v]", utils.CurrentNetwork().Name)
 
 	trxBulkBlockNum = *gInt64MaxWorkload
 	maxErrCnt = *gMaxErrCntPerNode
@@ -23,30 +78,42 @@ func main() {
 
 	initDB(*gStrMysqlDSN)
 	initRedis([]string{"127.0.0.1:6379"})
+	registerHealthChecks(*gMaxSyncLag)
+	metrics.Serve(*gMetricsAddr)
 
 	initWorkerChan()
 
-	startWintnessDaemon()
+	lifecycle.Go("witness", startWintnessDaemon)
+	lifecycle.Go("analyze", analyzeBlocks)
+	code := lifecycle.Run(*gShutdownTimeout)
 
+	logger.Infof("account QUIT, exit code:%v", code)
+	os.Exit(code)
+}
+
+//analyzeBlocks 分析块中的交易并更新账户，收到退出信号后处理完当前的块范围再退出
+func analyzeBlocks(ctx context.Context) {
 	if -1 == *gMaxBlockID {
 		b := *gMinBlockID
 		e := getDBMaxBlockID()
-		for {
-			fmt.Printf("Start account analyze for block range [%v] ~ [%v]\n", b, e)
+		updateSyncHeight(b, e)
+		for ctx.Err() == nil {
+			logger.Infof("Start account analyze for block range [%v] ~ [%v]", b, e)
 			ts := time.Now()
 			analyzeTrx(b, e)
 			tsCost := time.Since(ts)
-			if tsCost < time.Second*10 {
-				time.Sleep(10*time.Second - tsCost)
+			if tsCost < time.Second*10 && !lifecycle.Wait(ctx, 10*time.Second-tsCost) {
+				break
 			}
 			b = e
 			e = getDBMaxBlockID()
+			updateSyncHeight(b, e)
 		}
 	} else {
-		fmt.Printf("Start account analyze for block range [%v] ~ [%v]\n", *gMinBlockID, *gMaxBlockID)
+		logger.Infof("Start account analyze for block range [%v] ~ [%v]", *gMinBlockID, *gMaxBlockID)
 		analyzeTrx(*gMinBlockID, *gMaxBlockID)
 	}
-
+	lifecycle.Shutdown()
 }
 
 var trxBulkBlockNum = int64(10000)
@@ -67,7 +134,7 @@ func analyzeTrx(b, e int64) {
 
 	blockIDs := genVerifyBlockIDList(b, e) // 7408
 	trxList := loadTransFromDB(blockIDs)
-	fmt.Printf("block range:[%v] ~ [%v] (%v blocks), load %v trans cost:%v\n", b, e, e-b, len(trxList), time.Since(ts))
+	logger.Infof("block range:[%v] ~ [%v] (%v blocks), load %v trans cost:%v", b, e, e-b, len(trxList), time.Since(ts))
 
 	if len(trxList) == 0 {
 		stopWorker()
@@ -78,12 +145,13 @@ func analyzeTrx(b, e int64) {
 		trx.ExtractContract()
 		anaylzeTransaction(trx)
 	}
+	analyzedTransactions.With("ok").Add(float64(len(trxList)))
 	// updateTrxOwner(trxList)
 
 	waitCnt := 3
 	for {
 		workerCnt := workingTaskCnt()
-		fmt.Printf("main routine, working task:%v, waitCnt:%v\n", workerCnt, waitCnt)
+		logger.Infof("main routine, working task:%v, waitCnt:%v", workerCnt, waitCnt)
 		if workerCnt == 1 {
 			waitCnt--
 			if waitCnt <= 0 {
@@ -97,13 +165,13 @@ func analyzeTrx(b, e int64) {
 	stopWorker()
 
 	list, err := ClearRefreshAddress() // load all address from redis and prepare handle it
-	fmt.Printf("total account:%v, err:%v\n", len(list), err)
+	logger.Infof("total account:%v, err:%v", len(list), err)
 
 	accList, restAddr, _ := getAccount(list)
-	fmt.Printf("total account:%v, rest address:%v, cost:%v, synchronize to db .....\n", len(accList), len(restAddr), time.Since(ts))
+	logger.Infof("total account:%v, rest address:%v, cost:%v, synchronize to db .....", len(accList), len(restAddr), time.Since(ts))
 	ts = time.Now()
 	storeAccount(accList, nil)
-	fmt.Printf("accList size:%v, restAddr size:%v, synchronze to DB cost:%v\n", len(accList), len(restAddr), time.Since(ts))
+	logger.Infof("accList size:%v, restAddr size:%v, synchronze to DB cost:%v", len(accList), len(restAddr), time.Since(ts))
 
 }
 
@@ -118,7 +186,7 @@ func analyzeTrxFrk(b, e int64) {
 	ts := time.Now()
 	blockIDs := genVerifyBlockIDList(b, e)
 	trxList := loadTransFromDB(blockIDs)
-	fmt.Printf("block range:[%v] ~ [%v] (%v blocks), load %v trans cost:%v\n", b, e, e-b, len(trxList), time.Since(ts))
+	logger.Infof("block range:[%v] ~ [%v] (%v blocks), load %v trans cost:%v", b, e, e-b, len(trxList), time.Since(ts))
 
 	if len(trxList) == 0 {
 		stopWorker()
@@ -129,6 +197,7 @@ func analyzeTrxFrk(b, e int64) {
 		trx.ExtractContract()
 		anaylzeTransaction(trx)
 	}
+	analyzedTransactions.With("ok").Add(float64(len(trxList)))
 	// updateTrxOwner(trxList)
 
 	stopWorker()
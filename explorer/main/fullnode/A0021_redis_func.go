@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"sync"
 	"time"
 )
@@ -33,7 +32,7 @@ func AddRefreshAddress(addrs ...interface{}) (newLen int, err error) {
 			curAddrs, err := redisSADD(_refresgAddrBuffer)
 			if nil != err {
 				_ = curAddrs
-				fmt.Printf("push account address to redis failed:%v\n", err)
+				logger.Errorf("push account address to redis failed:%v", err)
 			}
 
 			// fmt.Printf("push %v address to redis for later synchronize, address count:%v, err:%v\n", bufLen, curAddrs, err)
@@ -60,7 +59,7 @@ func startRedisAccountRefreshPush() {
 				// fmt.Printf("push %v address to redis for later synchronize, address count:%v, err:%v\n", bufLen, curAddrs, err)
 				if nil != err {
 					_ = curAddrs
-					fmt.Printf("push account address to redis failed:%v\n", err)
+					logger.Errorf("push account address to redis failed:%v", err)
 				} else {
 					_refresgAddrBuffer = _refresgAddrBuffer[:0]
 				}
@@ -74,7 +73,7 @@ func startRedisAccountRefreshPush() {
 			}
 		}
 		cleanAccountBuffer()
-		fmt.Printf("Redis Account Refresh Push Daemon QUIT\n")
+		logger.Infof("Redis Account Refresh Push Daemon QUIT")
 	}()
 }
 
@@ -1,14 +1,17 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"os"
-	"os/signal"
 	"sync"
-	"syscall"
 	"time"
 
-	"fmt"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/metrics"
 
 	_ "github.com/go-sql-driver/mysql"
 )
@@ -22,30 +25,26 @@ var gRedisDSN = flag.String("redisDSN", "127.0.0.1:6379", "redis DSN")
 var gMaxErrCntPerNode = flag.Int("max_err_per_node", 10, "max error before we try to other node")
 var gMaxAccountWorkload = flag.Int("max_account_workload", 200, "max account a node need handle not fork new worker")
 var gIntHandleAccountInterval = flag.Int("account_handle_interval", 30, "account info synchronize handle minmum interval in seconds")
+var gLogLevel = flag.String("log_level", "info", "log level")
+var gConfigFile = flag.String("cfgfile", "", "config file for network profiles, default use built-in networks")
+var gNetType = flag.String("net_type", "", "network to synchronize, e.g. mainnet, testnet or a [network.name] in cfgfile, default is common.netType in cfgfile")
+var gLogFormat = flag.String("log_format", "console", "log format, console or json")
+var gLogFile = flag.String("log_file", "", "log file, default is stderr")
+var gLogMaxSize = flag.Int64("log_max_size", 512, "rotate log file when size exceeds, in MB")
+var gLogMaxAge = flag.Int("log_max_age", 7, "days to keep rotated log files, 0 means forever")
+var gLogMaxBackups = flag.Int("log_max_backups", 50, "max count of rotated log files, 0 means no limit")
+var gLogPackages = flag.String("log_packages", "", "log level override by logger name, e.g. \"fullnode=debug\"")
+var gMetricsAddr = flag.String("metrics_addr", ":20120", "listen address for prometheus /metrics and /healthz, /readyz, empty to disable")
+var gShutdownTimeout = flag.Duration("shutdown_timeout", time.Minute, "max time to wait for workers to store fetched blocks after SIGTERM")
+var gMaxSyncLag = flag.Int64("max_sync_lag", 100, "/readyz fails when synchronized block is behind node more than max_sync_lag blocks")
+
+var logger = log.Named("fullnode")
 
-var quit = make(chan struct{}) // quit signal channel
 var wg sync.WaitGroup
 
-func signalHandle() {
-	sigs := make(chan os.Signal, 1)
-
-	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
-
-	go func() {
-		<-sigs
-		if !needQuit() {
-			close(quit)
-		}
-	}()
-}
-
+//needQuit 收到退出信号或块同步结束后，各daemon写完缓存的数据后退出
 func needQuit() bool {
-	select {
-	case <-quit:
-		return true
-	default:
-		return false
-	}
+	return lifecycle.Context().Err() != nil
 }
 
 func startDaemon() {
@@ -58,32 +57,72 @@ func startDaemon() {
 
 func main() {
 	flag.Parse()
+	if err := log.Setup(*gLogLevel, *gLogFormat, *gLogPackages); err != nil {
+		logger.Fatalf("init log failed:%v", err)
+	}
+	if *gLogFile != "" {
+		_, err := log.StartRotate(&log.RotateOptions{Filename: *gLogFile, MaxSize: *gLogMaxSize << 20, Interval: 24 * time.Hour,
+			MaxAge: time.Duration(*gLogMaxAge) * 24 * time.Hour, MaxBackups: *gLogMaxBackups, Compress: true})
+		if err != nil {
+			logger.Fatalf("open log file failed:%v", err)
+		}
+	}
+	overrides := make([]string, 0)
+	if *gNetType != "" {
+		overrides = append(overrides, "common.netType="+*gNetType)
+	}
+	if err := config.SetupNetwork(*gConfigFile, overrides...); err != nil {
+		logger.Fatalf("init network failed:%v", err)
+	}
+	logger.Infof("synchronize network [%v]", utils.CurrentNetwork().Name)
 
 	maxErrCnt = *gMaxErrCntPerNode
 	getAccountWorkerLimit = *gMaxAccountWorkload
 
-	signalHandle()
-
 	initDB(*gStrMysqlDSN)
 	initRedis([]string{*gRedisDSN})
+	registerHealthChecks(*gMaxSyncLag)
+	metrics.Serve(*gMetricsAddr)
 	startDaemon()
 
+	lifecycle.Append(lifecycle.Hook{Name: "daemon", Stop: stopDaemon})
+	lifecycle.Go("blocks", syncBlocks)
+	code := lifecycle.Run(*gShutdownTimeout)
+
+	logger.Infof("fullnode QUIT, exit code:%v", code)
+	os.Exit(code)
+}
+
+//syncBlocks 同步块，完成指定范围或收到退出信号后等待各worker写完缓存的块，然后通知其他daemon退出
+func syncBlocks(ctx context.Context) {
 	getAllBlocks()
-	if !needQuit() {
-		close(quit)
+	for wc1.currentWorker() > 0 {
+		time.Sleep(time.Second)
 	}
+	lifecycle.Shutdown()
+}
 
-	syncAccount() // syn account after getAllBlocks() quit
-
-	fmt.Println("Wait other daemon quit .......")
-	wg.Wait()
+//stopDaemon 同步剩余的账户，等待各daemon写完缓存的数据
+func stopDaemon(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		syncAccount() // syn account after getAllBlocks() quit
 
-	fmt.Println("fullnode QUIT")
+		logger.Info("Wait other daemon quit .......")
+		wg.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
 }
 
 func getAllBlocks() {
 	wc1 = newWorkerCounter(*gIntMaxWorker)
 	ts := time.Now()
 	getBlock(0, *gStartBlokcID, *gEndBlokcID)
-	fmt.Printf("get all blocks cost:%v\n", time.Since(ts))
+	logger.Infof("get all blocks cost:%v", time.Since(ts))
 }
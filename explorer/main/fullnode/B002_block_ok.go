@@ -20,7 +20,7 @@ func getBlock(id int, b, e int64) {
 
 	ts := time.Now()
 
-	servAddr := fmt.Sprintf("%s:50051", utils.GetRandFullNodeAddr())
+	servAddr := utils.GetRandFullNodeEndpoint()
 	taskID := fmt.Sprintf("[%04v|%v~%v|%v]", id, b, e, servAddr)
 
 	client := grpcclient.NewWallet(servAddr)
@@ -34,7 +34,10 @@ func getBlock(id int, b, e int64) {
 		getBlock(id, b, e)
 		return
 	}
-	fmt.Printf("%v latestNum is [%v]\n", taskID, le)
+	logger.Infof("%v latestNum is [%v]", taskID, le)
+	if id == 0 {
+		updateNodeHeight(le)
+	}
 	b = checkForkTask(id, "", le, b, e)
 
 	bb := b
@@ -58,14 +61,19 @@ func getBlock(id int, b, e int64) {
 			break
 		}
 
+		if needQuit() { // store fetched blocks in blockBuf and quit
+			break
+		}
+
 		if id == 0 && b >= le {
 			time.Sleep(3 * time.Second)
 
 			le = getLatestNum(dbc)
+			updateNodeHeight(le)
 			runTaskCnt := wc1.currentWorker()
-			fmt.Printf("Current working task:[%v]--max task:[%v], latest block id handled:%v\n", runTaskCnt, *gIntMaxWorker, newE)
+			logger.Infof("Current working task:[%v]--max task:[%v], latest block id handled:%v", runTaskCnt, *gIntMaxWorker, newE)
 			if e > 0 && 1 == runTaskCnt {
-				fmt.Printf("Sync all data cost:%v\n", time.Since(ts))
+				logger.Infof("Sync all data cost:%v", time.Since(ts))
 				break
 			}
 			if needQuit() {
@@ -89,7 +97,7 @@ func getBlock(id int, b, e int64) {
 		if len(blockBuf)+len(blocks) > cap(blockBuf) || time.Since(tsWriteDB) > 10*time.Second {
 			ret := verifyStoreBlock(blockBuf, blockIDs, client, maxErrCnt-errCnt)
 			if !ret {
-				fmt.Printf("bulk get block(%v, %v) check store failed! error:%v\n", b, newE, err)
+				logger.With("start_block", b, "end_block", newE).Errorf("bulk get block check store failed:%v", err)
 				errCnt += maxErrCnt
 			}
 			blockBuf = blockBuf[:0]
@@ -106,9 +114,12 @@ func getBlock(id int, b, e int64) {
 
 	ret := verifyStoreBlock(blockBuf, blockIDs, client, maxErrCnt-errCnt)
 	if !ret {
-		fmt.Printf("bulk get block(%v, %v) check store failed\n", b, newE)
+		logger.With("start_block", b, "end_block", newE).Error("bulk get block check store failed")
 		errCnt += maxErrCnt
 		wc1.stopOne()
+		if needQuit() {
+			return
+		}
 		getBlock(id, bb, e)
 		return
 	}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/golang/protobuf/proto"
@@ -16,7 +15,7 @@ func storeTransactions(trans []*core.Transaction) bool {
 
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("start transaction for storeTransaction failed:%v\n", err)
+		logger.Errorf("start transaction for storeTransaction failed:%v", err)
 		return false
 	}
 	/*
@@ -44,7 +43,7 @@ func storeTransactions(trans []*core.Transaction) bool {
 	sqlstr := "insert into transactions (trx_hash, block_id, contract_type, contract_data, result_data, real_timestamp, expire_time, owner_address, create_time) values (?, ?, ?, ?, ?, ?, ?, ?, ?)"
 	stmt, err := txn.Prepare(sqlstr)
 	if nil != err {
-		fmt.Printf("prepare store transaction SQL failed:%v\n", err)
+		logger.Errorf("prepare store transaction SQL failed:%v", err)
 		return false
 	}
 	defer stmt.Close()
@@ -59,7 +58,7 @@ func storeTransactions(trans []*core.Transaction) bool {
 				blockID = int64(utils.BinaryBigEndianDecodeUint64(tran.Signature[1]))         // use signature[1] store block_id
 				blockCreateTime = int64(utils.BinaryBigEndianDecodeUint64(tran.Signature[2])) // use signature[1] store block create_time
 			} else {
-				fmt.Printf("ERROR: can't get transaction blockID and create time:%v\n", utils.ToJSONStr(tran))
+				logger.Errorf("can't get transaction blockID and create time:%v", utils.ToJSONStr(tran))
 			}
 			trxHash := utils.HexEncode(utils.CalcTransactionHash(tran)) // calc trx hash need reset RefBlockNum as main net do not fill this field
 			tran.RawData.RefBlockNum = blockID                          // set it back as store contract need this value
@@ -81,19 +80,19 @@ func storeTransactions(trans []*core.Transaction) bool {
 				blockCreateTime,
 			)
 			if err != nil {
-				fmt.Printf("ERROR: store transaction failed!%v, trx_hash:%v, blockID:%v\n", err, trxHash, blockID) //,utils.ToJSONStr(tran))
+				logger.With("trx_hash", trxHash, "blockID", blockID).Errorf("store transaction failed:%v", err) //,utils.ToJSONStr(tran))
 				// return false
 			} else {
 				storeContractDetail(txn, 1, trxHash, tran)
 			}
 		} else {
-			fmt.Println("ERROR: transaction contract is empty!")
+			logger.Error("transaction contract is empty!")
 		}
 	}
 
 	err = txn.Commit()
 	if err != nil {
-		fmt.Printf("commit transaction data failed:%v\n", err)
+		logger.Errorf("commit transaction data failed:%v", err)
 		return false
 	}
 
@@ -105,7 +104,7 @@ func storeBlocks(blocks []*core.Block) (bool, int64, int64, []int64) {
 	ts := time.Now()
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("get db failed:%v\n", err)
+		logger.Errorf("get db failed:%v", err)
 		return false, 0, 0, nil
 	}
 	/*
@@ -130,7 +129,7 @@ func storeBlocks(blocks []*core.Block) (bool, int64, int64, []int64) {
 	sqlstr := "insert into blocks (block_id, block_hash, parent_hash, confirmed, transaction_num, block_size, witness_address, create_time, tx_trie_hash) values (?, ?, ?, ?, ?, ?, ?, ?, ?)"
 	stmt, err := txn.Prepare(sqlstr)
 	if nil != err {
-		fmt.Printf("prepare insert block SQL failed:%v\n", err)
+		logger.Errorf("prepare insert block SQL failed:%v", err)
 		return false, 0, 0, nil
 	}
 	defer stmt.Close()
@@ -159,10 +158,10 @@ func storeBlocks(blocks []*core.Block) (bool, int64, int64, []int64) {
 				block.BlockHeader.RawData.Timestamp,
 				utils.HexEncode(block.BlockHeader.RawData.TxTrieRoot))
 		} else {
-			fmt.Println("transaction contract is empty!")
+			logger.Error("transaction contract is empty!")
 		}
 		if err != nil {
-			fmt.Printf("insert into block failed:%v-->blockID:%v\n", err, block.BlockHeader.RawData.Number) // utils.ToJSONStr(block))
+			logger.With("blockID", block.BlockHeader.RawData.Number).Errorf("insert into block failed:%v", err) // utils.ToJSONStr(block))
 			// return false
 			errCnt++
 		} else {
@@ -179,15 +178,23 @@ func storeBlocks(blocks []*core.Block) (bool, int64, int64, []int64) {
 	}
 
 	err = txn.Commit()
+	ingestDuration.With("block").ObserveSince(ts)
+	if err == nil {
+		ingestBlocks.With("ok").Add(float64(succCnt))
+		ingestBlocks.With("error").Add(float64(errCnt))
+		updateStoredHeight(blockIDList)
+	} else {
+		ingestBlocks.With("error").Add(float64(len(blockIDList)))
+	}
 
 	// fmt.Printf("store %v blocks cost:%v\n", len(blocks), time.Since(ts))
 
 	ts = time.Now()
 	blukStoreTransactions(tranList)
-	fmt.Printf("store %v transactions cost:%v\n", len(tranList), time.Since(ts))
+	logger.Infof("store %v transactions cost:%v", len(tranList), time.Since(ts))
 
 	if err != nil {
-		fmt.Printf("connit block failed:%v\n", err)
+		logger.Errorf("connit block failed:%v", err)
 		return false, succCnt, errCnt, blockIDList
 	}
 	return true, succCnt, errCnt, blockIDList
@@ -196,17 +203,24 @@ func storeBlocks(blocks []*core.Block) (bool, int64, int64, []int64) {
 // ERROR: store transaction failed!Error 1205: Lock wait timeout exceeded; try restarting transaction, trx_hash:bdc4b78f1da1eca46a95214f0389e931b4fcb0be047483b5dda0fac79a0eafa5, blockID:1963173
 var maxTransPerTxn = 1000
 
+func storeTransactionsObserved(trxList []*core.Transaction) {
+	ts := time.Now()
+	ok := storeTransactions(trxList)
+	ingestDuration.With("transaction").ObserveSince(ts)
+	ingestTransactions.With(resultLabel(ok)).Add(float64(len(trxList)))
+}
+
 func blukStoreTransactions(trxList []*core.Transaction) {
 	pos := 0
 	remain := len(trxList)
 	for remain > 0 {
 		if remain >= maxTransPerTxn {
-			storeTransactions(trxList[pos : pos+maxTransPerTxn])
+			storeTransactionsObserved(trxList[pos : pos+maxTransPerTxn])
 			pos += maxTransPerTxn
 			remain -= maxTransPerTxn
 			continue
 		}
-		storeTransactions(trxList[pos : pos+remain])
+		storeTransactionsObserved(trxList[pos : pos+remain])
 		pos += remain
 		remain -= remain
 	}
@@ -0,0 +1,43 @@
+package main
+
+import (
+	"sync/atomic"
+
+	"github.com/wlcy/tron/explorer/lib/metrics"
+)
+
+var (
+	syncHeight         = metrics.NewGaugeVec("explorer_sync_height", "Block height of synchronized data and of the node.", "source")
+	ingestBlocks       = metrics.NewCounterVec("explorer_ingest_blocks_total", "Blocks written to db by result.", "result")
+	ingestTransactions = metrics.NewCounterVec("explorer_ingest_transactions_total", "Transactions written to db by result.", "result")
+	ingestDuration     = metrics.NewHistogramVec("explorer_ingest_store_duration_seconds", "Latency of storing a batch of data.", nil, "kind")
+)
+
+//已写入db的最新块和节点的最新块，用于就绪检查
+var maxStoredBlockID, nodeBlockID int64
+
+//updateNodeHeight 记录节点的最新块
+func updateNodeHeight(blockID int64) {
+	atomic.StoreInt64(&nodeBlockID, blockID)
+	syncHeight.With("node").Set(float64(blockID))
+}
+
+//updateStoredHeight 多个worker并发写入，同步高度取已写入的最大块
+func updateStoredHeight(blockIDs []int64) {
+	for _, blockID := range blockIDs {
+		for {
+			cur := atomic.LoadInt64(&maxStoredBlockID)
+			if blockID <= cur || atomic.CompareAndSwapInt64(&maxStoredBlockID, cur, blockID) {
+				break
+			}
+		}
+	}
+	syncHeight.With("synced").Set(float64(atomic.LoadInt64(&maxStoredBlockID)))
+}
+
+func resultLabel(ok bool) string {
+	if ok {
+		return "ok"
+	}
+	return "error"
+}
@@ -2,7 +2,6 @@ package main
 
 import (
 	"database/sql"
-	"fmt"
 
 	"github.com/tronprotocol/grpc-gateway/core"
 	"github.com/wlcy/tron/explorer/core/utils"
@@ -89,7 +88,7 @@ func storeContractDetail(txn *sql.Tx, confirmed int, trxHash string, trx *core.T
 	case *core.ExchangeTransactionContract:
 		// storeCreateSmartContract(txn, confirmed, trxHash, trx, v)
 	default:
-		fmt.Printf("new type:%T-->%v\n", v, v)
+		logger.Warnf("new type:%T-->%v", v, v)
 	}
 
 }
@@ -132,7 +131,7 @@ func storeAccountCreateContract(txn *sql.Tx, confiremd int, trxHash string, trx
 		utils.Base58EncodeAddr(ctx.AccountAddress),
 		ctx.Type)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err) //utils.ToJSONStr(ctx), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err) //utils.ToJSONStr(ctx), err)
 	}
 
 	AddRefreshAddress(ctx.OwnerAddress, ctx.AccountAddress)
@@ -179,7 +178,7 @@ func storeTransferContract(txn *sql.Tx, confirmed int, trxHash string, trx *core
 		ctx.Amount,
 		"")
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress, ctx.ToAddress)
 
@@ -225,7 +224,7 @@ func storeTransferAssetContract(txn *sql.Tx, confirmed int, trxHash string, trx
 		ctx.Amount,
 		string(ctx.AssetName))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 
 	_, err = txn.Exec(`insert into contract_asset_transfer 
@@ -245,7 +244,7 @@ func storeTransferAssetContract(txn *sql.Tx, confirmed int, trxHash string, trx
 		ctx.Amount,
 		string(ctx.AssetName))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress, ctx.ToAddress)
 
@@ -289,7 +288,7 @@ func storeVoteWitnessContract(txn *sql.Tx, confirmed int, trxHash string, trx *c
 		utils.ToJSONStr(ctx.Votes),
 		ctx.Support)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 
 	AddRefreshAddress(ctx.OwnerAddress)
@@ -331,7 +330,7 @@ func storeWitnessCreateContract(txn *sql.Tx, confirmed int, trxHash string, trx
 		utils.Base58EncodeAddr(ctx.OwnerAddress),
 		string(ctx.Url))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 	return
@@ -406,7 +405,7 @@ func storeAssetIssueContract(txn *sql.Tx, confirmed int, trxHash string, trx *co
 		ctx.PublicFreeAssetNetUsage,
 		ctx.PublicLatestFreeNetTime)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 	return
@@ -451,7 +450,7 @@ func storeParticipateAssetIssueContract(txn *sql.Tx, confirmed int, trxHash stri
 		string(ctx.AssetName),
 		ctx.Amount)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress, ctx.ToAddress)
 	return
@@ -496,7 +495,7 @@ func storeFreezeBalanceContract(txn *sql.Tx, confirmed int, trxHash string, trx
 		ctx.FrozenDuration,
 		ctx.Resource)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 
@@ -538,7 +537,7 @@ func storeUnfreezeBalanceContract(txn *sql.Tx, confirmed int, trxHash string, tr
 		utils.Base58EncodeAddr(ctx.OwnerAddress),
 		ctx.Resource)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 
@@ -578,7 +577,7 @@ func storeWithdrawBalanceContract(txn *sql.Tx, confirmed int, trxHash string, tr
 		confirmed,
 		utils.Base58EncodeAddr(ctx.OwnerAddress))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 
@@ -618,7 +617,7 @@ func storeUnfreezeAssetContract(txn *sql.Tx, confirmed int, trxHash string, trx
 		confirmed,
 		utils.Base58EncodeAddr(ctx.OwnerAddress))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 	return
@@ -659,7 +658,7 @@ func storeAccountUpdateContract(txn *sql.Tx, confirmed int, trxHash string, trx
 		utils.Base58EncodeAddr(ctx.OwnerAddress),
 		string(ctx.AccountName))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 
@@ -701,7 +700,7 @@ func storeSetAccountIDContract(txn *sql.Tx, confirmed int, trxHash string, trx *
 		utils.Base58EncodeAddr(ctx.OwnerAddress),
 		string(ctx.AccountId))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 	return
@@ -746,7 +745,7 @@ func storeVoteAssetContract(txn *sql.Tx, confirmed int, trxHash string, trx *cor
 		ctx.Support,
 		ctx.Count)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 
@@ -790,7 +789,7 @@ func storeUpdateSettingContract(txn *sql.Tx, confirmed int, trxHash string, trx
 		utils.Base58EncodeAddr(ctx.ContractAddress),
 		ctx.ConsumeUserResourcePercent)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress, ctx.ContractAddress)
 
@@ -832,7 +831,7 @@ func storeWitnessUpdateContract(txn *sql.Tx, confirmed int, trxHash string, trx
 		utils.Base58EncodeAddr(ctx.OwnerAddress),
 		string(ctx.UpdateUrl))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 
@@ -880,7 +879,7 @@ func storeUpdateAssetContract(txn *sql.Tx, confirmed int, trxHash string, trx *c
 		ctx.NewLimit,
 		ctx.NewPublicLimit)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress)
 
@@ -932,7 +931,7 @@ func storeCreateSmartContract(txn *sql.Tx, confirmed int, trxHash string, trx *c
 		ctx.NewContract.ConsumeUserResourcePercent,
 		ctx.NewContract.Name)
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress, ctx.NewContract.ContractAddress)
 	return
@@ -977,7 +976,7 @@ func storeTriggerSmartContract(txn *sql.Tx, confirmed int, trxHash string, trx *
 		ctx.CallValue,
 		string(ctx.Data))
 	if nil != err {
-		fmt.Printf("insert contract(%T) trx_hash:[%v], blockID:[%v] failed:%v\n", ctx, trxHash, int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1])), err)
+		logger.With("trx_hash", trxHash, "blockID", int64(utils.BinaryBigEndianDecodeUint64(trx.Signature[1]))).Errorf("insert contract(%T) failed:%v", ctx, err)
 	}
 	AddRefreshAddress(ctx.OwnerAddress, ctx.ContractAddress)
 
@@ -2,7 +2,6 @@ package main
 
 import (
 	"database/sql"
-	"fmt"
 	"sync"
 	"time"
 
@@ -84,7 +83,7 @@ func getAccount(addrs []string) ([]*account, []string, error) {
 	for {
 		workCnt := wc2.currentWorker()
 		lock.Lock()
-		fmt.Printf("*** account, working task:%-5v, finished:%-10v, total:%-10v, badAddr:%-10v, waitCnt:%v\n", workCnt, len(result), totalTask, len(badAddr), waitCnt)
+		logger.Infof("*** account, working task:%-5v, finished:%-10v, total:%-10v, badAddr:%-10v, waitCnt:%v", workCnt, len(result), totalTask, len(badAddr), waitCnt)
 		lock.Unlock()
 
 		if workCnt == 1 && len(result)+len(badAddr) >= totalTask {
@@ -110,7 +109,7 @@ func getAccountNet(accc []*account, process *int64, lock *sync.Mutex) {
 	if len(accc) == 0 {
 		return
 	}
-	fmt.Printf("*** accountNet start to syncrhonize accountNet info, total account:%v......\n", len(accc))
+	logger.Infof("*** accountNet start to syncrhonize accountNet info, total account:%v......", len(accc))
 	wc2.startOne()
 	totalTask := int64(len(accc))
 	client := grpcclient.GetRandomWallet()
@@ -156,7 +155,7 @@ func getAccountNet(accc []*account, process *int64, lock *sync.Mutex) {
 	for {
 		workCnt := wc2.currentWorker()
 		lock.Lock()
-		fmt.Printf("*** accountNet, working task:%-05v, finished:%-06v, total:%-06v, waitCnt:%v\n", workCnt, *process, totalTask, waitCnt)
+		logger.Infof("*** accountNet, working task:%-05v, finished:%-06v, total:%-06v, waitCnt:%v", workCnt, *process, totalTask, waitCnt)
 		lock.Unlock()
 
 		if workCnt == 1 && *process >= totalTask {
@@ -295,7 +294,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 	ts := time.Now()
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("get db failed:%v\n", err)
+		logger.Errorf("get db failed:%v", err)
 		return false
 	}
 	/*
@@ -327,7 +326,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 			?, ?, ?, ?, ?, ?, ?)`
 	stmtI, err := txn.Prepare(sqlI)
 	if nil != err {
-		fmt.Printf("prepare insert tron_account SQL failed:%v\n", err)
+		logger.Errorf("prepare insert tron_account SQL failed:%v", err)
 		return false
 	}
 	defer stmtI.Close()
@@ -338,7 +337,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 		where address = ?`
 	stmtU, err := txn.Prepare(sqlU)
 	if nil != err {
-		fmt.Printf("prepare update tron_account SQL failed:%v\n", err)
+		logger.Errorf("prepare update tron_account SQL failed:%v", err)
 		return false
 	}
 	defer stmtU.Close()
@@ -346,7 +345,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 	sqlBI := "insert into account_asset_balance (address, asset_name, balance) values (?, ?, ?)"
 	stmtBI, err := txn.Prepare(sqlBI)
 	if nil != err {
-		fmt.Printf("prepare insert account_asset_balance SQL failed:%v\n", err)
+		logger.Errorf("prepare insert account_asset_balance SQL failed:%v", err)
 		return false
 	}
 	defer stmtBI.Close()
@@ -354,7 +353,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 	sqlVI := "insert into account_vote_result (address, to_address, vote) values (?, ?, ?)"
 	stmtVI, err := txn.Prepare(sqlVI)
 	if nil != err {
-		fmt.Printf("prepare insert account_vote_result SQL failed:%v\n", err)
+		logger.Errorf("prepare insert account_vote_result SQL failed:%v", err)
 		return false
 	}
 	defer stmtVI.Close()
@@ -438,7 +437,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 		for k, v := range acc.AssetBalance {
 			_, err := stmtBI.Exec(acc.Addr, k, v)
 			if nil != err {
-				fmt.Printf("insert account_asset_balance failed:%v\n", err)
+				logger.Errorf("insert account_asset_balance failed:%v", err)
 			}
 		}
 
@@ -447,7 +446,7 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 		for _, vote := range acc.raw.Votes {
 			_, err := stmtVI.Exec(acc.Addr, utils.Base58EncodeAddr(vote.VoteAddress), vote.VoteCount)
 			if nil != err {
-				fmt.Printf("insert account_asset_balance failed:%v\n", err)
+				logger.Errorf("insert account_asset_balance failed:%v", err)
 			}
 		}
 
@@ -455,10 +454,10 @@ func storeAccount(accountList []*account, dbb *sql.DB) bool {
 
 	err = txn.Commit()
 	if err != nil {
-		fmt.Printf("connit block failed:%v\n", err)
+		logger.Errorf("connit block failed:%v", err)
 		return false
 	}
-	fmt.Printf("store account OK, cost:%v, insertCnt:%v, updateCnt:%v, errCnt:%v, total source:%v\n", time.Since(ts), insertCnt, updateCnt, errCnt, len(accountList))
+	logger.Infof("store account OK, cost:%v, insertCnt:%v, updateCnt:%v, errCnt:%v, total source:%v", time.Since(ts), insertCnt, updateCnt, errCnt, len(accountList))
 
 	return true
 }
@@ -505,13 +504,13 @@ func getDBMaxBlockID() int64 {
 
 	txn, err := dbb.Begin()
 	if nil != err {
-		fmt.Printf("start db transaction failed:%v\n", err)
+		logger.Errorf("start db transaction failed:%v", err)
 		return 10000000
 	}
 
 	row, err := txn.Query("select max(block_id) from blocks")
 	if nil != err {
-		fmt.Printf("getDBMaxBlockID failed:%v, return 10000000 as default!\n", err)
+		logger.Errorf("getDBMaxBlockID failed:%v, return 10000000 as default!", err)
 		if nil != row {
 			row.Close()
 		}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/tronprotocol/grpc-gateway/api"
@@ -31,7 +30,7 @@ func startAccountDaemon() {
 		}
 
 		syncAccount()
-		fmt.Printf("Account Daemon QUIT")
+		logger.Infof("Account Daemon QUIT")
 	}()
 
 }
@@ -42,15 +41,15 @@ func syncAccount() {
 	}
 	cleanAccountBuffer()
 	list, err := ClearRefreshAddress() // load all address from redis and prepare handle it
-	fmt.Printf("### total account need to synchronze:%-10v, err:%v, start synchronize account info ......\n", len(list), err)
+	logger.Infof("### total account need to synchronze:%-10v, err:%v, start synchronize account info ......", len(list), err)
 
 	ts := time.Now()
 	accList, restAddr, _ := getAccount(list)
-	fmt.Printf("### total account syncrhonzed:%-10v, bad address:%-10v, cost:%v, synchronize to db .....\n", len(accList), len(restAddr), time.Since(ts))
+	logger.Infof("### total account syncrhonzed:%-10v, bad address:%-10v, cost:%v, synchronize to db .....", len(accList), len(restAddr), time.Since(ts))
 
 	ts1 := time.Now()
 	storeAccount(accList, nil)
-	fmt.Printf("### store account size:%-10v to DB cost:%v\n", len(accList), time.Since(ts1))
+	logger.Infof("### store account size:%-10v to DB cost:%v", len(accList), time.Since(ts1))
 }
 
 type account struct {
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/tronprotocol/grpc-gateway/core"
@@ -16,7 +15,7 @@ func startWintnessDaemon() {
 		for {
 			if witnessList, ok := getWitness(); ok {
 				icnt, ucnt, ecnt, err := storeWitness(witnessList)
-				fmt.Printf("witness work result:(%v,%v,%v,%v)\n", icnt, ucnt, ecnt, err)
+				logger.Infof("witness work result:(%v,%v,%v,%v)", icnt, ucnt, ecnt, err)
 				time.Sleep(30 * time.Second)
 			} else {
 				time.Sleep(1 * time.Second)
@@ -26,7 +25,7 @@ func startWintnessDaemon() {
 				break
 			}
 		}
-		fmt.Printf("Witness Daemon QUIT\n")
+		logger.Infof("Witness Daemon QUIT")
 	}()
 }
 
@@ -50,7 +49,7 @@ func storeWitness(witnessList []*core.Witness) (iCnt int64, uCnt int64, eCnt int
 
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("get db failed:%v\n", err)
+		logger.Errorf("get db failed:%v", err)
 		return
 	}
 	/*
@@ -72,7 +71,7 @@ func storeWitness(witnessList []*core.Witness) (iCnt int64, uCnt int64, eCnt int
 	sqlI := "insert into witness (address, vote_count, public_key, url, total_produced, total_missed, latest_block_num, latest_slot_num, is_job) values (?, ?, ?, ?, ?, ?, ?, ?, ?)"
 	stmtI, err := txn.Prepare(sqlI)
 	if nil != err {
-		fmt.Printf("prepare insert witness failed:%v\n", err)
+		logger.Errorf("prepare insert witness failed:%v", err)
 		return
 	}
 	defer stmtI.Close()
@@ -81,7 +80,7 @@ func storeWitness(witnessList []*core.Witness) (iCnt int64, uCnt int64, eCnt int
 	sqlU := "update witness set vote_count = ?, public_key = ?, url = ?, total_produced = ?, total_missed = ?, latest_block_num = ?, latest_slot_num = ?, is_job = ? where address = ? and total_produced <= ? and latest_block_num <= ?"
 	stmtU, err := txn.Prepare(sqlU)
 	if nil != err {
-		fmt.Printf("prepare update witness failed:%v\n", err)
+		logger.Errorf("prepare update witness failed:%v", err)
 		return
 	}
 	defer stmtU.Close()
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/tronprotocol/grpc-gateway/core"
@@ -16,7 +15,7 @@ func startAssetDaemon() {
 		for {
 			if assets, ok := getAssets(); ok {
 				icnt, ucnt, ecnt, err := storeAsset(assets)
-				fmt.Printf("asset daemon work result:(%v, %v, %v, %v)\n", icnt, ucnt, ecnt, err)
+				logger.Infof("asset daemon work result:(%v, %v, %v, %v)", icnt, ucnt, ecnt, err)
 				time.Sleep(30 * time.Second)
 			} else {
 				time.Sleep(1 * time.Second)
@@ -26,7 +25,7 @@ func startAssetDaemon() {
 				break
 			}
 		}
-		fmt.Printf("Asset Daemon QUIT\n")
+		logger.Infof("Asset Daemon QUIT")
 	}()
 }
 
@@ -50,7 +49,7 @@ func storeAsset(assetList []*core.AssetIssueContract) (iCnt int64, uCnt int64, e
 
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("get db failed:%v\n", err)
+		logger.Errorf("get db failed:%v", err)
 		return
 	}
 	/*
@@ -72,6 +71,7 @@ func storeAsset(assetList []*core.AssetIssueContract) (iCnt int64, uCnt int64, e
 		  `public_free_asset_net_limit` bigint not null default '0' comment '',
 		  `public_free_asset_net_usage` bigint not null default '0' comment '',
 		  `public_latest_free_net_time` bigint not null default '0' comment '',
+		  `asset_precision` int not null default '0' comment '通证精度',
 		  PRIMARY KEY (`owner_address`,`asset_name`),
 		  KEY `idx_trx_transfe_hash_create_time` (`block_id`,`trx_hash`,`create_time` DESC)
 		) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci
@@ -81,26 +81,26 @@ func storeAsset(assetList []*core.AssetIssueContract) (iCnt int64, uCnt int64, e
 	sqlI := `insert into asset_issue 
 	(owner_address, asset_name, asset_abbr, total_supply, frozen_supply, trx_num, num, start_time, end_time, order_num, 
 		vote_score, asset_desc, url, free_asset_net_limit, 
-		public_free_asset_net_limit, public_free_asset_net_usage, public_latest_free_net_time) 
+		public_free_asset_net_limit, public_free_asset_net_usage, public_latest_free_net_time, asset_precision) 
 	values 
 		 (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, 
 		 ?, ?, ?, ?,
-		 ?, ?, ?)`
+		 ?, ?, ?, ?)`
 	stmt, err := txn.Prepare(sqlI)
 	if nil != err {
-		fmt.Printf("prepare [%v] failed:%v\n", sqlI, err)
+		logger.Errorf("prepare [%v] failed:%v", sqlI, err)
 		return
 	}
 	defer stmt.Close()
 
 	sqlU := `update asset_issue set asset_abbr = ?, total_supply = ?, frozen_supply = ?, trx_num = ?, num = ?, start_time = ?, end_time = ?, order_num = ?,
 	vote_score = ?, asset_desc = ?, url = ?, free_asset_net_limit = ?, public_free_asset_net_limit = ?,
-	public_free_asset_net_usage = ?, public_latest_free_net_time = ? 
+	public_free_asset_net_usage = ?, public_latest_free_net_time = ?, asset_precision = ? 
 	where owner_address = ? and asset_name = ?`
 
 	stmtU, err := txn.Prepare(sqlU)
 	if nil != err {
-		fmt.Printf("prepare update witness failed:%v\n", err)
+		logger.Errorf("prepare update witness failed:%v", err)
 		return
 	}
 	defer stmtU.Close()
@@ -123,7 +123,8 @@ func storeAsset(assetList []*core.AssetIssueContract) (iCnt int64, uCnt int64, e
 			asset.FreeAssetNetLimit,
 			asset.PublicFreeAssetNetLimit,
 			asset.PublicFreeAssetNetUsage,
-			asset.PublicLatestFreeNetTime)
+			asset.PublicLatestFreeNetTime,
+			asset.Precision)
 		if nil != err {
 			// fmt.Printf("insert asset_issue [%T] failed:%v\n", asset, err)
 
@@ -143,6 +144,7 @@ func storeAsset(assetList []*core.AssetIssueContract) (iCnt int64, uCnt int64, e
 				asset.PublicFreeAssetNetLimit,
 				asset.PublicFreeAssetNetUsage,
 				asset.PublicLatestFreeNetTime,
+				asset.Precision,
 				utils.Base58EncodeAddr(asset.OwnerAddress),
 				string(asset.Name))
 			if nil != err {
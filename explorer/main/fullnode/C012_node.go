@@ -1,7 +1,6 @@
 package main
 
 import (
-	"fmt"
 	"time"
 
 	"github.com/tronprotocol/grpc-gateway/api"
@@ -15,7 +14,7 @@ func startNodeDaemon() {
 		for {
 			if nodes, ok := getNodes(); ok {
 				icnt, ucnt, ecnt, err := storeNodes(nodes)
-				fmt.Printf("node daemon work result:(%v, %v, %v, %v)\n", icnt, ucnt, ecnt, err)
+				logger.Infof("node daemon work result:(%v, %v, %v, %v)", icnt, ucnt, ecnt, err)
 				time.Sleep(30 * time.Second)
 			} else {
 				time.Sleep(1 * time.Second)
@@ -25,7 +24,7 @@ func startNodeDaemon() {
 				break
 			}
 		}
-		fmt.Printf("Node Daemon QUIT\n")
+		logger.Infof("Node Daemon QUIT")
 	}()
 }
 
@@ -49,7 +48,7 @@ func storeNodes(nodeList []*api.Node) (iCnt int64, uCnt int64, eCnt int64, err e
 
 	txn, err := dbb.Begin()
 	if err != nil {
-		fmt.Printf("get db failed:%v\n", err)
+		logger.Errorf("get db failed:%v", err)
 		return
 	}
 	/*
@@ -64,7 +63,7 @@ func storeNodes(nodeList []*api.Node) (iCnt int64, uCnt int64, eCnt int64, err e
 	sqlI := `insert into nodes ( node_host, node_port ) values  (?, ?)`
 	stmt, err := txn.Prepare(sqlI)
 	if nil != err {
-		fmt.Printf("prepare [%v] failed:%v\n", sqlI, err)
+		logger.Errorf("prepare [%v] failed:%v", sqlI, err)
 		return
 	}
 	defer stmt.Close()
@@ -72,7 +71,7 @@ func storeNodes(nodeList []*api.Node) (iCnt int64, uCnt int64, eCnt int64, err e
 	sqlU := `update nodes set create_time = current_timestamp where node_host = ? and node_port = ?`
 	stmtU, err := txn.Prepare(sqlU)
 	if nil != err {
-		fmt.Printf("prepare [%v] failed:%v\n", sqlU, err)
+		logger.Errorf("prepare [%v] failed:%v", sqlU, err)
 		return
 	}
 	defer stmtU.Close()
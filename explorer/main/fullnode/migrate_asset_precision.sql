@@ -0,0 +1,8 @@
+/*
+asset_issue 增加通证精度字段，转账导出按精度换算数量
+已有的库升级时执行一次；新建的库 tron_schema.sql 中已包含该字段
+已有记录的精度由 fullnode 通证同步（每30秒全量更新 asset_issue）补齐
+*/
+
+ALTER TABLE `asset_issue`
+  ADD COLUMN `asset_precision` int(11) NOT NULL DEFAULT '0' COMMENT '通证精度，数量按 10^asset_precision 换算' AFTER `public_latest_free_net_time`;
@@ -105,6 +105,7 @@ CREATE TABLE `asset_issue` (
   `public_free_asset_net_limit` bigint(20) NOT NULL DEFAULT '0',
   `public_free_asset_net_usage` bigint(20) NOT NULL DEFAULT '0',
   `public_latest_free_net_time` bigint(20) NOT NULL DEFAULT '0',
+  `asset_precision` int(11) NOT NULL DEFAULT '0' COMMENT '通证精度，数量按 10^asset_precision 换算',
   PRIMARY KEY (`owner_address`,`asset_name`)
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
 /*!40101 SET character_set_client = @saved_cs_client */;
@@ -311,4 +311,314 @@ CREATE TABLE `wlcy_trx_request` (
   `ip` varchar(300) NOT NULL DEFAULT '' COMMENT '请求对应的ip',
   `create_time` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
   `modified_time` timestamp(6) NOT NULL  DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '记录更新时间'
-) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
\ No newline at end of file
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+API key信息表 保存链外信息
+rate/burst 为令牌桶参数，daily_quota 为每日请求上限，0表示不限
+*/
+CREATE TABLE `wlcy_api_key` (
+  `api_key` varchar(64) NOT NULL DEFAULT '' COMMENT 'api key',
+  `name` varchar(200) NOT NULL DEFAULT '' COMMENT '使用方名称',
+  `contact` varchar(300) NOT NULL DEFAULT '' COMMENT '联系方式',
+  `rate` int(32) NOT NULL DEFAULT '0' COMMENT '每秒请求数',
+  `burst` int(32) NOT NULL DEFAULT '0' COMMENT '允许的突发请求数',
+  `daily_quota` bigint(20) NOT NULL DEFAULT '0' COMMENT '每日请求上限',
+  `status` tinyint(4) NOT NULL DEFAULT '1' COMMENT '状态 1 有效 0 停用',
+  `create_time` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `modified_time` timestamp(6) NOT NULL  DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '记录更新时间',
+  PRIMARY KEY (`api_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+API key每日用量表 用于合作方计费
+由redis中的计数定时同步
+*/
+CREATE TABLE `wlcy_api_key_usage` (
+  `api_key` varchar(64) NOT NULL DEFAULT '' COMMENT 'api key',
+  `usage_date` varchar(8) NOT NULL DEFAULT '' COMMENT '日期 yyyymmdd UTC',
+  `request_count` bigint(20) NOT NULL DEFAULT '0' COMMENT '请求次数',
+  `rejected_count` bigint(20) NOT NULL DEFAULT '0' COMMENT '被限流的请求次数',
+  `modified_time` timestamp(6) NOT NULL  DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '记录更新时间',
+  PRIMARY KEY (`api_key`,`usage_date`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+投票轮次归档表 每个维护周期一条记录
+轮次开始时写入票数和排名，snapshot_time 为写入时间；服务停止期间错过的轮次 snapshot_time 为0，没有票数和排名
+丢块检查越过轮次结束时间后结算出块和丢块数并标记完成
+*/
+CREATE TABLE `wlcy_vote_cycle` (
+  `cycle_start` bigint(20) NOT NULL DEFAULT '0' COMMENT '轮次开始时间',
+  `cycle_end` bigint(20) NOT NULL DEFAULT '0' COMMENT '轮次结束时间，即该轮的维护时间',
+  `total_votes` bigint(20) NOT NULL DEFAULT '0' COMMENT '总票数',
+  `witness_count` int(32) NOT NULL DEFAULT '0' COMMENT '候选人数量',
+  `voter_count` bigint(20) NOT NULL DEFAULT '0' COMMENT '投票人数量',
+  `snapshot_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '记录票数和排名的时间，0 为错过的轮次',
+  `finished` tinyint(4) NOT NULL DEFAULT '0' COMMENT '是否已结束 0 进行中 1 已结束',
+  `create_time` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  `modified_time` timestamp(6) NOT NULL  DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '记录更新时间',
+  PRIMARY KEY (`cycle_start`),
+  UNIQUE KEY `uniq_vote_cycle_end` (`cycle_end`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+投票轮次候选人归档表 每轮每个候选人一条记录
+本轮出块数按区块时间统计，丢块数取自 wlcy_witness_missed_slot；不在排名中的出块人结算时补充记录，票数和排名为0
+*/
+CREATE TABLE `wlcy_vote_cycle_witness` (
+  `cycle_start` bigint(20) NOT NULL DEFAULT '0' COMMENT '轮次开始时间',
+  `address` varchar(200) NOT NULL DEFAULT '' COMMENT '候选人地址',
+  `votes` bigint(20) NOT NULL DEFAULT '0' COMMENT '得票数',
+  `ranking` int(32) NOT NULL DEFAULT '0' COMMENT '排名',
+  `voter_count` bigint(20) NOT NULL DEFAULT '0' COMMENT '投票人数量',
+  `produced_blocks` bigint(20) NOT NULL DEFAULT '0' COMMENT '本轮出块数',
+  `missed_blocks` bigint(20) NOT NULL DEFAULT '0' COMMENT '本轮丢块数',
+  `modified_time` timestamp(6) NOT NULL  DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '记录更新时间',
+  PRIMARY KEY (`cycle_start`,`address`),
+  KEY `idx_vote_cycle_witness_address` (`address`,`cycle_start`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+投票轮次奖励表 每轮结算后一条记录，记录结算时使用的链参数
+*/
+CREATE TABLE `wlcy_vote_cycle_reward` (
+  `cycle_start` bigint(20) NOT NULL DEFAULT '0' COMMENT '轮次开始时间',
+  `witness_pay_per_block` bigint(20) NOT NULL DEFAULT '0' COMMENT '每块奖励，单位sun',
+  `witness_standby_allowance` bigint(20) NOT NULL DEFAULT '0' COMMENT '每轮投票奖励总额，单位sun',
+  `total_block_reward` bigint(20) NOT NULL DEFAULT '0' COMMENT '本轮出块奖励合计，单位sun',
+  `total_vote_reward` bigint(20) NOT NULL DEFAULT '0' COMMENT '本轮投票奖励合计，单位sun',
+  `create_time` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  PRIMARY KEY (`cycle_start`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+超级代表奖励表 每轮每个候选人一条记录
+*/
+CREATE TABLE `wlcy_witness_reward` (
+  `cycle_start` bigint(20) NOT NULL DEFAULT '0' COMMENT '轮次开始时间',
+  `address` varchar(200) NOT NULL DEFAULT '' COMMENT '候选人地址',
+  `votes` bigint(20) NOT NULL DEFAULT '0' COMMENT '得票数',
+  `ranking` int(32) NOT NULL DEFAULT '0' COMMENT '排名',
+  `produced_blocks` bigint(20) NOT NULL DEFAULT '0' COMMENT '本轮出块数',
+  `block_reward` bigint(20) NOT NULL DEFAULT '0' COMMENT '出块奖励，单位sun',
+  `vote_reward` bigint(20) NOT NULL DEFAULT '0' COMMENT '投票奖励，单位sun',
+  `total_reward` bigint(20) NOT NULL DEFAULT '0' COMMENT '奖励合计，单位sun',
+  PRIMARY KEY (`cycle_start`,`address`),
+  KEY `idx_witness_reward_address` (`address`,`cycle_start`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+超级代表丢块记录表 每个空出的slot一条记录
+address 为空表示无法推出应出块的超级代表
+*/
+CREATE TABLE `wlcy_witness_missed_slot` (
+  `slot_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '应出块的时间',
+  `address` varchar(200) NOT NULL DEFAULT '' COMMENT '应出块的超级代表地址',
+  `cycle_start` bigint(20) NOT NULL DEFAULT '0' COMMENT '所在轮次开始时间',
+  `prev_block_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '丢块前最后一个区块的高度',
+  `create_time` timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6) COMMENT '创建时间',
+  PRIMARY KEY (`slot_time`),
+  KEY `idx_witness_missed_slot_address` (`address`,`slot_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+提议表 由定时任务从节点同步
+*/
+CREATE TABLE `wlcy_proposal` (
+  `proposal_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '提议编号',
+  `proposer_address` varchar(200) NOT NULL DEFAULT '' COMMENT '提议人地址',
+  `parameters` varchar(2000) NOT NULL DEFAULT '' COMMENT '提议修改的参数，json格式 {"编号":值}',
+  `expiration_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '过期时间',
+  `create_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '创建时间',
+  `approval_count` int(32) NOT NULL DEFAULT '0' COMMENT '赞成的超级代表数量',
+  `state` varchar(20) NOT NULL DEFAULT '' COMMENT '状态 pending approved disapproved canceled',
+  `modified_time` timestamp(6) NOT NULL  DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '记录更新时间',
+  PRIMARY KEY (`proposal_id`),
+  KEY `idx_proposal_state` (`state`,`proposal_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+提议赞成表
+*/
+CREATE TABLE `wlcy_proposal_approval` (
+  `proposal_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '提议编号',
+  `address` varchar(200) NOT NULL DEFAULT '' COMMENT '赞成的超级代表地址',
+  PRIMARY KEY (`proposal_id`,`address`),
+  KEY `idx_proposal_approval_address` (`address`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+提议状态变化表 change_time 为同步时发现变化的时间
+*/
+CREATE TABLE `wlcy_proposal_state` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `proposal_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '提议编号',
+  `from_state` varchar(20) NOT NULL DEFAULT '' COMMENT '原状态，首次同步时为空',
+  `to_state` varchar(20) NOT NULL DEFAULT '' COMMENT '新状态',
+  `change_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '发现变化的时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_proposal_state_proposal` (`proposal_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+链参数快照表 保存上次同步的链参数
+*/
+CREATE TABLE `wlcy_chain_parameter` (
+  `param_key` varchar(100) NOT NULL DEFAULT '' COMMENT '参数名',
+  `param_value` bigint(20) NOT NULL DEFAULT '0' COMMENT '参数值',
+  `modified_time` timestamp(6) NOT NULL  DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '记录更新时间',
+  PRIMARY KEY (`param_key`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+链参数变化表 proposal_id 为使参数生效的提议，0表示无法确定
+*/
+CREATE TABLE `wlcy_chain_parameter_history` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT,
+  `param_key` varchar(100) NOT NULL DEFAULT '' COMMENT '参数名',
+  `old_value` bigint(20) NOT NULL DEFAULT '0' COMMENT '原值',
+  `new_value` bigint(20) NOT NULL DEFAULT '0' COMMENT '新值',
+  `change_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '发现变化的时间',
+  `proposal_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '提议编号',
+  PRIMARY KEY (`id`),
+  KEY `idx_chain_parameter_history_key` (`param_key`,`change_time`),
+  KEY `idx_chain_parameter_history_proposal` (`proposal_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+交易对表 由定时任务按区块顺序重放交易对相关的交易得到
+*/
+CREATE TABLE `wlcy_exchange` (
+  `exchange_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '交易对编号',
+  `creator_address` varchar(200) NOT NULL DEFAULT '' COMMENT '创建人地址',
+  `first_token_id` varchar(200) NOT NULL DEFAULT '' COMMENT '第一个通证，_ 表示TRX',
+  `first_token_balance` bigint(20) NOT NULL DEFAULT '0' COMMENT '第一个通证余额',
+  `second_token_id` varchar(200) NOT NULL DEFAULT '' COMMENT '第二个通证，_ 表示TRX',
+  `second_token_balance` bigint(20) NOT NULL DEFAULT '0' COMMENT '第二个通证余额',
+  `create_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '创建时间',
+  `block_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '最后一次变化的区块高度',
+  `modified_time` timestamp(6) NOT NULL  DEFAULT CURRENT_TIMESTAMP(6) ON UPDATE CURRENT_TIMESTAMP(6) COMMENT '记录更新时间',
+  PRIMARY KEY (`exchange_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+交易对成交表 buy_amount 按交易前的余额计算
+*/
+CREATE TABLE `wlcy_exchange_trade` (
+  `trx_hash` varchar(64) NOT NULL DEFAULT '' COMMENT '交易hash',
+  `block_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '区块ID',
+  `exchange_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '交易对编号',
+  `owner_address` varchar(200) NOT NULL DEFAULT '' COMMENT '发起方地址',
+  `sell_token_id` varchar(200) NOT NULL DEFAULT '' COMMENT '卖出的通证',
+  `sell_amount` bigint(20) NOT NULL DEFAULT '0' COMMENT '卖出数量',
+  `buy_token_id` varchar(200) NOT NULL DEFAULT '' COMMENT '买入的通证',
+  `buy_amount` bigint(20) NOT NULL DEFAULT '0' COMMENT '买入数量',
+  `price` double NOT NULL DEFAULT '0' COMMENT '成交价格，1个第一个通证可换的第二个通证数量',
+  `create_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '成交时间',
+  PRIMARY KEY (`trx_hash`),
+  KEY `idx_exchange_trade_exchange` (`exchange_id`,`block_id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+交易对K线表 candle_interval 为 1m 5m 1h 1d
+*/
+CREATE TABLE `wlcy_exchange_candle` (
+  `exchange_id` bigint(20) NOT NULL DEFAULT '0' COMMENT '交易对编号',
+  `candle_interval` varchar(10) NOT NULL DEFAULT '' COMMENT 'K线周期',
+  `open_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '开始时间',
+  `open_price` double NOT NULL DEFAULT '0' COMMENT '开盘价',
+  `high_price` double NOT NULL DEFAULT '0' COMMENT '最高价',
+  `low_price` double NOT NULL DEFAULT '0' COMMENT '最低价',
+  `close_price` double NOT NULL DEFAULT '0' COMMENT '收盘价',
+  `volume` bigint(20) NOT NULL DEFAULT '0' COMMENT '第一个通证成交量',
+  `quote_volume` bigint(20) NOT NULL DEFAULT '0' COMMENT '第二个通证成交量',
+  `trade_count` int(32) NOT NULL DEFAULT '0' COMMENT '成交笔数',
+  PRIMARY KEY (`exchange_id`,`candle_interval`,`open_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+历史价格表 每分钟保存一次各行情来源报价的中位数，过期的价格不保存
+TRX 以 USD 计价，通证以 TRX 计价
+*/
+CREATE TABLE `wlcy_price_history` (
+  `symbol` varchar(200) NOT NULL DEFAULT '' COMMENT '币种，TRX 或通证名',
+  `currency` varchar(10) NOT NULL DEFAULT '' COMMENT '计价币种 USD TRX',
+  `price` double NOT NULL DEFAULT '0' COMMENT '价格',
+  `sources` int(32) NOT NULL DEFAULT '0' COMMENT '参与聚合的报价数',
+  `price_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '聚合时间，取整到分钟',
+  PRIMARY KEY (`symbol`,`currency`,`price_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+通证资料提交记录 发行人签名提交，审核通过后写入 wlcy_asset_info
+*/
+CREATE TABLE `wlcy_token_meta_submission` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '提交编号',
+  `owner_address` varchar(45) NOT NULL DEFAULT '' COMMENT '通证发行人地址',
+  `description` text NULL COMMENT '通证介绍',
+  `website` varchar(500) NOT NULL DEFAULT '' COMMENT '官网地址',
+  `white_paper` varchar(500) NOT NULL DEFAULT '' COMMENT '白皮书链接',
+  `github` varchar(500) NOT NULL DEFAULT '' COMMENT 'github链接',
+  `country` varchar(100) NOT NULL DEFAULT '' COMMENT '国家',
+  `social_media` text NULL COMMENT '社交媒体，json',
+  `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '0 待审核 1 通过 2 拒绝',
+  `reason` varchar(500) NOT NULL DEFAULT '' COMMENT '拒绝原因',
+  `submit_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '提交时间',
+  `review_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '审核时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_token_meta_submission_owner` (`owner_address`),
+  KEY `idx_token_meta_submission_status` (`status`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+地址标签 owner_key为空的是公开标签，由管理员维护；否则为api key的私有标签，只返回给该api key
+*/
+CREATE TABLE `wlcy_address_label` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '标签编号',
+  `owner_key` varchar(64) NOT NULL DEFAULT '' COMMENT '私有标签所属api key，公开标签为空',
+  `address` varchar(45) NOT NULL DEFAULT '' COMMENT '地址',
+  `label` varchar(100) NOT NULL DEFAULT '' COMMENT '标签',
+  `category` varchar(20) NOT NULL DEFAULT '' COMMENT '分类 exchange scam contract team other',
+  `note` varchar(500) NOT NULL DEFAULT '' COMMENT '备注',
+  `update_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '更新时间',
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `uniq_address_label_owner_address` (`owner_key`,`address`),
+  KEY `idx_address_label_address` (`address`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+测试网水龙头发放记录 包括广播失败的记录，失败时已扣除的冷却时间和额度会退回
+*/
+CREATE TABLE `wlcy_faucet_grant` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '记录编号',
+  `address` varchar(45) NOT NULL DEFAULT '' COMMENT '接收地址',
+  `ip` varchar(45) NOT NULL DEFAULT '' COMMENT '申请IP',
+  `amount` bigint(20) NOT NULL DEFAULT '0' COMMENT '发放数量，单位sun',
+  `trx_hash` varchar(64) NOT NULL DEFAULT '' COMMENT '交易hash',
+  `status` tinyint(4) NOT NULL DEFAULT '0' COMMENT '1 成功 0 失败',
+  `message` varchar(500) NOT NULL DEFAULT '' COMMENT '失败原因',
+  `create_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '申请时间',
+  PRIMARY KEY (`id`),
+  KEY `idx_faucet_grant_address` (`address`),
+  KEY `idx_faucet_grant_create_time` (`create_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
+
+/*
+定时任务执行记录 多个web实例时每次计划只有获得锁的实例执行，scheduled_time用于判断计划是否已执行和重启后补执行
+*/
+CREATE TABLE `wlcy_task_run` (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '记录编号',
+  `job` varchar(64) NOT NULL DEFAULT '' COMMENT '任务名称',
+  `trigger_type` varchar(20) NOT NULL DEFAULT '' COMMENT '触发方式 schedule catchup manual shutdown',
+  `scheduled_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '计划执行时间，手动触发时为触发时间',
+  `start_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '开始时间',
+  `end_time` bigint(20) NOT NULL DEFAULT '0' COMMENT '结束时间',
+  `duration` bigint(20) NOT NULL DEFAULT '0' COMMENT '耗时，毫秒',
+  `status` varchar(20) NOT NULL DEFAULT '' COMMENT '结果 success failed',
+  `error` varchar(1000) NOT NULL DEFAULT '' COMMENT '失败原因',
+  `instance` varchar(100) NOT NULL DEFAULT '' COMMENT '执行的实例，主机名-进程号',
+  PRIMARY KEY (`id`),
+  KEY `idx_task_run_job_scheduled` (`job`,`scheduled_time`),
+  KEY `idx_task_run_start_time` (`start_time`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_unicode_ci;
@@ -1,10 +1,12 @@
 package buffer
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/mysql"
 )
@@ -28,14 +30,16 @@ func getAccountTokenBuffer() *accountTokenBuffer {
 		_accountTokenBuffer = &accountTokenBuffer{}
 		_accountTokenBuffer.getAccountTokenBuffer()
 
-		go accountBufferLoader()
+		lifecycle.Go("accountTokenBuffer", accountBufferLoader)
 	})
 	return _accountTokenBuffer
 }
-func accountBufferLoader() {
+func accountBufferLoader(ctx context.Context) {
 	for {
 		_accountTokenBuffer.getAccountTokenBuffer()
-		time.Sleep(5 * time.Second)
+		if !lifecycle.Wait(ctx, 5*time.Second) {
+			return
+		}
 	}
 }
 
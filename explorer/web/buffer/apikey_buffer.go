@@ -0,0 +1,91 @@
+package buffer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+/*
+store all valid api key in memory
+load from db every 60 seconds
+*/
+
+var _apiKeyBuffer *apiKeyBuffer
+var onceAPIKeyBuffer sync.Once
+
+//GetAPIKeyBuffer ...
+func GetAPIKeyBuffer() *apiKeyBuffer {
+	return getAPIKeyBuffer()
+}
+
+func getAPIKeyBuffer() *apiKeyBuffer {
+	onceAPIKeyBuffer.Do(func() {
+		_apiKeyBuffer = &apiKeyBuffer{}
+		_apiKeyBuffer.load()
+
+		lifecycle.Go("apiKeyBuffer", apiKeyBufferLoader)
+	})
+	return _apiKeyBuffer
+}
+
+func apiKeyBufferLoader(ctx context.Context) {
+	for lifecycle.Wait(ctx, 60*time.Second) {
+		_apiKeyBuffer.load()
+	}
+}
+
+type apiKeyBuffer struct {
+	sync.RWMutex
+
+	keyMap map[string]*entity.APIKeyInfo
+}
+
+//GetAPIKey 获取有效的api key信息
+func (w *apiKeyBuffer) GetAPIKey(apiKey string) (info *entity.APIKeyInfo, ok bool) {
+	w.RLock()
+	info, ok = w.keyMap[apiKey]
+	w.RUnlock()
+	return
+}
+
+//GetAPIKeys 获取全部有效的api key
+func (w *apiKeyBuffer) GetAPIKeys() []*entity.APIKeyInfo {
+	w.RLock()
+	apiKeys := make([]*entity.APIKeyInfo, 0, len(w.keyMap))
+	for _, info := range w.keyMap {
+		apiKeys = append(apiKeys, info)
+	}
+	w.RUnlock()
+	return apiKeys
+}
+
+//Reload 签发或停用key后立即刷新
+func (w *apiKeyBuffer) Reload() {
+	w.load()
+}
+
+func (w *apiKeyBuffer) load() {
+	strSQL := fmt.Sprintf(`
+	select api_key, name, contact, rate, burst, daily_quota, status, create_time
+	from wlcy_api_key where status=1`)
+	apiKeys, err := module.QueryAPIKeysRealize(strSQL)
+	if err != nil {
+		log.Errorf("load api key error:[%v]", err)
+		return
+	}
+	keyMap := make(map[string]*entity.APIKeyInfo, len(apiKeys))
+	for _, info := range apiKeys {
+		keyMap[info.APIKey] = info
+	}
+	w.Lock()
+	w.keyMap = keyMap
+	w.Unlock()
+	log.Infof("api key info in buffer :data done, count:[%v]", len(keyMap))
+}
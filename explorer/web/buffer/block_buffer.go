@@ -1,11 +1,17 @@
 package buffer
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"sync/atomic"
 
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/metrics"
+	tronredis "github.com/wlcy/tron/explorer/lib/redis"
+	"github.com/wlcy/tron/explorer/lib/trace"
 
 	"github.com/wlcy/tron/explorer/core/grpcclient"
 
@@ -36,6 +42,11 @@ if we can't find block in redis, load it from db and write to redis
 */
 
 var _redisCli *redis.Client
+
+var (
+	blockReads  = metrics.NewCounterVec("explorer_block_buffer_reads_total", "Blocks read from block buffer by tier (memory, redis, db).", "tier")
+	blockHeight = metrics.NewGaugeVec("explorer_block_height", "Max block id known to block buffer by source.", "source")
+)
 var blockBF *blockBuffer
 var once sync.Once
 
@@ -48,18 +59,20 @@ type Buffer interface {
 	GetSolidityNodeMaxBlockID() int64
 	GetMaxBlockTimestamp() int64
 
-	GetBlocks(startID int64, offset int64, count int64) (blocks []*entity.BlockInfo, err error)
-	GetBlock(blockID int64) (block *entity.BlockInfo)
+	GetBlocks(ctx context.Context, startID int64, offset int64, count int64) (blocks []*entity.BlockInfo, err error)
+	GetBlock(ctx context.Context, blockID int64) (block *entity.BlockInfo)
 
 	// transaction
 	GetTransactions(offset, count int64) []*entity.TransactionInfo
 	GetTransactionByBlockID(blockID int64) []*entity.TransactionInfo
+	GetTransactionByBlockIDs(blockIDs []int64) map[int64][]*entity.TransactionInfo
 	GetTransactionByHash(hash string) *entity.TransactionInfo
 	GetTotalTransactions() int64
 
 	// transfer
 	GetTransfers(offset, count int64) []*entity.TransferInfo
 	GetTransferByBlockID(blockID int64) []*entity.TransferInfo
+	GetTransferByBlockIDs(blockIDs []int64) map[int64][]*entity.TransferInfo
 	GetTransferByHash(hash string) *entity.TransferInfo
 	GetTotalTransfers() int64
 }
@@ -102,7 +115,8 @@ func (b *blockBuffer) GetSolidityNodeMaxBlockID() int64 {
 //	startID: blockID start to get, -1 mean get from maxBlockID, if startID == -1, use offset to decide which is the max block_id in the buffer
 //	offset: 从最新块开始的偏移量，返回的blocks max(block_id) = 缓存的currentMaxBlockID - startNum), if startID >= 0, ignore offset
 //	count: 需要返回的块的数量
-func (b *blockBuffer) GetBlocks(startID int64, offset int64, count int64) (blocks []*entity.BlockInfo, err error) {
+//	ctx 中有span时读取缓存和db记录为子span
+func (b *blockBuffer) GetBlocks(ctx context.Context, startID int64, offset int64, count int64) (blocks []*entity.BlockInfo, err error) {
 	log.Debugf("get blocks data in buffer...")
 	// log.Debugf("GetBlocks startNum:%v, offset:%v, count:%v\n", startID, offset, count)
 	if count <= 0 {
@@ -122,16 +136,16 @@ func (b *blockBuffer) GetBlocks(startID int64, offset int64, count int64) (block
 		numStart = 0
 	}
 	// log.Debugf("GetBlocks finah startNum:%v, offset:%v, count:%v, maxBlockID:%v, numStart:%v, numEnd:%v, count:%v\n", startID, offset, count, maxBlockID, numStart, numEnd, numEnd-numStart+1)
-	ret := b.readBuffer(numStart, numEnd)
+	ret := b.readBuffer(ctx, numStart, numEnd)
 	return ret, nil
 }
 
-func (b *blockBuffer) GetBlock(blockID int64) (block *entity.BlockInfo) {
+func (b *blockBuffer) GetBlock(ctx context.Context, blockID int64) (block *entity.BlockInfo) {
 	log.Debugf("get block data in buffer...")
 	if blockID > b.GetMaxBlockID() {
 		return nil
 	}
-	ret := b.readBuffer(blockID, blockID)
+	ret := b.readBuffer(ctx, blockID, blockID)
 	if len(ret) > 0 {
 		return ret[0]
 	}
@@ -141,18 +155,23 @@ func (b *blockBuffer) GetBlock(blockID int64) (block *entity.BlockInfo) {
 // GetBlockBuffer ...
 func GetBlockBuffer() Buffer {
 	_onceBlockBuffer.Do(func() {
-		initRedis([]string{"127.0.0.1:6379"})
+		conf := config.Get()
+		initRedis([]string{conf.Redis.Host})
 
-		_blockBuffer = &blockBuffer{}
+		_blockBuffer = &blockBuffer{refresh: make(chan struct{}, 1)}
 
 		_blockBuffer.solidityClient = grpcclient.GetRandomSolidity()
 		_blockBuffer.walletClient = grpcclient.GetRandomWallet()
-		_blockBuffer.maxNodeErr = 3
-		_blockBuffer.maxUnconfirmedBlockRead = 50
-		_blockBuffer.maxBlockInMemory = 5000
-		_blockBuffer.maxConfirmedTrx = 30000
+		_blockBuffer.maxNodeErr = conf.Buffer.MaxNodeErr
+		_blockBuffer.maxUnconfirmedBlockRead = conf.Buffer.MaxUnconfirmedBlockRead
+		_blockBuffer.maxBlockInMemory = conf.Buffer.MaxBlockInMemory
+		_blockBuffer.maxConfirmedTrx = conf.Buffer.MaxConfirmedTrx
 
-		go _blockBuffer.backgroundWorker()
+		blockHeight.Func(func() float64 { return float64(_blockBuffer.GetMaxConfirmedBlockID()) }, "db")
+		blockHeight.Func(func() float64 { return float64(_blockBuffer.GetFullNodeMaxBlockID()) }, "fullnode")
+		blockHeight.Func(func() float64 { return float64(_blockBuffer.GetSolidityNodeMaxBlockID()) }, "solidity")
+
+		lifecycle.Go("blockBuffer", _blockBuffer.backgroundWorker)
 		go _blockBuffer.backgroundSwaper()
 
 	})
@@ -162,11 +181,25 @@ func GetBlockBuffer() Buffer {
 func initRedis(redisAddr []string) {
 	redisOpt := &redis.Options{
 		Addr:     redisAddr[0],
-		Password: "",
-		DB:       0,
+		Password: config.Get().Redis.Pass,
+		DB:       config.Get().Redis.Index,
 	}
 	_redisCli = redis.NewClient(redisOpt)
 
 	pong, err := _redisCli.Ping().Result()
 	fmt.Println(pong, err)
 }
+
+//redisWithContext ctx中有span时返回将命令记录为子span的客户端，与 _redisCli 共用连接池
+func redisWithContext(ctx context.Context) *redis.Client {
+	if trace.FromContext(ctx) == nil {
+		return _redisCli
+	}
+	client := _redisCli.WithContext(ctx)
+	client.WrapProcess(func(process func(cmd redis.Cmder) error) func(cmd redis.Cmder) error {
+		return func(cmd redis.Cmder) error {
+			return tronredis.TraceCommand(ctx, cmd.Name(), func() error { return process(cmd) })
+		}
+	})
+	return client
+}
@@ -1,6 +1,7 @@
 package buffer
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
@@ -13,7 +14,9 @@ import (
 	"github.com/tronprotocol/grpc-gateway/core"
 	"github.com/wlcy/tron/explorer/core/grpcclient"
 	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/trace"
 	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/module"
 )
@@ -57,6 +60,8 @@ type blockBuffer struct {
 
 	transactionCount int64 //total transaction record
 	transferCount    int64 //total transaction record
+
+	refresh chan struct{} // 管理接口请求立即同步
 }
 
 func (b *blockBuffer) getSolidityNodeMaxBlockID() bool {
@@ -150,7 +155,7 @@ func (b *blockBuffer) getNowConfirmedBlock() []*entity.BlockInfo {
 		limit = "limit 1000"
 	}
 
-	blocks, err := module.QueryBlocksRealize(strSQL, filter, orderBy, limit)
+	blocks, err := module.QueryBlocksRealize(context.Background(), strSQL, filter, orderBy, limit)
 	if nil != err || nil == blocks || 0 == len(blocks.Data) {
 		return nil
 	}
@@ -191,10 +196,12 @@ func (b *blockBuffer) bufferBlock(blocks []*entity.BlockInfo) bool {
 }
 
 // include numEnd
-func (b *blockBuffer) readBuffer(numStart int64, numEnd int64) []*entity.BlockInfo {
+func (b *blockBuffer) readBuffer(ctx context.Context, numStart int64, numEnd int64) []*entity.BlockInfo {
 	if numStart > numEnd {
 		return nil
 	}
+	ctx, span := trace.Start(ctx, "buffer.readBlocks", "block.start", numStart, "block.end", numEnd)
+	defer span.End()
 
 	// log.Debugf("readbuffer %v ~ %v (%v)\n", numStart, numEnd, numEnd-numStart+1)
 	curMaxBlockID := b.GetMaxBlockID()
@@ -229,11 +236,15 @@ func (b *blockBuffer) readBuffer(numStart int64, numEnd int64) []*entity.BlockIn
 		}
 	}
 	log.Debugf("readBuffer get from buffer:%v, missing:%v\n", len(ret), len(missingBlockID))
+	blockReads.With("memory").Add(float64(len(ret)))
+	span.SetAttr("buffer.memory", len(ret))
 
 	if len(missingBlockID) > 0 {
 		ts := time.Now()
 		var redisBuf []*entity.BlockInfo
-		redisBuf, missingBlockID = b.loadBlockFromRedis(missingBlockID)
+		redisBuf, missingBlockID = b.loadBlockFromRedis(ctx, missingBlockID)
+		blockReads.With("redis").Add(float64(len(redisBuf)))
+		span.SetAttr("buffer.redis", len(redisBuf))
 
 		if len(redisBuf) > 0 {
 			ret = append(ret, redisBuf...)
@@ -243,7 +254,9 @@ func (b *blockBuffer) readBuffer(numStart int64, numEnd int64) []*entity.BlockIn
 
 	if len(missingBlockID) > 0 {
 		ts := time.Now()
-		blocks := b.getBlocksStableB(missingBlockID)
+		blocks := b.getBlocksStableB(ctx, missingBlockID)
+		blockReads.With("db").Add(float64(len(blocks)))
+		span.SetAttr("buffer.db", len(blocks))
 		log.Debugf("readbuffer load from db cost:%v, size:%v\n", time.Since(ts), len(blocks))
 		b.bufferBlock(blocks)
 		ret = append(ret, blocks...)
@@ -254,7 +267,7 @@ func (b *blockBuffer) readBuffer(numStart int64, numEnd int64) []*entity.BlockIn
 	return ret
 }
 
-func (b *blockBuffer) backgroundWorker() {
+func (b *blockBuffer) backgroundWorker(ctx context.Context) {
 	minInterval := time.Duration(10) * time.Second
 	for {
 		ts := time.Now()
@@ -262,6 +275,9 @@ func (b *blockBuffer) backgroundWorker() {
 		b.getNowConfirmedBlock()
 		//log.Debugf("111-%v, %v, %v, %v\n", b.GetMaxBlockID(), b.GetMaxConfirmedBlockID(), b.GetSolidityNodeMaxBlockID(), b.GetFullNodeMaxBlockID())
 		for {
+			if ctx.Err() != nil {
+				return
+			}
 			if b.getSolidityNodeMaxBlockID() {
 				log.Debugf("222-%v, %v, %v, %v\n", b.GetMaxBlockID(), b.GetMaxConfirmedBlockID(), b.GetSolidityNodeMaxBlockID(), b.GetFullNodeMaxBlockID())
 				break
@@ -269,6 +285,9 @@ func (b *blockBuffer) backgroundWorker() {
 			//log.Debugf("333-%v, %v, %v, %v\n", b.GetMaxBlockID(), b.GetMaxConfirmedBlockID(), b.GetSolidityNodeMaxBlockID(), b.GetFullNodeMaxBlockID())
 		}
 		for {
+			if ctx.Err() != nil {
+				return
+			}
 			if b.getNowBlock() {
 				//log.Debugf("444-%v, %v, %v, %v\n", b.GetMaxBlockID(), b.GetMaxConfirmedBlockID(), b.GetSolidityNodeMaxBlockID(), b.GetFullNodeMaxBlockID())
 				break
@@ -277,29 +296,61 @@ func (b *blockBuffer) backgroundWorker() {
 		}
 
 		tsc := time.Since(ts)
-		if tsc < minInterval {
-			time.Sleep(minInterval - tsc)
+		if tsc < minInterval && !b.waitRefresh(ctx, minInterval-tsc) {
+			return
 		}
 
 	}
 }
 
+//waitRefresh 等待d，收到立即同步的请求时提前返回，ctx取消时返回false
+func (b *blockBuffer) waitRefresh(ctx context.Context, d time.Duration) bool {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return false
+	case <-t.C:
+		return true
+	case <-b.refresh:
+		return true
+	}
+}
+
+//requestRefresh 请求后台任务立即同步一次，已有未处理的请求时忽略
+func (b *blockBuffer) requestRefresh() {
+	select {
+	case b.refresh <- struct{}{}:
+	default:
+	}
+}
+
+//flushMemory 丢弃内存中的区块和交易，之后从redis或db重新读取
+func (b *blockBuffer) flushMemory() {
+	b.buffer.Range(func(key, val interface{}) bool {
+		if id, ok := key.(int64); ok {
+			b.cleanBufferBlock(id)
+		}
+		return true
+	})
+}
+
 func (b *blockBuffer) backgroundSwaper() {
 
-	go b.sweepBlockBuffer()
+	lifecycle.Go("blockBufferSweep", b.sweepBlockBuffer)
 	//go b.sweepTrxHash()
-	go b.sweepTransactionRedisList()
+	lifecycle.Go("transactionRedisSweep", b.sweepTransactionRedisList)
 }
 
-func (b *blockBuffer) sweepBlockBuffer() {
+func (b *blockBuffer) sweepBlockBuffer(ctx context.Context) {
 	minInterval := time.Duration(10) * time.Second
 	swapData := make([]*entity.BlockInfo, b.maxBlockInMemory)
 	for {
 		ts := time.Now()
 
 		tsc := time.Since(ts)
-		if tsc < minInterval {
-			time.Sleep(minInterval - tsc)
+		if tsc < minInterval && !lifecycle.Wait(ctx, minInterval-tsc) {
+			return
 		}
 
 		maxConfirmedBlockID := b.GetMaxConfirmedBlockID()
@@ -397,11 +448,12 @@ func getRedisBlockKey(blockID interface{}) string {
 }
 
 // loadBlockFromRedis 从redis读取block
-func (b *blockBuffer) loadBlockFromRedis(blockIDs []string) ([]*entity.BlockInfo, []string) {
+func (b *blockBuffer) loadBlockFromRedis(ctx context.Context, blockIDs []string) ([]*entity.BlockInfo, []string) {
 	ret := make([]*entity.BlockInfo, 0, len(blockIDs))
 	retIDs := make([]string, 0, len(blockIDs))
+	redisCli := redisWithContext(ctx)
 	for _, blockID := range blockIDs {
-		data, err := _redisCli.Get(getRedisBlockKey(blockID)).Result()
+		data, err := redisCli.Get(getRedisBlockKey(blockID)).Result()
 		if nil != err || 0 == len(data) {
 			retIDs = append(retIDs, blockID)
 		} else {
@@ -451,7 +503,7 @@ func (b *blockBuffer) getBlocksStable(numStart int64, numEnd int64) []*core.Bloc
 }
 
 // numEnd do not need to get
-func (b *blockBuffer) getBlocksStableB(blockIDs []string) []*entity.BlockInfo {
+func (b *blockBuffer) getBlocksStableB(ctx context.Context, blockIDs []string) []*entity.BlockInfo {
 	if len(blockIDs) == 0 {
 		return nil
 	}
@@ -467,7 +519,7 @@ func (b *blockBuffer) getBlocksStableB(blockIDs []string) []*entity.BlockInfo {
 	where 1=1`)
 	// log.Debugf("read buffer from db filter:[%v]", filter)
 
-	retRaw, _ := module.QueryBlocksRealize(strSQL, filter, "", "")
+	retRaw, _ := module.QueryBlocksRealize(ctx, strSQL, filter, "", "")
 	return retRaw.Data
 
 	// ret := make([]*entity.BlockInfo, 0, len(blockIDs))
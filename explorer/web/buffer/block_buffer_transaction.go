@@ -65,6 +65,16 @@ func (b *blockBuffer) GetTransactionByBlockID(blockID int64) []*entity.Transacti
 	return b.getConfirmedBlockTransaction(blockID)
 }
 
+// GetTransactionByBlockIDs 批量读取多个区块的交易，缓存中没有的已确认区块一次从db读取
+func (b *blockBuffer) GetTransactionByBlockIDs(blockIDs []int64) map[int64][]*entity.TransactionInfo {
+	b.preloadConfirmedBlockTransaction(blockIDs)
+	ret := make(map[int64][]*entity.TransactionInfo, len(blockIDs))
+	for _, blockID := range blockIDs {
+		ret[blockID] = b.GetTransactionByBlockID(blockID)
+	}
+	return ret
+}
+
 func (b *blockBuffer) GetTransactionByHash(hash string) *entity.TransactionInfo {
 
 	if trans, ok := b.trxHash.Load(hash); ok {
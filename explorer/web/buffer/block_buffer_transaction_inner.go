@@ -1,14 +1,17 @@
 package buffer
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"sort"
+	"strings"
 	"sync/atomic"
 	"time"
 
 	"github.com/tronprotocol/grpc-gateway/core"
 	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/mysql"
 	"github.com/wlcy/tron/explorer/web/entity"
@@ -27,7 +30,13 @@ func (b *blockBuffer) getConfirmedBlockTransaction(blockID int64) []*entity.Tran
 
 	filter := fmt.Sprintf(` and block_id = '%v'`, blockID)
 	retTrxs := b.loadTransactionFromDBFilter(filter)
+	b.storeConfirmedBlockTransaction(blockID, retTrxs)
 
+	return retTrxs
+}
+
+// storeConfirmedBlockTransaction 缓存从db读取的已确认区块的交易，同时生成转账
+func (b *blockBuffer) storeConfirmedBlockTransaction(blockID int64, retTrxs []*entity.TransactionInfo) {
 	if nil != retTrxs {
 		b.cBlockTrx.Store(blockID, retTrxs)
 	}
@@ -41,8 +50,38 @@ func (b *blockBuffer) getConfirmedBlockTransaction(blockID int64) []*entity.Tran
 		}
 	}
 	b.cBlockTrans.Store(blockID, transList)
+}
 
-	return retTrxs
+// preloadConfirmedBlockTransaction 缓存中没有的已确认区块，用一条SQL读取交易并缓存
+func (b *blockBuffer) preloadConfirmedBlockTransaction(blockIDs []int64) {
+	maxConfirmedBlockID := b.GetMaxConfirmedBlockID()
+	missList := make([]int64, 0)
+	idList := make([]string, 0)
+	for _, blockID := range blockIDs {
+		if _, ok := b.cBlockTrx.Load(blockID); ok || blockID > maxConfirmedBlockID {
+			continue
+		}
+		missList = append(missList, blockID)
+		idList = append(idList, fmt.Sprintf("%v", blockID))
+	}
+	if len(missList) == 0 {
+		return
+	}
+	retTrxs := b.loadTransactionFromDBFilter(fmt.Sprintf(" and block_id in (%v)", strings.Join(idList, ",")))
+	if nil == retTrxs { // 读取失败时不缓存，下次重新读取
+		return
+	}
+	blockTrxs := make(map[int64][]*entity.TransactionInfo, len(missList))
+	for _, trx := range retTrxs {
+		blockTrxs[trx.Block] = append(blockTrxs[trx.Block], trx)
+	}
+	for _, blockID := range missList {
+		trxs := blockTrxs[blockID]
+		if nil == trxs {
+			trxs = make([]*entity.TransactionInfo, 0)
+		}
+		b.storeConfirmedBlockTransaction(blockID, trxs)
+	}
 }
 
 // sweep transaction buffer size
@@ -496,14 +535,14 @@ func (b *blockBuffer) getTrxDescListFromRedis(offset, count int64) (ret []*entit
 	return ret
 }
 
-func (b *blockBuffer) sweepTransactionRedisList() {
+func (b *blockBuffer) sweepTransactionRedisList(ctx context.Context) {
 	minInterval := time.Duration(600) * time.Second // 10 分钟
 	for {
 		ts := time.Now()
 
 		tsc := time.Since(ts)
-		if tsc < minInterval {
-			time.Sleep(minInterval - tsc)
+		if tsc < minInterval && !lifecycle.Wait(ctx, minInterval-tsc) {
+			return
 		}
 
 		_redisCli.LTrim(TrxRedisDescListKey, 0, int64(b.maxConfirmedTrx)*2) // clean transaction redis
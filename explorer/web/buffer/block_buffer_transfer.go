@@ -69,6 +69,16 @@ func (b *blockBuffer) GetTransferByBlockID(blockID int64) []*entity.TransferInfo
 	return nil
 }
 
+// GetTransferByBlockIDs 批量读取多个区块的转账，缓存中没有的已确认区块一次从db读取
+func (b *blockBuffer) GetTransferByBlockIDs(blockIDs []int64) map[int64][]*entity.TransferInfo {
+	b.preloadConfirmedBlockTransaction(blockIDs)
+	ret := make(map[int64][]*entity.TransferInfo, len(blockIDs))
+	for _, blockID := range blockIDs {
+		ret[blockID] = b.GetTransferByBlockID(blockID)
+	}
+	return ret
+}
+
 func (b *blockBuffer) GetTransferByHash(hash string) *entity.TransferInfo {
 	return nil
 }
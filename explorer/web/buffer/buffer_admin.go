@@ -0,0 +1,126 @@
+package buffer
+
+import "sort"
+
+/*
+管理接口刷新缓存
+	reload 立即从数据源重新加载，加载失败时保留原来的数据
+	flush  先丢弃缓存的数据再重新加载，用于数据修正后清除错误的缓存
+区块缓存由后台任务同步，reload 和 flush 只通知后台任务立即同步，不等待同步完成
+*/
+
+type bufferAdmin struct {
+	reload func()
+	clear  func() // flush 时在重新加载前调用，为nil时 flush 和 reload 相同
+}
+
+var bufferAdmins = map[string]*bufferAdmin{
+	"block": {
+		reload: func() { GetBlockBuffer(); _blockBuffer.requestRefresh() },
+		clear:  func() { GetBlockBuffer(); _blockBuffer.flushMemory() },
+	},
+	"witness": {
+		reload: func() { w := getWitnessBuffer(); w.load(); w.loadStatistic() },
+		clear: func() {
+			w := getWitnessBuffer()
+			w.Lock()
+			w.addrMap, w.sortList, w.statisticList = nil, nil, nil
+			w.Unlock()
+		},
+	},
+	"market": {
+		reload: func() { getMarketBuffer().load() },
+		clear: func() {
+			w := getMarketBuffer()
+			w.Lock()
+			w.marketInfoList = nil
+			w.Unlock()
+		},
+	},
+	"vote": {
+		reload: func() {
+			w := getVoteBuffer()
+			w.loadQueryVoteLive()
+			w.getMaintenanceTimeStamp()
+			w.loadQueryVoteCurrentCycle()
+		},
+		clear: func() {
+			w := getVoteBuffer()
+			w.Lock()
+			w.voteLive, w.voteCurrentCycle = nil, nil
+			w.Unlock()
+		},
+	},
+	"accountToken": {
+		reload: func() { getAccountTokenBuffer().getAccountTokenBuffer() },
+		clear: func() {
+			w := getAccountTokenBuffer()
+			w.Lock()
+			w.accountTokenInfoList = nil
+			w.Unlock()
+		},
+	},
+	//读取时为空会在读锁内重新加载，不能清空
+	"token": {
+		reload: func() { w := getTokenBuffer(); w.loadCommonQueryTokens(); w.loadIcoQueryTokens() },
+	},
+	//清空后所有api key都会被拒绝，只重新加载
+	"apiKey": {
+		reload: func() { getAPIKeyBuffer().load() },
+	},
+	"label": {
+		reload: func() { getLabelBuffer().load() },
+	},
+	//清空后不再保留已过期的价格
+	"price": {
+		reload: func() { getPriceBuffer().load() },
+		clear: func() {
+			w := getPriceBuffer()
+			w.Lock()
+			w.prices = nil
+			w.Unlock()
+		},
+	},
+	"chainParameter": {
+		reload: func() { getChainParameterBuffer().load() },
+		clear: func() {
+			w := getChainParameterBuffer()
+			w.Lock()
+			w.params = nil
+			w.Unlock()
+		},
+	},
+}
+
+//BufferNames 可以刷新的缓存名称
+func BufferNames() []string {
+	names := make([]string, 0, len(bufferAdmins))
+	for name := range bufferAdmins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+//ReloadBuffer 立即重新加载缓存，没有该缓存时返回false
+func ReloadBuffer(name string) bool {
+	admin, ok := bufferAdmins[name]
+	if !ok {
+		return false
+	}
+	admin.reload()
+	return true
+}
+
+//FlushBuffer 丢弃缓存的数据后重新加载，没有该缓存时返回false
+func FlushBuffer(name string) bool {
+	admin, ok := bufferAdmins[name]
+	if !ok {
+		return false
+	}
+	if admin.clear != nil {
+		admin.clear()
+	}
+	admin.reload()
+	return true
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"time"
@@ -65,7 +66,7 @@ func getMaxBlockIDTrx() {
 	bb := buffer.GetBlockBuffer()
 
 	maxBlockID := bb.GetMaxBlockID()
-	block := bb.GetBlock(maxBlockID)
+	block := bb.GetBlock(context.Background(), maxBlockID)
 	trxs := bb.GetTransactionByBlockID(maxBlockID)
 	if 0 == len(trxs) {
 		fmt.Printf("get max blockID (%v) %v\n\ttrxs empty!\n\n", maxBlockID, utils.ToJSONStr(block))
@@ -116,7 +117,7 @@ func getBlocks(start, rs, re int64) {
 	tsr := time.Now()
 	bb := buffer.GetBlockBuffer()
 
-	ret, _ := bb.GetBlocks(start, rs, re)
+	ret, _ := bb.GetBlocks(context.Background(), start, rs, re)
 	retLen := len(ret)
 	var c, unc int
 	var minCBlockID int64 = 900000000
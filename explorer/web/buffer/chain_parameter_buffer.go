@@ -0,0 +1,112 @@
+package buffer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/log"
+)
+
+/*
+store chain parameters in memory
+load from fullnode every 10 minutes
+*/
+
+//链参数名，与 Wallet.GetChainParameters 返回的key一致
+const (
+	ChainParamMaintenanceTimeInterval = "getMaintenanceTimeInterval"
+	ChainParamMaintenanceSkipSlots    = "getMaintenanceSkipSlots"
+	ChainParamWitnessPayPerBlock      = "getWitnessPayPerBlock"
+	ChainParamWitnessStandbyAllowance = "getWitnessStandbyAllowance"
+)
+
+//defaultChainParameters 节点不支持 GetChainParameters 时使用的主网默认值，单位sun或ms，维护周期使用当前网络的配置
+var defaultChainParameters = map[string]int64{
+	ChainParamMaintenanceTimeInterval: 6 * 60 * 60 * 1000,
+	ChainParamMaintenanceSkipSlots:    2,
+	ChainParamWitnessPayPerBlock:      32000000,
+	ChainParamWitnessStandbyAllowance: 115200000000,
+}
+
+var _chainParameterBuffer *chainParameterBuffer
+var onceChainParameterBuffer sync.Once
+
+//GetChainParameterBuffer ...
+func GetChainParameterBuffer() *chainParameterBuffer {
+	return getChainParameterBuffer()
+}
+
+func getChainParameterBuffer() *chainParameterBuffer {
+	onceChainParameterBuffer.Do(func() {
+		_chainParameterBuffer = &chainParameterBuffer{}
+		_chainParameterBuffer.load()
+
+		lifecycle.Go("chainParameterBuffer", chainParameterBufferLoader)
+	})
+	return _chainParameterBuffer
+}
+
+func chainParameterBufferLoader(ctx context.Context) {
+	for lifecycle.Wait(ctx, 10*time.Minute) {
+		_chainParameterBuffer.load()
+	}
+}
+
+type chainParameterBuffer struct {
+	sync.RWMutex
+
+	params map[string]int64
+}
+
+//GetChainParameter 获取链参数，节点未返回时使用默认值
+func (w *chainParameterBuffer) GetChainParameter(key string) int64 {
+	w.RLock()
+	value, ok := w.params[key]
+	w.RUnlock()
+	if !ok {
+		value = defaultChainParameter(key)
+	}
+	return value
+}
+
+//GetChainParameters 获取全部链参数
+func (w *chainParameterBuffer) GetChainParameters() map[string]int64 {
+	params := make(map[string]int64, len(defaultChainParameters))
+	for key := range defaultChainParameters {
+		params[key] = defaultChainParameter(key)
+	}
+	w.RLock()
+	for key, value := range w.params {
+		params[key] = value
+	}
+	w.RUnlock()
+	return params
+}
+
+func defaultChainParameter(key string) int64 {
+	if key == ChainParamMaintenanceTimeInterval {
+		return utils.CurrentNetwork().MaintenanceInterval
+	}
+	return defaultChainParameters[key]
+}
+
+func (w *chainParameterBuffer) load() {
+	client := grpcclient.GetRandomWallet()
+	chainParams, err := client.GetChainParameters()
+	if err != nil || chainParams == nil {
+		log.Errorf("load chain parameters from fullnode err:[%v]", err)
+		return
+	}
+	params := make(map[string]int64, len(chainParams.ChainParameter))
+	for _, param := range chainParams.ChainParameter {
+		params[param.Key] = param.Value
+	}
+	w.Lock()
+	w.params = params
+	w.Unlock()
+	log.Infof("load chain parameters from fullnode, size:[%v]", len(params))
+}
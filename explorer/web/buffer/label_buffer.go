@@ -0,0 +1,78 @@
+package buffer
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+/*
+store all public address labels in memory
+load from db every 5 minutes, reload after admin changes
+*/
+
+var _labelBuffer *labelBuffer
+var onceLabelBuffer sync.Once
+
+//GetLabelBuffer ...
+func GetLabelBuffer() *labelBuffer {
+	return getLabelBuffer()
+}
+
+func getLabelBuffer() *labelBuffer {
+	onceLabelBuffer.Do(func() {
+		_labelBuffer = &labelBuffer{}
+		_labelBuffer.load()
+
+		lifecycle.Go("labelBuffer", labelBufferLoader)
+	})
+	return _labelBuffer
+}
+
+func labelBufferLoader(ctx context.Context) {
+	for lifecycle.Wait(ctx, 5*time.Minute) {
+		_labelBuffer.load()
+	}
+}
+
+type labelBuffer struct {
+	sync.RWMutex
+
+	labelMap map[string]*entity.AddressLabel
+}
+
+//GetLabel 获取地址的公开标签，没有标签时返回nil
+func (w *labelBuffer) GetLabel(address string) *entity.AddressLabel {
+	w.RLock()
+	label := w.labelMap[address]
+	w.RUnlock()
+	return label
+}
+
+//Reload 管理员修改公开标签后立即刷新
+func (w *labelBuffer) Reload() {
+	w.load()
+}
+
+func (w *labelBuffer) load() {
+	labels, err := module.QueryLabelsRealize(context.Background(), `
+	select id, owner_key, address, label, category, note, update_time
+	from wlcy_address_label where owner_key=''`)
+	if err != nil {
+		log.Errorf("load address label error:[%v]", err)
+		return
+	}
+	labelMap := make(map[string]*entity.AddressLabel, len(labels))
+	for _, label := range labels {
+		labelMap[label.Address] = label
+	}
+	w.Lock()
+	w.labelMap = labelMap
+	w.Unlock()
+	log.Infof("address label in buffer :data done, count:[%v]", len(labelMap))
+}
@@ -1,21 +1,16 @@
 package buffer
 
 import (
-	"strconv"
+	"sort"
 	"strings"
 	"sync"
-	"time"
 
-	"github.com/PuerkitoBio/goquery"
-	"github.com/parnurzeal/gorequest"
-	"github.com/wlcy/tron/explorer/lib/log"
-	"github.com/wlcy/tron/explorer/lib/mysql"
 	"github.com/wlcy/tron/explorer/web/entity"
 )
 
 /*
-store all marketBuffer data in memory
-load from db every 30 seconds
+markets are built from the latest TRX quotes of price providers
+no data is kept in this buffer, price buffer refreshes the quotes every minute
 */
 
 var _marketBuffer *marketBuffer
@@ -29,76 +24,41 @@ func GetMarketBuffer() *marketBuffer {
 // getMarketBuffer
 func getMarketBuffer() *marketBuffer {
 	onceMarketOnce.Do(func() {
-		_marketBuffer = &marketBuffer{}
-		_marketBuffer.load()
-
-		go marketBufferLoader()
+		_marketBuffer = &marketBuffer{prices: getPriceBuffer()}
 	})
 	return _marketBuffer
 }
 
-func marketBufferLoader() {
-	for {
-		_marketBuffer.load()
-		time.Sleep(5 * time.Second)
-	}
-}
-
 type marketBuffer struct {
-	sync.RWMutex
-
-	marketInfoList []*entity.MarketInfo
-
-	updateTime string
+	prices *priceBuffer
 }
 
-func (w *marketBuffer) GetMarket() (witness []*entity.MarketInfo) {
-	if len(w.marketInfoList) == 0 {
-		log.Infof("get market info from buffer nil, data reload at :[%v]", w.updateTime)
-		w.load()
-	}
-	log.Infof("get market info from buffer, buffer data updated at :[%v]", w.updateTime)
-	return w.marketInfoList
+//GetMarket 各行情来源的TRX报价，按来源名称排序，行情接口不提供成交量，成交量为0
+func (w *marketBuffer) GetMarket() []*entity.MarketInfo {
+	return getQuoteMarkets(w.prices.GetQuotes())
 }
 
-func (w *marketBuffer) load() {
-	marketInfos := make([]*entity.MarketInfo, 0)
-	marketURL := "https://coinmarketcap.com/currencies/tron/"
-	_, body, errs := gorequest.New().Get(marketURL).End()
-	if errs != nil && len(errs) > 0 {
-		log.Error(errs)
-		return
-	}
-	doc, err := goquery.NewDocumentFromReader(strings.NewReader(body))
-	if err != nil {
-		log.Error(err)
-		return
+//getQuoteMarkets 每个TRX报价作为一个市场，名称为行情来源，交易对为 TRX/计价币种
+func getQuoteMarkets(quotes []*entity.PriceQuote) []*entity.MarketInfo {
+	markets := make([]*entity.MarketInfo, 0)
+	for _, quote := range quotes {
+		if quote.Symbol != PriceSymbolTRX {
+			continue
+		}
+		markets = append(markets, &entity.MarketInfo{
+			Name:  quote.Source,
+			Pair:  strings.Join([]string{quote.Symbol, quote.Currency}, "/"),
+			Price: quote.Price,
+		})
 	}
-	doc.Find("#markets-table > tbody > tr").Each(func(i int, s *goquery.Selection) {
-		marketInfo := &entity.MarketInfo{}
-		node := strconv.Itoa(i + 1)
-		rank, _ := s.Find("tr:nth-child(" + node + ") > td:nth-child(1)").Html()
-		name, _ := s.Find("tr:nth-child(" + node + ") > td:nth-child(2)").Attr("data-sort")
-		pair, _ := s.Find("tr:nth-child(" + node + ") > td:nth-child(3)").Attr("data-sort")
-		link, _ := s.Find("tr:nth-child(" + node + ") > td:nth-child(3) > a").Attr("href")
-		volume, _ := s.Find("tr:nth-child(" + node + ") > td:nth-child(4) > span[class=volume]").Attr("data-usd")
-		volumeNative, _ := s.Find("tr:nth-child(" + node + ") > td:nth-child(4) > span[class=volume]").Attr("data-native")
-		price, _ := s.Find("tr:nth-child(" + node + ") > td:nth-child(5) > span[class=price]").Attr("data-usd")
-		volumePercentage, _ := s.Find("tr:nth-child(" + node + ") > td:nth-child(6)").Attr("data-sort")
-		marketInfo.Rank = mysql.ConvertStringToInt64(rank, 0)
-		marketInfo.Name = name
-		marketInfo.Pair = pair
-		marketInfo.Link = link
-		marketInfo.Volume = mysql.ConvertStringToFloat(volume, 0)
-		marketInfo.VolumeNative = mysql.ConvertStringToFloat(volumeNative, 0)
-		marketInfo.VolumePercentage = mysql.ConvertStringToFloat(volumePercentage, 0)
-		marketInfo.Price = mysql.ConvertStringToFloat(price, 0)
-		marketInfos = append(marketInfos, marketInfo)
+	sort.SliceStable(markets, func(i, j int) bool {
+		if markets[i].Name != markets[j].Name {
+			return markets[i].Name < markets[j].Name
+		}
+		return markets[i].Pair < markets[j].Pair
 	})
-
-	log.Infof("market in buffer : parse page data done.")
-	w.Lock()
-	w.marketInfoList = marketInfos
-	w.updateTime = time.Now().Local().Format(mysql.DATETIMEFORMAT)
-	w.Unlock()
+	for i, market := range markets {
+		market.Rank = int64(i + 1)
+	}
+	return markets
 }
@@ -0,0 +1,169 @@
+package buffer
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+/*
+store aggregated prices in memory
+load from price providers every minute, save fresh prices to wlcy_price_history
+*/
+
+var _priceBuffer *priceBuffer
+var oncePriceBuffer sync.Once
+
+//GetPriceBuffer ...
+func GetPriceBuffer() *priceBuffer {
+	return getPriceBuffer()
+}
+
+func getPriceBuffer() *priceBuffer {
+	oncePriceBuffer.Do(func() {
+		_priceBuffer = &priceBuffer{
+			providers: newPriceProviders(),
+			prices:    make(map[string]*entity.PriceInfo),
+		}
+		_priceBuffer.load()
+
+		lifecycle.Go("priceBuffer", priceBufferLoader)
+	})
+	return _priceBuffer
+}
+
+func priceBufferLoader(ctx context.Context) {
+	for lifecycle.Wait(ctx, 1*time.Minute) {
+		_priceBuffer.load()
+	}
+}
+
+type priceBuffer struct {
+	sync.RWMutex
+
+	providers []PriceProvider
+	prices    map[string]*entity.PriceInfo
+	quotes    []*entity.PriceQuote // 最近一次读取的各来源报价
+}
+
+//GetPrices 获取全部聚合后的价格
+func (w *priceBuffer) GetPrices() []*entity.PriceInfo {
+	w.RLock()
+	prices := make([]*entity.PriceInfo, 0, len(w.prices))
+	for _, price := range w.prices {
+		priceCopy := *price
+		prices = append(prices, &priceCopy)
+	}
+	w.RUnlock()
+	sort.Slice(prices, func(i, j int) bool {
+		if prices[i].Symbol != prices[j].Symbol {
+			return prices[i].Symbol < prices[j].Symbol
+		}
+		return prices[i].Currency < prices[j].Currency
+	})
+	return prices
+}
+
+//GetQuotes 获取最近一次读取的各来源报价
+func (w *priceBuffer) GetQuotes() []*entity.PriceQuote {
+	w.RLock()
+	defer w.RUnlock()
+	quotes := make([]*entity.PriceQuote, 0, len(w.quotes))
+	for _, quote := range w.quotes {
+		quoteCopy := *quote
+		quotes = append(quotes, &quoteCopy)
+	}
+	return quotes
+}
+
+//GetPrice 获取聚合后的价格，没有价格时返回nil
+func (w *priceBuffer) GetPrice(symbol, currency string) *entity.PriceInfo {
+	w.RLock()
+	defer w.RUnlock()
+	if price, ok := w.prices[getPriceKey(symbol, currency)]; ok {
+		priceCopy := *price
+		return &priceCopy
+	}
+	return nil
+}
+
+func (w *priceBuffer) load() {
+	now := time.Now().UnixNano() / 1e6
+	quotes := getProviderQuotes(w.providers)
+
+	w.RLock()
+	prices := aggregatePrices(quotes, w.prices, now, config.PriceStaleSeconds*1000)
+	w.RUnlock()
+
+	history := make([]*entity.PriceInfo, 0, len(prices))
+	for key, price := range prices {
+		if !price.Stale {
+			history = append(history, price)
+		} else if previous, ok := w.prices[key]; ok && !previous.Stale {
+			log.Errorf("price [%v/%v] stale, last update at:[%v]", price.Symbol, price.Currency, price.Time)
+		}
+	}
+	module.InsertPriceHistory(history)
+
+	w.Lock()
+	w.prices = prices
+	w.quotes = quotes
+	w.Unlock()
+	log.Infof("price in buffer : quotes:[%v] prices:[%v] fresh:[%v]", len(quotes), len(prices), len(history))
+}
+
+func getPriceKey(symbol, currency string) string {
+	return symbol + "/" + currency
+}
+
+//aggregatePrices 未过期的报价按币种取中位数，时间取整到分钟
+//没有未过期报价的币种沿用上次的价格并标记为过期
+func aggregatePrices(quotes []*entity.PriceQuote, previous map[string]*entity.PriceInfo, now, staleMs int64) map[string]*entity.PriceInfo {
+	values := make(map[string][]float64)
+	for _, quote := range quotes {
+		if quote.Price <= 0 || now-quote.Time > staleMs {
+			continue
+		}
+		key := getPriceKey(quote.Symbol, quote.Currency)
+		values[key] = append(values[key], quote.Price)
+	}
+	prices := make(map[string]*entity.PriceInfo, len(values))
+	for _, quote := range quotes {
+		key := getPriceKey(quote.Symbol, quote.Currency)
+		if _, ok := prices[key]; ok || len(values[key]) == 0 {
+			continue
+		}
+		price := &entity.PriceInfo{}
+		price.Symbol = quote.Symbol
+		price.Currency = quote.Currency
+		price.Price = getMedianPrice(values[key])
+		price.Time = now - now%60000
+		price.Sources = int64(len(values[key]))
+		prices[key] = price
+	}
+	for key, price := range previous {
+		if _, ok := prices[key]; !ok {
+			priceCopy := *price
+			priceCopy.Stale = true
+			prices[key] = &priceCopy
+		}
+	}
+	return prices
+}
+
+func getMedianPrice(values []float64) float64 {
+	sorted := append([]float64{}, values...)
+	sort.Float64s(sorted)
+	middle := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[middle-1] + sorted[middle]) / 2
+	}
+	return sorted[middle]
+}
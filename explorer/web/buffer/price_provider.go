@@ -0,0 +1,210 @@
+package buffer
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/parnurzeal/gorequest"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+//计价币种
+const (
+	PriceSymbolTRX   = "TRX"
+	PriceCurrencyUSD = "USD"
+	PriceCurrencyTRX = "TRX"
+)
+
+//exchangeTrxTokenID 交易对中TRX的通证名，余额单位为sun
+const exchangeTrxTokenID = "_"
+
+//PriceProvider 行情来源，报价时间用于判断是否过期
+type PriceProvider interface {
+	Name() string
+	GetQuotes() ([]*entity.PriceQuote, error)
+}
+
+//newPriceProviders 按配置创建行情来源
+func newPriceProviders() []PriceProvider {
+	providers := make([]PriceProvider, 0, len(config.PriceProviders))
+	for _, name := range config.PriceProviders {
+		switch name {
+		case "exchange":
+			providers = append(providers, &exchangePriceProvider{usdToken: config.PriceUSDToken})
+		case "fixture":
+			providers = append(providers, &fixturePriceProvider{file: config.PriceFixture})
+		default:
+			if source, ok := config.PriceSources[name]; ok {
+				providers = append(providers, &jsonPriceProvider{name: name, source: source})
+			}
+		}
+	}
+	return providers
+}
+
+//jsonPriceProvider 从json行情接口读取一个价格
+type jsonPriceProvider struct {
+	name   string
+	source *config.PriceSource
+}
+
+func (p *jsonPriceProvider) Name() string {
+	return p.name
+}
+
+func (p *jsonPriceProvider) GetQuotes() ([]*entity.PriceQuote, error) {
+	resp, body, errs := gorequest.New().Timeout(10 * time.Second).Get(p.source.URL).End()
+	if len(errs) > 0 {
+		return nil, errs[0]
+	}
+	if resp.StatusCode != 200 {
+		return nil, fmt.Errorf("status:[%v]", resp.StatusCode)
+	}
+	price, err := getJSONPathPrice([]byte(body), p.source.Path)
+	if err != nil {
+		return nil, err
+	}
+	quote := &entity.PriceQuote{}
+	quote.Symbol = p.source.Symbol
+	quote.Currency = p.source.Currency
+	quote.Price = price
+	quote.Time = time.Now().UnixNano() / 1e6
+	quote.Source = p.name
+	return []*entity.PriceQuote{quote}, nil
+}
+
+//getJSONPathPrice 按.分隔的路径读取价格，数组用下标，价格可以是数字或字符串
+func getJSONPathPrice(body []byte, path string) (float64, error) {
+	var data interface{}
+	if err := json.Unmarshal(body, &data); err != nil {
+		return 0, err
+	}
+	for _, key := range strings.Split(path, ".") {
+		switch v := data.(type) {
+		case map[string]interface{}:
+			data = v[key]
+		case []interface{}:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(v) {
+				return 0, fmt.Errorf("path [%v] index [%v] invalid", path, key)
+			}
+			data = v[index]
+		default:
+			return 0, fmt.Errorf("path [%v] key [%v] not found", path, key)
+		}
+	}
+	var price float64
+	switch v := data.(type) {
+	case float64:
+		price = v
+	case string:
+		price, _ = strconv.ParseFloat(v, 64)
+	}
+	if price <= 0 {
+		return 0, fmt.Errorf("path [%v] price [%v] invalid", path, data)
+	}
+	return price, nil
+}
+
+//exchangePriceProvider 由链上交易对余额计算通证的TRX价格
+//配置了与美元锚定的通证时，同时给出TRX的美元价格
+type exchangePriceProvider struct {
+	usdToken string
+}
+
+func (p *exchangePriceProvider) Name() string {
+	return "exchange"
+}
+
+func (p *exchangePriceProvider) GetQuotes() ([]*entity.PriceQuote, error) {
+	exchangesResp, err := module.QueryExchangesRealize(`
+	select exchange_id, creator_address, first_token_id, first_token_balance,
+		second_token_id, second_token_balance, create_time, block_id
+	from wlcy_exchange`)
+	if err != nil {
+		return nil, err
+	}
+	return getExchangeQuotes(exchangesResp.Data, p.usdToken, time.Now().UnixNano()/1e6), nil
+}
+
+//getExchangeQuotes 与TRX组成交易对的通证，价格为 TRX余额/通证余额，同一通证有多个交易对时各自报价
+func getExchangeQuotes(exchanges []*entity.ExchangeInfo, usdToken string, now int64) []*entity.PriceQuote {
+	quotes := make([]*entity.PriceQuote, 0)
+	for _, exchange := range exchanges {
+		var token string
+		var tokenBalance, trxBalance int64
+		switch exchangeTrxTokenID {
+		case exchange.FirstTokenID:
+			token, tokenBalance, trxBalance = exchange.SecondTokenID, exchange.SecondTokenBalance, exchange.FirstTokenBalance
+		case exchange.SecondTokenID:
+			token, tokenBalance, trxBalance = exchange.FirstTokenID, exchange.FirstTokenBalance, exchange.SecondTokenBalance
+		default:
+			continue
+		}
+		if tokenBalance <= 0 || trxBalance <= 0 {
+			continue
+		}
+		trxAmount := float64(trxBalance) / 1e6
+		quote := &entity.PriceQuote{Symbol: token, Currency: PriceCurrencyTRX, Price: trxAmount / float64(tokenBalance),
+			Time: now, Source: "exchange"}
+		quotes = append(quotes, quote)
+		if usdToken != "" && token == usdToken {
+			quotes = append(quotes, &entity.PriceQuote{Symbol: PriceSymbolTRX, Currency: PriceCurrencyUSD,
+				Price: float64(tokenBalance) / trxAmount, Time: now, Source: "exchange"})
+		}
+	}
+	return quotes
+}
+
+//fixturePriceProvider 从本地json文件读取报价，每次重新读取，报价没有时间时使用读取时间，文件不变时报价不会过期
+//	需要模拟过期时在报价中写明 time
+type fixturePriceProvider struct {
+	file string
+}
+
+func (p *fixturePriceProvider) Name() string {
+	return "fixture"
+}
+
+func (p *fixturePriceProvider) GetQuotes() ([]*entity.PriceQuote, error) {
+	data, err := ioutil.ReadFile(p.file)
+	if err != nil {
+		return nil, err
+	}
+	return parseFixtureQuotes(data, time.Now().UnixNano()/1e6)
+}
+
+func parseFixtureQuotes(data []byte, loadTime int64) ([]*entity.PriceQuote, error) {
+	quotes := make([]*entity.PriceQuote, 0)
+	if err := json.Unmarshal(data, &quotes); err != nil {
+		return nil, err
+	}
+	for _, quote := range quotes {
+		if quote.Time == 0 {
+			quote.Time = loadTime
+		}
+		quote.Source = "fixture"
+	}
+	return quotes, nil
+}
+
+//getProviderQuotes 读取全部行情来源，失败的来源跳过
+func getProviderQuotes(providers []PriceProvider) []*entity.PriceQuote {
+	quotes := make([]*entity.PriceQuote, 0)
+	for _, provider := range providers {
+		providerQuotes, err := provider.GetQuotes()
+		if err != nil {
+			log.Errorf("price provider [%v] get quotes err:[%v]", provider.Name(), err)
+			continue
+		}
+		quotes = append(quotes, providerQuotes...)
+	}
+	return quotes
+}
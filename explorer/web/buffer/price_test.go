@@ -0,0 +1,109 @@
+package buffer
+
+import (
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestGetJSONPathPrice(t *testing.T) {
+	cases := map[string]float64{
+		`{"tron":{"usd":0.0213}}`:         0.0213,
+		`{"data":[{"price":"0.0215"}]}`:   0.0215,
+		`{"tron":{"usd":"not a number"}}`: 0,
+		`{"tron":{}}`:                     0,
+	}
+	paths := map[string]string{
+		`{"tron":{"usd":0.0213}}`:         "tron.usd",
+		`{"data":[{"price":"0.0215"}]}`:   "data.0.price",
+		`{"tron":{"usd":"not a number"}}`: "tron.usd",
+		`{"tron":{}}`:                     "tron.usd.value",
+	}
+	for body, want := range cases {
+		got, err := getJSONPathPrice([]byte(body), paths[body])
+		if got != want || (want == 0) != (err != nil) {
+			t.Errorf("getJSONPathPrice(%v) = %v, %v, want %v", body, got, err, want)
+		}
+	}
+}
+
+func TestGetExchangeQuotes(t *testing.T) {
+	exchanges := []*entity.ExchangeInfo{
+		{FirstTokenID: "_", FirstTokenBalance: 2000000000, SecondTokenID: "IGG", SecondTokenBalance: 1000},
+		{FirstTokenID: "USDT", FirstTokenBalance: 50, SecondTokenID: "_", SecondTokenBalance: 2500000000},
+		{FirstTokenID: "IGG", FirstTokenBalance: 10, SecondTokenID: "USDT", SecondTokenBalance: 10},
+	}
+	quotes := getExchangeQuotes(exchanges, "USDT", 1000)
+	if len(quotes) != 3 {
+		t.Fatalf("getExchangeQuotes:%v", len(quotes))
+	}
+	if quotes[0].Symbol != "IGG" || quotes[0].Currency != PriceCurrencyTRX || quotes[0].Price != 2 {
+		t.Errorf("getExchangeQuotes token:%#v", quotes[0])
+	}
+	if quotes[2].Symbol != PriceSymbolTRX || quotes[2].Currency != PriceCurrencyUSD || quotes[2].Price != 0.02 {
+		t.Errorf("getExchangeQuotes usd:%#v", quotes[2])
+	}
+}
+
+func TestParseFixtureQuotes(t *testing.T) {
+	quotes, err := parseFixtureQuotes([]byte(`[{"symbol":"TRX","currency":"USD","price":0.02},
+		{"symbol":"IGG","currency":"TRX","price":0.5,"time":500}]`), 1000)
+	if err != nil || len(quotes) != 2 {
+		t.Fatalf("parseFixtureQuotes:%v %v", quotes, err)
+	}
+	if quotes[0].Time != 1000 || quotes[1].Time != 500 || quotes[0].Source != "fixture" {
+		t.Errorf("parseFixtureQuotes time:%#v %#v", quotes[0], quotes[1])
+	}
+}
+
+func TestGetQuoteMarkets(t *testing.T) {
+	markets := getQuoteMarkets([]*entity.PriceQuote{
+		{Symbol: "TRX", Currency: "USD", Price: 0.02, Source: "exchange"},
+		{Symbol: "IGG", Currency: "TRX", Price: 0.5, Source: "exchange"},
+		{Symbol: "TRX", Currency: "USD", Price: 0.021, Source: "coingecko"},
+	})
+	if len(markets) != 2 {
+		t.Fatalf("getQuoteMarkets:%v", len(markets))
+	}
+	if markets[0].Rank != 1 || markets[0].Name != "coingecko" || markets[0].Pair != "TRX/USD" || markets[0].Price != 0.021 {
+		t.Errorf("getQuoteMarkets first:%#v", markets[0])
+	}
+	if markets[1].Rank != 2 || markets[1].Name != "exchange" || markets[1].Price != 0.02 {
+		t.Errorf("getQuoteMarkets second:%#v", markets[1])
+	}
+}
+
+func TestAggregatePrices(t *testing.T) {
+	now := int64(600000)
+	quotes := []*entity.PriceQuote{
+		{Symbol: "TRX", Currency: "USD", Price: 0.03, Time: now},
+		{Symbol: "TRX", Currency: "USD", Price: 0.01, Time: now},
+		{Symbol: "TRX", Currency: "USD", Price: 0.02, Time: now - 1000},
+		{Symbol: "TRX", Currency: "USD", Price: 9, Time: now - 300001},
+		{Symbol: "IGG", Currency: "TRX", Price: 2, Time: now - 300001},
+	}
+	previous := map[string]*entity.PriceInfo{
+		"IGG/TRX": {Symbol: "IGG", Currency: "TRX", Price: 1.5, Time: 60000},
+	}
+	prices := aggregatePrices(quotes, previous, now+30000, 300000)
+	trx := prices["TRX/USD"]
+	if trx == nil || trx.Price != 0.02 || trx.Sources != 3 || trx.Time != now || trx.Stale {
+		t.Errorf("aggregatePrices trx:%#v", trx)
+	}
+	igg := prices["IGG/TRX"]
+	if igg == nil || igg.Price != 1.5 || igg.Time != 60000 || !igg.Stale {
+		t.Errorf("aggregatePrices stale:%#v", igg)
+	}
+	if previous["IGG/TRX"].Stale {
+		t.Errorf("aggregatePrices modified previous")
+	}
+}
+
+func TestGetMedianPrice(t *testing.T) {
+	if got := getMedianPrice([]float64{3, 1, 2, 10}); got != 2.5 {
+		t.Errorf("getMedianPrice even:%v", got)
+	}
+	if got := getMedianPrice([]float64{5}); got != 5 {
+		t.Errorf("getMedianPrice one:%v", got)
+	}
+}
@@ -1,10 +1,12 @@
 package buffer
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/module"
@@ -29,17 +31,19 @@ func getTokenBuffer() *tokenBuffer {
 		_tokenBuffer.loadCommonQueryTokens()
 		_tokenBuffer.loadIcoQueryTokens()
 
-		go tokenInfoBufferLoader()
+		lifecycle.Go("tokenBuffer", tokenInfoBufferLoader)
 	})
 
 	return _tokenBuffer
 
 }
-func tokenInfoBufferLoader() {
+func tokenInfoBufferLoader(ctx context.Context) {
 	for {
 		_tokenBuffer.loadCommonQueryTokens()
 		_tokenBuffer.loadIcoQueryTokens()
-		time.Sleep(35 * time.Second)
+		if !lifecycle.Wait(ctx, 35*time.Second) {
+			return
+		}
 	}
 }
 
@@ -1,11 +1,13 @@
 package buffer
 
 import (
+	"context"
 	"fmt"
 	"sync"
 	"time"
 
 	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/module"
@@ -32,8 +34,8 @@ func getVoteBuffer() *voteBuffer {
 		_voteBuffer.getMaintenanceTimeStamp()
 		_voteBuffer.loadQueryVoteCurrentCycle()
 
-		go voteLiveBufferLoader()
-		go voteCycleBufferLoader()
+		lifecycle.Go("voteLiveBuffer", voteLiveBufferLoader)
+		lifecycle.Go("voteCycleBuffer", voteCycleBufferLoader)
 	})
 	return _voteBuffer
 }
@@ -48,17 +50,21 @@ type voteBuffer struct {
 	nextMaintenanceTime int64
 }
 
-func voteLiveBufferLoader() {
+func voteLiveBufferLoader(ctx context.Context) {
 	for {
 		_voteBuffer.loadQueryVoteLive()
-		time.Sleep(30 * time.Second)
+		if !lifecycle.Wait(ctx, 30*time.Second) {
+			return
+		}
 	}
 }
-func voteCycleBufferLoader() {
+func voteCycleBufferLoader(ctx context.Context) {
 	for {
 		_voteBuffer.getMaintenanceTimeStamp()
 		_voteBuffer.loadQueryVoteCurrentCycle()
-		time.Sleep(60 * time.Second)
+		if !lifecycle.Wait(ctx, 60*time.Second) {
+			return
+		}
 	}
 }
 
@@ -90,7 +96,7 @@ func (w *voteBuffer) GetNextMaintenanceTime() int64 {
 		log.Infof("get NextMaintenanceTime info from buffer nil, data reload")
 		w.getMaintenanceTimeStamp()
 	}
-	log.Infof("get NextMaintenanceTime info from buffer, buffer data updated ")
+	log.Debugf("get NextMaintenanceTime info from buffer, buffer data updated ")
 	return w.nextMaintenanceTime
 }
 
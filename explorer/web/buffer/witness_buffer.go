@@ -1,11 +1,13 @@
 package buffer
 
 import (
+	"context"
 	"fmt"
 	"sort"
 	"sync"
 	"time"
 
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/module"
@@ -31,17 +33,18 @@ func getWitnessBuffer() *witnessBuffer {
 		_witnessBuffer.load()
 		_witnessBuffer.loadStatistic()
 
-		go witnessBufferLoader()
+		lifecycle.Go("witnessBuffer", witnessBufferLoader)
 	})
 	return _witnessBuffer
 }
 
-func witnessBufferLoader() {
+func witnessBufferLoader(ctx context.Context) {
 	for {
 		_witnessBuffer.load()
 		_witnessBuffer.loadStatistic()
-		time.Sleep(30 * time.Second)
-
+		if !lifecycle.Wait(ctx, 30*time.Second) {
+			return
+		}
 	}
 }
 
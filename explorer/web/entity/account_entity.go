@@ -9,6 +9,7 @@ type Accounts struct {
 	Count   string `json:"count,omitempty"`   // 是否返回总数
 	Start   int64  `json:"start,omitempty"`   // 记录的起始序号
 	Address string `json:"address,omitempty"` // 按照地址精确查询
+	Time    int64  `json:"time,omitempty"`    // 按该时间的价格估值，0为当前价格
 }
 
 //AccountsResp 查询账户列表的结果
@@ -19,25 +20,28 @@ type AccountsResp struct {
 
 //AccountInfo 账户信息
 type AccountInfo struct {
-	Address       string           `json:"address"`       //:TDtjQ1JR5UrS92W9kB6BCeAQJwn1dyBEbs,
-	Name          string           `json:"name"`          //:"00000000002097beb4b9ceabbff396bf788a8d9ee8c09de37e5e0da039a6a87f",
-	Balance       int64            `json:"balance"`       //:3006,
-	Power         int64            `json:"power"`         //:"JRB1nNvqT6kcRJLdzTnUGyiwvMcnDTAaxYZhTxhvDkjM8kxYh",
-	TokenBalances map[string]int64 `json:"tokenBalances"` //:"00000000002097bdd482e26710c054eea72280232a9061885dc94c30c3a0f1b5",
-	UpdateTime    int64            `json:"dateUpdated"`   //:1536314760000
-	CreateTime    int64            `json:"dateCreated"`   //:1536314760000,
+	Address       string           `json:"address"`         //:TDtjQ1JR5UrS92W9kB6BCeAQJwn1dyBEbs,
+	Name          string           `json:"name"`            //:"00000000002097beb4b9ceabbff396bf788a8d9ee8c09de37e5e0da039a6a87f",
+	Balance       int64            `json:"balance"`         //:3006,
+	Power         int64            `json:"power"`           //:"JRB1nNvqT6kcRJLdzTnUGyiwvMcnDTAaxYZhTxhvDkjM8kxYh",
+	TokenBalances map[string]int64 `json:"tokenBalances"`   //:"00000000002097bdd482e26710c054eea72280232a9061885dc94c30c3a0f1b5",
+	UpdateTime    int64            `json:"dateUpdated"`     //:1536314760000
+	CreateTime    int64            `json:"dateCreated"`     //:1536314760000,
+	Label         *AddressLabelTag `json:"label,omitempty"` // 地址标签
 }
 
 //AccountDetail 账户详细信息
 type AccountDetail struct {
-	Representative *Represent     `json:"representative"` //
-	Name           string         `json:"name"`           //
-	Address        string         `json:"address"`        //
-	Bandwidth      *BandwidthInfo `json:"bandwidth"`      //
-	Balances       []*Balance     `json:"balances"`       //
-	Balance        int64          `json:"balance"`        //
-	TokenBalances  []*Balance     `json:"tokenBalances"`  //
-	Frozen         *Frozen        `json:"frozen"`         //
+	Representative *Represent        `json:"representative"`      //
+	Name           string            `json:"name"`                //
+	Address        string            `json:"address"`             //
+	Bandwidth      *BandwidthInfo    `json:"bandwidth"`           //
+	Balances       []*Balance        `json:"balances"`            //
+	Balance        int64             `json:"balance"`             //
+	TokenBalances  []*Balance        `json:"tokenBalances"`       //
+	Frozen         *Frozen           `json:"frozen"`              //
+	Valuation      *AccountValuation `json:"valuation,omitempty"` // 美元估值
+	Label          *AddressLabelTag  `json:"label,omitempty"`     // 地址标签
 }
 
 //Represent 。。。
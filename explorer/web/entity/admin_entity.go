@@ -0,0 +1,53 @@
+package entity
+
+//BufferNamesResp 可以刷新的缓存
+type BufferNamesResp struct {
+	Total int64    `json:"total"` // 缓存数
+	Data  []string `json:"data"`  // 缓存名称
+}
+
+//LogLevelInfo 日志级别，packages 为按日志名称或包路径覆盖的级别
+type LogLevelInfo struct {
+	Level    string            `json:"level"`    // 全局级别 all more sql debug info warn error fatal off
+	Packages map[string]string `json:"packages"` // 按包覆盖的级别，修改时为空则清除全部覆盖，为null时不修改
+}
+
+//NodeStatus 节点检查结果
+type NodeStatus struct {
+	Endpoint  string `json:"endpoint"`        // host:port
+	Type      string `json:"type"`            // full 或 solidity
+	Reachable bool   `json:"reachable"`       // 能否获取最新块
+	BlockID   int64  `json:"blockID"`         // 最新块高度
+	Latency   int64  `json:"latency"`         // 获取最新块的耗时，单位毫秒
+	Error     string `json:"error,omitempty"` // 失败原因
+}
+
+//NodeStatusResp 当前网络的节点池，包含配置的节点和发现的节点
+type NodeStatusResp struct {
+	Network   string        `json:"network"`   // 网络名称
+	Total     int64         `json:"total"`     // 节点数
+	Reachable int64         `json:"reachable"` // 可用的节点数
+	Data      []*NodeStatus `json:"data"`      // 节点状态
+}
+
+//IngestionStatus 数据同步进度
+//	db中的确认块由fullnode同步程序写入，缓存中的未确认块由web服务从full node读取
+type IngestionStatus struct {
+	DBBlockID         int64 `json:"dbBlockID"`         // db中最新的确认块
+	SolidityBlockID   int64 `json:"solidityBlockID"`   // solidity node的最新块
+	ConfirmedLag      int64 `json:"confirmedLag"`      // db落后solidity node的块数
+	BufferBlockID     int64 `json:"bufferBlockID"`     // 缓存的最新块，包含未确认块
+	FullNodeBlockID   int64 `json:"fullNodeBlockID"`   // full node的最新块
+	UnconfirmedLag    int64 `json:"unconfirmedLag"`    // 缓存落后full node的块数
+	LatestBlockTime   int64 `json:"latestBlockTime"`   // 缓存中最新块的时间
+	TotalTransactions int64 `json:"totalTransactions"` // 交易总数
+	TotalTransfers    int64 `json:"totalTransfers"`    // 转账总数
+	MaxSyncLag        int64 `json:"maxSyncLag"`        // 落后超过该块数时 /readyz 不可用
+}
+
+//WitnessMeta 超级代表的链外资料
+type WitnessMeta struct {
+	Address    string `json:"address"`    // 超级代表地址
+	URL        string `json:"url"`        // 主页
+	GithubLink string `json:"githubLink"` // github
+}
@@ -0,0 +1,38 @@
+package entity
+
+//APIKeyInfo api key信息
+type APIKeyInfo struct {
+	APIKey     string `json:"apiKey"`     // api key
+	Name       string `json:"name"`       // 使用方名称
+	Contact    string `json:"contact"`    // 联系方式
+	Rate       int64  `json:"rate"`       // 每秒请求数
+	Burst      int64  `json:"burst"`      // 允许的突发请求数
+	DailyQuota int64  `json:"dailyQuota"` // 每日请求上限，0表示不限
+	Status     int32  `json:"status"`     // 1 有效 0 停用
+	CreateTime string `json:"dateCreated"`
+}
+
+//APIKeyUsage api key每日用量
+type APIKeyUsage struct {
+	APIKey        string `json:"apiKey"`        // api key
+	Date          string `json:"date"`          // 日期 yyyymmdd UTC
+	RequestCount  int64  `json:"requestCount"`  // 请求次数
+	RejectedCount int64  `json:"rejectedCount"` // 被限流的请求次数
+}
+
+//APIKeyUsageResp api key用量查询结果
+type APIKeyUsageResp struct {
+	Total int64          `json:"total"` // 总请求次数
+	Data  []*APIKeyUsage `json:"data"`  // 每日用量
+}
+
+//RateLimitResult 限流检查结果，用于填充响应头
+type RateLimitResult struct {
+	Allowed        bool  // 是否放行
+	Limit          int64 // 突发请求数上限
+	Remaining      int64 // 剩余令牌数
+	ResetSeconds   int64 // 令牌恢复需要的秒数
+	QuotaLimit     int64 // 每日请求上限，0表示不限
+	QuotaRemaining int64 // 当日剩余请求数
+	QuotaExceeded  bool  // 是否超出每日上限
+}
@@ -0,0 +1,34 @@
+package entity
+
+//AuthChallenge 登录随机数，用账户私钥签名message后调用登录接口
+type AuthChallenge struct {
+	Address    string `json:"address"`    // 登录地址
+	Nonce      string `json:"nonce"`      // 随机数，只能使用一次
+	Message    string `json:"message"`    // 需要签名的消息，包含地址、随机数、生成时间和host
+	ExpireTime int64  `json:"expireTime"` // 过期时间
+}
+
+//AuthLogin 登录请求参数
+type AuthLogin struct {
+	Address   string `json:"address"`   // 登录地址
+	Nonce     string `json:"nonce"`     // 随机数
+	Signature string `json:"signature"` // TronWeb trx.sign(message的hex) 或 trx.signMessageV2(message) 的签名
+}
+
+//AuthToken 登录结果
+type AuthToken struct {
+	Address      string `json:"address"`      // 登录地址
+	AccessToken  string `json:"accessToken"`  // 请求头 Authorization: Bearer accessToken 或 X-Key: accessToken
+	ExpiresIn    int64  `json:"expiresIn"`    // accessToken有效期，单位秒
+	RefreshToken string `json:"refreshToken"` // 用于换取新的token，使用后失效
+}
+
+//AuthRefresh 刷新token和退出登录的请求参数
+type AuthRefresh struct {
+	RefreshToken string `json:"refreshToken"` // refresh token
+}
+
+//AuthRevoke 吊销地址已签发的全部token
+type AuthRevoke struct {
+	Address string `json:"address"` // 地址
+}
@@ -0,0 +1,82 @@
+package entity
+
+//ExchangesResp 查询交易对列表的结果
+type ExchangesResp struct {
+	Total int64           `json:"total"` // 总记录数
+	Data  []*ExchangeInfo `json:"data"`  // 记录详情
+}
+
+//ExchangeInfo 交易对信息，余额为本地按交易重放的结果
+type ExchangeInfo struct {
+	ExchangeID         int64   `json:"exchangeId"`         // 交易对编号
+	Creator            string  `json:"creator"`            // 创建人地址
+	FirstTokenID       string  `json:"firstTokenId"`       // 第一个通证，_ 表示TRX
+	FirstTokenBalance  int64   `json:"firstTokenBalance"`  // 第一个通证余额
+	SecondTokenID      string  `json:"secondTokenId"`      // 第二个通证，_ 表示TRX
+	SecondTokenBalance int64   `json:"secondTokenBalance"` // 第二个通证余额
+	Price              float64 `json:"price"`              // 当前价格，1个第一个通证可换的第二个通证数量
+	CreateTime         int64   `json:"createTime"`         // 创建时间
+	BlockID            int64   `json:"-"`                  // 最后一次变化的区块高度
+}
+
+//ExchangeTrades 查询交易对成交记录的请求参数
+type ExchangeTrades struct {
+	ExchangeID int64 `json:"exchangeId,omitempty"` // 交易对编号
+	Start      int64 `json:"start,omitempty"`      // 记录的起始序号
+	Limit      int64 `json:"limit,omitempty"`      // 每页记录数
+	From       int64 `json:"from,omitempty"`       // 成交时间不早于该时间
+	To         int64 `json:"to,omitempty"`         // 成交时间早于该时间
+}
+
+//ExchangeTradesResp 查询交易对成交记录的结果
+type ExchangeTradesResp struct {
+	Total int64            `json:"total"` // 总记录数
+	Data  []*ExchangeTrade `json:"data"`  // 记录详情
+}
+
+//ExchangeTrade 一笔成交
+type ExchangeTrade struct {
+	Hash        string  `json:"hash"`        // 交易hash
+	Block       int64   `json:"block"`       // 区块高度
+	ExchangeID  int64   `json:"exchangeId"`  // 交易对编号
+	Owner       string  `json:"owner"`       // 发起方地址
+	SellTokenID string  `json:"sellTokenId"` // 卖出的通证
+	SellAmount  int64   `json:"sellAmount"`  // 卖出数量
+	BuyTokenID  string  `json:"buyTokenId"`  // 买入的通证
+	BuyAmount   int64   `json:"buyAmount"`   // 买入数量，按交易前的余额计算
+	Price       float64 `json:"price"`       // 成交价格，1个第一个通证可换的第二个通证数量
+	CreateTime  int64   `json:"createTime"`  // 成交时间
+}
+
+//ExchangeCandles 查询交易对K线的请求参数
+type ExchangeCandles struct {
+	ExchangeID int64  `json:"exchangeId,omitempty"` // 交易对编号
+	Interval   string `json:"interval,omitempty"`   // K线周期 1m 5m 1h 1d
+	From       int64  `json:"from,omitempty"`       // 开始时间不早于该时间
+	To         int64  `json:"to,omitempty"`         // 开始时间早于该时间
+	Limit      int64  `json:"limit,omitempty"`      // 最多返回的记录数
+}
+
+//ExchangeCandle 一根K线，价格为1个第一个通证可换的第二个通证数量
+type ExchangeCandle struct {
+	ExchangeID  int64   `json:"exchangeId"`  // 交易对编号
+	Interval    string  `json:"interval"`    // K线周期
+	OpenTime    int64   `json:"openTime"`    // 开始时间
+	Open        float64 `json:"open"`        // 开盘价
+	High        float64 `json:"high"`        // 最高价
+	Low         float64 `json:"low"`         // 最低价
+	Close       float64 `json:"close"`       // 收盘价
+	Volume      int64   `json:"volume"`      // 第一个通证成交量
+	QuoteVolume int64   `json:"quoteVolume"` // 第二个通证成交量
+	TradeCount  int64   `json:"tradeCount"`  // 成交笔数
+}
+
+//ExchangeContract 交易对相关的交易，用于重放交易对余额
+type ExchangeContract struct {
+	Hash         string // 交易hash
+	BlockID      int64  // 区块高度
+	ContractType int64  // 交易类型 41 创建 42 注资 43 撤资 44 交易
+	ContractData string // 交易内容，hex
+	OwnerAddress string // 发起方地址
+	CreateTime   int64  // 交易时间
+}
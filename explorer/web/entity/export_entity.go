@@ -0,0 +1,25 @@
+package entity
+
+//ExportTransfers 导出转账记录的请求参数
+type ExportTransfers struct {
+	Address string `json:"address"` // 导出的地址，包含转出和转入
+	From    int64  `json:"from"`    // 开始时间，毫秒时间戳，包含
+	To      int64  `json:"to"`      // 结束时间，毫秒时间戳，不包含
+	Format  string `json:"format"`  // 导出格式 csv / jsonl
+}
+
+//ExportTransferInfo 导出的转账记录
+type ExportTransferInfo struct {
+	Timestamp       int64  `json:"timestamp"`       // 交易时间，毫秒
+	Date            string `json:"date"`            // 交易时间，UTC
+	Block           int64  `json:"block"`           // 区块高度
+	TransactionHash string `json:"transactionHash"` // 交易hash
+	Direction       string `json:"direction"`       // in / out / self
+	From            string `json:"from"`            // 转出地址
+	To              string `json:"to"`              // 转入地址
+	TokenName       string `json:"tokenName"`       // 通证名称
+	Precision       int32  `json:"precision"`       // 通证精度，TRX为6
+	RawAmount       int64  `json:"rawAmount"`       // 链上原始数量
+	Amount          string `json:"amount"`          // 按精度换算后的数量
+	Confirmed       bool   `json:"confirmed"`       // 是否确认
+}
@@ -0,0 +1,37 @@
+package entity
+
+//FaucetRequest 申请测试币的请求参数
+type FaucetRequest struct {
+	Address     string `json:"address"`     // 接收地址
+	CaptchaCode string `json:"captchaCode"` // 人机验证结果，未启用人机验证时可以为空
+}
+
+//FaucetInfo 水龙头状态
+type FaucetInfo struct {
+	Enabled                bool   `json:"enabled"`                // 是否可用
+	Address                string `json:"address"`                // 发放地址
+	Amount                 int64  `json:"amount"`                 // 每次发放数量，单位sun
+	DailyBudget            int64  `json:"dailyBudget"`            // 每日总额，单位sun
+	BudgetRemaining        int64  `json:"budgetRemaining"`        // 当日剩余额度，单位sun
+	AddressCooldownSeconds int64  `json:"addressCooldownSeconds"` // 同一地址领取间隔
+	IPCooldownSeconds      int64  `json:"ipCooldownSeconds"`      // 同一IP领取间隔
+	Captcha                string `json:"captcha"`                // 人机验证方式，空为不验证
+}
+
+//FaucetGrant 测试币发放记录
+type FaucetGrant struct {
+	ID         int64  `json:"id"`         // 记录编号
+	Address    string `json:"address"`    // 接收地址
+	IP         string `json:"-"`          // 申请IP，不对外返回
+	Amount     int64  `json:"amount"`     // 发放数量，单位sun
+	Hash       string `json:"hash"`       // 交易hash
+	Status     int32  `json:"status"`     // 1 成功 0 失败
+	Message    string `json:"message"`    // 失败原因
+	CreateTime int64  `json:"createTime"` // 申请时间
+}
+
+//FaucetGrantsResp 查询发放记录的结果
+type FaucetGrantsResp struct {
+	Total int64          `json:"total"` // 总记录数
+	Data  []*FaucetGrant `json:"data"`  // 记录详情
+}
@@ -0,0 +1,8 @@
+package entity
+
+//GraphQLReq graphql查询请求
+type GraphQLReq struct {
+	Query         string                 `json:"query"`         // 查询语句
+	OperationName string                 `json:"operationName"` // 操作名称，语句中有多个操作时指定
+	Variables     map[string]interface{} `json:"variables"`     // 变量
+}
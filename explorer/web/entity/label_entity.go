@@ -0,0 +1,38 @@
+package entity
+
+//AddressLabel 地址标签
+type AddressLabel struct {
+	ID         int64  `json:"id"`         // 标签编号
+	Address    string `json:"address"`    // 地址
+	Label      string `json:"label"`      // 标签
+	Category   string `json:"category"`   // 分类 exchange scam contract team other
+	Note       string `json:"note"`       // 备注
+	Private    bool   `json:"private"`    // 是否为api key的私有标签
+	UpdateTime int64  `json:"updateTime"` // 更新时间
+}
+
+//AddressLabelsResp 查询地址标签列表的结果
+type AddressLabelsResp struct {
+	Total int64           `json:"total"` // 总记录数
+	Data  []*AddressLabel `json:"data"`  // 记录详情
+}
+
+//AddressLabelTag 交易、转账和账户信息中返回的地址标签
+type AddressLabelTag struct {
+	Label    string `json:"label"`             // 标签
+	Category string `json:"category"`          // 分类
+	Private  bool   `json:"private,omitempty"` // 是否为私有标签
+}
+
+//AddressLabelImportResp 导入地址标签的结果
+type AddressLabelImportResp struct {
+	Total    int64                      `json:"total"`    // csv记录数，不含表头
+	Imported int64                      `json:"imported"` // 导入成功数
+	Errors   []*AddressLabelImportError `json:"errors"`   // 导入失败的记录
+}
+
+//AddressLabelImportError 导入失败的记录
+type AddressLabelImportError struct {
+	Line   int64  `json:"line"`   // csv行号，从1开始
+	Reason string `json:"reason"` // 失败原因
+}
@@ -49,7 +49,9 @@ type Auth struct {
 
 //AuthResp 验证签名相应
 type AuthResp struct {
-	Token string `json:"token"` //:99.99
+	Token        string `json:"token"`        // accessToken
+	ExpiresIn    int64  `json:"expiresIn"`    // token有效期，单位秒
+	RefreshToken string `json:"refreshToken"` // 用于换取新的token
 }
 
 //Address 地址签名结构
@@ -0,0 +1,54 @@
+package entity
+
+//PriceQuote 一个行情来源的报价
+type PriceQuote struct {
+	Symbol   string  `json:"symbol"`           // 币种，TRX 或通证名
+	Currency string  `json:"currency"`         // 计价币种 USD TRX
+	Price    float64 `json:"price"`            // 价格
+	Time     int64   `json:"time,omitempty"`   // 报价时间，单位ms
+	Source   string  `json:"source,omitempty"` // 行情来源
+}
+
+//PriceInfo 聚合后的价格
+type PriceInfo struct {
+	Symbol   string  `json:"symbol"`   // 币种，TRX 或通证名
+	Currency string  `json:"currency"` // 计价币种 USD TRX
+	Price    float64 `json:"price"`    // 各来源报价的中位数
+	Time     int64   `json:"time"`     // 聚合时间
+	Sources  int64   `json:"sources"`  // 参与聚合的报价数
+	Stale    bool    `json:"stale"`    // 没有未过期的报价时沿用上次的价格，stale为true
+}
+
+//PriceHistory 查询历史价格的请求参数
+type PriceHistory struct {
+	Symbol   string `json:"symbol,omitempty"`   // 币种
+	Currency string `json:"currency,omitempty"` // 计价币种
+	From     int64  `json:"from,omitempty"`     // 聚合时间不早于该时间
+	To       int64  `json:"to,omitempty"`       // 聚合时间早于该时间
+	Start    int64  `json:"start,omitempty"`    // 记录的起始序号
+	Limit    int64  `json:"limit,omitempty"`    // 每页记录数
+}
+
+//PriceHistoryResp 查询历史价格的结果
+type PriceHistoryResp struct {
+	Total int64        `json:"total"` // 总记录数
+	Data  []*PriceInfo `json:"data"`  // 记录详情
+}
+
+//AccountValuation 账户的美元估值
+type AccountValuation struct {
+	Time       int64             `json:"time"`       // 估值时间
+	TrxPrice   float64           `json:"trxPrice"`   // TRX的美元价格，0表示没有价格
+	TrxValue   float64           `json:"trxValue"`   // TRX余额(含冻结)的美元价值
+	Tokens     []*TokenValuation `json:"tokens"`     // 通证估值
+	TotalValue float64           `json:"totalValue"` // 总价值
+	Stale      bool              `json:"stale"`      // 使用的价格是否过期
+}
+
+//TokenValuation 通证的美元估值
+type TokenValuation struct {
+	Name     string  `json:"name"`     // 通证名
+	Balance  float64 `json:"balance"`  // 余额
+	TrxPrice float64 `json:"trxPrice"` // 通证的TRX价格，0表示没有价格
+	Value    float64 `json:"value"`    // 美元价值
+}
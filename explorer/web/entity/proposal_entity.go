@@ -0,0 +1,65 @@
+package entity
+
+//Proposals 查询提议列表的请求参数
+type Proposals struct {
+	Start int64  `json:"start,omitempty"` // 记录的起始序号
+	Limit int64  `json:"limit,omitempty"` // 每页记录数
+	State string `json:"state,omitempty"` // 按状态查询 pending approved disapproved canceled
+}
+
+//ProposalsResp 查询提议列表的结果
+type ProposalsResp struct {
+	Total int64           `json:"total"` // 总记录数
+	Data  []*ProposalInfo `json:"data"`  // 记录详情
+}
+
+//ProposalInfo 提议信息
+type ProposalInfo struct {
+	ProposalID       int64                   `json:"proposalId"`                 // 提议编号
+	Proposer         string                  `json:"proposer"`                   // 提议人地址
+	Parameters       []*ProposalParameter    `json:"parameters"`                 // 提议修改的参数
+	ExpirationTime   int64                   `json:"expirationTime"`             // 过期时间
+	CreateTime       int64                   `json:"createTime"`                 // 创建时间
+	ApprovalCount    int64                   `json:"approvalCount"`              // 赞成的超级代表数量
+	State            string                  `json:"state"`                      // 状态 pending approved disapproved canceled
+	Approvals        []string                `json:"approvals,omitempty"`        // 赞成的超级代表地址，查询单个提议时返回
+	StateChanges     []*ProposalStateChange  `json:"stateChanges,omitempty"`     // 状态变化记录，查询单个提议时返回
+	ParameterChanges []*ChainParameterChange `json:"parameterChanges,omitempty"` // 提议生效后的链参数变化，查询单个提议时返回
+}
+
+//ProposalParameter 提议修改的参数
+type ProposalParameter struct {
+	Key   int64  `json:"key"`   // 参数编号
+	Name  string `json:"name"`  // 参数名，与链参数名一致
+	Value int64  `json:"value"` // 参数值
+}
+
+//ProposalStateChange 提议状态变化
+type ProposalStateChange struct {
+	ProposalID int64  `json:"proposalId"` // 提议编号
+	FromState  string `json:"fromState"`  // 原状态，首次同步时为空
+	ToState    string `json:"toState"`    // 新状态
+	ChangeTime int64  `json:"changeTime"` // 发现变化的时间
+}
+
+//ChainParameterHistory 查询链参数变化的请求参数
+type ChainParameterHistory struct {
+	Start int64  `json:"start,omitempty"` // 记录的起始序号
+	Limit int64  `json:"limit,omitempty"` // 每页记录数
+	Key   string `json:"key,omitempty"`   // 按参数名查询
+}
+
+//ChainParameterHistoryResp 查询链参数变化的结果
+type ChainParameterHistoryResp struct {
+	Total int64                   `json:"total"` // 总记录数
+	Data  []*ChainParameterChange `json:"data"`  // 记录详情
+}
+
+//ChainParameterChange 链参数变化
+type ChainParameterChange struct {
+	Key        string `json:"key"`        // 参数名
+	OldValue   int64  `json:"oldValue"`   // 原值
+	NewValue   int64  `json:"newValue"`   // 新值
+	ChangeTime int64  `json:"changeTime"` // 发现变化的时间
+	ProposalID int64  `json:"proposalId"` // 使参数生效的提议编号，0表示无法确定
+}
@@ -0,0 +1,22 @@
+package entity
+
+//V2Response /v2 接口统一的响应格式，成功时error为null，失败时data为null
+type V2Response struct {
+	Data  interface{} `json:"data"`  // 响应数据，列表接口为记录数组
+	Meta  *V2Meta     `json:"meta"`  // 分页等附加信息
+	Error *V2Error    `json:"error"` // 错误信息
+}
+
+//V2Meta /v2 响应的附加信息，列表接口返回总数和分页参数
+type V2Meta struct {
+	Total *int64 `json:"total,omitempty"` // 总记录数
+	Start *int64 `json:"start,omitempty"` // 记录的起始序号
+	Limit *int64 `json:"limit,omitempty"` // 每页记录数
+}
+
+//V2Error /v2 响应的错误信息
+type V2Error struct {
+	Code    int    `json:"code"`              // 错误码
+	Message string `json:"message"`           // 按 Accept-Language 选择语言的错误信息
+	Details string `json:"details,omitempty"` // 错误详情
+}
@@ -0,0 +1,56 @@
+package entity
+
+//WitnessRewardResp 查询超级代表每轮奖励的结果
+type WitnessRewardResp struct {
+	Total int64            `json:"total"` // 总记录数
+	Data  []*WitnessReward `json:"data"`  // 记录详情
+}
+
+//WitnessReward 超级代表在某一轮的奖励，单位sun
+type WitnessReward struct {
+	CycleStart     int64  `json:"cycleStart"`     // 轮次开始时间
+	CycleEnd       int64  `json:"cycleEnd"`       // 轮次结束时间
+	Address        string `json:"address"`        // 超级代表地址
+	Votes          int64  `json:"votes"`          // 得票数
+	Ranking        int32  `json:"ranking"`        // 排名
+	ProducedBlocks int64  `json:"producedBlocks"` // 本轮出块数
+	BlockReward    int64  `json:"blockReward"`    // 出块奖励
+	VoteReward     int64  `json:"voteReward"`     // 投票奖励
+	TotalReward    int64  `json:"totalReward"`    // 奖励合计
+}
+
+//VoteCycleReward 某一轮的奖励合计及结算时使用的链参数，单位sun
+type VoteCycleReward struct {
+	CycleStart              int64 `json:"cycleStart"`              // 轮次开始时间
+	WitnessPayPerBlock      int64 `json:"witnessPayPerBlock"`      // 每块奖励
+	WitnessStandbyAllowance int64 `json:"witnessStandbyAllowance"` // 每轮投票奖励总额
+	TotalBlockReward        int64 `json:"totalBlockReward"`        // 出块奖励合计
+	TotalVoteReward         int64 `json:"totalVoteReward"`         // 投票奖励合计
+}
+
+//RewardEstimateReq 估算投票收益的请求参数
+type RewardEstimateReq struct {
+	Votes   int64  `json:"votes"`   // 投票数
+	Ratio   int64  `json:"ratio"`   // 超级代表分给投票人的比例，0-100
+	Cycles  int64  `json:"cycles"`  // 按最近几轮的平均奖励估算
+	Address string `json:"address"` // 只估算某个超级代表，可选
+}
+
+//RewardEstimateResp 估算投票收益的结果，按每轮收益倒序
+type RewardEstimateResp struct {
+	Votes  int64             `json:"votes"`  // 投票数
+	Ratio  int64             `json:"ratio"`  // 分成比例
+	Cycles int64             `json:"cycles"` // 实际参与平均的轮次数
+	Data   []*RewardEstimate `json:"data"`   // 各超级代表的估算结果
+}
+
+//RewardEstimate 投给某个超级代表的预计收益，单位sun
+type RewardEstimate struct {
+	Address          string `json:"address"`          // 超级代表地址
+	Name             string `json:"name"`             // 超级代表名称
+	Ranking          int32  `json:"ranking"`          // 最近一轮排名
+	WitnessVotes     int64  `json:"witnessVotes"`     // 最近一轮得票数
+	AvgTotalReward   int64  `json:"avgTotalReward"`   // 超级代表每轮平均奖励
+	VoterReward      int64  `json:"voterReward"`      // 投票人每轮预计收益
+	VoterRewardDaily int64  `json:"voterRewardDaily"` // 投票人每天预计收益
+}
@@ -0,0 +1,36 @@
+package entity
+
+//TaskRun 定时任务执行记录
+type TaskRun struct {
+	ID            int64  `json:"id"`            // 记录编号
+	Job           string `json:"job"`           // 任务名称
+	Trigger       string `json:"trigger"`       // 触发方式 schedule catchup manual shutdown
+	ScheduledTime int64  `json:"scheduledTime"` // 计划执行时间
+	StartTime     int64  `json:"startTime"`     // 开始时间
+	EndTime       int64  `json:"endTime"`       // 结束时间，执行中为0
+	Duration      int64  `json:"duration"`      // 耗时，毫秒
+	Status        string `json:"status"`        // 结果 running success failed
+	Error         string `json:"error"`         // 失败原因
+	Instance      string `json:"instance"`      // 执行的实例
+}
+
+//TaskRunsResp 查询执行记录的结果
+type TaskRunsResp struct {
+	Total int64      `json:"total"` // 总记录数
+	Data  []*TaskRun `json:"data"`  // 记录详情
+}
+
+//TaskStatus 定时任务状态，只包含本实例的执行情况
+type TaskStatus struct {
+	Name    string   `json:"name"`    // 任务名称
+	Spec    string   `json:"spec"`    // cron表达式
+	Next    int64    `json:"next"`    // 下次计划执行时间
+	Running bool     `json:"running"` // 本实例是否正在执行
+	LastRun *TaskRun `json:"lastRun"` // 本实例最近一次执行，没有时为null
+}
+
+//TaskStatusResp 定时任务列表
+type TaskStatusResp struct {
+	Total int64         `json:"total"` // 任务数
+	Data  []*TaskStatus `json:"data"`  // 任务状态
+}
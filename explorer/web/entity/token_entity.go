@@ -93,12 +93,6 @@ type TokenBalanceInfo struct {
 	Balance					int64				`json:"balance"`				// 余额
 }
 
-//UploadLogoReq
-type UploadLogoReq struct {
-	Address   				string 				`json:"owner_address"` 			//	Address
-	ImageData 				string 				`json:"imageData"`     			//	ImageData
-}
-
 //UploadLogo
 type UploadLogo struct {
 	Address 				string 				`json:"address,omitempty"` 		// Address
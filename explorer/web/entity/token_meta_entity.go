@@ -0,0 +1,56 @@
+package entity
+
+//通证资料的审核状态
+const (
+	TokenMetaStatusPending  = 0 // 待审核
+	TokenMetaStatusApproved = 1 // 审核通过，已更新到通证资料
+	TokenMetaStatusRejected = 2 // 审核拒绝
+)
+
+//TokenLogoReq 上传通证logo的请求参数
+type TokenLogoReq struct {
+	OwnerAddress string `json:"owner_address"` // 通证发行人地址
+	ImageData    string `json:"imageData"`     // base64编码的图片，可以带 data:image/png;base64, 前缀
+	Transaction  string `json:"transaction"`   // 发行人签名的交易hex，交易data为图片内容的sha256
+}
+
+//TokenLogo 保存后的通证logo
+type TokenLogo struct {
+	OwnerAddress string            `json:"ownerAddress"` // 通证发行人地址
+	LogoURL      string            `json:"logoUrl"`      // 最大尺寸logo的地址
+	Images       []*TokenLogoImage `json:"images"`       // 各尺寸logo
+}
+
+//TokenLogoImage 一个尺寸的logo
+type TokenLogoImage struct {
+	Size int64  `json:"size"` // 边长
+	URL  string `json:"url"`  // 地址
+}
+
+//TokenMetaSubmission 通证资料的提交记录，审核通过后更新到通证资料
+type TokenMetaSubmission struct {
+	ID           int64              `json:"id"`                    // 提交编号
+	OwnerAddress string             `json:"ownerAddress"`          // 通证发行人地址
+	Description  string             `json:"description"`           // 通证介绍
+	WebSite      string             `json:"website"`               // 官网地址
+	WhitePaper   string             `json:"white_paper"`           // 白皮书链接
+	GitHub       string             `json:"github"`                // github链接
+	Country      string             `json:"country"`               // 国家
+	SocialMedia  []*SocialMediaInfo `json:"social_media"`          // 社交媒体
+	Status       int64              `json:"status"`                // 0 待审核 1 通过 2 拒绝
+	Reason       string             `json:"reason"`                // 拒绝原因
+	SubmitTime   int64              `json:"submitTime"`            // 提交时间
+	ReviewTime   int64              `json:"reviewTime"`            // 审核时间
+	Transaction  string             `json:"transaction,omitempty"` // 发行人签名的交易hex，只在提交时使用
+}
+
+//TokenMetaSubmissionsResp 查询通证资料提交记录的结果
+type TokenMetaSubmissionsResp struct {
+	Total int64                  `json:"total"` // 总记录数
+	Data  []*TokenMetaSubmission `json:"data"`  // 记录详情
+}
+
+//TokenMetaReview 审核通证资料的请求参数
+type TokenMetaReview struct {
+	Reason string `json:"reason"` // 拒绝原因
+}
@@ -0,0 +1,47 @@
+package entity
+
+//VoteCycles 查询投票轮次的请求参数
+type VoteCycles struct {
+	Start   int64  `json:"start,omitempty"`   // 记录的起始序号
+	Limit   int64  `json:"limit,omitempty"`   // 每页记录数
+	From    int64  `json:"from,omitempty"`    // 轮次开始时间不早于该时间
+	To      int64  `json:"to,omitempty"`      // 轮次开始时间早于该时间
+	Detail  bool   `json:"detail,omitempty"`  // 是否返回每轮的候选人排名
+	Address string `json:"address,omitempty"` // 按候选人查询
+}
+
+//VoteCyclesResp 查询投票轮次的结果
+type VoteCyclesResp struct {
+	Total int64        `json:"total"` // 总记录数
+	Data  []*VoteCycle `json:"data"`  // 记录详情
+}
+
+//VoteCycle 投票轮次信息
+type VoteCycle struct {
+	CycleStart   int64               `json:"cycleStart"`          // 轮次开始时间
+	CycleEnd     int64               `json:"cycleEnd"`            // 轮次结束时间
+	TotalVotes   int64               `json:"totalVotes"`          // 总票数
+	WitnessCount int64               `json:"witnessCount"`        // 候选人数量
+	VoterCount   int64               `json:"voterCount"`          // 投票人数量
+	SnapshotTime int64               `json:"snapshotTime"`        // 记录票数和排名的时间，0为服务停止期间错过的轮次，没有票数和排名
+	Finished     bool                `json:"finished"`            // 是否已结束，进行中的轮次出块和丢块数为0
+	Witnesses    []*VoteCycleWitness `json:"witnesses,omitempty"` // 候选人排名，detail=true时返回
+}
+
+//VoteCycleWitnessResp 查询候选人历史轮次的结果
+type VoteCycleWitnessResp struct {
+	Total int64               `json:"total"` // 总记录数
+	Data  []*VoteCycleWitness `json:"data"`  // 记录详情
+}
+
+//VoteCycleWitness 候选人在某一轮的票数和排名
+type VoteCycleWitness struct {
+	CycleStart     int64  `json:"cycleStart"`     // 轮次开始时间
+	CycleEnd       int64  `json:"cycleEnd"`       // 轮次结束时间
+	Address        string `json:"address"`        // 候选人地址
+	Votes          int64  `json:"votes"`          // 得票数
+	Ranking        int32  `json:"ranking"`        // 排名
+	VoterCount     int64  `json:"voterCount"`     // 投票人数量
+	ProducedBlocks int64  `json:"producedBlocks"` // 本轮出块数
+	MissedBlocks   int64  `json:"missedBlocks"`   // 本轮丢块数
+}
@@ -0,0 +1,31 @@
+package entity
+
+//BlockSlot 区块的出块时间和出块人
+type BlockSlot struct {
+	BlockID        int64  // 区块高度
+	CreateTime     int64  // 区块时间
+	WitnessAddress string // 出块人
+}
+
+//WitnessMissedResp 查询超级代表丢块记录的结果
+type WitnessMissedResp struct {
+	Total int64                `json:"total"` // 总记录数
+	Data  []*WitnessMissedSlot `json:"data"`  // 记录详情
+}
+
+//WitnessMissedSlot 一次丢块记录
+type WitnessMissedSlot struct {
+	SlotTime    int64  `json:"slotTime"`    // 应出块的时间
+	Address     string `json:"address"`     // 应出块的超级代表
+	CycleStart  int64  `json:"cycleStart"`  // 所在轮次开始时间
+	PrevBlockID int64  `json:"prevBlockId"` // 丢块前最后一个区块的高度
+}
+
+//WitnessMissedAlert 连续丢块告警，以json格式发送到webhook
+type WitnessMissedAlert struct {
+	Event        string `json:"event"`        // missed 连续丢块 recovered 恢复出块
+	Address      string `json:"address"`      // 超级代表地址
+	Name         string `json:"name"`         // 超级代表名称
+	MissedCount  int64  `json:"missedCount"`  // 连续丢块数
+	LastSlotTime int64  `json:"lastSlotTime"` // 最后一次丢块或恢复出块的时间
+}
@@ -1,6 +1,7 @@
 package module
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 
@@ -73,6 +74,45 @@ func QueryAccountsRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.A
 
 }
 
+//QueryAccountListRealize 按地址批量查询账户列表，不分页不统计总数
+func QueryAccountListRealize(strSQL string) ([]*entity.AccountInfo, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryAccountListRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryAccountListRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	accountInfos := make([]*entity.AccountInfo, 0)
+
+	//填充数据
+	for dataPtr.NextT() {
+		var account = &entity.AccountInfo{}
+		var frozenBalance = make([]*entity.BalanceInfoDB, 0) //解析冻结信息
+		frozen := dataPtr.GetField("frozen")
+		if frozen != "" {
+			if err := json.Unmarshal([]byte(frozen), &frozenBalance); err != nil {
+				log.Errorf("Unmarshal data failed:[%v]-[%v]", err, frozen)
+				return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+			}
+		}
+		for _, balanceFrozen := range frozenBalance {
+			account.Power += balanceFrozen.Amount
+		}
+		account.Address = dataPtr.GetField("address")
+		account.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
+		account.UpdateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("latest_operation_time"))
+		account.Name = dataPtr.GetField("account_name")
+		account.Balance = mysql.ConvertDBValueToInt64(dataPtr.GetField("totalBalance"))
+		accountInfos = append(accountInfos, account)
+	}
+
+	return accountInfos, nil
+}
+
 //查询某个地址下的token信息
 func querytokenBalanceInfo(address string) (map[string]int64, error) {
 	strSQL := fmt.Sprintf(`
@@ -103,10 +143,10 @@ func querytokenBalanceInfo(address string) (map[string]int64, error) {
 }
 
 //QueryAccountRealize 操作数据库
-func QueryAccountRealize(strSQL, filterSQL string) (*entity.AccountDetail, error) {
+func QueryAccountRealize(ctx context.Context, strSQL, filterSQL string) (*entity.AccountDetail, error) {
 	strFullSQL := strSQL + " " + filterSQL
 	log.Sql(strFullSQL)
-	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	dataPtr, err := mysql.QueryTableDataContext(ctx, strFullSQL)
 	if err != nil {
 		log.Errorf("QueryAccountRealize error :[%v]\n", err)
 		return nil, util.NewErrorMsg(util.Error_common_internal_error)
@@ -120,7 +160,6 @@ func QueryAccountRealize(strSQL, filterSQL string) (*entity.AccountDetail, error
 	var apiBalance = make([]*entity.BalanceInfo, 0)
 	var frozenInfo = &entity.Frozen{Total: 0, Balances: apiBalance}
 	var represent = &entity.Represent{}
-	var balance = &entity.Balance{}
 	var balances = make([]*entity.Balance, 0)
 	var bandwidth = &entity.BandwidthInfo{}
 	var totalFrozen = int64(0)
@@ -189,6 +228,7 @@ func QueryAccountRealize(strSQL, filterSQL string) (*entity.AccountDetail, error
 			account.Frozen = frozenInfo
 		}
 
+		balance := &entity.Balance{}
 		balance.Name = dataPtr.GetField("token_name")
 		balance.Balance = mysql.ConvertDBValueToFloat64(dataPtr.GetField("balance"))
 
@@ -300,6 +340,36 @@ func UpdateSrAccount(address, github string) (int64, error) {
 	return instID, err
 }
 
+//SaveWitnessMeta 在一个事务中保存超级代表的主页和github地址，没有记录时插入
+func SaveWitnessMeta(meta *entity.WitnessMeta) error {
+	strSQL := fmt.Sprintf(`
+	select address from tron.wlcy_witness_create_info where address='%v' limit 1`, meta.Address)
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil || dataPtr == nil {
+		log.Errorf("SaveWitnessMeta query error :[%v]\n", err)
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	url := mysql.EscapeString(meta.URL)
+	github := mysql.EscapeString(meta.GithubLink)
+	sqls := make([]string, 0, 2)
+	if dataPtr.ResNum() > 0 {
+		sqls = append(sqls, fmt.Sprintf(`update tron.wlcy_witness_create_info set url='%v' where address='%v'`, url, meta.Address))
+	} else {
+		sqls = append(sqls, fmt.Sprintf(`insert into tron.wlcy_witness_create_info (address,url) values('%v','%v')`, meta.Address, url))
+	}
+	if CheckSrAccountExist(meta.Address) {
+		sqls = append(sqls, fmt.Sprintf(`update tron.wlcy_sr_account set github_link='%v' where address='%v'`, github, meta.Address))
+	} else {
+		sqls = append(sqls, fmt.Sprintf(`insert into tron.wlcy_sr_account (address,github_link) values('%v','%v')`, meta.Address, github))
+	}
+	if err := mysql.ExecuteSQLCommands(sqls); err != nil {
+		log.Errorf("SaveWitnessMeta address:[%v] fail:[%v]", meta.Address, err)
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return nil
+}
+
 //QueryAccountSrRealize 按账户查询github信息
 func QueryAccountSrRealize(strSQL, filterSQL string) (*entity.SuperAccountInfo, error) {
 	strFullSQL := strSQL + " " + filterSQL
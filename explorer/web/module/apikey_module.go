@@ -0,0 +1,105 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryAPIKeysRealize 查询api key列表
+func QueryAPIKeysRealize(strSQL string) ([]*entity.APIKeyInfo, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryAPIKeysRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryAPIKeysRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	apiKeys := make([]*entity.APIKeyInfo, 0)
+	for dataPtr.NextT() {
+		apiKey := &entity.APIKeyInfo{}
+		apiKey.APIKey = dataPtr.GetField("api_key")
+		apiKey.Name = dataPtr.GetField("name")
+		apiKey.Contact = dataPtr.GetField("contact")
+		apiKey.Rate = mysql.ConvertDBValueToInt64(dataPtr.GetField("rate"))
+		apiKey.Burst = mysql.ConvertDBValueToInt64(dataPtr.GetField("burst"))
+		apiKey.DailyQuota = mysql.ConvertDBValueToInt64(dataPtr.GetField("daily_quota"))
+		apiKey.Status = int32(mysql.ConvertDBValueToInt64(dataPtr.GetField("status")))
+		apiKey.CreateTime = dataPtr.GetField("create_time")
+		apiKeys = append(apiKeys, apiKey)
+	}
+	return apiKeys, nil
+}
+
+//InsertAPIKey 新增api key
+func InsertAPIKey(apiKey *entity.APIKeyInfo) error {
+	strSQL := fmt.Sprintf(`
+	insert into wlcy_api_key (api_key, name, contact, rate, burst, daily_quota, status)
+	values('%v', '%v', '%v', %v, %v, %v, %v)`,
+		apiKey.APIKey, apiKey.Name, apiKey.Contact, apiKey.Rate, apiKey.Burst, apiKey.DailyQuota, apiKey.Status)
+	log.Sql(strSQL)
+	_, _, err := mysql.ExecuteSQLCommand(strSQL, true)
+	if err != nil {
+		log.Errorf("InsertAPIKey result fail:[%v]  sql:%s", err, strSQL)
+	}
+	return err
+}
+
+//UpdateAPIKeyStatus 修改api key状态
+func UpdateAPIKeyStatus(apiKey string, status int32) (int64, error) {
+	strSQL := fmt.Sprintf(`
+	update wlcy_api_key set status=%v where api_key='%v'`, status, apiKey)
+	log.Sql(strSQL)
+	_, rows, err := mysql.ExecuteSQLCommand(strSQL, false)
+	if err != nil {
+		log.Errorf("UpdateAPIKeyStatus result fail:[%v]  sql:%s", err, strSQL)
+	}
+	return rows, err
+}
+
+//SaveAPIKeyUsage 保存api key每日用量，按主键覆盖
+func SaveAPIKeyUsage(usage *entity.APIKeyUsage) error {
+	strSQL := fmt.Sprintf(`
+	insert into wlcy_api_key_usage (api_key, usage_date, request_count, rejected_count)
+	values('%v', '%v', %v, %v)
+	on duplicate key update request_count=values(request_count), rejected_count=values(rejected_count)`,
+		usage.APIKey, usage.Date, usage.RequestCount, usage.RejectedCount)
+	log.Sql(strSQL)
+	_, _, err := mysql.ExecuteSQLCommand(strSQL, true)
+	if err != nil {
+		log.Errorf("SaveAPIKeyUsage result fail:[%v]  sql:%s", err, strSQL)
+	}
+	return err
+}
+
+//QueryAPIKeyUsageRealize 查询api key每日用量
+func QueryAPIKeyUsageRealize(strSQL string) (*entity.APIKeyUsageResp, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryAPIKeyUsageRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryAPIKeyUsageRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	usageResp := &entity.APIKeyUsageResp{}
+	usageResp.Data = make([]*entity.APIKeyUsage, 0)
+	for dataPtr.NextT() {
+		usage := &entity.APIKeyUsage{}
+		usage.APIKey = dataPtr.GetField("api_key")
+		usage.Date = dataPtr.GetField("usage_date")
+		usage.RequestCount = mysql.ConvertDBValueToInt64(dataPtr.GetField("request_count"))
+		usage.RejectedCount = mysql.ConvertDBValueToInt64(dataPtr.GetField("rejected_count"))
+		usageResp.Total += usage.RequestCount
+		usageResp.Data = append(usageResp.Data, usage)
+	}
+	return usageResp, nil
+}
@@ -1,6 +1,7 @@
 package module
 
 import (
+	"context"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/mysql"
 	"github.com/wlcy/tron/explorer/lib/util"
@@ -8,10 +9,10 @@ import (
 )
 
 //QueryBlocksRealize 操作数据库
-func QueryBlocksRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.BlocksResp, error) {
+func QueryBlocksRealize(ctx context.Context, strSQL, filterSQL, sortSQL, pageSQL string) (*entity.BlocksResp, error) {
 	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
 	log.Sql(strFullSQL)
-	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	dataPtr, err := mysql.QueryTableDataContext(ctx, strFullSQL)
 	if err != nil {
 		log.Errorf("QueryBlocks error :[%v]\n", err)
 		return nil, util.NewErrorMsg(util.Error_common_internal_error)
@@ -25,22 +26,7 @@ func QueryBlocksRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.Blo
 
 	//填充数据
 	for dataPtr.NextT() {
-		var block = &entity.BlockInfo{}
-		block.Number = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
-		block.Hash = dataPtr.GetField("block_hash")
-		block.Size = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_size"))
-		block.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
-		block.TxTrieRoot = dataPtr.GetField("tx_trie_hash")
-		block.ParentHash = dataPtr.GetField("parent_hash")
-		block.WitnessAddress = dataPtr.GetField("witness_address")
-		block.WitnessID = 0
-		block.NrOfTrx = mysql.ConvertDBValueToInt64(dataPtr.GetField("transaction_num"))
-		confirmed := dataPtr.GetField("confirmed")
-		if confirmed == "1" {
-			block.Confirmed = true
-		}
-
-		blockInfos = append(blockInfos, block)
+		blockInfos = append(blockInfos, newBlockInfo(dataPtr))
 	}
 
 	//查询该语句所查到的数据集合
@@ -91,3 +77,22 @@ func QueryBlockRealize(strSQL, filterSQL string) (*entity.BlockInfo, error) {
 	return block, nil
 
 }
+
+//newBlockInfo 读取当前行的区块
+func newBlockInfo(dataPtr *mysql.TronDBRows) *entity.BlockInfo {
+	var block = &entity.BlockInfo{}
+	block.Number = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
+	block.Hash = dataPtr.GetField("block_hash")
+	block.Size = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_size"))
+	block.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
+	block.TxTrieRoot = dataPtr.GetField("tx_trie_hash")
+	block.ParentHash = dataPtr.GetField("parent_hash")
+	block.WitnessAddress = dataPtr.GetField("witness_address")
+	block.WitnessID = 0
+	block.NrOfTrx = mysql.ConvertDBValueToInt64(dataPtr.GetField("transaction_num"))
+	confirmed := dataPtr.GetField("confirmed")
+	if confirmed == "1" {
+		block.Confirmed = true
+	}
+	return block
+}
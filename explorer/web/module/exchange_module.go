@@ -0,0 +1,192 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryExchangesRealize 查询交易对列表
+func QueryExchangesRealize(strSQL string) (*entity.ExchangesResp, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryExchangesRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryExchangesRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	exchangesResp := &entity.ExchangesResp{}
+	exchanges := make([]*entity.ExchangeInfo, 0)
+	for dataPtr.NextT() {
+		exchange := &entity.ExchangeInfo{}
+		exchange.ExchangeID = mysql.ConvertDBValueToInt64(dataPtr.GetField("exchange_id"))
+		exchange.Creator = dataPtr.GetField("creator_address")
+		exchange.FirstTokenID = dataPtr.GetField("first_token_id")
+		exchange.FirstTokenBalance = mysql.ConvertDBValueToInt64(dataPtr.GetField("first_token_balance"))
+		exchange.SecondTokenID = dataPtr.GetField("second_token_id")
+		exchange.SecondTokenBalance = mysql.ConvertDBValueToInt64(dataPtr.GetField("second_token_balance"))
+		exchange.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
+		exchange.BlockID = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
+		exchanges = append(exchanges, exchange)
+	}
+	exchangesResp.Total = int64(len(exchanges))
+	exchangesResp.Data = exchanges
+	return exchangesResp, nil
+}
+
+//QueryExchangeTradesRealize 查询交易对成交记录
+func QueryExchangeTradesRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.ExchangeTradesResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryExchangeTradesRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryExchangeTradesRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	tradesResp := &entity.ExchangeTradesResp{}
+	trades := make([]*entity.ExchangeTrade, 0)
+	for dataPtr.NextT() {
+		trade := &entity.ExchangeTrade{}
+		trade.Hash = dataPtr.GetField("trx_hash")
+		trade.Block = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
+		trade.ExchangeID = mysql.ConvertDBValueToInt64(dataPtr.GetField("exchange_id"))
+		trade.Owner = dataPtr.GetField("owner_address")
+		trade.SellTokenID = dataPtr.GetField("sell_token_id")
+		trade.SellAmount = mysql.ConvertDBValueToInt64(dataPtr.GetField("sell_amount"))
+		trade.BuyTokenID = dataPtr.GetField("buy_token_id")
+		trade.BuyAmount = mysql.ConvertDBValueToInt64(dataPtr.GetField("buy_amount"))
+		trade.Price = mysql.ConvertDBValueToFloat64(dataPtr.GetField("price"))
+		trade.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
+		trades = append(trades, trade)
+	}
+
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	tradesResp.Total = total
+	tradesResp.Data = trades
+	return tradesResp, nil
+}
+
+//QueryExchangeCandlesRealize 查询交易对K线
+func QueryExchangeCandlesRealize(strSQL string) ([]*entity.ExchangeCandle, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryExchangeCandlesRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryExchangeCandlesRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	candles := make([]*entity.ExchangeCandle, 0)
+	for dataPtr.NextT() {
+		candle := &entity.ExchangeCandle{}
+		candle.ExchangeID = mysql.ConvertDBValueToInt64(dataPtr.GetField("exchange_id"))
+		candle.Interval = dataPtr.GetField("candle_interval")
+		candle.OpenTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("open_time"))
+		candle.Open = mysql.ConvertDBValueToFloat64(dataPtr.GetField("open_price"))
+		candle.High = mysql.ConvertDBValueToFloat64(dataPtr.GetField("high_price"))
+		candle.Low = mysql.ConvertDBValueToFloat64(dataPtr.GetField("low_price"))
+		candle.Close = mysql.ConvertDBValueToFloat64(dataPtr.GetField("close_price"))
+		candle.Volume = mysql.ConvertDBValueToInt64(dataPtr.GetField("volume"))
+		candle.QuoteVolume = mysql.ConvertDBValueToInt64(dataPtr.GetField("quote_volume"))
+		candle.TradeCount = mysql.ConvertDBValueToInt64(dataPtr.GetField("trade_count"))
+		candles = append(candles, candle)
+	}
+	return candles, nil
+}
+
+//QueryExchangeContractsRealize 查询交易对相关的交易
+func QueryExchangeContractsRealize(strSQL string) ([]*entity.ExchangeContract, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryExchangeContractsRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryExchangeContractsRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	contracts := make([]*entity.ExchangeContract, 0)
+	for dataPtr.NextT() {
+		contract := &entity.ExchangeContract{}
+		contract.Hash = dataPtr.GetField("trx_hash")
+		contract.BlockID = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
+		contract.ContractType = mysql.ConvertDBValueToInt64(dataPtr.GetField("contract_type"))
+		contract.ContractData = dataPtr.GetField("contract_data")
+		contract.OwnerAddress = dataPtr.GetField("owner_address")
+		contract.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
+		contracts = append(contracts, contract)
+	}
+	return contracts, nil
+}
+
+//SaveExchangeSync 在一个事务中写入交易对余额、成交记录和K线
+//K线已存在时合并：开盘价不变，最高最低价取极值，收盘价取新值，成交量累加
+func SaveExchangeSync(exchanges []*entity.ExchangeInfo, trades []*entity.ExchangeTrade, candles []*entity.ExchangeCandle) error {
+	sqls := make([]string, 0, len(exchanges)+2)
+	for _, exchange := range exchanges {
+		sqls = append(sqls, fmt.Sprintf(`
+		insert into wlcy_exchange (exchange_id, creator_address, first_token_id, first_token_balance,
+			second_token_id, second_token_balance, create_time, block_id)
+		values(%v, '%v', '%v', %v, '%v', %v, %v, %v)
+		on duplicate key update first_token_balance=values(first_token_balance),
+			second_token_balance=values(second_token_balance), block_id=values(block_id)`,
+			exchange.ExchangeID, exchange.Creator,
+			mysql.EscapeString(exchange.FirstTokenID), exchange.FirstTokenBalance,
+			mysql.EscapeString(exchange.SecondTokenID), exchange.SecondTokenBalance,
+			exchange.CreateTime, exchange.BlockID))
+	}
+	if len(trades) > 0 {
+		values := make([]string, 0, len(trades))
+		for _, trade := range trades {
+			values = append(values, fmt.Sprintf("('%v', %v, %v, '%v', '%v', %v, '%v', %v, %v, %v)",
+				trade.Hash, trade.Block, trade.ExchangeID, trade.Owner,
+				mysql.EscapeString(trade.SellTokenID), trade.SellAmount,
+				mysql.EscapeString(trade.BuyTokenID), trade.BuyAmount, trade.Price, trade.CreateTime))
+		}
+		sqls = append(sqls, fmt.Sprintf(`
+		insert ignore into wlcy_exchange_trade (trx_hash, block_id, exchange_id, owner_address,
+			sell_token_id, sell_amount, buy_token_id, buy_amount, price, create_time)
+		values %v`, strings.Join(values, ",")))
+	}
+	if len(candles) > 0 {
+		values := make([]string, 0, len(candles))
+		for _, candle := range candles {
+			values = append(values, fmt.Sprintf("(%v, '%v', %v, %v, %v, %v, %v, %v, %v, %v)",
+				candle.ExchangeID, candle.Interval, candle.OpenTime, candle.Open, candle.High, candle.Low,
+				candle.Close, candle.Volume, candle.QuoteVolume, candle.TradeCount))
+		}
+		sqls = append(sqls, fmt.Sprintf(`
+		insert into wlcy_exchange_candle (exchange_id, candle_interval, open_time, open_price, high_price,
+			low_price, close_price, volume, quote_volume, trade_count)
+		values %v
+		on duplicate key update high_price=greatest(high_price, values(high_price)),
+			low_price=least(low_price, values(low_price)), close_price=values(close_price),
+			volume=volume+values(volume), quote_volume=quote_volume+values(quote_volume),
+			trade_count=trade_count+values(trade_count)`, strings.Join(values, ",")))
+	}
+	if len(sqls) == 0 {
+		return nil
+	}
+	err := mysql.ExecuteSQLCommands(sqls)
+	if err != nil {
+		log.Errorf("SaveExchangeSync fail:[%v]", err)
+	}
+	return err
+}
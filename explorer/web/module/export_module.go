@@ -0,0 +1,58 @@
+package module
+
+import (
+	"context"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//ExportTransfersRealize 流式读取转账记录，每行回调一次handler
+func ExportTransfersRealize(ctx context.Context, strSQL string, handler func(transfer *entity.ExportTransferInfo) error) error {
+	log.Sql(strSQL)
+	var transfer = &entity.ExportTransferInfo{}
+	err := mysql.QueryTableDataStream(ctx, strSQL, func(dataPtr *mysql.TronDBRows) error {
+		transfer.Block = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
+		transfer.TransactionHash = dataPtr.GetField("trx_hash")
+		transfer.From = dataPtr.GetField("owner_address")
+		transfer.To = dataPtr.GetField("to_address")
+		createTime := dataPtr.GetField("create_time")
+		if len(createTime) > 13 {
+			createTime = createTime[:13]
+		}
+		transfer.Timestamp = mysql.ConvertDBValueToInt64(createTime)
+		transfer.TokenName = dataPtr.GetField("asset_name")
+		if transfer.TokenName == "" {
+			transfer.TokenName = "TRX"
+		}
+		transfer.RawAmount = mysql.ConvertDBValueToInt64(dataPtr.GetField("amount"))
+		transfer.Confirmed = dataPtr.GetField("confirmed") == "1"
+		return handler(transfer)
+	})
+	if err != nil {
+		log.Errorf("ExportTransfersRealize error :[%v]\n", err)
+	}
+	return err
+}
+
+//QueryTokenPrecisions 全部TRC10通证的精度，key为通证名称
+func QueryTokenPrecisions() (map[string]int32, error) {
+	strSQL := `select asset_name, asset_precision from asset_issue`
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryTokenPrecisions error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryTokenPrecisions dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	precisions := make(map[string]int32)
+	for dataPtr.NextT() {
+		precisions[dataPtr.GetField("asset_name")] = int32(mysql.ConvertDBValueToInt64(dataPtr.GetField("asset_precision")))
+	}
+	return precisions, nil
+}
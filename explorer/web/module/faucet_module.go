@@ -0,0 +1,63 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryFaucetGrantsRealize 分页查询测试币发放记录
+func QueryFaucetGrantsRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.FaucetGrantsResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryFaucetGrantsRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryFaucetGrantsRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	grants := make([]*entity.FaucetGrant, 0)
+	for dataPtr.NextT() {
+		grant := &entity.FaucetGrant{}
+		grant.ID = mysql.ConvertDBValueToInt64(dataPtr.GetField("id"))
+		grant.Address = dataPtr.GetField("address")
+		grant.IP = dataPtr.GetField("ip")
+		grant.Amount = mysql.ConvertDBValueToInt64(dataPtr.GetField("amount"))
+		grant.Hash = dataPtr.GetField("trx_hash")
+		grant.Status = int32(mysql.ConvertDBValueToInt64(dataPtr.GetField("status")))
+		grant.Message = dataPtr.GetField("message")
+		grant.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
+		grants = append(grants, grant)
+	}
+	grantsResp := &entity.FaucetGrantsResp{}
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	grantsResp.Total = total
+	grantsResp.Data = grants
+	return grantsResp, nil
+}
+
+//InsertFaucetGrant 保存测试币发放记录
+func InsertFaucetGrant(grant *entity.FaucetGrant) error {
+	strSQL := fmt.Sprintf(`
+	insert into wlcy_faucet_grant (address, ip, amount, trx_hash, status, message, create_time)
+	values('%v', '%v', %v, '%v', %v, '%v', %v)`,
+		grant.Address, mysql.EscapeString(grant.IP), grant.Amount, grant.Hash, grant.Status,
+		mysql.EscapeString(grant.Message), grant.CreateTime)
+	log.Sql(strSQL)
+	insID, _, err := mysql.ExecuteSQLCommand(strSQL, true)
+	if err != nil {
+		log.Errorf("InsertFaucetGrant fail:[%v]  sql:%s", err, strSQL)
+		return err
+	}
+	grant.ID = insID
+	return nil
+}
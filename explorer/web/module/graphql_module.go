@@ -0,0 +1,101 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+/*
+graphql 列表字段的批量查询
+每个父对象一个子查询，子查询各自排序分页，并带上 group_key 列标记所属的父对象，
+用 union all 合成一条SQL，结果按 group_key 分组返回
+*/
+
+//GenGroupSQL 把每个key的子查询合成一条SQL，groupColumn 为子查询需要select的 group_key 列
+func GenGroupSQL(keys []string, subSQL func(key, groupColumn string) string) string {
+	parts := make([]string, 0, len(keys))
+	for _, key := range keys {
+		groupColumn := fmt.Sprintf("'%v' as group_key", mysql.EscapeString(key))
+		parts = append(parts, fmt.Sprintf("(%v)", subSQL(key, groupColumn)))
+	}
+	return strings.Join(parts, " union all ")
+}
+
+//QueryTransfersGroupRealize 按 group_key 分组的转账记录
+func QueryTransfersGroupRealize(strSQL string) (map[string][]*entity.TransferInfo, error) {
+	ret := make(map[string][]*entity.TransferInfo)
+	err := queryGroupRealize("QueryTransfersGroupRealize", strSQL, func(key string, dataPtr *mysql.TronDBRows) {
+		ret[key] = append(ret[key], newTransferInfo(dataPtr))
+	})
+	return ret, err
+}
+
+//QueryTransactionsGroupRealize 按 group_key 分组的交易记录
+func QueryTransactionsGroupRealize(strSQL string) (map[string][]*entity.TransactionInfo, error) {
+	ret := make(map[string][]*entity.TransactionInfo)
+	err := queryGroupRealize("QueryTransactionsGroupRealize", strSQL, func(key string, dataPtr *mysql.TronDBRows) {
+		ret[key] = append(ret[key], newTransactionInfo(dataPtr))
+	})
+	return ret, err
+}
+
+//QueryBlocksGroupRealize 按 group_key 分组的区块
+func QueryBlocksGroupRealize(strSQL string) (map[string][]*entity.BlockInfo, error) {
+	ret := make(map[string][]*entity.BlockInfo)
+	err := queryGroupRealize("QueryBlocksGroupRealize", strSQL, func(key string, dataPtr *mysql.TronDBRows) {
+		ret[key] = append(ret[key], newBlockInfo(dataPtr))
+	})
+	return ret, err
+}
+
+//QueryAccountVoteResultGroupRealize 按 group_key 分组的投票记录
+func QueryAccountVoteResultGroupRealize(strSQL string) (map[string][]*entity.AccountVoteResult, error) {
+	ret := make(map[string][]*entity.AccountVoteResult)
+	err := queryGroupRealize("QueryAccountVoteResultGroupRealize", strSQL, func(key string, dataPtr *mysql.TronDBRows) {
+		ret[key] = append(ret[key], &entity.AccountVoteResult{
+			Address:   dataPtr.GetField("address"),
+			ToAddress: dataPtr.GetField("to_address"),
+			Vote:      mysql.ConvertDBValueToInt64(dataPtr.GetField("vote")),
+		})
+	})
+	return ret, err
+}
+
+//QueryAccountStatsGroupRealize 按 group_key 分组的账户交易统计
+func QueryAccountStatsGroupRealize(strSQL string) (map[string]*entity.AccountTransactionNum, error) {
+	ret := make(map[string]*entity.AccountTransactionNum)
+	err := queryGroupRealize("QueryAccountStatsGroupRealize", strSQL, func(key string, dataPtr *mysql.TronDBRows) {
+		stats := &entity.AccountTransactionNum{
+			TransactionsOut: mysql.ConvertDBValueToInt64(dataPtr.GetField("trxOut")),
+			TransactionIn:   mysql.ConvertDBValueToInt64(dataPtr.GetField("trxIn")),
+		}
+		stats.Transactions = stats.TransactionsOut + stats.TransactionIn
+		ret[key] = stats
+	})
+	return ret, err
+}
+
+func queryGroupRealize(name, strSQL string, handle func(key string, dataPtr *mysql.TronDBRows)) error {
+	if strSQL == "" {
+		return nil
+	}
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("%v error:[%v]\n", name, err)
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("%v dataPtr is nil ", name)
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	for dataPtr.NextT() {
+		handle(dataPtr.GetField("group_key"), dataPtr)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//addressLabelBatchSize 批量写入标签时每条sql的记录数
+const addressLabelBatchSize = 500
+
+//QueryAddressLabelsRealize 分页查询地址标签
+func QueryAddressLabelsRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.AddressLabelsResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	labels, err := QueryLabelsRealize(context.Background(), strFullSQL)
+	if err != nil {
+		return nil, err
+	}
+	labelsResp := &entity.AddressLabelsResp{}
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	labelsResp.Total = total
+	labelsResp.Data = labels
+	return labelsResp, nil
+}
+
+//QueryLabelsRealize 查询地址标签
+func QueryLabelsRealize(ctx context.Context, strSQL string) ([]*entity.AddressLabel, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableDataContext(ctx, strSQL)
+	if err != nil {
+		log.Errorf("QueryLabelsRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryLabelsRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	labels := make([]*entity.AddressLabel, 0)
+	for dataPtr.NextT() {
+		label := &entity.AddressLabel{}
+		label.ID = mysql.ConvertDBValueToInt64(dataPtr.GetField("id"))
+		label.Address = dataPtr.GetField("address")
+		label.Label = dataPtr.GetField("label")
+		label.Category = dataPtr.GetField("category")
+		label.Note = dataPtr.GetField("note")
+		label.Private = dataPtr.GetField("owner_key") != ""
+		label.UpdateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("update_time"))
+		labels = append(labels, label)
+	}
+	return labels, nil
+}
+
+//QueryAddressLabelsByAddress 查询一组地址的标签，ownerKey为空时查询公开标签
+func QueryAddressLabelsByAddress(ctx context.Context, ownerKey string, addresses []string) ([]*entity.AddressLabel, error) {
+	if len(addresses) == 0 {
+		return make([]*entity.AddressLabel, 0), nil
+	}
+	values := make([]string, 0, len(addresses))
+	for _, address := range addresses {
+		values = append(values, fmt.Sprintf("'%v'", mysql.EscapeString(address)))
+	}
+	strSQL := fmt.Sprintf(`
+	select id, owner_key, address, label, category, note, update_time
+	from wlcy_address_label
+	where owner_key='%v' and address in (%v)`, ownerKey, strings.Join(values, ","))
+	return QueryLabelsRealize(ctx, strSQL)
+}
+
+//SaveAddressLabels 批量保存地址标签，同一api key下地址已有标签时覆盖
+func SaveAddressLabels(ownerKey string, labels []*entity.AddressLabel) (int64, error) {
+	var saved int64
+	for start := 0; start < len(labels); start += addressLabelBatchSize {
+		end := start + addressLabelBatchSize
+		if end > len(labels) {
+			end = len(labels)
+		}
+		values := make([]string, 0, end-start)
+		for _, label := range labels[start:end] {
+			values = append(values, fmt.Sprintf("('%v', '%v', '%v', '%v', '%v', %v)",
+				ownerKey, label.Address, mysql.EscapeString(label.Label), label.Category,
+				mysql.EscapeString(label.Note), label.UpdateTime))
+		}
+		strSQL := fmt.Sprintf(`
+		insert into wlcy_address_label (owner_key, address, label, category, note, update_time)
+		values %v
+		on duplicate key update label=values(label), category=values(category), note=values(note), update_time=values(update_time)`,
+			strings.Join(values, ","))
+		log.Sql(strSQL)
+		if _, _, err := mysql.ExecuteSQLCommand(strSQL, false); err != nil {
+			log.Errorf("SaveAddressLabels fail:[%v]", err)
+			return saved, err
+		}
+		saved += int64(end - start)
+	}
+	return saved, nil
+}
+
+//DeleteAddressLabel 删除地址标签
+func DeleteAddressLabel(ownerKey, address string) (int64, error) {
+	strSQL := fmt.Sprintf(`
+	delete from wlcy_address_label where owner_key='%v' and address='%v'`, ownerKey, address)
+	log.Sql(strSQL)
+	_, rows, err := mysql.ExecuteSQLCommand(strSQL, false)
+	if err != nil {
+		log.Errorf("DeleteAddressLabel fail:[%v]  sql:%s", err, strSQL)
+	}
+	return rows, err
+}
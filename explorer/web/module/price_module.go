@@ -0,0 +1,97 @@
+package module
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryPriceHistoryRealize 查询历史价格
+func QueryPriceHistoryRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.PriceHistoryResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	prices, err := QueryPricesRealize(context.Background(), strFullSQL)
+	if err != nil {
+		return nil, err
+	}
+	historyResp := &entity.PriceHistoryResp{}
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	historyResp.Total = total
+	historyResp.Data = prices
+	return historyResp, nil
+}
+
+//QueryPricesRealize 查询价格记录
+func QueryPricesRealize(ctx context.Context, strSQL string) ([]*entity.PriceInfo, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableDataContext(ctx, strSQL)
+	if err != nil {
+		log.Errorf("QueryPricesRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryPricesRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	prices := make([]*entity.PriceInfo, 0)
+	for dataPtr.NextT() {
+		price := &entity.PriceInfo{}
+		price.Symbol = dataPtr.GetField("symbol")
+		price.Currency = dataPtr.GetField("currency")
+		price.Price = mysql.ConvertDBValueToFloat64(dataPtr.GetField("price"))
+		price.Time = mysql.ConvertDBValueToInt64(dataPtr.GetField("price_time"))
+		price.Sources = mysql.ConvertDBValueToInt64(dataPtr.GetField("sources"))
+		prices = append(prices, price)
+	}
+	return prices, nil
+}
+
+//InsertPriceHistory 保存聚合后的价格，同一时间重复写入时忽略
+func InsertPriceHistory(prices []*entity.PriceInfo) error {
+	if len(prices) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(prices))
+	for _, price := range prices {
+		values = append(values, fmt.Sprintf("('%v', '%v', %v, %v, %v)",
+			mysql.EscapeString(price.Symbol), price.Currency, price.Price, price.Sources, price.Time))
+	}
+	strSQL := fmt.Sprintf(`
+	insert ignore into wlcy_price_history (symbol, currency, price, sources, price_time)
+	values %v`, strings.Join(values, ","))
+	log.Sql(strSQL)
+	_, _, err := mysql.ExecuteSQLCommand(strSQL, false)
+	if err != nil {
+		log.Errorf("InsertPriceHistory fail:[%v]", err)
+	}
+	return err
+}
+
+//QueryPricesAt 查询各币种在该时间之前最后一次聚合的价格
+func QueryPricesAt(ctx context.Context, symbols []string, at int64) ([]*entity.PriceInfo, error) {
+	if len(symbols) == 0 {
+		return make([]*entity.PriceInfo, 0), nil
+	}
+	values := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		values = append(values, fmt.Sprintf("'%v'", mysql.EscapeString(symbol)))
+	}
+	strSQL := fmt.Sprintf(`
+	select his.symbol, his.currency, his.price, his.sources, his.price_time
+	from wlcy_price_history his
+	inner join (
+		select symbol, currency, max(price_time) as price_time
+		from wlcy_price_history
+		where symbol in (%v) and price_time<=%v
+		group by symbol, currency) latest
+	on latest.symbol=his.symbol and latest.currency=his.currency and latest.price_time=his.price_time`,
+		strings.Join(values, ","), at)
+	return QueryPricesRealize(ctx, strSQL)
+}
@@ -0,0 +1,237 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryProposalsRealize 查询提议列表
+func QueryProposalsRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.ProposalsResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryProposalsRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryProposalsRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	proposalsResp := &entity.ProposalsResp{}
+	proposals := make([]*entity.ProposalInfo, 0)
+	for dataPtr.NextT() {
+		proposal := &entity.ProposalInfo{}
+		proposal.ProposalID = mysql.ConvertDBValueToInt64(dataPtr.GetField("proposal_id"))
+		proposal.Proposer = dataPtr.GetField("proposer_address")
+		proposal.Parameters = ParseProposalParameters(dataPtr.GetField("parameters"))
+		proposal.ExpirationTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("expiration_time"))
+		proposal.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
+		proposal.ApprovalCount = mysql.ConvertDBValueToInt64(dataPtr.GetField("approval_count"))
+		proposal.State = dataPtr.GetField("state")
+		proposals = append(proposals, proposal)
+	}
+
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	proposalsResp.Total = total
+	proposalsResp.Data = proposals
+	return proposalsResp, nil
+}
+
+//QueryProposalApprovalsRealize 查询提议的赞成列表，按提议编号分组
+func QueryProposalApprovalsRealize(strSQL string) (map[int64][]string, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryProposalApprovalsRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryProposalApprovalsRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	approvals := make(map[int64][]string)
+	for dataPtr.NextT() {
+		proposalID := mysql.ConvertDBValueToInt64(dataPtr.GetField("proposal_id"))
+		approvals[proposalID] = append(approvals[proposalID], dataPtr.GetField("address"))
+	}
+	return approvals, nil
+}
+
+//QueryProposalStateChangesRealize 查询提议的状态变化记录
+func QueryProposalStateChangesRealize(strSQL string) ([]*entity.ProposalStateChange, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryProposalStateChangesRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryProposalStateChangesRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	stateChanges := make([]*entity.ProposalStateChange, 0)
+	for dataPtr.NextT() {
+		stateChange := &entity.ProposalStateChange{}
+		stateChange.ProposalID = mysql.ConvertDBValueToInt64(dataPtr.GetField("proposal_id"))
+		stateChange.FromState = dataPtr.GetField("from_state")
+		stateChange.ToState = dataPtr.GetField("to_state")
+		stateChange.ChangeTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("change_time"))
+		stateChanges = append(stateChanges, stateChange)
+	}
+	return stateChanges, nil
+}
+
+//QueryChainParameterHistoryRealize 查询链参数变化记录
+func QueryChainParameterHistoryRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.ChainParameterHistoryResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryChainParameterHistoryRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryChainParameterHistoryRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	historyResp := &entity.ChainParameterHistoryResp{}
+	changes := make([]*entity.ChainParameterChange, 0)
+	for dataPtr.NextT() {
+		change := &entity.ChainParameterChange{}
+		change.Key = dataPtr.GetField("param_key")
+		change.OldValue = mysql.ConvertDBValueToInt64(dataPtr.GetField("old_value"))
+		change.NewValue = mysql.ConvertDBValueToInt64(dataPtr.GetField("new_value"))
+		change.ChangeTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("change_time"))
+		change.ProposalID = mysql.ConvertDBValueToInt64(dataPtr.GetField("proposal_id"))
+		changes = append(changes, change)
+	}
+
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	historyResp.Total = total
+	historyResp.Data = changes
+	return historyResp, nil
+}
+
+//QueryChainParameterSnapshot 查询上次同步的链参数
+func QueryChainParameterSnapshot() (map[string]int64, error) {
+	strSQL := fmt.Sprintf(`select param_key, param_value from wlcy_chain_parameter`)
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryChainParameterSnapshot error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryChainParameterSnapshot dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	params := make(map[string]int64)
+	for dataPtr.NextT() {
+		params[dataPtr.GetField("param_key")] = mysql.ConvertDBValueToInt64(dataPtr.GetField("param_value"))
+	}
+	return params, nil
+}
+
+//SaveProposal 在一个事务中写入提议，stateChange不为nil时记录状态变化，approvals不为nil时替换赞成列表
+func SaveProposal(proposal *entity.ProposalInfo, stateChange *entity.ProposalStateChange, approvals []string) error {
+	sqls := make([]string, 0, 4)
+	sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_proposal (proposal_id, proposer_address, parameters, expiration_time, create_time, approval_count, state)
+	values(%v, '%v', '%v', %v, %v, %v, '%v')
+	on duplicate key update approval_count=values(approval_count), state=values(state)`,
+		proposal.ProposalID, proposal.Proposer, FormatProposalParameters(proposal.Parameters),
+		proposal.ExpirationTime, proposal.CreateTime, proposal.ApprovalCount, proposal.State))
+	if stateChange != nil {
+		sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_proposal_state (proposal_id, from_state, to_state, change_time)
+	values(%v, '%v', '%v', %v)`, proposal.ProposalID, stateChange.FromState, stateChange.ToState, stateChange.ChangeTime))
+	}
+	if approvals != nil {
+		sqls = append(sqls, fmt.Sprintf(`delete from wlcy_proposal_approval where proposal_id=%v`, proposal.ProposalID))
+		if len(approvals) > 0 {
+			values := make([]string, 0, len(approvals))
+			for _, address := range approvals {
+				values = append(values, fmt.Sprintf("(%v, '%v')", proposal.ProposalID, address))
+			}
+			sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_proposal_approval (proposal_id, address) values %v`, strings.Join(values, ",")))
+		}
+	}
+	err := mysql.ExecuteSQLCommands(sqls)
+	if err != nil {
+		log.Errorf("SaveProposal proposal:[%v] fail:[%v]", proposal.ProposalID, err)
+	}
+	return err
+}
+
+//SaveChainParameters 在一个事务中更新链参数快照并记录变化
+func SaveChainParameters(params map[string]int64, changes []*entity.ChainParameterChange) error {
+	if len(params) == 0 {
+		return nil
+	}
+	keys := make([]string, 0, len(params))
+	for key := range params {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	values := make([]string, 0, len(params))
+	for _, key := range keys {
+		values = append(values, fmt.Sprintf("('%v', %v)", key, params[key]))
+	}
+	sqls := make([]string, 0, len(changes)+1)
+	sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_chain_parameter (param_key, param_value) values %v
+	on duplicate key update param_value=values(param_value)`, strings.Join(values, ",")))
+	for _, change := range changes {
+		sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_chain_parameter_history (param_key, old_value, new_value, change_time, proposal_id)
+	values('%v', %v, %v, %v, %v)`, change.Key, change.OldValue, change.NewValue, change.ChangeTime, change.ProposalID))
+	}
+	err := mysql.ExecuteSQLCommands(sqls)
+	if err != nil {
+		log.Errorf("SaveChainParameters fail:[%v]", err)
+	}
+	return err
+}
+
+//FormatProposalParameters 提议参数以 {"编号":值} 的json格式保存
+func FormatProposalParameters(parameters []*entity.ProposalParameter) string {
+	params := make(map[string]int64, len(parameters))
+	for _, parameter := range parameters {
+		params[strconv.FormatInt(parameter.Key, 10)] = parameter.Value
+	}
+	data, _ := json.Marshal(params)
+	return string(data)
+}
+
+//ParseProposalParameters 解析保存的提议参数，按编号排序，参数名由service填充
+func ParseProposalParameters(data string) []*entity.ProposalParameter {
+	params := make(map[string]int64)
+	if err := json.Unmarshal([]byte(data), &params); err != nil {
+		log.Errorf("ParseProposalParameters [%v] err:[%v]", data, err)
+	}
+	parameters := make([]*entity.ProposalParameter, 0, len(params))
+	for key, value := range params {
+		parameter := &entity.ProposalParameter{}
+		parameter.Key, _ = strconv.ParseInt(key, 10, 64)
+		parameter.Value = value
+		parameters = append(parameters, parameter)
+	}
+	sort.Slice(parameters, func(i, j int) bool { return parameters[i].Key < parameters[j].Key })
+	return parameters
+}
@@ -0,0 +1,74 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryWitnessRewardRealize 查询超级代表每轮奖励
+func QueryWitnessRewardRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.WitnessRewardResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryWitnessRewardRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryWitnessRewardRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	rewardResp := &entity.WitnessRewardResp{}
+	rewards := make([]*entity.WitnessReward, 0)
+	for dataPtr.NextT() {
+		reward := &entity.WitnessReward{}
+		reward.CycleStart = mysql.ConvertDBValueToInt64(dataPtr.GetField("cycle_start"))
+		reward.CycleEnd = mysql.ConvertDBValueToInt64(dataPtr.GetField("cycle_end"))
+		reward.Address = dataPtr.GetField("address")
+		reward.Votes = mysql.ConvertDBValueToInt64(dataPtr.GetField("votes"))
+		reward.Ranking = int32(mysql.ConvertDBValueToInt64(dataPtr.GetField("ranking")))
+		reward.ProducedBlocks = mysql.ConvertDBValueToInt64(dataPtr.GetField("produced_blocks"))
+		reward.BlockReward = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_reward"))
+		reward.VoteReward = mysql.ConvertDBValueToInt64(dataPtr.GetField("vote_reward"))
+		reward.TotalReward = mysql.ConvertDBValueToInt64(dataPtr.GetField("total_reward"))
+		rewards = append(rewards, reward)
+	}
+
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	rewardResp.Total = total
+	rewardResp.Data = rewards
+	return rewardResp, nil
+}
+
+//InsertVoteCycleReward 在一个事务中写入某一轮的奖励合计和各超级代表的奖励
+func InsertVoteCycleReward(cycleReward *entity.VoteCycleReward, rewards []*entity.WitnessReward) error {
+	sqls := make([]string, 0, 2)
+	sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_vote_cycle_reward (cycle_start, witness_pay_per_block, witness_standby_allowance, total_block_reward, total_vote_reward)
+	values(%v, %v, %v, %v, %v)`,
+		cycleReward.CycleStart, cycleReward.WitnessPayPerBlock, cycleReward.WitnessStandbyAllowance,
+		cycleReward.TotalBlockReward, cycleReward.TotalVoteReward))
+	if len(rewards) > 0 {
+		values := make([]string, 0, len(rewards))
+		for _, reward := range rewards {
+			values = append(values, fmt.Sprintf("(%v, '%v', %v, %v, %v, %v, %v, %v)", reward.CycleStart, reward.Address,
+				reward.Votes, reward.Ranking, reward.ProducedBlocks, reward.BlockReward, reward.VoteReward, reward.TotalReward))
+		}
+		sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_witness_reward (cycle_start, address, votes, ranking, produced_blocks, block_reward, vote_reward, total_reward)
+	values %v`, strings.Join(values, ",")))
+	}
+	err := mysql.ExecuteSQLCommands(sqls)
+	if err != nil {
+		log.Errorf("InsertVoteCycleReward cycle:[%v] fail:[%v]", cycleReward.CycleStart, err)
+	}
+	return err
+}
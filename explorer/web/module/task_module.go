@@ -0,0 +1,100 @@
+package module
+
+import (
+	"fmt"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//InsertTaskRun 保存定时任务执行记录
+func InsertTaskRun(run *entity.TaskRun) error {
+	strSQL := fmt.Sprintf(`
+	insert into wlcy_task_run (job, trigger_type, scheduled_time, start_time, end_time, duration, status, error, instance)
+	values ('%v', '%v', %v, %v, %v, %v, '%v', '%v', '%v')`,
+		run.Job, run.Trigger, run.ScheduledTime, run.StartTime, run.EndTime, run.Duration, run.Status,
+		mysql.EscapeString(run.Error), mysql.EscapeString(run.Instance))
+	log.Sql(strSQL)
+	id, _, err := mysql.ExecuteSQLCommand(strSQL, true)
+	if err != nil {
+		log.Errorf("InsertTaskRun fail:[%v]  sql:%s", err, strSQL)
+		return err
+	}
+	run.ID = id
+	return nil
+}
+
+//QueryTaskLastScheduled 任务最后一次按计划执行(含补执行)的计划时间，没有时返回0
+func QueryTaskLastScheduled(job string) (int64, error) {
+	strSQL := fmt.Sprintf(`
+	select ifnull(max(scheduled_time), 0) as scheduled_time
+	from wlcy_task_run
+	where job='%v' and trigger_type in ('schedule', 'catchup')`, job)
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryTaskLastScheduled error :[%v]\n", err)
+		return 0, err
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryTaskLastScheduled dataPtr is nil ")
+		return 0, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	var scheduledTime int64
+	for dataPtr.NextT() {
+		scheduledTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("scheduled_time"))
+	}
+	return scheduledTime, nil
+}
+
+//QueryTaskRunsRealize 分页查询执行记录
+func QueryTaskRunsRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.TaskRunsResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryTaskRunsRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryTaskRunsRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	runs := make([]*entity.TaskRun, 0)
+	for dataPtr.NextT() {
+		run := &entity.TaskRun{}
+		run.ID = mysql.ConvertDBValueToInt64(dataPtr.GetField("id"))
+		run.Job = dataPtr.GetField("job")
+		run.Trigger = dataPtr.GetField("trigger_type")
+		run.ScheduledTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("scheduled_time"))
+		run.StartTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("start_time"))
+		run.EndTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("end_time"))
+		run.Duration = mysql.ConvertDBValueToInt64(dataPtr.GetField("duration"))
+		run.Status = dataPtr.GetField("status")
+		run.Error = dataPtr.GetField("error")
+		run.Instance = dataPtr.GetField("instance")
+		runs = append(runs, run)
+	}
+	runsResp := &entity.TaskRunsResp{}
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	runsResp.Total = total
+	runsResp.Data = runs
+	return runsResp, nil
+}
+
+//DeleteTaskRunsBefore 删除开始时间早于before的执行记录
+func DeleteTaskRunsBefore(before int64) (int64, error) {
+	strSQL := fmt.Sprintf(`
+	delete from wlcy_task_run where start_time<%v`, before)
+	log.Sql(strSQL)
+	_, rows, err := mysql.ExecuteSQLCommand(strSQL, false)
+	if err != nil {
+		log.Errorf("DeleteTaskRunsBefore fail:[%v]  sql:%s", err, strSQL)
+	}
+	return rows, err
+}
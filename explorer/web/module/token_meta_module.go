@@ -0,0 +1,213 @@
+package module
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//tokenSocialMediaColumns 社交媒体名称对应 wlcy_asset_info 的字段，与 QueryTokenExtInfo 一致
+var tokenSocialMediaColumns = map[string]string{
+	"Reddit":   "reddit",
+	"Twitter":  "twitter",
+	"Facebook": "facebook",
+	"Telegram": "telegram",
+	"Steem":    "steam",
+	"Medium":   "medium",
+	"Wechat":   "webchat",
+	"Weibo":    "Weibo",
+}
+
+//IsTokenSocialMedia 是否为支持的社交媒体名称
+func IsTokenSocialMedia(name string) bool {
+	_, ok := tokenSocialMediaColumns[name]
+	return ok
+}
+
+//QueryTokenMetaSubmissionsRealize 查询通证资料提交记录
+func QueryTokenMetaSubmissionsRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.TokenMetaSubmissionsResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryTokenMetaSubmissionsRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryTokenMetaSubmissionsRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	submissionsResp := &entity.TokenMetaSubmissionsResp{}
+	submissions := make([]*entity.TokenMetaSubmission, 0)
+	for dataPtr.NextT() {
+		submission := &entity.TokenMetaSubmission{}
+		submission.ID = mysql.ConvertDBValueToInt64(dataPtr.GetField("id"))
+		submission.OwnerAddress = dataPtr.GetField("owner_address")
+		submission.Description = dataPtr.GetField("description")
+		submission.WebSite = dataPtr.GetField("website")
+		submission.WhitePaper = dataPtr.GetField("white_paper")
+		submission.GitHub = dataPtr.GetField("github")
+		submission.Country = dataPtr.GetField("country")
+		submission.SocialMedia = make([]*entity.SocialMediaInfo, 0)
+		if socialMedia := dataPtr.GetField("social_media"); socialMedia != "" {
+			if err := json.Unmarshal([]byte(socialMedia), &submission.SocialMedia); err != nil {
+				log.Errorf("parse token meta [%v] social media:[%v] err:[%v]", submission.ID, socialMedia, err)
+			}
+		}
+		submission.Status = mysql.ConvertDBValueToInt64(dataPtr.GetField("status"))
+		submission.Reason = dataPtr.GetField("reason")
+		submission.SubmitTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("submit_time"))
+		submission.ReviewTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("review_time"))
+		submissions = append(submissions, submission)
+	}
+
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	submissionsResp.Total = total
+	submissionsResp.Data = submissions
+	return submissionsResp, nil
+}
+
+//InsertTokenMetaSubmission 保存待审核的通证资料，返回提交编号
+func InsertTokenMetaSubmission(submission *entity.TokenMetaSubmission) (int64, error) {
+	socialMedia, _ := json.Marshal(submission.SocialMedia)
+	strSQL := fmt.Sprintf(`
+	insert into wlcy_token_meta_submission
+	(owner_address, description, website, white_paper, github, country, social_media, status, submit_time)
+	values('%v', '%v', '%v', '%v', '%v', '%v', '%v', %v, %v)`,
+		submission.OwnerAddress, mysql.EscapeString(submission.Description),
+		mysql.EscapeString(submission.WebSite), mysql.EscapeString(submission.WhitePaper),
+		mysql.EscapeString(submission.GitHub), mysql.EscapeString(submission.Country),
+		mysql.EscapeString(string(socialMedia)), entity.TokenMetaStatusPending, submission.SubmitTime)
+	log.Sql(strSQL)
+	insID, _, err := mysql.ExecuteSQLCommand(strSQL, true)
+	if err != nil {
+		log.Errorf("InsertTokenMetaSubmission fail:[%v]", err)
+		return 0, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return insID, nil
+}
+
+//RejectTokenMetaSubmission 拒绝待审核的通证资料，返回是否更新了记录
+func RejectTokenMetaSubmission(id int64, reason string, reviewTime int64) (bool, error) {
+	strSQL := fmt.Sprintf(`
+	update wlcy_token_meta_submission set status=%v, reason='%v', review_time=%v
+	where id=%v and status=%v`,
+		entity.TokenMetaStatusRejected, mysql.EscapeString(reason), reviewTime, id, entity.TokenMetaStatusPending)
+	log.Sql(strSQL)
+	_, rows, err := mysql.ExecuteSQLCommand(strSQL, false)
+	if err != nil {
+		log.Errorf("RejectTokenMetaSubmission id:[%v] fail:[%v]", id, err)
+		return false, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return rows > 0, nil
+}
+
+//ApproveTokenMetaSubmission 在一个事务中更新提交记录状态，并把资料写入 wlcy_asset_info
+func ApproveTokenMetaSubmission(submission *entity.TokenMetaSubmission, tokenName string, reviewTime int64) error {
+	exist, err := isAssetInfoExist(submission.OwnerAddress)
+	if err != nil {
+		return err
+	}
+	sqls := make([]string, 0, 2)
+	sqls = append(sqls, fmt.Sprintf(`
+	update wlcy_token_meta_submission set status=%v, review_time=%v where id=%v`,
+		entity.TokenMetaStatusApproved, reviewTime, submission.ID))
+	sqls = append(sqls, getAssetInfoSQL(submission, tokenName, exist))
+	err = mysql.ExecuteSQLCommands(sqls)
+	if err != nil {
+		log.Errorf("ApproveTokenMetaSubmission id:[%v] fail:[%v]", submission.ID, err)
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return nil
+}
+
+//SaveAssetInfo 管理员直接修改通证资料，不经过提交和审核
+func SaveAssetInfo(meta *entity.TokenMetaSubmission, tokenName string) error {
+	exist, err := isAssetInfoExist(meta.OwnerAddress)
+	if err != nil {
+		return err
+	}
+	strSQL := getAssetInfoSQL(meta, tokenName, exist)
+	log.Sql(strSQL)
+	if _, _, err := mysql.ExecuteSQLCommand(strSQL, false); err != nil {
+		log.Errorf("SaveAssetInfo owner:[%v] fail:[%v]", meta.OwnerAddress, err)
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return nil
+}
+
+//getAssetInfoSQL 把资料写入 wlcy_asset_info 的sql，exist为false时插入
+func getAssetInfoSQL(meta *entity.TokenMetaSubmission, tokenName string, exist bool) string {
+	columns := []string{"brief", "website", "white_paper", "github", "country"}
+	values := []string{meta.Description, meta.WebSite, meta.WhitePaper, meta.GitHub, meta.Country}
+	//没有提交的社交媒体清空
+	socialMedia := make(map[string]string, len(meta.SocialMedia))
+	for _, media := range meta.SocialMedia {
+		socialMedia[media.Name] = media.URL
+	}
+	for _, name := range []string{"Reddit", "Twitter", "Facebook", "Telegram", "Steem", "Medium", "Wechat", "Weibo"} {
+		columns = append(columns, tokenSocialMediaColumns[name])
+		values = append(values, socialMedia[name])
+	}
+	for index := range values {
+		values[index] = fmt.Sprintf("'%v'", mysql.EscapeString(values[index]))
+	}
+
+	if exist {
+		sets := make([]string, 0, len(columns))
+		for index, column := range columns {
+			sets = append(sets, fmt.Sprintf("%v=%v", column, values[index]))
+		}
+		return fmt.Sprintf(`
+	update wlcy_asset_info set %v where address='%v' and status=1`, strings.Join(sets, ", "), meta.OwnerAddress)
+	}
+	return fmt.Sprintf(`
+	insert into wlcy_asset_info (address, token_name, %v, status)
+	values('%v', '%v', %v, 1)`, strings.Join(columns, ", "),
+		meta.OwnerAddress, mysql.EscapeString(tokenName), strings.Join(values, ", "))
+}
+
+func isAssetInfoExist(address string) (bool, error) {
+	strSQL := fmt.Sprintf(`
+	select address from wlcy_asset_info where address='%v' and status=1 limit 1`, address)
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("isAssetInfoExist error :[%v]\n", err)
+		return false, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("isAssetInfoExist dataPtr is nil ")
+		return false, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return dataPtr.ResNum() > 0, nil
+}
+
+//QueryAssetIssueName 查询地址发行的通证名，没有发行通证时返回空
+func QueryAssetIssueName(ownerAddress string) (string, error) {
+	strSQL := fmt.Sprintf(`
+	select asset_name from asset_issue where owner_address='%v' limit 1`, ownerAddress)
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryAssetIssueName error :[%v]\n", err)
+		return "", util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryAssetIssueName dataPtr is nil ")
+		return "", util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	var assetName string
+	for dataPtr.NextT() {
+		assetName = dataPtr.GetField("asset_name")
+	}
+	return assetName, nil
+}
@@ -3,6 +3,7 @@ package module
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 	"sync/atomic"
 
 	"github.com/wlcy/tron/explorer/core/utils"
@@ -455,3 +456,19 @@ func QueryAssetCreateTime(ownerAddress, tokenName string) (int64, error) {
 	}
 	return assetCreateTime, nil
 }
+
+//QueryTokensByName 按通证名称批量查询，名称来自请求参数，逐个转义后拼接
+func QueryTokensByName(nameList []string) (*entity.TokenResp, error) {
+	names := make([]string, 0, len(nameList))
+	for _, name := range nameList {
+		names = append(names, mysql.EscapeString(name))
+	}
+	strSQL := fmt.Sprintf(`
+			select owner_address, asset_name, asset_abbr, total_supply, frozen_supply,
+			trx_num, num, participated, start_time, end_time, order_num, vote_score, asset_desc, url
+			from asset_issue
+			where 1=1 `)
+	filterSQL := fmt.Sprintf(" and binary asset_name in ('%v')", strings.Join(names, "', '"))
+	pageSQL := fmt.Sprintf("limit 0, %v", len(names))
+	return QueryTokensRealize(strSQL, filterSQL, "", pageSQL)
+}
@@ -26,28 +26,7 @@ func QueryTransactionsRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*enti
 
 	//填充数据
 	for dataPtr.NextT() {
-		var transaction = &entity.TransactionInfo{}
-		transaction.Block = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
-		transaction.Hash = dataPtr.GetField("trx_hash")
-		transaction.ToAddress = dataPtr.GetField("to_address")
-		transaction.OwnerAddress = dataPtr.GetField("owner_address")
-		createTime := dataPtr.GetField("create_time")
-		if len(createTime) > 13 {
-			createTime = createTime[:13]
-		}
-		transaction.CreateTime = mysql.ConvertDBValueToInt64(createTime)
-		transaction.ContractType = mysql.ConvertDBValueToInt64(dataPtr.GetField("contract_type"))
-
-		if dataPtr.GetField("contract_data") != "" {
-			transaction.ContractDataRaw = dataPtr.GetField("contract_data")
-			_, transaction.ContractData = utils.GetContractInfoStr3(int32(transaction.ContractType), utils.HexDecode(dataPtr.GetField("contract_data")))
-		}
-		confirmed := dataPtr.GetField("confirmed")
-		if confirmed == "1" {
-			transaction.Confirmed = true
-		}
-
-		transactionInfos = append(transactionInfos, transaction)
+		transactionInfos = append(transactionInfos, newTransactionInfo(dataPtr))
 	}
 
 	//查询该语句所查到的数据集合
@@ -100,3 +79,28 @@ func QueryTransactionRealize(strSQL, filterSQL string) (*entity.TransactionInfo,
 	return transaction, nil
 
 }
+
+//newTransactionInfo 读取当前行的交易记录
+func newTransactionInfo(dataPtr *mysql.TronDBRows) *entity.TransactionInfo {
+	var transaction = &entity.TransactionInfo{}
+	transaction.Block = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
+	transaction.Hash = dataPtr.GetField("trx_hash")
+	transaction.ToAddress = dataPtr.GetField("to_address")
+	transaction.OwnerAddress = dataPtr.GetField("owner_address")
+	createTime := dataPtr.GetField("create_time")
+	if len(createTime) > 13 {
+		createTime = createTime[:13]
+	}
+	transaction.CreateTime = mysql.ConvertDBValueToInt64(createTime)
+	transaction.ContractType = mysql.ConvertDBValueToInt64(dataPtr.GetField("contract_type"))
+
+	if dataPtr.GetField("contract_data") != "" {
+		transaction.ContractDataRaw = dataPtr.GetField("contract_data")
+		_, transaction.ContractData = utils.GetContractInfoStr3(int32(transaction.ContractType), utils.HexDecode(dataPtr.GetField("contract_data")))
+	}
+	confirmed := dataPtr.GetField("confirmed")
+	if confirmed == "1" {
+		transaction.Confirmed = true
+	}
+	return transaction
+}
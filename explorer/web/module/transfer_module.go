@@ -25,29 +25,7 @@ func QueryTransfersRealize(strSQL, filterSQL, sortSQL, pageSQL, filterTempSQL st
 
 	//填充数据
 	for dataPtr.NextT() {
-		var transfer = &entity.TransferInfo{}
-		transfer.Block = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
-		transfer.TransactionHash = dataPtr.GetField("trx_hash")
-		transfer.TransferFromAddress = dataPtr.GetField("owner_address")
-		createTime := dataPtr.GetField("create_time")
-		if len(createTime) > 13 {
-			createTime = createTime[:13]
-		}
-		transfer.CreateTime = mysql.ConvertDBValueToInt64(createTime)
-		transfer.TransferToAddress = dataPtr.GetField("to_address")
-		transfer.TokenName = dataPtr.GetField("asset_name")
-		transfer.Amount = mysql.ConvertDBValueToInt64(dataPtr.GetField("amount"))
-		if transfer.TokenName == "" {
-			transfer.TokenName = "TRX"
-			//如果是TRX，页面做的单位转换
-			//transfer.Amount = transfer.Amount / 1000000
-		}
-		confirmed := dataPtr.GetField("confirmed")
-		if confirmed == "1" {
-			transfer.Confirmed = true
-		}
-
-		transferInfos = append(transferInfos, transfer)
+		transferInfos = append(transferInfos, newTransferInfo(dataPtr))
 	}
 
 	//查询该语句所查到的数据集合
@@ -99,3 +77,29 @@ func QueryTransferRealize(strSQL, filterSQL string) (*entity.TransferInfo, error
 	return transfer, nil
 
 }
+
+//newTransferInfo 读取当前行的转账记录
+func newTransferInfo(dataPtr *mysql.TronDBRows) *entity.TransferInfo {
+	var transfer = &entity.TransferInfo{}
+	transfer.Block = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
+	transfer.TransactionHash = dataPtr.GetField("trx_hash")
+	transfer.TransferFromAddress = dataPtr.GetField("owner_address")
+	createTime := dataPtr.GetField("create_time")
+	if len(createTime) > 13 {
+		createTime = createTime[:13]
+	}
+	transfer.CreateTime = mysql.ConvertDBValueToInt64(createTime)
+	transfer.TransferToAddress = dataPtr.GetField("to_address")
+	transfer.TokenName = dataPtr.GetField("asset_name")
+	transfer.Amount = mysql.ConvertDBValueToInt64(dataPtr.GetField("amount"))
+	if transfer.TokenName == "" {
+		transfer.TokenName = "TRX"
+		//如果是TRX，页面做的单位转换
+		//transfer.Amount = transfer.Amount / 1000000
+	}
+	confirmed := dataPtr.GetField("confirmed")
+	if confirmed == "1" {
+		transfer.Confirmed = true
+	}
+	return transfer
+}
@@ -0,0 +1,153 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryVoteCyclesRealize 查询投票轮次
+func QueryVoteCyclesRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.VoteCyclesResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryVoteCyclesRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryVoteCyclesRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	cyclesResp := &entity.VoteCyclesResp{}
+	cycles := make([]*entity.VoteCycle, 0)
+	for dataPtr.NextT() {
+		cycle := &entity.VoteCycle{}
+		cycle.CycleStart = mysql.ConvertDBValueToInt64(dataPtr.GetField("cycle_start"))
+		cycle.CycleEnd = mysql.ConvertDBValueToInt64(dataPtr.GetField("cycle_end"))
+		cycle.TotalVotes = mysql.ConvertDBValueToInt64(dataPtr.GetField("total_votes"))
+		cycle.WitnessCount = mysql.ConvertDBValueToInt64(dataPtr.GetField("witness_count"))
+		cycle.VoterCount = mysql.ConvertDBValueToInt64(dataPtr.GetField("voter_count"))
+		cycle.SnapshotTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("snapshot_time"))
+		cycle.Finished = dataPtr.GetField("finished") == "1"
+		cycles = append(cycles, cycle)
+	}
+
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	cyclesResp.Total = total
+	cyclesResp.Data = cycles
+	return cyclesResp, nil
+}
+
+//QueryVoteCycleWitnessRealize 查询候选人的历史轮次
+func QueryVoteCycleWitnessRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.VoteCycleWitnessResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryVoteCycleWitnessRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryVoteCycleWitnessRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	witnessResp := &entity.VoteCycleWitnessResp{}
+	witnesses := make([]*entity.VoteCycleWitness, 0)
+	for dataPtr.NextT() {
+		witness := &entity.VoteCycleWitness{}
+		witness.CycleStart = mysql.ConvertDBValueToInt64(dataPtr.GetField("cycle_start"))
+		witness.CycleEnd = mysql.ConvertDBValueToInt64(dataPtr.GetField("cycle_end"))
+		witness.Address = dataPtr.GetField("address")
+		witness.Votes = mysql.ConvertDBValueToInt64(dataPtr.GetField("votes"))
+		witness.Ranking = int32(mysql.ConvertDBValueToInt64(dataPtr.GetField("ranking")))
+		witness.VoterCount = mysql.ConvertDBValueToInt64(dataPtr.GetField("voter_count"))
+		witness.ProducedBlocks = mysql.ConvertDBValueToInt64(dataPtr.GetField("produced_blocks"))
+		witness.MissedBlocks = mysql.ConvertDBValueToInt64(dataPtr.GetField("missed_blocks"))
+		witnesses = append(witnesses, witness)
+	}
+
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	witnessResp.Total = total
+	witnessResp.Data = witnesses
+	return witnessResp, nil
+}
+
+//QueryCountByAddress 查询按地址分组的计数，结果的列名为 address 和 num
+func QueryCountByAddress(strSQL string) (map[string]int64, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryCountByAddress error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryCountByAddress dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	countMap := make(map[string]int64)
+	for dataPtr.NextT() {
+		countMap[dataPtr.GetField("address")] = mysql.ConvertDBValueToInt64(dataPtr.GetField("num"))
+	}
+	return countMap, nil
+}
+
+//InsertVoteCycle 在一个事务中写入轮次和各候选人的排名
+func InsertVoteCycle(cycle *entity.VoteCycle) error {
+	sqls := make([]string, 0, 2)
+	sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_vote_cycle (cycle_start, cycle_end, total_votes, witness_count, voter_count, snapshot_time, finished)
+	values(%v, %v, %v, %v, %v, %v, 0)`,
+		cycle.CycleStart, cycle.CycleEnd, cycle.TotalVotes, cycle.WitnessCount, cycle.VoterCount, cycle.SnapshotTime))
+	if len(cycle.Witnesses) > 0 {
+		values := make([]string, 0, len(cycle.Witnesses))
+		for _, witness := range cycle.Witnesses {
+			values = append(values, fmt.Sprintf("(%v, '%v', %v, %v, %v)", cycle.CycleStart, witness.Address,
+				witness.Votes, witness.Ranking, witness.VoterCount))
+		}
+		sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_vote_cycle_witness (cycle_start, address, votes, ranking, voter_count)
+	values %v`, strings.Join(values, ",")))
+	}
+	for _, strSQL := range sqls {
+		log.Sql(strSQL)
+	}
+	err := mysql.ExecuteSQLCommands(sqls)
+	if err != nil {
+		log.Errorf("InsertVoteCycle cycle:[%v] fail:[%v]", cycle.CycleStart, err)
+	}
+	return err
+}
+
+//FinishVoteCycle 在一个事务中写入轮次的结束时间和各候选人的出块、丢块数，不在排名中的候选人新增记录
+func FinishVoteCycle(cycle *entity.VoteCycle) error {
+	sqls := make([]string, 0, 2)
+	sqls = append(sqls, fmt.Sprintf(`
+	update wlcy_vote_cycle set cycle_end=%v, finished=1 where cycle_start=%v`, cycle.CycleEnd, cycle.CycleStart))
+	if len(cycle.Witnesses) > 0 {
+		values := make([]string, 0, len(cycle.Witnesses))
+		for _, witness := range cycle.Witnesses {
+			values = append(values, fmt.Sprintf("(%v, '%v', %v, %v)", cycle.CycleStart, witness.Address,
+				witness.ProducedBlocks, witness.MissedBlocks))
+		}
+		sqls = append(sqls, fmt.Sprintf(`
+	insert into wlcy_vote_cycle_witness (cycle_start, address, produced_blocks, missed_blocks)
+	values %v
+	on duplicate key update produced_blocks=values(produced_blocks), missed_blocks=values(missed_blocks)`, strings.Join(values, ",")))
+	}
+	err := mysql.ExecuteSQLCommands(sqls)
+	if err != nil {
+		log.Errorf("FinishVoteCycle cycle:[%v] fail:[%v]", cycle.CycleStart, err)
+	}
+	return err
+}
@@ -0,0 +1,88 @@
+package module
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryBlockSlotsRealize 查询区块的出块时间和出块人
+func QueryBlockSlotsRealize(strSQL string) ([]*entity.BlockSlot, error) {
+	log.Sql(strSQL)
+	dataPtr, err := mysql.QueryTableData(strSQL)
+	if err != nil {
+		log.Errorf("QueryBlockSlotsRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryBlockSlotsRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	blockSlots := make([]*entity.BlockSlot, 0)
+	for dataPtr.NextT() {
+		blockSlot := &entity.BlockSlot{}
+		blockSlot.BlockID = mysql.ConvertDBValueToInt64(dataPtr.GetField("block_id"))
+		blockSlot.CreateTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("create_time"))
+		blockSlot.WitnessAddress = dataPtr.GetField("witness_address")
+		blockSlots = append(blockSlots, blockSlot)
+	}
+	return blockSlots, nil
+}
+
+//QueryWitnessMissedRealize 查询丢块记录
+func QueryWitnessMissedRealize(strSQL, filterSQL, sortSQL, pageSQL string) (*entity.WitnessMissedResp, error) {
+	strFullSQL := strSQL + " " + filterSQL + " " + sortSQL + " " + pageSQL
+	log.Sql(strFullSQL)
+	dataPtr, err := mysql.QueryTableData(strFullSQL)
+	if err != nil {
+		log.Errorf("QueryWitnessMissedRealize error :[%v]\n", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if dataPtr == nil {
+		log.Errorf("QueryWitnessMissedRealize dataPtr is nil ")
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	missedResp := &entity.WitnessMissedResp{}
+	missedSlots := make([]*entity.WitnessMissedSlot, 0)
+	for dataPtr.NextT() {
+		missedSlot := &entity.WitnessMissedSlot{}
+		missedSlot.SlotTime = mysql.ConvertDBValueToInt64(dataPtr.GetField("slot_time"))
+		missedSlot.Address = dataPtr.GetField("address")
+		missedSlot.CycleStart = mysql.ConvertDBValueToInt64(dataPtr.GetField("cycle_start"))
+		missedSlot.PrevBlockID = mysql.ConvertDBValueToInt64(dataPtr.GetField("prev_block_id"))
+		missedSlots = append(missedSlots, missedSlot)
+	}
+
+	total, err := mysql.QuerySQLViewCount(strSQL + " " + filterSQL)
+	if err != nil {
+		log.Errorf("query view count error:[%v], SQL:[%v]", err, strSQL)
+	}
+	missedResp.Total = total
+	missedResp.Data = missedSlots
+	return missedResp, nil
+}
+
+//InsertWitnessMissedSlots 批量写入丢块记录，重复的记录忽略
+func InsertWitnessMissedSlots(missedSlots []*entity.WitnessMissedSlot) error {
+	if len(missedSlots) == 0 {
+		return nil
+	}
+	values := make([]string, 0, len(missedSlots))
+	for _, missedSlot := range missedSlots {
+		values = append(values, fmt.Sprintf("(%v, '%v', %v, %v)", missedSlot.SlotTime, missedSlot.Address,
+			missedSlot.CycleStart, missedSlot.PrevBlockID))
+	}
+	strSQL := fmt.Sprintf(`
+	insert ignore into wlcy_witness_missed_slot (slot_time, address, cycle_start, prev_block_id)
+	values %v`, strings.Join(values, ","))
+	log.Sql(strSQL)
+	_, _, err := mysql.ExecuteSQLCommand(strSQL, false)
+	if err != nil {
+		log.Errorf("InsertWitnessMissedSlots fail:[%v]", err)
+	}
+	return err
+}
@@ -1,11 +1,10 @@
 package router
 
 import (
-	"net/http"
-
 	"github.com/wlcy/tron/explorer/lib/mysql"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/util"
 	"github.com/wlcy/tron/explorer/web/entity"
@@ -15,7 +14,7 @@ import (
 func accountRegister(ginRouter *gin.Engine) {
 
 	//?sort=-balance&limit=1&count=true
-	ginRouter.GET("/api/account", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/account", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Accounts{}
 		req.Sort = c.Query("sort")
 		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
@@ -24,92 +23,64 @@ func accountRegister(ginRouter *gin.Engine) {
 		req.Address = c.Query("address")
 		log.Debugf("Hello /api/account?%#v", req)
 		resp, err := service.QueryAccounts(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+		if resp != nil {
+			service.LabelAccounts(resp.Data, getRequestAPIKey(c))
 		}
-		c.JSON(http.StatusOK, resp)
+		return resp, err
 	})
-	//:number=2135998
-	ginRouter.GET("/api/account/:address", func(c *gin.Context) {
+	//:number=2135998?time=1539100800000
+	apiRoute(ginRouter, "GET", "/account/:address", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Accounts{}
 		req.Address = c.Param("address") //占位符传参
+		req.Time = mysql.ConvertStringToInt64(c.Query("time"), 0)
 		log.Debugf("Hello /api/account/:%#v", req.Address)
-		resp, err := service.QueryAccount(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		resp, err := service.QueryAccount(c.Request.Context(), req)
+		service.LabelAccount(c.Request.Context(), resp, getRequestAPIKey(c))
+		return resp, err
 	})
 
 	//查询某地址的媒体信息
-	ginRouter.GET("/api/account/:address/media", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/account/:address/media", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Accounts{}
 		req.Address = c.Param("address") //占位符传参
 		log.Debugf("Hello /api/account/:%#v//media", req.Address)
 		if req.Address == "" {
-			errCode, _ := util.GetErrorCode(util.NewErrorMsg(util.Error_common_not_suport_parameter))
-			c.JSON(errCode, util.NewErrorMsg(util.Error_common_not_suport_parameter))
-		}
-		resp, err := service.QueryAccountMedia(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+			return nil, util.NewErrorMsg(util.Error_common_not_suport_parameter)
 		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryAccountMedia(req)
 	})
 
 	//修改超级代表github信息
-	ginRouter.POST("/api/account/:address/sr", func(c *gin.Context) {
+	apiRoute(ginRouter, "POST", "/account/:address/sr", func(c *gin.Context) (interface{}, error) {
 		//获取header
-		token := c.Request.Header.Get("X-Key")
+		token := getAuthToken(c)
 		req := &entity.SuperAccountInfo{}
-		if c.BindJSON(req) == nil {
-			if req == nil {
-				log.Errorf("parsing request parameter err!")
-				c.JSON(http.StatusInternalServerError, http.ErrBodyNotAllowed)
-			}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
 		}
 		log.Debugf("Hello /api/account/:%#v//sr, header token:[%v]", req, token)
-		resp, err := service.UpdateAccountSr(req, token)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.UpdateAccountSr(req, token)
 	})
 
 	//查询超级代表github信息
-	ginRouter.GET("/api/account/:address/sr", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/account/:address/sr", func(c *gin.Context) (interface{}, error) {
 		req := &entity.SuperAccountInfo{}
 		req.Address = c.Param("address") //占位符传参
 		log.Debugf("Hello /api/account/:%#v//sr", req.Address)
 		if req.Address == "" {
-			errCode, _ := util.GetErrorCode(util.NewErrorMsg(util.Error_common_not_suport_parameter))
-			c.JSON(errCode, util.NewErrorMsg(util.Error_common_not_suport_parameter))
-		}
-		resp, err := service.QueryAccountSr(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+			return nil, util.NewErrorMsg(util.Error_common_not_suport_parameter)
 		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryAccountSr(req)
 	})
 	//查询用户的交易统计信息
-	ginRouter.GET("/api/account/:address/stats", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/account/:address/stats", func(c *gin.Context) (interface{}, error) {
 		address := c.Param("address") //占位符传参
 		log.Debugf("Hello /api/account/:%#v//stats", address)
 		if address == "" {
-			errCode, _ := util.GetErrorCode(util.NewErrorMsg(util.Error_common_not_suport_parameter))
-			c.JSON(errCode, util.NewErrorMsg(util.Error_common_not_suport_parameter))
-		}
-		resp, err := service.QueryAccountStats(address)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+			return nil, util.NewErrorMsg(util.Error_common_not_suport_parameter)
 		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryAccountStats(address)
 	})
 
 }
@@ -0,0 +1,138 @@
+package router
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+//StartAdmin 注册管理接口的http服务，和对外服务分开监听，只应对内网开放，address为空时不启动
+//	所有接口都需要 X-Admin-Key 请求头，与 ratelimit.adminKey 一致
+func StartAdmin(address string) {
+	if address == "" {
+		log.Infof("admin service disabled")
+		return
+	}
+	appendServer("admin", address, newAdminRouter())
+}
+
+//newAdminRouter 注册管理接口路由，不经过限流和跨域处理
+func newAdminRouter() *gin.Engine {
+	ginRouter := gin.New()
+	ginRouter.Use(gin.Recovery(), requestIDMiddleware())
+	adminGroup := ginRouter.Group("/api/admin", adminAuthMiddleware())
+	// 注册缓存、日志级别、配置、节点和同步进度管理路由
+	adminRegister(adminGroup)
+	// 注册定时任务管理路由
+	taskRegister(adminGroup)
+	// 注册api key、公开标签、通证资料审核和吊销token管理路由
+	apikeyRegister(adminGroup)
+	labelAdminRegister(adminGroup)
+	tokenMetaAdminRegister(adminGroup)
+	authAdminRegister(adminGroup)
+	return ginRouter
+}
+
+//adminRoute 成功时直接返回结果，出错时返回 /v2 格式的错误，NewError 自定义的错误信息放在details中
+func adminRoute(group *gin.RouterGroup, method, path string, handler apiHandler) {
+	group.Handle(method, path, func(c *gin.Context) {
+		resp, err := handler(c)
+		if err != nil {
+			writeV2Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+}
+
+func adminRegister(adminGroup *gin.RouterGroup) {
+
+	adminRoute(adminGroup, "GET", "/buffer", func(c *gin.Context) (interface{}, error) {
+		names := buffer.BufferNames()
+		return &entity.BufferNamesResp{Total: int64(len(names)), Data: names}, nil
+	})
+
+	//立即从数据源重新加载，失败时保留原来的数据
+	adminRoute(adminGroup, "POST", "/buffer/:name/reload", func(c *gin.Context) (interface{}, error) {
+		name := c.Param("name")
+		log.Infof("Hello /api/admin/buffer/:%v/reload", name)
+		if !buffer.ReloadBuffer(name) {
+			return nil, util.NewErrorMsg(util.Error_common_no_data)
+		}
+		return gin.H{"name": name, "status": "reloaded"}, nil
+	})
+
+	//丢弃缓存的数据后重新加载
+	adminRoute(adminGroup, "POST", "/buffer/:name/flush", func(c *gin.Context) (interface{}, error) {
+		name := c.Param("name")
+		log.Infof("Hello /api/admin/buffer/:%v/flush", name)
+		if !buffer.FlushBuffer(name) {
+			return nil, util.NewErrorMsg(util.Error_common_no_data)
+		}
+		return gin.H{"name": name, "status": "flushed"}, nil
+	})
+
+	adminRoute(adminGroup, "GET", "/log/level", func(c *gin.Context) (interface{}, error) {
+		return service.QueryLogLevel(), nil
+	})
+
+	//{"level":"debug","packages":{"web/service":"sql"}}
+	adminRoute(adminGroup, "PUT", "/log/level", func(c *gin.Context) (interface{}, error) {
+		req := &entity.LogLevelInfo{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Infof("Hello /api/admin/log/level %#v", req)
+		return service.ChangeLogLevel(req)
+	})
+
+	//重新加载启动时的配置文件，只有可以热加载的分组生效，和收到SIGHUP相同
+	adminRoute(adminGroup, "POST", "/config/reload", func(c *gin.Context) (interface{}, error) {
+		log.Infof("Hello /api/admin/config/reload")
+		if err := config.ReloadLoaded(); err != nil {
+			return nil, util.NewError(util.Error_common_parameter_invalid, err.Error())
+		}
+		return gin.H{"status": "reloaded"}, nil
+	})
+
+	//逐个连接节点获取最新块，节点多时耗时较长
+	adminRoute(adminGroup, "GET", "/node", func(c *gin.Context) (interface{}, error) {
+		return service.QueryNodeStatus(), nil
+	})
+
+	adminRoute(adminGroup, "GET", "/ingestion", func(c *gin.Context) (interface{}, error) {
+		return service.QueryIngestion(), nil
+	})
+
+	//{"url":"https://www.example.com","githubLink":"https://github.com/example"}
+	adminRoute(adminGroup, "PUT", "/witness/:address", func(c *gin.Context) (interface{}, error) {
+		req := &entity.WitnessMeta{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		req.Address = c.Param("address")
+		log.Infof("Hello /api/admin/witness/:%v %#v", req.Address, req)
+		return service.UpdateWitnessMeta(req)
+	})
+
+	//请求体与提交通证资料相同，不需要transaction
+	adminRoute(adminGroup, "PUT", "/tokenmeta/:address", func(c *gin.Context) (interface{}, error) {
+		req := &entity.TokenMetaSubmission{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		req.OwnerAddress = c.Param("address")
+		log.Infof("Hello /api/admin/tokenmeta/:%v", req.OwnerAddress)
+		return service.UpdateTokenMeta(req)
+	})
+}
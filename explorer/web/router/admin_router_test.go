@@ -0,0 +1,93 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestAdminRouter(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	conf := config.NewConfig()
+	conf.RateLimit.AdminKey = "secret"
+	config.SetCurrent(conf)
+	defer config.SetCurrent(config.NewConfig())
+	defer log.ChangeLogLevel(log.CurrentLevel())
+	defer log.ResetPackageLevels()
+	ginRouter := newAdminRouter()
+
+	request := func(method, path, key, body string) *httptest.ResponseRecorder {
+		req, _ := http.NewRequest(method, path, strings.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+		if key != "" {
+			req.Header.Set("X-Admin-Key", key)
+		}
+		w := httptest.NewRecorder()
+		ginRouter.ServeHTTP(w, req)
+		return w
+	}
+
+	for _, key := range []string{"", "wrong"} {
+		if w := request("GET", "/api/admin/log/level", key, ""); w.Code != http.StatusUnauthorized {
+			t.Errorf("key [%v] status:%v", key, w.Code)
+		}
+	}
+
+	w := request("PUT", "/api/admin/log/level", "secret", `{"level":"warn","packages":{"web/service":"sql"}}`)
+	info := &entity.LogLevelInfo{}
+	if err := json.Unmarshal(w.Body.Bytes(), info); err != nil || w.Code != http.StatusOK ||
+		info.Level != "warn" || info.Packages["web/service"] != "sql" || log.CurrentLevel() != log.WARN {
+		t.Errorf("change log level:%v %v", w.Code, w.Body.String())
+	}
+	//不传packages时保留按包覆盖的级别
+	w = request("PUT", "/api/admin/log/level", "secret", `{"level":"info"}`)
+	if !strings.Contains(w.Body.String(), `"web/service":"sql"`) || log.CurrentLevel() != log.INFO {
+		t.Errorf("change global level only:%v", w.Body.String())
+	}
+	w = request("PUT", "/api/admin/log/level", "secret", `{"packages":{}}`)
+	if len(log.PackageLevels()) != 0 {
+		t.Errorf("clear package levels:%v", w.Body.String())
+	}
+	for _, body := range []string{`{"level":"verbose"}`, `{"packages":{"web/service":"verbose"}}`, `{`} {
+		if w := request("PUT", "/api/admin/log/level", "secret", body); w.Code != http.StatusBadRequest ||
+			!strings.Contains(w.Body.String(), `"error":{"code":`) {
+			t.Errorf("invalid level [%v]:%v %v", body, w.Code, w.Body.String())
+		}
+	}
+
+	if w := request("POST", "/api/admin/buffer/unknown/reload", "secret", ""); w.Code != http.StatusNotFound {
+		t.Errorf("reload unknown buffer:%v", w.Code)
+	}
+	if w := request("GET", "/api/admin/buffer", "secret", ""); !strings.Contains(w.Body.String(), `"witness"`) {
+		t.Errorf("buffer names:%v", w.Body.String())
+	}
+}
+
+//TestAdminRoutesNotPublic 管理接口只在单独的监听地址上
+func TestAdminRoutesNotPublic(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	for _, route := range newRouter().Routes() {
+		if strings.HasPrefix(route.Path, "/api/admin/") || strings.HasPrefix(route.Path, "/api/sync/") {
+			t.Errorf("route [%v %v] should only be on admin router", route.Method, route.Path)
+		}
+	}
+	admin := make(map[string]bool)
+	for _, route := range newAdminRouter().Routes() {
+		if !strings.HasPrefix(route.Path, "/api/admin/") {
+			t.Errorf("admin route [%v %v] should be under /api/admin", route.Method, route.Path)
+		}
+		admin[route.Method+" "+route.Path] = true
+	}
+	for _, key := range []string{"GET /api/admin/apikey", "POST /api/admin/label", "POST /api/admin/tokenmeta/:id/approve", "POST /api/admin/auth/revoke"} {
+		if !admin[key] {
+			t.Errorf("route [%v] should be on admin router", key)
+		}
+	}
+}
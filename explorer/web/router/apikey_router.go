@@ -0,0 +1,104 @@
+package router
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+//getRequestAPIKey 优先读取 X-Api-Key 请求头，其次读取 apikey 参数
+func getRequestAPIKey(c *gin.Context) string {
+	if apiKey := c.GetHeader("X-Api-Key"); apiKey != "" {
+		return apiKey
+	}
+	return c.Query("apikey")
+}
+
+//rateLimitMiddleware 按api key或ip限流，并在响应头中返回剩余额度
+func rateLimitMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !config.Get().RateLimit.Enable || c.Request.Method == "OPTIONS" {
+			c.Next()
+			return
+		}
+		ret, err := service.CheckRateLimit(getRequestAPIKey(c), getClientIP(c))
+		if err != nil {
+			abortWithError(c, http.StatusUnauthorized, err)
+			return
+		}
+		c.Header("X-RateLimit-Limit", fmt.Sprintf("%v", ret.Limit))
+		c.Header("X-RateLimit-Remaining", fmt.Sprintf("%v", ret.Remaining))
+		c.Header("X-RateLimit-Reset", fmt.Sprintf("%v", ret.ResetSeconds))
+		if ret.QuotaLimit > 0 {
+			c.Header("X-Quota-Limit", fmt.Sprintf("%v", ret.QuotaLimit))
+			c.Header("X-Quota-Remaining", fmt.Sprintf("%v", ret.QuotaRemaining))
+		}
+		if !ret.Allowed {
+			c.Header("Retry-After", fmt.Sprintf("%v", ret.ResetSeconds))
+			errCode := util.Error_common_request_rate_limited
+			if ret.QuotaExceeded {
+				errCode = util.Error_common_request_quota_exceeded
+			}
+			abortWithError(c, http.StatusTooManyRequests, util.NewErrorMsg(errCode))
+			return
+		}
+		c.Next()
+	}
+}
+
+//adminAuthMiddleware 校验 X-Admin-Key，未配置adminKey时管理接口不可用
+func adminAuthMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		adminKey := config.Get().RateLimit.AdminKey
+		if adminKey == "" || subtle.ConstantTimeCompare([]byte(c.GetHeader("X-Admin-Key")), []byte(adminKey)) != 1 {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, util.NewErrorMsg(util.Error_user_token_invalid))
+			return
+		}
+		c.Next()
+	}
+}
+
+//apikeyRegister 注册api key管理路由，在管理接口上监听
+func apikeyRegister(adminGroup *gin.RouterGroup) {
+
+	adminRoute(adminGroup, "GET", "/apikey", func(c *gin.Context) (interface{}, error) {
+		log.Debugf("Hello /api/admin/apikey")
+		return service.QueryAPIKeys()
+	})
+
+	//{"name":"partner","contact":"ops@partner.com","rate":20,"burst":100,"dailyQuota":1000000}
+	adminRoute(adminGroup, "POST", "/apikey", func(c *gin.Context) (interface{}, error) {
+		req := &entity.APIKeyInfo{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Debugf("Hello /api/admin/apikey %#v", req)
+		return service.CreateAPIKey(req)
+	})
+
+	adminRoute(adminGroup, "DELETE", "/apikey/:key", func(c *gin.Context) (interface{}, error) {
+		apiKey := c.Param("key")
+		log.Debugf("Hello /api/admin/apikey/:%v", apiKey)
+		if err := service.DisableAPIKey(apiKey); err != nil {
+			return nil, err
+		}
+		return gin.H{"apiKey": apiKey, "status": 0}, nil
+	})
+
+	//?from=20181001&to=20181031
+	adminRoute(adminGroup, "GET", "/apikey/:key/usage", func(c *gin.Context) (interface{}, error) {
+		apiKey := c.Param("key")
+		log.Debugf("Hello /api/admin/apikey/:%v/usage?%v", apiKey, c.Request.URL.RawQuery)
+		return service.QueryAPIKeyUsage(apiKey, c.Query("from"), c.Query("to"))
+	})
+
+}
@@ -0,0 +1,82 @@
+package router
+
+import (
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+func authRegister(ginRouter *gin.Engine) {
+
+	//?address=TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3
+	apiRoute(ginRouter, "GET", "/auth/challenge", func(c *gin.Context) (interface{}, error) {
+		address := c.Query("address")
+		log.Debugf("Hello /api/auth/challenge?address=%v", address)
+		return service.QueryAuthChallenge(address, c.Request.Host)
+	})
+
+	//签名登录
+	apiRoute(ginRouter, "POST", "/auth/login", func(c *gin.Context) (interface{}, error) {
+		req := &entity.AuthLogin{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Debugf("Hello /api/auth/login address:[%v]", req.Address)
+		return service.AuthLogin(req, c.Request.Host)
+	})
+
+	//刷新token
+	apiRoute(ginRouter, "POST", "/auth/refresh", func(c *gin.Context) (interface{}, error) {
+		req := &entity.AuthRefresh{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Debugf("Hello /api/auth/refresh")
+		return service.RefreshAuthToken(req.RefreshToken)
+	})
+
+	//退出登录，body中的refreshToken可选
+	apiRoute(ginRouter, "POST", "/auth/logout", func(c *gin.Context) (interface{}, error) {
+		req := &entity.AuthRefresh{}
+		if c.Request.ContentLength > 0 {
+			if err := binding.JSON.Bind(c.Request, req); err != nil {
+				log.Errorf("parsing request parameter err:[%v]", err)
+				return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+			}
+		}
+		log.Debugf("Hello /api/auth/logout")
+		return service.RevokeAuthToken(getAuthToken(c), req.RefreshToken)
+	})
+
+}
+
+//authAdminRegister 注册吊销token的管理路由，在管理接口上监听
+func authAdminRegister(adminGroup *gin.RouterGroup) {
+
+	//{"address":"TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3"}
+	adminRoute(adminGroup, "POST", "/auth/revoke", func(c *gin.Context) (interface{}, error) {
+		req := &entity.AuthRevoke{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Debugf("Hello /api/admin/auth/revoke %#v", req)
+		return service.RevokeAddressTokens(req.Address)
+	})
+
+}
+
+//getAuthToken 读取请求头中的token，支持 Authorization: Bearer token 和 X-Key: token
+func getAuthToken(c *gin.Context) string {
+	if authorization := c.GetHeader("Authorization"); strings.HasPrefix(authorization, "Bearer ") {
+		return strings.TrimSpace(strings.TrimPrefix(authorization, "Bearer "))
+	}
+	return c.GetHeader("X-Key")
+}
@@ -1,12 +1,9 @@
 package router
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/mysql"
-	"github.com/wlcy/tron/explorer/lib/util"
 	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/service"
 )
@@ -14,7 +11,7 @@ import (
 func blockRegister(ginRouter *gin.Engine) {
 
 	//?sort=-number&limit=1&count=true&number=2135998
-	ginRouter.GET("/api/block", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/block", func(c *gin.Context) (interface{}, error) {
 		blockReq := &entity.Blocks{}
 		blockReq.Sort = c.Query("sort")
 		blockReq.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
@@ -27,25 +24,15 @@ func blockRegister(ginRouter *gin.Engine) {
 		//log.Debugf("c.params111:[%v]", c.Query("producer1"))
 		//log.Debugf("Hello /api/block?%#v", blockReq)
 		//blockResp, err := service.QueryBlocks(blockReq)
-		blockResp, err := service.QueryBlocksBuffer(blockReq)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, blockResp)
+		return service.QueryBlocksBuffer(c.Request.Context(), blockReq)
 	})
 	//:number=2135998
-	ginRouter.GET("/api/block/:number", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/block/:number", func(c *gin.Context) (interface{}, error) {
 		blockReq := &entity.Blocks{}
 		blockReq.Number = c.Param("number") //占位符传参
 		log.Debugf("Hello /api/block/:%#v", blockReq.Number)
 		//blockResp, err := service.QueryBlock(blockReq)
-		blockResp, err := service.QueryBlockBuffer(blockReq)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, blockResp)
+		return service.QueryBlockBuffer(c.Request.Context(), blockReq)
 	})
 
 }
@@ -0,0 +1,64 @@
+package router
+
+import (
+	"net"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+)
+
+//clientIPKey clientIPMiddleware 确定的客户端IP在 gin.Context 中的key
+const clientIPKey = "clientIP"
+
+//clientIPMiddleware 确定请求的客户端IP，对端地址是 server.trustedProxies 中的代理时才读取 X-Forwarded-For
+//	未配置可信代理时只使用连接的对端地址，避免伪造请求头绕过按IP的限流
+func clientIPMiddleware() gin.HandlerFunc {
+	trusted, err := config.Get().Server.ParseTrustedProxies()
+	if err != nil {
+		log.Errorf("parse server.trustedProxies err:[%v]", err)
+	}
+	return func(c *gin.Context) {
+		c.Set(clientIPKey, resolveClientIP(c.Request.RemoteAddr, strings.Join(c.Request.Header["X-Forwarded-For"], ","), trusted))
+		c.Next()
+	}
+}
+
+//getClientIP clientIPMiddleware 确定的客户端IP，未经过该中间件时使用对端地址
+func getClientIP(c *gin.Context) string {
+	if ip, ok := c.Get(clientIPKey); ok {
+		return ip.(string)
+	}
+	return resolveClientIP(c.Request.RemoteAddr, "", nil)
+}
+
+//resolveClientIP 从对端地址开始，沿 X-Forwarded-For 从右往左跳过可信代理，返回第一个不可信的地址
+func resolveClientIP(remoteAddr, forwardedFor string, trusted []*net.IPNet) string {
+	ip, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		ip = remoteAddr
+	}
+	hops := strings.Split(forwardedFor, ",")
+	for i := len(hops) - 1; i >= 0 && isTrustedProxy(ip, trusted); i-- {
+		hop := strings.TrimSpace(hops[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+		ip = hop
+	}
+	return ip
+}
+
+func isTrustedProxy(ip string, trusted []*net.IPNet) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+	for _, ipNet := range trusted {
+		if ipNet.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
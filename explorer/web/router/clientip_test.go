@@ -0,0 +1,33 @@
+package router
+
+import (
+	"net"
+	"testing"
+)
+
+func TestResolveClientIP(t *testing.T) {
+	_, proxies, _ := net.ParseCIDR("10.0.0.0/8")
+	trusted := []*net.IPNet{proxies}
+	cases := []struct {
+		remoteAddr   string
+		forwardedFor string
+		trusted      []*net.IPNet
+		want         string
+	}{
+		//未配置可信代理时忽略请求头
+		{"1.2.3.4:5678", "8.8.8.8", nil, "1.2.3.4"},
+		//对端不是可信代理时忽略请求头
+		{"1.2.3.4:5678", "8.8.8.8", trusted, "1.2.3.4"},
+		{"10.0.0.1:5678", "8.8.8.8", trusted, "8.8.8.8"},
+		//客户端自己伪造的地址在最左边，跳过可信代理后取第一个不可信的地址
+		{"10.0.0.1:5678", "9.9.9.9, 8.8.8.8, 10.0.0.2", trusted, "8.8.8.8"},
+		{"10.0.0.1:5678", "", trusted, "10.0.0.1"},
+		{"10.0.0.1:5678", "unknown, 10.0.0.2", trusted, "10.0.0.2"},
+		{"[::1]:5678", "", nil, "::1"},
+	}
+	for _, c := range cases {
+		if got := resolveClientIP(c.remoteAddr, c.forwardedFor, c.trusted); got != c.want {
+			t.Errorf("resolveClientIP(%v, %v) = %v, want %v", c.remoteAddr, c.forwardedFor, got, c.want)
+		}
+	}
+}
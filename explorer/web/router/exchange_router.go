@@ -0,0 +1,49 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+func exchangeRegister(ginRouter *gin.Engine) {
+
+	apiRoute(ginRouter, "GET", "/exchange", func(c *gin.Context) (interface{}, error) {
+		log.Debugf("Hello /api/exchange")
+		return service.QueryExchanges()
+	})
+
+	//?interval=1h&from=1539100800000&to=1539187200000&limit=200
+	apiRoute(ginRouter, "GET", "/exchange/:id/candles", func(c *gin.Context) (interface{}, error) {
+		req := &entity.ExchangeCandles{}
+		req.ExchangeID = mysql.ConvertStringToInt64(c.Param("id"), -1)
+		req.Interval = c.DefaultQuery("interval", "1h")
+		req.From = mysql.ConvertStringToInt64(c.Query("from"), 0)
+		req.To = mysql.ConvertStringToInt64(c.Query("to"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 200)
+		log.Debugf("Hello /api/exchange/:%v/candles?%#v", req.ExchangeID, req)
+		if req.ExchangeID < 0 {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		return service.QueryExchangeCandles(req)
+	})
+
+	//?start=0&limit=20&from=1539100800000&to=1539187200000
+	apiRoute(ginRouter, "GET", "/exchange/:id/trades", func(c *gin.Context) (interface{}, error) {
+		req := &entity.ExchangeTrades{}
+		req.ExchangeID = mysql.ConvertStringToInt64(c.Param("id"), -1)
+		req.Start = mysql.ConvertStringToInt64(c.Query("start"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
+		req.From = mysql.ConvertStringToInt64(c.Query("from"), 0)
+		req.To = mysql.ConvertStringToInt64(c.Query("to"), 0)
+		log.Debugf("Hello /api/exchange/:%v/trades?%#v", req.ExchangeID, req)
+		if req.ExchangeID < 0 {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		return service.QueryExchangeTrades(req)
+	})
+
+}
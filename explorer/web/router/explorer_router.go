@@ -1,17 +1,72 @@
 package router
 
 import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net"
 	"net/http"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/health"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/metrics"
 )
 
-//Start  启动服务
+//Start  注册http服务到 lifecycle，lifecycle.Run 时开始监听，退出时停止接收新请求并等待处理中的请求完成
 func Start(address string, objectpool int) {
-	ginRouter := gin.Default()
+	appendServer("http", address, newRouter())
+}
+
+//appendServer 注册监听address的http服务到 lifecycle
+func appendServer(name, address string, handler http.Handler) {
+	service := &http.Server{
+		Addr:           address,
+		Handler:        handler,
+		ReadTimeout:    60 * time.Second,
+		WriteTimeout:   30 * time.Second,
+		MaxHeaderBytes: 1 << 20,
+	}
+
+	lifecycle.Append(lifecycle.Hook{
+		Name: name,
+		Start: func(ctx context.Context) error {
+			listener, err := net.Listen("tcp", address)
+			if err != nil {
+				return err
+			}
+			log.Debugf("Start %v service, address:[%v],", name, address)
+			go func() {
+				if err := service.Serve(listener); err != nil && err != http.ErrServerClosed {
+					lifecycle.Fail(name, err)
+				}
+			}()
+			return nil
+		},
+		Stop:   service.Shutdown,
+		Server: true,
+	})
+}
+
+//newRouter 注册中间件和全部路由
+func newRouter() *gin.Engine {
+	ginRouter := gin.New()
+	// 访问日志带request id，按结构化字段输出，客户端IP按 server.trustedProxies 确定
+	ginRouter.Use(gin.Recovery(), clientIPMiddleware(), requestIDMiddleware())
+	// prometheus 指标和存活、就绪检查，不经过限流，也不计入请求统计
+	ginRouter.GET("/metrics", gin.WrapH(metrics.Handler()))
+	ginRouter.GET("/healthz", gin.WrapH(health.LivenessHandler()))
+	ginRouter.GET("/readyz", gin.WrapH(health.ReadinessHandler()))
+	routeOf := newRouteOf(ginRouter)
+	// 链路追踪，请求中的mysql、redis、grpc调用记录为子span
+	ginRouter.Use(traceMiddleware(routeOf))
 	ginRouter.Use(corsMiddleware())
+	// 按路由统计请求数和耗时
+	ginRouter.Use(metricsMiddleware(routeOf))
+	// 按api key或ip限流
+	ginRouter.Use(rateLimitMiddleware())
 	// 注册区块链查询路由
 	blockRegister(ginRouter)
 	// 注册交易查询路由
@@ -20,31 +75,40 @@ func Start(address string, objectpool int) {
 	transferRegister(ginRouter)
 	// 注册账户查询路由
 	accountRegister(ginRouter)
+	// 注册地址标签路由
+	labelRegister(ginRouter)
+	// 注册登录认证路由
+	authRegister(ginRouter)
 	// 注册投票查询路由
 	voteRegister(ginRouter)
 	// 注册超级代表查询路由
 	witnessRegister(ginRouter)
 	// 注册通证查询路由
 	tokenRegister(ginRouter)
+	// 注册通证资料路由
+	tokenMetaRegister(ginRouter)
+	// 注册提议和链参数查询路由
+	proposalRegister(ginRouter)
+	// 注册交易对查询路由
+	exchangeRegister(ginRouter)
+	// 注册行情查询路由
+	priceRegister(ginRouter)
 	// 注册统计查询路由
 	reportRegister(ginRouter)
 	// 注册其他查询路由
 	otherRegister(ginRouter)
+	// 注册数据导出路由
+	exportRegister(ginRouter)
+	// 注册graphql查询路由
+	graphqlRegister(ginRouter)
+	// 注册接口文档路由
+	openapiRegister(ginRouter)
+	// /v2 下不存在的接口返回统一信封
+	ginRouter.NoRoute(v2NoRoute)
 
 	//ginRouter.Use(cors.Default())
 
-	service := http.Server{
-		Addr:           address,
-		Handler:        ginRouter,
-		ReadTimeout:    60 * time.Second,
-		WriteTimeout:   30 * time.Second,
-		MaxHeaderBytes: 1 << 20,
-	}
-
-	log.Debugf("Start service, address:[%v],", address)
-
-	service.ListenAndServe()
-
+	return ginRouter
 }
 
 func corsMiddleware() gin.HandlerFunc {
@@ -64,8 +128,9 @@ func corsMiddleware() gin.HandlerFunc {
 		if isAccess {
 			// 核心处理方式
 			c.Header("Access-Control-Allow-Origin", "*")
-			c.Header("Access-Control-Allow-Headers", "Origin, X-Requested-With, Content-Type, Accept")
+			c.Header("Access-Control-Allow-Headers", "Origin, X-Requested-With, Content-Type, Accept, Accept-Language, X-Api-Key, X-Request-Id, Traceparent")
 			c.Header("Access-Control-Allow-Methods", "GET, OPTIONS, POST, PUT, DELETE")
+			c.Header("Access-Control-Expose-Headers", "X-RateLimit-Limit, X-RateLimit-Remaining, X-RateLimit-Reset, X-Quota-Limit, X-Quota-Remaining, Retry-After, Content-Language, X-Request-Id, X-Trace-Id")
 			c.Set("content-type", "application/json")
 		}
 		//放行所有OPTIONS方法
@@ -76,3 +141,32 @@ func corsMiddleware() gin.HandlerFunc {
 		c.Next()
 	}
 }
+
+//requestIDMiddleware 为请求分配request id，写入响应头和请求的context，请求结束后输出访问日志
+//	请求头带 X-Request-Id 时沿用，便于和网关日志关联
+func requestIDMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		requestID := c.GetHeader("X-Request-Id")
+		if requestID == "" || len(requestID) > 64 {
+			requestID = newRequestID()
+		}
+		c.Header("X-Request-Id", requestID)
+		c.Request = c.Request.WithContext(log.NewContext(c.Request.Context(), log.RequestIDKey, requestID))
+
+		start := time.Now()
+		c.Next()
+		log.FromContext(c.Request.Context()).With(
+			"method", c.Request.Method,
+			"path", c.Request.URL.Path,
+			"status", c.Writer.Status(),
+			"latency_ms", time.Since(start).Nanoseconds()/1e6,
+			"ip", getClientIP(c),
+		).Infof("%v %v %v", c.Request.Method, c.Request.URL.Path, c.Writer.Status())
+	}
+}
+
+func newRequestID() string {
+	buf := make([]byte, 8)
+	rand.Read(buf)
+	return hex.EncodeToString(buf)
+}
@@ -0,0 +1,60 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+func exportRegister(ginRouter *gin.Engine) {
+
+	//?address=TDh2S3T3whq9FxD8cmTbBjSCvhsLHmqftK&from=2018-01-01&to=2018-12-31&format=csv
+	ginRouter.GET("/api/export/transfers", func(c *gin.Context) {
+		var err error
+		req := &entity.ExportTransfers{}
+		req.Address = c.Query("address")
+		req.Format = c.DefaultQuery("format", service.ExportFormatCSV)
+		log.Debugf("Hello /api/export/transfers?%#v", c.Request.URL.RawQuery)
+		if req.From, err = service.ParseExportTime(c.Query("from"), false); err == nil {
+			req.To, err = service.ParseExportTime(c.Query("to"), true)
+		}
+		if err != nil {
+			errCode, _ := util.GetErrorCode(err)
+			c.JSON(util.GetHTTPStatus(errCode), err)
+			return
+		}
+
+		//导出耗时可能超过http服务的写超时，取消本次请求的写超时，客户端断开时由ctx终止查询
+		if err := http.NewResponseController(c.Writer).SetWriteDeadline(time.Time{}); err != nil {
+			log.Warnf("clear export write deadline error:[%v]", err)
+		}
+		//查询到第一行数据后再写响应头，查询失败时仍然可以返回json错误信息
+		err = service.ExportTransfers(c.Request.Context(), req, c.Writer, func() {
+			contentType := "text/csv; charset=utf-8"
+			if req.Format == service.ExportFormatJSONL {
+				contentType = "application/x-ndjson; charset=utf-8"
+			}
+			c.Header("Content-Type", contentType)
+			c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=transfers_%v.%v", req.Address, req.Format))
+			c.Header("Trailer", "X-Export-Error")
+			c.Status(http.StatusOK)
+		})
+		if err == nil {
+			return
+		}
+		if c.Writer.Written() {
+			//已经开始输出，通过trailer通知客户端导出不完整，正文末尾也有一行错误信息
+			c.Writer.Header().Set("X-Export-Error", err.Error())
+			return
+		}
+		errCode, _ := util.GetErrorCode(err)
+		c.JSON(util.GetHTTPStatus(errCode), err)
+	})
+
+}
@@ -0,0 +1,58 @@
+package router
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+func graphqlRegister(ginRouter *gin.Engine) {
+
+	//graphql查询，body: {"query":"...","operationName":"...","variables":{}}
+	ginRouter.POST("/api/graphql", func(c *gin.Context) {
+		req := &entity.GraphQLReq{}
+		if err := c.BindJSON(req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			errCode, _ := util.GetErrorCode(util.NewErrorMsg(util.Error_common_request_json_convert_error))
+			c.JSON(util.GetHTTPStatus(errCode), util.NewErrorMsg(util.Error_common_request_json_convert_error))
+			return
+		}
+		log.Debugf("Hello /api/graphql operation:[%v]", req.OperationName)
+		resp, err := service.ExecuteGraphQL(req)
+		if err != nil {
+			errCode, _ := util.GetErrorCode(err)
+			c.JSON(util.GetHTTPStatus(errCode), err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+	//?query=...&operationName=...&variables={...}
+	ginRouter.GET("/api/graphql", func(c *gin.Context) {
+		req := &entity.GraphQLReq{}
+		req.Query = c.Query("query")
+		req.OperationName = c.Query("operationName")
+		if variables := c.Query("variables"); variables != "" {
+			if err := json.Unmarshal([]byte(variables), &req.Variables); err != nil {
+				log.Errorf("parsing graphql variables err:[%v]", err)
+				errCode, _ := util.GetErrorCode(util.NewErrorMsg(util.Error_common_request_json_convert_error))
+				c.JSON(util.GetHTTPStatus(errCode), util.NewErrorMsg(util.Error_common_request_json_convert_error))
+				return
+			}
+		}
+		log.Debugf("Hello /api/graphql?operation:[%v]", req.OperationName)
+		resp, err := service.ExecuteGraphQL(req)
+		if err != nil {
+			errCode, _ := util.GetErrorCode(err)
+			c.JSON(util.GetHTTPStatus(errCode), err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	})
+
+}
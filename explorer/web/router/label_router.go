@@ -0,0 +1,156 @@
+package router
+
+import (
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+//labelImportMaxBytes 导入的csv最大10M
+const labelImportMaxBytes = 10 << 20
+
+func labelRegister(ginRouter *gin.Engine) {
+
+	//?category=exchange&start=0&limit=20
+	apiRoute(ginRouter, "GET", "/label", func(c *gin.Context) (interface{}, error) {
+		start := mysql.ConvertStringToInt64(c.Query("start"), 0)
+		limit := mysql.ConvertStringToInt64(c.Query("limit"), 20)
+		log.Debugf("Hello /api/label?%v", c.Request.URL.RawQuery)
+		return service.QueryAddressLabels("", c.Query("category"), start, limit)
+	})
+
+	//带api key时优先返回私有标签
+	apiRoute(ginRouter, "GET", "/label/:address", func(c *gin.Context) (interface{}, error) {
+		address := c.Param("address")
+		log.Debugf("Hello /api/label/:%v", address)
+		return service.QueryAddressLabel(address, getRequestAPIKey(c))
+	})
+
+	//api key的私有标签 ?category=exchange&start=0&limit=20
+	apiRoute(ginRouter, "GET", "/mylabel", func(c *gin.Context) (interface{}, error) {
+		ownerKey, err := service.GetLabelOwnerKey(getRequestAPIKey(c))
+		if err != nil {
+			return nil, err
+		}
+		start := mysql.ConvertStringToInt64(c.Query("start"), 0)
+		limit := mysql.ConvertStringToInt64(c.Query("limit"), 20)
+		log.Debugf("Hello /api/mylabel?%v", c.Request.URL.RawQuery)
+		return service.QueryAddressLabels(ownerKey, c.Query("category"), start, limit)
+	})
+
+	apiRoute(ginRouter, "POST", "/mylabel", func(c *gin.Context) (interface{}, error) {
+		ownerKey, err := service.GetLabelOwnerKey(getRequestAPIKey(c))
+		if err != nil {
+			return nil, err
+		}
+		req := &entity.AddressLabel{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Debugf("Hello /api/mylabel %#v", req)
+		return service.SaveAddressLabel(ownerKey, req)
+	})
+
+	apiRoute(ginRouter, "DELETE", "/mylabel/:address", func(c *gin.Context) (interface{}, error) {
+		ownerKey, err := service.GetLabelOwnerKey(getRequestAPIKey(c))
+		if err != nil {
+			return nil, err
+		}
+		log.Debugf("Hello /api/mylabel/:%v", c.Param("address"))
+		return service.DeleteAddressLabel(ownerKey, c.Param("address"))
+	})
+
+	//body为csv，或multipart表单中的file字段
+	apiRoute(ginRouter, "POST", "/mylabel/import", func(c *gin.Context) (interface{}, error) {
+		ownerKey, err := service.GetLabelOwnerKey(getRequestAPIKey(c))
+		if err != nil {
+			return nil, err
+		}
+		log.Debugf("Hello /api/mylabel/import")
+		return importAddressLabels(c, ownerKey)
+	})
+
+	ginRouter.GET("/api/mylabel/export", func(c *gin.Context) {
+		ownerKey, err := service.GetLabelOwnerKey(getRequestAPIKey(c))
+		if err == nil {
+			log.Debugf("Hello /api/mylabel/export")
+			err = exportAddressLabels(c, ownerKey)
+		}
+		if err != nil {
+			errCode, _ := util.GetErrorCode(err)
+			c.JSON(util.GetHTTPStatus(errCode), err)
+		}
+	})
+
+}
+
+//labelAdminRegister 注册公开标签的管理路由，在管理接口上监听
+func labelAdminRegister(adminGroup *gin.RouterGroup) {
+
+	//{"address":"TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3","label":"Binance Hot Wallet","category":"exchange","note":""}
+	adminRoute(adminGroup, "POST", "/label", func(c *gin.Context) (interface{}, error) {
+		req := &entity.AddressLabel{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Debugf("Hello /api/admin/label %#v", req)
+		return service.SaveAddressLabel("", req)
+	})
+
+	adminRoute(adminGroup, "DELETE", "/label/:address", func(c *gin.Context) (interface{}, error) {
+		log.Debugf("Hello /api/admin/label/:%v", c.Param("address"))
+		return service.DeleteAddressLabel("", c.Param("address"))
+	})
+
+	adminRoute(adminGroup, "POST", "/label/import", func(c *gin.Context) (interface{}, error) {
+		log.Debugf("Hello /api/admin/label/import")
+		return importAddressLabels(c, "")
+	})
+
+	adminGroup.GET("/label/export", func(c *gin.Context) {
+		log.Debugf("Hello /api/admin/label/export")
+		if err := exportAddressLabels(c, ""); err != nil {
+			writeV2Error(c, err)
+		}
+	})
+
+}
+
+func importAddressLabels(c *gin.Context, ownerKey string) (*entity.AddressLabelImportResp, error) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, labelImportMaxBytes)
+	var reader io.Reader = c.Request.Body
+	if strings.HasPrefix(c.ContentType(), "multipart/form-data") {
+		file, _, err := c.Request.FormFile("file")
+		if err != nil {
+			log.Errorf("import address label read file err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		defer file.Close()
+		reader = file
+	}
+	return service.ImportAddressLabels(ownerKey, reader)
+}
+
+//exportAddressLabels 输出csv，还没有开始输出时出错返回错误，由调用方按各自的格式返回错误信息
+func exportAddressLabels(c *gin.Context, ownerKey string) error {
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", "attachment; filename=address_labels.csv")
+	c.Status(http.StatusOK)
+	err := service.ExportAddressLabels(ownerKey, c.Writer)
+	if err == nil || c.Writer.Written() {
+		return nil
+	}
+	c.Header("Content-Type", "application/json; charset=utf-8")
+	c.Header("Content-Disposition", "")
+	return err
+}
@@ -0,0 +1,89 @@
+package router
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/metrics"
+)
+
+var (
+	httpDuration = metrics.NewHistogramVec("explorer_http_request_duration_seconds", "Latency of http requests by route.", nil, "method", "route")
+	httpRequests = metrics.NewCounterVec("explorer_http_requests_total", "Http requests by route and status code.", "method", "route", "code")
+)
+
+//unmatchedRoute 未注册的路径统一记为该路由，避免按原始路径产生过多的指标
+const unmatchedRoute = "other"
+
+//metricsMiddleware 按路由模板统计请求数和耗时
+func metricsMiddleware(routeOf func(method, path string) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+		route := routeOf(c.Request.Method, c.Request.URL.Path)
+		httpDuration.With(c.Request.Method, route).ObserveSince(start)
+		httpRequests.With(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).Inc()
+	}
+}
+
+//newRouteOf 返回将请求路径还原为路由模板的函数，路由模板在第一次请求时从已注册的路由生成
+func newRouteOf(ginRouter *gin.Engine) func(method, path string) string {
+	var matcher *routeMatcher
+	var once sync.Once
+	return func(method, path string) string {
+		once.Do(func() {
+			matcher = newRouteMatcher(ginRouter.Routes())
+		})
+		return matcher.match(method, path)
+	}
+}
+
+//routeMatcher 将请求路径还原为注册时的路由模板，如 /api/block/:id
+type routeMatcher struct {
+	routes map[string][][]string // method => 按/拆分的路由模板
+}
+
+func newRouteMatcher(routes gin.RoutesInfo) *routeMatcher {
+	m := &routeMatcher{routes: make(map[string][][]string)}
+	for _, route := range routes {
+		m.routes[route.Method] = append(m.routes[route.Method], splitPath(route.Path))
+	}
+	return m
+}
+
+//match 返回匹配的路由模板，没有匹配时返回 unmatchedRoute
+func (m *routeMatcher) match(method, path string) string {
+	segments := splitPath(path)
+	for _, route := range m.routes[method] {
+		if matchSegments(route, segments) {
+			return "/" + strings.Join(route, "/")
+		}
+	}
+	return unmatchedRoute
+}
+
+func matchSegments(route, segments []string) bool {
+	for i, seg := range route {
+		if strings.HasPrefix(seg, "*") {
+			return true
+		}
+		if i >= len(segments) {
+			return false
+		}
+		if !strings.HasPrefix(seg, ":") && seg != segments[i] {
+			return false
+		}
+	}
+	return len(route) == len(segments)
+}
+
+func splitPath(path string) []string {
+	path = strings.Trim(path, "/")
+	if path == "" {
+		return nil
+	}
+	return strings.Split(path, "/")
+}
@@ -0,0 +1,34 @@
+package router
+
+import (
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRouteMatcher(t *testing.T) {
+	matcher := newRouteMatcher(gin.RoutesInfo{
+		{Method: "GET", Path: "/api/block"},
+		{Method: "GET", Path: "/api/block/:id"},
+		{Method: "GET", Path: "/api/account/:address/votes"},
+		{Method: "GET", Path: "/static/*filepath"},
+		{Method: "POST", Path: "/api/block"},
+	})
+	cases := []struct {
+		method, path, route string
+	}{
+		{"GET", "/api/block", "/api/block"},
+		{"GET", "/api/block/", "/api/block"},
+		{"GET", "/api/block/1000", "/api/block/:id"},
+		{"GET", "/api/account/TXYZ/votes", "/api/account/:address/votes"},
+		{"GET", "/api/account/TXYZ", unmatchedRoute},
+		{"GET", "/static/js/app.js", "/static/*filepath"},
+		{"POST", "/api/block/1", unmatchedRoute},
+		{"DELETE", "/api/block", unmatchedRoute},
+	}
+	for _, item := range cases {
+		if route := matcher.match(item.method, item.path); route != item.route {
+			t.Errorf("%v %v matched [%v], expected [%v]", item.method, item.path, route, item.route)
+		}
+	}
+}
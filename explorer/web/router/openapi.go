@@ -0,0 +1,372 @@
+package router
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+/*
+openapi 3 接口文档
+接口描述在 openapi_spec.go 的 routeSpecs 中按 "方法 路径" 登记，请求和响应的结构由 web/entity 中的结构体通过反射生成
+文档只包含实际注册的路由，/v2 路由使用对应 /api 路由的描述，响应套上统一信封
+*/
+
+const openapiVersion = "3.0.0"
+
+const entityPkgPath = "github.com/wlcy/tron/explorer/web/entity"
+
+//routeSpec 单个接口的描述
+type routeSpec struct {
+	Summary     string      // 接口说明
+	Tag         string      // 分组
+	Query       []string    // query参数
+	Body        interface{} // 请求体结构
+	Resp        interface{} // 响应结构，为nil时不描述响应内容
+	ContentType string      // 响应类型，默认 application/json
+}
+
+//openapiIgnoreRoutes 不需要出现在文档中的路由
+var openapiIgnoreRoutes = map[string]bool{
+	"GET /socket.io/":       true,
+	"GET /api/openapi.json": true,
+	"GET /api/docs":         true,
+	"GET /metrics":          true,
+	"GET /healthz":          true,
+	"GET /readyz":           true,
+
+	"GET /api/docs/swagger-ui.css":       true,
+	"GET /api/docs/swagger-ui-bundle.js": true,
+}
+
+var openapiDoc map[string]interface{}
+var onceOpenapiDoc sync.Once
+
+func openapiRegister(ginRouter *gin.Engine) {
+
+	ginRouter.GET("/api/openapi.json", func(c *gin.Context) {
+		log.Debugf("Hello /api/openapi.json")
+		onceOpenapiDoc.Do(func() {
+			openapiDoc = buildOpenapiDoc(ginRouter.Routes())
+		})
+		c.JSON(http.StatusOK, openapiDoc)
+	})
+
+	ginRouter.GET("/api/docs", func(c *gin.Context) {
+		c.Header("Content-Type", "text/html; charset=utf-8")
+		c.String(http.StatusOK, openapiDocsHTML, swaggerUIVersion)
+	})
+
+	ginRouter.GET("/api/docs/swagger-ui.css", swaggerUIAsset("text/css; charset=utf-8", swaggerUICSS))
+	ginRouter.GET("/api/docs/swagger-ui-bundle.js", swaggerUIAsset("application/javascript; charset=utf-8", swaggerUIBundleJS))
+
+}
+
+//go:generate sh gen_swagger_ui.sh
+
+//swaggerUIAsset 返回内嵌的 swagger-ui 文件，客户端支持gzip时直接返回压缩后的内容
+func swaggerUIAsset(contentType, encoded string) gin.HandlerFunc {
+	compressed, raw, err := decodeSwaggerUIAsset(encoded)
+	if err != nil {
+		log.Errorf("decode swagger-ui asset err:[%v]", err)
+	}
+	return func(c *gin.Context) {
+		if err != nil {
+			c.Status(http.StatusInternalServerError)
+			return
+		}
+		c.Header("Cache-Control", "public, max-age=86400")
+		c.Header("Vary", "Accept-Encoding")
+		if strings.Contains(c.GetHeader("Accept-Encoding"), "gzip") {
+			c.Header("Content-Encoding", "gzip")
+			c.Data(http.StatusOK, contentType, compressed)
+			return
+		}
+		c.Data(http.StatusOK, contentType, raw)
+	}
+}
+
+//decodeSwaggerUIAsset 返回gzip压缩的内容和解压后的内容
+func decodeSwaggerUIAsset(encoded string) (compressed, raw []byte, err error) {
+	compressed, err = base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader, err := gzip.NewReader(bytes.NewReader(compressed))
+	if err != nil {
+		return nil, nil, err
+	}
+	raw, err = ioutil.ReadAll(reader)
+	return compressed, raw, err
+}
+
+//getRouteSpec 查找路由的描述，/v2 路由使用对应 /api 路由的描述
+func getRouteSpec(method, path string) (*routeSpec, bool) {
+	if strings.HasPrefix(path, v2Prefix+"/") {
+		path = "/api" + strings.TrimPrefix(path, v2Prefix)
+	}
+	spec, ok := routeSpecs[method+" "+path]
+	return spec, ok
+}
+
+//buildOpenapiDoc 按已注册的路由生成文档
+func buildOpenapiDoc(routes gin.RoutesInfo) map[string]interface{} {
+	builder := &openapiBuilder{schemas: make(map[string]interface{})}
+	paths := make(map[string]map[string]interface{})
+	for _, route := range routes {
+		if openapiIgnoreRoutes[route.Method+" "+route.Path] {
+			continue
+		}
+		spec, ok := getRouteSpec(route.Method, route.Path)
+		if !ok {
+			log.Errorf("buildOpenapiDoc route [%v %v] has no spec", route.Method, route.Path)
+			continue
+		}
+		openapiPath, operation := builder.operation(route.Method, route.Path, spec)
+		if paths[openapiPath] == nil {
+			paths[openapiPath] = make(map[string]interface{})
+		}
+		paths[openapiPath][strings.ToLower(route.Method)] = operation
+	}
+	builder.schemaOf(reflect.TypeOf(entity.V2Meta{}))
+	builder.schemaOf(reflect.TypeOf(entity.V2Error{}))
+
+	return map[string]interface{}{
+		"openapi": openapiVersion,
+		"info": map[string]interface{}{
+			"title":       "Tron Explorer API",
+			"version":     "2.0.0",
+			"description": "/api 保持原有响应格式，/v2 使用统一的 data/meta/error 响应信封",
+		},
+		"paths": paths,
+		"components": map[string]interface{}{
+			"schemas": builder.schemas,
+			"securitySchemes": map[string]interface{}{
+				"apiKey": map[string]interface{}{"type": "apiKey", "in": "header", "name": "X-Api-Key"},
+			},
+		},
+		"security": []interface{}{
+			map[string]interface{}{},
+			map[string]interface{}{"apiKey": []string{}},
+		},
+	}
+}
+
+type openapiBuilder struct {
+	schemas map[string]interface{}
+}
+
+//operation 生成单个接口的描述，返回openapi格式的路径
+func (b *openapiBuilder) operation(method, path string, spec *routeSpec) (string, map[string]interface{}) {
+	isV2 := strings.HasPrefix(path, v2Prefix+"/")
+	parameters := make([]interface{}, 0)
+	segments := strings.Split(path, "/")
+	operationID := strings.ToLower(method)
+	for i, segment := range segments {
+		if segment == "" {
+			continue
+		}
+		if strings.HasPrefix(segment, ":") || strings.HasPrefix(segment, "*") {
+			name := segment[1:]
+			segments[i] = fmt.Sprintf("{%v}", name)
+			parameters = append(parameters, map[string]interface{}{
+				"name": name, "in": "path", "required": true, "schema": map[string]interface{}{"type": "string"},
+			})
+			operationID += "By" + strings.Title(name)
+			continue
+		}
+		for _, word := range strings.FieldsFunc(segment, func(r rune) bool { return r == '-' || r == '.' || r == '_' }) {
+			operationID += strings.Title(word)
+		}
+	}
+	for _, name := range spec.Query {
+		parameters = append(parameters, map[string]interface{}{
+			"name": name, "in": "query", "schema": map[string]interface{}{"type": "string"},
+		})
+	}
+
+	operation := map[string]interface{}{
+		"summary":     spec.Summary,
+		"operationId": operationID,
+		"tags":        []string{spec.Tag},
+		"parameters":  parameters,
+	}
+	if spec.Body != nil {
+		operation["requestBody"] = map[string]interface{}{
+			"required": true,
+			"content":  map[string]interface{}{"application/json": map[string]interface{}{"schema": b.schemaOf(reflect.TypeOf(spec.Body))}},
+		}
+	}
+
+	success := map[string]interface{}{"description": "OK"}
+	contentType := spec.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	var respSchema interface{}
+	if spec.Resp != nil {
+		respSchema = b.schemaOf(reflect.TypeOf(spec.Resp))
+	}
+	if isV2 {
+		respSchema = b.v2Schema(spec.Resp, respSchema)
+	}
+	if respSchema != nil {
+		success["content"] = map[string]interface{}{contentType: map[string]interface{}{"schema": respSchema}}
+	}
+	responses := map[string]interface{}{"200": success}
+	if isV2 {
+		responses["default"] = map[string]interface{}{
+			"description": "错误，状态码见文档",
+			"content": map[string]interface{}{"application/json": map[string]interface{}{"schema": map[string]interface{}{
+				"type": "object",
+				"properties": map[string]interface{}{
+					"data":  map[string]interface{}{"nullable": true},
+					"meta":  map[string]interface{}{"nullable": true},
+					"error": schemaRef("V2Error"),
+				},
+			}}},
+		}
+	} else {
+		responses["default"] = map[string]interface{}{"description": "错误"}
+	}
+	operation["responses"] = responses
+
+	return strings.Join(segments, "/"), operation
+}
+
+//v2Schema /v2 响应信封，列表结果拆成data和meta，与 newV2Response 一致
+func (b *openapiBuilder) v2Schema(resp interface{}, respSchema interface{}) interface{} {
+	dataSchema := respSchema
+	if resp != nil && isListResp(reflect.TypeOf(resp)) {
+		dataField, _ := reflect.Indirect(reflect.ValueOf(resp)).Type().FieldByName("Data")
+		dataSchema = b.schemaOf(dataField.Type)
+	}
+	if dataSchema == nil {
+		dataSchema = map[string]interface{}{}
+	}
+	return map[string]interface{}{
+		"type":     "object",
+		"required": []string{"data", "meta", "error"},
+		"properties": map[string]interface{}{
+			"data":  dataSchema,
+			"meta":  schemaRef("V2Meta"),
+			"error": map[string]interface{}{"nullable": true, "allOf": []interface{}{schemaRef("V2Error")}},
+		},
+	}
+}
+
+func schemaRef(name string) map[string]interface{} {
+	return map[string]interface{}{"$ref": "#/components/schemas/" + name}
+}
+
+//schemaOf 按json tag生成结构的schema，entity包中的结构体登记到components中
+func (b *openapiBuilder) schemaOf(t reflect.Type) map[string]interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return map[string]interface{}{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return map[string]interface{}{"type": "integer", "format": "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return map[string]interface{}{"type": "integer", "format": "int64"}
+	case reflect.Float32, reflect.Float64:
+		return map[string]interface{}{"type": "number"}
+	case reflect.String:
+		return map[string]interface{}{"type": "string"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return map[string]interface{}{"type": "string", "format": "byte"}
+		}
+		return map[string]interface{}{"type": "array", "items": b.schemaOf(t.Elem())}
+	case reflect.Map:
+		return map[string]interface{}{"type": "object", "additionalProperties": b.schemaOf(t.Elem())}
+	case reflect.Struct:
+		if t.PkgPath() != entityPkgPath || t.Name() == "" {
+			return b.structSchema(t)
+		}
+		if _, ok := b.schemas[t.Name()]; !ok {
+			//先占位，避免结构体相互引用时无限递归
+			b.schemas[t.Name()] = map[string]interface{}{}
+			b.schemas[t.Name()] = b.structSchema(t)
+		}
+		return schemaRef(t.Name())
+	}
+	return map[string]interface{}{}
+}
+
+func (b *openapiBuilder) structSchema(t reflect.Type) map[string]interface{} {
+	properties := make(map[string]interface{})
+	required := make([]string, 0)
+	b.collectFields(t, properties, &required)
+	sort.Strings(required)
+	schema := map[string]interface{}{"type": "object", "properties": properties}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+//collectFields 收集结构体的字段，匿名嵌入的结构体字段展开到外层，与encoding/json一致
+func (b *openapiBuilder) collectFields(t reflect.Type, properties map[string]interface{}, required *[]string) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts := tag, ""
+		if idx := strings.Index(tag, ","); idx >= 0 {
+			name, opts = tag[:idx], tag[idx+1:]
+		}
+		fieldType := field.Type
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		if field.Anonymous && name == "" && fieldType.Kind() == reflect.Struct {
+			b.collectFields(fieldType, properties, required)
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		properties[name] = b.schemaOf(field.Type)
+		if !strings.Contains(opts, "omitempty") {
+			*required = append(*required, name)
+		}
+	}
+}
+
+const openapiDocsHTML = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Tron Explorer API</title>
+<link rel="stylesheet" href="/api/docs/swagger-ui.css?v=%[1]v">
+</head>
+<body>
+<div id="swagger-ui"></div>
+<script src="/api/docs/swagger-ui-bundle.js?v=%[1]v"></script>
+<script>
+window.onload = function() {
+	SwaggerUIBundle({url: "/api/openapi.json", dom_id: "#swagger-ui", validatorUrl: null});
+};
+</script>
+</body>
+</html>
+`
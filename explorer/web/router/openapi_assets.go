@@ -0,0 +1,6198 @@
+// Code generated by gen_swagger_ui.sh from swagger-ui-dist v4.15.5. DO NOT EDIT.
+
+package router
+
+//swagger-ui (Apache License 2.0) 内嵌在程序中，/api/docs 不依赖外部CDN
+//	升级时修改 gen_swagger_ui.sh 中的版本后执行 go generate 重新生成
+
+//swaggerUIVersion 内嵌的 swagger-ui 版本
+const swaggerUIVersion = "4.15.5"
+
+//swaggerUICSS gzip压缩后base64编码的 swagger-ui.css
+const swaggerUICSS = `
+H4sIAAAAAAACA+xde3OqyLb/Kjk1NVUzs6PyEF+pqbr4jO5g4js6tf/gJaLdwAEUH2e++23AF9Ao
+UeeeuVWTVPaOzfqtXr3e0o1JWw6vKLKZWqo7UQe6WfqJFrIkQ75Mdc1OTXmogk3J4jUrZcmmOs38
+9q8nTTchD9StnBYt62mVTxNp4uk/T1yz//SmirJmyeiVotqzpZAWdZjRZMSZtzJB3G+ZP9OnyZ9m
+NgS7FLRStry2UxaiSvHSfGnZJZIgfn5JObKwUO2Yq0DV5NRMVpUZGkiTTICzoEubHeRNRdVKROAK
+b9qqCOTnwJilSsGRqa7bshkYmsm8FBrS+FXgtSWLtqprO0m1DMBvSgLQxUVwxeTO07G7mhIlw5e9
+jOlcXoZPQUmnqiLyhsvwOTS8NIPSQl69NKmPOKiDRBNlCWMdlMvcCfraFUvVlJKIRJSRlGjoZa9h
+4kVfyeYU6E5ppVqqAOQA3kD8z50H6ppuGbwoPx9/ezktHIkQtMkuYGoJ+Y7JuwtPWQvVKOnCHCnW
+ehF4caGY+lKTUr7b2ibyUYM3kaxBfoJg/mGrNpB/oGWZyGxoKbatw5Kma/JLaJISYiibrju9xIhx
+oniSEB9ZerlGEHTGoJPYpq4pvrYcX7mqNkNxZr+cjwk6QCwDfEQ95KULQQqy5qFxhxmkqbb3TnsD
+5JJqo8AV/ww6mrnYRczw03RKvOx/JYigE1so+sGZzxeIn4PXlyHtLI0z6jwTjHPixdAt1dO4KQOk
++pX8gtwSRTQPUkhaRSsJvCW7kPAsu70HpNIUE1q3O6etG+hS+Aq/lFQ9IN8KZQr9GGuq5kkXDTkP
+iLzN/uUPN5hMHVg/fj3CPC88LCmAU6Fy8FjfCC5pUNiV4vEtmShF/bo7RuVMlSRZC/rdEq04mDxU
+zVjagRHdsF1jGqFEBlDEBYZcj0eRxu/wNeLcrXDZ+QWbjOMk3F1MNhiUL/DOC0svK0xR3Ykq7w97
+Y8i/m7Il2z+eoxeQm0A1dAUzmVu29gj/6o9j/uINQ+bR/KJc8i9hpt9jSqUU1LepqS4urZSqaaHa
+ci5qItK98Fdo/cmjRFGvezF4SXKrAXFhDSc2JiKNX8BVuoP0Fwj3ogcpdvrSdn2tRBrrfe59KnuE
+feQLoTIoAwlJszssLE2jeH9K571/c5G0AGRF1qTzunisJet9ujsk7kNg2zzyVeTq65SjSvbMD4Wj
+Hl+cmWrLKS8Nl/zGKFREdQXpy8Kml0R57hikx/jhl7aOsZ84k8UFWgcuCkwe5a4fMeu+5BTaEgqy
+6XrgPho8z0LrVbUUJoxiQMigQdBun0ZilmKhgBNn2BB01eFZ/WXvJSl9OkUeUEpRof4nwOokiz+Q
+El1uIH4NcbhTg4CTLpKdTvipCuTU0gA6Lx2UEJthvMx78MRgPZdtXgVWsFWUteWFVtFaQpSoN0cK
+oFrIjHYoMpA+Vrx1vQr+4RelH6FCAhHKlgPVMABLS7KwVJ5+C8S2pQNVelJQV4ShTXmRhUWg5mSK
+QwgAtTF4RLiH2SMUU5XOmp/z7vNpaYJfJN7mSyrkFTljaMqLG6C57LM6LL93HeJ7Q9FZ9NXuDWa1
+geL+6v3TrLBj9F+1Vs3n3tyBxme3Pnrt9gVqQkhUfTPplMuTRlGd9MotYVTXJsMWGI+6jCgC8OEC
+NjNjWJ8RoxrJvcP2SugxM5+eyQqfrP9VdTLya3k2pmwgVcrqZCQZwpxQ8/llpqmWjUmVUIfbYZur
+kU6HGur8YJYT4bAvL5jBmDbM8RYsmvPCt2Z1nX3XZrbYIIHUqClyg7QEjcvJCI9kWo3hIOe+FkZD
+YtwrqM1XJYdoHKlhFZuL+kKgWqBZn7UHlXJVoNHv1cGyrZJzrl9bN6si8zavke9VbtPuOQo3Z9ft
+XsF57xXWXIVwuL6+5qr6hquwSvPwM88qH6+txWRu9Lq18VEeEXbhR6+lS69d510trCRaot80cfsG
+i5vJprB+7y+Yty27eds2N2+fCK+SW3nEEONPxUb4+Rnf2uSzPRchQGsAK0EtbyaNcW48aq2kz06x
+qTZPOkDr7A+IYhPOCOmVzb1tiktxc7TFXKCIldyoO2/b2pKrFG3B08/MFhrM9l1rEwM4DMiMeC73
+Ol2OqaL9Rs9mYqWwfpuzK5FE9m8MVgizFejhZkwNe5PReI5smRVG66W4NVzbJ5TFl0Oske33BZqP
+HtoTpIveAK3PtXWFWfCjTo7rd6vv/XEWSUq1iWGd69fr7blCcdtJtTNfOO1FJ8vNFYerNc/4gaVI
+d2cCbIMzfkaQXycZv6pRRT45kxrFzbBRXAnI5zq+fpRBY7ZC41u+UXSatXa1WW0ifxko32sk8nmw
+9K5VFuqHyhb2dsp1G8MN/zkBk9pkg/ShIDldHeb4EbOVGnWk82Gri+Zw6d8gQDGi08gTNp1FB/0/
+pjvbwZobDKjOvFXpEINNu8pmuT7rcPMazfWaR35I53NpRAJB657x6wb5ba/yW7myv9EYn3R9tVL0
+/HKw6DZ8Oj/uvDjso+vVYlZs1Oc8NSSajeHSjXdRbWY+rHLvW4NzM8S8N+iWh69zfrzaZjLbQvXN
+qmYVsfGpjmtso9z83ihrciYzrRsOqzhsuVhg+TplzMGg48LLre6AqZmLlqIov//+65MpoyplPxFP
+sZk0ReYemEzLXprr+MmUnRYyxdk/yfSfZPp3Tabv1fGmO5/VulU3mY439ybTE79OMn5/o2Ta6T82
+mXbm/6Vkuqr0CLLpJiPumExhN5thvpXrH+Upy9Vhhisr60KXbYwqGsmysKfQ2U5uUa5+5xpcteI0
+KzLXlGE9w3R4p6Ya7MgZzJzanKuuBHYERovVsOwsC6tOE2W8mlOuGLPCICcSXl6qgXp/0Vt2YKWS
+KAEXUl6/e56F3WY5nH7nhnzMv5niPJPtsOPu58yYoLzbGyz+vW9kT18fGYst12vSqG3KHTczdw6J
+E2G5Nf/aJcSqvnqjGJRD/Dz25uXaYnb8ya64XtZ5c9jCBz1zxojH5LOjjOFQ40fZYnP5Lb/aNBXk
+88UmEMl+v9wWa23AN0BT1lpgQBvvw0Y7x1McM6kgm7g+WnXjt2xPPruzU45QUDz7c79D9Bp6OXjl
+xryXk1+7Jsofh1ox7g9ZpUMhv+ix5Nt8bI+3tTXXY+n3Xnbd7itbbj6w9nGy4qqLFfI1m6vWUJPD
+5lDuPeXYStPN+0f/Osnj+iKhHnWDcqy0YWhuw6xEKCJ+CwblbodTCxtOJV28LdJuLNazbyjGmpsT
+XxSLqJ1oGWgNhkChGo7y9zusqyi3ER+95lmedPPA2ZyX7LH5G+bmTzbXQXVv/NldiaC7EijnrC6W
+dYFG49RsJaL62NmwG2QPslmZtZFPGSh/oGuK4fMpt/t9oyUg2cYjZj4ZgBqSz+W/5Edg8b5orlEV
+I9774rY9lyocsn23P6lwi1a1228SXFVBtRTx74vrM341gWqTKGcspdqJ36QR4Edc5efVzmM9AShP
+UZNRtyHCot189XX5DuPrQmde23DzDtPe1lBdaG69urBoojzZrqNx571ac7hqc42uqyd+8XXrxK95
+ld/b9pRLQz6JfNVZeX4JWrWun3PPeyH3+nmPBQToytFUP7b5TF5h3/e1ip14vStbzhQtFjV8QmOm
+G689UG6pk3IH+dYb0Xr92Ga/fSBP7d74w3YJ43u/A9vVgv69WkT8BpnuHfz8n2nGYdluhWVRwVCq
+bA/9N6uV2XWtnCl02JGbNzss5muMxmuY8WatxiI8y5VDF8pstVZhiVoXFYhqOcqz2+zXy4NRDVXF
+DM+yThXNW6l1uC5bLbD6u9Yfkp1+Nd9qWbMPetXj6I9MZpKwwU9WYL7U37v1tdJZjBTqn/7+/3d/
+n7QGBPtwN7cmrFkUaZ/XrX0PmbRubYevwJn0ij20JiR/a+760kTlHtY/J+nr3RsDqC7U/JsuHHk5
+f19/33HiN77K72H9/fax/X23+t/q7+Vci164GWIbvllCDKoWh2bvdfXxB9P4d7b30Ra68yFX65DT
+SreqfFO1TO5bi2Rbg7WlsLUGy9JkmW1xPaIx9vN5bzB8735nKuNm88INlNNWTPAEy/O1My7uiZjn
+i6cJpOBhAkkNHm6RQPCl/YzbXLt2isbdlw1uppLBl1TwJR18mQ2+ZIIvc9cO7Lg7t9HdZn8TR4a8
+Cn7EXd1vU8VdNnjLcpBpYglsGVy4trZjL6JiGbzmb0sGh9TIcaDgHn9w0cG9fiN0mGh/iCm4Z+Pu
+awZHJOzpgODgLPgyaIslwG8xBv2dtwwkT8rbRdvFnwKJB6VQH7IuHvZ8D+eAmFyaYn6+hCquyVwY
+Rebz6Xz+Iiy7psOo/OWJ6HU2Mg9Np2n6IioXReVy6dwV2aIrYoiLkMKaiUxDpS8viFkXItPkLk+T
+j06TJ9NZ6so8+cg82cvzkGsyAiEuQ/zDZ4fTQ8Th2I5/yEWeBryRF1DDu7TlF3Pvp+6pIuLl7CzA
+NqVqkrx2X/z5P1CWVP7JEk1Z1p54TXr6Bara/uQATcjw1128XJp1RzS46JsCAgNMFhMY4PWwwM2W
+KDIwwCTBgZvvenzgJksQIrjJrkcJTo9JAgU32/VYwaEShYsL/AsiJkHI+GPHAzi5K2EE74kieGMQ
+wRtjCN4QQvDGCII3BRC8JX7gTeEDb4keeFvwwFtiB94YOvD/PnKuRQm4J0rAjVECbowScEOUgBuj
+BNwUJeCWKAE3RQm4JUrAbVECbokScGOUgL8kSsJHsYMnOYNiie4Zy/PD8N7hZ2/0Xyo0dNPmQ48l
+pN2j4O6jGlGQN34G+2pv6E3rVd0b5MHjwiI9pPj6csLbxIR3SxkrEbhNIpBEouD9GyUlypod9Jyj
+u6I8cP6Ui38TqKTp+98irJA3Y/mg8S/x8aIDy8mLka+w8sMSy8u/9CVubjxjeREX2Xw1fI5WCYXC
+PYaJY3WTbeKY3WieOHY3WiiO3WUjPSShnCwHH2Y4+EC7wYea7aFxFcPtHqNdNBB4mIHAAw0EHmog
+8FADgVsMFOC0P90ePeWOJUudncLEHIyPe9zyCCeOoK/f3TrwQKnk68JiUQnkPcc96PbCgTe8ZRnw
+tlXAxIu4IDC4RWBwm8DgXOCg6/PBJ9E8li/7oX0rHnp2Jy3YB4ybHDA4dzgOe3zyzU8HGLR/IQ4v
+BJ8wxjHYX4njAA4cvJjH4L3xOLSGeXIvoK6vh6LA+/fvvmyGM9gtljiD32qMMxa32+OMyW0miagP
+Z5XHtD+8d7/o65aCdxkK3m0n+AAzwTutBO81Et4g4CaDgLsMAu42CHiAQe7MZGHNYQ0SxKT85/gO
+uJgPIXDpNJk3scQkSUaIJd5cpBST34RoaZqO0EJVwpEyDBMhxZDl8/kImaWC1emJ7D1hsViMEALf
+2Dhynuejkuq6hpNBFMUY1hhiWZbxuvUewQwRT7Pud4QeSxp+TPNAlyoSQdKZBfhfiGfi52e3S39O
+F3+NARauAAtxwPwVYD4OmLsCzMUBmStAJg6YvQLMxgHpK0A6DkhdAVJxQPIKkIwDEsxlIBGrHYK6
+BqXiseRVMIlRkpdiIj5rKoIHRd84f/VBhQugQhwofwGUjwPlLoBycSDmAoiJA2UvgLJxIPoCKFbl
+1AUQFQciL4DIOFDYH89BRKwmIr4YgFHxOPIiEOeDXvkyZSmcuvNElohm2SjhdJol6VxMTcCR5ykm
+WkJ09D5Qiab5HI2pzu5z/RFKIY+h3MgA6E6QNvqpAEdpceQ/TYUpyUfLqbE0DRAWmJEpkWdiuGMR
+fDZHTym8UQxVC/cfEkMQhahVZrqNo3ZNI0QrKpayQMQWdiw9T0v5uFYIdb/hvonO5xkK0+NgSIt8
+MZ+N7TGigKIsC2JUJxq/2kRaPaSOLF5qASzlCHk2W5QxkRahpJm8LMV5FYa+mBNFTAvj0csWFiJK
+Mg7i8NZMlnCAaQ51SXIcAKdIuTCVpkwcAh8c06koC3EQXPRL6DtCf34rMQCIvT2EQPtPMQkCcB9t
+gt7zHDO3+3k1S6sUThUmGSJIo0Rqhj7JBJFRYTIsFR2mwhFlQ0QkhgaVnLDk0b1lRGaoAITo0FuA
+Iua933HL7fRG0XufddDL+TtI/11gnMZS/oZi4N0eltX+2jVu+23F6/wiMkd5+TuBF6a/sMwv3wAz
+ifN7UXH+FaWJczEMJd7LMIRYR4vSxfkahjLO3aKklz0udLvvHqeL3re72+8SskzoelFuib3vMTf6
+TOLsrlusR8KkDgkT+iNM5o4wqTfCxM4Iv+aLD8t/kVuT93sifKQjwr/GD2N8Dlz3OZDU50BCnwPJ
+fA4k9TmQ2Oe+VnGD92zv8jnwcJ8Dj/Q5cLPPRRpz7yMag/d5MR+Z6/fwbscZIvXGondM/eeMI7fd
+o+8gdE3GfMDqlxuEw0IiOz0X1xKlvrAc/B4cdkXY/aYH1Z3jSuFXFgq/sE6YeJnwy6u8uCLwlRWB
+L6wIJF4RwK0oSOkc3y4c9kBC18ngdXz6dagQFZaIDhHhaLJBGkzWdZggCRUlsVPELrKxFc0+xA6z
+e0VE9q6IHW6HiojsTxG76D7UDe8OnPOmOs4oEZI4u0QJ8aaJ0mGtEyHDGihChbdRqMOPNVNk5z7W
+UtE+OtZY4W34x26cO2e9aKwNYUITwmQWhIkMCJPYDyYyH0xmPZjUeDCx7eANposxE7hqJpDQTCCZ
+mUAiM4EkZgKJzASSmQkkNRNIbCaAN1OA0Jrxku6kSP9xbe9FiXginrLG+olCP5c2WvZYKowtJMfS
+51hqj/vC3Fkc3p2fSIJmztHZ/cxY9FfLyEGtfpq7WbMY+FeUG4Lfot8YFslVHGJwUcsPSf5H1cO7
+NA/vUjy8W+/wTrXDB2n9kobBXRoGd2kY3K1hcKeGQXINBxi4f07n8BH+qbX3yffHv7+T2uz/1sfp
+L/Igs+gg+LcE0m4pIbw/axLK+37xIHYm5s+PpPcF43+r+7Ymx3Ujzff5Fdp2nIjTM0VZpERdSuMT
+GzOxG34Y78PsjmNjx44OSqJKmk6IWop1a8X57wuAN1wSQJJV9njd7j5VxIdEAkgmkh9us253nZ4s
+R4nZvd5lZ5cXy/LskbAuMm6KREbKutS4LdVGyILjumA7VZSdyLITR9lJU3biKjtpy07wspO67AQt
+u6l45Kp5V/XIWfe+8pGj9m31I7z+TQNErhbomiBytkHfCJGjFdpmQNLbnZaRuLxA2Z3Z7MjU9l8O
+HiulMYthArHn2iZFImaWjbWLZK/BqycWIIbdlu2yzK54p/H3GrjtX1HCYeStHi4r7fRwvgi9Hu53
+QdHDZfCtIh6b70vymb1Sltvyu2o5jb/Tx23/ij6eV0DVx/kW9PqEXgTtJAz0XfiUiKZ+QZjn/WDe
+14MF3g7mfTmY/91goVeDhd8MFnoxmP+9YKHXgoXfChZ8KVjwnWCUV4IR3ggWeiFY8H1glNeBEd4G
+Rn0Z2IfeBafdg8fuwWv3ELB78No9+O0eQnYPYbuHkN2D3+4hZPcQtnsI2j0E7R4odg8Eu4eQ3UPQ
+7oFi90Cwe6DafSgeMk4XOD5mR/PgRPF0lx8L/mXQXM/5+GXyRb95DBdz30OelWIT6skE3H8UwrSM
+x9DkEKoaSa0wqb6RtnMXdGmSRk227eUg6lapT0W16pMxxfrUz5sz2wtH59GXedVlPm0ZXVlcMV/f
+dom4YuBTDDTFtNQj5G/dZV/iFz25uT1MQynPbFmR+By+i58e40k8kR/HotrdGUV9G8yQ3HIKWOa2
+Lx6TgD1/c9lFQqLDuayPw3ysnyLwsng1sfwRAnwts+u9++lR/IOqZ+DqBw55UZm/5OUtN+S2j521
+0/OZtfRk51Vz5VWSjB6ucnaLblVWVnd5eaG8Ue4mbaF+jOHF3YsWmj/EsM2RKiq8foSB25sTNTh6
+nWKneZlX+5OGb54ZvE8OR62e4oGzmhLd17LHWpWUSK2OEoxVUUKNGkowXsFGY7V+Eo1WT9y9fT6+
+NzVsf2tHI1c1W5yoKZrHqm6HqmtsZsKq3WJ2efUqVkGbeeSdm20qnjWTByo4ctaJ9iFAubwtue/w
+UGO0efpu9zdFi9c639cQ3dXdTUPoOTzN0GZsmgHL528E1Ya6fJgZ1RNOs7v8r+mi68S4SYyxxKRJ
+TLDEeZM4xxIXTeICS0ybxBRLXDaJSyxx1SSusMR1k7jGEiG7VU26WGq2QRzuk3Crs3v3MzqmSVCs
+gGIEdDuV58v3Vlb9GyqtAcYaMB4ct9XD2Y029KvA8Ogv0B8KADoB3higRRHDAATujgQ6cDgY0KGk
+eMCWTg4JnJWmRQV4IwQDA5FtUGyAZvCGB2aOcIRg5iAECWaWQJzQw0mhAgb3RQsGPhgwGPhwzGBk
+CIcNIsOIyMGZLRA8YPkI8QOWjRxCuDMHo4i+OQcEEo5MgVjCzkUIJ+xM5IjClZUQVCBZPXGFPNPJ
+E1r06Xh00afjAUafjscYfToeZvTpeKTRp+PBRp+Oxxt9ujPk6CH+qKPz5YHAQ8f5Yo8OSQg/TGz8
+OeyMFMmIUQkbEpSwD8YkjBKSsEERCRsQkDByPMIGhyNsdDTCPhSMsFGxCBsYirChkQgbGoiwwXEI
+GxSGsEFRCBsWhLBhMQgbGIKwgREIGxWAsHHxBxsVfrAPRB9sdPDBRsQebEzowUZEHmx04MFGxx1s
+SNjB/FEH8wcdzB9zMH/IwfwRB/MHHMwfbzB/uMGC0QajBRuMFmswaqjB6JEGGxRouIIKIAYVMCSo
+gA8GFUAJKmBQUAEDggogBxUwOKiA0UEFfCiogFFBBQwMKmBoUAFDgwoYHFTAoKACBgUVMCyogGFB
+BQwMKmBgUAGjggoYF1TAqKACPhBUwOigAkYEFTAmqIARQQWMDipgdFABQ4IK8AcV4A8qwB9UgD+o
+AH9QAf6gAvxBBfiDCggGFUALKoAWVAA1qAB6UAFmUKFflH3pogB7bD6cjRDBSN51yTso9t/NzDsz
+wEBRlYlC1hAdehCauteT+bto7kM4VHKFhA6zAoYaJdr/+Wpj6+dWjmahhqEBErl0WLQANcnKFx3P
+b/nhXiN5nuKZuyPxSLlDaPDM2OGiTndh3W9Ph5kWoCJwI0Cm1HA7QIBoZ6s43BoshNMgbKTXJlC4
+1ywQVcKW4crkNQ659NxrH5/CWx4uChuJ2gwLmQwLWQwjGgyj2QsLmQujWgsbZCxsqK2wEabCRlgK
++5Ch4EYBfqOAkFFAyCiAaBRAMwoIGQVQjQIGGQUMNQoYYRQwwiggZBRGjHH/prfz9ghFViErOo+l
+A4qs7zyKBY+FvElmxBLdo1yiS9fLjUZVq2eCOu0+aRqIv40DNGaDFGZ0fXHdhvSyE4zrBppu+idw
+duEf+3l5Pt6P/PMkOmbsDO+PUXa9cgO9vd/4d//DP/ESvv8h2/9P+et/57iH7CW/nMvJJX9rf344
+5fCSV+d91v/E05/zh+fd86V6fiiLXVEVDxfxzy1/KvLJ8/khK88ZPPRamB/opmJPeVE+nbMHDCy1
+ixw1chbR5LIz2Nh9cci1JfrigZbpn4vLreBfKw+suGT7QvynkB+KpqDn8pyXRlb5bPI/RJM2AFf+
+roE1CUaz9/3hqnvdcZoMpF+duSteQnbTs9fPHjzd1CRRe7U6s1wvQz7BoLviUFzOGvaf5KPJH/4X
+ht/zz/KbWFiu9kL9zJHjKSsz3iUHXfnmIapSdvuely9nAN1OlOdYtqYS9RFR54rrZBzrfLxFl6Jk
+GajA+slgVy4/PajlGdimyE/xzyKcJavBBmuBlgj0ErGm1rFqj7zWhP3COs9cS7aOOz++xhogtgGJ
+BkhswFwDzG3Awq/i8TXVAKkNWGqApQ1Yhaq51gBrG7DRAJvZ8C3khsE6e8REYJ1iYrB+MTFY15gY
+rHeCGh/r07xCfWRisG4i1H1tYrDOMjGb2Sdtc9Zfd3cfsnAXsnAPsnAHsnD/sXD3sXDvsXDnsXDf
+sXDXsYE95+6lkO8zAGgvQbiXINxLEO4lCPcShHsJwr0E4V6CcC+B3UvGdPP1WVzVccurO0ftvvPv
+cx7E5zw4ueybm7UiVvwwHxpd9FxVxaUW8/go8cdi/3yLzpeLuT1VKdGGNqeMiZvZ69veTeb8FN/b
+a9ytvbSnpE2z99me5m3awk5btGlrOy3tylvaiVHSJSfpT2ZiOmsT05mVuOpyruyc4uh/5bZ6PVlO
+7EuIMsVvw1507V5OVrKqH5Ksaogk6zqaAKHaC6alCTzVuzQbgPjZTG/vemgg7S0PQ8f1kxyNPcaj
+JGP2oyRjJqQkY1aklo0bklo8ZktKOmpOSjpuUaoKbqMSqLBdacqipqWpi1qXpjBuYLrKbhuzlcbN
+TJHnsTQF1RrbpwQlJxFM+AyQee2Pec2Pea2PhYyP+W2P+U2PBSyPUQyPUeyOhcyOhayOBY2O0WyO
+0UyOUSyODTM43LjAa1zgNS7wGhd4jQtCxgV+4wK/cUHAuIBiXEAxLggZF4SMC4LGBTTjAppxAcW4
+wDIunSors/33/HCHvBLLYwRvKAKvaWydm1IDo0re1mPAo+ksdWVg+VNmiU8EfPD5bo1EeazWEHWR
+HCGNsUKk0p9zEFdb0tCKsMH1YGOq4VEZhqoMg1UGh8r66Tqn5j4HOYnYvisWpjpXkOsYLs2C7Yvr
+u4FKB9tnq5K0nbBWFsylmA1MP8cMO4UZSV9GVJcN1NanGZA0A6JmYGum48SFh1X+VkWHfF+UmVy3
+a3/xCtiD9eQx21fnlxxJkN+4yPOTOJsVee6Q/3K+ncW1KPJuvHO7IrkoJ9M4vU3y7JZzr/+ro/h7
+8VyJyosrtSf1JRaT/XMpLtj7ZyHEzHfjTp7/U3PXUfV+xT792Wv9kdcZGTIKv8ZquhUjsNdESU+Q
+9LmSvkDSF0r6GklP1fKXCGCpAOaYBitVA6yItQJYYjpuFMAG06E+cKgHjZpgr7tDfg/5e8SAYJ2i
+Q9B+0SFo1+gQtHcMXdAO0jF4HxnaoN2kY/Ce0jHuztJxn7fkoOlEFuxDFuxCFuxBFuxAFuw/Fu4+
+Fu49Fu48Fu47Rus6Nqzn3L0EwV6CYC9BsJcg2EsQ7CUI9xKEewnCvQThXgJaL4HVSxqMDyyuZuVj
+iqs9+XDiakg+krhakA8izqYTltCmmhbwKi4cb1RB0lqp1qfxq7jdvHUbdlpbh/ncTmsrMV9YaYtW
+5sKWmbZpqZ22bNOWdtqqTVshaW39Vnb91m2+tZ1v06Zt7DQRb7jet9eoOp3LQ9c403n3Pxv5WtTo
+W1u35XTZ/m9lwSVPXQPl1/bQobkec9322qdiJtunYlbbp2KGq5SL264CwMxX0QyzYCUZM+I+GbVj
+JRkzZSUZs2alWTCD7pNRm+6TUbPuk1HLVpIx41b6BLPvPhk3cbVPHFauNh3N0JUKB229x0pz/5TI
+RsYsnleA+d4A5nsBmM/+WcD8mdf6mdf4mdf2mdf0mdfymdfwmdfumdfsmdfqmdfomdfmmdfkmd/i
+WdDg2VB7Z3RzZ3Rrxy0bfJYNPssGn2WDz7IhYNngtWzwWjZ4LRu8lg1eywavZYPXssFr2eC1bPBa
+NngtG7yWDX7LhqBlw1DLBrplg2bZ+kbJ9rIgwSztoL9N6LF54IDXNwv16Pp3B7i+dujuvYaoA8sw
+q4Pa8xr9dUeWztFbQOs3U2+ew6v5m6k7z+DV/k3Xv7mTyQF+t2vwHqjBu1WDd38N3q0avPtr8G7U
+4H1ctGsaljxKbIhtaRkI5qXhgxamoSlGpmYg25mRiWRqRh6CtRk5KAanZaHanJGJZHZGHoLlGTk+
+L/a0LJINNEg2zB7ZEHNkA62RjTBGNtwW2VBTZIMtkY0wRDbcDtlQM2QDrZBicTDQ4mCYxQ0ZYlUw
+yeJghMXBcIsbOtTqGUgWByMsDoZb3NAhV8+AxGq3KqvO+/u1aGb+6t+Nu2tyyMQUZI9qn+D3zvS4
+9omxhlieatCB5K/Db7CVigrHTtJdA/rV16CeGmi4uhKfc0FsXTNGrBgj14vRqsWG1cpdAyDWAMg1
+AFoNwKqB/o5ItrcQq0Cqd+v4GkETt2nTjZm4VhLN82EEad0lmmfLCLa7SzTPpRE0eZdonmkj+PUu
+0TwPRxD6XaJ5lo6YJegSE7sR+kSrEWZpnzizNJolanJip3ep5tVnBTeEPFqk9WqDY1Gyx/rZz4v0
+kD99ReG8Ryz4ZuaEx3NEPH/ozrBGCuAPnRmSBCmBP3RnWCEl8IfODPMYKYE/lBmG+squ1eVpIYMa
+Hs3hb3s0S6D58Tz+HkDzBDoBz+PvBzRP2xWf4vH7/mFDu4cN7h02vHPY8L5hw7uGDe8ZNrZjvJ0A
+QzsBBncCDO8EGN4JMLwTYHgngKcT9B1dkO2/C2cul5c9qpeQb75i0DUGXaPQFQZdodAlBl2i0BSD
+pih0gUEXKHSOQecoNMGgCQqNMWiMQvngbkNnZr1eT+d67K2xpxtI7E8PgnC2u6yGr13wNQpfueAr
+FL50wZcoPHXB8aouXPAFCp+74HMUnrjgCQqPXXC0Sxvsb2bmfs1LnpWRBoljI9g7ZOX36KnM3lvE
+fD439xAcNECaGhGfmrhaGaHv7Qwv4iLQOtk6MBHkcm4dlGWZoUFRXDQV9vs9JkaF5HmOtIVs3RZy
+XIg/SPN3gOMRaaySf762haxmi9nR/LA59PkX8XyJaaqBVkm6Nw+bzC5PihpLa+PwUwGKhJ21mfc9
+B/7V3yAEGNNCA/3muDvGmdE91+fyCp0iaZ7ssxSTpOOyxXJ+TJCmu4ql0A3okM5ma6PtTkWlYUQD
+7owe0tPXM9wUdFQ2P6xQsxf31LVvxny1ShPTslXAJtusFrjdKbBNnu/2Rr0u2ct7/5LyKi0QbXbw
+nPegxWJjbolW0ufpKj+gfaqiNsv93jRhicpvOnB/yC3ga3Y78a9pFXZc8jciR2FaE+Tr4+GYojjD
+5I7Hfb5DgdobcuB/zIOLeFK3kbcGtpuR9FZ7irrIY8f/+1QfoksIQtqM60DGtSvjKpBx5cq4DGRc
+ujKmgYypK+MikHHhyjgPZJy7MiaBjIkrYxzIGLsy8rjHm3GGtE4XBVk5QwFRl3lNyLx2ZV4RMq9c
+mZeEzEtX5pSQ2dlgC0LmhSvznJB57sqcEDInrswxIbPLvOycdjjGsUpEZmewgjOeoY/PbLwVqnF8
+F63ZcCtw43AH1ArjOLQJ0mywFdRxsBbX2VmsEE9o3kV5Nt4K+LoiHBms8K9t+zrAszNgwWBrGBjc
+HC45Vn4IXzOx+8nOoSTifSwGPKQeSITJc+BgLN7sGsqVxY4+eZYmAMXwdiwqzEiEoxjajkw5ugkC
+LLwdp3bau7KgUSvP1QSkyEuAxLBdKc5cWETbdpwMNe0sWHzLs3QhLt59O9sEnWg79u0r4spjR8K9
+jxERHOKUkLhYOg4HHImSlZcVz4TFzOKFFWEz5lftCLqthQxXsSxIPP3kQmPRdVcHRx4s1m7zdOE2
+4tiQyFu4HSX4RroRicP7TK5GxqLyPpf7BUNi9D6by6vYETvP04brVgY0cpcbWeuxEtvsqibLn50s
+SI3sR163NAWjibQG5hrejc1uiT1EE2iN3DW6Hbzd8jqEJs4a2WuwX5Qtxhr1a2A9irsFNemaKCsm
+qKFqWOAWqKE0sVbc0LRKGzp4Gq6DaAKtwELVwC9RwWgirdBDsS0ZTgTsr8ZoIrHgpM4REIjJOh6d
+7xn/0gm8agKhivN9QavZ1kHBa7fgtUfwKih45Ra88gheBgUv3YKXHsFpUHDqFpx6BC+CghduwQuP
+4HlQ8NwteO4RnAQFJ27BiUdwHBQcuwXHX51vmvcF6RCq4BBPoGZdB4Wv/cLXHuGroPCVX/jKI3wZ
+FL70C196hKdB4alfeOoRvggKX/iFLzzC50Hhc7/wuUd4EhSe+IUnHuFxUHjsF46/RE2g55bdAlTR
+vujwKRggPmkBHYGl6fJRIkYd5irDET+q9I63CDOKJFBBXd5wTKmCXPIdEWbDIHlle+U6Qs6ObvJK
+1iJEAi/VZaSFoSbQVY4jKFUpLX/jG6Epgf4yFAwWYcWqBMZMN/BAoKnDXGW449iWavOWEBDuCGw1
+Ys4rX8U5SnHSeLq38QfST2YsPWhiyhCyppS0JpW09pe0opS0IpW08pe0pJS0JJW09JeUUkpKSSWl
+/pIWlJIWpJIW/pLmlJLmpJLm/pISSkkJqaTEX1JMKSkmlRR/9Tmg0JtrBPmD5wUNQWtKaWtyaWt/
+aStKaStyaSt/aUtKaUtyaUt/aSmltJRcWuovbUEpbUEubeEvbU4pbU4ube4vLaGUlpBLS/ylxZTS
+YnJp+NvdzrAFiFqB0Lk8ZPqtxnuFWXKwmTmVRfRK6yGGTHvqrs5Qz965BTbphjR7Yq/hiQvwKCdT
+DUn2pF+NrecY3LKadFWaPSWotklIoIbSlUSmDes89RygW2aTrvPvyKSiqkFIpobSCW9k4lEx2Kvj
+JFATosnEV96JDO3kpFtkh7DMe4fG9X5pmCR7VlNrI688BWNItec9tfmZPL8E53AERp8VQmZG26kV
+r0BMFjJtqk8zeCWqIH0eBplZbeYashfPN6NMNabV7FlXpX3EVGWgCSXEkGlPy7YkrU+cLQmbslWb
+xi9Pweith0zqKjnaed2A4A6mzzwhM78Nv9ZP/npIOAWk2zoyOaxlCZiShtIHQ2QCWcsTcsc6zJyV
+smaZtUzeEVLBGFLteWidVPOKVUFO2sQZIdTLdLzSfYLdIYO6pIfA+vgLccUQ3SIgbwlaJEFbLdTz
+gt64ogW4RbuijG6BkVe4ZoSElUhGoxJKQIIP2vKlTkIobOghTtrWGZgYa54INQkU5Y5U1IVS4ZdN
+Gb1pi6q67OHoRQX53rudi6MMig+IdgU32sItSl/4i3FFO9paL8qMQz8G0BaGKaR/qISAcGc8pC8m
+I7HevoLcAVKzAM3PefdhEm2dmt7AgSjnyYqbaCvbFFosIN8r2h1TaYvhCF3gLcYdZJnr58IlGaEW
+bb1dzzVQAi8D53wJnWGYsVKPUpLfhN1xmbm8j1JWaMByBmraokBKSf7AxBO5BWeRFYyjAHRO+ZrN
+7o5bIq9Z3CVNk9Q6GvOaJX06ljzvkmMkddGlJkhq2qUukNRll7pGUld9ufaBnlfoKhxBfqysWkOs
+p6NVh8QAYZi5jkEaARY6BGkJSHUI0hyw1CFIm8DK0AVpmLJvmFLe62K2TBkbALRpysREYaC5AUIa
+p1wYGKR1ytTAIM1TLg0M0j7lytQHaaBd30C7oqoKZrXQLjYRaBPtEguGoeYmCmmk3cIEIa20S00Q
+0ky7pQlC2mm3snRCGqrqG6oqrlYrVbGWjDZRlegYDDLXIEjjVAsNgbRMlWoIpFmqpYZA2qRa6Xog
+DfJiW87W10IvDjvaBtvtBTetbagxX1Bj2wYa+AW1vm2g0V9Qc9wGOuIFtc9toHNecIPdhnrsZI4S
+W79vPKGjxpbgL0/YULINu9ATMrxsg071hIw426CbPSGD0DboeE/IuLQNuuITNlRtg96ZZeL+rPLp
+fDH7hmVxm4J1AMuSLhlLnbepyK0z2aJNRK55ydI2Ebm9JVu2icjtL9mqKxOpKLQVReMXBrGWjFYZ
+Eh2DQeYaBKk8LDQE0gKQagikGWCpIZC2gJWuB9IgZdcg6LvJylhPR5ukTAwQhpnrGKRRyoUOQVql
+THUI0izlUocg7VKuDF2Qhtl1DYPHK2wXGwC0aXaJicJAcwOENM5uYWCQ1tmlBgZpnt3SwCDts1uZ
++iANVHUNhIzCrIrVVLRpqkSDYIi5ikAapVqoAKRFqlQFIM1RLVUA0hbVStMBaYgXy1K2noZ5wc1m
+G2qtF9SQtoEWfMEsa+tv1BfM0rb+dn7BLG/rb/oXzBK3/t54QS1zG+ihk+H1t16Xd8IGgW3YC56Q
+gWEb9Isne6zYhhzlyR49tiHPebLHk23IlZ7sEWYb8q0nZMzZIt526LGU10yex+jmW9RUB+WiQVDW
+RUWgxIsKQLkXFYDSLyoAZWA0HVASRkG4eBgL4qBibBzKxlgwlJCxUCgnY6FQWsZCocyMrRdKzqgw
+Fz9jYxwUDQJEWRobhxI1NgzlamwYStfYMJSxQXRDSRsV5+RtEJCDusGQKHuDAFECB8GhHA6CQ2kc
+BIcyOZh+KJmjAnE+x0Q4KB0LhrI6JgoldkwQyu2YIJTeMUEow2PphJI8mKUFeB6n3VGoHpcpEtge
+h3GGCR+HtYY5H4f5hmkfhz2HmR+XgRPIH3t0CvI/jtGKRgHhQxiJBUKHNQoRhI50FC4IHfwodBA6
+HlIYIXyIJJFC9fXSDl5ISXRQQyoCZYcUAEoQKekoR6SkozSRko4yRWr5KFnUA1x8kYlwUEYWDGWN
+TBRKHJkglDsyQSh9ZIJQBsnSCSWRFJSLR7IgDirJxqFskgVDCSULhXJKFgqllSwUyizZeqHkkgJz
+8ks2xkExIUCUZbJxKNFkw1CuyYahdJMNQxknRDeUdFJwOO9kABzUk4lC2ScDhBJQBgbloAwMSkMZ
+GJSJMvVBySjEsvx8lMvMCJSUw/DCrBRuiUFiCrfMIDeFW2qQnsItN8hQOSw5TFJZo02Ip8IHHxJV
+hQ5IFLYKG6MIhBU2ahE4K2wcI9BW2MhGYK7QsQ4jrz7lIg/BaDEfocWCfBYL0VkswGaxAJnFAlwW
+C1BZLMRksSCRxWg8FiPRWIzCYjEKicUoHBajUFiMxGCxMIHFiPwVo9FXjMReMRJ5xUjcFSNRV4zG
+XDECccWovBUj0laMxloxGmnFaJwVo1FWjMhYsRBhxUh8FaPQVYzAVjECWcUIXBUjUFWMwlSxwUQV
++wBPxUbTVGwkS8VGklRsJEfFRlJUbDRDxQYTVOwD/BQbTU+xkewUG0lOsZHcFBtJTbHRzBTzEFMs
+xEuxAC3F/KwU85NSzM9JMT8lxQKMFAsRUozERzEKHcUIbBQjkFGMwEUxAhXFKEwUCxJRjMZDMRIN
+xSgsFKOQUIzCQTEKBcVIDBQLE1CMyD8xGv3ESOwTI5FPjMQ9MRL1xGjMEwsQT4zCOzEC7cTCrBML
+k04szDmxMOXECIwTG0g4sdF8ExtJN7FRbBMbRTaxUVwTG0U1sZFMExtINLHRPBMbSTOxUSwTG0Uy
+sVEcExtFMbHPYJgcbBL42CQIskkQYpMgwCZBgE2CAJsEATYJQmwSBNkkoLFJQGKTgMImAYVNAgqb
+BBQ2CUhsEoTZJCCySUBjk4DEJgGJTQISmwQkNglobBIQ2CSgsklAZJOAxiYBjU0CGpsENDYJiGwS
+hNgkILFJQGGTgMAmAYFNAgKbBAQ2CShsEgxmk+ADbBKMZpNgJJsEI9kkGMkmwUg2CUazSTCYTYIP
+sEkwmk2CkWwSjGSTYCSbBCPZJBjNJoGHTYIQmwQBNgn8bBL42STws0ngZ5MgwCZBiE0CEpsEFDYJ
+CGwSENgkILBJQGCTgMImQZBNAhqbBCQ2CShsElDYJKCwSUBhk4DEJkGYTQIimwQ0NglIbBKQ2CQg
+sUlAYpOAxiZBgE0CCpsEBDYJwmwShNkkCLNJEGaTgMAmwUA2CUazSTCSTYJRbBKMYpNgFJsEo9gk
+GMkmwUA2CUazSTCSTYJRbBKMYpNgFJsEo9gkILJJxvXy3ab+CGvoS7+rP5piyd22/ihGUrt9/VGC
+pHYb+6MFktrt7I/WSOqqL9c2xIuxeR+vmrF7H62fsX0fq6Sxfx+rqbGBH6uusYMfq7OxhR+tuLlH
+H6+5uUkfrbq5Sx+ru7lNH6u8uU8fq725UR+rvrlTH62/tRMfbwBrKz7aAtZefKwJrM34WBtYu/Gx
+RrC242OtYO3HR5tB33KPt4G+5x5tAH3TPVZ7fdc9VnV92z1Wb33fPVZpfeN9NG7X8EXbp+LydhoE
+dXgqAvV5KgB1eyoA9XwqAHV+mg6o/zPXr7pcoI1DvaAFQx2hhUJ9oYVC3aGFQj2irRfqFK2dGC6/
+iABR12jjUO9ow1AHacNQH2nDUDeJ6IZ6SntxustZYkjUXyJA1GUiONRrIjjUcSI41Hdi+qHu09he
+4fKgFgx1oiYK9aMmCHWlJgj1piYIdaiWTp+3mP2irrty+lkWcrMs4GVZwMmygI9lARfLQh6W0Rws
+I/lXRnGvjOJdGcW5MopvZSTXyoieldEcKyP5VUZyq4zkVRnJqTKaT2VUl8qIHpXRHCqj+VNGc6eM
+5k0Z0Zkyki9lFFfKCJ6UERwpI/hRRnCjbLAXdXhMCHpMCHlMCHhMCHhMCHhMCHhMCHlMoHlMIHlM
+oHhMoHhMoHhMoHhMIHlMIHpMoHlMIHlMIHlMIHlMIHlMoHlMoHpMIHpMoHlMoHlMoHlMoHlMIHpM
+IHlMoHhMIHhMIHhMIHhMIHhMwDymhtoXANn1lt93RXngz9rfH9sftk3C7ZrtkSVut6o8X/NDpN/B
+fKlO0f50hsPPxeHwlXIZcyenv2Y5KMS6b9lQhibFulG5k6JclRyUgt2ZXAuqlQlKCN2Q2AgTV00E
+ZfnuURVyvuf3Kn+rokO+L8qsOheXRzhf8qg6cSlPJz0DF5uXItnK06UYhldE7jyXgsOH0km1zuIz
+aoTaWDaC5lg2qfynfLc1NWKjKsRG1YeNqY5bdRilOoxSHRyqazmqBpXx940rw4MCI71U0+U4aQD2
+KmCfX6q8NBD/oSL+4/lWnY/vg625gs623LoaEFRdA4NrbIBapT/FinlNWLAiLFwPRqgGG14LXOOg
+megIXGMgaAyoxjqqtTl5Yf2xKNnjPrueK57nR25CwYRC8ZqX++xmIZ9N5PP1iiMvJnKUf64UWyTV
+BEG7K4OA3fVBwJ/ntaveVmnVZANqyQZUko2oo6M+MKQ+A0zQxnrqA3h9NNwxWj4YD055dpBRxrG4
+iNjzR/5oh/jHKDXz3Z53SFY7pD/GSvLcTk6U5AT7cDjOFUSMfDQcFxoAE5GqCDt5qSRP1ytEwEpF
+1IChLzhve/5iuZpfvHOBHkByK52gC8D6QUdgXaEjHL2hg/AOMTFonxggpFt0hKNnDFCN+RRXJXqM
+OTuMBfuL+bqLhXqLhTqLEfqKhbuKEXqKhTqKEfqJDe0mR5eAs0sg2CXg6xIIdQmEugQIXQLhLgFC
+l0CoS4DQJYB0ib5QK89uz2V+798T8e6gGJGuARcu4CUry+JVgSaWzDP/brlU3tWUWznc1cDH2BRw
+YxlAxMfhxjkc86wSZd/yqjpfnm6PX25sf/2ylYkvWXnOuJQ+kxnPPl/2WZXfxQ17R3ln4PnAy61V
+6B7mAOfr7XzbSr5Dsj05H4Vfy+w6eLTo2upGbnsDG2h+He3qgdCJfNRO6Jz0p/SDkDa+Kz5lbGgb
+k9G7hw3pHUbpHPY5fcM+s2vYX6xnPL0A9F6AIb0AlF6Az+kF+MxegI/0giayzRLtuQIZHybLTnT0
+/sh7qgAw6HD5Ka/NLGXPVaEv7hVPzP0akXh4D8FAg3XiB3vYWk1zQdYATa11S05lsUI+xw01lWCj
+68CoVWBDa+DWFkZrC1RtAdFWV4Xb/13881jm++rn+Po2Uf5+3WpJD8rfr9trcTtLAvV4fssP/+XM
+rkVZ8bdy+61LyXa3Ap6rvE8cbp5cAWE3f00dP8ckheLsb0hvl47w19VRU+CVR0RFyb34XXfA4pFJ
+4gunfEf8tHEeQK5h+O+DTa5TStgdTS8biapmwoR2n2JsvcqMqjEjKcwG6uvVjdrLFhDXDWzdNMxL
+tMtu+Z2P0tV5z4uv2XPxzJ4jeonY+WBC+aMDWEAezphA/sgqumJWyTIuGmyOdTWE6dBrgqCdlUGw
+eH0wFeoqfYoNN/VkQ6rJ6LVkxEqyUXV01weG1Afo9QFifcBVHw17OPMPFrEso3p/jLeSPK9dePNw
+Mo3T2yQXFTpfrKyPx2L/bLCy4vFJhMid3Gk6XHC2r84veS9i7RdRPBvza088Pr8PKlXkwOojn+sV
+GthQJ/HdJdda8JFX/KcTNBsrqGmfRt6DCyWrEwLJupmaDaxiP88upWHtaELqJiVO5T8JwixixY+o
+uL1FNWnHiqI6ieVEYo3Ojdt5vo1e8933cxWJ1SxHPjhEL+fbeXcGUaPmO9CT1E8dyZ+Af0j+n59n
+X9WW6CCTaeK2vdJhSWVnSX1RUvGf4+ks/YpIaV4DEz7dIOAIeMif/622EveYaEPVWruaq0l1NVri
+bAZ3y5ntfC3O4qusKWP/XN6K8rF5aJAVp+zA5WPAPiguc94ivGS1PTKAyZQ3xP55d95Hu/zHOS9/
+nsbL9GG6XjxMF4sHa4WVUtRjdhR8QrOGrswO5+fb4/lyystztd0Vb1ENfpxNZpN4yQP4hP9Vl28l
+PJzntiAImC9ftqe83l08m/20bTZ99+7IiuC3DYmDOINAhbb1ACnL+SEJoLfHKPZUU5pAXdkHN0pt
+ks5Z6VJ3T1F2OTNumA+O55i5KamKudV1NlfHaZ7Qtusf0ezeVnlmJsVdUmwmJV1SYibNu6S5mbTo
+khZmUtolpWbSZrPpEvnPSLKWbgF4SNdrGy9Wi/V8uViZqMZMO2Tzuw3j7cw/BluYTWz8iJ4vt7wX
+JH8zviHyW5WLDrq+R/U4Iy19UhiTXi7clQZ7hrvyq5jCQtXoZ9WUvKf4gY5NBmDnA7CLAdh0AHZp
+tEuCNwycb1VU5rz7XD2jIGRj899u1TvkUfV+zeWyjq1+aIXv5Ei1G2sWenL9hyudsp5anLUq8ZZf
+cx65cI+gCJXHbUxTR74ze5rwv/fD+XaF7P1xB8X++7b/QpIOs//R0YaX77dJdm8WDs/TVX44qE7a
+9lI+OZg7NBDNoFeXt1nu98fjkPLEh/xVDmJvYrZVxCPNeMafbNsTvCYNF9o1xWI5u75tu5NRJ4n4
+1dU2xVU2ZVRlT7xf9kKvrpGPkL9txT/R4VzWaULnZ3YxJxDehTuf7iqxgLx4vtoSuEVMklpTx5Gt
+jZBv37gU/ZAPbC5bUfsuP9Sic5WzW7MicNs1U32sCx/a+cB8PtQDfLp54GPumnuVOR/j9YhEU7w7
+fHUm4gPxT9z+YwUqyc2pYGMG/XCoLROfmeGYWrXWVHeLOI3r2Zxjxs7w/njjxfMOK8/HrbJ6YHFV
+7GI2Sa9vTtlioe8hv+0nt2t2ucs+ip3gye3l6W5WeXHz4MVck6m+KCMJ1yIWtZC/vtZecTGbKeYs
+mt9Z8C+H80tTmQn/k84QUR+dj1eJmQUvwGAyrGZ4ICj7q7spp+fLsfj2jSuZl5cMDsU+tED5mpUZ
+yzn82zfh/D1WxIpLIWuoNn9itNlSaX5uUZOZozQ52VcWoF80uLJcev6WsSvkN67duYLc8OnqIhY+
+imiarGbdmNOeYOUT3/oz/whj4eVIzV9YZZXLpgxrYjupVjK3cOCiu4qeL3LMb8cwYn0aKdaDiP/L
+xLoXl4s38H09IdvlQK2m87ipRvzA5v781n45569RG2B9Nz81O6+YirHR/u7kys4Rt57etu1EvsPr
+NJ+YyiDzmxlXV//wFB7N+OicG9+b8ear6rpjl+/mI2W2axvNHmfjYKb6ZzFcWp/jnY9dzAil94Ie
+j+eSx5vFUQabd10Or8xsgKxpQ9KdFvXIZPXVeFktJ9APxILe2Xa7FIVp2N/8ou9kIJLyEBP51LeZ
+nf/9c8Sx3bd84oi5pudbVFzzS+/ub8+Mm8D73RW+CMtyVL8Tob9VWGzUV9/c+rb+qltpjPAi8Vc9
+SBKsftNSqRp3rpuYaZC+v9QuCdFaH8N0uw8GFOaI1vsT+a7VIfQIRX+pLVQLTTzmjovi1okFSjEx
+UMIP8rXLrE0r4iP1qTio7wDirubyRai3Vx6PIyI22cDddM9atQvBtTUfi+omnvqJZnmziW87pbOG
+hfy4FO17PjzQslyz6jQA+u3bIb/yDyL+qh8+YKto8LX0BF+1gW1feV9FuzLPvj/KfyPxwI5NV8u1
+Kzb9T280/d389Ve6GBkvczsp+RgvGYi2uvsM9j8LNzaJJrF8Efl/RZTwdVDYTig1NniHIeprrUDf
+x0nqt7v+QhIliG/A8nyVkRvmhvhnlPxsDzuBudM2SZqgbxLh6zylVpUaIjrCw8QXHhLdbzttaCii
+BaeNYa2p1cJlTiXTVhWRWJO0K7Ly0HwfJC7T6OTy6lcWW7GaPySzxUO8WMgQoD+0QBjMYrPfb2Zh
+qaThaLSw+1ilRoWONPnPdksmafIQL+OHxRppyeM+i+dhoaSGHCvrPlKlcc1IEX/gH65VbrfkYvOw
+TMT/kYbczPN5ThFLa8vx4u7jFRvZooQSnnLbMDerh3iVPiQp9oov4+x4DAslNeZYWfeRKo1rRop4
+PqTvT1ZDrmcPScIbc4M1ZDrL5/uEIJbUlOOl3UerNa45KQUIqVZriiEnXjvMcjOLk1AnCamkxhwt
+7D5WqXFNSZFfyIjuZrfm/GEz4+PPCmnM2SHNshVJLu3D8gPy7h9QbVyrUopQgneURtkkPyHtmu/E
+n27ByHRJLYTUyrX00SLvmK50aePa2lOKouD+lLP8dh/KKNXZJtPmh6ieZQixNMczVKIS/RdV/SQ6
+X2QUV7O8Sc/FHdaHQ75qvxrk9OBMmzJ0zrkeitcLFNkhei4haiZ5uQLZGXLj47tVqk5rvs/K/ECR
+J55wVXCBTeLddSoYK3gMEjVcvcbom7Q773Sdhu6XHtSrDlzzvdluAmfjk9P4IqMxe+q6YnXiGysP
+Z6rxe8yk8AD9jEm17Z22rszmlms9BCVUL7FoDMp8BWolmrfubvBwGHSye66q4iJ+k0sVVF1lnzXW
+PmuorHbdnNof2rOmuPaZowt6/9FRDq254nOk7ZxnJCY9/VD5kn9rtscMnDavrUibNu9f5NTndbCq
+WGuFvLVxotUKIRTxiDrRa3AdUoErQf/rYPWD1LazOb1X4uZv+Z6/WS28v79XrunwzqobWSdiuYs2
+Ni18Pn9XHAQDWGasC82GLrOxRWjP8sO5umtLVmbB/PV0i1oJYp5JM6XddNEcG1HL/HblGfgIfL5c
+jLb2Iq13wkpPP/I6NON/GtJiykej8CasNpcIoL7dqqx6vo0x9qBQ5SF308X+Wcz5dzEB/yYQf8Lz
+C7E9v+Apuh4dxr27LUW+mCm3UtYXS5kxhF3mf15t1QhQIWe7yVh7RUNYBg/RFJbdXMngWtsm3r3+
+tyZia5Zbq8v01HUkj/OcuWcza5E8eJ+ys9iDL9rkLil74dQUxl6dJP7NfD5HljM45gfJK4ra/RSn
+9+spv9zqHd3aLz0frwXV+i5Gqbk97ZABDJiLwNplMq0/a+RZZVpTm9uLnk71qbd7HjOHlivo6F/U
+fhBvzKlbpXbVZtaX/BVSDlCwO9gtdyLVwlpN2YSMtZ+x9V18W+wL/sl4OYQq2X2PNIHuLbAM4Ter
+wzrZzNE1M/VLN9MNzjctNHyyWlhj085zUVBzYmSrfauwMt/kDNVjI4zADqisP0uVkzE07pbXDl2C
+YUyGp9pioUT97pkjQ79ZZvdxrPWMjEHyy0FrUKKgZuWGb+obi3A+tngjqhflplj0EdC0C2C6j8d4
+3nVe8OjE1hn3nYhYOKH2DlPTv7dVRxArHd0srlpa4ZSlXf+9H3hz3Tmbj1rjc739kG1AXwjdOUO6
+07e8ytEf8otYZEHWX8nlVw9yDRa5erucy8jv7ajUjbKPZVHJHyYxD33P4mTyyflyFHtv8gdlV9d2
+eI6P7Si0CKl66iF9qP+vsJCNP5UcQ/NIbIm2TyxfatSEHl60A9FMXQ3Xvobyzek3weL74dJuh4a0
+2P/aVv97/n4UK4hvk7bh7lWhbEGUT/Of4+q5vHz99df/OhDvCtWapcrYkgKc6DLzRnJVSc0j6VeQ
+DsgZRdl+n1+rNgm4TTaeSeNln8Q2erLUb98aqbyVbtlTuwJcCtmqh/cFNTXWaj/QNfCv7RZFm0t6
+xWcBYhWSouKuh9tPvwVcswMHwty6zd+aTh95zK157nmtT0v1SiKsMXs8HjUZZO5LqtoG7vJnjEW4
+vfAQ/nbLeVDUyMb2j2KLSppVg5PTnPRBpuXNePUkJWusQcdBD+jTR+GD+m+wluszYjFzTRO6d/HI
+w0vh2hT6uQ/yrXXdeXkTH1LXMnti2V1jTNuQjH/0zHy5vn1r3wQ7VmwPzOQGqcp2DM3GLsb+05YH
+YlMRpgeiP4deylYQ5dM5TfcnUvY6yLcvxzjk4k8fMQiWqg4oze8o/LtIfgAb+2fcyOntxCN/LwEt
+5oOmVfE9v0TiOoN+BiJNUxfwUnDzFsxLpTAAHL/1Mt2CnVMiazk+XLOSd4k9dDbLwL0r9h1rocdw
+I2b4o26uSbBdCPObVTe52e7GjJWUWk8LUVa+f+cvQLaD/PDndvmbaF5eSvGaH/opyrmVsd3Fpu9i
+SI0WmX+1Nd1nlz3/NLCs0+6Sbo3McTlbzrb6bwQ3J4vjvvpUlLxFhpRYL+ba6r/pvlJ/9T3ltjvq
+xAggQiW9U9DJRyP/y9P9eAbA15gJbMNFI2/8YrOZ5YlVO/mw/4K2JGL7NtUPSkeG+jXDNp7okGaC
+rD4txXrR5BL8hb+QR8gcAmRW+ddoqLZB5UBU7yt1zHPp85RSHXuXoS1uKjxffnCdqIBkeL4YWaYL
+bN5VvGRNTPVgJ9/wNHOpQAPiX6agHHyhbiyZWfOt9QDkiG8s3bAd0LqGGMKhZw29F8+VeM8Qiit/
+46/VoVkBYchs0jrW1dfRPqni1fNLli9n4wSUjdX2nIYu+O7bv6pDm/W8ihOYiT8htRvwaib+hEaQ
+ev7Xd25LM0N8vrzwWOaAfBfzQeB7LjYBT+It/lTjtXK5BCa8FNJetjyEO1Q728Ujaj6Owvs5ub6+
++20XadWjbXNF9+cSfv5yyKrskTffU/5b3ov/8MZguz9lJf9C+N1zdYzWD//IH0/448vtd3/6cqqq
+6+Nvf/v6+jp9nU+L8um3yWw2Ezn/9GUideGgeMl/qWsmfkv5b+II4Kj+BuKPqvI5/9OXX/5R7EiY
+CLvhz35K5nxg4FDxe1Q+Q84f5i/5pTgc+NMD/+0Pi0mcnBYv8e8XL1H8g6XRkv8Un9I/Ln+wZDL/
+4wqi+UT+eYkS/nhzitIff1hO08laApNp+sf1DyFG/syFiMc/2GYSn+KXZB9NZ8k0WUfTmIfWSTSd
+T1f858005o8WdcpqOv/9nAPTdDKLYv445f/Gf5zvZ+KZ/HXCH5zEg1jIWW+iZJLwv+I3wZVO+CP+
+b8IhUsok5vaait3iL+mJi0p/P3/ZnOIZr4JQdXFa17LbsrjwKLY0uPXJkRQo9ZCCxU+/T7scbeKP
+P3357S//KLruly9fJ/zbdcI/Qcv8mmeVZs2SOZTnWtVbMvptKK0bsmYyVbIee6Wa10Ew2aorUzhs
+l1cLSG6NvI2S1mP3ZiBKt5ouVf7dPtGmYU86h3XlDVqKCLRuTXnQl/nQ/F3zXCvxZ/LZ76qo7j8V
+bxxWE/j8/90bKd+1eD5dxOvJarpON9l0uUnF3zo0mUw3q/VEfG+u+f+bZ/zXzXLDX8DpZraezKfr
+uci1En9rRMRzbSazBhE1CFVCJCSgRf1LzH+OQarEc8YLw3ql5dQL62qvqdpyH6PWh1TMpEM2l9Mt
+4sVikVO/wvTpkCT9/A8xya6nzXEfiIX9O3uG6nyF/M9321xarijVFgWmqKC//hirzXpa8yCJHMyG
+bCd0CIrFvs9fhx2a4ZBknO6jVQebePrwlmCzq+Qi0H8XzObvcpad4c8PjlQ+eubOxGt2u4mpVifg
+xt3Q/uRMFtzSn/UDo0gd9bekvbJ3cpWaez//hhTVEsWTrMwzZK7WPPzhsBF/ED+muQTNto1d85bD
+sVqldRne1gmBulYKAZvWCsFkq6GgtvX+6p6u1q5jvB4QL44nthorbBly83Am/rQDz3q93tqM2q++
+Io2l91zCr4OVaIZNhdpBplBkO95jsUFh9tMdmTGdH36OxFH1fDT9+mvCcWsnLmlhcw4T86wrt8hF
+i11w3NKJ62DqpM7/NzqH/AR6yIbvY/UTjmtSVgwk8vSFllbRmC7fJoW2Jg0tY8dryPa47j2XCye1
+vbKu4O6jq8i6OTl1jYTmUWUly1yKsOmf/Snff+cRZhc/zMQf/bgr68A3ZRIfl9bEJO5lNB3wOuBw
+LjkJJ28I3ZtnmilLuVwtpNwqgauiuPL22Z8986XefP9QH48ylacMaRt/1uKPuTLBPh1pJqP8Fu5Y
+76WdoyW5S3XOVh6l0YV065pmxldxWcdANS/F8vo2stLkM6i9Eh/lT/kh0JxDPk47jmimckRr7Yt0
+Pllxm5+Ih5RvWYtHWoiTbf+bn0paTufz+SROJ/yfeLpcriAWTyLx70LQM/9SPxaqzCdrkeYhTMyj
+8DMonqLnt3tHdLcbfLRbWBrCY9bsrnEf3NsJnExF6x/K4iql4zt8pIOnl+ssihWHDIxyHEFns8Mw
+9PEsPmnr5YHmUdfKkp7uMy2daZur5rVbda7t8S/Ewg629jZ0W/uWjlXXx6Zyq4WxIHbr3l/gEdt7
+YD2S+rR9Nr6yR2zrWbsHQXwdJFa+53Aw18ljjYXh56Mm9uSbu1hxkVIhri4Rjz+Np/NM58Uke2gP
+45qPPIwrmfnCE/00XmRe7aOHdPK307/TQZYiNiJ0e3fFNPVDAFN1Cy6ymfjjiiKsnL9UB2OvJf20
+o3qisSqeniC/k8Z+fXlp0+rCKUymc7FEBx3ol21Upy4b3MwO+dPX/nlUcGctPtv5cNN5OevCBucR
+zmpVprwlIbvexMlPZrGyVHdWe0vpX2ruKFmocYH4TSOlZ5MlH4zTzWQ1XcR8ZJ7GfHxOIFpM0/WE
+/7ORTPEaltHSO79hcMJyWAitPfVOt9at9R/P7CpmDeSZXaHLJkICQudF4UNhNLXvB617st6GTtFC
+QttznepHJ17mXVkFLH8ENLeEOmKT1Vf3R1jj4ltXJU7fndQr3PCKxtM1T7RXJoduI6t9xfWunnaK
+Npm4uExMvFfv3b6vzcb+GPLm5D+c2VnG4u2xMTvxB82kbSnVCSFBwDSwqpwih6Ytl8utb6WcW8Kk
+c5X2lUNGrrZWUVm8Tsv8/z6fS+mktfU1vmVxHnnC1wcuyfLn1pTQt9JPzS1/PknTW5WVjrMOjHyi
+wy43pRdWqxUJL9RVVhQNq7dmJuNbXyygFhc35Yc/ZOV3sWlqctVEqdcrYEuBm8U29gnE9jH3Tjoa
+2S2kS+/u+vGtFtGztAfb3tUlVYk9jYblETEx/fR+T+Bt1IKLdZ/UKdYXLmcf3Fam0g0fuTrAUpxy
++D6SyXGsv4Ucdj2Bmb3bid0sPBrWTthCO9P8kJEdj+dSkiB0351W49Tzuswm3ZYz0u1VQxQKdMTq
+6yBhevCtnFIzSIr0kYaQ4LtsiemWBWq92J+HlDoXWaXN2SdrUoGCyDW+CR1LGF2tHGN9j35zqG+4
+o5ABtrtwClG+qZS7Id0BZneem/hDex2dwuSVO+ZtP/WlLNhXFSan1z56zcqLsuK/nq8jOgz8w9qx
+4Uq4Pnk0dxvX7Uqxh07SDvfQMl8RikSXjOXe6xocO71kZvXOjTTNEER+eWa+PeUSVG95uGtDlLGX
+KC/FfpBfxq+AoOyjVQvpj/3QLl4yN9G6DkFpZPFBZ9rux2njxMbKrKMh2iwydOJB072/JdcLPLi6
+r1Nc2bsTqetDtU2A4gHhklmzcMcxUw6rMXPXcwHaTqdQi073BeN5cnkGWOft7W1RKL7ePRRiVrRx
+O1Y2Plm+qymkcUj+RTfmyqT22AzEVPTtgugXhTKjLpmWx/YH6wx1x6ynGqDLtUg8TK8OvdPYDvlS
+6AU4L8iQkTFvy3ZFjNmWtbyam7wpbNwn0oQE+9a1aH7oTocjf5s39o20a3skH6l5PQ2rHAWnGChy
+GFIjSJ7CKQU9+FJP1E+S5Ov2Mxai6cS6uqVQDL6O65du8vwZ90EzvZltNj9RZTSuqHebc+8pVx5B
++q5mx0l2nvxT1n0k3/GDwZRrr+T4PbQnlshdYfrlR/blTEaZHSfjP4XPlav+aHOc8ujKpJ3M0Jww
+ntob+lv4F5MtD8+IR0tnP9X3kklK5cGFOF+6/efZ+wCX5VzwsPS0pxItd+34ly4zZ9fq/dtLBs/5
+t2bWwnmn2VyJMFpvkhhRR+prbqus7hVVLHU1SMK0XWDWf2CsrGMjQT2yJnyUm+WMHK7HhSe878hJ
+ZZr/x9GKxf6lzZL35S4rkUV78U788Y1PTd72v/jZlw0mCx0+8yueS117Rb1xSM4H2Oum37Q1AuZM
+n/0d3upQOzxkltT+LmsbBDvsFrkHbW5t52pPN6KLtRcvI8vgkmw2P26de1p7SlBbgucaQL3qTJsL
+/0KXZB1n4o9xTVe3UK5f4DHzDeV0TVSq0RxP7VOXA6dsDilWjSjQPunWvnQbqR6Tz+gH7am9r083
+CmyZp7JnedhFW0tPtLhoVvfoNRG3h7ZvWIo4GpHenC8tXpXj+Um7fVc5+aQZW4zrhtdrwplnopDJ
+Se7m5n79fLkbh5f50dxXGRdG2XA+6nvvP5IgOD8gOZFn9cfkh4/xrCsSIwWY95TXD+fYwwX2MB1+
+Bkyds3P7zZkAtI1Soat/a9H6fdfxcbnZI8YmjzrxrZiSwqY7sZxCkBkf/+IlrI7FNND4VKoRzJfO
+g4sVqc39yMY+5sUmMfxEunLRz/iSl5pQUM+vTmo/44rsU5vfuj1f0ddX1bw7h+ZWZeyKRDjrze44
+WwSkyJc27P5C5y6JcxYicVoEGg0ETnPEYi9TYH0WxYG78cBBXzKjOyaJiTGJKmainGeiEfB9r0fK
+vl2daUzQQyzUKbDAcj9jns6e1vRJ1yePuiPl3XOkPmFiAlfdQSXZatdqNCPrtO0SkiX1+YJbyjwZ
+ms1uxq5Kr5Z5WRblDdtolLu3TojP28/YMNIsITVYM4rCk531VpinNu6zq/xe+mHS5vvimt/4YPjx
+obYTZY1w2J0S1oFJyktlfr08X5pTgJECOe7oX+XQNFLnFZCtb2JjwL9djUMk1aeUe8/U+LeZWXNO
+YieEV1lXu/k9Ml8kdAYNzXrzL3ZGDXXhWVFLKrIeZT0zaI583ZSDeWyybQYBCVOxwPX1YkyY0UTU
+TS7Pnw4csucwUUNwHVEPIwtQEeNvLE7UWAXdqVib/V09yXFrbaJZf/21KvpjlyxAbGwl/DShWtv8
+q9xUVk7b+Kmn0dy7pnpS68HxvLQO2TTXiIXSy26lRtwcBj/6fPQhapW5depSe3mXMem0UO80Rg90
+d2gh5ikDahgHIRJW9miXto1zSup2QYt6VdvwF0INWpixQODv/u63f/8b7uGfy33+B/428vL+7V//
+5Xe9qOn+duOFXf/+t/8PhsWCq0Y2AgA=
+`
+
+//swaggerUIBundleJS gzip压缩后base64编码的 swagger-ui-bundle.js
+const swaggerUIBundleJS = `
+H4sIAAAAAAACA7w7a3PbOJLf71fITFYhIpiy5MnDVBhtNnFqU7WTpMZJTdXanixNQRITitSAkB2f
+xf9+3XiQ4EOOndm6rdmIBNCNfnejCQ8f7/XeZryXxBFLc9aL03nGV6GIs7S3TlgIQzmD/1+FiwXj
++5t4/2KTzhLmfc29f717ffz+5NgT30Xv8fB/9uabNEJAl1FBbpzs4iuLhBME4nrNsnmPfV9nXOT9
+fmtmlc02CZuqH0+vC4RLfMfgrBbP2DxOWb+vfr1wNZuqR/f0HPb1d+071b/eieLl87t/SE7kPqxr
+tHDFMs6pW/JFbjgTG566Lgle3lyGvMeCm9Gzo6fPfMkzDDoblJjgMVAwEd774DKLZ72DCS7mwfAP
+9/SPs6vzx8T9Gl6GecTjtdjOQhFuLy/UGxnGK5oGw/4D9+xqQFxYvp2Q6XBBs2B4erY5gP/t48/o
+Lf777K18OYKXsZqBnzdnm7fHb9+eDxeAK1S7+ucD4g8XKxoHp47nUGfonEsCK60RyZKgeeCKgG23
+jkOFx9k6CSPmppYcpH6VKHonwGu68OY8W71ehvx1NmOuIAUhpATNqOMQD9atXDKJ5+5eThSwE15k
+G+FfJGH6zcEZmxaNP/bidMa+f5i77PTgnLzcHxVuTjSCXi4FmwS5BzYbLd1QbZDU5zdBArATPcY9
+wXLhbsi0tr+fFwV9cvjs6IlUJuUtdXJv5gp6828f1f+xIBJ3GnB3fDg6GBFQEPdSNyUgcu4+HY3G
+TwhIG8dCAkLl7mj0/PmY0ESO5YRuYOzo2dODp4RGcmxD6Bphn42PfiF0Do+/HB4eHZKJEUxvaYlm
+GXS4x8n16iJLwMly+dCc8GLBeCgyPu2QtXGYwu+YBJe7ZTvmRVkKgtpEgDsIgnJ8zzx7a56JDMGm
+hja/3JAiX0XJ5UyZGKrSxZiUizCNcKUgRCx5dtVL2VXvE0Afc55x13kdpmkmelGYJL2wFyVhnvdC
++K9XUmxhXynsEOhc5ZYHE/5CeAlLF2I54YOBcoQ0EKf8fJJ6LN2sQGgXEBXsl+12b0RT5HseLzZq
+fu+AOpdhsmFOnPbSft9NvSseCz1H6AcZmzwVsD7ybM24uAZyUu8bu6YpKSoqF9oKS+WAHHpsugsD
+GKbc2Oe0ItEHemz68N3QA88FBL5TcR4ASLXvpZKOkozeDYjLQUHoW3pkwcSHq9TsrzScG7ndtgbR
+CBQMyHIeJ2CNbuX2VVjpRPGGqRgJikMaLV1gvKHcW2/ypReu18m1y1GaxuMr7q7Roo3mRTCaiBch
+XwCaFDKDtgBhLIAH6SZJ9oJyBQhrar/4N8VE/G08vYTtQL0eID4OIQzVONKKhOUYFv0fcJZ3ajhm
+IDj6I1CXA36gZQchu03nR9LmIG0k3gjUMpcLK0RcGM3nTHw03v5hPq3QW6OdMcb78kWGiS9ftttO
+KAwT9KIWLK5q+eiqm4bttjN7WRsGAryAKmQV8mN7+Z6ApKjLiz0TAYUdF6vRWnwFv1F1AERGZkWw
+39iccQahTYcxrDd6yzBPH4neBWOQ/dJYxGES52zW2+/lG1CMS2orMOSxmUMmpWpgQ18UaL/fA7DP
+kpUvjYDSIX80d6AQUUy5v2MBRHRmF3EBxu7O5MBQeTtmgAe5jXwC65zKxfDgcx9GLDv0kEWphilG
+K9/y5hPDkvHoFGJ5CgSW0ZyA6F0WSN5P08HgnH4nBGj+bioEPmnb9KuyFgqaweHlqN9XqoRdqlAw
+suPCCOMC5W3YcSfs2IYdS9g0+OYiX1BSsNIRZBC+dhE1hX/AIw3pmccuGb+G0qPi4VvNzktVcmmM
+Ti6rtkofXIvDPaBzL86Ji6mPMvhHBv1XnIfXMC5/Mco0Vp8oalEdMDnpTNHvUshPwDyw4WNNDiyB
+UUuMPTiAKIr8njPgkAVR+K9rpWnJmCjLcCgTZIEuCD1WTxdgPzr+y/dSrhC6ZECnKc0mLfvXtUaX
+G/f72uRFd+Fxgi6J7HCW57K42OSix2KxhOELJh0G2bMrkQmraiETrSLOQgGVc78vqkl6YxVVvs7v
+zM7gzexeFIQCDh3FQCsM0iJEtjQ4vUHBO/ky2ySz19lqnaUgmM9rOHwwhyrUraPAz5u/FvLeK6kI
+byP3+SB9GhSGQ2v5yKhTm3MIlFUNoBMBPxoqx2fweac+CeVdcU6h4Fq53JIf1OKZHKMZyKVw117J
+OZlI2fY+BK9lHf9V1vFHh1CkvZO1+FdC32MB/uTp0TNCP8mx9wAla8uPoHLB0lne+2DrCExTxWjP
+8yrbo7FLlD06EOJ+hRNS8j5cgdQhMMF5BiSZwAJGTKBzHgxljo5RF/nQwdhozlLDP7zHD87s+bPh
+EM9XfjeiyPCbD/NoyVZhN75q2ZlZZ/AqdZMGF5C5TuQ6xcVNwsRNDj59whKGksghBQWVno3PCaj5
+0tmbknpM5LKsgDMeSg/RAPZSS2Dt8hWNHFDS+hYpVbT6IeXsz03M2cyPaQqy9XMa50Cjn0iQj6FY
++hs6i3Pg+hqF70c0TqNkM2O/sXD2IU2u/bkZ+R38i8mhZY0HZTCzgLmO8lqpSofQFQ7JSGZGFjjy
+EU68wOUl06MT4K53GZjMSa+DsN8PZdpzHj7kbO7o43G/fw0Vcq72tk3GvQY97IVqPiznS2XgyRjm
+TW5b67BynDC0RAj76zB1IKqgDUspOCtE3ZP/7otYJMwp6F3BFMCXLwJ8AcCi7RZsvQUcrxYAm/PI
+Bwd7MgJfWrJ4sRS+Mz5Yf3foVTwD5agXsAUt5Qs4HsT5h1cnhy4phTRjEGYjQI71Tn4V44k/Ccp4
+lEwTf2/vml5acsUwgDHlkl6SmyjMmZG+v0NGM5qBndu8aoDCDls3llXVDdQYpLbCimT/ojTJO1ke
+igLpDdGudpK7apGr1tepvTuJlh/dlUqIReEmEbsoXCgKOwja5eN3ow/jRlFgUPpIHUSLWTlHU1Pw
+Ecy8cwnxUOoKiNa2/eTCOQkyXmuBIqRruh5+cIUyDnuNpBqnVEVTCzrWsLIFHLjIsqTiqxyBmiLE
+ULkGI8NBOOteMI7SqA+UlgjMenBSqBHT1GGJvaVKM1PA+erJ0/HhnZpgc6sJphtetSbY+Pl4PG40
+wXRzK4HH55Bcf5FtMJhOZBPMPToY/zJWbbDxsycHv5iMOy8zbl4l8VrulWWcSr/4KM2uzFdVyvnM
+E8hZsoPbTlqsK2lByeduwJJc5m04ZFiIcf/2kiySXXLiiUz1QF1MZZMygykr4kXAZGcmnmHXDTb3
+0wJ4wyLZlDPBDaD1TTCvUQqb1WDLU2Q6dZZCrHN/OCwXeLpL78VZNejAfsXn9yev3h5/KbP873GS
+/MYiBrlJVl1Y8DUIF23CgRdREs6ErLzcn6Cd3512XnQVB4rCmq5URJZUu1jywtEk8t6vlAUoMXs2
+Nebs1PpaANWzLHSIrninWMH7FhbgF5Ivol/+vht99xKAJdP8x+l1nmSh2OeYJiGStwCg6LoRUF8y
+SKJfVPcaAkjiO2kGR+YUTh5pxrG1wBl36BIe/f88vOEFlI0Xm8UUqAge3rA0giT++bd3pTc1KS3+
+0957qVK5RHcPRDSEBOFAoIlT1isF1bsIZwuGWZ/4KOaiUL6+/LGvl55OqO1IILcZBNG9EWV4PoMH
+wGkwvIlnv2abFA+QqshgAZ7m3q3CBYMzWZYidCAjQ8PGDdoDjI64UmLvXKr31StBVpaR4uudfBGq
+QAmL584atKFcWJSLO1Mu7ky5kJRLGorKAU0RX2lXwrQtWtV8UufynAwqtl1IEbULDO2rzrW0HmsI
+XgtjMQV9/vRgdHSndLViNJcPC2YnLpWQMkxIR0fjZ9bXlrDsDagOSHmyNB+lSNlHNF+p4GBpfViA
+gzcrPyy8SOXHBVTuKT8PsKfQaELtj6oGTtz8SmEQ7Y8m/CV+s9jfJ3sYT4XER/AjDJQaMZzqOB1Z
+rSBpUlX3QncuPqfLEL90znpYZvZgn57qAvQeOQM2cB45qmeSBNb3zxInkz2CsqcJ4Q68r9DOKMIF
+xrEAPyDieygEzwNTAsYpGOE/P/36r3L+aglVCETBCAqVBfseDM/ywbCOipkn9VmywsrU73bbRs/0
+s1gl2y2rJhBF2cm2dAqu8Elv1/VxrEaO3As9vAJfdIB3Qsv9aWPnV8BF0NmjNskVV+QuOWXngeje
+voHjFgyd2+ddsLo/BWE5XqRuAxPV4beTknyXGLTC3JptkBZJrzEX7GRH0+w6MmU4lDUQhLNZG0H5
+4QebYpoVuQpYSYMuW8SwMOXSrwRUtf7pOVSzrHyfiCD28mU8x8KI7I/AF0I3A+X1+5n8GoXxoFsL
+niQ8yLyvWZy6Ts/pEiVnq+yS/X8wkunoAp5s8QRMyU5grtmayC5TjtzpUJNjqPkLHC5ait5ltgpd
+23mWYb7LVCS1uPOgIaQBUlPGbFzA5FDLCN+V0WSXIVrxpoO9EgUEodtsudzHbRryopOIHTS0pbPo
+JKAl42r/xvYie5VGy4yrM46NQd1/MQh06AP7E2rsYhMnM6k6AHWNF5w6L8BXcc1UqsWHaO681BHd
+phUQOS+GuBamz7UJNRVU28OmDdtoVXgx102cSZ1mE8VEcHpeZW35nZ1Iu7K+QrmcyN48+jQfPAqc
+RwPMuoNHzqPKwytLBzoLl+jbJ/dOoJCmfwfjzK4CqGRV6uAAKEtcnebK00KZRSsgVj3LSwp1DKx8
+rJJmhY1Vz7vypJR6lzFjdZG4NzrN+XhOkfJXtZsq9pTMIW+Uubms7CKW58rYPkH976pEUr3jF+i6
++i2M7WtMwY08+FhY/gnvaN46ZCro17mKHngvwVRjGAN18OJNjcg5dfgKysMXXpNKgs7jF6lLH2zI
+fi2Dbtfoi5YErBjtyeZr0GIPv3N0CMmw2SGnugFgB7pWdp6Kc1nFYvDUSE4283n8naHrZFDjhkFq
+atzsRTjJoMblOvkNnH1nkJ5m55VwLR8xblknuGUJXaamnXiWfdIya8XNamoXz0bcsukuwFPMV+Oy
+qtfX9sw4ptay8WMaCPkq5PIzeDq1yzf8Ki1PGDSbqM/qfOrywOkvWZLE64kDojsEhM+J72LPrNzB
+PKrgEXYQD3Gs9ErZ7GRY+i+zHO/ViEFgRgeOPxyCAdbn8BnH1qEypEHgDCH9yXcc/3PD+LWemOKE
+HMCZOQ8XeFDTkw9w0oxJs4sbBay+RTUcA6+/AnoPTrmJ6g3ujx7LkXmSgaWl2COs2ApfHMAesjzZ
+XMBJzg0J7q9fDmhGBnwQFxN5TNa+Uh2mlNbyQOxnNOmKCxgBmJS0ujSI9yPD/f/FC5Jnw7PhMC6N
+NZWepqQZpKejc4Dkho709ODc6IpQt4bPezwl7jRwz6bbB9uz4fYhIYCWSHSogPsgOxvW0dm4UGn3
+wjUtcdURSSXfB9MDiehhicAYgsQhP7aq+2KJtp8bfRFTvXZK6uwBISXWyQbQ6tUlVGkBG9jEEEYZ
+FKcJZIdd5iCp0C6gHgL1Y30Fvbq6OvPkB0+Dju40rQhN1UYaDTRCQiPzoTwwVUdP76XG4bQ+1YAV
+N2CpYNGkGsgHUKHHbkTzxqDcf13ur314rXw40T6clD68Vj5sdEDXsjICetekRac9KqmU7xWNEKei
+Qeyuab4flTbRoA4EsS4kZWVckHqfy2hRjU4UFfNOKuYNKuYdVMx/QMVcUWGio9aOpGUZJLX4qGlZ
+ml1flKQsB1Fta8QZtY0ikkqZWfErf4nxaxZEBroe7fLhGK8rulGFvIqOchLC26zBVSHv1vjOKp7N
+Etadb26Lh7vzEOQgzEUg2Sr50KyVhzCc1oL0y0aQrjMZaiZZF5OhZjK2mAwrJpt8dVzYKdNrlQq1
+KcnLpTsYBSYtHllJO369QTdM0Q2xclc7KHJUKU+j3WW8rCa+fPmazzbRtzeb1er6TRbhwUHeH1UF
+oAx4bCbrGVOxZ/M5HP2ggjLdoX9gZc246nbplzY4s99qmJh+6Kra8R6BhWTHUdDC3DrIflCb2Bm1
+tnnr4NkEqG+lSW11AxpFZhv8tDxzAohLzjvOhHAavaUJpyWrlso2lFHyursFt951qfSmvD7qn54X
+1kV12S/u9+vYardNi+aV1NoBVBDRdQBVnWO8nAcUr9Utr6pdPdeIzDv27FV1b93NZ4WCs4UWKIeB
+eNu4jgZBwbpPFdh30/Cwx1WLeNkh62VQ6xzazNbbVyPwy9YVbNMrNwszKRPrxhkIQOOvNYJa10YF
+FItSbBmKzTqH4N8cdF8VLFQ8p6td9w/L2/FM76EuIW63+FCdc9gqjBP0dfMo1C/lxj3n6oKeaDSR
+UdItgToSFu911FcfS9Q7bF3CtECq42LHNgghMl93zG5DcFsYMXCFG+FlqL8myJzxyzhiptnBUdFL
+cGP1Ys8K+81aKMzTT8peQ7elf2JtvkMUFkUt8H9q+naA6m3vqECrt2VLQR92NS5zbYrp21ACXgXj
+5n5R+WFej0PkWA01KFSYQl1JmkPNfJFl31pQUEV7ZrIbVIbIBQ9XLdhyRgKy7+sk42wIQ7mENreM
+Or5jfUuzKyi6FM89vH/TE1lvncWp6GkCYACvFjNS3MuYsWptaEJa9OV/06KNCsBeMQABImXY5kWY
+p9uN/acMW2NuG/avevNdZYKa/m/7Q7dha5NtQt/DgrUWNdX3s8Qu2DzbpLMoyTazFnA11QX9k1as
+wbUVtwRxT6P+e52oFvBtFZhqofygZKtsn1jfpyzbcO1eq2mqyxaS8q/rv+hf6mqccqN1sslPoALB
+Vno5I8ztOWtelI8/6UzrZZaytit9xOH3at8dPqGIaUHeDlRH7ZL75XmB94UGyqkM31MHjsSOQwa3
+EfXj5F87QyiFXvxFheJNI6lNeJCnGKkCR53jpZaRxChLPut5pW0z+htLQnkRu5rCU9/6I8gm/h6o
+K6PqryOvrvAKjFnwiUMdA6fDEzDvJf51J/dmDK87fWQ8Avc5xrtP+F1MTik0Cqm+yfCHKwPDVHYW
+yXQYwzLY47Y1ruxF6bVNDgyIbFRa84Bwjdd0Zoq7mphE7VXNqsEuwYnW0E5Biu7xhnSF/dYtWNEx
+uFPUonv8J50WpNB22c+2+HZYuS3TLgQ7SjMAq10I6JSgMv+WIhrDpc63W7fCHshUhJ2tAaPd6+Wf
+IrN7xYsy5MiLnVXjtB+u1pPhgjp9h9wxVNS/wVo9CZf8WDLyO6m+SiaNqr5GGRnEmPJaYGl5ZTOw
+huDEctluMPDGBszvxn8bADXzrWDUHY7aZAXYacpN2Ma0hG6or8VM50ezUm+K6Ga4ok5TiXV+74Cz
+Htuo83DUibJbb3fA3xkP23R3yLwDuTPEPipknmXIXwm3umAnWwbYHZRXXA4ojLQk3qmajs9N1t9a
+/W08Hi5i8JU/N5mYOMSeeapm0J3qE8/UxIPDo/rE4Ws1kTQwHR6r8QWOTwS/Lv9akrXv6ZIiCvVx
+0LheYXL2VbvT94Me544OZkGPg/9j7k27HDmvM8G/kgXRRYAZicK+JcE0VrFkklVmFUlLWalkIBAA
+ojIQgYoIZCYqA31EUgsleWm3LattyW2pm5IoWaRIrVwk6sN870PNOT4mPV+yhypSmvb8h3nuvRGB
+ABJZLEp295yqfBHvvt7nvfe+26XdxtanaJHtknJM95j08bFPH218XKMdf5eUBuz3J2A/II9jus3k
+ONu9cVzuwq1VvyQp3DhuNG4cN4v4w2+LArVL+Kvgo4uPboVuRMm1smyW2NJmS4cvTsl1Cmy22OzA
+zJfZI18usFlis8xmQzzabNKFK/kKe1c4k3ylwWaLzQ47NbJs5tnSLbJZJkuhkmWTkyzmKLFiPsuW
+YonNKpklcapQ/sU2F6zYoWyKXbF0czBLObaUCuRTKnXYpCRLZY5faufZpPxLHTFLbHLQDgftclFK
+3Rab5FTOZtjMkUeZy1gutNnSoETKTapDucURy1yscrfAJnt3yaUiF9RUskU22SlXYLNCZkG8i2xp
+iKXJ/s2SWKi5q5kCWar5KpvsVMywyW1fLVFRqlLdaoV9KkWxtNik0ler7FHl7qg2Kmxy6atN9mnm
+2CyJE+cl2beooaptTqrNLm2+aqfa4Xy7HLtL340MZ9vINNikbBvcjo0sZ9vgajdynG0jzz75HJt5
+NotsltjkoFznRrHKEYotNqk4jTJ3TIMHakNq26i02eTCNapcIKlug6vbkOo2uLoNrm6jyfk2JT5X
+usGVbrQ5UEdMTqpLvk2pYTPTYpNq2OQaNqWGTa5hU2rY5Bo2uYZNrmEzL9G5Wk3uyibXqlmUby57
+kzu0WWazwvGkhk2msqbQV5M7tFnNiaXIJqdb5VBVTrfaYZML2uCkGgU2eeg0Gxy0IQly/Ztc85bU
+s8X1bGXYv8UVbQlJtLiiLaloi+vT4vq0ZFC2ipRyq8RRuD4tHpktqUmLR2ZLatLimrSkJi3uq5b0
+VYv7pyX90+Lytbh/Wm2qV4v7p8X90+qKSY3dltK3ufRtKX2bS9+W0rfzDTYpqXaBkmoXmcjaDEBt
+6Yc2l7st0Ndm0GtL8dtVDlYVH4aCdjMvliabnHKLSbrdosCdDNNnh8dEh8dEh8dER1CgU+BQDI2d
+CntXCmyW2ayw2WCTEu9UucCdKntXq2KhMnYakhN3d4fRqtPgUNzbnUaTTQazDhNDh4mhw43d4aJ3
+pOidFhdBKtBusVOb8uhmKF5Xit4tlNnkMnRL1OhdmRa61M1ZuY0rm8k1yMx3ySyKU7HIZkMsbTKp
+0WGyR6nEZoe9yxk2i2ypcKhKh8yGpNXiKK0ym5xUWzy6nG+Xip/NFSpsNsRCwXJSlhx1PUz2lxLl
+uES5kvhX2KciPhX2qYoPNSLMnFiKbFbEQgXMtdi/Jf5czFxL/NucZ5steRqhMHNiKbIpPkRM2XyF
+c8sTnGfzUmmeV2FKsC4VqsADLVuifoDZZQsN3mypIj6ED9mSJFDqsKUrPl2qYlm6q0zUA5Mbv5yl
+9i7nxCfPloJYimyRNipzpmXpsDJ3WFlKXabBlS23y2xSqIokViH+IluRUBUa8jDLbCEkgEkFrDTF
+n6tblQJWs9S4Vem9aonqXi2LhaaFbFXSrNJ4z1YlgWoLGJJtSAKNLLV+Q8rRKFKcBhFKVlA+y5id
+bRbEUqBkAMdsaWTY7LBJbdts8rhoEoeRbUn6rRzFbzHbgB8K1pKB1SI+KtuSArZ4YLU6VbYQNwaT
+W5AxDSan2aXCtiXldpMS64ily0OlK0Olm6WUu1KnboF9CuLDg70rzdUtltmsstlkU7x5vHS5fF0p
+X5dYEpglsbTYpJp3ZUR3W+zfEv8W+wvldYn3gik+bc6mIz5czW5XEuhyAl0JRkxYLkOTH0y+zK8q
+l/nRhJYDirBZZpMaM5fN5tksslkVpzaZxGvBLLFZYVNi5MS7yxaavGC2xEI5ZrnL8cM+ND/kshXO
+hMZnTroX9EwerbxYimwpiaXDwTpNsbAPTUwwKRXpxFw7V2SzzCaVqC1ptUvsRFwsoEGcaMLJtRti
+aYilIhZKvt0Unyb7NMWnyT4t8WmxT0t8WuzTFp82+7TFh+bVXIf43XwmU2SzRCbz5/hhp3yTzRaZ
+BfGocqhqWywcvSE+hL55QV/8kE+WySvPvZDPSspZgvJ8liuYB02R2RULxylwmxXahK4FGfjVLjH1
+DZldGgWaahqFtlgICRtF8SkRkDVKWbHQZZGNEk1FMJtkFsSDRIdGiW+RbJSqFF8gssFSQ6OcJVDC
+D8Uv53JsIcqF2WQLdUSjzB2BnzKZLO80Kpksm3mxFNkMfBpsttiSy5EpxamU82RWOJ9Kky1dsXTL
+bDbZpHJWmQ4aVRpP4Hg5fpXYiUa1JJYytUC1UmALTfaNaovqUe2IP41XmCWxsE9X0uxSpQQvG8y+
+NxpSwEYhx2ZBLFSahuTWILkRZoPNDjsRRjYaxCjBLLLJgZpV9iaeo9GgaRImp9vmNm202UNK2ehw
+itIKDcIMsPI0ePBTYrMqFipyMys+WfZhOMSPWCpi4WDSWk2aYGFyPzSJ92g0y+LToeIIxYMTzJPZ
+FAtRFMwmW6g7ujIxdRs0F3UbZbG0ASLdpvhwQbsiK+CnzGabzS47UQt3RXzoNvMcOF8RS4vNDpmF
+DJtZNvNsFtgscdAme7Q5E+ZxQSicfZtYh26bGe1umzilbrsrPlz+jhS5Q9MnTE6tQ3gMoKbm7HaJ
+bwZci6XIlhIH4xmhKzMCfspsNsRCmXbb4tNmn7b4tFuX0q49dTRduVJvbV4C9AjzkSv3Bjem/b5O
+aff7A7KIMN3vsUUTy4AsAz2jszkgFYOI3PkSe3C/44dQN9OXaWSQocTyGkfvRankMpk+cCZHIhTM
+MiNcn01EyBW0XBStz82Enyybuli4wBWdzGqWnarqah10Hq/4ocT0LPnrOfbI59gjz07cW/jhOZCy
+zGV4Kiqq6hb/9MjsEW4XNSpdkSuCH52uwFWrbOo8x+XCKQ5tRrNSSRqYtRy5HoERzBKbNOv0sj02
+KdMeaRlgUpg8FRYm+eZ5Ls1r1Gp5nSfc/CDPZkUsVAuN/as0Y8DkmVgm8uxgEHY7dfmiy7qsFcqL
+iohVQFVRAbFIUmyyooi1WUUCCZgFNotskgwiII8fVgYVmmwpijYow2qgkuiEWNEjaowSa8FKHY7f
+qbAp6qBOm1VArOhhnMiUWctUbnAqZZadyjzLg1PJs45H9DbZKpvsU8mxT64oljKbVbG0Wf0jFuLC
+MpU2S3UVFmkrHVHWZPKs+BFlTZ71MHmWaasF1uwURfNDLB3mYVbjlDhKJVAJsaXJEZsSkVuuym1W
+bbEuiHEMP6LgYY8OJ9VhFYlIr42M6GlYESNl4HkAZplN1rNIuzcKrEgpsvKEW79Rlm/W8kjZGhVR
+wHCCUrYG92ejVRYL62SkcA0uXIOL1eBiCf7jR5QrrPGQYjEiwiyzyUoZKVazIJqWEpvszU3WLImi
+hb+lJE0eZU3RnDa5mZqi8GtySZrcTDI34IdVFZJ7i3NvMR7jhzUaBdFoFERJUmSzxNqRHJscXVql
+VRG1B+tIpCwt7rGWlKXFZWlJWVotUYhwim1OkVuoxS3UlhZqc+naUro2l64tpWtz6dpSujaXrs3N
+0uZytblcbW6WNher3WLdSUtUJG1OirMVFhI/rCgRRQxTRSfPSo28KCxY9dIplEXp0WGVA/s3xZ+1
+PB1Wo3S4BTrMtOKHygYxh00K1GV9YTfP2oe8uHTYZBWFKES7rEXpsrK6y7qUboVVH13WnHRFc8Ji
+DZhU1jfkmqw2yLNyoVgS3USVTdFElFgTUcqJpcBmWSysd+Cc8cM+FQnGCoMMy+6Zqug7iMmEbN8W
+Eb/LYnZOpO0Ci9liybOlmGOTpegyf5f5m1sNIrWI15RiJcPlr2TYUimyWWJRmipRFdmwmmOxOC8W
+4ughD5dFLGZLsShiMbWCcHbZBvGRMKmoDRGoGywJCnuDnwKLzQURmNlS4sI0ubBNESiZyMDjZ0WU
+brPczM3c7LLEnOMEWtSx2ZaIkq12jk3x6YjEzEK0yJAtliFbrMBokao2K4JPts3SM/gbsbBAKYJP
+hjTXEM9YCmRRO8dKvly7I4IRCx4ZZsbwwxbmDjJVlh5KIipQZ8AsiIXlhiqLElWWNCgTmCIBMIPP
+zCo6iVl+kQlyzNKTghQmB+VubVRaLCwI/1vhRKo54dJzzPkzGTWqNCwaou9oMNyDy+eUq8xTV1mC
+aeSE2c4z5018I8wWm8xll4Xl5iqwkhym8NqkAIBZZpNlgCaLBU3m6lvM1XckNgsPjS7n12V2mycx
+/DCHTWI/TGF5iWMUUQ4/xCR2cmLJdSO+8Gb9Umaryos6MrWX2CIrQCXWyZdFV1nmqaJakgUMZiZE
+osmwRJNpiE+DfRriw8UF9AvCs4+MRPx0GZUFb9mnJT4t9mmLDwvpmbb4tNmnUxTVKS9idWTdqtNm
+dJKlqm6OsUTUZ8zYZUXFkeXhlS2LiqTcIUslK4qvLJMuQ3aWp/1sVQij2maNllBWo8IWSa3BqTVF
+4dNkAGsK0bKmP9uSErS4BC0J1qJgDVlTww9ZKiJSVyifhkg4+GGL+FTFpysW6pJGoygSG6fWFJ8m
++XS7XB/8VKMuvly/snlTeYzN6/VEcqe2m9i8uZnYO8kq+fmNG+nUSX4ed0ooV+sJODwGBwmNrxs3
+tvZOMkopOw98UjsJ5fF1S910xi6xeXUzkUrduJHY1OfKo+uD1RKbj8Nhkz5RDLZtZmFPIaNsrjT3
+E5vXYUvMlXY9KbdYDzvHk2RQuDSV6YFF8RIp5WwguKaUR+qXkMextbV1qDvjoW65PXqbYGoNtyZH
+Pf+Mh+5saZ64a6Y20jK6munlhjm1OtT67HxUcCpF3awMRtNi33JU37Idb3Sku4hrjafeVDV9z1EP
+dVN3XMNypw4d1/Apl//jp/ECcGp5+1a2cmhWJla+pLJL0Z3mCzer+VJv6EqYnnaQ7VXVYt/RCxq7
+jIc91aiqt4e3JqWboYvuTAoIVFAddjk+0HJ9M68WdT0zWs1elzCD2zO30i9Vp4fDcZRwWcv0elZG
+jVJRzfxopudUXx3rjqGpVnAVsX+gO85sEt0e77uq1T80DjTbsceq5XECRrZX6mXVkprT2X6gOcfl
+cj97XJAMzGGvp3pZtV+JKqLm1cIgGzQH2dUDrdw/HIR2DXKYeluTxrFuHZYHbiajj1G+gTo2zBld
+e6Vas6i44ur3VAv/HH0y7ZmG5mvULQ7qoauONvI12z4wrKE2Ui1LN6Vqpj00XM/QXP9IV+m25dCX
++6nQKw88NaNJl5Ru3apWSr38sfhWMuotXes7WalFr3BUykCIdGQMDYxbuVxFK45cto5yPUcf5vVD
+aaKb5q1Sdlo9KvdiLZLXb3qLBhmX1cooss5G5eEkaq5eddCb2FHc3iirlrN61HhquX+7b4dWo6Br
+6M7QelzQ+hlV4jqHWlbPqOO87hsWDW4+ko7RbRoDfTGwXYwFtd/TPQ/NF4z8sJ2ObHp8wT0wp0fB
+iNO1g8O+p1WDdphozs2qlg9st7WcVtVzWtQo8KuIzTNuFarHt2YySGYDxy4YpbJUY4Zq5NTx+NiP
+ziChoL45MQcGulwzUGZXG9mm6rgjY+ISMbiGhv6MqFDP9MqZsjRSodhzitos6sf+8ejADS2uPupL
+81RmGTVTykuzD7UDJz/IREO0qh71Iovay/X6oWU4reSirpqYuUHUjV6+PwqCgd6nhYDWLfRZUb3d
+Dy16VQ9o00YCg8pACnpLO1Czk7Hmq5pGF9CB/lwMeUcz1ZmmYtz1TFU7GDhGXwUpmLZ20JsSZPk9
+OA2BXralgybMQ8M6MNG91JboTL6y0tccvW8As6hZdWtoWLpO5xnxjRQmjuGC+Ae23bd078h2DjBe
+DpEiH3USShrZnm66vqmOe7YzHBmW4Y9tJK3NNBNx0TUz1Ro69nTiTxDWHjrqZDTz6VJTus6N8gXO
+9KVnXfoe8mXooBgUbToG/Zim6vNthqBUe6z7R4ZpGup4hB9uoGy2VwhHWlY7yOlZ6cfsrVtHuaAb
+cy6PRxkTPdfLZAQW8323UMgHY2W0CFPIAWOrajRsQvdi8dZRISfhSwPndiX4xgCycwEkVA8cpC/f
+GsrTywfTzm2nVC3Idz+rajcDd31wOKsERI/GPSwXJc2B5hVy1QP5vnmrnAvSHJhH+WJA98Pc8XEh
+oKShvij/8CAP7NXj9Cbfx14lWxB3Y+wUs3lLkKl0BIlG8r156GQrVWmfg4lzlA3aFt+zYjn8npaz
+pUEciqLRfpwLIMo4rlSzEsaa3ZrlAuC3vWm5WpJ0Jj2vWi5L2SbDnpvpSzvcqt6c9QI6cUa3Dqsl
+KZtjH04rFUnfrS7qhXFaLBYkjFfK9IpBXofTW5VSMB4wv7uFjIyZ49GtYi5wvz1wsiX0y+nLf3f6
+8i9PX/7b0x/82enLXzl9+Xv8AfPZ05dfwXeMAn11ojoBHaiuawOKPJ3I0j3QvZ6KkdmzJghiwMXv
+2fqIqAo0qakTw1NNJkmM96nJ2CpUyMSsTQ3wFjzm9Slqa7hMmsegWL6OC9Q4tfpCOCNdNb0RogHH
+x2O7Z5iGboFE+1PaJoniYK5Wh3w9oj82PHfaMwCTvsD9kdHXffBWQE8Tszs+j72+4eia59vWDGym
+SwFiPABtXtS1gGKnfTr+AfjFFAE6p4sZ/XACVi18wommEY/wWdUHA/BMvntkDDzNBkj7iKAyTrg+
+Eh1ZNmbkGT5NfWBbSMIHJDGYezMfbeCpwCIkdmiqKl0niWoe2uYBXfuqywDOZxynPJPPyfG0chCQ
+7q1sNqDoIfBwHBLxcVHGX9G7PS5GJOweyWc1g3lZolX7vVsBrFd1r5ibCimDpMoSVhsWegdGSOBu
+xgs/p7mIJSgFVI9Pt+KGn7er8mnYGbVshGTl5eVzfOzdykp57bx2VBC6mLjuLCjD1JpOClLjoyEI
+UAs/zWDsz6qoxS0atOirKQaJag5UMHC6jS+ZQW+DlaK5oq+OfYwVc8Y8kXprqhLs6jzN6BitKk0s
+Np3owGDFWMYUgHENdmyMfkIXycCxnZkPzJYbbny6RXrCY2+gGs4RhiWmkmjaHhhOMDUNiFOzjCl8
+HcxMfdt2/MH0pnGsO/YxvhzkQKUf2mafDzT5I8NVeTz7NB/09Ynt8dcQE5XLXy49R+jT+ZbZESri
+06EspOIRwxOyNwdT+0AmJhNcjmMf6DSNWZrKkyfRhMNDlZgi15uhNcaqA+omgh0Tw4FGBInpZg/E
+gnqMDc2xXXvg+eBRVZeGMb5cl4YoUU/AtGIqox3DfdMAady0RxZC+kgMk6QBondtcypTIRMVWNsD
++ULXjfGF6R0UO14IIv6hKg3uhkPXkC8tqx4Ow8nGFBjWs2oAqoNRT5eBdjML+gy/xjLMTExPEZPi
+HC/4cRmE8JUvTzs4ksnkcHRreujP7L4NxAPIqL1pf6T28GE4A7ADNPpMwQnVw0hBz4RMDHMzoHv0
+Ij6GKrqIgFQXGLXRHLemGIqO2tddzcaHrfaPiNdxpq5LzAeyUifoI78nW4bJhUAUggzwVvfsIzA9
+JBox1hIXwWLCyJm5BEuaAXBRwbhoGMoQBxDL1GGnwCYYGspdM21vxC4Y89wpJItMaaT0dZOgakYf
+Np0axMfY1iCLgSwAcjTu+4bLM4d80Kjqo1B0k23Edfk0OlwaDHo4QjHWXNVEOvRAD2eqHxMDPgTR
+6Hq/B7YPdET5gwRBUOM+sJjYNZmBmJwMpEx0gYrhw1FlGmIujEh9CEpHKTGX9AcaZzFCi4JTNEA9
+Jm1KJ4JyedIC7cgF3b4xMVDCoeoTMlu9qenfnIzB/iEdkxg1aigT01QPQgHRimPYHqYg9ClaBQyi
+BoHMxQAYo6EcQgOQhnYwdvvgHR1vCIrBB8O+49s9OqyIMtOEa1HnTkA+Y1UDE2lohAxwMcEoUqbB
+dDUj3lHQBThmiDSjqg5PdMAOVzOnPd/VtalDTRechgTJjezJhNLBx5FngNckehYaxMcRzbSupwlq
+uNPJxGTheEYysa4RTRo8bKaY2y2aSQ/RStTCEEwsKSpqYvCVBkcEVjTFBlQrH5YduIDGhDxvHQOb
+Z/aBPVIhCL/znXc/+86P3nn93eff+cG7X3rnu6Aze6hC6gMIYNhBNB6qBxgioDawHj1IzgRjRh9E
+NzJ1D/ZAhlKnfYOeIVSn7sQG6aiH1Ewqy9Jj/Ri/0xH8/B4KbhKtgr56U/yKNE1USHIzKG0IejZC
+xga/ACaV6M3RqbPwy2MwFBwJ+6jNNVCTpzLNoeBwR48Ay0FWmHeGxB2BI2AuaUyI7IeiP/86zD15
+kGFC6R6/JPmpPpOZQ+GmE8JEjTgZnQQdQwN443fKQg3zLy4R5JDL3bfHDDz0zquLcoSaEIJdqseA
+UA3tRhfzoQa+XNBnBzMaqI5YK5RjYHgMPAMmBPq1abqk3yNOB+VkKoWgoyI/muloJhvSS2wo95DK
+hXSGdl/t92Gn++zIfYpiIj5GQW+KWZhgANCIuRDNMGJqZQGQriqi+CyX6US8Ht2m4I9mxDaCdF1+
+rgC/5Gz4N3VMkEj/JiZykI5/QKelwVMdoD2oXJAtVeNY5ZmRhC/8HlP9TNWlEuPXc+wefnWApqOB
+/jH/kIxOr9egHQGg6EormDtpvvRoQgV9WzzPWrrQF37RZMd+KGha+lTIH72rHqkQJPuWejjzGWWA
+3mjVIfUXYMwkyX+CziEUnaAdMOCYL8U0g1/AO9oBLCO6mX4PDf2Ifo9pBDMakLIg0HARuzo2+sy2
+gtxv49fQGT5GxgFmafw6wD7MxGhkal9iTYNfZ0SggkwJvwgdgGX4dYbUfy7EXX1M3C7B4IEP4mFl
+Hf9iPHs8POFvDAY0zj0bOYJ+gSmEwv6RatJVgfi1huh4/FI/Rfor/PbALEM41vsSHgMBf/4R3zvp
+0i+D5zFD+MyfAU2OpxaQZepN0X+3pzT/jPy333j7lV89+/Zbb7/59mtvvwG8eed77/yEUQdfAj/f
+f+eHsHz7nVfF+vq7nwUSfefdz/vvvvXOd/7lu4Cn74n1OQT7x3efJ/93n/Pf/RxA60X4fIkcIG19
+4fTlF1nCeoHFrh+cvvwW4AyVHOGnRzMGftBbwDY6rOWrA+afiJ8C+IOvwCxPPxjoBHcTjH70sMqs
+BrqBOFowsoA69Bf4BnWKiR+Apc50xwKewU6w5mCE+r0RwRRpUiziMIiGDvgHI5lZQ5Lc6LXQANd8
+OshvjOjHsGxfY3UJYxxDHDHZ+Jmy+tEAk0MMhGqMXWEowHvYA8zgBGaYhAjL+rYbQFYgDAZARc87
+U3f2iRgtoJZqMssxRCkYwwi6dJ5QAuGtbxMj7venYC8m+EGScJwS/+DrPM/6dMsopK1AbNMPWYql
+R/Uc4tVZpQpa1A6ID/d6tsnghBlviJkM0DDUQYTgx41DBioTHJ9JPAbogRgLwGogmeIH4AmbMcHE
+CijCEJ/5gcZoNB3S+DXGGIiesOWsjgTO+TdVmjX9mzZD3c0pOHvXP1CnAwImunfZ4R/kdwAyEW4d
+UgWBEXWHqR7NyFEfOOoBEIjLSYoxcqS2BjxNj6egyrHadzA5jgWzxuqBjmoKVOHHo5HFcrUuPzOC
+LUwExKaMKYINbu7IH09dfTr2gyUCiNz2TCUpe0prCAYkAEt+ZryigHiWfeRMb/sYBGA7Ah0ZpG56
+3wLAptJ4sR3m82x0+4HqTwbGbQI30qAR5s1cwwbkgRQ85oLwAy4V7JaP2FRbhj9igzCGSByfgE7o
+hyBJINDHxEJNh3mIcMGZTQ9mU8DgQCeOSD2gwqPk9sBgFavNmtYpShao1Zl7IhtPJ/Qy4E2EtCFs
+Oj7rQxABwEZQ6YHXsIVfQtLT21Nwly4DGGCxT5IAOIEJxBFPxViyfbkgl3QEng2bbqH1AIw6JjDf
+uwncOva9A/mxZ7anBsIQ6QxAUT44mZvEaRnMGBwaJuYq/DiYL/xAn3JoM1UdoqPQyIdTcO4uQSxR
+8RFxlxYBKkjePzZGhCEzTLIgkhlpH4792yrA3fXf/vnbb/3qGSDmD99+xQdk/gKg+crbr7/9C4LN
+F4F2r8H8ElleBex97t3n3n3eD6D0VbBvn2fLu5975w1YXyTL5yQogPbdZ5jP+xnA8vOEnASxPyKY
+9U9feOn0hbdOX3jl9IUXTl94+fRbfyZQ+sOF4uoHz/vvPfeV95795nvPPffesz9777nvvPfcj3zW
++Ppqf2oCWHXPolWfAcn9Y0ZVdA6A0yFeQuX+Aj6ijupt6uWeavSnhJmkema+kdhtW1TPAEp2BzGM
+RASjJRibeUDIPkBFl9BQVycAQ5LgwPMRBhLE+HzZCaBP5ZWaPnNqLK/Nxs4UsAcEgEkzMGEf8W0m
+Orxv99FvoF1MC/0pSufrPG/ofR3UwvdH+TrztfqEKHugGvAd6NR5xLGZxIfRt+2AcAnebJiEVUNj
+4JF5SN8mWFMyXZdhDmE4ZQJfwKCDMeuLLDCcappBPFpfF/0D6R4wWjHhsB5iSuYM49k3DtA0vOwC
+eQqc4chHVmiTm7p32wOc6eCVDjCoCcXGDGLUemCJaXlGP8aMZ2JAz4ivQl7E+tD3MUoFaRL8EGCO
+wtgkh5KJdKYQaXwSmFghiF4Dnw2wHEM4JWU90d/YBlPNMpcO4KJWsjgvyzgguDKsm7QaemCQeeQd
++qRsAU8GmFBJLgN5knQm5sznq0wErIBOt2+rPh8vBm9GCDxx1GPDF+4agveYlYxjmwAM/UXsjg2I
+Gqk9QS/Sl9gjQqsDoBRGCHynwx5hlEmaE5rMYGISIy2KA7mMrmwkQCKu7GCGPnJNlumYf3LHhOYu
+LRn5olB1hU+DGKIy96YzYCF91vm4U8rXPQJ+s9ISHBtprsgEJIK3Rl6eTZy3Z6PfYNoYpcQEkgtN
+zx5JIMzHgctzwNX63tSiFKbuaOpPmfs9RHMSRlHtDu0+WvXQNg9tgiTi8RgqAUjM5xkYh8Qiu2SC
+1kRPNlOB0f5tA8AEJPm//pQhBwgDhovZNTBrL/7LW4wvYON+RPKjBKDPV1mUfPcLACCK9oUYyHzr
+y8AZ2F87feHZ0xe+evrCN05feNE//fb3T7/91um3nz399g/x4Z++8tLpK2+dvvLK6SsvnL4KRPrx
+S6c/fuv0x6+c/viF0x8/69/5z1/87Rf+9M4L3/nt5/7TnTe+6qvUUSrLn31VAxxBklINDE31oE+y
+q8lrvMfEuvVgkFzrTABSDqmPXHQU+C6VcYphCvjU42Xgvs/NCbYRkizxaT1WCxkHAlikxSSD1En2
+AEFsigEJErB1+zbwasArZSTFjifM6pFBQqyrMstHBnCqRx7ERQLbPIYz1h2xEEsSqUkGEgBzTZyb
+SgaASjMPGMUIxIRtA9Oms1JoRHwb0A1Svk9n5Il/g/VQHfrIB+ImzVMDAp8BS42kDByQXDwwVDL6
+JImaY1arsixKHJzDS3cEcn3Ssup0i2Kfcc4f9ozbLGv6w6GuM+oB3Hoj1rKSMWCODpBGnN4UUDwi
+DoJVAqQqIjXRhPg74NoQgEBipz9ye5pvaGToOq1J9Hu8MEGKIxieOgVnh565qesTYu5c/+YErXsA
+iQX83BGMyXjoHzgkNlJfAvUAX8OeB4Try+o0jAMykCiImpk7nZEPBqpg2mT1MEDA4JHC1hgA7YiZ
+A+sLw5oC9miJktTIxNLBQGBaifBJ7+GzjGmRgEjrIT4xFASCxKRhiqAL+oFxaJIJDYuJph0R+0XS
+JymfqEC0sEn6KLjZIxMGRglPRLTKScbAv0VcvoMZlbg0MgyPxVNeNWH1sO+Myc0GM+O4hxMA3shh
+1sxn3YGrOgA4sDwwjsFYaZjXYXjEmpEB8dLVj2eEh0ckuKqs2GKlFuRWDEIILXAjcdEFj+S7ljYA
+JAKNMFcOyZgRKjIoOiTBDgB9iOYRcHvE0tGaDQz0pWeoZEwI8zBtgyEjZINAeagiD7BbZByyQdCG
+LA+psUmMJSwDoNGa1RF4NRJRdUY2/7hHiGZjirkNBPBvEzzcpjnl7R+//RNIp6/5YLvAaf3qWQE4
+xjSwWu98D3Lmv3wmdPkeZM/Pv/Mj/50fw/M5INw7rzO+IdbrxGsJa/X8u8++8/oSWwV4e4nh7ZXT
+b0eW74jlxZdOX3zr9MVXTl+E5XsvnX7vrdPvvXL6PVi+/9Lp9986/f4rp9+H5Ycvnf7wrdMfvnL6
+Q1h++ovTnz57+tO/O/3ZX/jvPfvKe8/9nM1/hOUH7z333fee/fZ7z30RLNvfv/fsC+DU3nvueR9Q
++euff/X9V3/xm1982//gzc9+8OYX3v/6G+//l8/6v/3am7958UsffO35D77zl/5vv/2373/j54DW
+97/8RYCqShIz/mivALAUzJeqDyE3mwSuPi/3AJvA6hGS+ipGuXpMKj/gJOL0QGg9DfiokXhMsAlw
+xGRMUNGD7NobH/k9i1TyYwJN/CEMOorVgrdJGO4RehJ4EmySph1QCeDsAShB7JoOf0xwGpBXGxDT
+h3CErq4GgOz7NIn2SVmuH/p90E7fgN2CDAsc6iOvPnCmf+j4NMiJZdLBkuruLV+npSMgwMAgNduM
+QA9/YO5AJQOPhFj4zQxi8/AHZq1PMqzqs7w6JqbumMAOf/BDPkMbeIZJdmTg94CWlujvyDd6Y5/k
+NUOb+sYA3xaADsOYyJ7whNR8Bsp4U+vhDwiHtrsJoL+JueSmdROIB8nWnvkHg5FPrNSBMQbigd1z
+CO88Elt9E/U3TQ1AZtI2F8IyYtrwB7kUbUkqUsAYAMykJV1/bPbwB8ZuTChGYipB2phYOZ/16R7C
+og0s9I2F+BZERAt9C4jzLYwLYget0QGxdT5veXPwCzbVmmk+wSNEVJ8Ij3ABAqpvo50g/fuknbEP
+Rz4pXSYkhI4Abgb9Ae7QLgA6WiX2J5gQJ0eaf+tQI5084RwQzsQf2DiDkA32IwIy4BNYIBd5uhgr
+LkQSVyMdPb5RVuCZ7w4cwiti6oBR+CMUZNZNAzT1CJ58D2y41z+glWTfQ5m8myQkTsB4HQKW4Hfo
+guXCH5jwKdp4ypuGPMATSYokH05IhUZY5B/RXhO0yxHSP/IGEAUt/xhC5/HsNqnN/BnKDobLf/vV
+t3/y9pukOXvr7Z/7/+fXgEw/I9nwjbd/zCIi5EHIiZ95+0f+rz7zq+d+9QzAC7ZX/V899/Ybv/qM
+/0+v/NM3/+kn/j9/55+/8s9/LxD1og9M+hl4NhIZ3/0cUOqdn8B2+sJXTr/1RQh9+Hrx9FtfOH3h
+H/zT1z57+tpLp6990z99HV9fPX3tvwJWXgeGvPcsfb1JaENQ88wv33v2r9575nv+r1976f2/+cIH
+v/hLgMx3f/3am+9/5W/995//yw+ef/XXv/iG//7fvHLnS9+48/ff9N//2Td/+93v//avv+n/5gdv
+3PmHN379xt/5v/1v/+XO8999/yff9olr6wNegC4+mBRIYKAukiaBO7cAL+DQfIxpTPkY1egYFRgC
+qOn5PYAK2K+B3wPSQF40/N5NQAuQBWDi9wBFQBoAy6HfA9wQsvik1oe8CMQY+BotQIPb8ll29DVA
+CO1oApD4IEvt0MeUrB2D9fK120ASv3/Tx3Doj4Egfv82cMMHZ6ITVwXs8NHz+hTA4Q9u+oMDH0QN
+RgXDDDPPsAeg8In7ASsERgisESAECAJ5EfgBqLgF4c8fQjQEZEz94ZE/nAEv/NHYH1k+JuwRYANI
+0Qc35GPIG4QYPsa9cQuSH9DCJ02XDngAKoAT8sFlHID9IXDwDwANQAY4Ov7BESRC/+A2AMIHuRM6
+GD6mYxPSIdACwDH1zUOftFMAAc0fE0T44KTGI398AHgAOvhj0lb5QKLxLX8MUQ+QAfQAOwScOPKB
+gOCxx7eBE75FCAFwADaA9fFB9KAVwLAFFJn6mEMAL+DFIVdNBv4EbA9QgPgefwJ32hvikzoekADe
+aObfUkHzYGV80jFNQesgc1A4iNsHPIGjdmm1DcyI794EZUNWg4gGjgQUDuIGbYP/8N1D3wX9g+Rv
++0TsoGuwJENQOAjc90DuJIr5HvEfIHUf4AQc9o5877Y/Vf3p0J8e+JglMFFNb/vEjWigev+QaN4/
+BNVP/aOBj+lwpvszD0yHf3vs3z7y//tP/vubRN4/8sF2/DmR72f9//Hcf/ofz/21/z+//LP/+dmf
+0c+/vvlj/1+f/fm/fvZV///9ytf/n5+8BKrDHP4NENrffPCVPyV6+/Uvv0k/d772C/75+pf9X//8
+mV+/9nf+r3/xtTuvvurD//1nfuDfeeZbd974mX/nc/9458++jZ/v3/mL1+jng699yb/zF6+8//pb
+9PPBM//Nv/OVz9/5hz/nH0hT+Hn/G18IhCz/ztdf/OCNXxIH8f6X8fPiq79+/T/6d17+KRgH/87P
+vvWbbyLI67/89Wtf9++89fKdP/s7//3PPX/nbz7rv//FL4PFoJ/ffPNF//0vf+03z/xn//2//uWd
+L32Tf974OoHDb7/6pv/+V3965xXE+9prH0DWC/iS91977f3n/8J//60f/fZvPuN/8OzrH6CaH/zF
+N+68/Pf+B995886XXvSBOXf+/jP8g1Lj54N//Fv+efPr/m+e+evffubv/N+88rP3X/pT/zc/fumD
+L37Xh8tvfoifr33+ztf+K8DnH+781TP+b1/4j3f+/HPM+rz2g9QlpVk/u0P8wsfu+4OL9z+weam+
+8+n9p0/8+X/greTdpaCfTmw+Eh6qTNwH79vn3TMa3sZGl5Vd4PfW9PW3vy/fPCrPPZlXwWy1Rqoj
+t282FbmvUvOum31x6ireXW6fJFFDri/Uz3nGsb5yf1p4be7Z3MPnHZcLoNj13T1Fjd6IVQxupycU
+s34ylofYagkjoRi1hJlQzFrCSyheLWEnFLuWqCXmyrSeUTT8TerG9vRBdTt8tyW6HG+aCq8O1+Ti
+5Y1MrZ8cpLYhSasHfC3yRrY2TC4ibGVTyrJ/rna4EiNfm624FGq9FZdi7WjFpVTrrLiUa8cLl/CS
+ZRdFnU83N8OeadB7ZYvXEvrUA4kxXwG4s5/MpmpO2oP8BueLF/eTsQd0h/KuAhqKwwbBvNROUqvn
+0GTc1MkR/p3MlUlKORmp7qN8j77ca8iPKqdStWvJVM2k91vrdc/3k4t0kEwtkU7wCwxaPV9L/GH4
+XaBIsaIccqHTUpBFUJ2DRuX3/Wvx8s9ikXw/jEGliWJwEZYi9ShSO+ZfXPY/Wi5JuZbYCr9Ltfai
+JI14pA5H2gpLEsRGkNpyTkuRjpeLv7UuEnqieE5PtHlfQNv2wm5YSnx/zTuMOXrtRY+SOzH6x7Xp
+PBbpGj0hLtSycGzIu5OTdDzPxfXGcpfkJI3EFBDTpd2t9N59l6QC/LQHv7sVu3AyeHeH04uPpp0w
+WPRWQ/hST83ZTp69gDIZvvKKJkylJ/YkmaKXHVNpz36EdjS0QECLq1etcFDOU0l18YwttcSYn3YM
+EKrmKbF7nmuOIs+51KSCrL+vqWjuOQ0avlLyKKU8sXw/5jmvXyKBuvgRUpN1h83aVvCU5UqD1C9c
+0FfdooBRT4ShIoe5cuucJ31SJ4sHdAz3SXqufu27MBeS3sWL8s5oEOwJx5BLWJPhHdbRPcFtW3cf
+s72HVX7nnS8xveKgGJzg+WEb3iO66npXLP0p2+nTNMARVvK9PGn0+6SPZ5IL33zxaD/Mo7RDeWLq
+yIp8+ZXE1Vhrri2NTbNhU3Yxdy4ubsWsE069UpJJ3G0xnoIJN3jxzeP815Vt3dWp2yuvYZwtQQTY
+SS96zViePZRn6EIncsjsLV78TYMWHtrKxhoj6rq1d7gGpZdOCgJyCfiG+4sXPUp9HUElSF/qapCU
+Md+iIZyLFxOHvbgDleHDBsk5w+8C8NALHg89v3loePFLyHqs/gkZCfc04u6S+wUaisFbu3crQThe
+ESZMtTHwdGdtb3LJ1qVWv/Tp8B5ZwOemfGa2qnsPyMuNXKF479wlwiXJ4/zSLHGatd1P37jh7j2w
+A+60xecXaeRMgkx2KUk2dsS8kf4dnZI1/n0gtXPj0s59l+RVqifryf4yi3xp52NLh0A3L/7hxy79
+Qf0/gG3x77/vgRs3dm/c2DuZ71yoKentT//fX/+r2InMc8JSoNTO8mnNXT4PqiSSCYWOaq5vSjp/
+mp/XkjsX7urPl9sj0I3+JqqWSobX3aeiI7GPJjHxJlLIz5c8E8lLl+hAK+Utl+LHwpbWh300mc2k
+qJ7phBLKBvBE4bYSm5fphGxC4tEf0pX23qSocvi1HwkUcFs8PK0khgYdWr2vvvY0q/LU7/skzL/D
+ewQyQQdSy5P08kAw2KX49fv+f3hT/4fLSqFsFK9dXCoKy2/Xz7maHXLS+XevQ3JalcVckq3O3OeP
+UgDz4e7Ro6kafgp7YAi93eKeMsBPdU+h5mLEVfr1ie8PlPFCnqJbxvnh4VvhBJR0FDMVPlVOM6Rh
+TXV+nnJETy0K2z4+P0D/4sXpSg8LoI7Xp3rpxs5Z/jN8jdFZ3IWeUqbS1g+r7hNWT6WdWnq/Zdq0
+gRKyqW4hfuq82Nu66ercYMP6Ip3L1iFVOQJeyLFc/e0hJuXVsgxT8szcYX03ettAiR7cAY0O0MjJ
+5NnT7BcumBcv8sxnRjOfDsaWG/5QunGGnEK/Q0zai4xnKXSuGbONNuszLshR3dwJ3x6paTsJEClk
+Q8/sJ5QO2Exz210wzb0lptlYzzSPlPhrErUjssJ39f2HWkdZ+yhC7cKFvhIb+jVLOTvqa7aydsAL
+l65MedhvB+wauCknrR/rGs3G2ylzIR+4y7fg331gnGWlFGftffvUIUBkDHAn5dWB5zxsNsh1L3Ld
+FVcSshJzZp2CcX0huw3vk8Q8xAgLspm8TL7II3qbnAtiLIphI3eWtq3NzRp90fvvljxYPVaPkxbE
+jkwqfK2cBRZrfSOsGdUrrBMRvB6UGkUSHMlsezzkY+xZjd8hDoVGJxAGrWXlVzKZ3qFJD9OtTEMP
+0BxHH5j9ws/NFCS9oCfjPDk/ILuVrSWdTX6yeelRVM7Vir+xDD7q01vpaFLH3A0O5FIkfjuQzVKh
+lPfwUjE/trsvJTnJKtl8dU5TceBCU/AQNf3E8pz66WhS/aN/r3fW4hPjw/QslG09tUBOOH7id35s
+7febwFYLEs1isdzVMzOUgRnqHNI9CR5DDyYiczHu3YDuaEC7vh8I/piCgqe6eXrT+CcYERR+gWzD
+JWRTl5BtGiKbq8RKDggKHtKraQQ6YSsa4cj5eHwgENcX/L8B/uxGn69B2d26kSlk0ns7cEmSY35+
+g0ggcGWHuK0wT/lBEvip7lbLpb0bfb+yW62Ui/kMfZfomz6Ku1V2KORg5HcRgGw5eJeKhXwuS7bq
+boW/M3tIo1SAI+KXQqciIgVh/QIlSxbyyO9WS0GY3G4ZZtnPpqJyEjPcX9TspKRkc3MwyqlUclfZ
+3tsUHvVjO6kHojsEwfduXkpmkrtZYrGz860d/uL6++KYg+OyndpQHHNLjkHI7Dy1JW3mZ3bLlepe
+JrBGrsUVlwVDzoT8qX9z9fsSpX7899Sy343wYtRkfwg1qUIaRl1dPLuy+2l0kbINTBzyI/JuHfIx
+cYYk+O7m9oiXAKEFZLiTSNSih7EDN8wyWbBa9VX3TTWE5iypJS+YgS7i0o3+JRKrp3H7ttQFhPyo
+KCx283upQEGwcCW9nrak2pst0bK1RMtqSMshhsjDcOBlwnfjau58MT/ay3NjlOu6x332wwkkBIBP
+Ls0EfxibOoqZdTPHH6+GT0cR8msj/MlKhK1YjCCLrZUoun7e9OTp/wvmJwzmk8Cr9kklepuy9sfK
+4qnJ2p/M101iuv5vP4stEVI8Nessbenu7mqwvXBCOzPRnTOrEcdkhU/ZG9tEyzHatH6Xmc5eN9Mt
+SPlGevO+gIo1iCzrpr7Djz71OUrw3Ob6qW+xLOXoK8+BU8umbevKRLeuq0NqP9iIy9bJqrKVX4I3
+xMceU04kmMLWtjV+rXvKY9jSg0W+aJ1wAGsff+P6dFt7cBK04nDRdlq07jeI1v0Ok8OVdb/Zikuu
+drTikq/1VlwKtf0Vl2KtteJSql1ZcSnXbq64VGqXV1yqtcdWS5ipXV91ytaurjrlao+vOuVrj646
+FWrtVadi7ZFVp1KtuepUrnVXnSq126tO1doTq62Zqd1aOC2WNwepuba5ubo4+GBCllTuO7sEeEGW
+zgZ1VCt4/GwnOajn0P0yPMKls3FKOTHcQJQL182ClHeQcK2zWHkb1PPnxKchG4ufHMhAW11kvLZI
+a106tKu39nCS5HYM18JyOaJafHjMbA7BH7rH4E8lU7FK+v7x4jNYBvb9J88uhwbJP7nUQmig2pNn
+1hyvLVJMhrWgQkZlfCq5UtV6kG9jEfMgyoSyHNSLsVxay207qJdqy/nUQ0v53DwbiwWWpRpcuXsN
+PkrKa0p+czX1+xP3BwlWaon7w8SrtUu7D9WfjomhT65kgtJkYulepnSDtCBxwzcb832Me/D+xHrf
+66utWVhXtRWqu7om0r10NTIvKNrWViypx+PjK3kP9CpDGAkt0niU+JLEliygh2ympuRo+XzzHBSg
+2aOW0GRSSQSURETYvtK6/smrnZW0yrSs/MRkEq6Cccrlu6TclwkqSDmXSS2TSju2YYDarFiL0xgc
+Souwj6yErdwlbDMWVjq7HMu1y+xcPUwLKcXj3o53BXfdAlertWh3AyVaiiX6xErxlmljuXi3zmSx
+dnw9SevWAqoLx/vYMbtoc96/oMX3LzwVe8lb+Ju+MqYl9hSpolSwg/2UEnU6qZ3T1Ek7RlJCLbpt
+4WeGfvQbQv/FixasBKxB+vQTjlNIIMueKQUVAjuibWYXZX04KisH2kzGUtjJ1bKRViusiqdoK3sb
+5v0HtUDDFtZWSylq0qFqcnaiYLb0f5v9CdQe9AImnVzzE+AUE+JOVYU7H1PxE4Fj1Fa8QSGyhZ5B
+TQPPwDbf5tsUN2z9nB0MXqz0Hi+OR6XnO7lsC+UIvpRw6wEEjblYePsGrSHJm6t0GDCyBRocemc6
+/op8ZMege4pvLIhifMjS1jmrNFH0cxe4pK0d1FqFJHYiHG0to9CmOea0E7rVTwQZaHQGVAStoOED
+hr9rxV4wpQUSeq5YGi8omko35tzWH/bG5qLWIua4618/XThyswYCjzNWTaTzBJxag2GSX+B2U/EW
+T/Rsmy4uAlnR4LEHG5747AS/tUXgeN+si8c+O8FvbRF4uRe98CvYNRLYlrvWC7+CQIFttb/XlSLy
+3Yl915Yjnh0lyw12beEj7RYLeu77y2tLczbYzjrH2nkLh3cZj+uyWxty5xz32l3WJM+OQ2/J6vsX
+wsWEeAex8JwNNp2E6oXAGikSQoeFNkGWVryRY9P0cbTRcRzIoojBqxsbTwepP72hDYYbWxuurm/Q
+0cdEuEgRH0hREazlIpB1AOqjo5uhfalI1l0KEKS9pgDLkLA8jK4HzjKGwkCpVXjwFt/hTqrQvoob
+3uI7CBrZl+HEC78k2GJ3GZ2AXEC4pziRaozqTSs/HCTYNsVKmQ8JzmHC8JE6ZxV81injZFVI5jp6
+IP7siNZ3AvgOFEM1nUB8YfHMfmSZ11bCRsldiJJLL4Xw/RWHeOLrYi+8KerCFi/HungLb4q3sM3P
+abNl/Pk9m07aLNY+d2mYRYtwnHPaIKj8eaWPDft1F76LMnepoMFEqq9OpLXltcxIQbVhWBteimeS
+K0fW1eACN95UsGChdp09ah781D0YC65tnkQ9wXiE2T7GBUpfvXLt8vXLT3b2Lz/WvfzY5eufXC3N
+yghfoY1o6x5rXp36Lu2BT/CFAPTL2+8Se4osFO/uRSpZUr6dRMq22lKDxfYQPFTPgLXd3JwronuL
+N43Dy7y8TByuF5xdBPbScuvOWZR7mncssk706Y3xFFzeSD3UN7yRvnH/8P6NgakOgXgewC6uIdwl
+xWpmG/gfLfamLFFYhtIZJpKkrQS1AK8deDu7mb0UIgcem2QPtIOLPcFnE6IE0HXKpeRFq+dOtv2L
+H8uWMvgxPfrmz6F85mDyi/ew5AtsVrdTl4ZGSjHqzraaRkU6qjaKb+EIWtH5g1y9npFmJLzlXqYG
+RwgjtS0rGMFKkq1YqbmxGek2Wc2qLFSld+/MdSv+SqRNXYpL7oFeddl9ngq123zHneYFwILWCj0c
+fWwf6k9YR3TfYH/hvzyYl6MvjWp0gu14S00VbckEHgx17wqrn5OpLS9uo10vi+MmmW3vwdjWCC/c
+FgERadcjsnDicXl0wP7oQs2dTIUaZLVubdo0zXub2ShN7jwktZndiye0oAmjvvBdl+xD9o5XQ4Bt
+2dQLmc1QIOqFG5jmZ9N+UKVFxSAwPBE80rLry827thPW4Xqck/F9A229ZpdRIgggmhCUiPbwiBZx
+wa2fH5u9z4sr97CcG5e9z4sb8eznxQ4CnBefpJPVGfq8pBB2JRniKGWHVOj+RGyL02o28an8I+VB
+W6/uJYP4nP+RMqBdXednoK+ZhGgcryD+iiwODsGjE0CZBYQLOkTE4LiguhDVQWLhhrrFJqYwJhB0
+195bWq9DlRQX0cy6EUZzHzS3XUQzdt29tBsRjbfJDjEqSm1bcUy1gLIhEVnLdV1mXfXlvU2JxPYZ
+mYW5I32xzvYgLbLRdJFILRwfYschOcbxSoiBWVuddtzvhlN3hhfAVhtHGsZDw2w7Z+cuVGqpykhQ
+Agl0O3StIzfl41yXJ1UzadB0V1+Ktmmch13zaH33bNacWbineAX012R8lqFZOhIRiRs8rJadFgvP
+ofCh6Mg9ISWJiakA2gtZ3gCnn6lRzdtgqczSKCipITy7YWkj27kmFUrV9DRfGIYJNpk647tcw9jw
+juutaO6I61RS8SqGjixb6hGZXI+ULUkipl1ioP5oaVVWX1p+jSP5XPa4314OHrh+aq2rp39Y2gGY
+BuGfWg0e25x5ZqPyR1BAfFTNwTy1t72uNevemZ5ZtOjqTmd9dWU+4t19P3nGk7XO0+TJ+dqeQCCp
+LQnrSiRl15aF7ghmQ8VlIp/OFtL5BJxksMVKPoUjYQ2c6qaih/Wtn3RYe3ZbeTjYf/ZHSjASa0fK
+o8HCPApxlZVln1KA9LWn5mECYfRxFH0o0WtaFPkwiDvjuL05n9yQI3x6/RKJaX/o35BTKou1fkNf
+TD+XPv3gjUvqDfeBhy4Z0baYKCSpsYPOAPbJ8SwbgzI+sC5ked/whUxw/I7OAIRosGBUMR0FC/re
+YqKTpX2eAUHQDFsnBBm1cAcW4wEn7wUzIU2AsUXqgI/5sNjRmcVNTofbNRnD/08H/pcM2oAxDyef
+C1kw18GwO6GZh9QNdG8YfdNhxPmiqUw9tk9FsRRbURVDcRVTmSqaMlEGCuiIt+J79oFuucqQ9bU8
+2ziYUKz6OJJ/HrS2HZo2B6R9oss9ecED8isr91MUZYIoXj1pi7M2wkB0dCsVY64fInZ7a4tTofLu
+aySRkJIrqdZt8NspSY03No8wegNmUmVnQHsfn4zhlqdgmKi7D91w98JR0geXMqH9/5OtLQUDKgpK
+7ii7ciEJb2Zl0AnxdFXZnRWLwUUY8tJVclinMSf6J1cZ1YfpRXunePNbWKRBUFc0xEjUVZDsLoSu
+qZBn5wbmsxRanfa0HeombYrbNh8Mg26bKC/tgqaWTrOyD8jwCKU42DX3iJOjgwrJKWbiUHxjD6pZ
+Cl5uOPh4aZHrqwSlrBnR4dppSuHcaU1MWY7C3UN3hCWUEYiqFmareIZnwj8RxoTovxp3ObuoXHfP
+S4ZCEGZri0IZ9bCo080olbAxaRuSfH5IbWPZLg3MOqk7qOsAukk7ahMPpVNCK6Qnkp15Cz51Ggbv
+No1T6bQL9bD7MKYWDQZnDkOl2U4hyoIip7pIrprtgP+bmnrAGXFsYzCj+ssKnKbXnWSuXCmWUsoE
+32krqYHhG5BztZCtFFLKSJwHemzPVJ8zMHXvRHaj1rzYdOLQig+wpmVbA2Po1tR5XefzBgEnFGnT
+vFRMnRessMk9CnZ6P3lClEmYitDyBhZwB1aGQJe+qDbX+eLbWmKfLqI+SMxT6SnIaKpTv8Wq3zdc
+ujsxuZsIkI93v5Jmiq64JGWJ7ib2UlKIEyTxhEWXxz2qOgf07ETNndfVZIo5fPQpcUEe7R0d6klT
+WRs80rdBAkG/TXesgN/smJx3MtE3DhPKyaLZRqD+pKMkxkEiiZTSpwuNHXvqmrNruneZriN/+Pqj
+j9RO9ve5caYA6Ro13pzmlLTa7z9s2weAoZgtmejpdHPrtUAyCPJH3deIZBt6msiQBXvda3josN4U
+vBhazURjWTaNPN3ZsJCgXOdP+zWJY+jr6WCfFOkj3fpJbAAkU/WHkmtaCZNMKlxZHev1vr4YYMNo
+gJ2N5tURsR5tYQ4VGNlQ+0n0EnruZvd24pbaSZghJEDa6Lezu1fbjfSU3BuJvVjfXRjKqVXVsfR+
+g55WaesTRxfNKLCRErNNPX2EAMnEmcJuYODR/YYbGrfF1OF4GxO6YF330JKGC2KSBPX+hmuA8d94
+KJ/OldKZDdXqb9DLbRs9fUNUKH1S/R4W0hn8Q8vfrWysF+eBEMqEpGlttNv7jevXH0ed5croxJ7S
+vfJ483J7/3rj49diTYExM4Zn45FHrjy1325cb0g8JwzONrDf87sXIjtXCsV8plIjwVxxMBJOqJU2
+5DqVBMRFJiVQaNJTTsKtdjRiBvNgfYu0YckKAEplILJZi5mslLM5ErvJySDKBGBlcoUckSVBWimX
+Dch5QyO/YjYDvwktuQ/qk21Qc9KqQ+pPaimR3LRU8GEtUwYhV/oST3/pmy7rJgLc2sb4hKzMyt/J
+LkDdTH/KTMG2xycxuSo70Rd4JtDJJH0Nw+NxxpD6NPSbK8ViJZu7t0a69jB6pPHE9Yf3r165+sRV
+bqyJQg5XHr/8qY40nvLIlY9feeI6W0bK1cc7+1GA/Sv0mWOvvrLWeaw82XjkMnpdUhsqrSuPdS9/
+/IkgGXY8VB7vXLt+JZZy4/rlK4+x30yhmzPbujx+g05g154iDwpgLLL9aGF/yvBGV0necL0rE+bv
+KUBHMW16soMtx4FlfdB9BYOuESZ3eUxqSUMiXltkc4W+pIqNVdf16R4sgl0FNtBxT3ZvLdwb9KhN
+sFRCXldiXpqmu24LciKl3gVJXSXKl+a4eV64puoaGtWFRJ1YwpcXER7X+e5ndn5MCcGFG4Ddriv8
+VtiiSRapXAUqcC2XvC4PHtP1vi6Ve5wzumpPphO2PxqSIggpXyzlyqKhtpIWE2WyXKoSfRrspjJV
+JiuFYqnAdAk3N6TKYqZAu7xDWg0IdFJP0HjZn1COCdBnIqoo+MJ6Qjo+ofTrCfTyfuS5b3PPJSBc
+JM46DuuJkKVNKIf1RNRKnEBCmdUTQSNFKXJTJLaXt8sGUhBzfRNlos7oEaqaPl/ZixoPNogHkzp2
+6nr9IQ+UTZMa5deQ9ztrzrzugcKj8rPCLX23PoIkuL18WU8879HZvPc/JG9p33vKWLl2bloKGILQ
+YiFhhb1rqsJiHzjk4Nw1MVOYilhbrdZMhbcBa5jRgc4mCanJBD39k0ht93Vkom9M059K07uF4IWe
+uPy4Lq92CNEqCTUiHYLmie/z0TowkVSDjuMkuRSX+zVNCXjVhHS/8OsJmriJK1XGOj88V0ssVX1j
+jMkb8++Up3Vlg56no4ma3gHZOFLdDXldjCdmeXVr4+pKEOt+cBvcQXAdOPZ4gwoQhE6QAJLWaSly
+56MUm2MsCm1jIjMgvtdiA+kcWEuudMs8Ppoaq6NpfHY0HdzrSJYC3Nuwat0t0WiwWDJYbAWzo8Of
+GDQhLLvRJx/qNiGSGEBQtOE0+Lymgwf3ahMafwOwqPTa5r5Ic2HUhOJqYHLptBj9uoHueAPc1to8
+58An8JSBhscM9DqOwPNWz+7PErUL8cUJWmgFX2mgw3Ri/6lc+yAKj0SGVXdXCuygj5IDZapMljRA
+PZonErVReqlV6wmePzYSm+BItLSTT0GuTdQSm5PVAw1LvOvTTwkh1DbCbT/x1ty478Sc83gXAlAg
+ApCqWjOnpPbckPJuIBYxrVLsp1MLxfzqxJU8oaapcQk//qlUcpBibZUl5M8j8wnHpMtRgmOsukqv
+FALcEN2ZgY3nKYr0xSFDf+UeR5D0K9IM+jIaJcbyKGGUMusnyzPo2cY1uHFdPtK/NKSCPqTHieTl
+VzccXPZiVM2377mNpqmwyOubShokaiqTdmzcpEaJWgRiuhPAJylNnfnixid9TcNZcZz+8PZSSB/+
+pO4YAwOSukmtvtIiS9PsPgVPcKQa7dfq68qCGsK0Qwpwo4LvTx0Dcw2F3z9cZLbYOvHRmtFe34yg
+xvlcufy/vfXuffBNfvem/jdr2sk9NW00QqfcyI/F6HbDUU4GFog0rj5S4q3qxrmMqWKrbv4av6dk
+I0HMlRNdW9gnHHVhHzAScJlDIOmTQBgWaEwWrsGQa3AoJZ5xT6r9PssyqvnHFFNW3AJ2vjevD9KL
+MtOFf5gU+HJCw73SuJYnjT/VT69raZn4O4OBzo+0gSea6klypmLq/WvsTSvDTt3EbHCo6ApkedEO
+hk4TUpImU+SxnbB7NxFzscLYo733aa5fneaTEzqsylallwp57iMw5p4drhuyKNuR0CSM0F1p6kKu
+uXTTtS1lg1SelyamauD7gUsPJJRES9SfWzRHJJajHG8dHR1tkRphC2XVaYFMx/Sa+JOtYPTo/S1i
+ThDtTx595GHPmwTuCTqusm2lBzpNqSfUEUdgdDD0+pinbQSIOqwT9qISdGow8V6mx+WoFrZTU3m5
+Y9WZ5CN67VI/G/SM+zyVJlls+cYbkf0/ce3KY+E6eZreLQmup0t6wtPxxY+kZ2InDtiJ3Lf1tH2w
+g29rZ7qO9RuuMHvLjGCc9aPbI2vuvbJ+s4D1A8jVfo+MwSKBxZ26tNY0p90ZaY1PuycDNAwuNZRt
+dnoqHcQjstCjZiZVY/gtDXgS6udWPLY9Zxb46fUzq+rOTqwrHLqZU5ceoIbfrD+tbLCtBkYm8Jg/
+TVfK8ec+PZNMOxRFpyfhY26xWPGgSGEuVebNaL9HU0r7xfjw66t8+OFawfPqarDZWXb98TqJ8PEZ
+agGIXhxonUBPD+xZy66notb3FiOtT9hBlyZfk1c8SW982dPHyYVADqKnMUrLf5+gy3UJ9B+t80ad
++kNUtpO7CHg0DcKX9M60PjtXqvlypvjRtYlT0g9FReJ5lN21JffGxPgjfSYatZjOI5vNVqorOo9C
+vlrKiSqSdXei9ShlMvmiaCMLlXwmF1szmSajrlLpsqJH0EvUfbKLxLaBTgTjwemVmAPv1l11TJNm
+7Qr1D+gkNkGml3RBZ6OdrW2dOmcS6D4nCh+lCXfpnxeT248jRtrTMOJcYcH3qjmlx2ZrgjioeH+q
+EWKrocpTUYNJ3FDcaDxCnKIZvHZy5KiTcJY35/PYkNek65VwOC7xXCcLBaM9X2EGTsj6Ccxj4Chk
+UobsSzO7S9Pezi4dvwPahOtCwUvXcrelm+BVgsAtptdM0IV0NG0MaX0muZtOp13FW+y2Nnfs5Mmu
+t1c7OaRpvnYSCZHOQoi0UNRAGRLQSLSuE1V7EsqOd620dX6l7bDSKlfaoFL/7pV2qfNjlTbilXZ3
+rKVKO2H13NXqzRUmo49OzlqMOkn1+KHqyEI+X82fWSYQyo2WCU52p+kV7fpeLQAqRs8QXEXTER2D
+JDZ3ReGdQHXmCtKL9OuLlHgD4nY8PVaXqXUw0m6aNESfuJaiyhiyDXIJS+l4NgV7VJ2kFrfEESk6
+dTWt00ve1/RbyXAxw1GSAW+9C5rZQ7Fp2yCvVTxlIhPpw/DCwrAycew2hDm061bY37LRlPb385HU
+PblNTmU0ISWcDe6Gbgvk7xQvryNRItnFLXOiu+AAAWO8SJ5HDVIPaSVBFwuv8Y90NlQCyYX8HSUI
+sacsyC2Ui5dkKJNlKJ1lKGKhlOVUgmruKYGsHdhpAqM9UuuaarnLgyWVpTFEIs5StwtC2gFPps7r
+1rYtO2VC5p2ZyqWxYUufmPc8PvhiReoECc+tilTX1sKUWsgC0vnjX7HW5Z4+AtP56NRjjsEVXpAH
+5/J4pNVi0u0y58p75c8fgJaUZ3kJ6p7pUubDBT2uXbS659Ti5VrqkZj6pk8DZK4wK3BvyEbYYa2u
+ls2U/sLlut1YWjrrEeMWi9Gc0R4CN1hWi0Xs2g6JVeCoeAk8WFZblDVYWjPcxrLTvrKygH/tbohb
+zJbK1RXEzRYK2crKAhD4qApf9k9uU1qOBRyXS5kS3UVAbpOUMqKFokoul6Oj4uQ2SiljcssUy0Dw
+YQTmAWwfkv5AV2ZEIeP01SyJxzTsgsG5isu0/bi3FDZkj2W7L29qWZpDiS/mExprpsKkkNtQyI3O
+fDOKD9OPgHtewWe+PWkVnz0lFJd2MU72OKdFghCDEYl3jhOxqkB4uROJN8PTRaLKUchJ60szy3IV
+SBe1vbxV4anG449dfuzjtY21A2llX8I5OxHojBQ9+rgxVm/azkawYTSdEHRSuehrmgzj5Lw2ovNU
+DN4rc9hS/2w4S00kbavfrW03PGXXUow95vZUHhiWDGdzOxGsF9JdVYHGima11MWLmAtAD7zV2w1n
+ANIdAzkpTzoUfKAv5WhKjkZ04b5cp+LxfgDGO53RDqVwA0AxTftI71+ThNGRwZW4VELF5bA0KcnJ
+MbHOlU79rCbid9wPs64j1suKTjiGPMKA9HpwiughTFIGwnndbS22XIQ95fCs5y26ENMFb12FABm2
+dnpgOC6fJOA1kySLl0EMHQSNuYkQl/iMRed68c4NZVlDDlrRaKDM3TBU2NGyoZj4Gyl/2nDpJ2lg
+ePAAhANM4FuKCqGiW1eGhLp+SNAAWB4SfBEsd7sXcJTBmHADhiOi/iACGHnl+BzUO8MQhNSCePvr
+EWO5t1l7TTzfkqQfdN1yW3ipnQsXzIB4Axn/LOEq4S72LTr7oDHNDjhSn3tvKcqFC6I81qmLw5Fw
+IctKpuDYKssPyrVz6h/O+qRtUFgSv7eZmM5yTj2ZY615KhrvcfZghSyUM1C7ODMSMRTM4QU6TFWh
+A7ui0TcUwKejkuRbpyvkTmIzs7PU9hcvLtuDlo7P9bVz8PbixXM8wjSkBhIglshyzCh4tCCgJ+9a
+syCiAbbEVEgwnjNfVM6Us4V7640nrtIWpH3h/K5JpyjXr3z8448sO9rKdELbPNhCC9vDoSkWQyFO
+LuBp3FiHhiNkX2ImMNYjJ4kf2waixg99iqLNihRtJ7v6Hu2HX8jqxqpWzj6rlXOXtHIxXstTVtYu
+WSfnfahObkkJN1yjhIPwRWNo3b6gZHzYxVXaKemyai5XLN1bl3HET6pjU+oj3RNXpeXKkcBtR2RF
+qt1olelTV9Om6MYW2tXFhkxWrIfKLks/uk7nuS1SucaVzSnlhLRGSrZUyv5+2oUseNs8s7tJboZA
+xUCDWLR/uVypWgm0f9VMwPIm8+VyIWJRp7wd9hHqoVizALns9Ep70ZQYUwNrC53hslZNtGTrVGjT
+ebAdzI1p3cwzWjd1SevGbcVF/uiNpcYaK9Cy2FELBQ2g1k927fQyOUcyl54e685QT6I9rEieosUS
+Jhl0JaIuE/1CXIv28obBI6nUWREpHeWCJXIZddK9VZN2lq9WsYjJJKYLjioYVUa0FLIqs+PV6LjJ
+XOFBco9ti+FMNYkT0EIYa85o7Xm1UDIyI7IKFlRFVTiwaie8lAYwic1NDt8CHJXbCZCIBlYIPva8
+7vBqTagdstPLhSOmi1fGVEVNxeGS7kmJH2QM1rycYMXooXohk9lJ2mkBX9KE04k79ksmBqph6kEO
+xGp/pFB0UIpvkg2EHV52STqxparNxEZikx9iSingtsBGpFI1T0bfGWp0gqMuTB/ZajlzjwK97tHJ
+tTNsBDEoOyODEHjGvBzVRudCiP786Y/dd6LPn07VjvjsXjq8g4OPUtYTCZSiUK1kPjqVGnEqzRar
+MoSTpUylXBZIyxeKlUKsF40Ie3atCD5WYChCmri+/iSccmVkxQ436kmwAYu7x6Od+HKU8onHL7dC
+bXRyXf1T217aVGf21Avxn/urresTOrNELU4yEvUVlSdKDEVa8CV2VJcroRvdVRGtS8zne6SWpia6
+d+VNoJNxNcc2zet2oIY5U7pA++Loan923b7GoQMFTBg1OJ4R6GA0U1eda/FUrynxXm2cD0zcp7lC
+rlxZ0csE+pYP1cvwcGe9TLJQzGLWJs0MHQRivUyoRR8vUH/I3uOFXiYhnbUvdQNbxXtuA0euWuCV
+II1MjMOOL0oqEn49gx2/jC86u74qCdONGWANGo6jzqjfjHpm23jQ2zY2N1PqrrEXk44Nvv9ahik6
+GExXP+g+IE+KuBRS1KCkiLSt10Xu3NFrYAGjwWwRtFBvc4ZdzGeX3Wuk4PsjnS4FZv17eL1AeJCA
+4+5iGt8TxL0QHo0CKk3SAZqk+FW97Ry9mBLE31n2h62ftj+RSj59CTBinSEqIzVf7+GmgDmpWjaW
+NMTqj5o2klhwbMsArDOCHhEAJlcXtOOtOE8pSxull8h93WCIqyntuSxv2Mv9dvFicDxVDQ5sGEvq
+gmxqW+4TpVvJWdmzrICgB5nkEtnzA0Qzr8lKgPgriEtqAL6GktU/FqTncFTQGHkiNmboiMvuFESY
+4L3wib26yyssEYjxKZTpgve/a2K7EzrXtrTGm9jnxxjPHF+KtoDqrqZOaF8nNeMGnbPbOBoZnu5O
+VE3fIBX/xtP7T0cqwanlTicT2/EWB5SUDULMp/8gl3maGQFAHp1YwkSMcibNZYUNy+3RkeR9upJi
+gxW1FzKpKI7sgkqurYnvk/MgcB6Ezqn/vTV0g/K491hDl2xkCQCfyJJVPAsWbaF/W6EEYjvDiYKA
+JrVN10MYLqnWSEMS8tV6ip8eW5lHV2Yfmd2WgyxNRfRE8kKP5AQSHN+x4qQH1qIw/CoVnywYoThy
+AlJ8+Ahl2rOXxLyzoEEanlQEGrOIl2rUmbFdyWjlxrSIv7A1Bvh0+BHUk/HAqjOEjCdT2nxHp98I
+pUOCTqg9lAheRHJgEG2XlSd0C8rOpSSd0Pal8fyR0e/rVupSbck5dYlJd2Ac6/3lJEKm2uFz2oJM
++rYnl27FykjH2NeUEV2UvEArrcSRGRpf4bhI++JFVc6aW2n7UHfoPMXm4vOTse8/idZ+F6LBfHUT
+hwyFhcB5ssqu7C94n6MVruXaCr9zvIYv6sxj4ujJ8lCuxdZyFq6gtLtg3qL3w1XvULuwsxuHUdpH
+sldzlhy3+P5gZ6+2uzdX7jaVUx7BurpiL/JYhmmkTb611QzEhzOZK5GcfrJ7GBOL3dU6K0TTQsnL
+AvJsT1op0CgvN5RwwxF3zvxqLxD5ief//YSJUjabK66wnaVyrhrJ/kYIE3y4dgPilG713Q01HfEO
+0lPOlLofnByQFuCwJCnwegvfLJiwLRIEE6JZFgmXLgihtVq+s4hOH9NNTmt69Iz4sDQwgZT8VuE8
+OOAdKZ3U1ZPbmCOshHJC9xVwnlIkCNMrAYP7S7lI4ZowxK1/t/YGvw9GPPO/ruEXUtVy83OPWAtl
+8OU+6xKA9ld4q7KsC50sCJi2PSx5q3XV95dJlchIuXsPqv/+PVioZLK/g0axH+vBYHvScg/mC6XM
+6gK6CGVLglpw+nJJUAsWxgcLSWwUnbWMXdkQHqgf0Mbf6OjCXfWKkS6K0CXUEMVP+a2sfahKXH3k
+xnlzEx2sBHqoKfHp4Z4reuoytstNruv01iiPvLjyKJyz3Lsph2jn9bKKmNccIzVx0gv3JC9SJw1R
+SlREKSW83SLB5eYnE2hJlW4TldAXL54t6cWLMak0EBk3WC5JPPH4Iwlwj6P0p3Z4b3QSggSxZDXI
+FBGPsjJUVXr3im9lqKNEwSusNNsHr0dpNl0LEjiDwUDiC61JGGIhKiy3wNNXbdc1eqa+MSYGZSu4
+zWTDcN2pvrNxfaRvTNA8fPBLtDobxDdshG/BbvSm3oa6cd/JojBzuKKeHIVGA50i6ac3WiNdO9jw
+Rqq3AfrdAIfDJ8hUz9PHE48Ycc/mLKQkG0FJ0k+v6YVwMufl7ZUeBgDQ+EnbjoERfWGlPcT1w1sD
+cOK6WxJ6I9m68vi1VLxNqH6hJ9Vdvuepjb6tu1wvvoaJ90dw+yHUmnLMU4t20cPTo1I3l50026Gd
+0Bv3y/nwLTptAaDbatCmga0H7t8IzkGsb6WzLbO6njKf0zGTc2jInXKm9LZeEOAaupNu8iYSAVTy
+CRR5XNCNaV+Rj07PcQQBlHOSN8XKNMoMY/JMDZSpnP7QeRGPcpcrts4c8dDWHPDgq9IeorOnaw56
+aOuOeUQxYif2aglXHevBSFicOzn/jAwdi5mHB0YmyoSOBZ6tfm1xRGKX9b5RcyghdilRByjLuu69
++Dq6uaSJoGeDlkWopzvRqYc57emhsYmpfwNgdd+JJidHno7ehiF6DRZE94MC7QvQJeIrmHGG9fwo
+IR979gLEkHPdCVhcM940CWXB2SKzuFywFK7G6ila+A8UK7APwk0O8a0Ay6mnfF9U/vOQA66W7nFp
+87HOU/vXH378ylOP7XcefzxYmFl23G82rrceDpahyeva1U4rCm0sOcXCuuzBm47DsKbSeqTTeDw4
+u8Df+81PBkcW4nQdXP8Rd2ryVXlyDQjciXDCgKOYwyJYX1mcXAnu/2CSDC7/4O+mHIs4jDMy5Wwm
+JiljxO0jnX2+YdvahzUB3maN836Pck6A24k8eRhxDPesYxjeXHjRerWEn4ojlzEBxmhh2+/NEnHW
+4txFeV76AfwafH87U0yK4TKKPFiNrK49jjNaDWasDdZfDeauDTZeDWauDQaZMNhBfWYLWGbtFrBM
+fAtYhq9EiucyXZvL4e+bCw2eC5mlnLRlUCmCL/49jwIEe06XWOtgvWOJtS4WMqXsgrWOlul3WYTK
+keo/l63ki6m9+Fp8dCWs7FM7uenynHSCsluMxFMlGd/RINolevpCtVw6CMnXrJ+//+3CBT3SoC/u
+GF8G9MT1MDHwCnKkjXYNKjpdhr54cZw11PVzsljaHpm8EOIk3baYovN4bKWdPXS5l5zXola5t66J
+KnvmFFXQEUudE8g4S50TLFot3xxTyMUXqNaS9YZ9Rqu81F+JcP4bgGWlAYjEE4rssvfCveyBQJBY
+NJLDSzYWqZM5OVsJ1xGoy+3zItqKtRkt84TrAUpidaN7GE2RlYNzElMVQFRqc82FbdrK1sfwcBTJ
+a6QRDG/FpJ1c4cbQHX0zYTsQcbyatets0pvJkWcOnt5mQtlIBH5ihxW//x93/77YtrHkjaKvQmE8
+ChC1aFKSdQEDc2RZjpX4FsmXJAqXDJGgBJsEaADUJRLnOc5/Z7/CeYX9ROcRTlX1HQAp2c6aPd9Z
+M7HARqPvXV1VXfUrRDM+T6ejAarEQTCZuYWOQxxx/H/aN9+yVPCeXC0StTJQkF3HvaqsvtXUJ0oF
+J3eiZbCPpmZbWzvr36du2Vl/JOCOXGIV+LLd3trc3LLuyqM5hjqwRQ07HDppRFU4b8ocJzX4nJBz
+JlTz1zf+4g5NQx05XN9prZU1DYKU2pqGtUetkqW+GJSBVj7gjTCRcm5SexYgfmyEYcJqkWucd8nn
+BJHyeLph/HShjZ+Oz5s2o7XQLQXpwBnsAgnUSYyHITdGQiJxeVtyrtNyOQ85kHbKHje2pSTtk4XU
+s/ZrvGQxZhYvSCqt5izf3c4m3AY6s0xirXbwHkaVHnrkl1TXPhvMfMRJXrnD8lgwq8rI2Phr+yyZ
+3IXzVKpH6eiFywM31WYklTjz+nXPWaMgHk+uXVMuoPPuWybU5uD/+emkDv8vmkwpmNx/MlOBOuHN
+nVTyf//OSf26npAMdZcTJg9/fnsrmSJRrvaPFL6QfbJcL2eqcVdREd85j1FyDuDuDQbiAZZXIMVO
+jgsp6OMtX4Zov2VPOW42ecOr99OZ0U+QFe/bVUcSXA3Mm3x9dxMa6EVtwzONzs37nWlQ9D7/mEvT
+ozAvuDKjYs6mjU65KlzbZKKFrVIRWFoBtWDwRJbLzBOuSfwjYbLRxJpd7kiws7P1DUimqdXY1o7B
+OKT6jMPr7HSSP4tHRZT5ibZHIy/p1s7X15vfIRmVmG/dqtwKcpRWWExsxCoGGbTpWiFkhUcbW19n
+4P9i9w+JVZoykfbs4MXb/UMx+SLt5eun+0KTgm7aQnci9Gv8iprrTniSGEquQlFmen3GIfteIhpS
+RUYxYSlTZawmFVz0i7Qa9osh1UQaDfvFmICXcm0KB60wlBKjhZ4CBnTEvdQPfe0NtuTe2x/Mu701
+f2onMNoau8+ITy3rKRC1IjnDQBvnBMlsuj9MvtspzXEWtSKutmJMyFa0VdY2t9fa37dXHm2itSPR
+E1zKAu8Db524wf/2zvaOtVvm8P7SakKx/0nFDN/m+w3wC32pFnNxABv1fd0SXH4JxYRoZ6z62pHu
+KTfHcdPanmXDBLkZtLqW6U/47i1/IreJ/Qlu5QUW/WQblXAOI6z6BzRpDdTw9vQZEXj9FTrocqLO
+Pe0yzhyoZiOBqTk3RSg8oz6KgiRTcPVZYr2w/8coiPgOkeUljYRxd0OyAlzBeBtI22Fh3W9i+9Ms
+k7bBMRO/DCKXS5MYQQUvz8NCUTlOAY+m43GYXVcUZ+IOOdWGvWHZzzoODN6Zzz1KjzpRzC7Kkao7
+wN7QeYobGLVU2ie7ZmoIUMS1pxlBClAgtQ27uEKnQl3WaqnLmkld1kzqUtM4Apjgk4Y+a0WPcAP6
+nAyVWIklPA+daBDDPuWehkQa7qn8QLijgbJBzys+HUINNs/RRA1Ipm2fk8W2z8njdjdZbftEx9qd
++KdE2kCvtktW0NwZNoIFS6bQHeVKMqqY4E7Na/7+jHvQHl3n6H+GagPgHdnNOLx6ypHwI4xcRVB8
+E6rlPJg2raWs3UzPl5eXcCrPMZZKNqUILucUx3iUyx9uHoyaim1yc3YOy2UIH8b5q/CVO/SWl4c8
+3iRB1hg2mS02REtc1LS0H7W+z++pBBBleQ5yDZX0lCi0F3UWQHmn0zO/xeJkmPptBDj31xhaqvrr
+jCt3N1C3jNYXx1Hv9na1TWw85HtBypQQZa84qHBvC83hM9McHpZEwZdECksi/amggG7ZcWovibTX
+SShyZ6xuGKFBaCuUKQVgTja2pVHmt5v4gi6SqVO1Wbj6B/PgYNRmwReUg4atNgu9gTw3BnIVXhye
+cSc3trnVuq8rsDgSjvZf7O+93X8KD4fvBUOaSIb0cP+3d/tHb0+evH76x8n73Rfv9m0mtvoekt7u
+Hrw6efZi92ebubXyHrzae/HuSMLpxzLP7h6F6N3/ffflmxf7Rycv918+EW3Ky+XsvX71dv/V25O3
+f7zZFweCynH05vWro/1qlilTvcbOQpMPD3afvNg3+tZnR/tvKx2jWAGoI3h9KC4kuRh6V77hnHyi
+snNgjwSpkcCY4sIS0gVc5JN0cE0YmuLmkt6gp335/bNReCZuNO2vDxDGOJf4+Bf4dpegOfevwvFk
+FOUvIwyRLCMbqG8F+CWhTQsHIHzHDQvKLy95V7AL78Msxmg0utX71f4Qbr0WeK/4LezCPCcMXbsX
+ZjmqK0Y0Ytd0j0Zg1RNo1Ak3S8nJQVolCnOLE0TdPBFwTOG89xnNxgkGUiYJqT5XLCeBxCWVJ6SJ
+OInETJyMaSroLrhSjrAZOuEqpqmVg09KKUu/0k/oDJ8b2avJgl7T2J4IffZQZORXzwuzni/MOjU9
+zwcLPc9tyNh3ZCqD0E7kxGALZWN5EHFItoLh/d5L7rZvIBmXpdD67NKJ/UzhI9+n1PDOUk3d/4Lm
+cojepFx+vLh8+ZUxKNeyFnpVyi6CeL7lYy5+UaSmtFyzIRd/bUlGa06/ZopGdw6mHZ2iXC40S4A2
+VMZxWl+0+YVR+r6KBMbJc2GswIx9jq6hy1BMddD65jq+41O54K7UgqMWFbJFGRN7DFoktIaVbunQ
+Hff5eIb+LXMqK5c8nFvybCYCZqhixAQVd5Zx3OrJYorjdg9L2r1XSed2SSobYW+sb63d/0J0HvjY
+cBEUmFA0lm/0K5bMQuLsayXEhEyKtnbkKTQM3HOUu6ZlueumCnBljEKjqAc7QdG/TrIn9PISNlHR
+dSUaVR2ioo3YpSSzzEQYohESfnsKgyhcXiauVWZFB5zc8aqV5LqSlIUct4oaqsRl/BQItYXzjidA
+aAMAyXS0IhZA7DKLAcwuYiKEJZQrWk9Wr5iJiizeGEkYQZQgyzhKGYgZfTTJ42igccUlTgnrHNEI
+7as5cOQSDZWElMRft7fuvHIzvCpj6CuQHKCRZMLB0LEE0ULr1dOwQAxHYc00PwdT1hgaVz8/UW6A
+aEl4XAuz78Qi+pTTI2yyULuApmLthVLANgfcLHt52bIHqWbQZVoqtoKRf6kaIdxr91s3Uc26OYmS
+wSSNk8JcPZGxeswMYg3lfNXIRc+skaW4CTUjTqvwjqUzFV2ezlk6hJGVeBiTDslEGXtA6xUN9UTT
+oiJNCZbr6lDoNSqV1FSp4DJpdcKfkk4IcnN6HJpSc0ju5GSoysF36QZWrDzeEMJ/8DSyKl79MoxL
+lY4ugLGbnhZZhLqoO4B6tc4EzYBRaZNqwxyX/+Qk6XzG1tc3vsWyZGoqp9bbrfY9LEu4ZxJqBKVz
+kjLk0VZ3NvgiTvJp2P+M7t7C65H6LbxN3uDpGGotyjRIXEep0fY4yBpKCBgagXztta9IrRcQVxy8
+ShuqYoFf2BeWYVktsmBhHiPHwNYZXoZ5faxP4mhmrPIWtwmHAIHRo2OnPxfIV1cJ/JPhdPjgQRYN
+ker1u1iUv6AJ/ZmoZXIHXHAs4IJ14ZkVsRUHaRIQjq4iLwYAmDa4KTdmyoSBQMTgA+DMsFkZQ68x
+x9FsGTFCfWPWBW1gfQLDRCeIt9n1QfF6WlAsUaQC8r/FU0Gj3cfLyu3Nra1H3xGcco5HXh0EdR0g
+qtgzlpWV9J4kr72h8tubzPHbIwGRu+7ho+Wxt0c3nY7mmmQSMkymw54E6laSVZOHB4VeCRBiyk33
+WxygE4h1Nz1OekHmQ8/Fa0nQRHnpjKOrFq7+WkyPkroUOHhitSjkP6AJPD6K19FFBOUvGa8unM10
+fi3XRSbcrdXvjgkrJkhwpNCjDT9CCgTPcVV5lYVNmij2nYlKkQg50ahLcYmuc5BM6PYEr92cQwx1
+RwvE2UuRGcN1QefwvlCJomefIyPKEmEj0zvnl+l48jbFrcETB4FbGEjVEbIowA40i/RFehlleyEG
+GKddOy6PLjsja1aEklCGF4ooRGVkyQNgQAi6myN/KyzvAR+piw4/4cbdMf9GAXlz2Hc57JP5e7Py
+6nxj3hvOdNG7FP34DIBr4Ar/7/+r4SJLyRqE++05lQLOGZe46qHuWdojcM7l5WqTNuXhsWug7FXK
+j+vbPVQeW0UtG33vgpxReIrRmXlT3knAczSu6i4aLKfhAP1FGAq/nC2vrylkVujzjONKD3xHPjl8
+3ev5Zg6q01Z5C3nQk1VaLas6iyZJ3BOX/8I9mz5L+1OM9E0M/jcNh4rZeo/h+JH+93Xjga1EMoBu
+974DfOGqDiTIx0L/LgcanDs2Okv92NBwoEnWRXBWRUu+UCLBpHLscnssoIh44BZkYkhh4K8lG3Ea
+hRjUG/dy9394e1LN37o/x/+n7E+ubodmjP8Nq9HcnXWri4Z4VWi277vvcKFd1y20669baP7XrKdo
+PO/NqVxMsHoaz9++fUPRVI1IyRZWDz8IgdJ9/OHBzWD2w0fuxb+1ubH5DRKQaSlGQhS3mNhY23i0
+LbRfj7YebYmoQJub7S0BubazJmBBNx6t41dT0pwB5ymCIW9u7WzqYMg3e0oE0hZDGCCNwjuroOHM
+uMUxYO34ZUDux+UUJRv5eV0R+2THYECFKqFKlthXrxSXjwguJnYeowH4PtmyLsL0fNnSQL0ghnmk
+GObcYJgVJ8cNGRDvSdyVVFi5EpBImfUSiOaR0mJIiAvktsqphyA24cQrn6oMaizwOs10tNKJEpB5
+Kr+wiM4d4iX1n64VdANWsadOjcxZ+cSsqSa/3Hb5AqJVoKVOt5wjUYR0KsL+eNVSJpmifGrZNT46
+LGQO/FttTcZze4teOW/UyAIBsA2IuBiNzKvwTla2zxJ5T1sJxUITLHy4/kpM02eXr/PscasroIdW
+2p4O77pS+DAq4jd+OUOLIwwewHu75iGPfjNb1EckWVLXMZoxIhb/AxgytJvi74aNqVzgWwgylZuV
+GuEMU4YpLKB33LLPM0BZmpXiYWA+PrgpZv6DGwzxhwd1qTGVK/h/Q4sqdbg3MEDm7azRyFmplWc1
+Q+Z6dgsjdTk0t4W8OZXC3I8IBuBj9R/rKjab7ugrE6Nu8+rw7rrN8twbawAybEYy+8jEBWA0Y0W1
+SSpSqizrW6dMNaquRFfeQi6apFnpHEnLR2RESiv5a9HRQrpJECsV1CfXqSmAP9cRpSC4MXr3oKFX
+1NWk3fG5j25nHshRzalwOkr7n1fzSDgfqFNCGnlUEZDuLGWVY2Lc69MiPF2QHWWIuoqKuICVOGN6
+QDD4xb3baRxsq2hZPblf7dIFF+p9ex7lyEPGzFklJ0YYOZI+CAwniwcRobWcjdLTcCRxXEQWhIQM
+a05EsUJSacYrjJukWqa0Zb0aOyilcbOS51kZ6dyVd6yyTY1mmMlsztZR2etekj4WzZyR8/0+7/75
+xxYx3Dsb7e0NYg3hdWzG+lNXBc8EE8DRivDEF+dc3zjn3kyz6GtVrYgDg3IptrtKoLSoJdGb+bih
+JQFs7HpqVVamZjPEK89K9LGk4zXonHzjkoL+zd+ejBtjfvw0HVvf84EqlP6XS32d2gYVUrtryo9u
+wRW8zFTX8vy6HUKDjPyyOR4zFulyZKbZu1dHu8/2T9T914d4NDqM+hEsszfYURVDlXebvsJ7Qf6E
+bLZMKquwl5dr+1VpJwz8klGc2WRZRKXQyoJAQBF1eiiQa3k0REx4ytXr5WdG2zGaNAWmetzSgP+u
+g8Blu0BmtJhwN4lEk7NVkj9qKGJqZs1hzRjZT064kuEmTsh2Bi9dGdFpn7etWwiel6EGw3GYvGAo
+ax3UAqQbXIooi1a8NG40vVCJ+mbKUG34PMxRVo0GJPjixQ9QFxTB7x3UQczLHANSk/ycfQN+xaPt
+duWeR4Cz19zzsGHd3Sgp+IUnxoCcc6BIMd1j7V6h8IC174KwcHQwICsTQTt16EopYIaBukAXOaSF
+JV1L2Gl0PRF2dQBOlZfpWJueH+M1b4udN69iD1ZPgaujP5oOog8ZzNbrZHSNKiWWywVKeXGfYcBJ
+bUpYneJCYn7Rr8z8xectMZOUNe9RVCCwnB+ab4WBV25zZpYPw1kJ1fCCDRP/mvUNg95TFuf7IlTU
+pb6g3NfL+0o9HvBB2B9Pimv/hIWWZTFiXx4Jd8XdypvdO4yZP+tr8D0avytXEe5hDIUctzD4yOtA
+w43RDTC57U1HA3TJQLSZgyTGG1T7cIK5klrhJG3wWUarGh6Yi+lEj2LbV0sM8E4KDolPwbR8fXWA
+SehlM9pXS+wVplX0UfDiLb44j8/OR/AfzNIAc7/BRDlWfK6o2Xy0yA3p0MgCP1/iT6USMGcF/QXQ
+JQnWwzhN9pERyMkl5+ksOIPN8iLIlCmRpZDhWGLsifFebj98F102hs3XGZD8aPAynHROg9Pb2yeV
+KHZ4SSdm8VnwhIo5ZYRmpr8lULO/g2fWbq7N9E5m0puUq0O+BPg3CN51+dYn3P13QpPxzraNS9TJ
+wr8JXCAx0FD+JQLLyEIEpRA6ZapJARzBupBB7WSGsZuxU2Kd8FJtxi8Q82BoBZTLvS5BpQylWR5b
+ekanSo2k9D5wUgKNxbX5bG6EYvYgcE7jJMyu5+RDGJqwwJwfArzQjDY37sopoh5rAMAU2PBiFU6d
+CA5U+BhmG8cK9UenYpxP0ToMxIqHuEBqXobTQZzOe3kBkgZ/+eD29oNGsZyqG2U59JeVywUFLoF0
+wVD+781qFPOx1MWJ3cM3eq5ROy/CeIQSQWOIOEILL31OUU8/jgYxx1xCgQgROt/D4rAG72r18vJy
+FQd3dZqNeICJwfxRHAOdioEXKTDEwvLy30KXmqFMiyHnajeHYJu4+u95xxg/tPoiY6rwGbQAzd0s
+agE0AaH9g/tVozEvhkaIyMTrJn41N5vcR2weRat9Zdo0Yy+q112flJr1xaym0IKrc+rvVArk7MRL
+s8mZx+1Vn9fZQD1nbibIRcwGZJI0ZmcigMkZHyiEZ8aDXwFokPb8InjapcN/knrumbAJ4JNxSmYI
+sRxofbFskgPZgpiNMVzImWWgeiR/i33qsV2ZYtFu9llHFB9rLobtWckCJgKd5HM4tfjhN4ZfcFq+
+gnLPw9yV7CpuTJGiGCeRQhQE+JkxGu/Jl73at7KwHh58srhkOqZjFiE/RWd4Gq3o29tTPBBf3d6+
+pfE9DOBAcUJUR6Pt7P7t7ZvbW/cwOIYyXYNc4gss9VCzbWcMOlbLt+FtbVlXfri8bBani0Ku7tD4
+IjC/4A1THxjxBg+VUeCLQH9K+QzqfQQHpqbQR95cc5IiExZvCGepdpNx3wKjHRbhqtjO16t0Le2P
+ZzU7ZGBvSV0I7MvRCX04u3srn3b1lyf0VUMtcb/8Cgocs9PqNbFptvh//18/OvJS5W5CYlRAu2XG
+9tlRzc25gEc3v4QhWhU7asYc94HDjpjjwTZ6urx8wUU+pBSD4KJKKQbamJ2iMUTzZqzg00Wa4VWu
+Gb76lUwDr4C5QyETluG39FaHsobWK2qg0ryu+YtX4H31IrBv0ObT512gz5dfY10BZzdJIAODuY7D
+kWDTX0gzuDPLOH9clnC4BK44uM/dQ/+ztuE5lQqIPc0bCImCHY97aF0Na5FMS8vNe2lI6PDJiTtm
+EUbnECz2wD8wfrzmalH/NVBRSAZ5AQ+mgY9WBp+9Lgqkn2WI3yWiJa/X8I2aeDLwRJhMohO/BJyj
+POL3sr8Ghn0ZwUW99txfkMb9GjiIz+zMWzg08Hceq18q0/aG1Qqskvn2v0gFL5f0xA+00iKWTcqv
+KK2iDENjvkuhGblQyDNcSdHsbfoMhQbBlyEcdmmW7xIY+Sh+3fp7JfVQiVwkuS0q/mLKvXaDZjWG
+Hwfs5muk7+gKyNHgaTSBJdG2BW++8G2pyDPkcW4wrHUicE6Kmak06u0czdiJ9D117FaOoLtTBDD8
+lSm1IT/4EjjXf4HFUnuK1iyhQww7zRv1hXpy5C3STshLdAQu2Fn7Tjgqy9Y4VUrwZL7phD07EUNP
+VsMPi37reG1WP9KShS+KhnQN4qLOKTVuvJQKE+MY0eGSzF+uyd3Hl6in5iqp8nWsL2fC8uVMM7/7
+Wia7/51M9hUXMmKoKvnq72NEbvVS3sTwqOmEJrTZ/k4DqLrbmEftza2de8SbFKpP7fxXtjuYqKU4
+/eZwNXxEqtcjatBd+5ajcjlSMzUVg/p5122lpVO2KlI1w3+7BYz76RQxi4gdlS7o/OLXvojRYH0a
+Tn95uVCX17bb7Dzrh3L77XtF65a6cHlIHXX98jQevEynsFEsq3h14b2g251M3HXpCZDaJfSGEtow
+U8sUSS2TsIaaopvY/W6E7FbN24A1Oy2REdf1lZLVJ7paslLMGzxJ0SiXeBaw0cJwzI4did42hdG5
+oFI6RYuMCWm6Wo3CmjYM9GU58zqAI0wugZYagswRQq98AcmnSU2OOQ3Un2mQSwUgHz+KsFAtnk0C
+t+rEp4zchPxc3zSvlIsNhRx+Z6XnvFIdF/LbK+2M7IJg7lIknIlbmi9IBi4SA/Hc3mbaqKSUCXdp
+Sru0KFWE2mBua1JyP2GFtdWy0lZLatZyOveECC16lPNxshFqS8spWThOd08/cvY5neXycJ/eKcFp
+s5TpHKPqm/NiPHqGIPgL8vJT27xIFJeP5gkhuLhkxrgjTFY1eOZ+MJXiuaWHI5lkc9xons2EGbNe
+11l5Li9/bKxiwJY6s/OPqPwqUm5qSF4R3el8zujuEe6LmJKoc0W+SMaYxBBHvlMtwFDshgTBW82i
+XAEEk9Uw1kY1t6mXnC7SS3KU47zWF9NyID3OyGF+7ipTWqGsZqmgiE/VZd6Cl6GpguvOX2+l87zm
++nsuizETHbaqsno8fx1KLhWEyQDYaoQ55AtRrHE0tCCL/EoRMV0j2I4EwpWPikHidP8+sEr/pYOm
+p2QYDtJ/P06U+1ALLKs4P7pECL1sTSBX4TvFKn7IwsmzEBnFGqzDGt/lta3Wxnq9fbkN1qoNZJQX
+eziJlcRSDXa0vOzaR76z3mw1cZsXErZwwytBvZYqyXlHF1ZSqmOt2XK8EjKs0IrwcU5gqOHIp4tL
+S6SrJCiveAUIDCfEXMd5r2L/HUln3wQh+V9nMfmDVByUC5Yoj3U7ZjNObENY7vnwbjoaJD8UjTNE
+UODQ8FKq2dnaeNT+PqlmZ621scHdOggsRbgftLc22tzKjFxHRNiErS0RRcYlXDlh6tHaFG4dm2tt
+C+x5ooFitTe/4aeBfdzTL7SXRl2MRhskNrFxZNElppwlnZFsrqM7jnQYL+3ZoYuZctxZ6sT3jen2
+VruMO1txjq6DiBG4MH01vtpD5rjfrMfnq8eor0HKygwz32SmI1KmQYIhex37C6dH/md2UgXzlbBC
+ddOqoHZzWieNowy8HmoSOw5Z3gsSYs6n1sWcV6r72BFGLgRewuA7RkosboyIKKbEr49gNLWt0MJP
+OCM3FSHVOmb1fXzXD4zXYmqOQRqf9AiZUTGTVkwiHbnd1TYpmfK9xkj1dOWFkezMGguscRT0ZW+1
+lzZ6nZCH7j0HYnTHFJkAjV89XYTVk3QWz02KXi0cFO+JNUNzG6bQIL+iQdIFXSyj+O52hWjgfGpa
+UKF3Zald9QiUc9ol3PStZplgaKkFhhbytsZ8yVfaqu1ZIAcfRMuWau4QmiiX/9YZLYNC1rSoBnjz
+Hk2CYRPODTSfd7QmgfY4WRWGstKcWpDPuQRU6JcMqmlIsaFBQWNFQSt8IVkMppyWzntZ7l3MQt7q
+O3BH5zS85LFSxXrjWyQMjgmsR2CMkCEaXow1hnE0GsjQmSAuoxEOuhGnzXGc58CGqT18B0nGMPHK
+noAR7ZSoKSG3g1JFysp/ja6BH6tNVsH3pF6+Jg+q8tIyMPqchpkk0mqbhEy3I41aqFNYZLGgbyKK
+XC1zN02iqwmdqg1Yssg9NO6YZxSbaT3ciTB7vxVhrNxw3tlYHiKvciKH3rdP/3HPPD9jfn4q1DB9
+fnb/iTmMlfHM/ecQG8gn0V809HOJh41MiWQVg7kH2V3DTpTMGnZ5L9S1x767iBRWhkWwLP59P3Ic
+T0SoBNb+fqxwXsUsPqvaLQu4YbKcrb+1VZjDWNwis/VTBmwTXisb19AcdniRaTTHHq6aR3PE4XAe
+CPIJy+oRkI9YNgf+eJflc7CPP5fe8Nr3WN0VFr16LWl49CQaplkk74Px3Sf17uicAKIkPaS3r+6w
+5q9KJdtr1cB43OTfMuUXkspQIliS+b6ABRhoN6S60K4NA6PuvqDtIPEGrU7yU9FJCKw9MUHnkp4O
+b6AdBO4Je6csJ4YKsS6B3cWB3uXtoQT/HbtuJWpHF6nIV8lOfOdnQvHtsQtZLm2yOZQBg1yWhBVh
+tXN9788rLDiZ9UERp9rBwvDhsu65k5KVAqLbWnEPwiA1x5XDAOpBheNCme8mzepuUnFHJVdB1gXz
+XVc8l1d3aAMHQr+R9uaqx+ortC+MWNKs2+NuJbP9S5/ltCDY5f/MeHFQqaW2OjXmDxxmjfV7NUxq
+WCfG8RGjfVDMA9ih/UbcHIeT/QToepQT7KyZOcKISd3jCOF58dk0f/d6fuRJFxsyIDOt2ivVoBer
+XAV58O+Y4PCbJ7gDA70ULy/HeGujI7xa077/VRtVC5VCszCR57DHru5dkmGPK/b7if4WT27rey0y
+ircVkVGXc/QVdKMq6Olydr+inBoRTRf02SqIB5Oeg1ghTXOqDuxoctNJgrQLguwcmSti2XyBDF/O
+olEe3STBvDyIgjwrkfNE9AMJ+p55UFDknq/uBsUrzBbKldGCToguZPO7UOlAJhepdY+J3Xltdodw
+RNj95iU1OhSKeYFzPeyqRRLO61zS83y9lObmoW7iYc+y4B7ZYUSzul4KS4XEjFRu3A3LvodBCAR2
+MoL+EF7vYXS2fzVxP94gaMLsI3POKH44jlmI/3wK3AMZvUgW4hZBcXt73GNLS3O2CgZhso96z+Pe
+1zWMUzU6PSsMxilCH45WJ/sp6mTAOBXHmck4ZUbsu1JMzrsZJ44lfkwho+BYwD7J8J1KvSlJNWRK
+6sgvmYEjBThAfqtAm1tiVw/EifcqqEb0xGNW8rjGmWGy3wmTmUoce2q+0OIEBRXKhT5kaVIr5ObC
++kP1UqDkoIRZdyyXwNORsSEASaEBrvvkOIIG2EoHmhfLiiTyfmqhppiUNpGCga6amtyENQCft7e5
+8aWIBbW10b6vjMctMfj1Y+k+clIfXE0ICmEJ6p7HVqO9mIpjkQO+85hjsWmQQWbMzMiJJ+hoTmYJ
+JF35YBq4paBmNzKofaRh8Jt9bur1Uxt9PAPUy6NLpxFJ1aAwuTzZdT3MNUG4yzebd+8qzgaGig0s
+PL3Xq/HfQCrSez173O5mZmSrjCJbJTWRraRiCnddYvO2fBL76uJJki0t80RfLVphd+RaQVAGXHV4
+6/jVcRlEiCQQ/J+neaECI1VAEoVf+7nMdMbQfYYspLkGAnsCw5ELbcMkS/FeMJdBjTjIuIpipFf9
+PjOu5K/KW+Ckfgusr29vtyvRJ/lusGOYWRfWFSj3hN8bfcXAxxWZVluO+oX9W0q5I70zQ7kzp7AR
+9NahgGAcp8uMEziq3bOhsQUnczJbe9b8YGj4cU8WbNO+2Kbs3GzosIzwoREDTGR5MqI3EeVD6xqu
+W/hGqwggchBwMQmFVeXOpsJGtUh3ODZboiW9D3FxXpJo8hJtuQP3XrLLiGA8ZRfw3zX8dwr/XcJ/
++9UBmEu1zJG++kc2ul5v5hhGsPbMMfQw0sw/VZ2mK4urnLGt1vqjzX/GeUDcm0MFYh8359ni6JOG
+G4IgK5QpEAMJrp3abgOhDa4dm3DeOUsTRMUUFkkjfus5nQUZ7MXQdSRqpradofgiyHuYXqMV94I+
+NxGbMtUkXvDCppRareykRuiyUTF3ISSfGSMjku8MxrC2sdkSNis4qwIZaWdjW0KRtlqoiSRwkvWN
+nR2FjQQZFDbSjYRoMEBHcegOYP0Z8KLaU/qECzwGYuh72E6oXS5AutaooBSdeWSYs6TJKE4iEZwu
+zZ6EAxik2AARpZb9n7M2+VJQ053j6hsFKd7cQeZuavsjcwesaeU9X4n8La3dkk//dHnZ8ImFXyPb
+LXZkucWOate0CQGgrUBjwT91HQHn4yBiD4fzUe9i3zT9w5HKa8FGBtKjDxVf0r1v0Qag1Xu/yVYr
+lIeONOf+3LQ3am+2tr4OJ2x7Z0fi8nIFfp+uA1rtHW10hLzlqHmCRrCIEzKGxsDsTJoEM9fMpqMI
+veQJ5vBY2LX2UAmI+BeEOPuWBst3Tk5HIaKy6kBXyhtDIE4bk5MFjuV5Jv0T5gKXcnQM9GCQ+gcY
+0HdJHg4jNX7RLEjI6WrSFEbmhZAh+s0cRYjab4TJ/zwrRFyPU7q7HAnRazQfqG+AyyhLp/no+gjP
+4yTKnr99+cK/OTlBk24g4cxGnyKFWSK0FAJLBhaQxa13hk0TOiq4McYNF4lb0y3CjxITIcKNzacQ
+Q1ivREH/+eg5xgLlK5BT662dR1Vk47DOPU9hivH+RvLoshHFZEzy0eppeuX0UGTSHrNL6HRTVN2j
+YWIzYRdhJsPH4d2+d7w2/d0qXvzjwpkx56lK9Z37gDpmGlkX7UnL+fPmn9LY1ARRs0ZlIPxITa9S
+w1XGSL69bc0s8F3iseYvDrQso5P2+2JJ89lPCUF8bd2I+4187Pza0+afUD8d+v8PnZyc2HADX21/
+nyyAL6ieCZFX4845Blpir6kLzmis5shpwEJKavGka77ARYfdcLghOkZsf7TZ+j405e2t9o4ZqJ1r
+T5faWpqNteHvPFNe6TJ7wy08jvBFZEm9UhwLllqsJLxKZ3HizfDLO8Kfid0AUgVGzOLRKwyX2xon
+wwxIAKmBWQvJ1RJQr3Ib+P/Yzlp7/Z5GEkLnd5TEk0lU/BwlCEebZicg441OJhiyJj+PRiNhP7Aw
+NzA+58KaYGG+/phbAuxZwdvpQt+2BOAshGUMIHDp74zf1F5r72ySMQCkTbgtwNbGOhoXDCjtHOVh
+hRN4RmljvPhGP4RHrQ28xFaOsRIXR/u4yKl0Tn788bjnmFr6SHkqZd5PLRD6eASzSGCXZx7yaEKd
+6uHFLZTqrA4ayDFGt7cP/3V88tfD1d7Ds2YBQ8jlRucHZyVSiv6HPzw8Y84Pf/31ww+OtwLvQMY2
+CiHAL5X3r39h5n/9y/GMtL8cSIQC4OEHI51SHSvpr4Q+J8D1rlEs1IUv4A+982ub/YPzw0q0Av+y
+K6uX8AoLVjl/+C/nr+QHs4eifVZTPmKFHz9aHXnwEJIewCj8AK34rx/8+4xeTS/5gIrR1DTjRHix
+NgpUsqPSU+KnwHYUmiJyj7Kih4pL8lM0uwa6AwslTPq4gS+afzafAZfeLfhB/rHRcBAH3PEbN38l
+DfgfD8riY3LSxOcZPSEB6H5kIg8JKSIPPs+cjyAXzPDt7KPnVwt/cENXAhLtXnfb/Sv7K7n9K7v9
+K/FwBLAIcnATHN1HaNaDGwUjStj5fyWzj+I0PgpMQH9Y7vyCtKITfry+vKygLrXWd73XNX+QbANU
+DYOYO0onf78Lpsy+YOKWOdFiy5xwJQAeZqVvXawVnsnd5P/e6hdVDZwO8iQrwccGQZ8DVTPaory+
+a8yUHrdqR7tljnar57eNhgzJ29LByZe0C0gTlX8uHaA4ZjjiQqCFLowd0nJnJWERx8fCnwJYBpnV
+GE8m8an1Ci+GnNXfHel3yU1AMX2KPqp46ULo+KbD5kcg4MvL5xx/UGzDBjBGw8YAWj6uNFH0Yuzx
+kRp3RNHkmojX6UHRgSavPncYB+LnY4znT4oUWGCVrOLuevAwlqRkefnhvxTm3V8PCScPvetUlkxC
+4lwGFnWgY24fB+7SQxvj5eUzaPc+iAFvXh+9dZjz5h39u/t277kjD4f9ygjJrkMXCqRAlxWqkwUY
+wLyjR9JZfYZAerVUKHYJ5Cn4L9ziRGzwgdOaDv4J5O8ZERigLfITEbiArrqxV3Xle8Z8rtI4rXJF
+SeMHqPGS1/jDR69DdgFmk+GU8MTivuxc26rv3EWi7Pk1sjfp3bmJNJaDPinUwMvbWz7OBAhgDent
+rWtXjGeA1rqLcN9HsB+u4JwB+scckE4iQq4TLy6BbP6FlJHtyaR9cWBiZMtH3xLZMrac4YCXExeX
+m5s7gjXaWGtt6otLLlcb/mw3hxYDlhvhgRBINyl5s2Wl3De5YSQ2Q2xnqvDrO3Jpsng89Kbt8vmo
+3W6VWLztzXvH5yRjz7WtdfQAPKfXQwHVDHxiizg8SBsQfwfs3/ajR5zDw7hRSry6Dm6AQOXov05h
+lBGaA3WjzyOEugUpFSG/RuG178SkM10djqIrh2FsqLMsnSaDvXSEH2dnp9CfFmvIfzCOWjgYkHtC
+UaRj32mplLfphH6epmj04TvtyVUjT0dAtbGYR23W4P95MsthOIinue9sQEb8r9Wgr6+OzsNBeuk7
+Ccy8zCuro7QZO/0HO1hqWW31C3tzzxGp7e4YDrA4oYyrjyZXMuGQumElvYiGKuXvg2QQXfnODvxv
+zghdas6ecZMOsSM0qrXcG/riITQ1grkZDHlMQdaBVAlN8hC4GqA952gQwZz8OinCq+cSSxkPy/o3
+3GyQ1DwytCiaCcHOO4yGnssh+I4v2X5PvSAMfU9BugjX9bIYltsIL4VEeCkqsMhQ/hU7mVN+KL8L
+DZvJfdLdRAN0R5RfcftxcU2LfhAEgln7kjM28n6UxCkCXhlI7I5m/zweDV6lgyi3ISGEYCaUaEtR
+M4E8aMMCx4Iej6hJ+ggCMa0fAgHxKjiaVdQzQ4ccK6DxqGISEzVhFe9fQAOxaBxoxNaG7l2eR4iR
+8QkDKOZ5fBHxKMncKuOmrqAsGqcX0aKySCEGo4jmY5ITnzfRcIId0Zl3iWfWrrgGgcMJSfAenRw3
+J+7SlYQoR5Ogy+6pf80+BVqhxK8YKHTGqAj/oKAZ7CbvZ+loJMhIwtLhMI8K8TNl/C3uVm5o9xix
+qHGww+Xl7KfW7W26Ej4OEvjxuEWR2CZZhJ0WC8mFFh0EwwpS20VzsM5uFPKb6BHfOnAIm7gaMH+N
+cYzNoI0Gh971iO5gL5p7a547Z9cVPGwvVP+5BpYZPSVDDPGAqhMOrIsIgKVqJWLD55m3ONCuqR4T
+lGY1F6QGo7sO/cFcSFPenZvLeFCcA81ttf7T0aSc0/BP07yIh9ISjaeiwi6D0QihuwleLAKZ7Uf8
+WODUU1LHNtLP2aw+0igCuYzi/meuykEGijenfNTMEOlN9qgG+fF0CpUl88uTZ4o4YdShpIg3vyO7
+6mLk5lE4Ab7EDM/E6ZGRVNOd/OLMnokwy9JLh6mRddg5X9X4XFMA7E2EwcHpgnb8xwh3yyoVskp3
+JL6V5rArvIl6ft/sM24OUYMRW4WSMUnWXQtHHLz8rKS5lmfxoewspi1YXzC3JMMCiamLYi8BZ9E4
+eT7grNWo18hImhiQBSwNil/KzPVBRV8iP7+8vE+Wg6RLq1+ovOSCCBvQM+JlLs/jAhaPfwNdEFED
+OC6hAEe5B7RtP51crxbpan8UT07TMKsb8LPmHuR6m+7JPOwGCQjQhfkbQdrSLAAHPaATgKE8cO94
+K/pQEDe3deeFsGZDQDyy0X8hySy3a6se89ys7Q4uXyhZ70QkFAZq/bLidaLtv/rS/mvIrwnRpmhS
+Y1SF4FS86TmBxovEM9VTMuzqN220+KUCoyPHOUWkcL1u5lfNrnGobZPYTERbOsdmFoZBOjKSIG2F
+hrt4qi10A7TVEoUkrOLFhX0Kjz/O06Sj2uJjD5shr4LVhYGSiDOOFSyiP8Chz6/7bYQxPOgG5cGU
+ceatReBwI7L6rAN7XdA+YiCY7dxT/CWnxydI2EMBFxRbtgV55XZMXM+1t4VMDGmt9WrMTBNGFaXe
+uN+gZZzFaO+G/OyzLB1T9dre8eY8zCkJz3YRRlncQS6EvjQDbuki2qII7rpnojjuIZQ0D2lm3H8O
+k2Z9pnLow1L4Ks6lveIBdYlPhvzViIdGtCzZRn03FbnOMxH5WJu9lK/2MsZhJQp995/NZnHp4t9o
+joN1NlSnHNuAhzSdyjIJdsxNpft+Wh2Sme4jtzyQblzxjNFi+EfvYKV5iOj4gsvVykkxlAMKrND/
+9//9//r/NJzqZakK97GHjsgEdMAnu4EBVBySUcqjCLPssEYe8dCH0qffobOBNsb9BqA62Hz7XcbF
+eXVb5veyoVCqKdkq2gFA+QWJrFm/GWmmYGBdboHhWHVT/CuKCvGUsyA4tEj97mWXMScaW8zMRVoy
+HhTtqRg4FDXmDfQocFTobMYRmipxsTnQbQ4+fiiPq/vgBkb1I3OnAZwqKiwi+VTIH3AqHUZAj1X7
+QJgaGa/H4YSI2duU775i/js4dWGBrG1trrW/LxbkxtbGVhl9eGttu3Jni6cAN/HiNJpDdBHlFuuk
+b15u4C5TaxJFYD8KjntsCNvjtYj1CdwciNHfevVxI4NqNTIVa0lY7nBTXzyyM9W0ohv5x87uZAI7
+8UmYw3l4nSJCnyNMLd9k4dk4fBaPSIRyDpJhqsK8Owrm2kriptNm0q40Yn1SJGY6L9ZMUbG5c/OH
+mcOKriE9HK3cEnqbOS/RMkg9fBCxWdHuMuqxCele+rTgk4Dfb/XFWNewLoLfyQx+J2tWqAh39JFn
+y009uZ9WyX0dPUJWpJKMnspwNhjacPsrbQQrTzptPjsrA8BNyKDi0Vbr0T0tVHLyakWeglvoEssW
+92nzPGNxMowy/oJS/macuPz+8oWMJ4DJ71i5GEr+wsbROEVL5726rx6o10d1X3+4y95isxJEkQIm
+loIoEi9vKeN31lrrOyVji7WNta3tsrEFRya3jC3Wd1qI7nBNaRfo969kg0tKO8XwQsrw44rS9tHl
+mOyosbwjSjtBVReXP3ZQ1YVpu6jiAjrV3sR+vKa0PXSCVHEdD/DEaq231Yn1KrgRJtXE207CAvZR
+0uXI5dm1PEmS6NL97Hpk7QFChevN+pxLk2eNvJ6azTCGqSjG82Uy439PYG5iblyDSyr7L+Etjavf
+kZF7TtCsaLWI0TKAhF+8bH6KithmkR4cvT6iXKQxUdkXZmzm01OeFzYQ3r+ID6fTmAt0zvow3H40
+3NxYfbTV3lrdgLFfPV0f9lfX+jub68PNzXAYbqo+oE8TcUX0qdUDkSOeXGzwt+2d7eajdrPdajU3
+1sz3m/z9WqvV9luD023/0enOpt+C//F/NtY2h/521N7yNzfWECBmqpBQWuLHyXCUhoVIQuXTmcpw
+mgIPEiY4p4541mKSiiZrxMKdsbfE8UUo7nxqjilyLx0SZL4NXCpZknNFaBK8OqYo5Sd0jdy7vX11
+XPS0U8in5ocR+Tuh+53vvEs+J+ll0iD/zwaaptAdK3uDNVL239+je63z4EEWDTlOfMXQN+JRwbSx
+kfMfjvd4FaEzDtG8NLx6YwRIc8ZxYvzusZeYJ+bqP3wdXvHHHnvK38Tj6Zi/EE/RFWEHXEQv1Uud
+JHL12Av+9Qs6Ivj34rnHnljmIp52oK0zGVmrPcnXzJN8jU5yaZpJR7nS5x9nPaOAiH665GuMz96M
+CGHe4ZDbgUIpMGJ/MY73y5yr8UjGMMRj8RD/eYn/PMV/XvQscTpEmR4om6q5KZ2Kl5cJ01r9xoh5
+rspXGPn0sxiM21tXp1EEMWx2bhQu2pDrmxBgX6hrRTlPxjCIpFGHdr7lYCdNHVkPpFLjFzVD/wxu
+hDF6ZuwR82NpMNUIGzFwAnzCp1jHa4pXZnCoIIe+vpQr9Fo2NPTQWmIpOw5hS9GfpjZhrryS6vjb
+26Qpwr4ciqRK3ksZD8bIrGLEUG6zp/CFV/odYDEYkXru9C4vr+KlH27SaXWmptA5Ctkq07vlGQnR
+kktPetgjMHqBj0CB6uh7eqKZoSecFCafn7gip0xhCfdJfBaY/sBzzIjatTuwbe7ANu5Aln3r7uVZ
+2FcYjC0vm786eLcnyWtEmC+eiHcq8F+EHbAqKwMSb9KFptj35WRBBJRGYSlcXqa448AVvh6qByV2
+AGuksoTJNc9CDyoLD+4AzYPZmnpa4aL2Tt4VpaKIIr5GU1H88AluW8RbgBUH9AjxoemPSz8D+mVR
+HdEvo1OZTPPQerkjTXcya7tH9naP6rZ7opuczd/uCd/uk3tv90Ru94Tv06Rmu5uv9HYvare7mdfY
+7kX9do9K2730O0hou2el7Z7VbndgicvbfcK3uyYD3ai63aPydp9Jj5gbssW7gUn2x4wYkAsVQ+uU
+6Zb6+wwvcHBjhyN93vtXjLa/fzJDC2PYsjelEfOPqpGydlGMGwdj/IAm/rPQ+u1BjdEwvvIPDDCV
+V7NgDKc/ZIXhTKCve8He7a2TpEV4RkatwJK7B92DFcdHzzZvZY+9gl1wfnzQxRM2yX1n5cDnj04v
+eDXDMl4cf+7heSf24d9BgR7O5dgt91peuHsQUml5eekCSt6/vb26vf3bPfS6Fyp6sX+CSS8piQfI
+9P92n3pdF35zFhOtFskyTv72/BC5RmQVYL9cqIiZKp/4rYJqvguMGwICrY07qPhbwvjOwkwhs+M8
+Z9I4IWtKPs0zNrZ7d3ho4zMYsIE1gi0mv4/t72P5fay/J2JUbm5qf5eq2NZNyWHWNje0PzOsSdRn
+0qixRbSlI8H6f1Jl5HYZuSwj12V0OCHKO8IuGpjAlZX/lEUZpyqci180advnxO49ewC186n7QNYS
+sILEAKBizeCyrQOk9ObB43Iae85tL4j4AJ0yOATRnpYwgWx1+GpJO0mXs6tRladDvq9YCdRIvDiO
+et2WD+KAz+MWVb5JTV5RDoFRgrEacRvWLsgz+3YMVrZmaBFI3POwDYbhTFRmbVdh1H/RW0Lew7l0
+kmpiW/kOYQL78wYDr4J/xUJxZEU3SsNvBrgqv1n64OJGWfqFEM9Lr1cfrD53vcct2gnvYZMpTkqY
+v6ffyknxLMSCLy9/QSkFFwc+0BkvH4h+yx/NUMYt01CGeDDReyRKXlc9IlshOK6ClyaOEGDA6Kdk
+esRcYV73/FhVhVT89hYkKD3H3cJXP5Juon+gh8dbaoXnzawSgnJ53EYXUxH5EeYD6DJ9GdB5J/DL
+ZzPJyz2TwyOnkBVARhOu2oo7v1LAbPfBygoFbwm9Lh0i1xTRmhayhN6FExd/y+PXm/luxuNJwbBj
+MTT+9zxcnEGMIYKA6uDtrEP2TFbS8vL9SrK/QgF6MqEDpFJiU7y6b8lSn0Al0WO1SBmFmS4qYKNz
+rwdubR/j9q1tAXJQxP2Icpt5FGaoDKvPflzAqrh5cUxm+KfAx32e8UVASc9o8uWkwjzOZgjRSFuL
+ALqDN1pszrqZXnSn3VNfse7AKic0gfKkVpqT1PLhvxDk9uODm3T2sdapOwU+pvoBtMbQBxoBtNOK
+LrCRzma4rYlDwFWZel3JYMg6PKZjcl9Qw5d4Tt6Jsvon1a1Ig+O0N1P3t10h80l6kpHjsJISbm+J
+oWOZ3pGZsR3H9FfutUtqgXlePEPyiqClnmEmH7xDjfG4yePDDLqcbwvZa/gcKbXeZjcnSLD8cww+
+8wJyvMBxMcKXX9zZW2s9pt69ln4KJwJy30g3ih7/V8kPwBAekfhnvFPyArzcRTnIekuSl3zSFLiL
+tLIwaVvRC1JI8d/jnSGtejVmi4YGRkXkonHkWbupf6yyYEiImqGTYxPh2Hy539h8YejXAnsuou5F
+psx1e2sk2+NlvCgN1nvUgAkh4Hx5ubaDcMDKGX1BcjGew1de0jVy24KM7+wm18U5rIpGP0wap1Hj
+PMoiZwbLqGnnbIMMwoBsKAn3qkbWvsKrD76WpRJCtjkzBGyaRyAXWoohQVq+8XRzU+HGIo2QNIto
+Kl5tFtF+8+CnUlK39Hv1gb+uln8UtDvRT0A/o5UV2jPlIU00zhvIZMWxY4+SsxLB4myqjjHdlcKT
+1Lj2G06S1QqlVVimXCeiKVw9SCfzz+xP3qwTokUnnBYpJvNnm5H/WXKYP5NmgyjWiaZYJxWKhWUj
+vTzhehMYCyJefwYyga/2PwURe+KecH2KMf+ep5YML4mUMcKF7I8OL/CPQKbzAv9YWCCHPMURcZcS
+YEWXlxWRVLP1DL40V2EWHJeSFC5qBoQ285hZjKC1GVtMUDzgoHAufhPsJddVEoPo/ca3xe4zlQSc
+Yke1XIJf45e/BW/Rv7FypP4mlYi0ZPEqQMi0BSk9ylcHy8sFsGe/BYXUHxOPbXyWlT7jL2FTrq7C
+Z5k3qzskfhOG/lL+4vcOZalMpkJfUA7+TczjbyAHG+89Hs9M7tO6omSqJ/suhNTfpJBq5Ol4v60E
+vx1Dr/9Tvu4RS8BhgJYMriIRMyqo/m8m1f+thzP524z9TTdFUZOjiwiVJ/+BQZgmBllxpSJCnBSI
+BsveabBwBeT3TOA3L3HRJvHmIMwgn39E7sQ3g6g/CrlBAdrJxcmAjMz/KjB4KgrTshJVdttj73Xy
+ccSuSBdBfzKvB8I2rMWD5p+e+469h3NC/fwCP2dse3v7O4266CLfwDhMNSoEWfvgXX977b4R3WUM
+nzf7e+LaXqRg5E5+YS8SkD+klPcy5c3u4e5LcW0vkvZfvnn7B3+hw03xq3umoqrQ6yNKfc54mBYe
+0IiSfmFG5BZK+ZVSXr57u4sB2sw3P7MXr3+2Uv5kMqiIUeQfzIo0Qmm/ibS6Vv0uu/P6zf7h7lvo
+wsnL/be7PDgJ5YgipoMxvX7xfv8pJReV5JOjd0/eHu7zr7KIenK093z/JU9JImYgddBUyxQJaMht
+C2Uqxpwj27aoAtaBlm0RIw7+bYrJPMRlxAQSpMrVj6RLWAk0kdtOR6xP6Fxv0AaHm01bSU+uD3CX
+xAW3phuXG2wWdhYxAQZWzbAXwnbn0KGRirdBNQgEUVkw2TLTC4X+zjFFoVkjkNPeV7+9lC3eE5ik
+Ou7HvuqMwCfVr64iDPF9KMyNOPqoSOK+dBSchFJeTtEcbWC+2I3YKD0zUz5HLOJRRczUPZXK45CI
+XljVflKJ+rsDqpgbXvE4JJFJFrili2UOI6xQbKv1zS20frGt1nnURMsiZg3dP22DmM21tTL4iLCV
+t+1hNjFypWUOI2x1LHMYtNWxbGFElBTLFmZrcwfzWbYwQP8wzbKFaa9tCVMYAQb7ilyMH21ueOwt
+ZXzlsTcEi4TWIodk+tda3/LYS3p76LGnZA26huY4LyjtqceeUHd2NqGLzyjtiQcnlwrEIvXhDgLw
+nPDFekLBROHosBLJoeq9ncbRXB/YiWT45sCxYaVGuAP4u5NYh9V7LnLJzSO//kWkw3I5kcZzDvvV
+SqV15bCfjcQxX9P65Z/iJSxrnfiHSKT1aRT/WyldZP/dSi63NIrsjionp5NxVIQnHGGbFaVcGto2
+m/PmJOc0xmEJ5oDOcfhhAxkljUwkaVTDspd0hnczvN0xEFoKBBZBZAmuxu7UsW6Sx+TGNe+YDFpV
+zHT43DAyzPO5EU6kMkZGxriS8UttvryS772ZT0APR9JimaABYbAkSFRWiqaSoJG/fIkhHxhlPwLW
+LZwFCdARAnlDnVGEt3Chi/HraGJdiTno0PmTAfsE2T81/3zTxNa45GpJZnzApm/Pqvoly8gaOXSQ
+7KJLPLL2s2rpbBRdRCOfx/FwGND3HF18UJEewq4i33AfmeHsM2oI8W8T07rG80pb6Je0B0AMgrJS
+R4iJjbtZ0z5p3dhDpyuOYmhEkulHEn83qxnscuia0BzsnFwGhhHZjcrz2u+zXWBWJgF6eBmO2kO0
+7J2iKs6OgWfU1jSOfAw6qBUijRzoR9S4WG+2W81WAx0LL+PRCNUR3Gt3gJqXi40mvO00kG+sZxUI
+LiMKB0sYjR0GoSWo4Q3h9EkFzcuwn6X+OVPmvDxhIPmPA/Sv7EcTGBF/rIJ+malnM4R26eirDhIV
+QjNUNNM2U3Q5GDaB2krLiYtgghb3L2DCX5HI9yzNELK8W5/sc/9uOL14FbD2FTZyo++qKSLEtYgA
+0J+mfV+A0/6T3fbQizfRYLJUYyFxYjMB35nL7SdCrZ9n6H0Jm497faESSEd45FqX2L7xQjGssvki
+2iEgkaHFOg3XhXvN9E+BCkBWoedmNv0okICajspLezaQe1YKdaLJGHiX9ngggctxl7tkRCi2t8Nu
+ULaHA+J0FKG0JsLQN8X7GY8t2MmRcrylYoF2PBGERiF8Nm1uFZVFnkAKmqCVvkRTjYIXUL0b5tdJ
+3wLUnkBTyca+YyoX6LKpRMacQXQq6pGw5H5jkGLY8fPwImqEDV5Qo0iFe2/UAOrVCE/TDAPrweCJ
+XWXQisIGjyYfj5vMqgWIDV+oIVGQnCiITX74EpZlTsRyWkoW9Yb7gjUEOPjq3igGMaAxiAdmUFPo
+1Ee7NR8bHKuGQddR+wmZz0XnODxTXr9D80U7VMzSOJjqfcrqiM9FeRee1u3Cy9pduC/CiymgQzr7
+kAm4CsLLMAaaj8Yy0oJ1EjGxXIBTVyvmhgckfRlOgKBjW9F5Zo9DQahVARPAC4SDUmzxK05kTuQb
+YMNZzm4kyZlyKsf+8S6T7iRrQod4FEc0to7/jtCok2jNk2u3AlYhd7GGJ+Iw1Le3ejfrd3yj49ul
+oQAW5JgOgnQo9CsjQCyaRhwXPdNSgq4j0GsVad0V0LqrWlp3JQq74rSuStrO3fH/KaStqCdtOBVH
+bLdzgv5WPFgA3v+bQQ9owFo9vDgsBT+gN220S6RlNoD5pttlaOBRgOYRu3T1fCInZZdbc8OCSw4G
+sDMScXvDe618c4/EXmiYjs03sGahV9a377LR1yxP2oLyNoQ3OSQXYhPAi1bu7W1GPqLT/HGw0Wp1
+bWom372NrooVRNErcEN55dZh9KjAuBrNmuQgp/nX8tk5MzyDGs/I9yhnIM3i44Q/3pMiwKTjZIg1
+4xpfudP6IH4iBMWB9AkXNqRz65gabJTIi5YuQFCAdjTUOcfoXFzQ5UmzVgMEDWJXTdVsKJshDth5
+ZHmZdy7JCCYuU1JyXuE77b/+C52xtfW3mfx4tQ2dhrYKI3Cmmg3yyzDCKBVa5htERog/S3B6wOzA
+zpHYggmnpcIjecKVUHDoxvnv4xFBi+lorKrwu4qmYqA4GZydF5bomKhKkhCMXRbNaV6Bq+QiEvBp
+9XlhFnhmY2ngZ9flWp6zulDLkYzOQ3WdRoHqpfrww5wP5wwdN9MkrR7XvSVoG6PG8bIi1f5eX745
++PsR98gwP4vmjhrhcTgyXpFQM5jFXX1DcTLekS6Oz+ZJpO8L3JtyESJ+eIHkDth6bhv7C4z00X0/
++6I++xU+2/36z36Gzz7TptQfRPzVn/Bqz1IgoHckWyzYmqBizA7gOiKtAjbmFQ+EIhrUZ0rzQ8yo
+QnU2if+w9kg4nwUIEjUAQY+TMNLSjNlFZ4J4M8RZGO6kyCcQ4iYebUI6tDMIOnPBSdDy8hI3seNw
+EhgMWq9cgyyNyXgQ7xvDpiqtHCkbeFj3eMr6PSbg8elG3pO/YoQoJhN21ZzA/EF2zIYlwN/N/t8e
+wp0bebyOu4RH3/Iy2TlywFG6RNN5jq3ae4Hj0LHFfWeEDzgcy8FbND8T0jQMrvLSGywvD5pqwg7Q
+FNz4FVjvfMg8XV7uUxPMXBn8Ohi4AwbjQaD9knHRSrmPD26mM//BTX/2sYMQIOjxG4yadhBoMnGs
+JHpqnEbNUmBwV4UGFW8MG/Bipg7N5B5fqtyqeSpvcMTlbBH3JEPtWk14QqikJvowDgllr4R2pfyV
+VPoAmO0fH/7oKHeyUTVuMZWb22/Ka5Qy0R66JpxcYAy5X0pkfkXb55pO7VDsgJA2zEEpYJQV2djX
+/OG14u1d7gXY5bfSfLz8JVrerymyHQVYxGLQ/l0NOBo62E0KyUDgNPjMwQ0ir3MKq+x0Go/kPQ3e
+PSRNfaHD/VqJFuHdORdTT3E2q+Qn4OxsIaPPCuZz2Awnk9G1MOhjKJSwVDYhM/BHKpdGtZWnHgOy
+rKfebMB5R0Lz4tW6px3UxXUSulJypRE2smgOpnyz8eyrl7LrvOTa6kkZ0uScHpXiPAvjUTRADQWp
+FAjGQ4ol5L5F5iwY6EemBj/8+GPpq+aPPzYafyU//vgmzfMYtkfjkBS1uQ8vELJ7tbH3+vBIPL6K
+iss0+9zAQqZZJFLfHb5ocCV+YzxFLPuowaNwNdKMP+VOYwjPVJKYv+YP3j16zUVuAZSDbNIb3M1x
+OALaycFlSEtEkR5eR2Wgh/LRygM+fTewQ6IPXKF2TGeVo9Y8iGPU0eeGbrQuLJ5i9NkIcip6zIVB
+HhD3pkqQuFK6THX8/gwjt+gEHhTYJXBnVc8keHg1Hkl86SneKRonpPkJm6jdLRXyTfv+1L3BwRXK
+rZQrSHKm2AljEvRhR2xDTYf4WvJHc3oGJ6JmST/VsIN/sHnMlclIHtR8+du9vnwVSUh689vEYEJF
+DyIaANRB81IywntY32qtf0MIGQsbp9VaXxMgVWhRwi+VReBNHmHoUWvbCrd57wAlMQw7cst47aTA
+LEJ598Q0enPI44FQW76+OwOzO/yW+84bcnHnbN2Qi1vlvoZdmOgwG0M9KudqrAStHgQ3x+dNw8qm
+J6OqVK4Qi6aY2a6AO+M3ySoZTk+mywJyqIoS+emSWWVfAa7OyI86jPIHKp5ov5n+7Lm6JvNDacli
+fywibIt72N59C5HmMKowoSOVkjCtZAzTLkvRbjflSiVF44GwjRZkSKZ11WTXo+vjFJvvoExJI5ac
+nyphNRSieiwkvVyI6iOMzTcN4i5V+n7HwztB4FbDWZMb4UvUv1GXsz4n6ILvCzao2ie87CbUGyOw
+twmBM2WTHsutbtVaWt3VTSE5z+tsVTKP8a4RLwbS29ul0Ctd1vJ7xw88EJffWNQy+EaCggkEQ7wp
+oKp/ja6baNSnXJXNgfy6odLGEfAu77GYD1nJxqt+lMwByqTOI5mhC6kRO2u48HQVYCrlMO0Z4h3w
+r0sHn0dwjKVTLxLqtPr+ZtbSoHU/bQ6zdAwsuou+865UreUmZGPO8ZUtUZd/S0jSrmLMc2DMC+5F
+ZPS7T6JmCiPKI4oPFwq51C2WWjJuasi4MkjgwWcsM2Q3p9eILS0j0O+dR/3PtNnEJBgxisU6UBsP
+3U74jUl5LEZ4O8A5WloFtfZ+91gLMwMM6jumhhDDI468GVm434Iai15UvqL/UQ9MW0mr4Y2M6baj
+IinhlDSVPEHIOxEkXGnbxesHzfWSgxq9Im5e2VPwJPGLcX35XjqQL3QChk7DFMlKeYg63xTBTAL1
+pPyw48Ci5xLdC5aCTcc14O6fzSej9HR5GZiFsAhNXb9+58ZBPKdYRqjXGKiQvscQLsaIklXonJVA
+ijJxLsnRTO0loaskNhNqTNC9pXIg1Vixfn+lY0umnFv3AoPWBVsAGiHV4KjipCYA32TTNiBtdXuh
+p9sqKSLnNxBvtEduqWdlPmLBO+u4hzxdNQTwOxZ91vsm8YBdMrZ97bapdFYMdWIPNb8PKa8qjKHl
+WTUsmtH7VyDnUZevbYbnFC4EgYUrJlleTvWYOcIcjq+WDPbr7a3yeq/mck4EU83lRAc/ISEDud77
+ceWjMC+4AQEZvbKpMGT+xKSNGdm48l/c2otsXJk8b+nnW2ZefFHSG1ZzF0ZvDtmis5qyPOUyJD6+
+kMcN/niCMHepQLxDXM4sCUdP034uIO9EHEiJdBeNLwRQ1xeag1wYyiv5Ohdm8vLKQZjEyysDYQsv
+r2aFHbz8+TQaxkmsi/mVwe+BThWm8INStj/JPEnZTPzBEOVM2L7zic2FtbtuJg1UmsLsn0foFDeQ
+1u5FKILfFvT8FLZ7PMqlVbtdwFuZN6G8Z9HgtT0OaaSE7lyat8uFL63bS3RNmrfL756JhTRSX8qU
+aflb+aIfMVLfv82uD4rXU5U+ibS2AJsP535YsnEfGllq2B1pN18pRlrPq/GxXsBJC1TujfxIGsyf
+h/lzOVMXRqGcY5Sm8ZrROOBc+4Eyiq+8IpWGNIsvq2uUUTy/HJZW8bJbV5FapSIAmnxzEqnlXHpz
+ZPTYsFffhQ/CZJ9rdIz0z8r8H/JzoxNpKC/thZ9EwzSL9m2zeRg83LCSazzUqmBTlSPM6eP8ZTSI
+Q0zigJLaLXH/yzQcCRN7W1+wtX0XwKSwbL87mic3lLfs6QWYpGVQL5QUdjTPR9gOG1+S41VaBvXC
+A8C2qed49Edab7Fbhqb/DKc3jCQe3gQJO0nRbNvhZxI8pBOJ1IpcHD/j+wiMV2RhH4+CPY1tvyux
+7V8jf39CGOt7Jsa6OgUIif3TnFwUMs9De/7696QYwfsOFCVeLch0pCyN0AF2FRYAQRugT0D9R0oj
+InuCzXgzJ7NmW0ofHAZKVbKQjSl6wnYV3RDEJ7vW/cny8q4dPE7c3AkchG7hU9WvMxHRHFsADHt2
+FiGlcV+SR6dfsKflLtz1mTEcHiv1V/WBOvsCp5+cKp/oSl4wCoa0hGCOz6zkCP1WODiKkAeHKS/o
+74X5zEOY53+n8z+Td7XyukmEacZsX3S2d9UwSyQbF8HDi6573Frd6f3o/dWsPj3kimgd7aBgbTI9
+e68Lf2ouDc4HY/UPdI73vHqBlxPR1exPbU+BbO5KUVzA5lhJklnEuJR0XeaWBEkR2EHB8fDX0i1h
+1qlmT2T8ps8i+TMkYVgjGBABNURNUMqFEhNr3NkDfR2gAiyZrRKA6EwExkawPs83O4JJH8ozTRng
+PEX/XR5vUxwsfKaf35lfHlE8/y/l/JIkCIZKbFil+PDYr3d8YbBgNKs/B+VgF4u2umOwZg5edPDA
+Z0lQFo3qM6qLvtvbhKMBzdif5QaXg4eYJakSysTENwgXTswfc4ZBMpLU99/mZEL2kjL8Pm8whcVh
+mVxGkf7gAfvAnvOrYVrO/RptCVfBuI6OiKQ2VsS9920iKt2/lbyFeNt0F4uj4wqz0NK6ww0qK1Kp
+5cUniCZdTLPKUjSLUKnzisioCNw2Bj1QM0PjVER3TTpy6aXFraceU2BM8A9O/rASuK00atV9mymL
+MQv5lm6exflfIlcDsu3DqhIFtm5UUwrkYuoL8Z7Zs1bKDH251ACgTBIpG4KzCnHTqrFAj3ah9pux
+dvio9TzjQr2fTpPC9X5qd/UMKlRds5+i3QJ1AobsrDbajiolW/g1LoAZMH/2zFh3NrbdkE3GWPVQ
+p0LTksGlCBQgbbQSuh7AQThKM5RDUkOeE0no/2U6wuCsJmRwkEMGNAkXrcQ5s0dfR/XRYDjd1D9q
+Xp43daXHqdIXZd2MSuAhUcxgP65QM/BlV1Nw2A15weUOIPZlGiTda2toE2CNOuXtdmOIt1lEE2jK
+8amwAwij+VyhVBGV6Fw8/xOp9Cl9kc/7oqL1K304MqwAye1QLnwMZCaOn6mRJ56Tp2/kyefkmXDz
+06UWG0Y1qAxP6+9EiqquvLx2WWockBUF430KCClAuSY9Fs3kFxIYDVrfTOC1U2ZuMQypndwzK6IT
+nSP/Qw+oF3fxe3OF1XDcNGKJGZtzUDGqinS1ZNSnfimOVxFNq0m1JGGGFtiGEe+NjA9E/FuT+Leq
+7nbh+Fv3bmkPUTJmbFBfy3esh5AAT2w4L3tokpoBqR0E2cEhH38MtzE2Vy8PqbKozbQD6ls5b9Tm
+fhLzdSrWWfmKbrd8RQcLOUGlFDRXbETtczB3maXQSX7RZBYfm+bxZ4YFO4gAt7fHvQ4HhPzafWhe
+X5nL2p67sqxbmTpDSuTT63mWnkFYWF/cyRhx3rQzBxCmQOcmbablPHQI/rPVw02pRudaGeSYY5ME
+ZDeFA+HdMXtyIM5smmQelwn22TlNB9fc0E51Xgr+2poAwdVN20rraggFtyO6H4WjnbSez4EkRTmF
+7iVqY8/TDZ5pqqen0XeDqjsOmg3YTKfixBuXFa5+zlIQ816Yrbv8X9M67uRWbp90RTBXSTaX7PWq
+E2EJhtX9VvdFGgiPBR1MomrYDrJjdCkEAXTmt0Ue4bbgdeek+zDuIeNwT14XOJBREUPpxUOMI7KK
+t6qOzAIJ6DeF2dA4Nu4T7/TwavXy8nKVsk8zmK9+imEWFcqjJl+qazWGe3GtvR5yZRXnDTkBUkSf
+PwVczBbA0HRmCJFRMjF30D/b80MyRimfBF6dkv9aJakeb95ub62BImWA7s/Jgv7AWsnu16fE6hPw
+23SVm5SEVtH0uFpqqvupepjX9bDUv/D2Nr69zXV3jv793dEC+6LuqFx13Sm/LHeHt9r0thEE3NRh
+N8dkSf3wX+5xuPp3D/9pre6s/LXa7P3o+Q9p37o8rg6SJYLXqHBe8hIWT3rranzu7azHl5QzQ7ee
+Mk9jBIBPgmNuQs2E/XRPC+nysEMHuxn6AMljyiZr38UYyN11rGS/KjiBguU7M2xkvA4d2UJM5ypR
+W5eRSH9AroRMyHBbdAEdY/b4IhTHBvtUr9m4MdwLMAhqDT1C1/p0sfbPGhKjxEXMUiq0w8DupEbZ
+/KYL5gmRAi3nh9pcPMxOZrwU13840/PiXSvWCUEUUacGS9Nec6HDrFq0EvSurNLSLanxejkuekFG
+7rM4XQe29ADtcFH/iZAWxu5falUY1K+ZAKbHo2YqEKqZT8MaRt3BUByy2raAF1YDmzHd34kZpqpS
+LBAj9VVy768krPxS2IzwujJ3Lc79leEz2dgtueD4/NiHn7BWyWb7fiYbJWS6aR3iXL8O4WxSB+c2
+NK9WOdTX4qvVta31zba+WhXUYhqYJjAWnJFh7WK4aESlAMdZ04DIs99hMPivLR4RM+lCqcKLwllG
+A08xJwvsZKsT/lR0wpUVD4OxGPxp2OtQMxNs3mLAPOnZdpz3goRNCYw7Z/JY63Gg2T4P2uTRpU9f
+bO++oKf4hmFsgyZHUM+a9RA/+qyEvKT+nZ/RQBpgFYQBjFDBJvca2CJ47GZNDaOH40bq9RmGSy8V
+YICg2EVEqCjU/oRoqLSx/eie7gNoUiXsXtDwVJ5RovL5C0vxMYUFGUKXDXtA/dCcBPZtRyO/k/vU
+MGlyF6lSvqAipWZdp8iAvUT+1F9aQgsstrG9tfMNbhFfMrUZ0eEI8iU8X8JucFu8QbZFW2s9i9hJ
+mEVkJ8E3888RI7c3ZdjD7ZtE6hsVgvmDSDnkzh7cziligyia7I1gwXBzKjLlIGuUJ9dvUox7yHO+
+1wYg3PBJ/RYN+0Xaa6SyHynvR8pjQkOj+UQWTFqdcyOmgqWn5LDJ7ZsKNk3MhN9luaEsN+Tlhlhu
++jkWhisZ45at3LxF2N+R1UzGYP2KeNMncrTRZ2a71UJPkpyoWsxjCq+3iBxOKW3E3UzWNx9hhPgJ
+pfW5k8laa2Nrg2xHIG1IliPu5tY6RjAeU9qALEdkhNMLSjsj0xF3a2MdKewppV2T6Yi79qiNaIj7
+lHZJpiMySukJpV0J0xFu0LJLaUcExyhJ+B6lfSY4RhkJ9ROlveZ4jGstbMorSjogMEZpC/OG0t6S
+uQR8295pQT9wgNprO14JmJHwHcUOexE404SuN4GlUCEOzkbpaTh6ex7nXf3o1+XMo9Gwi//4l3Ey
+SC/ZzTMhsPpPGFoS+8/YM5By/b9nwQuat3fYgkebGBb6C7XqHd78KwuhB5RGYL9mUDqhbPIfdv/j
+uPdfSw+Wf3C9H1dYJ3CaiDN89RqvK4m7fl7z3cN/CWkBhIWT/+6tPJAedJF57v+CH9DxUxfD7t7x
+71DqivJ+OIlYTfCXtbqYd1KXVgbSjlT4Ouku7rG60J/S03d5OemmXQNhJfL8XbLJ+MINGBRXb46R
+6DKyaM8NzQ2BWH0gFQ3MfB4OiVom+jWnFXgsI+7LPqkgVOwgXu0uccz7qKxsch2FG5mXUJVmyM8f
+0OdOy0GXSJDkXWMQ2pvI+r7DyNR7YR5pVW7BVte8mTenm2KM/1MXSf65HP7EsZ79SEvYv6q2wUSQ
+TkJ1EZkROBklU9Kt9Aa++Bxds0x+ScqovLgeRSTmRFeTEQwJKbnFiolrVi8uTHbDM/jhzJtx0MyY
+IpSaU7JrC3w1JcVWp5lDfLkzCk+jkVmS01SD9LVlNh3O7DsgrWfx1Te2j/szukYWJgKGJhQiTTDw
+ERpaf8IbnuATRfRx9OSizM6cjoKgiOFYdwL1M0RD7U8ktxsfMRwTJbdnqNp1xCChqo33hvsDBWnX
+WVZf8rJ9rPHbJiMXKPaInPA0GsVjNBg26hthfZXqvrU2p6GKQs8erHkST2ornv6zFd+qoqbKPGNG
+cZB17JJz0kD8j20n4B/2qBfz9lVetx4LvR5zYDxiNyO4AhquwMGVAKxH1C0vLmPo+OrTyzc3VmJf
+e5AVeslO9docmWuzsoG/rcnNmiYjMBv888+32aAPy8vp/dqdwtzKVufllnawpZ2alqZGG3NPXZpZ
+5ADDjdY3bmHLxB5Bp6icWpb0kAutm/ZOaQ+Z7UzFiDrmrpQDmJhEKUXP1TqidP95R19bMYY42xUa
+qIeEKJwvVi1wz2plm22X6wD/HdWthpFeDTmbmAtDdMQgAjVsidj0idr0qdr033luqkUXu0lpH6XV
+gxAzlReHKuETZ1f0HGfm4iqY/ljOWLVylONeKxpovmfAVSIosB4ooTH/uRRCvWjibWcQ8XjmfwY3
+CnnjMMoRIJturw+S31Cceo0ooGN/GBks8B/G6P0mwFgK4P2ZoddRmX9zdV6EjXKneD8eZp/14mug
+vsEOb4qoGrIwuY7wQwQ0cK3zg+KndLwchHmKHjfJoguY8QQxA2/6wPlhMNIh8Je1PDrx5pInrxwu
+BG6M8dA9Qiqc52DJ/mxWhwyD7qhcy8t0dsifXhNavR8i4kv1+FK5jqNep+7i3DVzUKx2CZuerBBu
+usM18EtZDaYOYl3h2ATtNRFGT91+UPI2q/sKjz0ay22fEKuKFrmTJgiEVbR0mW1RJuXI+Cftto/b
+s2iJn2u+glvRHZFa4VW64O0hkKR6JRTWq2/pFXuo7kH/0hehUlQjbTWtb9Nh9QkMmoBYnFslq2aw
+K+ZBcmBxtTeY7O8Wg8mYwv55hgql21vSK+FETxFiU4xZe0v2ONWjRt+vtWDpmLuPDhz53RotXPXN
+UlYHUaTGfm27dj7XNljtdxh8jNeyIaa0bUxpWxe7pae0HaTimy0fm1W0xc9tX+Re3+G5efp6ixdN
+o7aOsZKKNfiMYx21NzxEhJQfbohqCMG1gTlFGRs+JcEJgjIkacjwjkAhiXLAW+peg6ci/LoisTIr
+ynv8KZBJLDT8qc1UWR5Vhv1cY6FozQ5NSTP9rFq+8chsOUgX/3gz49pmxqqZQQqyDjVv45GvZj88
+zaYTsnSkI4rJ+d7Y9PGvEyUDR+fOi3TCMSojunxlx8ewzNdbPdJDe2wemUeS9bsJz4QJ0bdCMSmu
+96GLF/K3GO7tOoR/CAH2r9OHNfqYyIL7IfpIwPRK41O95/d4RHfxeoWnPW55JZ3IUx1LwTjPikjz
+SjUKoLXajq71bIWOUABh4XivA9yKDom7vAwsWHCTfkZg3M+M4/fCv9BoAQiA7sJ8Eej1pdLwh9CR
+5j4aDKtDZwb8TziX/MGCu739netHMYxgwQs9HaWneAN+Oh0Otd5mJEx1JPqZeYoRHiN+HQDvRb7/
+AYJzSxxvSMYRjL1OyPmQAnLBwRsUGu425JMg9p+kaKFlMZWZ928Xlv2Qw+AIRM9rmHfYAfjL1NXA
+oHznOlWQYdogWuk6T7mv0M3MR4XWqdmwepWFWktj5DnYGllkQC14VweMibJOAKkhyDBSLENw6hvT
+/CYt7QEgNloLq4OwhRfxGSryyd1I/fIY7BnnMAr7xSvYJBcRt4PjxiVFd4l8myRrtMRZI3Q+qIS8
+xVUaezq07t/Ly5F5Cv+NoWzly2ell8/g5dISKcme0FJrxvn7OLqkqmRYPTNYiGgHJFWN0uHIiREY
+2w4EY4yQrZTj9mV5Oo7cOtWEGF1cfXSlEAXIdI5JeLc1Mb7zn2stkIFMJYnv3DozNCu/6ecX/Bv8
+SxkLemrt8E9yyqop2+jbKM3ysvkLFhNabYzw3g4KRc40LGBpFc0SsCrQnELxIkQsuYsxEI2Ca2Vh
+soFKlFYCQoFWVJyFXwgxbBRk3ZohNZXVM78uA6mB3x0e7MlrTiTCdPkakSCkQem1XuD4eAqiYo9M
+KGDScmhaiH+M1zl/q3CVpjjGOctAsMf0mMjXMXYs5uKjx/gPoTaSP2nwgGHD+6uB15u7eKzbECBl
+nggN2g9E+SAwq8LZMCiV3Ck384Y+8tEGgD7xJ8z6wB/OSFEXmyCBPD5y5RiEwStlw5YNqtegeTf3
+T1B8l8IljuTIhX7LKKM8ITevINS70oLJva7IDVl8e+nksBHzZnQBQlTtYJaJEAwnFbZL5UrtwcjQ
+XEMVvDbe/m9slbC9JB1KbYWimvp25EC6gVzC9gchQuTEZaqp09SwApeaIhSBE75G4GNSCMBuUss+
+kYumq5QFiOsnV9I0WMLLbmQm7BXVlbc0PvKjlOSgiucOxcgUNaCTOZu5dgeXv+7UjnuB4442M6wP
+z5Whh9cjmWHXsjjr85GsfcOHPY6Ocxrvks4k7+6SQmqPvtJOH6oP8g5BLvaJW3MDcGzeADCnh54f
+0IXjDKrEQOUjqiW2a4nrVN+q+2ggQt8a3UrtAtLqZZjkyY4/0S2abiSVYSgFM1kHVWJuEb0Q+yZT
+9YrzLgtYFjhcFNOSBgkyLSCmAdMCh8YENX3kA4FaKR7HBXU1QPdcr7OE8QeaGEuqOQDy3pHXJWNS
+k/Czg0AekN+C1YwqGjIgt5bHVFJToJwTbn4jqxmKamBlV6o5D/rijk6WaLMc554khSjLPYPzoIcA
+xRg7ORm4IzYQwa6NpHOQnRT7OmxGJDLECYw7NKc5RNFKxomuqVD240xWOLUrPKtWODUrjO0KY6pQ
+7U5gKKHUJwY3NIm0umlvziwX+i4tlZOZsQgXuB7lVIwy+tGURzmkyUz0ZIL0gZOZwx+YzOI47gW5
+0YnU7kRqdqLgHK88Dl9ihBw6aOLhNVracHYB7UVBrIv7wuuCDHJVp4f/ANvP8HaJdDuaEhddx/EL
+Uj2TAQqpnpEmw16osVqr1g6Faqs12LNZ0OpkP0WdbGXFQ4JitCjrCRuiN7b5bS1VVpt8Wd8jJF2n
+qyhEghp70nenfGQ4uUq9GnaGL5YU6Id9vZ1GxAKGEY/qhRcu85V60TzdGvFet7cLVXu5J+84kUrR
+CHudn2ErRGp3kDw5QRlJRlTh+WY8tj2/qMykXNilg29MxnkEL9vH5TmEP0DAzgPusTKUpADXHFcN
+DNHkZ4+MyDtn9Zpc6X/FGzGAUcOxOQ/shTvQCxdDImI9F0HonjPoAt4E0FILJisXTPWGVpje2kTN
+0LiLTJC2MVjsOOJ2SbCQziKCyW23dyhiCZkmRRSFBOFsNjFS6ylPvobky0gj8e7z5EtIvoo0bs4J
+T76KKFaGDE+7y1OPIoppgTZJOxstCmJBhkqQjIbg51HpGkJ0AtL52YxOVG+ytEhxtbwe3t7enJxM
+8PfJiX/cmxmyIu2U5WW7uKipsgfF7PbWfit3H8YsahRegf6iry8TGcWLAve5SNwCOsLRYZMLfaxU
+jlI/wJZGZViT7liyKVpFAn8vPuPx1HlXAuE+UXRFP/tZhI7piNKRGdkK/UwEm+wKPqnRUS9LTe/M
+Ab5ufIqkPqTwZiWz5cpJCsKyYVdrkCNBoyRVkk57mjg5zopSCxW40UR7P0fXuZSyjCQU3zgJO+51
+ZI0pzkrkUQdSD3lWciVINdnSHXhLHRDXPSoULN30SN5SqjUNdR496p0XeVwbaohn6lYCPUKk5aba
+aLr+N5G8dZJXjy2i/XJcfko6HBHDpTBr/fMwQ8XtboF4E4+Dje3l5eKn4NGWpw3bYSxn0rheV3Ro
+8GKrbQqIonSWD9EPuZz436j0oois4j7ov/E26L9bVmTdh5TWdowl8TKyJHP9eRvzPjQ//+8WfW5+
+/VQuKB0wVHsKED7IHYutRbe3YviKn7JOAUsLvsGCKeCcGhrJQVUixkaeLC4JaIEjNypPV24EnpIR
+uCwWjcGNkqWlUFt364WlfMGrOFUFJyFcUAsqbSmOk163tNwwjavV1zwff2iT5BTvq2i1JyuO33BW
+UsX0ZOL4/gtd37G2J5ElpmmVtCsCbLFQnuNl0kTnMucWUIP/Au21bwiEFqNDwQKysIVSRoiOqHC9
+vcUPOspwgGJPQHF82WH4A438EKTwC78MQlZL0128tlCEDJgFFcDi3g1iuRye1/gJFFLMXB4xgj2L
+gicR+zsK3kbsXRTchIOBb5JvjezfINcXGiTOnt4AyXua9onNQvxhxkPzVj7nawy/7thnPCaVShFl
+EPYoE1voHgUubppV6IImvkdunUpBC2mvk6A2AAaGGAu+dB9glpt0gpoALMkRUQ3wI3SEmeHGp8qU
+vaX6AobWzI5PArc2RVZ9fpP76eT6ziZj7d7XVEr9qlQM1SHnOGcNlBpJOX/mNIfp4UeT/5OzaF4h
+DZ0zqFkCiNj6ZfFKfIOcgLis6OOSbpm1+3esBr5VivkLtravckKMWtseOt//O9fpu6iJf/kKeIdR
+rybXfNTfRcTh83GGH/jX0IO/F6QYKT/SWdu2OAtobZwQoCJfHDNj6RCYjNAoaOr+wIhKiETTPL5Q
+b5YFS20jyjXfPEstIwnotpvaSSEkwWED1cFDza0Eouzg9EYUMQLjnv5C843m2uLOBVMFBbeGM+JW
+SbgBKGM6UYY/TSNfINXuMWUXc3nPT+RmD+TnRBPEt6i/hPlaXBSfKL57mfU5IoXr8j1RAU66KvGG
+tNaYFPzMy+E7gC21SVGNbzxuHUBKksh13kYIFSIPicaQIiA5zHm7f/TWwN1+tnvwYv+pw0KG50Wn
+tunQ5xkfMrPZupvG2Fjf0aHOe0MrsPRhIUkDz5OVOqB8eRof08nHhvBHvEbLAAzbkCZRA7GbFBRS
+QzCryIEcPttb3dxprUF/dVdfvzk5eEV4+5XuzpLbWxePRmJ+ubbN5UuOYD2lVAz8HtomsmnQBulY
+6SgmQuEB8rF4OBcPxBidB/ULHpZ4h/PD7jDIUa+FfOtSMLTYVlgew+AlStbAueHmUaIcFAaSoKN4
+Bvy9vDwlzBCDvXHQkmu62gaeTo8wqha4IYaD/Ngq95FqjNMB8GQYFvujquYjRrT6aBT4UNXIpwWn
+5DRMcOwx4JX0t2tkPKgWA7aUjoLGZZg3UMM2zSZpHrHGZBSh1QXwQY2PUIBihl5iK8R1TP6xMRyF
+Z7CAkcENk0EDQzM04gKjeVGpcmybjZdphvemQxKZhmFerOJdziohxDYO93efvtznVp+ZVGnBksT9
+p36Mjoe97gRmNifS32JTqSeCqfenQdBfbUP+iaRHTPGqUMy5oGATmKnpygqzGfuRx6n0Ku6DoTcM
+RpKvR9b9hrdq6Q1NtL0T9q8mFEsQet+YJnl8hiONGIir7VYD3dPOoqzBCVVjHH7G2SvOI/o8i4ZR
+FiV9+IAy0IsQ29MA1gx3aQMd/Sj57yhLV7FY3EKw/qzN82b37fOTgxcv9n/efXGye3i4+wdspqf7
+v6utRA2ntfrf/z2ka7bp46DviX6Z1Hl5efhY9r1MtKAZ6EoJsy9b0Xj57uht49Xrtxhh7Yy0AyAY
+nYcJ7yO5+yAhEN3JSQCRnbS6QNEFTl6/A/L37OTJ63evnh6p1qNMiqTUjYMvcHZDK3tSfzhiQwRC
+/OdprNZ6q5GSbXj3P9YGqHREqx4P5ulPGBdzaWSaNAhyNSovyj3Y8ECGoWJUIhotCAsa/0GUo5N9
+A7dJdSW9e8VDMO0+ebEvG2XoET5YgZFNBmQht4EbqHxHVmo20TmgOF+muCtU6D5YTnLBHGHghFd7
++yew5k52X/HFDmTDOiKkvBuaPtVKHRSD3J4HSh0U/5R3YhCwQ9Tqc+4KnqB3Sy1GIWxAJoQE8+xU
+8B6lgzjUY/Q8stnPBwJxRy6j5B9YLZm1WhKzMbohvxgXtIYCQpu+cBsVVH6UzSEib+6hL455mBhR
+nrmGxNS8fvLL/t5bhCJBTpZ6DjsH47r2/ieZCV19jdkPHRNzG4Nvq80JG6KcKgWu1snjlGJBJRWY
+SJS3OHYjfpjfCNoTeRFm4myA0n64T0M0UxzZTDH+RFuk6uAQNzx3cPBtdXAmeDUP55YrbDYwfCfM
+0kes/CMxCB+x4o/GbHrWQD47fP2SwqMcHO6XOiBOKdF+Q0QQKZIpFz3SWj3Bj8/tCr2+f1+gHR9Z
+46NogOgVVj6/V/x0+0e6hVpA0aH/53u0t/sKd/ve61dvdw9ewcHxdP/ZwSu7h5mnBUDqg9A/ih2g
+mXet9kyqqXTXh3ecK8Di0cPiIy8U3fras0/0aPep6oNW3lbnRgtdIkGKUpEUDHkv0Tp2cXuxZVZT
+Q2ogMlNFY5BGfAKjqzgv7jqwy81etPGFyP5r5B6XlRGcAAg1Fd9Ms56Y0xgIxqI2kNyLOtGv6TRW
+d99uE5mo7bbh2awxGLNrDvW9+Hz7dvYDCi884IveokqMERvCEAOoxWNP32QB/AKBUiv7W53kJ3V3
+kAD/kaFyvwc8VSHjPJhmErCQLJvTSKuShN+B0fGftdopCrTaSdxqLC8XpjWq9rKvXghYrk1BefSA
+kyrzcyIIODdGdPXlkrekGC59eYSDkQVJB87IbHW1g2RiidSYWY/RXaZxzyQvUrD8pSDWb7g6oHRN
+xyvPdeVWBoXktbgplZvW3G7T3OanAebEPqS1fZAzt4RmwQWMDF2R/BkRt/ohCj+/DCfsD/vGhLSV
+AnUkyykn5hLJn4Joxn4rX1PzmxSQUU7D/mdgT60yAlNP+btE2WsqaBPXuCiLCttyqsZe4E+OuQZy
+ghh/aQ9Yf3Ue6b6IYO0zN0H+OEOBIVVvORHj2Eh/0K76k0OWomsqP1zklRP9CtA9QWiu+C7EOvUg
+INQP+b7QXSmXEQxLlwKvOmems6V30x9FYfY2HkfpFN5y7zxZCrREvgm9WacOQITDhoAQxB+acCbt
+X8AZjLiaiDKD5HmaR9MJwuuyuZlg4d6VhcqBV8mdJd2dqQ8i/FmEeYw7PdLURHlwDKdAk9MKWFWZ
+XjOVoWS1g1e2ftBLgd9IgawmJ4yWBZRzn5HlZ/Hdg1ubrzK+80srj9688u6VzxxoWLl6KAi/n6wp
+fiOEKoTUMlx55I5Uplzk8LnU9sTNgtyPYp5gYxXKOlEmskhOKXMcYfrBfyu4FTjS9FfipbSCUAla
+AtU2aW5kLpdITSgKPvIZL/SYYR+RFSW9QoHCk3dTo58tCBvw9SvqtfzhWqL/KzqKY3GrnsPYICSA
+aOJquzN6DGfwaHVVGldFx5MgPh5xK/ulAxz0iXd7q0f4eNIzbO2my8skyFc0GuVTsiZP19V358R1
+EYsvAjKuANO7chhh3C91Q0iu5OYH1rWn8cmMetry/EU1iHKjSpmcu7Vzof2iYmO4/T+ORKfCLMCA
+cGeXaQ0n0Zcv4aFsCFsZoL7XhaUwZX1cCsZwpJ4/hZEnLViLff8QWt2t+6ZPnjPIc+S3t6FYOktq
+EZHpxghX0U/yZWcEnBwN06RDNkGTIIQ1VV5H6CSsW6Ivh2tbgR9AQ2YGg5cUSrtUufvTNkqScPPM
+CW7yDDec4IXCHJXVLp6YwN6VodyemMBrb2H7kz08xvVRfi3+YcSmSd2LlwpqrUCDvZ2dTY+FBRnV
+pQp2Oi6qt8vq1tcel0gtRn3NOyqqF8fW59YeifAuFz3d66vDZerzQBkU5gW/p+w1DaD0pzA2X1kU
+flJTHFmaXhWLChNZaj4maNDaTxvFHPN36XcoCRmcHFEXb8SJIKCrkgHLV75LJx4rI+QAMT6oEi8Q
+1t5z6R+0NEaLZx5D3uc3McvLiM7ItW1o3CsFzyTYp8UpLD32sYBE35+Sovl4JD8Flq9nirVqUNVF
+LOc4zTJBbAkLtAxWpXaioFxuCOVa2lNVh3UxYlx1Ly+P8WuhhjEskGXaHIQnxf7W7X7Y+wrGhWzu
+5ETzMkGmmHEPyuOe1/nAXVFrlROV5kofhUJaQHPPGgwu+oaf0oiQjKDMy8unBYePKB1aol+3t2bH
+IVtuz30OHDcVhPGJglw1jw85YrL8U3VLk2prtqd2a0aqNZ3ynE9xLSkjSQyDivFMMZJ7ZeF7NbqD
+oiQIcq6rRT6Lhj1lthK0UTBEcRaZGZQgqwIhG47CAhhC/7wA5ng0un6Fvo4IrECV1fkoDQr3vHCH
+BQetZknpg4LFOcit4zivI4+NcUGeo2cEO3ceJWgszI3BX0WXpb6j7kT68uFQTwq0ylWaCW1nahTx
+JkMP0vgiuqOs/tyy4lydR/4F9maP08AyWVIfXfIuKVpJCi4kn1gW/+oSy5Gh3Pxr/LU7GFA7Veop
+pv7M4T/hFKzx5DvmvE1DZXom8vSwzorJs/QrlI7U2Bz5hX+GtfFP/DE+87O3zifC1P5QrpkhvxOy
+wDwP4a5UwvzURn8Pg8rs2rErqtW60YpjGdEuNMv1jNt4y219VJRsuqr8lzrUMGBkiOZn2sWwsK7S
+OtYO2CX/tjdWR04LI/ZNzVgWUoEO/GWkDiXEqTf9rvulRjfo4ANhpjK+c4cR79xkFDRe2icLTS4h
+tBufdmOX92TPxLE0m28XhZrCOaVhRdlxpgQdUtnSyBleVPaQIrNImOm8h56+lRInam2ZKV32frLi
+06QcbK9qNM1lsvuM1GT+SHXCmirhpFGnDI2kOI5BENJ+jFGdH6Nd5/wh7eQ11eZaM5IE5+iRZPhr
+Ld6OPjrVqMznRQUclKNt4UQI6BKG8KZ37tZqVVS2T77bRvMGZvPe3Fmq4hQjC8VibJZSh2+gs56V
+s9bgHujcF4XQeF/q2SzotkI64kquzLqAKezLs2JWtYy/NttB1dzeynNDMez64NAhjszvrvkHd9/b
+Kf7Y/C35Vbp8McIU3X8w94vKWSrMTCsHKcpZ6ShqUiAM1ILcgBCJw3lVECjzNjpMnXC5DBVzR5i8
+vrX5aMdjuzz5CJI/Y/L2o61HRnyrvaLGq4jOJVhKE6g9OirC/ue3GPa7OyedG/SX7f09biKdY7bA
+VZBEHk/pLHBGzGxnRHHHwi9XEIJEOyMmvQ5VIm34CbSEooQYWEGZqfrULlGyQcxI5b4FkZVkeldB
+Zhr31ziS7fXNHQS+5gP8Ggb4ADa7HdLhVSXlLaZYEX+t2GFmJMnaQMTMgN1/yONIlBLNEoxkqxaz
+jDJwP3uDTeRFP4yuQoIhZE5cRONc/e7pJXRYGMCnx5F5uJi/CQgk0pyFJJIHGgipQGRs4VX0Sidn
+sL3f6p8J5rq9fVPMx0tJSmDbJr17WboEUSA9/+Gg0w55DKMzZhikuJpi+NNGl+HPKIhTCAQ3JMze
+goxWlZFXt+z9njMsUp1BsefntHieFkEFCYM1zBQEnHLYiyKQMYRW/L+AQbvFf7yHMXtSBLBvyaT0
+MBrSKnasU5hfltI1URafoTszBzLKGInmfL+i9E8Os88KvG75m5zu3JMCwbvfwRKo4Zl5aAgkezA0
+QN30UqO0dUwTK0QkPeqZ/otfVZCM0iLK0UXzlK1/Q8m5LBqSeFgv+r1z36p0kJk5lW1UurH5byk7
+l4XbHdm+X2UGqaDEtUqrN76toPVKQY++v0W50X2jr5vf38TcWCFGyVvf0eZ/ZjV8XdH/7GK4z6Le
++qeKvt/m7LEvQMI+R9e+g9FtQBQdTYHqlbSeCtqGB4w7EHK4i6T+gSUutNhqmxs6HuKF8PLyUs0Z
+865y/hSmZEpXDvCxVComIkAMKjWwKjQBf5r26+0Z5WU4oWYApcde+dqORRguNsineBWOAM9hN5QH
+hGZerh+TBgoVYb6MREKYyqwf/ILNs8EG8CgjSI08iG9vJ90PhTvBE4uicXN2VPfuOd5o3kxEzJYh
+M2qm+nKrapQj50wEYgwFf/OIpmgGcHsLYh4dRXRtmuBtqjAMKcH7cXNAy/pDITfOVYz8XpiKjRne
+a48qwNt4qv+E1vHjcEJ2+o8d8xxP0d9zGuRacQKpr4dwMg9W/nrYRR0KYTokTaHAeptF/AoXxUBj
+0ouAbsCizJ2yyPADpjsnaA/GfpkHgiF5jkkg8Jw14g55SytUjt9J8mIxXXniv/NZpgjjr48w1GDG
+AS24yEz4EyUnZZIk3fh42hNw4PjIFQ4CyQix+t0hy2GCEQ53KUWE2r04609HYXZE3DRIELnEwiGW
+LNfBbFFa6uLS82ONLZwhwo0KBZl33WnwR4FIGOrqzB2JUJBT0miPArl99tLpaEAGaIKD0x4bfsMA
+FJ+zqKvbCdGVAn4RCtX8irzeEEb9hMcC7Y7kkz8SULMWkeCGXgt2UbVCZNNGFdWhRJWjUUL5mZY0
+B2gZyFFl48AYRZgbIBVU12BG05svL+dLgTLFOh6zuJCLF0MfyQblM6/XkQZ4deCR6KGu7qzIJHTh
+xZW4OSUHe4b/0q0VS9TNgJYwrCgNGLuXq1q5/J6ZvH7Qur0VcEJziXPC68RZnM3chEBLIzbG+Jlz
+lqnUtlcEcyKP6GH7vgiIs/6CMbfSPgVt858VLDyF9TYtonh47X8ouBkNf2ltaqWZ6Qpf7mcIk+Vj
+T54Vc6C6zZy4WZkpDvhPCoZA5fzHc4yEVeAk/lwwknb8XwoG8wvSe/EsS8f46teCEXY0FlOnuaaX
+iKgmkV1vdvsI5Ow/LWZMwsgSjEwNIqsGXcRVRSrmuZks0FvsmGio/2fBUFYSwcLepvyO5I9CXV7/
+Yr79HCX+b4UK5vagCN4Xekl9KPSN0ItCgvpKPDMyGzSMSoGICOnubYY+U0WqKEkITyMCLW28O3zB
+yJg/nRaN8/ACj+iwIY/FJhnE+o0fTAy3H9RrfKGFxQJeOTowqCF7kg+PumDRfiq2VNuhYEtS9jGf
+CaGomwUVQDk7RxNBgTiQu9SUlnMIpYtH2OriR6fEuNyP8iIALDN1Y78UlbsLLaQbhsFmpwPaCcLv
+cInIhLg5wytYif2bBH8SVoUaWtq2u/CPGuEEzgFJv5NZVRsnMgsKJOfi56Iei1jaVRZ85kxNxM+G
+4oQ3XpLPrtV8gjO1mojAPfQFrOmm2rU1DdBUj2cvWMElfkTh0y350xrIPwph+5mpCyflTSysUuJC
+RDMthCG5dh2zt03NCpBHXsPcCCUbcfRmGIhbfMc4l6NZHSDPH4bqvZ6frnXJ1R6fgqFmjTPyC1IN
+4zpbtQ+Bt1LCC8U7y6en8KXblv7zUfe4JwKZRYbrg+LHfjNxkH4zFnm1yTyCq7uL6hjXCax9eg90
+HM/jsZPN7fJ7YYYr0aUfHwO7eV/Qnl7Ps2OfZSbkquxo27Q6zjSppUgWwGHTQGbw8B+OEXldsL9c
+K4ewRbuF4e+XkpSmIedQRhMtaVkwRGR6sJTIahJZDbKi8ISQNyIuSZEJsZGY+LlyozBjBDYWLzVv
+b8VDk/goeSe2UIT06t0WpGxor0tqTNVrwcANkKxhkLF4xgUkMotMNahkWM81jIgxHh2L6K6jwDCx
+QA4EWY6RsquXTMaoSW2i2UF7vom4aZwI3CCDvbzBYqO6yuUaSEwGjpIUh0XW3Gj4aMbsMzas4X1s
+DxK/ZskdI5S2MUYTCeY1Uw1WlzjQZIlGEAd1bO334esLSZKiHsOGfAKn/bts9CzNXsH5+oaMljSO
+Y9qdB63asNQHnBdR8lZG14qFx0TwRCVnzvyUoisX0LKo+DW6zlmua4u7x0Jd4tBCdnogdIwMg8NP
+Beekq3PJvYUtqGHSNsNeMlwQtZUvvksQu1qgX2rwHM6I6E2t8YJgyECCUZ+ykFyCR+UVl9FdNa3l
+GUxbzS5kN7UDX4nhlM4d61SPdUqDDAwLIu1UxpuJ6Ua+ocPXmriJmlDmmIfFGTWFSsszNhDJb1jb
+VNc2BQFaadY8Lo7XLuERBtsZCYJUV2hfF9rHQvmM66OtMZmRIJNJimheF81Vp9WYCOggn8qMDykM
+Go/PUbTBEpVESLEVCW0ctAlQSoF5JAU5uxRmoykQ84Kkqdr+MuxnqRsj46W4OO1Qvi+uU7UbuEEz
+QlpTlv5B46JZyQTQlKhx05d9d6khZa8jw9ydwxDyPoVmn8awbEfShYn3C3OUOhbXdqx0YNR1AvrG
+g3GlmQUZa8fHUpdH6JCDOzNLU4yB7orLJFHyKeaL2DEfkRw9ETk7XUdfqRyY9TdkuQdv0EqdPIIU
+4pN5p4iEtH8ejwaQu5Mi0l2cuSk3+sg8P+UXxMSXCnOSzFVNRfNpGAmcMN62s7q2cae3CB3ptRGN
+wQPL0sRhawAxotmgqisNWp30Jx3y1sXr0LTHMPKF6oKHloSdFM0LEwL+VESIMCjxFppiI5gt5iNV
+P6Y64KhhLUaqNPV7rava6D+wFKS0HedYvOLJHdm4V5xLE1MhKk6gC+SY6CYcN5j0dXjEsoQbvqrK
+YRZQ8zNzDU1PmFk6Ipi7G1kDnKgzZiOJxlkZU0+4jNFylAioNH5B1rUsSjPjHfIrEutMGJSRDklP
+Ug3tUetXZ0NkMutXAA3MmHK8IdrPEXNza5cB97Vo10nXTVpbHWsT6uvcGySYGP5wEJ1Oz15EF9HI
+dxClR5Kh3AcazB+fg4SVZtfIo5BJCb2ShjM8Hxc9B8KyF5NITYafTHSatHm/UebvqO5GwaWBgI/n
+6eVTbA2iYodEgOSHvB1IzoA+QMNfhhOHjeLTl1Fxng5yn/pVAquFXiJBpuNcXt74xqhJzg9DSJVN
+kxGMG+3ZJbHh2xSdpJunIDaIwuVbcfbrTCKhkvc8zA+nYs+f8B+VPKiGm8BI8kkgE8cs4EaCnNnh
+L5SaRX3EvzDKM2wJUD0grU89xTSRyxed/ZAhHAzw1OVGODDE3px8UtUr84rfMvt0MoDRF8PomiXM
+Ife0/uYQVfpcL1BCarWAbCu2QIlhC5Q9bnez1bbfQt6wDbQ1A8K54iXHKRwMBiue9jpAY4XRFNDv
+9EzZ3yXofiMo6UWUnaZ5NKep6jXaoNnNtpoMLaw0OjUanUCjE97oEBod/pQQDCyc3Xajw/mNFuy+
+c2wqTHqNRsPxtAhAXLDsml4+C05e4Q2uOO6ncd5He+8EjaGBKSNhTCMt6rWK27bXdWM0lAqqbzzf
+Wp5ovwjZ/IriHo4PVu9WXBGU8TSrxGQx6T75Y2VcD54F9fE9pZlsTMFl+2zIzr8/rue51QHgw0U0
+iAkUP2BjdsYu2DU7ZZdsn12xkwUVxuUKY15hXAkkao8kxl4gJ+ZNEQUQ78hQEkOfoONCs04q44Yd
+plGmskTMIprxUixbHQhywxe51ltmeMVNvx/oGtgEKuzjlbIR287ghz02CMzfHBauj0BtbBzU+ZPk
+wPqRpNJjZ0GLXQgbYBXGk1RKZz9dSIlDdVE2EwfjOrg4PuuxU1iq1z12WRI4r+EQDcwRPfXYVSCq
+ZWNV4tqaLnFpv5qsbrWqvbhC95rjqx6hZ8XkHH0GNPWPOBoN3HMXF8fIY07RctjamoyJSZ0b3N7i
+NNJs6Cq3dEtOghSkPzjYwuXlpZNqFnuK17b0HJ/CsrwE8jXyVEDNs5UVFtuhWVUUzUrAxtgI2BiT
+r73adefovIpMDQXtsONE0EiXvTU8Fdh0A2RjjOchVhfy4f1gpAN7VOO8ytspGVAFSioP8FC8GXKV
+w1CM9Y6oZkeGDt00+9xuy+S2nb7uyyCs60zELOUi2AYJ9hTHVK7QTR3ekn+yCVmGLinI4iSGA7i9
+KfPu3DMo5gaDiqGg9o6MjEnMUsYZhRiV5TTDrxDJmlu0YtxG0xuGJkH9RKgELRFhl+ccHUJ7wzhj
+JEnZCZ3+QQ2vs9AanSR9uQDyZ2n2RtAe7bVATrtms0ymdF7rOpLNKLGw2hfCusZB6XdXd6D00cIe
+CFmB3yMb0pnJYtcxF/zCp2wu3VSf4sQZwrtxvIpCQbJAybRaKbCfe+k0qRVpzdLKrdSDPrcjb+PJ
+HX2pKbSjI6JaJxFKZ9Wa5GI44LCYX1UZNA87MTaLkJc2HL1SX60UXWDHClW/YHAXz1pJXbFw3mom
+LOsFtankh1T7hrPnhVduJl+Xi0enA/vbdiSs1+LW4BFxs5KCexoIGSHioAENaftRYj9M75KCc8lu
+ubXOz3SvlqTJqvC6m/A9zO8Zi6YSFcl2X4mKsgHMug9VsGna2qS01+VnolHW67d4Q8rrpVJNh8Rq
+f3KtCeYuZkpXoAqQ67b6MR8FReRcUw1Z8ekoD/rMJH+lkurmv+xqIppZAevUL2jhVdkVmUPrJ+V4
+d/gai4V7BR8y6eVRwNHLbiqCd20lM9glCPlBvhuyU2rWdImBsQG4MnvxGWBcb9aeAdpSqJP91Opq
+uc41DTuwHsQvMz/mQGZxnvxA4HNZtOR4/h5pXxadHCggtnUfKgvxjo1cUmuJv+VLfqUptTRcEffe
+5+WjCag0ErOGkK88W8hPyD6nbLiGHgX3LSHjqi5xQBqUfsEKViRWmwEC49JiVfrNwWLstSMviel+
+ofRSycikPTRaszfNUJM6v1GVY7OGYVFKI16YSLV5gupn9z3frEOxzBWYJbvFSruuc3N5ObOgvpnX
+LORFfHrHp1ppp747maNV1/cOwnbEoBye9fWeBMpYzMeUTWyNUvIFhZjgGuVicoGRppvD1Xr3Zqkk
+DzZ3XTx2cVnrCgZxPlnE0Ap2W5A0ecOg2XSXmxmoWyX92tqlHg+yp67HSzTE4Mtlg9xaMjAvowBh
+42poc2a5gplS+PPMWsPGye+GCmtKnhqSmjLL/CkkQ+BMME003IH1i9T/VgpeC7nlFJyHlXYl4+N2
+qyQliHp55zK7Z59IeshE55TEoO/hnA/RD2T4hnafaGx0HmaDBsXmRjhkpdSDWpnT4E1pZFMgRyhZ
+cBC+JaGEtDaqOMHrBZ2y9/SCQyq+Q7zpmGOBH9hBhucYeuL6yjOW01ng3Xe9KXneyGj3Okg63Igg
+a9aQcJCtcS2UD4bVtjRdTkzRV/khkKaCdIpNTvVcz4gxGooYo2kQWjFGR26qQvQoxi60g4iGOvQq
+5C9XMVvAEI7c45prkEgJ+Ghk0NMVZc0aScZN2I0lFCEOmNZVGUNv3sIhvsVNRDZuQKxrkEwIFrV0
+5kfkZT+b9eRFgCUYLaCfUVMrLOxrTir49ZACdC6i4rbvu3mxSAZLWQAZh7n/oGBkNeQXGdPmDX6W
+Ma2U9JNsxvoZulWvtx/tGIMyyfQZXbEJatfaBLVNm6A22QQBFW8KX74DtGhEC+qU7o2Vda2ZjujK
+aFG8l0UDKCUOR3nXiZP+aDrA+Nk59GGVu3hK75dGje0nUK5pNvIL00ybVVtBIZMqbfATVmfx3dct
+woBbi2y+0VqYhBkNrGCMZcQNWCkEHxl9JCLALfZ9TJY/8FCWIFisDZnC29sQA8bU3GL0RRm25QSb
+UGbTSIQNg6huYs6DqHZiBtjaz/FE7gvaYWyMTa9zIWBnGJS2KCYMbxCkG5CUFoOL29sEssC5dXZ7
++wfLuhgO1IcVd8ZuauZpWDtP5zPPvRATcW0s3Gsaa9jN06yJ+6ApHRSOL3oYCUkmKzvi4Osr7kix
+61iUJu0YaiAiJgrwb5rpWYAzviZv38qrdinkRRszHsQ9NfPQBvfQxoIf1pG+o5aOLBczdSOeVS+K
+Y1ZZRf6I2YvFn7DqovL7rHbq/fGMmIFRhmpwTXHFTA26ZYYvyNEIrPYKS22qb7+wUrsyPM2mkwLx
+xmieELSXK6Lv0EPTcb6I2JjYC4r8oauN5/ez5q8opZDbFxLZ7e2N9U2PDTLCUDjPPDYm2ru1vtn2
+2BlPHkPyRUahgTe2TZJ8ndXgOfHj8p4gThoaJDOUByoYJ6wuqsMMXsRjqho4F7e3MmNmRtBFzQum
+Z2X4aYc0WTIgJXoicmPkSzinkFTWyIREmohCcpOjgseZzmaCNC/8RK1doqyyAEHTA/VEPLM6xDAC
+jHp1nNE1Qi9AgDGK/3x3G1liVg0V0neB+EuVEe4qhi06TYHxCQmyJSHIFjKWcigYleN1BCzmsdQE
+MEeEY3J6GhSCvuP4EfhtS4Sg8kSFx4noAvBZo1FEbX+GtLvw0VXTTmJSadKRgEQJP4D2x5PimmyG
+jPM+027FWIXs6nHaC/QjdVj/LBcXLLVmM46g9fUjiyG66V/pcnCj46tTk5gzcySoFw/3/e7wQKF8
+onsMgb6Nn4ZF+NX1uxkcXuX+eLR8sMyA/xH9x0c9FWKYWeljv1Icu8ek4R2WJg37tiHZhXUJWYHf
+cLxuxWgZPTBOiLH0I8PFQZOMq+yeQ8Tk0uARMYrrUYTmFc3oajJC/iaX3sIJua/kEhFxj8Ss41Zv
+8Ryn5vT+4u6jzdkIpATuE0ceedwUUgAk/+oSiy2aJOeAmuUjskkec8QX0Tw/RgLHjNK+ojlTk+s7
+ue+A8TuBKsFQwyR3HI6QTuQjZW93Go1UI4tJmmPQF6Zhd5FsSDOR+XNFi/MwIoTvQaVGtarhfaxY
+w9cTAgq8EcOsB5dZpfn5TAcsuwfRGZWJzkgTnZFNdEb1RAdLOULropB4eyCu4bQ4B5nib4F7KwEZ
+Vin4oYH7s5vd97Sh6aw/cJbcI+3Ay48Z2Gwv0sso2wM2BPVlq23PI3VPzeRnpckvH1gw/Yma/jsO
+N7EvMnNfJGJfZHwp1OwOPSNyjSA7KpeL3DU2BNHn7DvPaeEec05Idp3q4CCuwoLhae6l6ec4Et77
+2gdUdN0JlMtayGgEYwObz4LwD1IJSWtcP6WVOyf0vFKDQuCVdXVWG5iv1E+KGtbK9OABc8/JmQlG
+dA9Zy5219W3gOF9zjnMvk27Dn7LAVaW8zlyvi//4dXEM8mg07OI/Pg8U4DVPizTU++UgM++RIx20
+nObbAPghyVfAcoGwQ/IvScYxCsJFAbLbHp9W1IzA6Udb+k14jeI9hVkXhRm5OkUw52DHjFz41jUm
+WrjOujcznysidIMtn6Ub7mYk2pgHBnw0kidJTqIBm+rPRvjZCOTzUNYLJD42ntFImMD5l3AhT1VQ
+qWFwlqFCkx0bIGYGVpqCMfNoo+B5qvZQbpGeXJwwucmS7nFwSF4ZHHwT+K9fDqin24xMvv5lA5Jo
+nI5+/eX73jz3KjVB0+MIUdSGwrG5kBd9wvUA7XrQHwIXIH9PWNeT+NeIUKJSz6GukY9lExXGruis
+wdEzhw+KlanK+OM5gHvSysaTzGyKY3ZQ28EbgWrXh/8C8TvuP3jIwyjDtieAOQUpSocsyM8Zt1dy
+HFo7GIT2Ms0GlNAPPmVumWTFDIiJZwKPdFTrm7vmSRY4T7AFWt/e92bQqijMoqzaLHMUKuXQN7qg
+xKSOKa73Neo4BvicRMnBAPZhImmjiCrAzincxecooXU3CM6Ps2PnapWSSGGKmDx4Juf5CSU6PTYO
+zvk3JzTjYxSdqC1Iicf2uXl7645lU4GpmdcZHM+JMZ5j0+d/wgao0eVOa/nMlSohv2CaWMDmV4QB
+uR7UuoRCIzQKMpOwUVen3MdUnkzkOIRhnpeXp4oRyPHUp4sPIArcRXR5ue/pM9sRpG2VdMO9IFfr
+bkmwz0A88MybWEd9+bMJkKuJ0M43sIqFuXN+06YVggZ9xXNFQc3xFgxhVlEzPQxe0VGsX4vhHbKa
+LT8mW/o1DzXFiM+EXkHk9gTsAandgJ9tt7Sf6+NgrdVaXk5/Wsc/p5nBD+P1XT0ECz+CsKoCqoLy
+TcRarUm3WQhPINYPzPAw+sjm/GMwEBBLDJcR2SJ7XKbBCLz2BAtqpUWxq9XLy8tVPMJXQbTgkiph
+s+YIuzodFTEMQvGQMgxAVqVXnhENVdi+JIKqnAV6nR3nxA57TSoWRDk6HoSMCstSEP5aYizkVs3c
+J0iXZUH+GfziJm2SEIhiE2E4xbU1ycz6pW5BNV/4KiuZ58tDNbRO39g+rOsPfFKI1yxU2H93MxEc
+oOp/J8PAXUOPxJGrosIjnBaiL/SBQzB5CqS0NWzEINDMhgGv+t38Av43/F5+Yfg9/ILBKGTyjGEl
+lmGA6zcMUuIcCOJ08dED8wICcobxlPv0ZB5DNYxHKGmw5j9S2Ptd8dMXLEfudPLjCRBWCqWDQwwP
+x6lkJvShSrwDL5jkYv65pw81S2YNZAYd7GgIq6DEX4xL/EUnoXuZzQ3JZgyNY/HcZDPQLcA8UcOF
+bIYstpZHCJeXhcvIWaePeHDGgd63D/Ru35fH+dy6sdlnRrP75ml+xkb3OM1j4zQP+WmeotcmUS1Y
+PUKbN+VU/T4ncnkvoGA1HeMxKOJQ4Tmkk1nbEAQrxSJm4ay+5LRc8oRKTu9Z8kSVHBq3UsvL3GHP
+TFP2ZDXXnc4wFiCYHHvck7ShO7/m2qPNr7YiqWtFUt8Koc11BDCKbsdCDucep7Hm8BWbg9S02tph
+XWuHta09lai2Vksft9hZTbnndeWef+UoPG510LnmzLuD4ZPg86NyuLMQL3qQtcqhCP1jIWtkZDN4
+JMbDhr5FHRzJTMyhm1iUaWH3wR+1IQ8GhBZenHOLCmdMhndlBHG9mRHLOwvuE2Ag8o97M3aYEa60
+ij/9Ki2epdNk8N0I0y/xamsajwaHguS8yAxd/VNLHUa35JkyC0gkn2AxFAcD4onkWBA3wUeD2CE9
+HsQGGYzKNBhkxGG/xaiWeOOe3d7+gQEpYsSphGlLA7fF+iDR9oEqKQTSSfAya5pdEHa2RCATZjQM
+eBvDuCQ3aeuIYef8PghBBvAGUVaoGHh3/uyV+QeRjIuVPwZ4MSFTWd81QxC8MG8y5w9fPIc9xOHj
+MrDgICsmEdM5JhF9fi+Kl+3vshHZWOCB+4ymcUjTgCExyaSCP74Psxhjl+dkTkETj3YSGM4uHJGl
+hDGR1+bPJzgTmHoqZuvvTVKFwgnlXgen3dC/FNFSLgOyfUH9gWGxMlheHtzXsEabv6CXOyk8EIug
+gxaLl6KxwRnwrvS7OmDByGNT8a4yasHUYxN6qcYKJFJq+b7o2oPMIyV2h3seaiizw8yMFW9ewBCQ
+2hC3riPW7xU7CfYNhvxIs80nyFmfsM/wXmyh1/AotxbWeok3PSuBexXI9c5XCKxuPeHAefNZ9Yes
+NL3+OZPF+a8Zr8T/PGN66q64i3q3TuSg5ZuZuz3Ruz3V6yq0119cs8ws9CPoNeIdkTqxYMcCnRr2
+DGodEHLQYr+QrlJEV9IsVj+qf60TYduQDou8qtEUFzg/+H/LWnNCtpojcaaN5lhWwlygUeZECdOp
+uPaeBilUMDo23/RIBl+almqbQrZWD4ui1TEkTmmEDFI/GCIjJO1MtCPvjcOrKRucKMsf1FU8vHGP
+/zXrrXizh2edgu7Noj6arXvCRAeVGYr0ZTOUyOY5JqFDp5i45WXjB2KXicVhp0IjchLFC4mbI+AD
+DqOz/auJeUnJr8SZcwb8zDSYKiCchCLPe0ZsY2WFXYNOgMd8jdlfyzT7a/WQ9iTfajII36YocSXd
+zwiqm8FSNMBAsbmer95kiDGgfiWIrPQkAwaYbFL6KaI7P8k46yJ/Q/k5yGbnaV5gaFT8SzhyuK6l
+qGShH6JN6PJy3i0jT6Mi9qGJdZivjDx/5JngPd0auBk/miHMlTdzr7w6Rx77BBMnU2Wra1tAvF0K
+ebgOPgwxahVK11GinNzrqkecKPKwnaLidkQjlVsjxZkzVEDw0crFXxjCScClPIRYqo5YgQqIfiU+
+yNQeMThAVyaeP/FMT8muDXnIR6zAsfLYkrLmFyh9l1Ifzy47gmS/ZpeCUhoVf0bS9m4yUaSNDBDF
+reIBicVA1I5f9/gNCp1OJVY25qfKqxodEXwSzVGjHSMTDqW64ilA1a94Po6E2B9JZ4LMgKLb48Mw
+Vy1CeKY1rzE0mCQ8Ue846Qlccwakh6/gCpbem8w9MgUMvaLhzYH9pvNqgTaGoRLsmveOYElNMQdh
+0niIHf1kGDiSi9OFiW0OJyHmxDECwiFHy/NK6eVFhnUxp2msMp7X6yk5bq5JtV53tTEOeLAkEU7M
+5cWyV0rCaoubnY40bL8Ms0Te37yRo2jCIYub4B8acd4Ix6fx2TSd5o3TqB9OUW96HjXEnWsD6UHj
+PMwb4zTDN2HSSJOooeaGUJjpCyzShFYOma4mTAaUB7VA0WA1TowCSFmWNwYxx67lFSMW5w9x8gN/
+25QKbsKBDzi6pYbylmeQJPMquLt6Y0xvwSGav0xj4EdBEIkqBlWa7RNeJYcit9HoylDiSGLzYWFd
+xCS9Exd5aq4+Q3euFiK3waviOBIwXRGgkbCgrma0skoTNfYvZW4o319rlGU0C5hOLNjxZsAbpaSe
+8i+1LGWEltQaqiOuoUKYZkk23ta6QRqfcJca9xLEhIPMfcsuPf8V/+tJArq8jERF0VPP4tIOg9LL
+RXcsKhuCg0kWbsWNus4yWkF4KxdZUxoKcYyaGWIJe53Lsi3EoeTJLnUrK4R/GLmXHrskjcKTWtE/
+6hpIv3994AEreJwPLZE/s63n/s7m2PSqD/7OXCP3AgtmFauSUfQPAcWDaDnnHC9H80Kdf8rWOQnO
+6rBbzxCz9UxgtaKJHs++D9JgFqEzIJnrCatxup+uEYZzSq4Kw6MKDCVxDrW+CMkcn4FhcFO1Rc9U
+hIiwzoUjrrXQz8sG7KOvMWCfzEBm57Lar22p8yhmCOdzzlkzCaDChplr7b4h3330xaAGla3FKk4U
+3GBRIsDI0AskzCUFQxWNzcxlZFuklJtjahGX6sZCdgTujWAfWdXqfaxAYO5h9c7mbgRc0u/Qjmh9
+Y/vRmmGi/oW0MbBL0b9rGJ/lPsVi2BM/slkgMfNvhol/Qzov302CPxDuDxc1V5+E+AO4TfkrvL2t
+29u4jtN5r2oUpBWbV/L5IoUJxif/k2PMHiS/4bXN6wwNbdH1KwpS/BO6hHkAGUu6PYZS37SI/Kfk
+UYSiij9Uj0fT0wJRAi1tYmKcngTVfcPtryIYU6+TBDc167Xetcde6BU/n5o9U6vlqttGtXqv2UxK
+wWkVCS40kODSx+vddHUdkeDiYL0T/5R24pUVLzyOV9dNJLhYHRScDLOENZtNkJCURWl0iMAKEZyD
+BwP0qTj7MOPwjAJ4x1eL7QYJ526f4zre4NVuNPDfZU3+hIi/HUWNZzO2A4v3kS/Uu8HjGwcZL+Fp
+08ma6MHCsuYAA9cIHsZ3IV8qzD0SNKRrrW2YGyBVBwMt8PycMzZfpuEIAal9oLARIQ9vbK9vbN2v
+bgxmEudwhl2TSgmbkKhAHyAvBo8jcrQRGRA9TsiyyujdmbGt9Y211td3dmp0dv3R5tqWRzZYKGvA
+dMsBQNxt99Gjra1NBMPKXOodSqKZu9lab2/I1k6xtUQfiCpIgzZOJY5geaElMT5e50U0BkYH6IX8
+0u0jVY6b5peoKszwJh3ehM3nbzy3z0yv2wVhVws77GoWtDrZT0CdYI0WHMtVrlCNUpuSmEAeQXSX
+J9o2QTXBY2jCqPmnZ8En/eMtwHgWnkvjgEz/SwKgQoV2CAx7xodDa9tvEHj2ICGweN8acn/KxuHn
+qPS9PwFCkKCHDdXAn3mh9rij1ynDBV5amwQBYCTQUqd1ce+lrptICz1SLYJfWcRqek2vishYqGvr
+7Va7tFDXttfW+EKFtJAv082ttZ0Nvky31nfWH6HaEpb01tbWDhlpCS9kCYlDp6q4HzGPFzj9YTSC
+qrc3sRI3IBFgQCQTAXcmglbVo+ByoG8OKgBbIogklCn6fsN5Egjg+GmCETmAWzqNSNHKBEQF7J0E
+DTrOJWxrMhhFe/DnLPpAkGUCMqTmhYG/OpMaaeXjpeNdhYPBKzhBogG2Zh5IBEhPR7J9rldqXFM1
+HR3TGEI44SipUnNzbEqf8qwufVbXufKXaXKExwXPJGAErDReVJyr5g5qJvMJd9tyy0NP35p9rdRv
+ZEZtUHnq7Mnt2j+toXbnTZuKPg3LxRjZudnLK6XilDkkZAFaVJkJjXpDMb3MtWzlmrHqYo/cenpY
+dKKOF5Ge4jTsf0aYQCHREK4pkAK/9rtj1HwXnQw/Jl1XhrQzoy87mv9To1BRsyYBMOJAG4IbWbeP
+ABZXBdeIomiFznAKOwQTuvwPZ/xTH/4zdeaIXi7UIoT0utRmKWXt8j9cXONF+Jl4YHUl0x9fPqAB
+ywzBdfgKe2csmgVrTEJNGUly+91BMZo0u5W9GpxjWDJoCBsHda4A3AHAkDV5QlOGNJr/RhBYEemk
+m6No+CK8TqfF/nAIp5ZPKfxZHLZntYZ6tPLp2kwoypkJ187olpvKehmNU89akXxV4VEMhM8IwGBR
+CFyZJQIFch4xLIVcatznKUIBiqL8hQsqNWEhqRrXE591xjWtS5tmHbqNJRIb4go0iiRVaanZrnXb
+E5XWFYF12j3nUf+gccA092TomOz2Vjn05/YsunHzDVfAZRKPK50hFDV9eoFu0Fsbm3AUX+MJvL6+
+CTzjKaZu4ql7SefzKcLUwqG8trO1jgC1sOdPgmNasfiP4ZZ1ZHmZaNveY7wQ4S4i2Uq7p3U3u9Kc
+Y1w3IdKdmy9YZHPQQ87wZdJBklChgwMo4FqCBDapfAbBSz0jGrz8Qag/6gPsTuyarlJ7dvGkL5py
+3EJpPraEwMP0qcVx4LVnX97eZQRKrNcBQbvATLpDIDqiejPSG3AYkyCawaS6vGykxUGgmtqN5dPt
+7cj1fKMTEcuN51j3usWm7g0SCN85evv6cP/k3Zunu2/3nzpMzIt/Q9gvQGoJLQM4QXvdDWEMS+sb
+TqVOFTEHL0+xvqy6lrOatcwmQruMMWBwvF5XD3u+1Fo9Q0n4SSw0W7t9M5O3NwUFQLLZX6A7Wsbv
+1mgkhKvE7uACESkHrrMSrTieM/PRhjgTd1nC5Me89Ovb3+EdIHc+QFaZgJ1QTWAbFPNHRHfAQE3n
+qKx+TqyBMUCohVOfTLg5ccaJK4ivQP7Vy3N0IYZkxz9nZ4g1hYz5YYSTBkf1ZZgNMFAzbGytgFxe
+PmOfgkxR6ANd2qdu3//EXiHBvEYZKmPHjj2Uyv5L/Ch1FQ3B5nQJX4kOwKNoJpqdqWZKr8+rAi0X
+3gYHdXgP0sLYkrSLqqQNyyDlV9PQmQvszM2MvapI8SnT3SE1ptUdEM/mdQftTUR3/DEzWgNrRgAS
+q8ZQcsY+lJJJmzkKXgH7lPHYJn1YH+qkqkUtgvPJAAfSIIx3nau4KMN+cRgNplfP+IhHOObAXZqT
+XZ/JUcYTx3rZwDbEwCoYSQb12Og1EsOfdg+10MdrPVj1d567eOXS3OOGsugL3WL7GhtVpntu+WTT
+3/DAOl439d/yo/FtD2OMy4pFSWiCDztISg5iJ8GxXEpRpLrmlYRQ8TryzcTINRFF0iyOYVeKz3zx
+AvfmXYNRC6GkgcrIWmFM7Mm453ETtDkFUqxkeU1/0jE5qzETMa4nFiuDUkaVR+FSqIJ/PJZTDoWw
+SQ+ZhFOc9k/wB6b94O4unncnvrEdJ8C7mTzb5Yy6B4WzSyj+lSrwkO66YCUcsSv22mNvgldQMdZ9
+CE9tuuh+s7z8hsOYiXNF/KLuvzSKGnpwIj21E+CkfVHO8sROWGp77FnQr+vWC81XYHSRp+q8Vi/8
+M3dsMAJoE0/j+IaNYG/tup/Z8VP2kj0BPuMZe8E+Qe933T12PGRjdsnOGH8JL9hhD7+DNMED/n33
+qJe3T8GMOXiGkEtDP55xT6sY+J5narvfvWa75cKnFa7zYMb+9vy/sfQp+5sd9LhK45xo3RhKdtEA
+BafwvFkmkXCcn5ukPphYvxJ2Kn2ucuO8mxP7u9zUc3McIhyHOuLnFyIovSjlrNSCs7pGX6JLBLKt
+8vYUTcgJI047Zltto1giXbrCv73FP8DitR9Cavth4UdLaG5RgKBneG2p0Ja8IB3M1PBM04Fd+V1U
+QMVX3hbqbSGLaQt2SoAZfY6uEUgaDlUzobDi9VLICMu3CErRtxQYlkxmpQg6SKUqWEk2apGCMz5O
+0cBxCbp6jM89VvC/nq5J9l8P0VszjrgirkyHeONxhGsuDRrJTKkdBtEEmIL8dSLaRUFPU2Mi35RC
+swszjNJXhvBd87YrD5Kad57fpo8EVr6q99BaQHYHM3ORCn1s7Z5IatqSNMfh5G1KPyizb6d4s878
+r1ECMLMHdsOsVxjxpVoAjqeMxGoGza4BakPLeavEdE6JKfEoVBo8gRg8E/BXL4PjOheOSk1R9xAx
+efgc1t1qQg7B2b+tAyS8kcc4KSa8+hJMsxhV79vawNp1Vh8U/NU0QuODGB0nvc6cwcsQobZGHwIs
+liWA60tnjNqhdzUPHJnA+BrWtQSG6anB6gmN0dN/71CrkabxNVQTL+xwfQbNF9jcvHlP7t28mhbd
+ufvoEgT5fBIowwyO1OwsGtD6pGtJYJ4VEbOKYwqZKRI/VYDNLkxh6I5Ygn73STDyfDem/YfG/wnq
+LO85jjUszcwcwmc6iGi5hSnwpTIxcyP6zQo3wT+hed79bca1FugLXLHC+kGKQ0LMkRiNCU+SW5gn
+Do18RvI5jpyqZwBNEDZT3LRnaQJHb4z2pkt9ap6KvBqgQzDIzUCbuy6QI/K8YUUd8XZHQcEhaehu
+CtsNz/5Z162l9aq0rysOmjGQzRgHS0N3ANPN8gA6Qpb2Kiebev50Vp2LvsFqD+i3z5tCXe2juMnr
+NarF8WsxCzPrnRV7pIl+yi/DCY27oLTk2yvSnwrSJl+l8hUucZ4UKikT/RNqyiN4vbrS5AtVFuoG
+8Jo7YnR7mPCHERqH6LlFc3PYbN2//WeI2oIdxbe6h18kUZCkLDFCinWSx8CuJKurCt0cyKSI3qHB
+zGfVzZrUmCMeJMAGwy7jVofIbQEdaTgrkpysOA1oAyRk8CTpbOPyPEoaQr2EZooKdQYyJs06BcMK
+2tsac/i+yl/qlw+00l4pfyL0TYkIK1hU/Pz1nu1j/4lc7As8cc3JexYiJCUHDTI87p+Swz3lLk2q
+/sDyjXlJrjEFt/8pZbR0aE/IJx/jpCAYX5rxbCW3/Hd+v1NzWqKBtKE/5M53Un8Ywz4ZBTGn1H2j
+yttbJD9xmUyd6yzD7nt/SB7+Fbpl6OsG3Vf+AIhSXEfITnW+M8h3BgJ2XHtW7OuMl5Dxkl2p/YUQ
+Rdh6hFSz2soTrIYZecqJ5Spx150EuGtC5pSm3/HYEbwqYJs51bmGt7vwNgM67+iJdRQVTtyJlsMM
+ZZxUrjqspLKbr79Vetv5WjvFZwMRqVIh/4TVUyH/iNlUyN9lOMp+n9mj7J+z8ij7Y1YdZf+U1Yyy
+vz9jV4TPTizJBwxcTuvyeed5MMJrwwLdjk5OyZJx8I6g0HNY9s8p1y9o/bP16NGWx36l+5tfPPYz
+3u5Q0p+U9LO0AfoDbYCK4DE3eUMzKTQLxCh4ozDPG0Ayrgo8thpoCyzozA3Xjy5SLaTCAFreXk6I
+/tsxXmczLT8YsvTHD3Fxzk2O3Ac3ZSU+Wfx8BLaG/RYQaQseZ2brE7P14Te1/kzdJSLuhv0ukz2b
+2yvdrbDarcM0LaBTSblTGXUqnLHfA2WZA7th2vyy57lZ9zcKOOr/CaP5wXVd5MCgz3QoiWkMg5tm
+s0nGemQ/EQeukOXd1AIGvr01SLPg+9IyJUdGEm2obnjcZTMOQwy1h+TF8gfaX6K1UBQFijGEZuFR
+yhtFckEhrSlDOD/TWhEkRP4VBXmW4D8RD2BTRMZYFLxsNc0pTTOZEhXMQcMqR076qHbSDdBkzp3n
+00nEH6EDGEAAqkKP8Xevjnaf7Z+ok/ZDPBodRv0ovuD7FqUv9YFeBajjEctKGo/+KnzRxTJJujHh
+tfjH2gmxvLxCCkYjXo6q66dkawVLKS0vpZCW0gjEGmtaUjl6jTAQlp2e6+xO8K5GDN9I3Fi51Vbx
+W8jUQzRxPStayMSzdBRh2RVXw7VaV8M109WQrOBJYSVsgpVKKjFcT9BDXDBTryKKZcJzMwycRbbL
+kKSmrdn4EOaNM5g12Iiaz0ok3UPeMTFlqC4fBtoXv0MXYxBfgBenR8+P8ZZ1GMajoxj6VYyub28x
+IAlGSibXIsPdZBgnA90O34GB5uMHa3p9fWNtY77xHbf3XCcTuqdsb40eIsuWjtvNWbZ0j7bb7e2y
+LR3d5FtRNZANFEShuL0tHktVkiccg8QvJdVnQcuKgI3WGD8VZBCZoEFkZBhCJpqnnNbJw9yptoz8
+IHloQkOnSMvlONg15i1H1+PTdCRNfKJj/rsZFzyqSo8rMfGN81//JVOBb7HQJ4ZZOq6pVKhSReUV
+A3WjvYhYb6lG5+O8N/NR3I/cbXSflDqs18rtKUOrEIM4ERSwlcJd5piDYerxg9tb5yji33at3vjO
+rtxSIuPDf7ld/118e+DBLu7627ftzdv1NQ8e90bhGCiJx0t48FAgEHpd3jepLigNkCnXGNtRyjZh
+AWf2pMD9mE+Aggwo6CLNAbArKtRj86/kAASnDEgNZj2FNyILow9CbJLw18ob4yns1vOQouJVJtv1
+GpxXRKHH5TcKfbR43kEz6Ik2Kh0qQxe9J85N8dbWcCMxEilAXg1VNG9ALnXHi/JQOE4yQEuaw3gE
+Ta5V4dUW8TTi12Ep94VqRglec+IQkSsIdysVujmk7yqqzsxQgJg+8kG7U/xUps2dAvay6D/fNJom
+FyaBLohAF/+51j0Xw4J36kutOq9XpDIt1ue216izL8jv1b+jn3lXZOAb/o3yRoVS7voUQeR9o2Vz
+mlVXwTU18q45QKN2M0BUNOMXvDfGLfyZIBskQytMtrbxS5KPqGO6l3M0nqaWhMYUHMzFPwF532bk
+gKv8AHmhWbfw1+jvMUE1ElCjdr2lNCxVJ7R7npWhXc7Q6nk9f71cZoGX+F9VcCXD2j1qXpyhXMLa
+XSWsfXUj76yzpltfP8JfOzJf39E7p2ftzp7TQsgeBxuVdVAcr//Ti6GSYf0fXy3tu6r4/uW0dlcV
+63dVsX5XFetfPZLt7/9i/bs3wd1frN+9Ir62jvVvIEHf/cX6d2/m9lePVfvOnre++ou1f57EtL56
+B7a/YUt+bR3rX13H12/S1vd/sXY3pfh62vK1dax9dR3/yBH8taTi61f3fSjadxPFf4CCtb/hIP13
+MCxff1p/N21e+zeQ3n+ATra+ge/4d7B3X8/cfO3qvA91/m4C/w9Q49Y3sGnfTUtb309cv56rWv/6
+GVn7hkPhu2l+6xu42nuJJ1xB5TEUk7XS4+KfCZNbVWLb2mphDoXie0Wzo68jOXz5EgepYmlwZiic
+0znAPRJUzR0I/CChP0EDJTuoqnKR4gqEhmMGlDSd8RKMBUWQ+RimKD+PIu6Ox+OLWT4xN9zVhbBT
+D5JRnERH9A3hWX6OrlnO75KEaoZu6QuO6w8P4Rn5xvQl3Dtp9MmPA6/Opfajj8lT3rxzNg7qTNha
+lRt7bXKUrQRtblpoQq3hBYTIcOreYJdl+CzqsB+xUpf8glGsZwSWXs2jM5zbVR0YijmresmlHiEJ
+z2Z094XtD6W9sak2zLzFk1rIeHW4mms1VAiYRiiweMuEyBEcPY6u2yIeAYNdoiM8XnS9ItQ144f+
+WCw9r3ssnnr+cY/t219eyu6ZRcxfzktnunwRh/U8EOs050g7qhj/2t1XN40iTNaFWY/UuMHP+Cyh
+IuR6JA8/gVe/qAKjNE9ckFwFY7dQbqYGyHHJNh0NDYZkaIBLIJ6xc49diYBcl8GxMwqTs2l4hsYT
+sjByW7oeUdI0L9LxkfwF6+dteCaNlEqrjDtCXb6AhFcUP1Sm8Eyl9CLM0MpHp0LiSP1Qt36yav1K
+pqBBEH6dy+eUl5aL3LKV/J6Pyn+TYfPhYY93RHQJzT/yQsWqN0Pv7f//tdr86t+iNt//X6s23/8f
+V5ufBA//Sh6eWU7D+rTCxcfvz4T/+IBvJe5Bbm2B0tk1HKVh4TujaFigV+sAw+McxmfnkNZuTa4c
+ebYlesvYfpxw+oUcgryyEeeRErFXRGTNClGj6J+szkMed2MuTuVyZfp01hAf9nGnNmAaFCvzG5dP
+QiBcRORo++vzrfCYpqXc3W81v4bBvVo9hyEb4bBF2Sp9JAMf8z7WmG8kXbT89pOZwdilzPkrcfjs
+uzfUW78wsP+SBw8R+0+eiJhXVBCz6oj4uRXCctc8VoVXc8Q77TDBhvii95pb8Y2B4H1a1QMSmQNC
+0f14aZRdhX6+10D1REeKGZOnh38sWknckICSjGa9WcWVXQEUBjfC+MN3YjorVk9Haf+zw8Zx8iEe
+FATUlhkjnhhxgiWmkdNcexSNMc5DaT9AIsO27EIHElgAmOwgi5QdkS2l7yRpEjkz2DC1tu9ohuWr
+5XnlXuFqTz3LtnbP2tYS+yETi99Y6qVzjLCWR0BFo7wwNgUFoqw7OYk5VadbLcx6ZHAelgXpcY9M
+SHnJZpkYQMA6QdmwbiTyLlJRn0I3DXUlwZRly8uCSTwPPhNmU0qxi5P8PB4W7i4Qx3NNGScU/W3I
+d30gxlP85KyPWg3DUQS0DKb0/gt/qBdiYSy51/ZR940CEwHAfKPVD8G2tzrJT/KKtJPIo1U4rJgi
+BI8YJcHc99wbvb3SnrF9py7aJxxFSObIZU+CDaeaNxRRlgtFrwyxxmAsO6+Nj2B11yMuSy6CNyzi
+skKVtfhUhZPg7UDXB5gMLjWh2QKMyzBYbbNzGBzzQl+S/fDbB1yDWuvhi5jegiAXlfajD+0s70ak
+0nV70U+Z2ol+ZsxJyEo7TPjHq/3lj2YG7RgrW5xkeRm4wVTyW5/dHMYwhjk1thL5c2kmw7yS125+
+3z1i6B6psPe7OBvIQvpjqkThMF5U0Zv6x+c9ZhJBjH5gBKceE/LHiac9LYxTsZPVcWXSeSbGcNIT
+0aoV5F5qDhpD3cJPZbK1a+mAoKPgzO0LM6XhCiw7Jflamyw3N1lUu2GQLsUYHoJ2wkiHHUspcqv0
+rBDLHkdmpd1bXuZ/1fhUElCNNLija7xXQrEwDqyWD+7T8k6fxh3GAOpmLTY2AtdfwAhhKTGL5hAK
+0eULEdCTvrrGr/J7fHUt4j4E57PzlZVZ5/ynviSI3oVLYtWQgrLZA3gdmLPWVxZ8kP16efnaAo4+
+hbZcM2u1qOpPNbkq0DV/yiHpaWCFtDRlZpifAysqY3qZ1+mXSqLwQl62pLqJTNVNVlHdJLbqhp9/
+xkLwLG7xle1bafjfKg5ud1qkPBAWe8MOEXRwc2Or5bGXgfs2OGRv0KeylosXigIuqshjYo5y7Y2Q
+Pwz9QY38MRJCj9QplMJQ6mVcdJWewuTrfUvpIjmz4x+wzGP6+sfA+LD3A+J2zHv9gyYcP0BWDOgi
++KWygtAAqunf3vZ5nKUSTzXQ6DPny8uo+5vH0Z3p0sa3t2OKxlQjJ13rbBfdtn/BTi1u0laYsP0a
+VvNEl7CP47/PdiXjR0zf5wobuKc78Xl5+TN7bTGfh7q811jea1hCUVOqW9hT+MEVLuyFzvm060wQ
+uucpewLvhR6GPdMZnnS5nOk/YX+L5cHe6dd/d90yKnU3w5AiGcVY8f9mXzDsqqHOYe+DitErtwyW
+Nq4iIAcPBEv4D1wxcU8VT3inigfPS877hSbvB6wksH0I/yhC/2Te46B1e1uxshX09Pog31dKHhnb
+BVG53VTaKCvOIEWf5Euv8yX4cnv7lvr3IBh0y2LzEbux9Qz+KVNqCH/KtzZFDDU0Cf5Jndh6zbQ6
+w38341D37APGvBl9ym9vYePBzNdvS6gBwRDPMoyktZeO0sx3/mM4HIJQ9jx45X7xug4W4viweOJ8
+DE/sl2DYregg3s/VTnyAo3rm+XVfaDLzXh9XXX3aPqfYnvKXkcfzn7M51WFldETbUs7UkHL2ujeX
+53ERHU3CfoQdi1Zx9zkzv5wOEhBb+uLN0Xy8YL+wB6yc+oxN2TsQBzTS9+7y8svb2z1cLrvBEhF8
++H1AS+PXoE5ofzfrsZ9rMQ+t/ZWZh0Mi92yqw2/gLn8vWcBXKKiIfVN3Lq4K5IcYWHBRaA5Cvtwi
+cOJZAAlSXsq6EvCPybaIzsBBgyFB5fFHulC/sM9DN/FmCFQn5ZjlZf7tklGwWQZFr/IVxKCBZ8cJ
+inoxc2/MsfK/6NYVtF/8d6w8Sv6vsHaEQ9PPamRh2n7mjHTwK78B+DP45P7MdtkhG7Azds3Ea8ny
+XLMTtjf3XgBXTd2aWTpbXn7AXro3yOn4f5q8SVrhTYaoaYbGvgSqf4aQnZmcsuCwkqQgEV5S618Q
+57G+oQC6n5BLxwtPuP89QyP2tdbapnz/N71/Jt+/Q8v3tfajLfn+C71/J9+/R3v49qP2jnz/gN6/
+l+8/oDPJxqNt9f1zev9BvkfHwvXW1raqX7oXivfkZIieJ/K99DV0PfZHcIPkyteKDKHPSi+ibDhK
+L3/3nRCWnVLd+k6ricorpqkgEMD19XUHFglRQyIJQA6JDq6il4Tj3wyBdH+IuJoLzpqBep8XGRzi
+i3LwM5bec5ruxEUI7LWq8D+2t7dV7mg8OQ/zOK/9QmYqQqxRfr651t8erquXMnyckSMcRI+GxudQ
+yQhW4z2ySh/o1Xhwj0xErxfk454vxvu1YT9cU+9PYSdGhfF+sL4J/6fbjSFs9dvhMNSv4mI0710e
+cfVa/duwgFadTot5X3+ZpnPfYYyI4iROFr2OE7mG6rII5e7cLufE2sx/DxILBvs2S+/317Zr5sZe
+M6VcI3J9Gc3PIDXXOoO5aClmjz3EtKNqOI3+zunOqfoQKFd0NTE+62+ebgxbRruSz/PfjiMMKm00
+2Sob97toVLUdYuUxs7mzWedphZa6zqccymB/e6z+pcOe1L+6Go8c9qX+3XWIL5/Xv+RB+X6tf3ka
+5ucOe1D/coKxPeEIGUHhf85pcHgR8ps0bLggqL8FN+EZuuT+wYDZDP1/hKaurRnjG4bGfpye5uZK
+st7V7NWhwxYQ1/K63NjYmLt3zXelxWO+qtCpIeyG9QWbsvS+QsdK7yurvvS+QhRarX5/c7NuZc/J
+UUOY1tfCMIruok2lXFW6UMpQIsnltwsOmlLWOjJcaYxFDEpvq5Rwc3Njw1patZSwlKs0HvPKiO9T
+Ufk4LOUrn1mnp+22MYc1Y1bKUUN2SznUBNh9LpeT9vn77+ByFvMtMzZOk/RzGP9DpGULHZY1dRkM
+BnN4o+HO2ubW2qLjkmeYT2HmHaB3fVdzpN5ZlRzlOa0v8xH2W8Fn6sU22ImG+i2BGDTrFp7N8NZt
+xNPh1s7gdD79K72v0LfS+9JGLpde4RI3o7W1aD51Lb2vHC/26/KuE68XLYDykXTnJ8ZmmNcKm27e
+WWINQa8MSy1BLw+OXM04zffINsmj6SCdn7HmKCrlqJMxSmNRT6Tm5bq7wCpTsPVoq/0omssXlF7X
+0NVSjhLzUP7+bnpqnSPVbBhiJRv8Q8RyH0T/liaWT7ef7u/vzN0u5df15G+7vdvea99xLm4/e7L3
+5Ml9aGv5pBRf3rVq52WrrNrt7b3W09a9RIPs7DR025vrrNHeacE/G/APDKtXtzrmfLzTZo2dLfi2
+tVn6tmYbl/pQIgylt3eMk7IkmdtzdX31uP4sKOevHpilma8ec+WlUT4sSu8rzO6Tje393afzD5N9
+6PL2k/nHxe76k/3tvbn0vjRkFEoxn7/+K6dNqfmlU7dU+r20OcM0G09H4YJ1fSf3hSeqf450AXKh
+PvVp1E9FcGBCO8lQlzhfLtp7tLm5u0iosjMoAjenwSXy+GgfBm3PeluzsOpy3TW5i+lBHStTmd7a
+U7O8Rhe+rTIIpU20kHTWHGelNi46+eZltavUQxueJkOx8+tGRzfcyFjZwXoHhpOwfx7dr0Aza82Q
+qVEJs8E0TlKRs45i6jIxFm7xSWQtrbqnre2tLSvb3TTvjtFRy6s/Sj9NM9mb8vJQxdnZFoxjP/1y
+n/72JxOjr9XdYTRQMfuLC1TZqvtRj98gHg7rx1hXGN136M5G+eg+TYNzNb24Nqr1k7Rw/WGc5cUq
+GUV4tY09D6+iuxYEKsHuyjOCXt+rQyPgzu5aCKNpeJ8+j9M04cq5++ROzuLk6l57z8xZ3XqaOkyG
+96l3kqX9KM8ROPYeufNFa0zverz9mt4r42U8LO6avIt4fGfbWO2JjJrZxaXPWHqax4M4TP4h/nx7
+7RRlP1lP1IrWoo0FHNjOen9rc/3rVRZ3flfl5e5fVVzTwrks3nDYH6ytLTynNze3T09bc9gsW2Nx
+b9VGZenz1/N7V+E9B+s7WxuP5ikySm/LXBAIidtr85iC/ulpOJjLcPC3i9QDZS7VLq8s5JVe1+gr
+SjnKYsndDapuu1KT5sl0c7JVZTo7Y40mopSjhn0q97KefZqX6+4CKyd0BBuj1ZovHJXe11y7tbej
+nc25nHzpdY0mo5Rjrgi+29pee/L0K3Qacut9ixIZshTpOM2y9HI1Ia8R/2beCOxs7mwvGIHS65o5
+wpuSOh35PbJay6L0srRnS2/rCeW8TOXJKOWrXpva72umvdzYyr1ztLP+aLho/9blqEpOpVzVI6WU
+oURYSm8r0nnpfYnEViq3ybP9tvbiv9Xf3FpwEXj66HSwub1AEW6/r5Dl0vsamlXKURFV2uHaabRI
+9LQzVLmH07WdjdPTu3gFleueHI7F0bQH7eFaW3M0/Uf97f5mhQ36KiXJIuKB+NaIn/0bgUZHwWNE
+RP7d43abv7PI6/52HPV8go9ORxGH1v14GH2ZRnnRILurxg8PbqLZD404J7BdkA/iEYcO5VZdyOwK
+6zECHI3CwUeP/eHN2E5rbWPtDuzd8d8EuTuJWPozPQ0jtvuMns4j9uI3ehpE7NeEnsYR+zCip7OI
+ff6Fni4i9vwNPV3Dt9f0dBqx3ziY72XETh7R037EYl7eVcTOJvR0ErGnh/R0FLE/ecm7ETv4TE97
+EbuK6elNxN7x9h1GLON4wS8jdnnOkYMj9vOf9PQiYqcRPT2J2KsxPT2L2PkHevobWsVLeQf95a3/
+ErFfNunpfcSS3+npQcQmKT19iNjv7+npecTe8LH6Bdr8gJ5+jdj7HXr6OWJ9/vZPaOknevoDvj2l
+p98i9lpAGxcGtPGj7fXWTgnaeAcO2c0StPHWxvr2pkcRVhM35yFMMKFPCVMO+9re2EBM5CGlTTC4
+icJOHlDaOYZWcSFju70NtcLbDXjveeyMXo8xUAzUBWfUJsVGhrQLHhl57dHWdikyMnyLaVeUto8h
+EqDkdVhwa1jyzlprfcfD6Aj4+gQDIbgKtRnyPdpc24LXn+n1rsf2sP3tbWgNe01pex5GanUftSkm
+8wGlfcLAiFDzVmtjHaOkYtorDIkIg9HebG156McAaW/QqDVzN9bXd9Yx3iGmvcQwh1DH1s7mFgY4
+zNzN7Z21HQxsiK+feOxvasJWq+Wxd5T2t8e+YNEwRzAe7ynti8ceYL719XbbYx8o7YHHnmNTd6Bd
+HvuF0p5THAJ3a2u7vU6RCCDtVwpE4K5B51toHIhpf3rsNwpd0HoEab9T7x5hMUAr0PBxE1pYRJQ1
+QmQUTG3vtDA5weedNZxBlvIsCWQJ6cOd1hYkxzw5hOScvlzD1BE+bmxvbW54zSfT4TDKhKXNNAoc
+QUeAPhKxeup6zQOBVNyMc/lI1uwqFmtkeJuM8Ue3T/8iLvQvR67nR/6N4YM5jJQNMcvQFphya6RY
+RKNWwMnpsPF788/ms3gUWTmWxpWPkMrqJCSzWYDtP4q+YDqnuBkbRh6060WcF9zL6A/IOOKfCr85
+MRxFUILmXjKzqpplZkLRcU5+/PG4hyF4b3isMESITzH2Df9OAmRjCMDiOD1u9RC8mz8sL4sH6fOf
+v4SZiCcjUbt4692Ih+CmnM9fajFu9uu3Z6w4/vjgBnPOHtxk8J8sXUTT+9gLRAsYsWRRQ/yc2RkR
+e+buotLjdo97volSKEU5QM10LFWamYRm5jXiYUeDl+EErcH52CQ4QTMja1HOquayoKwa29pch3wx
+dCMfDlcDIDpaHODM8MY0ci4t1canM5wu713sRbWNIuLJdRS8d41ddRoZmCMqAict6gFfgnJBy+AY
+PKRd4aITMQLwY8C/G9PD9PKri6QAB6kus6OjF1fGA9KusSyCs5mVqt43+13wgnVgwIzJALEYy6To
+6HxZ8LhmQLMuxlvzPB8hPAzKcqUpSwdbnnEIJyQAOkqf2l3uFBbINPIvaZENse16AID2OWvoUeBG
+K47jIQCXWnZekxS9rtG/k0g5FS3Np5mSaGAOQYBkM2EADhIiYYXXLXyEQumUckNZ9BG6Ovm6CN2I
+I+o+lgiENTh+OMS4DSAB/fVjcPyvH3orP/x1+eMPjnv8L6e34jmd7sOY1eeBLB3IYucIHEr+EZId
+K10lP4zJef3IHskMQ5nAORRdRX0KpMoDhWLYGPW8vFwoD3zP8MAYRKhbe3d4oIKacDg17Wkh2WYK
+eOxqyoElG+gZ9vKLDFSO5vG/mg97P3JsDvYO2v6MJoEzaUbgsM+R8mUP+UTLkLHHPZrGHH3YER4M
+5tJ1sAEksHuEFEZpGXD1MVAxBxk2kTYOr+LxdOwgvyaT4kQkDWQSSaLIvonfeC0bFg6ybrqcFzSC
+DrJxuiSVeC0Tp0kMwgUsyzFGqDo1CpBpl8b3Mm1fpk0w5EGGbtqccF1h1IUldC2aAhMoAlHgOhAB
+K3jk3aUB/Ode3d6eAOmgaAe4uShx6Wp5eelEHaliNHcDHYwiGCwvg7ARGB8uL3PqiaEkBPLHnv2+
+vG143j4GuHdl218Hx7vsM9tj1pfVKBhYvYMLXjamjj9hIPJRiC2RycVuExi+xxyh09BvWuJFnBTR
+We0bRWCpTZXjR4YDCaJy1rrmY6xy3JivxcZ8TSQOTjaPmCCcgk/wX+KpKD3kJu0ciiXbGMbRaCBF
+0AkPdI0LOTejLotOiGkPb28ddKWO+3TZ/5CM1THd41Qq4+ukJugIkYDgl6PXr5qTMMsjOiZt5yrV
+wjeohgH2JROxcUS4QYqhcRo1eJgOLAobSx7PB4RBgDQHRHtzVy4vw0k+FQsGfslHF577MHhTMXhT
+GjwdSS8DJgNh6ahBN+im+Wt07UeMiJKvx1A6cPKYOaiQcMhxm+nGaJ95x/OwzgO178w3vB0HcFDz
+eGAizlEaAJVCHC0WsaU2UaqOaFaz2URuKhWfpoxHu5KNLURjCTWMnMlhjPbFaRKoaogPjS4bh9HZ
+/hVybTyIijranPd68IfpaJReNgS9wHhEM/Sn3Sess1zh/EGRlxZNqK0Uo4A/Dtq3t5He8T9JAvyR
+fIB5reJ0b4RFA/YiOnzdFDOMtjJ+cNOmUOOO4zu5M/tIrbmstub0ztYYbXhc1wacXtmOcZoBT4wx
+LRc35NRsyE0SYQT2cXpBPD2fGhi+GYc5uLt5cGJkU6JWhSDO6G4pRRW3xV5SBJ1fjpCGUuDLIkX0
+GCIGqm9ZM4//FixFxL+CTB7P1adjUnLiimMc2skR6omgxAiD/OUoE/InhBDCyJwIwYaVIPIOZI6a
+4WDgJrQCGUcsoCYY8oASosQKJpdQvdlepY3BlNOcKG+EuASjQRPRg1Ds487iwDPSmF+bYw4bhM//
+GSfBZ7VDW5n29xa1SdLGKE3OMMrPeSimvH8OFKoPewDmfYnmHT2Y5byfVRfgxaKaf5pTs73eF9d5
+Ua1zwjs9qa16Xm9HUZ7rjvKyJ9Wyz3nZ57Vlz+vPGfmkZuXiz6vFm3yCqIIIfOAM6LosHtNGGHeJ
+aMObOH8VvnKfwkt1utTRLxzUBuZ6iyVg7Z7vTKfxwC4NF60BQ1akL9DRaS/MMVjf0sN/Hd+4ve5x
+a3UnXB32brZnq+p54x7P7bXZsTfrdWW4qmhuS3/GlvFWqqZpZmCpHNGr8r3ISCUwxds28o4ebIls
+YzE6alZFpZzy4NaF2oew1eWPpZb4S67d85ohi6Z2dEw2u74pBmNVbgkM/mr3r8GK+1cT/3j3GERR
+2n0rN5m3ebXfWSmQNVHMfas1yT/m/gyDuqe/iDi3YhBKAoiwuQTYgpyviAWDfz9+gVPbrMItqLYp
+Lrk8HjjzcxR782aDyrpjUGSSUKPsRSU4AVQ0xPnr3aN1Pwugj6fXkzDPJUu2dx71P/sJvJh9exhH
+AjkoC3g3ef88God+yCaSQUVwFvjqZTSIQwwl58czPFSziAONqGbOlOj/GQPfhyxlGLh8xl4bgSjl
+kEbNq/EIz078i9GcmUrlUfQ4+9R88CCLhpQPCQECLdPEE+Ky4izxl7z3C0caWVXO0A8/daHcxkWU
+5ZAjcNrNltOIEhDSgXQEzru3z1a3ne7jv5K/rtb7S6urjd9fvmhEV+F4Mooa/TBBxghpOwdCiAad
+BgagbAg8vwa2F0UMFQKxsboKJUU/cK6EuiCXlfgpkMQe/nX0418P3b+OVrwHDxEpTXY/QH2BhHqC
+wf6tOQa2CliKwR4dMNDAfd4+AUILw/wpCo5vMAy5TyLLQxFTmZP4eHiNc5f7x5K09mY9dgCfSCGo
+x17Z0WHlrrOqP6JKnwETdkQLxRMBSlmoFGosDlA790lu5E+R4veLJrZPRTA8xhi4GAi3aNa1tecD
+ST/QGthfXIyWjRsz4uJY9zNudVLHsDXPT2fsbX0XXiklCFddhR0U1cIghzX8pjmYjicufxyl4QCI
+ByMcVA7eudqeqV0BmXa3Ya0iNhs24ThUOF8gSblhcAUNCkW/Q9Zi+r1XFQXLSiDmcPIEL2SEUrnk
+GmRDJ5ako8MWa4VQ8fCMOQ3ElmdvIusKgC+8b8SMdJxvx4xE3J3qt+u1366b365L3P6OBgMjdhwv
+ZjzBcfNLGg+B0uBdot4hGrV89xD2kzjHCq/7OpJIgA9dHM/b6/HI06/f8te4mv1X+nnGDmFRSa1y
+hBcmRYBnwCjlCoJmHoVZ/9yi9SJILvIvUmkAaxwtxjK3rbB0lx1P3bbgHXgj8yroSSBeG7BMUj1Z
+8FCwKDPLwgKg3tFxreoRwzAQRVlenqeaRPgpA5iRvaR7NMGWag2keQ6Oom7kw/LlMU0NZpI502II
+FNVjhU5FL/tocwPX51MYROwOjV5+lGZwyPg34WhyHvqCUERKaXfueDTSowhbHGZwtJjvPMajipY+
+5InzPpVvoS1FeDavBaVPMSjwC2NUguOemrwMJ09stYSH21VrHNYnrgP8W/Dj/zhjMFuMDiB7KhJP
+7+j/XGvhll5xvJ4IHOFo6MxCJC3jeD4xztelpQ90m4H665+Rm4K2sAKxtTzjluaZefNTYbVxLaAy
+DyV9IP4vmq/owkd9/bd5yQSH9O3ta4tvc2jkztO8cAQkmP26vbbVbMH/tcVrjmBM6kNdybtIXl/a
+92hNYDesSzVDc97hPIMpelOyVCAcVNhLnOm3lujvrKFCjS6W+GLpc/bH8RBIzFOwoghSL/knefsM
+WUqXfkCeAjerFFTO5an7HkG6RDXdjHDITFxTwTB+oZVY1UECK2TuUb75uofWLa5xauS4xGClOR5S
++vdUaAESoPslsjKKxXiCG+4B5RrWjuXDf12tKooKeT8szCt0bLfqEuBWaf5vxQXDrbhVMArVy/i5
+dTPIN983nlVI4JdaplLY3A9cac/jVYuLgaJ8lR4GeI9IoWDkfPXVJWXoWXd0N8jAFMvLiRseRz20
+phJ32fjTx38C6Bu9oyMIRjLUW+OX6M6419wcAfV9uJ5KJ+Yipbx5jYXcVSS5K8G9aARQQdftuytB
+NczTQLf7V5PqKAFYI5UbH/nG5fPP8n5QfLo7GvkFykSkJXse5ufA1oKQ1Jp9eyShjiQ0nMII0qJD
+aIvw2SQTvU0PBvBlPIzh0GhkUT+KLyjIPUbEPhhjEQ0lPDXCHPbjZFqoi6dEUg2y8vS0FBbj5RRx
+p4GGMqY5BB7gHAHWlpdTmKnlZUQs50cJ2jfMmg9ukhllWn1wo3PD0H+E4umLSnZ4I9KAdy+6IPAB
+l4CEX4/7n9buko3H2WDGTMCwq/VOl9EBGUooswhc7gUsZM/eARrb1cNbal3rH9BuucR+j9wUidH6
+mlfDSBgr6zfzohS+ivErJz8P1x5tAhMwnaBKDUnQIEZ07Noyfo+sWE2aRq4g5cMYQzrpoSCGOinA
+FEdaRUQFkj08GeF8JDsheMJb9f3xpAAyAYf22qP29jwTR9UmE7PcZOn1Jl1aWnzdRBfKaMbS5aBL
+dJ7MhA3layJ7yQybg/Zbd5hc/imzq8VQAdC+kUwxMv/ncV6k2TWpGiYRt8OE5/4ozSP5AxUpvlHM
+jDPOSpTWBOIyTgbppSZwOAZRwFM7Chu/kQ6PHSzUYc6TUXoKf56l2fhpWIROzyMmm3+CNBG4Z1EA
+xXC583Y+wsD1jAzZ7jVUfcNmUpg5WjaTwrDQspkU5n/SZlLauAUjMgkrmulQXKszeZvOhC6MaSs4
+dT0PIuVVfzTNgUK9VEnyot58qZL0hTwz7uGZuj9n+sad6Yt2Zl3PQ8EJL0xYeL0eOsax3Zf0XGiO
+iu8l3KMy4RZCmJDaxWvXW6DTom1hiXDOaTogbaVBnruySJHGfzlM1XBnFb4sIa3liWLrupZYqHu0
+ucyp6iuz0hvVUOBkDZ5T2AV17GGT1j3H+vOCyS737tfnQuqw6gcOL9bmDOT9Zw3kss3Wevt+1Ovq
+DjPmtTW0aLV2JFDqdqtsxbzVXivZMQubX8uOub22vrVu2TFD2vbGzlrJeFkY60rjZbHpT4kbDx5L
+kxFuZqUvcZGHFI/Ly3Gt6pz4TJL+2KVJrSW9jCo7jxUBMjz8xpEuWVud7Keok62seFiSsRszw1Cy
+wz0G9xvRFUzRIHeBifNuOFcrSSouSLQnpStYOBykzIWPWJF9B4uRERVnkU8nEcwjLy7zZrho/qlS
+sSwoEu0Y7lNkx0D6HVTLns2kfdE/poO75OvhZg+9/f1shoJGB/5rUkKwr47ja1cgf4v+6TwZS2Cb
+bO1sCb+KyiYpmqfXRcSpfQ1o8kjct2N4h4RUSbKO9R/dbCXxHm6sJjPS9zyBcmj1VIthGBuIiooD
+5Ddhe4UYNHRKSy61Ig1gOBBdRbGSYRXZzG1hOBYP914Ltl3+uNWNVzf8mDgAvlYnsFaDDQ/W8TFC
+/YcZMsO7OMe9n35qb9+Wk1fa9GKt+mINX2xW09c9aPNxf2WlFxSPH7c3l9cePTISts3f8LhcdNZg
+H+aksqtr1Fptmx4/3rAK8TrthaW0W3O6tlHbs8eP1xa2Wa2iKc4rKvnqZ1axXjxCFCciaZD85zpD
+QQamur25vr0Oc90CGpqspp38p1EnXwliT0ggU5jvnOUr8eNRd+TDX493Ne1CT6Nj2Go9Kaxkx9DW
+td4K/IV+LW+u91bgiIYTz8cRTmls0Op3dQ36ve2t1HzdbtHnMLr0OZW0JkpyNG0IldJtprjLLKAw
+SPBPGhgsqtIUvIuTYptGqKsfffoXw3PtPtl7uv/s5+cHv/z64uWr129+Ozx6++79h9//+DM87UNh
+Z+fxp8+jcZJOvsB5PL24vLr+uwVHyMajza3tnZWHDoxlC/eMhOGPf8o7KzBc2XEMdBn+YclxaE50
+7PWCWDNeIysihihj6Bb/ufG4VRV2DxJuhMaVDM0GJw7GPafk7tBPAGQpHoYgiBTaOY6nopZoNAPT
+AzTEY8cIu47mHS0f9vR/bhji31TcJ6llRQGYcMjzoICVk+DKWfdSnOUcF/3mMpy77fbmdstbwbSV
+Ns788uajNUrBpYypGFI5lqvADYPUg5WwLVZACM9r+nlTPG6uL4fanjnWCyI5djCQhx7nFozz5hqM
+PgiFlfT1GSO3lXn8iaTemUv0mRgSd7u1ufHIqw/qxmMzoJnCnFcYh6irH10HQ7V8ypvTIh7B7BB0
+iwhu4vBIB0D/uU9NMILNfjRKL8VPc6evREtoCAHie0uNyqiJWpi+uxKhMr158Orozf7e25OXu7+f
+PPnj7f5R8KglOhgHa+2NrY3t9c2NLb0kc6HKih7Hxgo8DJOziC/DH96eR8I20vlhJVr5wcGr1lis
+TehdI51QSQ6qfZ0fPKXtxYL0NjQ8OcQdSx4Vb+Q1C2Lzs5G+dvGYYUI9kueRZbihVG61mrjC6A2y
+qkZnZN6GPOTVlsLvIK/acYdSt0SpvOYfNCi+IZlO5S1waSNld+gJbaODai/E3UVxe4uGSdNiuO1w
+o4YRKjPEDTpqZet667xLPifpZaKu2n20ppQTBIf1LQ8eJjS3aFzXkTe2SfMyiwuugeqkS5x44NUe
+j6LUYqkm1clMxTuhqeZLFxr4Po4ujSuCkl/Uz/CVXh+G0DR34Zy7wCZR4YzzS6+HQ1hFzGSe1JQM
+cXqEDYiM2FI7SrgmSAKrWRBJeVGwBu8daxhdFT9YA3YDPa2O4s+RXOelZQRTIBdBR4yCUZRHpqqQ
+KDtqvvPUOPC1VVLVqHPwCChgNDC+xPAP8E0lvVJbNUd9pdVdOH+7EfEo7zZh0iEHl5c3d8dpzS0V
+9nqIQph4dNUML+EdX2JYiQF1pMvRhDdcru3SOsStxDuLa1WtwtbtQFlPIu+NgcblQmwZMdrQ+KWf
+TjBMcgv+r0A3JrTG1MGzRBm24l1fb6hy/tT1dXM4u/yhWL4Obx5XhuBny8t2GKOoOQiLUG80mSDX
+fyrfpHOXjDjT+DiKs6tI32TxOC5gRmpPu+i4mrFXGvvaPIoienxm/pfty5mtKjMW/NIdC54fgvNP
+FrWiyYa19Q8dubq9E9O8BQW9HKsBPo9Ws6luH5rCtrISVlnLC1/etPNAVEUnWQnaHnkOcg4vUQKp
+Ecbz3DgDi59auFM0of6pmNN7JyWqTp1Op0UeD2j8OIVqnKL/Qy5G0Cpuxc1ub1vevFJ5h+4qlfqo
+DjYdOayQmgKKFlQ5ofwFL4Ek+NU0GBZWzwglNiOUzMy4poJZC+Zwa85ugdgvMKcpGbGjObvYGQ0K
+TSoso4V+uoELyG+0rpyV/x9xX6LdNnYk+isUp1sBRFDGRhAkBfFY3erEk14ydjuZRFHcMAVZGFOA
+BgBtqyXmC94HvO97X/Kq6i64AC4Xy52TLBYB3KXuVnvVTWsLjxOYg34Pp7bs1yjvMdFdOtpEntJ6
+Uws3Wo7g8bFN9uqq9ZKqUT4H+1CazYzdFnwhcAOHsYUVfqdQa8E3SSlbb+2muAnVuE0MG5Am2h5i
+oPaMBckeOHS2ZjOz/JhyC+8iLpN+XC7StD+l38u4SjOHP7xNs7i470/FeZvRW+INp+LnMJTf/4Bq
+Sb4e7POidEXJRTl061pOgIlZZBvskTfjHvGObpJPdd+nILmx19yiN5W+gnWv3CwyVQlRNh86UxW0
+KgLRblC1PeFztCs376kljMJwEUweNFrfUgZ8MuAZ5ptlA205RZVhi/72m1UAZxSNYkxIVl5YxQk0
+1qiN44Z3J5FRsV/1Z1zMBNj1RLLrcmUTvrLqDL4grSbnT7au4/t2Sb5BhFDV/rxtz/xPu3Br9Z53
+oHrSnvlRaUbZBNn+0gqcuoTHUrc2RtbcGB/ExpCs4kV1OSMLI7lg4T+Rgkrv6xumCY9R9GRzpwwd
+bZhhMafr5EF8MqfFaS1TzwtFwJ4WJ0P5FNKWUp5N62/wYlCwvZYDzU1qP1XYbja9l3exAaUoTmvo
+HpRzBDDWBYeO9KeHNpjzVqOgvdaKynRcOLuGuh/TUrB6JVGzrdg78PS+VaZQKxRIF53qELkES8NB
+1jRRcbnk+qt5Pt/2WZhacNWn2oLLuKxeaAoj4BjsyEHXbkbgtST1EBSDjQ8JBufHFXZKnQxCTqg1
+c6yyJhZLOXkzVTzIMOqOjhdmDcgiToOz1n5HB1F28vCGXHzgR69+HMpn02yEfrmAFeVvZXNHrlU+
+g3+W+E8B/7S0F5KTROVhOsfDNEXHjPjq9YuscoKzc6M6SimYKhcYecjIWRwVs/iknMWDAeYsweZi
+E2O9YYORLjKDTR8POaTsDSkYYtOC1wN8sZS04iiljU0mHywWDyOsa2F3bMuT9WGwPC3p5JTDJVkT
+ZzHg5lk8HAof4AO7xcgu6SpVAeEgMw8YjAgYxn3M3sJw3+MIJeGO12IGlRQZ0r0darEbTQ0Urm0p
+c8ozWswyRCC8EPRzmtPAc2ASo1z6ssm9grAy60p2Gj9z2SQ9wyw9MID0JCMFtMR65IfyAp2Khbez
+e5RaUBp4Otx2f8MehUIV0GMxSBEtijdK5g1lROzjH40/wMTU4zAt5syt5NHQ1FFlbSFtqI67zLop
+BjsAXMeddEmsaNpYTCUvTNXt/o2m+//UFXy1FU5LeIzjWZbrocDMJj6Rlt8Dw6iGkWsCymXWgChp
+2gBgnwLDEKJe+Wt3FAhrSK64gEmrx3rHHD9v2ea4bFIo5GueNa1FKJ50XnE9HjaltP5eth79EFc3
+x7dpJtFIrUFBCwznXivGvOYnxUyRJi/yS+5IR86LaVSdut5k7k/hr+vNPfjrTJy5OyUKmw/Sk6io
+J720ljPOMaWMY+o50+rEcUMK8QBhlA4lMSY9d1pgfwPn0oICgEGdiXtIhHUZGZ5zWJloQQy8w8Ja
+njrumJpYmo02vLqNjH647cbqp4w37Yyoacd9NLBx0QvIbqeu7WM3y5PRyJ0Ej4/L09HY8z1T17Wv
+6bqkH952GOqnsgVRKCHiwGUCOJjY02A08kaHh8sTx3F8x3EFUOu1yL4whxdYykMCZk5jUQWxLv4M
+rIzt2hiZf/vQsV3vkYaKOHcUeK79iO8OAZWLksDHD6J0rdEDtzUQZA87iWRYIiOHtHu/4WfqGJND
+3BvsC3r7MkTSx/RZDL+ThsIsBtHu2vwcZFY2gE7rdD9rutT3+P0Pwh0rSoEr+vmvfzr/9s3zly+f
+//XNq9d/+tNPL39WvTrQJY47ILaV2MDXVdHDdZ6rzna8M99dr3fZRnQMziYFAqIwy3cJJUCPRscr
+8cBZrw1TPx7kJbrOf9wjDz5KHu6g9Y156z0+Np33+j+DBNB7i/cBkwpi8b4kwfqqR9GoPUOxAKA/
+yF1eVL2PN+niBhU1IjCy9/a+9wtT1fzS+zA6/nTcew2np37lH38Ctrd3n69EnV6+vJL98oaP+3LK
+2AhFWI+haFzQ1a4gb6uHRN6XjukU3iWVunhN5QfzTRH0CaVHBtt6vVeXXOX1pV1Kkwh2Cx3k+fJV
++msShYAoLMbhRxucPKQFa8OmUsH9nM24VG08FjdXbgJiw2vSZCYghc/LWtfG8xN0pDMscnydkkcQ
+MPvKI3tYy4FyYF5nZXzdDNlTLXzNYmia3VZUrI6uDE9yxDVEyfEbUfjwMIHRKDOMLS1Y4FMrLrlr
+NyODsFC2A0JjW8lSFWkg0f2+gUPMpshXiUpVs9JBQ7v3+HjQlAs3K+Jg7zt9i/66tTqu1OrjuHYS
+pKoaPq7epfwjDX1ZQ/kmeWSZMTGygQRJzoXCTfOTGLiyHHl85Etw18Af5HDwkZypgFthbL5A/iek
+ncpOirkztdmqCltrY105lyIjMVoim6Lt2aDa2U/R19DQPF0dA8KPUMLUZIA2Wgb0obvPDlo2pg0L
+jpeFVBqda5wxvSouNFtjrrXXqFqkG4PNqXktItMewAWuYHF14sKAFDxSw8k51MahNbiNO+f8gaYZ
+ER6OTpV0xhrHxcwHMtNcJtjsBi42yUIvThTmZmJGQdQHbFBPwIETQRoV6iFQkH0Qtv0dp+zzJl2B
+ZQ2MmBiKDBzCXaC4H96qBogaS0WYJlSF/2N85wQq/yN4H0V7Suf4a/cg2mDr4qZNMkRscG1yguHb
+tCrVWF1Yv+okmcHau+YHoWSsBk4tIcKrdQdaz90LWv9LoPXcLdD6KrSeaYmngQP/d3dAH/h7QR9+
+CfSBvwX6UIV+3II+UJ5d+P9Iefbg//6W0QnL067xKTIvhbk1DC3iWHI9uQ2SwfQd5/bpjSxptnv/
+niKNOQw6wBrlWSaqTpbfpiVMq1R83jmuUpOoTg6LcMTBSS6AjSBpQc5dydRpYzHy/b40Vml8wmRs
+G5vcOrILSRVMXKHEVRnHD+7axOCqr5weKuGL9BZEB2VVTtG7JBlE/d7x8TEUsfonfJ/1B8mgf9pf
+WzFKqDXcF/FlpBmG2Ribjv1R9PVPYoL2WCPGv1RoKq12GBJ1jMs282GDpAkTVW2HYjr/uaBKU/Uj
+042qb1BpmDfNVGRhL05rf5IMX+QN45YeL+QrjNvqFfiqR+p15mWWnWI/1alqteSvGzYStQR7wXdx
+k3mLIyNn5rIhtEE/rDQSxrShsKXxrVvWjFxspZQOFQci5PUcXdBVNZbEWAlgrIQ8cxPS7F4kyPUt
+MAbyIY7wEXrFxybXF5+kyPWlJ7Hg+tQduliurpJyg9xCimmCTrje1iJGx26xSfbhWIpt8wO7VVmx
+ZezXgNNqgNz5OqeJzpLCaGXcamk1TKAWEgFhWlJ3rMbtEhjqDZUJS6bld2mGjoWVJryXm+CZ56Gw
+ughzoNVjh/rC6rGGL02WV1SkzuNCPqZRmrGtZMneCnPO91n7UHErrTnlVj32WQSSNo7YsNKYjnNu
+xDOl2vaUzHd49tAWDf/ucQIVtw0avXRRafinwMAy4DMl0PxUdVwIMo2h+aOyO3aZms+7Zff1RvjU
+rdqyLb/RAPIk6/KrRkOKfTne374MAmJGjgdtexvIkWhfbjEKGOsbdRR4D/h1Kpz0LPS8m3aYfcRT
+dRjT8Zu4gO3DOBVzveY475vItydB7Z39U9PdAcj6BsV8LQFXpIxHiTfT6j/xNodDFH0V913Z3//8
+C/rb1NeLjsVesHnGQcuPwzjAo8bIW8bOWyYkOu5ywQCuZhkBk4HAFv31At3SpF9aXnf9Y7drhY50
+2mUEJZNW9lmCckeuH24GhGXgjoIj+DFw6pEr3f+suMUlXwPtQM+4Tc6AXDUoUiivUgwwJU5/UJ0W
+G+r8XNxjSmN0AlvgvZbAutzn2ZXAJtwVTjH8/Klmr0Sa8j14pT5rTyeHCu5I5EJhon91muPKxpt8
+9NquwsxXj/z0FLe/YpBJLmfDBBCp7Kl8jTralyoz+b9o7oVho6VtLFAqN89Wh2fpOzSn+u7EnwRj
+dzIyTTKbDi6j2IqBoITWfo/MkCsbPj3lLXuuqe1E8Oisemqlamu7HhUfzB++fKzj5miC5uOo+eh/
+2Vi95mjc5qPTeKSRDsJ6rN92dvETt8qMu9A8ZXN9r+b7l5m7BvCK8R8xph9KUMyyfNPK6zgLquN6
++BamsW7wbP8Gw26DIxff4jQt2+SopfKTEqMicYNU9c9/JuaJPUcRrzDJNQnjj6YJk/vgnQVIumYh
+58X0n/+sqEYlayAWn1ZUo8Ia1UlCP5PapMyY+9XbWDrNbjeJdbxkG6SanFQAVX5/HnVev6DXbSY6
+YZPJGUegNwxLq6wbj5PBVyhE5JEDXAK3OA4G8QkKdflRBMjfnCE1ZAUH8eVRPlNVbV0wz/Rgnn05
+mIPhsCJQGZgVMacaILHYTjBDLZRhax+J08whZSA6VlNWZXOo78YJNiwbfdijM1fb2SMfKgUobur5
+bFPPZ1/QM/RXd67v2XM3jJk+7NGz3+zZ0A1aPLgUuGkOnGA8HrtOcMTfexuBO9sE3NlTgGv1ezkw
+FCidQAVTwuxdml3ggJggGIEPc/RfRsPZ6M8GB0HYVuvARHGAC7H5x0q6wEpJCQDH6ysclSIChgrG
+IjaPmhgMYBDkHKE8y19H7tGR61tMlmTfttcsWA1xGjnJzMyBwX/m5slJTVMpP9nGqTn7d0wNG0Bn
+YM1hy1/q1OxVU1xDZcipaczHQE6Tbmb+nfgfPjtuaDHFmpENmXR1l380gEwfod1Ig3q/mBJwUCWq
+zy65m0yTGsQDtUgH6JgBHWuAjrVAP4UwoJcXn+D50DlCN8ChwBIO8BCb6IaeOmxDzjOV32ljSpld
+wx0H4WExJ3bVdsaB/VhMiw3dn31B99DtY00tntD9E+nEbjJRI+CTE9ff0PfZF/SNzT7uQwC0+P/f
+h/5ZZV/BTQkIQyrmSkBWGoAgAQM098NYn0lTNmH+fxviNyoa7L6If89J+TzaoJ2U75Z5XD3phORU
+X+gaD2wmoq31PZz9Bj04m3r4Nl+9XSb7DSLcMQgSCzd1cfYbdOHouiDRVCOXsfc6wsxNFEk0SCSp
+Y38O4MufVJNHgyoV5tBBzSpT5tWEmvZJxaNWiWoXKglkn4FkR8mzHFPySLvsoNgwlLMNQzn71wyl
+GOJgHGUwg1wMZzjMoTmk0a3x5DieeL/xhPrhhJvMTo3xPD4q44CNPBpZNkf0YtLRX2BD1x3BT/be
+oe17AeBa5LusAYFNiwPTcoqe+YB2N4J0thGks98GJA6CBEnM0iaQOqKiBKnDA+wFkm/VyrgaLtTE
+IWSuL1647IUTdKevtb7+RsjPNkJ+9ptB3obbacPttubc2w75RiETLZsSypc1WJxsAUD8V9/+dN36
+T78rtTU7O9vW2Q+/TWd7IlxET4LANzHT0DFnDcSVAXYaZgJXoTemQ4FKOtSbE+qVqCpBTSGqEFP4
+w+3piLrY1R4Yi2DV2MwAdHZ6apvDdDdS2xMXP3mUHCO3xrkNK28a6uA3GOqT8LTjjq0hiF7oZc00
+vNDVANjx/XD3FyBolHCgbxJ0noalvwAV6zv/HHz8BUi3Dha2GqHBO+ZgAy5WsLUWiX0Bjt0EqNgq
+NQ5u7pjfHAt/FhIe1ugwtJv/6au4crw/Yv4svPxb968TYJQF/L7u+cDuuB7VLZzt1YKjb0ErgChN
+nO0GQitgbGiiCwW6Mesx+T7ul9KszC67ajlgosnv8RF9w00rY+6XzNmmqbOoA/GZBav+Qh7gWBm1
+atkJS5hWYPRn1HKgUz3gWU/dPBWsZLXRCMlcFF/Bn6ppI5fWS/SWiHa7Hm2yf2Ybuy7zVbFIzrOr
+TseZ6upE429MngxsrU6yIZuf+tVAzT43LNoOsdoEVFq/etwkf0mhXjan3utng+2XHe74XLkhbZFY
+BSPq8uZexJgi/V7UpPvT5yjEQPDKYoEN6kRNu2FNbDO1ylmNS47amXI2pNkQ7k/dOzxNbb4LaLqZ
+bTAzP8u/Ctp01IgPJeSzkalzZjCHNOaZR/G+j4/C14yyGhC1wXug0JtQd4EJpZmYKteM1verQE3u
+jsCvLidvWWUyT6rm4ybXmp82+MoWJ3WsErbD3IiY0wsjq5GSI0rpaYpKANtiOWNs9c53uXuQq1Q9
+rYhiokzP/CpFjHoDBeLdYMIfOqOEz0qUAPGem/2ft+Yek1iUe+v8jnmDYWwNwDesFAgHFabFzb9O
+L9eq7z8/5t9FD+va1e1XQQi+w9t3WDZznsu8V7AhFqtFBT2ZD5Q33NgUWEkEpH+blGUMuMx6IDCn
+lT4UwEKqJMItoZfr9N1KhF+uuZ87XQj5y1cP8mHdu/jqIVlf/sIxRRUv3lv8BqG6EKDn3oLugZFc
+1roU7wAlbIMeywDoLYCsZnQoG1iiDmFtrutLfHi3OsinPf6ST9P6l7WSTOd1nTKd7h9M2tFV/SHl
+IrywL9FXmhmTgNhkA39WDCPPrKJf3uBVOMyrrhh6SMnpNuaZhCmRqUTFtxqA/+048iSnBTrJVbWM
+1n+bvkNnOAWd9jO8rZqfPLa78yg+9eYiNxT8kfLy/JfTqGfjXTy9GEjZSc+l30dHMDXhkREDK7um
+m3qmWHBodD8PHSpgivq6j6w2Dq/uCZ8L9tXC0/fd8fnLl29+ev3zm5++e/Py+Y+/Pzf42UJXLXN9
+0OaV/oz3pAkVfk0EMEGLoqmHx0Fxiep6JaUE5SQ31wab2XrG/yzD/ramOmSgvvjxz8+/f4GB479/
+gyHkCA6vZanZE79SHB1JpL5e5nShy0GdjPXPdFPCpnmARZex0X3lymMrMbvsEat/9vq7785lM2c/
+vf7x21ezdqGNnbDVYoGf9WrB5sSB/Wr0N/XQtwxNxG8yx42+3pZz8JdpI2cfc9fE216L+w1O4ICW
+aoJkWhyo9po0AKp1+L9QfAsCtTNLZTuW5asHcdDwUnVL0gwJgjqpre5rn+JfagIDTXFIaj9PoqvH
+v2CuFMEBMroNtO0FW3wDs2HQdorfYs7iU/foyHPnefRaROIWJrBQbfRAev5IlrCMQrgluubRUW12
+Qk/joaH9ZJrUxmsjNymjBeAbExNH/NJ7UU8i7hZl6vDGLzjmzUXjiPQv0bOLfwye2cPJ8+Hf4uGv
+wzeXz94p1+spyWhmFSIw55k967hLMy2UctGiJlsNTxOEfEonO01xitk7RoeHxQkmKvFF4jAqfToK
+PGdiPmCKG888HTryLjPXm1jOxLGccELjAT5tlWCmpJSyN2X718G1VhsAODCLyK4GrEa9giUnGRj5
+kJKR0H0MxZC1xJJE0fUEW0HCbDQsZ02B/CdNAVZxMLkPS9sirjIwlVxrru3XZd1u2dPT4BEzMGBe
+lkdsV6lLUMvKnq6y4z66ro/GIsyMjw20WqIFg7Z4Thdz810CyFf07nLpRI59+to+w0fXJwMVZean
+TvUAyPgp5YJENemBeiWJ0QqaNDC5H3rfyyt08a5cHmQogxD/gje7me0MZtxBfybOAYXtzkyMRIz6
+s/rGsEbW2z/WghrjEmrWFeUcIx+gzCxF81xJu8f4WmBqi0uW4ajr2P/7prVUvQi0YpdXUvoH/uNY
+YWq1L4ljw0zX7XeUiI/YufqyQJXqQDs8fumvupDafn2pBbv4ot+4dIh01M0EXU7AguQF7nGCo0LN
+ugDTR0VwgjKyNl4UlwOcpXWdsku5gey/lIsoNdlmGMad/7d6C+V/Y36dTsAYFaTACCX6a722XDhu
+Gy98EPdP+baLF0vhdQ8j+M8Eg35yIzP6PK6jE7qH15Mkx8kn7KhsmcjZ5Rv4cHAgfZc1Kgu8OxIv
+4VVa7yNCmueAU6YFwE6w7IJ9FAYOv6qCDwNh73/9He9QaZ7knq/7KANuKIFqDyxQUoGXyfUSBRNe
+D2MRYfMy3UgKTKO1pGJcfAEpR7kf+9ukXBTpHWxUVtFaqWWbog4vsaASLMoo/vQ1w0srugd1hde5
+9mMpwzlrJZXRihD1Wrce4pKVEtjcVBH2sOXl4eFKrBbdNyyCYWbFsSpuQTHlq4RgsACBpeam29Hn
+dM98fUv0mtbrrhsy1kPQYjUkfbaarww5GBg1zr7s925tTuVHtjLR3dqa+E7oywul2EWhB0pnmq3z
+sG5daV6fyrxxMdnFpcVi/9tjnFWDgZjBOs1xRXkzCvYhlru9qVKKYrxtWug3othMBDWV4aXNpCMF
+SxBZSOURtg77mAvzjFTDuiKWpJZSrh7qKTcJU0/Uikw0EEWdi97FN1NMQcluhM/EtamYaK24KC9l
+XyWHWgxCuT+3JosJv7amB3hJruDcyI95wGIEIp7cwrmSSNwoNNsmXws1hnVxiUlB6y0TAUuC12OO
+Az+cbLpkjPJDdlO97JllW9GgV4VOf8g2BRxa9ARj93WzC++BlNa+snGdeoUtaNm8tAhwzExkBS05
+Z4LFlmqxWaPY0lzWDLFY/+VJiRHfjeBpqljCMR04Ne+Ija9kEGAMK81ZkEbMfnGxumTw1oHn5cCx
+lrK050OxRVOtCTznAt6x4o6FCMLClqLUWGBmxzhaDhyZeQjv8CmTIgVm7ddWtHYuNz9OLODq9Jjd
+YP/4GNMp62ZmK/WryG8PYLUV9R6/WiZjV33vtKIgA9KszRaJ7gtgmFa0tdzVFqbb7TQFS4J3gg2W
+9QUfKdKI5+8SsQ7ieUjWkrT8Mf7RWGCmKhn5vTC3TgKr3ux7BSzkrPdD/GkIn6B/RXWxoGy36fFV
+fhun3PLExyhfbu2PldH19y19iTATPyvEerqLecrnuh96tbUXLKHr40/wnnrAAqx9wB5pkZRsQu8i
++YK2Qlel0b9gSLX3bVwll326B4Vfa//42OB4scDauIPXbFnu6gtbtgPPAdDBf84+wRDuANm+/vkb
+ecl5enxTVXc/Zct7OHCs8B/4C9zWcKgWqyKR317RI9vwMBtFmhdpdS8zenWMIHWZef2zGTE+Vdth
+cfL5x/6UTzz/EkGFvpIAtH+bXKWr206xH9hrteRN+u6mU+4P+JKXEhHwWzcGr9iY3DXbCSWc51dw
+ZLbNgigzr392ZqFuB+E+sDnMr/hrUsEgNVLGtow/9dvFvod3ahlOxPZoLcuzpFPuR3z5GTMlRtGa
+KXknI6d1G9kIIIHP/nHx95Vt2xP817WH+Gec4L8he7i+vhx89UxlwJJGShGF3AFfPGek9PXLF9/k
+t3cwnAyRtCojxaoQmGkK10VTRvvri8x7VX19uSLD4u2+rh349i6BxHH43XkP/Sr5VD0D0T1FdfzP
+8NC32Lub6nYJr14Xy74l1oAVWG+Xq/AOQswLbK1AXDhwZszWDuwFGkSBxXi7egc436E7yXEvgxyG
+0sxVviDycryAZa8SUsBh3tP6A5rQE5R2iMuyjFW7zvkywSc4C3dxhloIHAi/BzlKrNVxWd0vE0w2
+hzdSkgpZvLvLyxTbjfrX6SeQS+WHKr+LbPm0WKZ3Ub8AGECy6PH/mXXpjzewC1/dxQsgRXeAs+oP
+ydv3afUa2AU2hIgmuf7+Q/7r5o+35eZvK82X+Orq/AOM+fu0hKEnBd5pfXevankZNcygjfwOZYv4
+XcxntTpmN6Wb9B3GgA19y9bfULMc0Vy8zePiCu+Lh014eCiyyX6MiwwvrkLRDBXlK+K7G+VBgO1U
+qFgihBfnPUwxlV6nC9i4K/SysdjF880m4CmJC/xlMBYEhOoLAf4lMLGCa59pa8PyU90YjQXEhWab
+27eyDbVFf2jjqI7z7BuYaDT/d2dOfDXaM0cuRHIn40XqKDYk2dU3N+nyCm/MLqE7XOEfAUnw7Vzi
++yWuNDsopWkdyDaST8kCMMltnF3xpddoGfF9b8FK9T7GZQ9OxIosGterJQabYE4VhmKyxuryXMH1
+8lJLqxLXTul52uujLfszVpkwwta1ErMNginb60k9r4eHcoZ1baDu4gkDarTRHRKveg0oBUu/jRfv
+sQGgMLd3aO6LNYoO49ltvOjlZe/Ts5RxiVn8IYUDmBd0moGPzSpz3v9//+f/Av79pgIEbA76g29q
+PWmd8e0/Hv5eHr1P7uHf9bN3gILXhjSmA+dazSthNIaWcFQ4OjGkae8/HqDu2uqdw54q6mPGwGcn
+Y32dZjC6+4cl3n3RVZEtAZjb/APD1/PGE2zKqXjxfLmkdyUwlNbq8LC531kZud9TQ2plFkDSJiN3
+ourYBBVz/dAPVSVftrZCzx/ZmrKh5wVeq2wQhK6u7Chw/XbZke16mqJj1wsmraITYCJ0Zd3QmQSt
+sl4QhpqijjMZjTojg/9qyvqBO26D4IyC0Ne1O/HG7ZFNJqEbaMH13XEbBt/1dO3CPHbaDUZe4GrK
+Bh4MpD0Nrjt2dPD6/thpw+u4Ix0Mo7HXmV7fAyB0c+ZMHLsNw9jzdDCMJ915CMKRqx2bG47aZcee
+M9K1Oxl5fmcpRsFIVzQY2e1mYZs7OhBczx61t8M4DCa6je4Ewbg9DS7sfu12GPtBu2xow57WwQsr
+1IHXtrXT68PY2vCOfL9xgmARxpMx3rZkjDwXONdas+7boyYKOGY6SXSmgsH4dmO/Imhjb7xHfa7T
+xKPkBeNGE+FoYgd1E95obDf3M+YOhbp9k2drXqPLvmM3Ggng1Po0oNEkGO/XGqDLIk1KmB/Hbxgd
+YBbDcOTs2cqHpIBxBc7YbQ7Ldif2fk2gNyW04MJBbjThOrAp924CSA40giJXcyj+xJ3s20h2RapC
+mOFx0MAgOB7A+fu3g6fPc5ubBcjAnksDbMl5vLjBIzGZNOGYjIN951VkroRmxnZzv0wm9jjYtxUS
+Q5FuTlpz+5RdBxwCABT4fnO7jEcTZ7RfC4pGF46kFwZBE6hwHO4Jy218B7sfuIHmsEIn3HdyiuRq
+tUiQikCVRiOO4++7TsjaF2WC+GXSWqfAdsI9QSEtM9CdsLXrvMB2920iv0UoJn7YPELOvktTwguk
+D25ro0zCwNnzJKM7AI4DaLXrffFuI7UjHgDHsZutTUZOYG/F3KjEPM7yjzAj4/b5AeIe7hiQsLUC
+FG8JITiA3zZgbUaPZHMuoE63w6c0CR6A7I4mI1OxICHGDCcTE7oCllNHdce2HzDDMQ0JBYquSXi2
+zaya0GDqLNJk1suItweJCR+pwDyfVmjODmzf2woH0UOEo+WSvwMIRgm3gsGKcEAcD1Z7KyBEBD8f
+ECJ/W+GgEhyM0PZ3rAvS0c+HAinoViCwAIcBxJztM0GE+EkwAAneBQUU4XCMHX+8HQ6k5U+Bg1Px
+HaDwUhwaEBm87StDLMHTwNkJiTgu4Wi0fU6IH6D7DeGsB56Djh0teKwyavuw7ABR8AkqmCmCmUow
+U1kIQO3GuDw+Yp0S65SyTlnXidkc+4Amtw+PI6XPm2LOoWydZXmvKJvo8Sjwt28+33/COVRYk63Q
+KOUEZgjdcPvSE6fz+SABj7MVFPjOQQiAZR9tx5HIKn0+CIxJ2goFKyJWBwS+XcfAH/kIyGfu9CK5
+SyTZ2LSNNcBhLQ4c0OEdlIR4wafMEnGBO6aJyogFmwB7uH3PIEP5+aAQK7kVECohCEqo16fVYCBP
++gQogBvdDgQUkFPhjbbvXeRqnwJDUe2AoZA8BgiI2+eBGOMnAHG3ez3ulAUZO8Btb18QN5g84fiU
+GHFaYjzl5x6huqZYMGBAt2Ngx/PYin0mkOiP87ngYR2BiAHxNyU5mCxn0pYU2HpOxh7IENkx5obH
+eGH6ET2wbtPr+yk+H8vHtcqtbwiCznkrdS2LPN0Ud0FCQk6oFyW8MSpNpJTUFS40ks4PKAcD+9uS
+g/2J626vyC3gcVmm71DnOWrqUlG/BoSt3QSvttHsK73HmRmWXuK2HtstnYE/GU82NG7htcs7mm+4
+pKZJyTqadT8clzfxLYZ/0I+ILujwQ6etIQr8kf254Cgrn7XDAflFIm2A7nXgwEYMW4oi3wuCfVZP
+6gHdsLnx/WCCyeuePL+b3IPlPG8qoBvgxB23FD1h6IfuPgPc1A0M2bP9SfMgeaOwO+jdrb66v32b
+L0uUNCctOJ0xtLFni0qWa9xi/qSlQQTpcC/gmJbNsxv6f9gTtmOH+9QvW6BMQr+lu3CDwNkLFKGC
+wTij1v4aA9JCDNXS7FioMApDwmL+KLDphePZLr3wPX+yH1qDEdympFdDUamlnbO9HTqtV9yxVVWj
+ItvZ0s9NdunnZDuMjURRM2hpx2AinD0bqQkpbjWvpalDo1W4Z0tI81AS8seexh6hLgY7EhZ+Go3o
+C1BE+gH70XNZkQn0BD+c0GVfQm/iswWcsLIBCNDUbkA2E2wOZFla5LHv8TKe49GbwB87TDMGW5iW
+O5g4Lu2E8cR3WFcje/vxZ2cSNR6uO96mSrQUixANQPFs8sdN09PxtdFPq6RA+3rfxNUEHLKj8ZHv
+edvPyl+S+D1RYZibUGuzQ9mrbS9z9GZDMlp3TaK2jjklo3W7rB2GzX0FKE1FG7bbtrg6vqMFhYzX
+bdOd31T+y90ahG3z+2gMQ9SOEP7bNqJOfK1CiYzXbXDHo7FuNsh43ZllX2t/J+N1Gwa7ebblLKPx
+um1FBdyh44DJeN0ua4/GOhjIeN0xEAfaHUTG67Z1djzydO2S8bq9Kya2Vion43XHsN80UEruHo3X
+bXiBsmjHhtbrthLcG2tFTbJet/fOSL8lyXrdnofJSGtNJuu1OUNUNfY4Vz22Q8J4ts0wVBjSp+b4
+g8DWuo2ghRvbc8NxSEjM9yZjhvpgR04YwvRZw34wZngktAOG+jymHW5OyXiknRG0j7dH6Yeh1pOE
+rAZMwwPkYMT0iwGdBSsF7CQ9ZDeIXhg6izEDQkLlIfepgU6pg764vyiWd3xTnJ6jFQAVYABvh/6/
+DhglspLB44Razwrd9ABxYZ6+e0JUhyPVkqgeyv43cfa7qofJQvoDDnJcArhS/mXAwhGbTBMAU9On
++UA6Tn/HYjNZO945Ah4olGMuiQ7EMQYYL/ICnVjRWTpfxHKFJ4Gt3ZwKDA4gQecpM7rXGoM4zead
+oBk7np60TsLmUVFyvLHV6/qpkQaHJdxhqoekjqLleXiAQHEWOC3PMZtNmb5dYvaXw0N9+pdEjXcM
+1wCzaZJpOdjpgR0Cp8GVEiPGH8U0uUGTUqtzKELdKhgvpoNBf+rYqChdUCvqj4JD01NnXof8OZc8
+Wg0TeCyj9NRVPrry46pOfLScF9PcoJC91Wk5M6uLEi+sknotZi8MvfGusQIWtKWLBFNn+Q4wm7BM
+7F1fHXI+v7gUhaeaNB2ZSDrXHrR+uAxReKOdAcbKHg+BAeErMgYM7OMUyyVDs+14hK58MI2A6MYg
+08K0Sey3kOto3VGwso/izzX+9HzUM99Ig7F1JfyNtqrIUvgJa73iq551l/s2yjYs9rtIppi5nb0D
+Ef0WdsatlemX32Q84wfr3nprfbTOrU/WmwhDDKxXEQVtHbxhibei6OrwcGm8MVkAZrTAMvdRMccz
+hQWMD+b0Cv6ZfTh9NXs1GJifonfzW6O6eHVpvTKn9PfOuLdeWZ94YCY2dB4ZH6NrQFvQ8HGWfKoa
+bU4vLmcHxlvY9OfWRyhwlWeJbLs0Plq31sVbJr5a0PyBbU7Fo+xKXL/KZy56Zd2jocsJJlrWwwcu
+fsMphT2h2Z3yFSYuSHnEJGyUDCdoBaAvWRB1aq3YxYCHh8VBVLDTDedsMUPHe6OMlhcLOG3mQVRy
+pHlgr+U8UUH4TmWBMi0w+nUJeApqXaoZDPHb46PNh30AvQ2dtRq/8SCE5WlqYIA3N7vhk4NHB8+u
+ji9iJyRnfozuhE2MN7YZ0W8cFj5ddFhQQ8XizOGQYyAuzkZ3xztRlMCOd/FPHnn4ZxH5+OcuCvDP
+dTTGPzfRiK7flhYz2dQVbIZ31ocaa9KOhqN0ZVrnsAwfTesTLAoUMmGLl8Y5bXHrefTh8XFpvY+q
++XNo4405LR4fr+m3LcJ9Z2/4lsbJv3l8fIWTf46xo2+jT8Z9dE57HHYoEl/Me2S+hzfRWxnq+lZc
+/cqjrnreVCwxxUT1RuLW1Hv2HMhbVNmzO10Z7617HrbQaswXjTms8FgU5m3c4cXJOeyL+WL6vrkX
+BNZdYIbq2/gOfgCpZ04A8BtWDi048AuWk7wk4KePBbIr+DViv8hMCo+BqPkyQdoJb8a4oYKx4492
+4WKmp2c8DzuCxFO6IBKx7cX3VClpCWwvcfcpbC/YXIrFFj3/D1aHh84zQIyN+GLHGtrmiQ27qjT6
+yoc+Imw4OAd3KmKetziahSlJ0spqparDQ8f2ci6JdYo4oMQU1BRa3aVfsIXg4BvQjqHictOEapS/
+FyoPSnNWngI2LodD3F0U+F4dHgJ1vlRv8S7rYw9HfrpCF3ag1hvZKXaW4RdHciBnhiOehoICNZKy
+v4kzEUllTqORA5PWZMfYLFzI60MqNUMKhoVdpJeaa3qv83zqIO3GlNsXyaVxxhJFIiORI5+F7gnO
+ZCdZ7zCLukwgF5cX8n6TgwNM8NIYAo+aJbNOBfig/sLYWAfANBlMfuBPJrukpDDkk8yxZFrjzgbC
+VPhq2NS7qA1sfPMBE5PMWLg18YZ3GLqOu7nEPzdRMr+G428D55EgHnBY8ljX5HdS47a+wR11hzk7
+7i5uLq2bQXTFL1/Hj/BkJfObE3t6fRKJNLVLo/+SHAIwKximR7vvUTrWHqCmG7z1G8O703jJconh
+BZyUDGh+Axt5en16M8NmTdYxhv3CJKws1ru1qO8cXTXR1TK5rgC5HaCcn767od+M3wPR3N4hGGrI
+uWTWpBu4xCrxp9lmc5AgMcCYIaeG1K3COCGk80oa0WxKyWkR1yyNxXAFFAUXxp6tThaz1WBgXSNN
+Me6sayvB7AVi1HeCV7m27takUtEHCRAdbkhFgAbJ7m+iu7enjzTBqcIpqePmG0FL9RmRN0pjRpni
+mSvhK07COYtQnaJFLjYyuuB0SXl42RP7XQEcaattMXeUPrLuAy9NiU/yGSsAE4uJLS/iyxlmmzWS
+C8BplzCQU8whdZFhCiO6KC0DZBEPBphBFt8WkuglmIOhm/9X9J7LNFK4I8TPFBiCkjKyAbksT2Ls
+Kx0AmoUXh4fwYg4HD7CXhYlGzJPInsMTMG3TgiSlKRRrvqkTXSnLFKMUNfF2Sd0jl3uRMSkjrUXx
+UiJuBVMT9tgpWsi0WCTkGlUzpZVlIAqh9yANov5DiZ3GfCYpfUa0iClQqghpkMkqCHnCUi6bXTJH
+Al/Pa+MkmDtNzMlH3FCmabAm7SmzNKM8pg2cwWAYxkcEzTCT1maIG+HV8byCbgpMcAaLCxR4WmES
+ORlwnaPoT6ivT6ZutFhtMAPwhaktEAAND35mMas2rOUDyjrTLhFECWd6cABbGrpg79RSOV17P0tx
+r3fTz1D6XqsOhwGZw+iQLhfJlkzTtN6iTToAJuMgNyVzybBCYygP61mshWXr+AoaBQwwMWIVFplm
+ZW2FLiC3jTgPZjQzHtZ1UiDUThn9Pkd+O5gWUkxZISZ7IQ3YZLyDfLthyDWuXLtI8g5C2DyLApyf
+43f8PHJL/CrqPxfsXT8CAt1dO8n+rQ2zqRxJp51TjLhMnGR53JJ5/7VM1jblZxRe/gi/+h2PUDV/
+UTvXAGaqVZZlDacbM4hYpWnOi+lqjlhg2v9J6EsBW+MbE1OnsSTtSWLOlRFPM+TdwkBrTGEriovH
+Y1xx0nniEB64/OsnkGuumGl18R5X4tnfs7+XR3HVu/jH9PJoevGPv2eXR88ojTbF1+bmNjVpWqdj
+b6XxnlXDISB9ShQXY17DGoHDnhwFTrCL+wyA+wyBZeUebRhhxRi/8cgVaiUmSpdsP3FBeeLZ6DaM
+jJA/HqOhE9VKE99HywVTK41RF3zN/Ir9MfQB4ssfk3tSL/kjn6mXbtAxwLqFv++SClDQdw0N7QO8
+/KbG99MWTlzJBEqNOzSAbiKdB9H9Cv5QntlpxRQH05yYZJT8irISOigUrMTvMv01mWKy7jtKh4WP
+dA0DJQzKUKeUEfcD7d7E1TSxnr96c/7jzy9fnL+aFmsKlb+JForz9LvoFvncDx2ujJYAEydH73C3
+plHzovJ0njLdUFRMDebgG6WoDqFhkBIK9SHvk3sYHNPwFhYG9af5qpxm3CfYIsQmFKsUxXw1PQB5
+AN3EYQpgkPxXlMIoKTMAVsGnu3lM4x8Mpgn/YfW/6wMLQze3Mg9ozByfwqCBgbnXJtvI2PAIYnYn
+AGtCyqayHRI6CzSIIzyzYlYAq4PAsHRrMNKozopfrGcSQd5YD5QKQUXgdeq5d1y+lY7dlINc9GEW
+PLj7KsJbRo7FDMII64eo/slmh3MPMk37RcGaxisvGcwz3gX34eY1YE7FnpqybO/0e80b6qJOuqEB
+Gn2HeyiL7sliQjIRKwCUhekh0d4hYJxxsDhQF5kALlPHGtMi0nByWB/yjpIDjkFk4QPAXW+Ihxzf
+04D4a/od42YpaDDD4bTiP9ZCXs3Wlk5fLu0FbHxcd4yc+p6ac0xjPK9oCFMO4IzwIgrxTK9Kjkuk
++4DSmIGBT8DMhLpyuGvrJi41uvyDA55CH/MMoKxwYxVzREqahZIlpfkBuyMogLdvVFH4xQ+sFiOI
+9pQ5Jk4f4qsrnWmBl4YdI8szyO5QI3Bj9XHi+1YTQlmbzzGtDlZaEFxAu/Ls3VSLnaJq0O+9EJwh
+nGFCZTH8yczZioq28oLzKzsZ0s0sdq8MgAmkELY3cTLvURtXqUgX0WaD9asPb6wcXqzI7g+I8ege
+Hhb1ahbKEa3TULDuUdpi+xRKzdnxnJLvccUPKZB/9G9D8jrn1jLCNxbjb531lKXQb5VgW0yW4e8v
+WFX++bL+bgiABDLgFfgTK8cmo5j3uRNlX3ZtHRQsxanBOHrg8uzdyiVP2Ac4RY9rggyQoJcQJg4h
+Qs+FkbKW3JaS5q9qmr8QFjOi8xMb+mB0nhP1m+iaiPoV/JVEHUj8ggKTgB4u6lgpIItMC0De5mih
+sa23Oi44QQnh1yQjosx+knn0I4jsH6OG1ABYlc9ddHG5tjbcPnuLuS5ZMX2yfRCtUIJD8WP2sabn
+0Ybjfy6PP2nUBQ4AbLUZucg6OgTBxJe6Wdi50FhU/X/SrnU5cSRLv0qhjiZSbQ3m4jK0aDXjdrlq
+HVsuO4y7dycob4UQAlQGRAvwpY0nYueN9se+x/Qb7flOpqSUEOCajeguS0JKpfJyrt85x9a/UOYK
+7dEDt+YOJiJ3fsH3puOxlN/rvyTWrL+jUHP2waVF818q/R1q8qultFmxlBaaz6iONKQFo0tpA/sJ
+liae4YQ8KPErRBrd0ILpZIf4NcyIX1NnAJo1Kha/iJKxbOIKtn3T9oqNrKCwYadPhA6LGfZL0w7B
+SQe3TkTSTmqZGFrPWwd+KskXeyYXKb4g0U5hj9PfGNEbl0hzivaQj42o3FxExMaCASksMV/v4bxg
+Yf2/XkqN5d8ILoEP3Mr0tFfxm553voHa4Dd01AfE87uDP46SCm67+OIorUVtSs7GYanHGXBSsSPn
+uNVqKr1ZqtApgZREUTof2JFTr4KY5tQfSR+9VNuep/RzKAlk9Ug61RNla7ABOpimKtMoJaX3zohJ
+6RP9LdKPitb0iAhoPt/fhTtH8qSN6yD/BvyN/Y6BbHO2QQNsWI9OiOrtX5zHcvlR20tdGFBoqqfl
+siceYUd7WK+/xN9QLpeCjFdCgEA/JmlBhPSYC2xStVJOHDFyltpD8isEkmWfmIoo+JYXTlRWPZub
+TAhCBH2M1mrvLCYIS50g9JkgmNoX3EHJMtsD0ZNfarDeQH/lzqKDGPFhGSMuE0O7gv6VJWNYRrAS
+fpyw6NssB5Fbg1/Azl9+mo7aJYGcpm++mOv1A+n08t18y0Kc0OpNW0moZujcKaktHYS2MnZRG6U5
+klqr2KSRfE1ctCdJOeffCrkJq2zgT+RTrhtmuy8ywRtN5Zh6EYuQ+WJRxSLlZudi6VK6fmktV7Lc
+AQqM1bfOaKPRwkDrIunRUIxksXeIOV1U7hpZM/E8moR9d4JO0OR40F9JYepyj6NKIsHys33aPtj6
+jThrdR48zMIKI3xqtcax3O2oN6p2u9yhr3ClAL+0ZJwSbecVgq6JBFTb3k9JXnMvzms+dxY977aN
+JpC/lp13ER+C/80tLPa5ySId9aNZ32GkncLItdXLKdfdYeWQLZ4GHRiY+2Vss4yyGTphCKWx3ryP
+jWgxz2CDeHGqgw3PZc6xmqQHRdGyNIO5Xlsx9bOy77IwbIXJiKzylD7LQLZGrXq018LVeFsjAl2J
+tZik4bzFq1FjThCkJJsF4XoVa2OyzXy93WCfGKcyOlR7cxhQJcKSBmhXHJRWiNBCijmie/FewAJy
+JpbPaQWICRV5JyTzCJNFrH2U7nPrbIsSrAz5iitQKNV8sbfV9kUx3kh2BY1vQ4xqTPxZLzQnamWS
+uLLURdRxLS08J45wrirtvXARi6OjvUUsWo0WcBb7RqCIZwYMf2oHoM5+Rw5FQEMBLKJp+wAAwMrP
+ls5vkCgUNs+V5nfIDoHM11ZrJvZUSAwT7idjZyVO70cpULRayDQVq1lYksNUDBkzXWgian2QIiOm
+6ZodJYuf5IigcnV9eXV2TaJEUDm9/PT+/MOv1ye/fDwjaWFUsDke6Oovv3748Lcv3ZP3J9fnX85v
+zq5Pbi6vuyQtDDLuokdHMcYvTsIau07CHK2Tb987gUV6onUHDYG4uxVIxMCpdWl9tc7zuF3E3w7K
+5YtY57pg/kjMkZntVXz5CgiKHBTp0eY/X+Sfrp1HLehuvTdRagp6xX3w1XzK2U9uQG+vdDJmXTtX
+JLys11c9469/TQaVLtAXXfUGt9aFg0+5Xq/PgQx756hERKSZ0g2xaLVeX+Oj35FEduqsxDsFB2G6
+aYJy5hNLl8unLIzBBLher8SpSYPYJ+Xa63jilNiovRCn1DETQsGpdUbSGK1DOvqEWswgSbAeTnuf
+bh38ck+qieN8oX7Sf1xLqMSnokS3PXWG4soycNUAYE3cwPp4UVSPQlxLUx0nnIXV99KRNpKFfS6+
+sLF7YY86F3T2aFrq4+mkS8zgji1/XzHnlyYE05v1uiTPr+DsGVMnvlqXva+3Cs9JkoUyTxH/xcBo
+EgYef7EuE8mEPqN0h1k6g5J8gVG5olG5ILpNn2UPiGBPQRgvrEswzOPGUSGNTgO9lSTiJhFhmvhR
+Ge5k8HEYGoeHy0OglNoSTc9Ob5KElG3JBS1DERkQ0WZhqqB9bFwNQbPkFIPMn1+s2jaTY5MkQZhA
+TInwaDRejd2P868ywMul+aN/s1m09/hNg07ufqiezyxlNZAVMsu3nk+73evVxEdGartq0VkX2avf
++d7EjTj+QF79DaMa3zQJqNlrGhJ14d3lRfZM+gXT85vwzp/xac2C2e0mcmeLoR8RiZiqu94HSSf+
+7ebi48lkcppqP/LaxgVSCqfqI9UVmXA7vYYKAK5q9iKY+oBtMSGh80+0ggfI3ExaIk7pSHXxyg3w
+fb/T/os/6mqyGgWz5CBuo/vbh48s9qrb6FwWWkzPUS2i64+0Cygcl55mxorO5djQl8WXuE64jISI
+L2GKumPfj5tB9nd6zrs7TSYpuRSfhytP9ZIReo3CyMF667iGbH5gFofDIPKH4ePnQ/F5cGAeBpm9
+UpqVywczNvPVG416fl1thOnIDMYIAqweHW97s/aCw4vu+dn6JgoGNJOHKh0zsgUcFWY+4sc1c4be
+UjB3B+tgPg5nPv0JB0l2Z1Or8xpWrvw+SWH0OfVmIRXb6KHo2PmmzcoPqCzky6zy6YsQ0FjYqIIn
+FHbcIOqMtN80iDP2UfGZiexW1aO3r+khdaO6EJ1S5QdvHIVT39R7hLsLU+Ue5wKdkyzYpPonebAN
+oriGQX1pFOBr2LubULggmZ8FKYzqM0j4cyvv/FlIot+iXF5UkI+Htjbx9Em5PIlPSR5clcuryn2r
+7XHZUDFDHSBZ6bBimEAC/Vwl1ZL+/nTUqdkHAoe8MomZlsJyOQA/BvghXtdng5EfL2qgpXDvz07z
+yOTy8Mltpzxk6Y38etmwXmwKEWQ/FiaAzmymEyyL//D7/x4sVZOfK4fbdhSHm29lpLvp/6znHxiJ
+QGvccjAQQhuzG7Rn6FF7bO/RkjDQhWCh6aJ0Plc/nS/OErUGJSnCjyFJXb6KhrcSdE1sMKKHbzFG
+zVZ1N8Z5Q2MpwCv7TlzzKMF8lFBhhegc0qpDDBBbQ8LkbVZIml6T1kaTNWuJVWFGzRrMN0QjKTC8
+m/qbglS3WSQGlspQKjrN+lsVkKTkIS8FmcxT/WaYSknjAilIN5Wpqt4QHTNVMpPaM3l/shL/q7Ym
+tvsyGqGWuQawsopoyF5Gsa38FZTATvGKecB94jHQxPCMKrDcV7YktFTQiIx+cmJ3ImlkfkUayUgZ
+k45NUsZU26SLnXVm9mNn1nu4tQX+lbXU0jef0B0re8W/zMXKerCAen+4te6cEy1LEuTrAaP5zcgp
+TcRZhwTlA/HYIQJkG98Z5sGACIKUoIlKdMvloeiSHE+a6AlUGdRMvneQAfWG+/3BX3ZE31nwTfSA
+ioWyu3Qza7LEljr39lI9qxjoKGGlU1rkTyp/aLkckeYypWmx/cpD5M5xYSympk1qSEB/Oy5OphYw
+PWOXHp2Wy1N1OCqXR3xowvfxRDuEjmU9ybk4oVF/Mi1oM0Mam9B50MmKKUcsxIjRzateeItZAmUk
+2ZMo+B00B/oCtHzHPzEMn/f7VtuJbqgrlXyhWeaSWCvYI94eV+v/ukRfFEuaKIpcHET9QASSpHxT
+UQfs9iKgdqsO2GFYkP8V1URlpUFI86yctneISKpkKKmSeu1QqDudgCeb9IECXSNQxQwzhTBNhM63
+CuOWNd+4FvGgPgMWoRm/bC/kl0ENGoqYlK4Ybr7ZST+puKin3wJfqLfe/gtTKZmBHqctR2izZmxJ
+T1mWq9opDD0WnGOEW41q6xt4gBZkpwYzDwCXpFw6z5rHbyUHUKPtkcIXLxviARx8J7Ptgg/wKepe
+ghOQtjnYsOFJT+eYhlwPdujdWrKa8LIdHhyYM0DVDLdnHIQHxq3RHkNh94RxarnEozVifiqMg6GY
+WXTVPDBMI6HqeIJeiQxa6YSsOh4PeYF31E0iqzSab82cScqQLGb6uQmNnDm9XrsrjTDJ87iwM4DT
+LI4IiExbbRfVz3hvcJl7KTwr1hPRi4FMh43y27ZzfgPPOqFcdGHRio+rq4bZhHNsBd1tx05sJAUU
+JQD8bE82Lg4yd5XlCUtvASAtybI0iLKAbGbf4DYphIvSjKRh8I3k6Uw1X30/Pp/953n3pmsvLGlg
+tSeWbl61V0zsG4U2+30kE4OaUEwE/aDOPI90YAXbrPopqSFtArYfu/i37QTUz9Gm4nobmjFL85wn
+iPNCJLtvdmK408s+spqX2X6qE1Ulof6WTVzwapo2TqHz+Lc9LqjFp6E8hRsQQeyFURc/Kgt97age
+ky1lNQ8KYyK2Z41QldZNTfALTE7FYekWXbrk9vAM6yGIs9+TSSN1HWj0VENoqfwlkzRWf/WqtBu5
+Ssvp4AIyby9hRnZTnzIRjpBEW58IkAzHWDHOI02IwR8IDYjD2jCaxXWQWo19IY5wLMXkSkYDRB25
+VGxEunAIS6sgdLF4dfsVxIs5HDVGZy9ZTT4vcUjh+YZoK0mCyTFUYrHFgFMuy7/F9yz8yZB2H/1b
+/HtUGQG9ODIzsZq6b0TQTzFVEIb2i2GiLDOTxt0yjQrddGXoSZbf63OhiKi8QetPZi/KGBQOZUKa
+1VbeesqmtFaxMSZvzimsdF9RBcskrJOrlhEdrjkbK7WjfmUgUXwIxs9hlUfHjVeZcmLrMpAf6lAZ
+Sw22CB1V97Amqa5z0DfM2lkJDXFI32RCd6lHwT3xKE7Xst2UXnGl3xuhuLvphyaPqb5mgoCSUB/E
+SiIQCYYk/SPcgv6TiGX8YbDiuGEBEVXIjQVsKE4P6HCWEFAZxKnYkjNxHY1Gtb57JesppBpVmBog
+nSc8M4mqaocclIAQw6W0Fpsc3pC5VOgqYBeJZszKPQOK3SyMBlICbpiYLbau8xmHIhqeS4K0ISPi
+mUfICxYCkehAkrnj4joGqWNZl7axHXdJ2wnobKVuOZYWF+Swk67l2tuWci3/SA20TBWhcywjdEo1
+UsWHqNrHRTFHTjUTyyJRnNPY3/QsSd35O9u4NA5GBwfWg4IYs+/FRJSIRjckAkhf7U8KFZSRzdAN
+iWfynRV9ylLqBDFqGFH67ag3vXVqFkw2ilQgULsy1CxFunKA+6AesLNJQXbCn1zWFMDWoSw4zpQ0
+cQEzLulgMsQ85lAvVuo/jGPLGOWewyk9Z6VTOFsWtlcZ8kZWIVH2ZixjkOI2jQX7+LTYLxKiNssp
++x2ja9jGFeksfgz9hLWBm5trzb03JIAsPj8jhQ4bIAF4TG8r8Sy+WBpIvKCbW95RqmZeAeRp/g3x
+snixwtn7yPf/8IvkVJrDASwniP5VLwMo2Ue8EWI5acoxyAyXLDK+y8wFrWpNSU2KQk7SPbRKN46X
+Gh9zyHZFdnhfHNWP6xJyobbe1FHz/8ad0BIZPMXJBII/UKd35Cy0LAn3dKZSc3K1cGK4MhBBcsIn
+R50S4VJHDHO/N60+qY1P0HUA5MQhcWxAN3G4oKvt2Wbk4IN4guyWllYdiWmqSlaGrucOfMe3znAf
+R8C/6NpoOg99bgh2w5dcyVB1g3zTC6P++EseHS75TR9A0vOg94iJsgq6SHNrPb6iix7u29VF2VDH
+p1fZW7spb3rRwoifAT2eMbwxZDy1a/kz3r/2NhUGspCNL3gGPUtwufbO/BdK0uF9saJTmuCIMYRm
+BRsYNng1CCOZ/246d5cwyb2JfM8P7v3IemMc+CR5R/7vqyDyB2m0Z/QiwYOt7dhBqe1IFYeDjzUA
+T/DK6gGZCN4SI01FKGP4HUaxBj1XpniBLFYtzrPKEoj2gkw1Q138TASIGPCCazILYfPtDstp3u6V
+EkjGhNdbR7ulDU2008Knpcq4UMIkE5CWTCC9ynIqD2YnTtejrJcAGcceJk7bc/hfnxc/iI7tTdzF
+Ivlg83P/kAjMjLY9ahQz55WHKDg7zaudJTcD7NdwnTBHe6g7nBQTTq3GQHOO9jSlXCbMgNhNYpws
+nmZeUnyMsVLGB5TtxvrJXed7C35MOqD1dLBel0pjMbSgehaauNujOF+7JpyV5lCvN4yg7SSwZ8r2
+EtphpamyZ8tj/REffANOTyBJOyN7Cqm6/ra+2zWXSKKH360/p/vlc+VwI2+I1GsmPQi6iV4bOUja
+FJXgy0X2SxMJOky7VELmkYUTVGaoHg3GUbTxVJS5bybllV0Lvt8KfI8PfnRKEwDlEKDkAbFW2EpX
+dPzp5Ob8tzPH+GTQ6gwqV5cf//b+/ONHh2QFbSMG2LPEBwsr1+Kzd1KFzXSkHWkZcXxbbVyGQOY2
+Lpj3cbU4vbAmWyu9xf02KHRiTZDJQxK6hTQiRKnMDo27Tdt0dPY4p45z/k/2Eh8f78kjyzQgRyFk
+7lMZJ8KEIU2ooHVyUaQibQh4LwXGZNLTJNgrpfsy+0mgZz1RewlZeKo/7sk9W5xFstjGlGaRVNmI
+vOST52nmyGFqjRrHKU04h2QqAE3zeRw4CnoZzuf+wJFOU1rei9Vk6SxBrKZ72JIeS59NC8mWBASF
+WF2nJEoR0YCokkaHmNZJevm8mwBcTetOu/6Jrl7/enVz9s60Tjlv0hfTuizEGwBiOLMMuYW58jvE
+makA8aJd+bVwS3eY0Fp3nVOBiEPL79VurUvT1k5N0+af1WWTQ3BOTFJhGMfIJFyQSoaJVyLEYKuV
+Ds9OxL3yk8hwyxVCYvo/P7WfVM7AB+crvf6JU+V4YoRclrGY19b8JFNOwThzIFTdy5RZZyocvV0S
+j5wGcxanwZQuTbT8KN28GsHncVPZauh6UXbjBw53yXXmZaMzMgl6YY5uXuBhusDdxPy7DxxuLdqg
+DcxkebADBLjFLiPDlPqP+gC4ANUsRKl8BoMFat+l3wzaR/vNf9nyKCLq1EmQxhoGpOzC1gZY907v
+XKL8aPFrGtpcxT1k0OYKTD5PZcZhClwf0yzrMiPsAm1SwYHIJdpqYNKNYCZcR10UxFZFSGtrJdxt
+GGQxc0LThm4vA7pIxoNtf9O/+Zw4sma98a1EN8uIEHDvGf1uD7m5iZghF6HAbcTcPVpYY0tssbGa
+usnneQMDT1pBMQLeHkC+hSxdYAZl8liYLL/6trkPsiTi/GKciPK41tii1FoLa2JBzJunFH2YUvSx
+jrmBpnpUBytVqVZAopuYdA40PGpWER9AqwNgOs6RK4DRg8I5r1yslgx+vewv/Ih0kPV6XpHgrfwv
+pJTOU9DuGZ3EALdHOlaFUzhZ79wyfl/5K/8i8Gio3QUiELtAi3+RhKHdhas/7xOlYWAAyhOi+Z0z
+etXUDYBcxIgGS2G2kYyN2GRliERtoSRD2LJLHT4hd1TYCYRpu3EcPmkG6TG3OKPFAFFqul6TUnJP
+vKBP/z90SqNymYMiiUWFk3u/I8TKSc5EnFQsE9n0iAyyYkWsyEcGRF1SJAEbEETTfupkrp5x728C
+7w4/22JADQysee7ZgXxWMCmZOA8K7gzEK0C8wjAkC+Ig5lDOk5hYz97YjVyPvpCNOpCDs+1OpPRI
+zeb2SDern0m55Hk4s309zcxLW8ssQgp7SNMZOkuLhhxUlwvbEflpvsZXoJXcaRKhKnQYyzygYSFY
+orSrtNKGmV4SG/lrgqAoJWI34glke3Si+eDlA/hZHqliUyQQzH46qrGhj7TOb0htmgQLhVt8kIUg
+EEz1r9cfhdHvuE6t3HfqZc9pANK4XM7tw0NYb7nmnu9G3viK1sAUmWUMPSbNXY7h73YM7/t6dQCz
+tIqHzSdYWsYx6kafmo0OnNkBaDHCQko+KSSyfl1pyeUM1+ukD4fcMHcQnWPo4Tjyh3QLn/FUCcNj
+WC3dhWtqAtT3dbXuCwMtGdDjSkh0iGfwRDIUeO0C7/0rdbMC0C4+j27ra7dlWix8i+vQ4zJ+HYhL
+ev5x9pe/+DX3qe9tvI9e9+c//vm/f/73n/+gd45DJFkyvvv+XfX7X2pFN7vf/fN/DA6/H6P/NY8H
+IsJLim5/NBTYSLYtHUKwcL66DocyXARplSA9gRvJGOXy4YyI+73/xiM6okDnLv0A/PaPb+t7044o
+l3XoFKSCa7OIP5fb2pHgydziItknUdZo8JLjSAlEiTYhjF/cwRtFIrLlN9pLztA9w6KMlQrQaJno
+XF3BXnbYu5yOgO4x8DOBXaHUY2tIoVtk2iIOuj9BpJb/rsAJbxj2MtaXm9XG0T49FKrzawpupAUu
+khobN2P/DaAw4eDNIPQXKBHiep4/X76J/NFqApLyOKdBY/hfNrNc/eho/xrIeEsTd5WuaKaSKSKg
+VLqZVrNFchJLpo3jJkq1eakze57mIR46mVKRJPMUulZJ/NHRZDoNHeZlTbh9ymWkSBiK575de7GG
+YozwJvbK6kGsG+HwYxkbSGQldoQ1LP2BGtfesKhV5LOs9FM/SSzgEmmmf6KE+9DAGW7fo68ZjYOv
+d5PpLJz/Hi2WKbXuRbdO0wrjIADDLKLVRKkRR8+0uVnCh72QGkKPIucAn8ECDXAdWig5YdaLm8mA
+63gqWUq+ukPg1Gg+JiRsjuGea4c/BzIPFx6bkpw519KFI90sp8PrQEsd0UzSP3AIjyB83sdt9p1q
+++nnftucOv/H2rXtNo5l11+xicQgW5Qt6mpRogSnWp1UJtPdmBpgArg8hixRMrskUiAp2e5qB54k
+kyuQD8hL5jVP+Yf5k3F+JHvtcw55KNGSXWl0l8X75fBc9tl77bU2lzd0DsjpjsHANbeX7D1H8GdO
+fzQ3uDuD8lJ9l1t5e+LX6nYbMmrKSQEyOiQCOgumHW62Je2RCPBzNZTBnxVgg+9H19//6rtff8c+
+3YIL+LbMP9YXqLQB+/D7Z0bFUBuKgEbrM/DLNOpT1aMvAvNrsojg2OspPdrVOKaS/A3jT5SHKZPy
+FRzgj7qfRUy9BV/SxQT9+t9KM4bGlOViFjB8J8/9V+b20jPWGUNnBh9VNv5QLUhTnKydISxS1zRT
+mvYZwSxm5BxoMB8W/uk0oIo6fvCMMAp9w14AbOaH03e3wYIZeshUiCdqrDd+GG/GooBcKmCy+JmV
+Pc3fW92dLFu6jIlyyoote7JvZKdQKEda+gbaH6HVy6t2oGLAMRg1Jc+O5nu6DKjNXOU+ZhoxksvV
+VdEzLXsgYYZvQ2lE+EcDOMH2GZqzAhEAB7PxnWa2voM/aoz7AfXi0e11wHHMWZ6xgOdwzd4PXjlv
+dBsdiacQifJbfj8pabDIeuYe2YEew1qGZb0sknC3Anvwl2jU2V6CTxzR/JxVRiLVysGZwZoinKdK
+7x6xqogdUmkXhhx+0AMWjnhDBkWcN2sS8SLeNclfcJFTBRQQc/Qs5SPIyjuEM50h2z3PBbr1DB0t
+alD7NhS5gcFFSfPPYQmGOWGQlSikBPiEsijWyYkG1vY4D1wkGAFVEp+ccIYG/R7Hp+qmiuxqJQhG
+0VSzfUxFDkoHyWYXe0XqF+a8B2teYWtYWNWHupk6XtsWaisazQMGRfmJJ0qa2J24rymYsaXFkbKT
+8y4MTjXQ4eBZJAEPFi0FYjQuJvBNRLHwjybrFVqwFlMtFGmhhBh6YLN7ZX8z0yCc0pIJcpqRrWaW
+VcgMdjDJqvHB+ida53C1i8/wPb3kJlYhTMicWoUiWwskXYaKOgagaiaQASgC9kqVxk+19E1ND0Wg
+jegKQU7k/2JKrEJUvgDrZuDM8OVdpsRjQoPpgC0PGLgYRWRUeVieecPR/u2wJBULUNGAAAhUpAh2
+d0uTeVutdgZiYzND2qGmIfo/kU6oEi/4O778hmXB8SPBpIfoGuxmfgQ8wItXylSl680DE0aNoUCn
+Ec9lk6QxJGSTnDa7k5OCXTTJcYYrnZRPs8IXw8kW3Y9bri/Wk3qBayvnVlxfSUa7gmRLHh1DzkOq
+Z0ugX8qewi3smlD7wTCL0BkAcfudNRlRQVb1eQiFHiAKpySziiW29NlGYrIki14WNCEZlvFESvUD
+8zigvkwTBCSjjGP3yFhIYNAjAsSoyk7tBaqHHQmOz4+nhQxXpFWj1h4ASeSVQ7Z30bdBPUvRyxVN
+6jAT1DqQ5ZiJlNQYSyFIoTGohBYVRGLHzAUR8u/CnED9T1ChK67gdc/i/R5VEMw1qHT+LuADwbiF
+38yymPB0vkjq82Lb3cVMI86xv1Vyx5+1yp15MoCLpXBaKoVDRkfEBplU/vns0MTSloZaNCwhDdli
+Jz4+ZnrifFx+dGNQynYPfnktegZ505JiKUq901TNuL7mVnd9TQPq58fhrmsfKFNGcaL3NeGkOfD6
+5nY0ydZuwpEOyyKT+PIKo1+sN3RuP7sqDkXqvSzgFrNCDF1kKJJ+3fg0u48XIhhnWpmQow2N+YPI
+8cwXIr0eQd6I4H5T1sLpzGJ0dqZDd0BgSZeqiYSUF+T8InTDa2Vvr6hVzdCqJoMVSJPXlys1tU7M
+SDYRGvGHl3QRzHjcSLfEZ0V9I0XAw7JGtiTqWctAbLdVDISVOwqFVkWkEFaFnCZNMWMXD29cCjPi
+yKhIATMk9aHJUSftvDq5RhvaMvj0q9NobKZizJG4sMVp1EFitsKkMylm6MkkmozzXqbZ0JGSG2Df
+geoGx2+5gbB0E6X+S+PjhlqQVK89SqOjVRwsA3byKs0rMLo5jfYLCRQ7tVdAyYPcZ1eYZL3gdQt1
+XFx0F/4CzGFWWVwJM0Ie0SBMdzrLnDzDBRIesYPVejgNrSQO26zVavVXJVh/5iQSmo5IxjlgqLat
+PnVMTR3DiMtW+wVDIVf10QwF9pzvFfoI2b/GoJ6iCJ0mm5cqEl8mdlKadVAukA5uCy0/Vg52Ti1u
+dEWkeos5QYJvbv3xVLqNsJqOgwWvUmes015vU+/K6F+Q+ksV/5NnqYsOs+vJWKCb3y/VbpY+brtT
+hVM0O5p1SC0tdC+u4Qvlg/xCApJafAu4e/CMBcbqFPZyq1767RgF8QoG0EgyIQBltA6T8QyR48vo
+aog/ZHtEV4wRjmwswkrRvBl8k5Kb780hsWNwZkdk/WjD9lBMTV31eKj55/U9waDXBAoEFkPhkcOs
+DyFLQ4UMohBo5MJLNbv7+91S78qLzQHtE2ZcxmSW2tuUtLknAaT5Gccp0kHpC2si0CxLc1AJp75L
+nyljskE+hCMz6qDcpDK52JsXUd8VUIOmjhryY1RdqP9KXkuwy0AVpm9jBsiy8s2H0CzVZ5xnMgS5
+tc79cyvTui2VgdrLZ8rNULKaxkPqBbUpHSzsBSe+0u/u2ykyTzJKqCCQyEzHaRw5ItOYp5Rl4895
+JxuBRIoSY9iZdvKQfBbVBmSBoVKo6Tqnc+w3EVCZcReyKidR7Fd/SK6T23HsT8m8ROT0cox8Y3OM
+HIAiiJaftdxFomxokU7SMw8RS4hHx08ukp0OUxd5B/ThFFOUATNXKAF8lttco3Far502DHsZTX03
+HBqrdewbriGyWw1qS6sHoUlp/PG/j+o1p1ml+lI/+toPg+Toe7rWJ3rbzZH54yIK4mjy6TReW4aN
+fKsw8d0ssD4P0tv1DQ1WyzN15JkssLMbutfZRjzI2d+8fzf69sOIvjSn1L3qCgbyK2gqe945MMh2
+mkVw8P4GmttvATfSMudB5l6nKbfoDqFMZAYWA4dTNxIpye1mu17bP2GSosDjLELMTZI7aTY2kX4J
+RM5FKqbJcvUdfbcLtuE1pbgtLeoSkVUFhQU0TIgaCAaNmCF8WZBDntivkeU18G6HPiLPMtuaYXQr
+e2ZZ/RZV2TYVwKDVbjhdOrbiUJHcgidUHFNxcFSb+pqffpoMWp1Gs0HXSvh0N3T94ZrlQmeVuuVO
+qnxgxQyrfN1+36lZlXar1WgXpxUTfnV3wsKpomiwwsqpHSpX50t4Seo022qeN9rNDpX/2eVvP9aq
+H9e1Wmd0dUZf4ezy9OO6QRbjx/U339RG+Nt2rs7m9EWM76hNzRbRnUsD/mqdHoW+P02O7oKpj3ll
+6s+pyQmDWrDUcVjhV+NwnsUVIjORSR0rXcZ0JqNdLH74Tn5z+kzRdh2Y6hQoS31OOBcHZ1J0Gz2d
+134Qa1p6gPWCukqlXq90Wl+Zfp/62YKiSkEto8auFp86/5Xpn3VqNcv1BwPH9iuILZzB0h80Wy1I
+0mEd9T6seI12phRrhpVG+yv/zPQrjXPASUYlAyc8uF6pUBRya2IPeWR+VpX7YU9R5UNIJK5UhK9w
+4HEtI3Op73H1pZG3L1n1x/mhXCepdbebLad+MraGS2pGJtnt9cZJZIk6KtbGsrJaroljoIRVrUqe
+e7EhtzlgmpeoxgaeUz+HZCtVik5d6p3V6B3y16FnogaI4L7Vp4NPTnBpyH2JC85zIdiNN+crzMUx
+RpW6uw1L0opMwvGh6w+8ABA1ICofvFBcf+RtKqy5/FANBivTHFcT62xkZYGThfC2JRUPR1hfjaBb
+Z5fcBMZC9iJ0n0olGYz1y8DOpO41/7r3XmJfo7LIN/jgXfe9ydBxrwfepFJvD2lYva5OcOJ9/4PF
+6bg9oSJxX/1gf6JTqx96orhuzA+Viz//BGHye6qKF2efLPsaNfFR7b/Hvol3Zyb2iIrSm1v8XTZQ
+Mk0qFTugBZWnhNI1rMd91h50bgTh0Macmw8sP8nJOfyHyyylQkr7KvTZS6mQEO9Mr+wl2bkTM6B2
+NmRgmVEZUYft5nOGKQDyp4Jjo+103kLMdGj40XqqvdYsDyZws1iMGbRDtqaEOjd1/vQpnbOalXkd
+fgNJhqOQOWChuU09lJ8GqcQSscM2HNR6ZjgYDDyHJXkrHg1WzgnLymFZy27kbJYyVLXgUlfc5lvp
+ezQavUQ7onn4SngOjmEtgoHDeH769z/+/k9/+A/4XvIVuRdWLC0qvmtfCKzTKHOImkOW/tY3EY+r
+TIKsS6cR6NKoBPBu0cgispVM47dGZUH/GV9xmEVuFVv+zMA485pkVLgHYSGoknZOMH+OvcQkc99m
+9Eld2zQRm+LiaJ2k4zh1V6YDQu4pLdQtm6rjkpYaPB2tlySVKRh7MSVbEkmucyT7lqyOlpItv/Nt
+jtWZ5tRiyxy2M8/x7psM2v7gJXARv18uwYuc+jQqJqcsx5JvuvOSDMI+ouWvg2SFKSZ1KElOVnZN
+K7+kQ8Zzn0bwMPQX9gfaJAZ2+4J6lE/Adb3zjCjk5HBOiabBnRqd0RO4nOR0EU0YTL8Fwvlue2IO
+CkGlspR6n8BoL6EqWLaBdH8h0Uir5N8JeGGRR582MurbAmu9voMKIUpS+YrmB3RskqZqElFtOzsz
+KiEDUal/pJHkhjkZd7uPaYHyTFW4T5eVykVBjnlhTgRL1H3Oqse67JF5YdkXj1v2i/b2j/ZmWASc
+3uUo+h945ueOTk5Gp2F0t3Ugb1PHXNP8dD40A88cMxLnmqZWVNPr9ph/ndMoXIrS8N7bSHAJ9BKy
+A2DZTsfT6QgEiiCaRjIsUpcKJcl8BqfBEpf8wDGNhBHjBtBYLuN+s1KmQ2fmt5xO8629e21Qc/A1
+Dfu9TSaz5UbeOzinlqbEmBnFCPdtAXqlHWWd7lZS/ePcSr1FidlibjtRmwqeQWpZvw6WfrROuUzt
+WpFchTPuH2yh2HrDkKWmU07bw0NXJGzm5fieekyxGISH+IRy3+hR3K8NaQJUSelB3LHCSXHEpcwF
+J+CleTd9KGUncyrgaTU3Kz/pxA8WNF7mRv+eMTb0KlkSc3iMVCjMP8MhZmSD2jB2UwsJH9SjIpXo
+cHm9UEi50T9AubCXqksTIafbrbdojlTrdh2LydE51n/ATaJGspJU1xe1zPkd2t1G69VRGulFF3DN
+dltp8wjyNiYVRtBH4meEz25SwHatgEhMo+9VzMPY64fnjHigg7O8+CO/lyPZoOMkJXgzpLoI/pCN
+Z0z92Xi9QKp/7AkHJoQFAUbAFXNmtViGaCZvCdFseyfkPYWdBY5DgeqhbwdAUVkaGspdRbVRkodc
+mLaKPll5FmIKD0haMQzm2Dsvyd7+/NgLL19wMV55xo+G1h9kkbwfrxBDU9keCKfCODqAUmFeBtQ/
+cd7LTm2VPe3JTO+dVAL6CsCCqc8wlnk9ouzxOcZH20UxFkMp50m/7HHf+2Qa3CgsgRspaqFHjho0
+nfN9lmVNdZDxOJxGQInCweWcahRZe3P4JfbcqJhaloJhuEgltq7JCDUrlagytmkOzEpU5cxdnKhV
+iPnRAFbIjNpJeJd7Va4Tqi9Zcq+hYCveppR1rVl/iXZNP5iGKA2LpYIOzXoRr7glQOZQD3X+paEW
+hdHrp3mg5VuqgH4Yree3Of9lMfeC1WJepHkRUEBUlXPnQMuRPvVxblYHuXs9yb6j6F7rkuokMo27
+T4mEdomvBmgXmTdIPkASwHDi8updkN6SBfCB2XGo53u5YVLfuGZyoWPmZ1Wxblk11iD6VJKKsp4Y
+NFAmIoRAtiKO8Cb0x+UlMpdWwxVoNaDDq3pM7GL3Ic2xtiU6PqYfw4+bj7OP8dEf//Cn/3x6fqL/
+f/f89PfPT//w/PSPz0+/f376p+enf35++pfnp399fvq356f/ef7df/3v09PHdb1WP+e/3Y/rmT+b
+GVAJa9c7X6Y7KggVApWVneQaYJy0DVVDmbQt8re3GKsktpRFws7bXak6ytx1anrEsqUsEoYsJjE9
+kiPmhhlpO+c1zI/mW5022d3RqRhL7zzpftyRVs4AXNvy7XVNvr2uZF9ZdvdearMnQ8wxOVvVRnYu
+b3XvLRcsSUKocg3NpwnZbw+2wU+CyWgeF4GJHdu5Lbxk3/dGbpaaBzMzFkoHNAmB8DDrYeKxF/A6
+Zt4WHxT4rCe6eBT3NBgAkBj2AhNgetzEHEEma4EfqQKFMGRPeLJGWuR8bd5oMKXPWrABs2YoYcpn
+5lVO2sXVeOViPo/9OTSMxAvTDXRBzEf7c/EId8SM561urf1lNVAjiwSD044auMR/bhGBsD6fqIPt
+JjCAXAdbtVb7XAnVcRWb5vm6S/CDBck7Roh8WGHaIRgqqEpum6NUNY1fju+D5Xp5NF4sojsfIPep
+f3/k3098fwqatQeqn7m9d+NNB17LoT5lXJIpm39qnxnkHNtXwFzoqEhfDrzgpiG2s0judr+1q60M
+YpnlVW+bKCtlphlmmexprIGC2GpHe0ykmj9yTaFbu6W+RsnvsJCzsMRbmQHNb6jca9LZWHVKWmIv
+7YfseaTnH9EEt+pwBkng5u0zvRJ8FrMK0zeMLWswlwPUg7mxNKdyBEevPYMfE9PNMU9y7Zk9hs/3
+UXGTzAZe4Xw+hs7LxTLUw3kzm2PddpNMyrfoLAopZZ/MtofXlC+d0HSoSzQNPgWMr595yS11EEpp
+6Z1OzdE6NecqAwFyA6yV5KBtUXg65+2akhxodrrWnien55sFi4UbPQKNCBfBgmlqnVbt7cVEZ9OI
+/LpyEi2Y75hifiEehBZ/tpKifqPe/YJ3CKfv0QFo5Uetwci2GzZIEVjQ8/Lq5ITf0HS0E4tQbA+2
+nWUfLpOES0Be42cqBBuZ1agz543GF5VESSHse///56v/vG9dbzbeYitRdWx0rFdUXjJRZDYsclrt
+z3IFTQhsvvVS1TftRo7TAAxs90bbFK8YPTGgFZNuBash4rpMLcwNh1bE7buddrf2li+tYPWTxXrq
+J6/uM9QJP98XM9Ql0RV0u7XOW6wMDTu6lSjAuQPcJTMCmr6d3AOb4xjTAucsMcGM5djVmtWvgdYO
+D8MHGa+pDwsaU9dcJnxOmVjHqwpCje0LMgBlCYJdsOaO81Xu2Lr1TqFmq6J4qULh2QQJpitNL1yl
+TVOJ5sEybmbIItkLbOkZMD2upHRTEDEYc6xnLOMtAp00yefYKyk1q+nXzryAOYRu6TejPTVXhRwW
+k0+y1duZW7nkopQ+c/7NypZFwT5I/jJuzf6EPiYFwsnetdxupb2T5uJXQEPjHAYF8EUqFZXkkAK7
+kkXMh6Y6SZH9yFm+XANPGRvxrkCWQYFAHhHKnc6jqzSOtb2QUs8PkBsvQxvb8x3wMMhzxTRh6o1P
+L1QV42UqMpHnJKFtNi2pU7CsNJUt+3hN1pbK/EwQL5hyHJJT/BJzqvR25cOo4x4LGu92p9V1Wvs7
+wnbHabZe08IiegZquYtxkr4XjYyqtLYm+r42TTA7bx7lluPV2wwWOoGtFfr9+Yw6mhy/pb/DBLsL
+LvJZKjo90cMF+RwoUQHJ17zcGDIQ0/WENcfQJwaDTpf+9s8b9KJi1yu6td52EaR2uu+tHaf5psGq
+kCHQqgsWI6oXEBSLAUBKvEvHrl+94pWlJzixcq9woq5j8pgqV0pws1J6ScHB5QRDW6YHUzrdrEpK
+5viXzZjVW8JnUz9v7syYObq1S50p+uxVPnme5dPk23zyXAhqg1EbOECeJM80mCNwX6L7oHmwipa9
+plItqRD5km6pJ4dmmTNvIvvcW5oNzvAYCDLcCkJyjYXg1qWBwRKJLUhnoUniTEdW2qYUoUI6w+an
+n8amlosJBqeEdwuwJdCW8eWcGShpUVZIW56a3TWPpkzNmb20b8T0lKku8meLhxs3tswH86a6pDky
+e8p7y/5Nb0nTUPD9LzF9nYFnnt9Y5xRR2gBeZIdcUxrtovbG6zowqE69bWqKM7gLw8LPN91yCv39
+GxqzpkKk5QDLGr3IkSzr3Ik0ESiMc0XcI/o/9k5CdlnUc9ZB3vIMwY5hfpY5IFXANM6ZR0wgGucS
++wjiUt1CEMcoDj7hwCk9gtNCsH8EUktsMgATO15vswFNs9rV7whFA5NqyWzQEB6S24LawVIdvOy3
+a42eTGwUvhqQrAnkZLvV8/udds8HRE5SbadlOFDWOJA6pe22K3674rdTk791N/YaQphCyJS2z+Ue
+h/Y05R4ZkaQt9UfRPGq9sN/s9EJ6iLkEjn9y00poU0N5FEyusqzMUmR6erqp+qcbnjjyxeZqdMEl
+U29Ohs/pJwlrNqnhRmpZwd+qjiWctmakYb2Mr//yL0bf/NXFu/d//QvYNNEjMqQPtxv2mNG/Ef27
+5zYTb/nNNM76sQJnIrFceJ8BKbR2Cbo2iCLQH7uA5qQquWY+k15emGt+8fBICLtA7A62nzhiQmsH
+UdvbN4+HVcfVele1wqQxFZ9PwrQDxA6DBfWcdETVYV4CkXQiQaLi8eKehefxokt6zCsJv6OHVkQ3
+qdiep8ywAmTbcb5sSJRDXpBjErb7ityLLP3FW15kZccJhRemWNLdu7c5JGSaoR2o49hxHM93Hcei
+YHLP8Wu65RXq1GrvOLkS7NOZR/aOqsgNuhhQBd+hf9mxxKjW4ePeD2OPPpPr8LIp8M131RHHPu6r
+dVqbmreM5YeHF3vobyWuhoOldKyCQou/Kjy2N3aIQqz1Vv2wtwLed+ONKiuL6uYNXLR41pvLzZWA
+SavxLbQZHY2LrLwRnXtXFWc/eKsKDd4b+gn5GsOby4crD1dwZfXBhp448663GtCZlbi3qlatbP/q
+/5j7F722rWYBHH0Vo6ZEimVjAwFiR/gjCWlok5ACadoalwpbNmpsyZVkLsHeb3Z+55HOK5y5rJtk
+maTd3z7n3wZZWvfLrFkzs2bN1Jo91pSGOpP9yBGpsY79Q0pL9dSaoibAD1+tC3uYUBuhguphT48w
+fG4qcL6UXaR2odNLhO6nzZ2vSGhzpyLCrXVcf4W6gXgLLDQcYo5Y4ymHqjChyd1H8U0JncpnXiHv
+zruwATa/IjZGv6y5epSPhSWwVR6y0GUkYVP3njyVshBs8+mzp6UCCvN4SXvDa8kRISpoc6f0jmH5
+8NHVulAreKZ6SMeaXJgpGraPl+zQgqgltU/C4R1ptab2Rn1D3KUYorURfXfmSn/qexNpTpV2At+m
+NgSgh43u+ezVXgMvg7x6/fo1Xvm49jb+MEJfQOijDaA8OPSlTguhlysuSpA5rhr7dsXXqtZ5nNrX
+Lqn/oafpmPxH38mAa1i6Hev8fGZVJzZeUYDBau44rWBBPn+WlRseY+LBsLGDP3tb29ZjmOe+bYlA
+DoM6OF3gD4wE+EUWSPpAdWt44nEviDnxOErNRGvVlVjAYmXmAX1ASjHA7POYdkkfdyK9VCM24pJ6
+eIOQriH7ihwo+rhK0WagO8KDVz4p2nnW3Fx9k69Nqg0NIKejOnbLFZ3Dq0Tu1u4DpydI7+7soerS
+O3+ak2KVadTKw0Q77/Jdip10Xxs5eSrSzDswvQ57w91q2dgOvEi7ufkVNEAWOHNoYIULspyRTIED
++EOKwvdyXoOWxZTFglGFh0QtJCbEY0oyd9eSSgmEyLaf7X4FPRjW2tgYY3347d0pWp+DjvmiYdjB
+JeN0PvsB337a+OZGSUHpv2zU3UNNuuMGPdve2tl5uEFkUMSpC7nfA23BI0xhjaOUVwwE/wfE1vbX
+fAvqCxTatI8yJ5/qYUIjDzmOSVg0+gbHeOl8Phajky75yNNWXlql3Edo+9IbKdlc2d7b/OZpJQsX
+BZsrsncsriEi9KE+6Eld1ez8HKjba3RHKGQbqik5ZCTTMn28IIDUjLTSgqZZtLMa1iLNhKkWR1g9
+ygyLAn0W1AHJ+/Sbp1ZQ4DlVI7bi9Q3TVzrt+elcZdArN4U8gZvN3a/BpPJlqwxSfr2Rsru5tvrc
+VmgjCthbD6kok5fdZmPvnyBH3CXzfZcKXIRrn0I3JJLf3Nlpbn/T4mfR/cNrX9jeeXDpP935J3Lc
+cgfUbMOE1wwaWBEEHKmVmS0UJrnNJgL/hcqA46DMjAhtnGTnBbkcaIq0ZoI8ojDUDQslXVLmwTtM
+mTJ9Am0E7hzv8c28ZhvllHaxLt/D231jdF8yQ/EfUmdAfpF3hvwZ7ng+t8fkpQXd2uE9p2yWtqzh
+DC3mjlHnSNqCcWu1Gfp5RgPpjvsthXCPsAzYPNM4KhZCC0oFLAyLZdI38szuS3uUhrEXBNqCQ92v
+8u08u3k6nSc/1TM+1jM+04qEfc96H1fiKKiIFlTETOTZ6jJoiO5WgwFQ/0XFN1S8Z+CYepECjiG9
+E3BcFehj7mlsAocvgWPsNSE9QkAZhMQEITOMH+NFUMS+5RAC04N34a9wfsn7aXH2RYIZJvDRLEwA
+kwogMSSuL7F9t+8Y012MMeb9Ss770L6S8x7l5n17e2v7n2jSiBNfwqnojmPJKLtydCgu4M1YQRXz
+k7Rm56nyIMw2c8iC0fKkK7YUQH1sEgFrPjovy6Fng6cGeglQxp2wb4eDb7LPi4Wb196WWhacqwS2
+ZkydA0coW0aSsrFxU4gtB+E0Jx3Ta7CyMwtwYDtLkKASJNiGVuD+w9zC5RNnJs5gLcZd3hc3/YjR
+VY1eHqJ2ybChEXs80PBNC3kiznKH7j2bLGLTOSjs29trfoNDKVYMZyAaayCaaX6/LyFnqvHIUMPY
+lXY0NSDLYU83t1nkLxSdR55k2kghmUz3sFcigaguNUTe6JO2Qw7dQkGb8nBxoTHVqScVddwDDcef
+876RXmLb0esSunhTEP4XHVjsoXOkI40Q32uEeEYHFlvYvQ9a2+KELpNt4uHgO32094r0uLc2oUlv
+1XXUF/rw47X3zjzX++LpdfTR+2BoXXxx3L8hAJv9SzHiEc69YT/c/eQN3TfeI/dHb2ZIUX+CL+Wx
+6Qf4kNddf/eOgDz9zfvd/dlbW7N/Wl//SRg1p4uHsPmQNXdUJKAAx/3Vu7Rn0lfPCWFkgG0RGQRo
+SP7KjwawayYy0nKzgMw+Bt6J/aVE1+PW/kSKHtoDzCeH3Uqvr+/seJ73Inf+gtck30jwz8W8QFXg
+Up8mRf1dtr3+KY/lA0HfRnkr/cXbI0V0JE2zJTlLbhDVfd3zInfNhu4ny8gAshrGKiPybLO+/go6
+9ys1AjIF6Gko38ZPdXaJVmftjmXU6MbBSgeZa/baDbs3urRJsQbahHxGtnD9wFsl8Pa9TJgMD70m
+Ou6hS6PoxbQT1OPPLUBFfjgGLBEYtFygablA+A2gS9BpB11h25t41lFXILK+3g/YNYAK8pro2BaS
+4YUBv2XTXRPhrsvFKwQ+oKD1dSCO2CsYbHVrDYdPjgO5X3Zm9o8Ko9b6V9CKSv+uj9rnDpq/idE5
+vNPB4+AEHa2hmj8EtFAbWuvP9NEePlXPNc34MlhYHLKgHsVZOAyDAfRQfyBN8DI3U6a9fBwonyLS
+NkAluR5y2o4fkJ3HtllM083I6ZIxbOOA1Q7Rq2ywwllm3P65gxY6ckvbtugHrwJLDz2ZizQWEqke
+NKyOvs05aeBC1Wj4s4AN0FZJK/LuRQGtTNK4ycJd+xVP/ICXtQABVIMeOtOOnFaAsxOsr/9lWx8l
+ojBISoUxEvRumwdkvBI/W8IerBbGzsNpNNmzHNo4D9iYcua9zw3+284P9WCCjlQUqjrRFaO54VYK
+WQNk4h12i6aB8u18TuV2NlswG0yqyRuGYpUQeTIr9evbJC9YxvStSR8fC7f/Tb1VfW3rbqjy3nBv
+AN9SF3TElYpwA+mTE1s5XQaZoswzQgSY0bAAyA+XMwTk9ZMAHl8Q2MmQvpc4sjIPq2UX95uwahCe
+Glj91XJpiPW5IFmyWaB0zCkHwfCjqZd5pU+XbC81i1IJszQYD4UyHq16dI7QeVnCZN5LJT6qa0os
+wTSwrxAcgBKH1yG/SvyAuo6B4C3xEBtoq6VeN0Wvm4VcqjKRmxy+JmiH441nf8pbLmCi9g1QSChX
+oobRiTaDxUdxvo2NDmxucCabm5k+t4eBLZpqkA12fsv721Te/CL1Gl2JifCdwbZF27pAXy3cUs9c
+BXUYSf1vSauAwm2gY9btDew3boHqN+73/yJOWSPvd/uAm/VJM0uJWD4IJBHsRR5sbLi/ASqgTQmI
+FbKxCp+8B3m4bPhVKoPCHpPwPHUSjYvR+AIirDyMAFamleCYPJlLY1smktFshvQ5SUR/7JW69EPZ
+30dyP2v6TQvyTs3U1JqOzeQ0L1wg57zfS20AwFr5BAMDP35H+jhr/Ya7h7sGFNUlqXI9KvHeiiow
+3iN2aonObuwr+xE68Q2iJaVfYaMfWmZ6D/5U1C3BG0wJK1BLLok/csyKS/XQ2FruG6Z3cgkY2sVp
+8SOT+DJUXydoM+sRrJtFapx3ujeJPzVFiDCL9wJ/tD5B3UCCul/ktneNpLbv9fEnVXzvlyU5ZECK
+ijh6JVzp72KFqsNBSeu4CjbczODzH6ppPJ+LyggoykDvGMlkrJEm/FNLGIX/SsFRwPK71RKb34ti
+u1iL7fzCTsvgcLckmQm9BlCPzfZFmVBm7IVSKIPSmelqsd1MC1zGLHBJUYrmk3QmFpIWEWQIWHwp
+YEGfJ0uCNTfxS7Vrl7tPXY5LuhznurzcyykqDaku6ZGMhHxIKe7KhkZ2XC4BbD5FASCLmZvNxta/
+1Pgj/4MFc2FKzY99wu/uylvhlrqyYQr+pHXXJYWacf4qyUNXVdbW/mdmp9r6WMpHB/9I7XF399nu
+1w0Aoy1PR+gTsIiM++prpj7UtyZST3TPuCAx9nziyGfwqzlyVDCzhUUoNSR5KbG5s6YuH3u3YuNa
+RPl9CFeKtZDBQQ6M6ojhlbIpkNkH0BSH0eVXHlrkcwENTLsib1VbLRRZAnWTgcnEncaznM708ulI
+cfoJN02B2cArLmjHzuFj0N2dr8meNJDm1HEK55LsY7uojSrguK/heKqBN+fi3CfbmWjPLP0Uoor4
+QASx8dWJVjUbkeaoSgk0ZPwNUL9mD+fzEXm3lgBhiufM8ty1CFCU9uCF+iEjUsKQaUqwERrP4lWC
+km2hswg88cT+tsXiypsyJO0wJaNXnStB7rfQGmLiJlWpGY3a+awa/XR369nePzliQkt5gO+ScPIt
+SINleWROBnIQ2iA7c8ty2NjQ7N97+jSv5bSzs7X9DArx07uor5YuKUTtoHK0OJ5r7m5tlua78tMj
+IaDBXFtPn+Vs1OiEZZfb0WTIZnl7QqMpW0/3Nrf/ne5lma6VEMOO9dJZdeVLWf0omNgTFkIG6r7D
+REthR1oKe61sWNxpU02X2ljzjT7LPdQn8LfaAdqFXrqn2qDFgbZ48Vn7TXmpnVAckz0TIasV3iiO
+9Ln+e23V/Uw7//ygHbOd6FsW77SA+pW2kvJW+7t6ob0avdYGU75oEe9Huj66jRX/7cn5dX/Rcu1H
+ej/5pC/y8gVV942Hrp7DwQAIacf9Udo8sdyfvC8FE1buD0CA43bzO/zq7eZHx/1tiVx3f/ZiabDl
+V+/n9fWfjbggyGnXZvj5s3DclQQrlPGAB0cpcRx479HifOAdo6O3wPuAyDgA7KGMFI8D75UtbP6g
+UHtG3/G0poP6nISKr2WxiKklwSiEQNL9MwqhFJzWSDGkFGye5irw1pArgYfuZ+GTLkOTKTt3EKAN
+m2HZmc7umndgx4FyA1xupj8Wul+UhLfLXWBnfWT94Vnwqav1BKPA/k24ZBQcy29oEDYORDL0zIMC
+8N+ALqWkSA624sCdlApmvXGAJnAO7F8V5v4BdnWmK350M3+Eu7jQU0FeHA3y99GZbt0I9dCBCPrk
+WJbqeNSUUWDPRPsu5T4Tebe2ttl6SQbHbXRk5XRs0xlkh/Qa3zjoLONNrxv1yL0ivXlo7zuB1ic5
+b8undgNlJCRE4bzzOQ3QG/fUbqIBfvROKEqAxYx3J2irclqxfF2418UBu9Q75CGT75+h0dIOyCGJ
+gGV3PgF/b5vmU/vkMOkOZT54/Xck5ot8JGJzFu5dibzdu2WXEEDNMv/uqhFbswVXBsNL44YCirUr
+Gmd28GYnAL/CzzTWuSaSzedXUgjErB0OBbQDmEHo9mU5nBySHU+eMhQuIYjJIiMqcoYvknOJ2PmV
+bGucT5pQ1QlPATosMKabZiReLNybsvHwA/tQ3FjQNlk+oQHunL1KrCqgql7Qb8pS8AUZo3UPy0om
+SEVVAliYHViNXE2UqybJV7Om6slo6H+TdUUuLSuW7yza9hT6+LOXvyp0bf/KkjcheAwCaXCuErKP
+Ub/gn54lPEXCbH1daX6ZaqOdC9v8dBTB5r1mkMqZlFOQRIZ8sU+uASP8CrNFMGZrkKH1NxCYLKPF
+FeiDrArA/FWAeIrw0JKHD7TqmSzQmBFLEt13Nmw0Jn2rvX2U4FkhCKkDjsJxfmf/7Fo5G2LlWrhQ
+22u+KoW5YO/xYFHChuSNAvcMfoCBRuHXZeC+RJeY8HcTuH/Bz2Hgfix3DwpFfhFF0lESIJFfXcvA
+j9a3Om+RfTLyoqRlNp+/gzG0yhz/WYA48uIsss2xUkS1NhV6d3hFhQGu9TPU8cn+bA+DgjrK33wq
+ZBj7+HFJM3PKxjJKuIsLYe/7yu6bbmL76IFIINKfjS2gz56JYONGR/UZrtTPwd3rQtEo2b/T5hhh
+2QRVSy8ZYShQ1Iukiqp3GpDRX6CMGT7M8cftv8Fx4WQ6DopxdBn/G9RU5eD2tZpzqUaqcVHrAAWo
+14F9IFRU3YLm7yhwl9STAUhXasBeBt/Y1CV9VHIa27opli08wLYOv63gPFEEK0dpUJYXW7KgMNON
+UKoEetL5+nWDtSlqQi2rBPxsq6sBXbwq0LPWvCSwETnP59b9gj/v/Rb0zQgQ3u4DqSS0+iYDUzIn
+Gtkif5Uh9NkjG/dafQsP9+c7w0LsgOLtbNnC48SO+cQRXSwqKyHA4t/ZehkhJRYBKw4rJbSTgG9C
+oLlf1nn4tfuTMIN4G3i/Sq+BbcCx7k+liDG1b5nGoD7/AugU+IIXgOhpZWwXDD9oNnSCMvIDYWBq
+bytnRLaQCpPsNHd3dkqTiFs3lGjJFopMlAZ+wgUBA5u7bmikkdoweK9ga0WzyeMJ1bXV3CovJ8c7
+oeue3eb2ioSG4UN0QAS8Y2lCQNP9eIpom1r3dHtra/vrgqyye2VKE4ot4kPbhMlHuoXC7PreU2Hx
+UTDbpHnXJBWloeIogfSiNURc+9ZeA8VdA28trh/0seO/8lJYX7dy3+jnPAae/v/gmos7AlbQ+hT4
+n+kmzcSdMTIHQsK5j7wZMq4vNWFkT1ydGGnHFHqEI2wzxXTtmSKzO+BNr+vMOaGgAb+ufLp9ia9Q
+NN/AvCaHsO3QvnbFyVBxE+rb4gqWNq8/zB/NZHg9+0sQ4RoXr3w+h2ev4hwFSEcRJRqFG67IrxIt
+XGjif7X+y5L6oQ6j8ktdeY5U+W9W3rmRby3VClRb0a240a1Ic60QiHKpHYluh25AsrIBh9oGU0um
+wpnnc0S6fmokaS+52Nt7tlvAHWTLFWXUOzv5iwB0e5J0+ncLVwRQz56upRTLIsVsFO41Gs1/ItwT
+Coe+Ujj8imp1lpSpv8b1oTop8A3PoQkfJ3XkuWNLn1FBnDhfynLnS5t7u3tbq2Wq6CgjTgnHbm89
+2y3H1wOdaOfp7rNnq3cZtjsI2G0Ftp4EmY+eKyjVzu5uear4Mg2SaymE3Wvk3bbpdFMfacnondzf
+tmCD23xofxNb5e7OVrHizd1tRx8ubW1K3/Em/t+RetfCim+qDXetdtEo9cRm7BVdOGSMu7OetMWs
+3TJO0d7BFG0tjFHZekrqbG4Kab2Ub9uiGajm5va33+YVguVQS4Bp09rbacrbICRInXkb706PDiv1
+87rQtkxx+4q175TpNzqzK7EnjN7PO2PDp8imUzDQBmx7J3fxJ2Sz9X1baEngWXCLXabmbOq6dEVa
+06IzvtQjPFq0pnZc158OIrIjVD+ElSLj5Defbj3damyvvmZaAJOvyfT1vQzj/vTm3vbOnnR+QJLr
+viYoplruTJZX2NzKlRZBD5TmdEGmL2T+19obz50C2YJM31DCFtL7Wy2yv9CC/FNSdAakKmT6EL8p
+ZPpoSZ1l+kKMfqytx/zlvTTPR9wjz/p48vaUaMcPfuJPUst9vxSmj2XPvCuSk3+AXy0nP0K5vxnw
+HqX/aF81oMXvvsKPk+DvGQCvhWcA8Pkm8AdBgiLmF96r9fVXBjHy2nu7vv7WCPgCwM6emdyPOQH7
+3/A1CFAP+ePJ0ct4Mo0jVMH+xUPJ2VLwo/w990/wqZwBvuEPlrP/WLju/hNHplfhMMPDAv6a8mHm
+75xYeAr8TXxx3M/exnl1Y+T+6rHh0G3S4S5ZrL92g1oTRX78gicUtt1pfd89Px/4tWHvfnPh3FtV
+YOkXjuVao9BC/isLVnlA+Ft7QNDMNVq9T8pkfD/CqvrZtSrkamO7nSsnM3Xb0NwJmkCBHBn0xEa/
+fdAKxzB4gscZG921x7bzP73595sN6H0cePfWmtWyvt9sQuMf09suvNn0tgdvDr09g7f/wbfdQ3iD
+zPAOPc4rTxtHBSS3CEsjf7R/IclsAJmF8m5RTerM1BV478pl0Tq1P6ACuLgF7Jh2FC29FJZ56hPD
+pCLZUUTNWVkqeVCyWbeFVWiVip3Q7xThnjSZ6GWdqA7vhp1ECpJGETHOFZ89lN86ROYXtHpJjU10
+BXWD6HuWsEtv17TmQ5IF9jQ+9ZM0ULx+S4f9PAuSO3vJmkDQsTrYvEdkaKHzG/w0nZaQFTuL9jgw
+/Z/TeB/R7gCIprXUWmyd0MqbTYEgQTfyrtGkB+x4p7grewdCvjZz2MQ22oWCJs0ch2ahvUZqA3jg
+4NDgt4lm9j07hnSXthhTRyR3bbSo4MMu58ipGhcC1viT9WpZ/vbRtgBd0dXESoqYL+oHFRTDSrM5
+m0B/vLHN6aGrtDBmqSQWeaYP7bHSKWbSW5ItfSRbAmcEfesDuV9WWl8VEnT7PdpH9UQWuZfAyd+w
+/h2d1qxbbOYtfi71FNoODGjUjfEStRK226S75VoeJC9rSBLYP+FBkGgPfOKxASZnURIQlaE/Dr/k
+JIyyOaiekysSTzmERaZMtSrw2PoS1J+QQjCuEKcKdVTpS8yqeWyBvVu4DGWt3EUyXZk0etNwi+tA
+Qmv9b/xUBeWAWsM0nylRegXWC6JXZjnfYOwPzO0Hq0429htlEoK2QGfIy9GtBYd03mc51+LIGfYD
+957dlhX4xs9Lyi1ADAp59AfBPb6hI8gcuBqASjqmSV0Ng01S2yUpQUlFTU2MZ7Iy0sSSlUV80saQ
+mKg5T7pxD6eZ/HvhCW2MmAdAs53lm1Fk1r+xDQbEiZO+HMxF7AAgIytt1IpEiSExLIfpEeNSQw4K
+CqD/ui3kJhUHRDUIjTBmekwSxApoPjcWbdHmGJeFJ/+dIRHjAeuwMCJrkqNAffxlqcXDtSM6kmAB
+HItqg4+3dkJuSMrHz2Noz1h7hx2j3S50DzsGbMUtCgFd+QAtsTuu1RBeAPfjLQixAaPWlz+fv4F4
+AvFQwHeKguU8VOXN40lCQC4WdAUpG1pu/o9Q1H6GTzY7R9dhclVIe+srDGEszUrkXdvfbMpyaT1F
+NuD2ROB2vpRGrRMCcDdv7EE77wOkk0rNDba4vHCLphTKU0urzAt3yexKeQZlunmxyGk3kE77DNHb
+X4D4NJYiHzAql0zz8BmqHFS1K9mkPV+sbYqH+0eFc0WlmQvNKzBSLby9RNcBJvZbIZkDiii1X7Ow
+dcjvKGLNX96h7flGSxWJtLyMB2Tk+g72VYDqI4V5IO6KuSu6ifDWVt8O3SF5i/dWYK/ux8BfR1mN
+HHU58/lwOdS1YKsAXoacqm7c1m5ubmrQwUkNtjHmsAZt5Kegyd7Hs9e1PTQACvB3j41rXdgN95B2
+fVE/heAusVAsCVn8tN/xWZYexdxQqzElBx7Bitsg78rh/iqwTdB3WvcLOj+b2K/EcA5KOQixD79w
+aEt99Q8Kb7/I3VQd4EGlQQC/KIcX7JvgkVuDANtoePpcBiTcSE7p9s8HdCG3tfOQDS5S23bKdYCF
+ymKo5R8FZ8JCZjHTspK+YWvKsFvAvopIAlJQdWQrW2yQlvxBsK7j010Ua1wbKuNkb47EI7tQU5Pl
+I0IScqMFMIfcFny91QKYC++WpBOn8KuFESjNsFBMclgvjKL7GcLkMLovvRQTuMfwqyUMf3mC5DuK
+MvfI8D/qvuePaXzjnqFTTC1Y+ACf2rreCXwpMcM7+MgZznvFsdN4inKRmZZBvOAylQziNUcKGcQX
+jhVCh4/ii4UOf/NXFr+Nb4LkpY+2wn/h3LNI5H/kWUcRoF7YBdL+VYAG7j/pIPRCbLlvdABCoeX+
+CIy9X/vS2wjdn9De36Baq4vvH7yN88GG+7u38UfjFr5/QyN/jdpur/poA6Ugf0BiePuVbP+RRAM+
+QxSGQDkN8huXVL77fqP1fL/zn+75+Xnvj3lvAwUcZnwxOgnYlmCjQbYEG43NRq86LwY82hixUIKL
+6bFEYgUTiehIegHV/C1vuRkSXOyxaRs9Njm/oBTk+82nsHwC78gONvBVUlgniEzR1TyWyI45S0ok
+B/Cleztz6ImH3qfold1SNtr+8722D7UT3971ex073k/oWDxCg7WOkR4vYbMZcHQGH3m+41arsb4B
+k8+XLVgFCju4Rx2M19fJgG83IXU0unKMuk0RGdZFT/NJx2rh/y2LL4i3MBAwMeRAg4tu8nyXXdJD
+IsfRSgdWNUNH7HofQCHPPUlzBqgi6sMeYlWsVtN9bD2Gp/Uc3619ev4JT7xoJdKGmPY7iunQ856e
+C041FqlSTLVBMS16tunp0fM/9OzS8/ycfnr0/IOecy5qVq4DeE1iDyXQ2d/aXF9Pnjc3d0n7DXW4
+OkFrWR5JdwOBRbsfZtPWZtMlh9U0c1cZhOw16DdtbW9vuTcpft+k9LV0sVg0RLZg01NXTtbXP9g/
+umfUQFSdgK6TJbQzEtEImwjQPSTTC9eP86YNKoHa9NbXgXz4iEUCf4iFGvXN5zDEaHU+oyo2sQoc
+UJRdwVtHvnxHL8U7ysp/ah2jA0j3/WaAryTOROVimMcJPUf0vKbnHT0v6XlDz0N63tLzgp6n9Dyg
+52d6vqTnMT3/oucRPd/T84yeH+h5Qs93K+//kwO5SyF04gPY2NPCAjRExUKhY7TeSRGpsQ3RalVe
+3pRILqEVi7THO9TSXGN7+2axUrXFLB1Ymc9k+ejAcepCxCblkksV+4tF+92ybI52TJYTler1CAM2
+JGtjWQXOKZA5U8BQQ0RpZEzhGh93eNGQLbZfkiIXXiqs856DKWd1oFQSpNH5a+qn6U2cDPgLNyJG
+ZjPyGa/eYecl8+EsepHBw8QfISkmv/vkiPhFcPACNkHoFDko9F5AX5IA/UM64iuiLxhddDLVnj5X
+Vr/byrS8DxzktOf2hSH5QdAih6uwgHCB+WzHPpMk+KN23xsFbSSlwwiI/mEVQBgNaniTwLQyP6Fi
+fJjpD/ZPUApAfBXgHYq1auIXlwIUL0poSzCx0LW9X6yVBh+rJjOIqnpMBHXM6mF6ijpA/th21jxS
+PxxinYh5LLJzhGnEDcKXSTCAsYTEqQ2JcEgBKnkejExyMgHX8XxJRSySwMqZHrqZaRY+3xS0TtKV
+SXuyDmqMmnTYOqhzxWo7fe9l0CoUB0snkTWbKa8LKSHoEMAdMRbMbrUJW2kf1V7REnbLLoOfhvvW
+ZvAjiOl7Z4CYjAkdMVwk83mylBsQ7Xc0aWq6UGG8PBnO+b0aPdkZCfkj9Pjh4706uQAS/jXXAC2g
+sh70vRMBhIu+J8dTVtGB0bzUkMu9uqZewTitEUjiL48XrAbPSA3l3dLomTB+JzNTn/reha78fbGi
+S0q73G8fCBjHQBWJejVRRqJeJepI6Ecij4R+vjqIbWlendssNzAfrTUZwOP0vZtAp+1Q//7P20jr
++0Og8QCD1P3/YcX4K51N1NzcrC3+fzUnBcBGEDaX3Q0vu9z8CECFacP5k8iSw/5745UfDoRuPqPB
+lWCugkNqIa2P3Do6s4GDrgIpphZCYfncGmvP6AqtI1Wvkf6C0/+Hl9sAsCjgze+3G1Z1SAqMDaBV
+JvZQi1ZvYKe4eR7KHe8G/alg+KEXdm96bbnXHM7nd0Jv1psF9iHQ1U77rqNHrOrdtvSowhcPxB2q
+ySLqVl4LcDELOtEXNKEvSMLVi428yED/cZsSCFRxp/4su4qTMLuz2tMadU50ptp0xY54KqYFtlHf
+HN3TgN28HNCoIS1c2F9wpP8Kclspb73z+bU4NvyXvSnsWWbPKp/aojX/bFc2dt4Uw5CCewMwCyMi
+S04xUgzKUaB2Zd1BYILQogUQb40W8EDUePuauD4cPEWnLrf3W6r8jEd53kEg4kzA/cxr+IP9gySn
+/s3QzudMgFswR0OG1wvvL1hkzQaR5xf7O0+fbj2VbXvTFsu5MBsXwHSYREkHB7p1QeC0MGiuNo6i
+HjxZ6qIIZy/zGxtDmVvYYGBHOdaYHYkITremNkKExvd5aBRbYwFDfeMGJzetb8q9ausZBvaJPUJv
+KA4R1UThf6XEFBZsFkQf4BOWw9Km8k96U7Y1mHB1bNAfeqh5WTMhkuhln2hqttgtWAbA93Ijuo0e
+MmQdRQyqYKeVa32ha9yiv5jqz0M3t6sI5nyvJyOWe4gkx3uD5KAVyKiEqrQsNweXaq0+uDKtcdz3
+xyTyQ0SCL4RxRJGOLlOhjYXJ2OTg/EjAeRHZYcPd4iamSpE9YhEBRomP79S+7a+RCMsoxynkXwaD
+tgjPg6/R2verJ2IJreAk2IXJYUxTJ2mFPQYGbQwT4rDYggLHyMAJGcaYw+VXxy6sAvdrLTA5D6d1
+hQDR+Sd5WnYpz2aQdwLM1PTn4WBIxErDqaIoT/NB0GO3lDXDU1Ye2sKokRpZrmIUKiE4ezO5jtrO
+a1GB0+b8OGC5mXRaXCC1sjj3jtrvgVjx3RSIFWPiz4Dre7jQTlmZLQGFqpWidB/VSoziPwQtBb0d
+kcd6XDJBHVF71SONuJb+5EZA6BaEyjpaZY0yKz4JdBNlQtHGEDVCgLZcCA0gRAXlmlSEFJAKEGJD
+AvIe0aqBG2i3c3lixVutlUWyIq/bcHP/o/M3ZEZJVsOSo+WDWKxy6iwEFQr4TuATosGEEFNgp2nV
+24TiqtUZud1rD9HMJ6bdA7AyKCPKSuWwBF4I9FFS+quL4ajf2Nx5klWBbLDJbRCytG4CdDktd6MZ
+Da3egHFAfSbubH9HIUzh1k41JebeRvsNRuyqtPX16Pm20ZXqQtJCWJVZXlsGSq010cqG4wpRf+zE
+gJQl7m9ggMgfe83Gk7jqY+Hx/ubTp2aFY1Rl33y68wRfqrG7uQZjCaC0vYZreMZNwg+ZiTfP4szg
+UK3hh6DwJG7XQW2ooFrteZnanwQV2zeaI2ZyYcZi90NvVusD5Oy2G2veDH33NtpO6mGbXXjUaj14
+71fDWhO/+9VaLex5qdoz99Y0LEgH5AsSZjddBGl3LdNgTQJTQn+Z3qIwrLi7BR4ZjfyAVk2Df7ou
+vqCWXZ1v5o4VIkZsOO6O1Wrrra+PNRMOxcovZ39bVhi0Wd9R68aRgpKk1gH6xuhLUScn+Gk23NhE
+ww1a+TGsfELgH+zf3djpNHdae27sfYSIPcRMzdamg3QRwVsItSmy9YPdbGCKn1uU8LfWr6gpqesE
+gIXOO4u3qKXnGH40M6lOFXoJ+gGPPA89f2Gh4b733gbYdJ/W9EVPJTavUAoNzhRDY5F6r9B+hvDd
+aGptpVWq5AkXu1VTNhkqKZ2CibX0ADRguz7YWX7G1aFeQrLkspoZIVMPfWmMUhSLpi5RXvfGvw4+
+Ckb6g2CvPxRUjaRjdpkdGB98LQr8tKCWxf+0My/cEnZyuXDkoVhnUbQFCpNBkuuHouRiKFHc4Rvu
+umJUmdI0z7LmlCgaqIpMHSm10fl8SSfW15vk9xRIlkDQ4/O52p5qK1RKdT1UhZAvojqNEgKhlWMl
++4lRmQbZEB9DUeITUjQ0MYUX5kDQNLfcbvFMBCkkU+sP3YUBQQHb+saGBVtoKTOPmzYAZdWO8Ei1
+GrWA5qpa/0EzP1U0qRszUK4JiodOVKtATbXU2IhgqIPyBEvA0AlRX1SKepB4rJ7YIRKeUIoli09F
+OR2rmqo6xyLwO6s6htJJg+9NEgxXmHIUR1TySEweUGUlx1+GkjdehE/CURitgAwxW7IKmA3akS/H
+8aWFF8mNiwuks4Nav5Ivq8dUsr7MIGAc+yePoSW7HQhdXZNSC2BSYWSrwkARc3dVuTllNGcZzllL
+FOlK5039uGxpGB1CcKEBXU4udeJ5OPEEDRO7A3H/VCKJVeVLmKbSlxOrKZtQ0cIpgEYjq/AQbnu5
+8qVbZj4PR3pK6YOiGkEuLaG/DPUDxmTmHYdJlL2qGxrZpGWJ/9fdMA4dv9oNLe5c6kaepM5BLqEQ
+E24N7EAeki2rJbecDhpbYpNLNNvVjFdbkWBfge/N5eeeCkDBzEVAKTSvrEnUBlX7EvB8SwsORAs+
+lKrKPjggl7LyD0Uv1N80ufM5kz188iyvtsgDxVaulZ9JX55AMbt6YKAQm+Sc3CyhWNyLWkEexQYS
+xQoALlaxeihtVau6vCObfCSbzCptKxrMAj9JfeE9nWogG1LMCM1YHjB9wN5i8QdzhWQx/iMzYK6R
+EGVQZis/BE6J5kEe5+c0G1dhSjM3W6dnwPbTVV2XO7LR+++M3udzUt/XdN9t4sBX9dVkwnPdPUEu
+PZ+E1f5X3DHhQVvuoaF4zEJ+cVXkVdn1PeZK3LekvPWNBkoj7wLtkLJ2CTmgSFjt3c7qV7Cve5HZ
+BjcT2yc70OBN2hbX2WnL4gi5gVGU2hwoSq5RziURLucSXxTFKh91gbNUkC5IYiIuiJZzXeAXUTYv
+L1k2f1EUDzFHMNAZwVIZp16ASG4CAIuoHt5sXHlvA++VoTvjvijqZDHYrbBzeMpKOd2gZwsjDkC+
+lWghy3RZj9XFCuay8hrpJCfx0TX528C9x2lsvQjQNoyYSDQ8yWQbarswpYUJ1JSieUhJg4gIOaWc
+V305rpxemVBOMCdUX1Ci3LBlieJblCi/HBfnWSZ6Qyqolth5RKRZnQQDnUhWh5uFrIr0Vi2xhVBb
+BO5VbeFv2RbxhbqFhBtFMoYHTiTeZRKBtnIJxVVqB6/YqDIQcERj8c2h+wNv6SYCm26yV0LJ1+8h
+vP3ajYZvLOkl45TXgfdSeLPhO5eoGP0FA5PgOv6sA9uvA3SO9gqqLyS33LH9OoACHcgo0xRyY5ov
+lGZxQ/Gsqh2XqceHwoKYz9cqWq8CMgqw92xbewLd3tveK7G+gI5ot9DVj1Snjxbu3tbWzlaZv+vt
+xtZ2Ie3O5rNGWbnNzV08Vcyl3X622SxzRfzs2dZmsVw061tW7rOnzxrPCml3t3dL/cQ3t7c3i317
+uru7V+YRdQ9t2C+1YXO7bByaW3tbqNNqoz0OvJRktmVza6esLc+g+Gah/M295rOy8WhCvTvFOWns
+lPndfbrT2F7u4vZ20aHslmH+gY0rxPoWgq8MKpP3VZxRvOhA1/INJD727l8dvzsDII3ehilZUnsf
+DwLxvjAaUWIpU3mXNnyCpPO5b6fklj7DT5lmPo/tsRsRrRG2UPSz+Wz76dPSmSBnwnmIfLZbOmt7
+je2lUW02n5WWC6ulWOyzncZOqSvd5vZusQVNgLKSpFubABzFNbGzufvs308WLan//mSJi3UPTpZI
+szxZW1uwxspgde/p0+JQNZ9t7ZZN1tb2093iDOxu7TX/F0NFGOW/P1R4o+/BccIEy4ME6GazzKH4
+092nO0s4trn7rGxA9/Y294oD+nTz6WYZ7O3sNTafFtJu7ew9LUv79Nnm0kRt7m2Xlgt4bWkF7m02
+Sid1B9ZQEQduP93cK8OBm3ubz54t7TV7T3dKy916Wuzb5lbpdteEva1Y7NbTZim87jZ3msXmAsTl
+3EioJqCx9mLa5l7BjuA/glfa1f778CocfT8EsZxkGWb3nm2VIla0WV9c10/3yrfvZqO5t2Lr3Nna
+2isrf/vpsyVQgK19t2wetgD+l0iJ3a3SbXZ3F82c5aGxCQ0sa8Jec7cICns7m41Se1Zbjb0lsHlW
+vnoA9ktgvHSdbe7uFNMC0JTtSFuN7Wd7S7vX08Zu6Qb6bKnYza3G02elg7D9rDi2zZ2d3UZpG3aK
+K3J7d/dZ2V77bG97q4hBNne3d8qKfbr5jG6RlYEP7KN7he0BLY7p9fZ0u/E0Py6GjS0YIoCcUmwI
+C3FrRZ3bMKzPSumHzc3dVRTi1rPNMnBEBzWrqMqnuzvbzXLi4+kSNbzZKIXhrU28E7qiH1h33o4g
+3SB9aOwKF0YR320XTJjSzVvHxTl+hsZGv7FYQMnNZ42npmRgLWdD3TSSz/LoJVNdaP3FjZRjC/xc
+X9fmveBb+KmIPet1OA5O6BK2TPcCT0343ZRK5Y9QMJELCLdwaoJ2n21E5tbrOJm8QqOB1JjQswiT
+v5gNh6ImFEmEDvYg9bpWly9CVo6ibI9S9oAlloEfw5WhL8f+ZBoMliKhnOZOaZaSYEi8tVmauCT4
+9Tj2V4bvbIvwHuxURo/rYfpLGNzM52UeAtfXU+nbyy56FVE3gtlLO+xH+7Xmoi2LqcyEDQBpemlN
+XSQl4aRw64yy1o3uH37tS6P27Lz23aPv1x8/qdb/uPhz/j+9jVC5aubjOHInKS8620qbGm8x+30A
+IrQuxPf2K8MwGA8qLBrRFwKNG8a2s1Bt7Runa19tbqDzTfUWfo+Gl1olzlnp+rKyXc3+PbXNcGGo
+I0NPnxKI8fZpl9eBMsbVK9E0QrPRmW7MUAnlJ/4Ur/8FFcOb9LCj+IeiVTHKw/Z12Hw/i4ydFpM4
+YcqW4PBOaFkR+QLoYDtAwwKqGBhBATwlRsrRbtlyocutYvcawqiH6vOVtANFpiU+psFAKjYI46jC
+sqldgJyDMfpluqvg03IQ1cn8pPsvix8YiwFLEIWaDWWHt3EEa2xgzhFALRpTnY3xyigmIFOruVlE
+WyvkLRg9xehKJxqosE6NBt3EGxhG9tFYqz84SI3FTJehdUkjNTp8z14tA/q0G9JVLFajMRkO5l0W
+vGVdHV0bGtMtpsTThfol1a2rvS4chOihbfLRwwU6kn4BoSV3dzkBZjmCRF7gBp0S42061RksOy9o
+xevriPgN9BSmH+T78VDZiaM8mBLy+Ovrci/4tnwyNeRN1tcLe9u3FcHNVbjTdlrh+jreUkfv60Dq
+Y/GIj1eWluFJjy7PmHxvhDNHr+LMRw+j3BjtbmnWnkPtsM19YXV35vOxPmwra4U4TzI67H119yhm
+kcdUwgwLWZNeMv9irwINmStdyuVaGRS/MR2j+/mCHZhWHkSESoX6rmMBXy2/JI/zz8BF1PtQH/6x
+iRtAQrFw+FJH5ZNlf8NXwgQUWdUz9e3yHVrGr2Qwupgqn9GAj1X5vw6h+SLlWjQoA4Hb+/EMd/44
+I+xekQkrmKvipxXSvVF47VvagQDZI+ultCcZwL7i6LfY+o4Y3Pl89bCZY9RS82QLn7ATufnVEXy9
+ZWegZP7QmMNIaRaunkNx6qzC3RU7jiu3GhwI2mP+8fSusBdS3E6gQrIEQJ/KI2WZDTvURhRUGVp7
+n7wEeH8ZowcjVBjVpkrRiI1tWc5iRXv/a2CF87IarPLABKDky9U4lJvKCljKyAorQ8GNgoK/0jj6
+lhx4TsdH/QJ8F0MD8zB5VTgEDrwZmT3w0Fx2WzvwRrKyG/TaxruXdJKq5VZQjwx4dbNoNqKY2+CF
+lzuVH6vp5XMBli93Vc5NIrRIzg06RitarK9glgOJvFXe1yETxhuEqD1j45NmCWlQ9OaSbzeNTj6L
+IGML2ZR2FjInsgxnVUsSdI7He2LmqhqTHjqbY3t2Zo0oxF7G5NrPmQSwInXNRCtabbLZdf2UjurN
+olna+G8KV0VnK4qWtn3/dw3vwoj0jBqQbTJqKWGglpvA4H0HXP6rw7eHZ2jC+YfDM3i+OTx4BT/H
+H86Ojt+fwtuH41MM//DxzOppHvfSMGWDGvJoRCabz+8XjjJ1l2PCLovMSilbm2dO2trCMD4ZKPpa
+DReVZvUXKn4w3SA1scQn4dWhYVdPLKdJkF3FAyiEX0QgIFIMgh+hCRWOIh9bwC9uzCo+aEVHEZlo
+bsn8dnNMlbjdpDqjGGqFf80+ZeaX1BM3Q6wUmMcaK4RY7prqdZ52Kh+DbMUY2Gi9ld9FlfKDoQIN
+YWfxR8CZQoDg3inpSITSj07UkqQ3jWBGP7IseqXLNOaQZuJFpBLjS+9JABtUAlQGZbKpEZ5QKFct
+I0jNhwIKicshC3ku9q42Hsc3wQDtMleg2Ar8YEEAcmTNL5Vgpzg1OzY41JsChyp2MEPMkoQT2IHI
+1puNFovL5QZEagpRYSBTozlmHGkhN5Hm5Gw2S08W32NP7uuQuCRBO5OCg2VL+zBTbkloTB7KcvKU
+Q4H2ybHXvSK/0OiQNQiGPrD2gtpH/yuz1NNSHRHS2Ww0WvKDk8afPSPLvgcpBMhyyPOtRsMslHkh
+/Y4CyqyTGbEt6/inPK+0BObahHqGT7wTUWDEEYOazHN/DMPilZs1FT532F5mns9E/ZZr3r2M0hwZ
+dmiGHX5jdYd2vg7ygw59bxmj5BrDURg8ZcdTDIoxTo4Lg9GSY4NtP6wvSWl4Z+KG0DqU1TfMSi1r
+YUoZCUaoKMsNWA3y1utuNZruVmMT/rbgbxf+9nrtQ4DfQZgAc7zsPK7WBGC6VTgmM8WgJ340KspB
+uUEVBG5NiZY0XQ/KPd6kJm/A7N4nq786fnd42w/YGXCQ+yQvC1hcPpWWtBdyl5FPQZr6o0Aayyel
+x0x64qXOyPtF0NT+Z0QD+FtsmGH/SwgWWJXLpjJMKFuVL2futNAfhQEu0OzWVwSAkeszkIQeLw7M
+QicIApevr8u3un+JF4mUiNK3lwcTdn9OBMQGvVGPUETJ5xGY49d3b99k2VRYXtXUyBjANeVKbGeR
+lgglFa/oSVAoW0Gly8cOPBLCHIzHJ0EKWDMNhD8UsltjCd5x6AYGQk4651H3PKv0JGYWSJ5jNlZs
+C4UNoVXYEHhzYR5X+CiW2wEkldFqC4jQ2Q+hdnRnS1jQSkQPUHkPEGrawc1WBbWSvNTp15oY6hop
++7W5TlVIsYSWfsURbEc2L8EY9ZUXblomC/aLAur3QXYTJ5/lhlwZ+sCUDyxZQMbHo/+bIghOlgv4
+RnCkMmB8AcwF4RgSdYr+PCxxWQzJktAk22CQ0J+EcYeMTLbAksqW0gI1X5K66bhq3LGrNPYsQDVG
+ndAvi3mgWXIuV5x+kIBNTDBDtDgEwbxyCdt6DQ8Gh9fQHtTrCGAibYsG0nLHDg0rke64foL+FaJo
+c4S3SY+DUpABXwM1JMEkvg5WlYyWQ6GZAM2Kxgj1jsiWXowA8k9xUZ/G47thCFQ6al3XyQg10DLi
+zbuAMLGCPVy1Ygi8S3rnkfQOEYPqVJmRKjNSwYcsVODjvOdbtCt7cRGk7+LBDK3I3POpF+rvLmy0
+P43+NDnLQJ+8pcF42MFHS7C+e5s7z8rdtrYjT1uhrI868NdiZ3+lWjfEhL08PUVeG37qQdr3p8b5
+iA4TJySFzA1v6dJCOcH9py7pT1qIsNOnFR9wv8heN3y6IV5WbBGhPOFVIPZqTTy5tlADiWw2vySr
+03iCU63Gz6M2WtZFwwBmJJC0Hb/qZfteE0jM595Wcz5vbu6SNVO8Yg+B+972HsU93YW4pbD19e2n
+AGkdNNVRzfShRXPHqaKlWy6lSaZ6MSUUvGZjfZt787kIeMY/+arga+cpfT1r0NezXfpqbm46XFsi
+TFNDL1rmO3TI+v/8v/9f6q6qv2ibs0bwDb9Er5vz6GVoKzhCD4a7Ozm1vVIXjNt7uzvbTp1lhHqH
+jU2ZlMnYR/N57hvYoaAQxN7ANHHhS0DMlaP4qjqyaP2O+LXl9Sw2hx/pb939fjy9I2HtolgqtsYx
+b5kiqUTCNlSzsVkGWtJYMw+HMHlWkIx+jALlNyjMZkRPWga7GGp2UUt4yk+jYT0vG55eXxdXVoPO
+0ok0Xmf0Qrp8qD59Ok7CtzFaUZYfQYG/S807L4CeiDa8uGCnVYxNWnjsvMCmo+xjCZOwOYsCIngO
+y0z2QaEy0/uNdC7SbJfl3lTUoZGlrQk3MxhwREELkU92mdvSDhKbmhkoGfVIj7lqbyTlO2hIIz/o
+EZB7Asmj+BG+S8qcaZGrl9oJ+p1EAhKIsZnjkCVuMcBLNQeq5oDS2Aka+QhKlBF0LM6/AAAd6FPg
+UvmZKj8r9iwzswvQYdeSMggJA9pcHTxnX7jPnj3baQVlSCR70L+l1kpaQ72mJYhfsDPcZUXRrx6j
+KmvlQveHl21PGrGWwYgBZGCZvL3+6BG3BecKW0N/glGzZA7dYha2rq8LoSsAREe/or9UH1odjAOE
+R8tp7TSajS2NVnO3z9aaeDmdRQKwKcDsvAuSUYAXfpR/t87YtoFDcPPzlzidbq9FmB+9APPebegl
+xJJkMFBmBMwrTDxK2e0yV+qMlHL6Gb4RbS6EwJHllZRTqgDDQ5R2Hook/ZhwnAE9mJOxqz5MRfqj
+9FDdjGLRe6vbW9DBhomJiRXXGnMZOWQrU5bL40llSBt2Vn24VjY36HR2lU5PDOjUg2GBHx5V18fB
+X04LPHW5+yHuAawNqY+WPz8Ry4CS6LWyND46GTQC3aSgLkFIL2iHeblbWdfvOZ0YnstSmjUA1lma
+xRPKJNH3WJ6cmZHGAl1eQ0knaY0Xtg8jY6OTBBcrhY8WVRvZ4pOGLV4YXD9AKAohbB8tluHZA51Q
+U4Web3zM57HrL3fOKwkDogyS0hr8GI2DND3OroLkJkwDMi8xDIOBF7VZ7FFchBI8QsAdS0g77Jgt
+Ek1vpfIlsvFnMUaqp2Ski8Utn9IOwwRYXbn1VdIrOrW9DJjmhlymNiBwlrN+UCRA5ARSm8g/HHnI
+Ibd33tjQip0hMYl3H8z1Xkom5LYHNXOBuY4fwqpWyuqyhQh1ttUpO+qUW0mrXLHzgerywjHPU+Fr
+8l1vQB3Ztpaq0CX0o3qZ5UZVbWHyZi5rvZi7UM7ZmCLLgICHorkwXXjCNJjBParenATDMUHymnjT
+vcpRYEuxdby1mUtSMlbQ9ts77SbOwKgv4ngc+FHxKPV4yEhnqTpbZHC7vdwtWFjmzgo95tzGiZap
+1G4FrIvTWarC5JkTV0nNukgI9doxnaeiuGx8R0IqAnUfxXm29NdNfhlEkgCNYxnKqhmgrMiUufoL
++Oa0JHZWhKizKOWkfNIpC+mZ0nNmO8uM0tLi1wu1yGzkQWKtCODMTiwfEUvjxEHX+s9/lFK6JNhZ
+K4WUTXI1pgo/5RWeDc85BkpRKhVfJeWEluYeWnmTu8axpBTR10ZunZI/01wISdcd13rnTykDkH+n
+Aeft5LrSsg7kDImEG3/YndbHcH7kRBm87c2bO/OtTQdehVa7wyU8Et7dE6TIiOhisn6R355gdIRT
+H5IEKN6VPPloTtawY+NGph6Qg2ZtyJ5NRIwcPLRTSEPhHA8ZHlAT97MsmEyzShZX0ikp8URxVKN5
+ho1Psb/18+gIADRBLXJICnuHTOJSBtpGKkxIp5UJ7O6VK/8adpjKEkDZToWlo+hUifzfeqVkizst
+R4vuUIaH6esk/hJE7pWnqUUz5cArpSJfBWk/CafQGHciUwyTIPgSuCNdpz92r/MnKe6dV7aCBGpZ
+Xxcv7qV3x0vdvYE3BX3tSyCoLpe8zyi0ztghIyLQnUDaSanaD0SOIHK0KvIGIm9KbVgwarSJwGuz
+bfj3dpFZFnQnemRbjiT/ZhdlEeTs7BRixHo1l7DhwOygLMUEsTm6vS+JU47TXpbFilNAxz0urTkJ
+J477F0Qxx2dGBWjU+cg7s9WacDQR8r6MPzXVlpYknbm1mew3O0mt2SLHnE10zEkOXaNujD7htbAi
+ViuWdGZgEzJwxFlZG0w9waUm5NQEnZztSFIM1BVHquIbph10tR8UWCLOO3WnaPMhIPNxGhlFnlY7
+rNXacu9ELcN2GbKPOQVeGo2dtr9GIlIbD29Rj4DahkMFRG4Ag4K3DRQ8q4adGIY3vWtbN4i5NefS
+7gOLizciEOsjT0XXIxRfpkt6p3XQ2lKI1pYnbAPejtClC21cCeIOuVe9F58r6B/hedZMLTx+oyax
+uT2aaxbxAxA79Rs/iaBU2Ocu/f7nCuVEXRTLFXYsFwq9kwkhb2J3LR/1ni8vE/zpJ3F0N8G3wSAB
+9gTfAG/RTxb2yVK9n4YD+p0Nwhh+L/FvENKTvsMRPsdh9Jl+4/7nv2dxhlnwIAV/sK7LWZZBz12r
+78M+ktILnY3hG4BYgIn6IeWjk3f8GfNzlMSzqaUclMLbAC+e8c84JHsxAzxbQ40UpjbwfUzPzA/H
+WN1giFUNQn8cj+iFEoXX+KSUWIqUrcDbhOxaB+MB7CX0OtINJks82MRhTImHccwdQC1U+Llq4mMT
+H1v42MbHU3zs4AMV0viHMl3J/l3RVzbB5uAAhxNsaRhNZxn9Yjc+X2LesX9J3RsHoyCiAEw/8VGL
+C36m9Ew+88/fswDbCh2biR8Y5gm9cqsjH0chimmiaNDjaSYbFctOx7OMG4KhU+DBeAim4hmPBAT9
+DX8JpiELPMnsEmEAI1KgecgOTl+UmAa4+eHLlT+Ib/BlAsCMv/Es6ZNtnimg8oRfKAvgic8Bv8Ro
+8QZe7ghO09klPSfQY6qRWp8hwYG/AhazAV1dmMAWkclbDALkM5xF/L2iB08SnhDjT0IPWGX4i6lw
+KGfYVFhZ+IRFgqvh5hIpXfctLbX0GhtIy2mcjcZ30yvjFYgC40vMiB+FEzzzjMcEw+JzEosBE9/Q
+kCgVoNYPE16n/XE4RSNHBPRDgnggWgimISYN2Ey7BFMCW2ydbBb9JtSmq89BEhH4+aOAYCuSP7Ah
+JP4g5CWCEEbFTfz0M4OTLxbmRLRE/2RcJh6mjmL5JkrGIv2xUXIiwCKLp+YMk0M2fGFemWdP/Iia
+sjDj+eauZAJsrsMAweuaetZDH6s4P8PgxZiXzzB4iUP+zgfwuhXfQqftjAabh41DU8ZS+BVdo1q6
+ke1VOBzO0uBtOLrKyCIThaVEkSBmeUdrk8KASs4oHQW8HscxtwSZxQP19kK9/aDeTujtB3+WpqEf
+vRjPuHFHYr6GAUmF9Nt7xqfwFSfTK+jo6I4+j4dDgdyCD3FotgbFU7Oxn+T6cTqNjSRn7MgEXmbJ
+5QwvzAQ4sq95k2FAZfQdJzVAD8LxSX+WpIyfw7TvJ9zlAZDZCgcgcNaGfj8w3+lijp/lgoT5Lh2Q
+Jv3c9ywJGScH4SgSpwMA3j5DEf0KsJkEqfwxARw+pyI1vifUvkkIwx6NanLlMGfABr4IdQGTJBfw
+LPocxTcIgQAUODxfaHgmstaoP45TRs6s5mZNhjiSA3oDfIO/sqIJ4XhC/cGAMNJkMhtnITcAV/yE
+0H9Mj2temlPY2rm46RUAXEx4P2FMNxEdoqyEaenlb0LcE7nsJoxZJ4xQ8Uu8Sdw6IaQ6EYtxwi1D
+rke/UGt66K+Yuy93AED3MDVyq+EvuXGNAb/z/jxBSCEn7vL9jt5UPtJuoxeBUSYpdy0NJtDpsE+U
+DZrY9BUmlR+1W9pvJ7CP6YEEBpWm629q73fUtR46Uybg7qNaD9FPsjRsN2NzopSyGGiFMJOm9ygA
+MAfe2xCfYv+EvV1tpBQ89oligu5g5wg3XY4kNF0SNy1IJ87UB+yOU8xrFL9wHsXXVdD/THMv6aqx
+Tzs0bBi0acly4TcVP4wukdBKZCC/CjKrH0MbKDyJ01QqjiMhFoitkrSCuQFKvVdRWymCzEC/lgyD
+iEFNngw5ubtLnltADqSIh6+JPxoJ4IPVIi7zERH5Obi7CpkmkxgkUbRWSm8Cg12FsDAiehkROhCb
+H/yMfWp+OJDU14QRKBQcjMjdGLynTGZ9DqMcQcZZxWhhe3koALtP6YcWnH/LT+aIaPnDDshb6BVt
+AxOm5MJIJ8GlPuUFN2NVMoEAAVj7/Is4lN9IVMMYGBBQ4jMlR/1Fgm4ym+S3ZdyfYGdg8MJRBxQn
+1hJseEw1wAoREzCdXYrCEVnGch2iMCiOxnf0OqYnqeoM6PWanzANHBBTX2CdpuJHUHhTAGICXcKt
+8TRQlCLlgz5ykDBrCT+pQR8CNeQLutBnE5C0KcBToGcAqhzVKOlFwGYkG8gREbD1S0KRgWyGGGVK
+ZJ9Y88R1IeUXDmieAJ0QoZ6OY8IZjxTOgG1JQR98z2BGuWxcv1l4HUhMgoRC7dKHTvMM+GmfNySY
+ryQETioQM6++5X3WLzCz1ArMPSSVxah/J76xtBqpf2JAwCv3MiRmjIh0jR/CqUFRzqIwk8E1sWHS
+ezIbB3k0Als9LpIEiDuJaUtCa0yIpmXkAX0nAW4aAovhjFAfaOnjF1HyA6a0SFbmRwLJKNZiQDQX
+p7sOBcVBdFIwGAViPQNQXctmCjowJPYDf2oxIlJa6/QpOqtpaHqRYzNE8q0mR4K/jAKYJJmEY/Ul
+wFe91/zBX7NUETjA3gRMeIhPBlf6AI4j9COV9EbC1JBIUKxhhPznaFOS9ZJSMmh8SeXI9stvk7XQ
+qJLzMD5EIjM3QQRGYYS14ZL9jHV/pi/kfT8j74tkNycG/DxFUjPld9RNjgLxgRtIauBQwnxqWGDz
+hPGu6e0NCw3GE0l78yf2R6HMsaBe1byQwzPFudR4zsXHJDQ+JPLgTzUQ/CnHjL/kukcmSAgodIL0
+s36/NTmlIrpngGSsL9H6bBL3M/+axiSWpDrKo4WEQ8OXpAtgP2ZEwS8SttU2jWTYkPegqQ+zUJMZ
+DXZNjZ7YHQp9EqEmnIgglULOL1JUQXId+GMgPc3vFNXcEmwdhYYTwn4yP7YnwcEitIxwOaPggOAb
+fnh/mQZAmQOJlKkvXt9QiZg8thtCm0vGiBZoR8b6gd5KcrCcCr7HwCoyCM+jo0AE4U6nJo/qUxOU
+ZkBXXIdy7NMMmdYMsFsqOFoFjfShJxElGp+D2sCHZtERey7IKJ/CcN30/Wk+ANX3dcgED1DGOLo6
+rFidjpFwrHfGBEkoNWR3sHNOcGnOmMs090sfmMxMv93JvVMAiHpXNw2JZa/5Uf+KRoK+hPCOh41C
+zJnBAAWacjNGVDPbJD4rFEJDvnArWP7L+Jbe0vAyHHO3kf7gKuANmjC4rt3JD25d7bbwfWfs7miw
+2sBBgrK6AaIQ8YxYxreosx7hRU2kWqiHd8tBWM0t9uB20yAbsLI7DL3D0C/4F8fAxAyQvgFy4pNB
+TjAdQYuTl7GkSS6BzBozoS0ZTAC62SRKZQr+lMhXRDIJBVRxPJHpBsDpXJkEvNpV6U0CC5nLkHIC
+pkaBeyU0VrqLjBFOmCLWyDqCBRX2P0csRhxLhnQsxcnINec4IwyQiwnfxZ6Kr3qPBMQrw8NIvgF9
+DOwDrQ1m9xSuBKwr+y7oZSBNZQi8yiFjglWAgaZeE9nqRLaamcoxzoj60i0RLCd8CPo+ZGkngYmU
+kk59ifLVOyMTohFY2HkpShLkHbDohYC7CSCsJERi+FohkzzRCuD1hsDrFqX5LTFhENeiSeNQSRxj
+sOwsZW9RPBbyozeyN87vz++75zfnn3pP5uL3fHG+2BhNHPcnTPD8+0L09/sU+QNG/oGCxFr3vHZ+
+Uz+fNRovdmvns9fwX2/DcX+nFDjDnKJXfQShv1Go3Wnxv+H8KnOyadqZT3wg1eJ5FoznqBMAr/1w
+ML+dTKdOa05G03rzLjyr9fNarwpZKQy/Wr35I8fZCB33Z1n4+U2VB3aOLXRaGPkrRnaxmY1GDX82
+G/g8gGdzZw+fe43D89kmR282Np/h8+nr89kWBPU2Ro4bBFQBCv8fYZFZyT3OMvWcG8DB8Q1fXeH3
+hZsYeelgDs+elAKvF9Ahmj6M0jq94tD6QzwO+3c5l2p8hEdqKLFHxy61LKtNKWEtnQ2HQIK1k3p/
+lgDOzk5pgFAFMBeAp/UHkmOxYzx1w9sZ+TR4N85II7VogP2fTGdJCJs/+cn6TvjJMlWG8u23ffee
+v9+cvXtbqr61cDkBV41X1kpTLZyi9lDhBO4sARI1GOC5cFrhQalYVb9qVbS1k8ugwpWhEoMrHHvo
+071AmbpbcuPRWF9Xl3JMtfWO+dFCnypuUnbCH5ErHzqfrIvdz7M261v1ZsNyE3FlaoBeZtDf2lpW
+H8R9Knc+f0Y6xPK7HsH2hp10DM3V09l0Slfb0CxZ0pZnvTKP65sfId4RFR+vpds0NFOAU3QmTmUO
++QzOxWvWKLlGv2p1GdgXga+J+3Kn+An7zADD3vlTd6ivj087+bj5PKu/i79QEIIYBLWm7pWoHW/f
+y0oGfJX1A9p7SdwRfGXGDLvX6KxWGSy+897Z17TPRixod9xLDkJThqchnoyOIPBGpLsKx9Qi9BLx
+nsMAp0O1nHnFQXHK4HHm+QLIRVttfZjltM/qglhfX1ev9fgmChI56OTYcUUcq9bEgZcE9siNHNcP
+vDjoxEFdLyPbYodxYeCha10vDOohyjUnkttwxxTIObBHR0KJx50ZEUsw0KdIWPuiW+mLuzN/hFPl
+TtGfC1QCQEbQMAxQdxqXPbyd2L6jwOsdRHf83GfrfqHXLvXvirLnIbdkvN+vr6eBsfBScxT0aOIC
+GbKGPDQNWjsKvB/d68D7yb0LvB/cy8D73b0JvJ/dw8D71b0NvN/ci4AR6WngfcDbEd2eVHiP7Vfo
+A+MtPl7g4ws+/kYNxgOR6XNJpl8w1SN8fMLHG0z/MjDVkuz89W6+xZ7xAL9EUVvrHklY6Vsm72um
+mfc1I0xtsrEgJYD635eEpjReko43EEODFzNY4EeRBId/XioqeMI4HItx+0v8HgV4jfM9Pc8CxFkf
+6HlCz3f0fEXPt/R8Qc/X9PxCuT7S828K+QWByX0kyv4k52b5dEEqTxjKDOJ0dul0is+dQ0k+y6Oi
+MH+8kxonLlEcTC5ZIBxTNiZp1bkUGekTafVhlWTzrkeFE3F18s10njzZBnqJCETR2R91Z0XfZELW
+WlAn+PIUmU/PoYSfRAk/6BKIS5fSR5bYh6ZUXUhEVsmrhSjZkOXKtgvZrOysInN/DzzrKsumrY2N
+m5ub+s1WPU5GG81nz/Y23sGA0+PdW8v9rTQdEnIbNHA/ryrn2cYtK3L8Cos/cIOMrHZmXjdvcxDT
+VBlEyJ4i5em5SeYZ367wJebG2TL6JxYbcHW5wa/ly4iF65RSM3nhhkYJmXOP2C3K+GqTnZm3qwQB
+Ka25AA7O0BaMB//I0kaWaVMb9Q8HJ6eHJxfvDl8dHVyc/fbh0OkkWask3EVfkuVjA2UCYi8lzVqn
+iE6tg7dvjz8dvro4O/jhlE27EGRldTMCanBap+iXUGc4ODs7Kc2AEZThc4AAax28enXx8eTo4vTg
+9WEu14n9A9nHWEpAuX8IcMFQ7lcHZweUwmzkif2jyp1LQLl/DBC3WK+PT14cvbp4efz+7PD9Wb6D
+hTjK9ilAtCezLY2JEU7JIfAvnXxpRIxwmRzQnvXx9PDiw8nx66O3h1w8XjIzAwnV8iU0GtSLgxOY
+airmfSGGek4xZ4EK/Pj+p/fHn95jeWfHL4/fngJtStg6q9MYQ7suzg7ffXh7cHbIcScY9+nN8dvD
+i1fHLz++gwGh8LcYfnJ49vHkPUS8o7AX+bCL1ycHP6gMr43Is5OPp2cIQQCjFPkKI6Hyl4cXL45f
+/UZhX1SHTg/eH50d/Y4teEdbBQf/dHj4QU4S7h1Z/Qh69vbgJZd5q7p9yFB0cvjD4a8f5vPbABEI
+0LEH7w5PP1BywCZZ/SU0Clp9+PYQG33x5uD9q7dH739YX8dreKti6+aOj9zXyyAX5H1jzn9d/zK1
+IFqxHOH9o1IeapF1yRdDNG33QNEPEiGirQ+m8f5t4Q6ANlRAS8MB8IR3pEAaDqw2eL8QmyWRg4jC
+kF1DLueXoI6IEpJ8gETuK3RGfxC4v9CtF46HnUpGvxXRj8Tvm3wy5qpSmfrFA6mRLnmnqv0iUnzS
+KSVaQzzDHfC8U9GVE3g4mPQiMFIhepG5EBugJWXM9TngtxN4OKICncrMlUPA6+sf7J+CVah5GXdC
+JY+wuk8Bv53Ag6p7FJQjWgcWOPVHaYjQfJ0EWDP0rCt1OQVFRSqIQHxc4LIDYlUOXldoJ/ZwGyXb
+nseQAoMcd7K+PiHV7MxDO5lpJmkmJghjRRVqghCKGatkRfJSUJ2SQCoQqpB1prLKNObZo1BnFFQk
+JO+L5G+d9ge7nzHIwO9rFtxMRfQXjJ5m7kcOHmYl3nvek1UE3EYEtmEaA2k/kjPCFLYQ+XFkS9Os
+C3kX6NRWCA2V2U9tVZRxW9EsD2b7t6CTFcN+xvt41yO6xdNaiv3diIUpLI4hX/ZPyX5uS1xKgxHp
+JvAZlJRVWjtR/eXV/2ZGr6+PsSJVz3RFPT8HeNe3pCwIphKc0vjfMT6V8VQ4eoPHX9i3uFML9yo/
+mxe2Eiq590KhuhUsWFSHbtmlsEOkeokSERRRMaMuLlYH9RhQfILstucHOk7msp3FYuEOlqzLQtZc
+A9Ru0crLFrEBaB8GL2+1MlPEtyI7UeAqNVrbFKm0uBKviYV0+QvH7SDoBj0HEr4FSH7BPuqv8Ja8
+IZFgY54QQVeWjJJctLWs0y3cScmSYQPSrwIH6LHn3Jj95xvixaoGbSxeuMg5gDI3/uieJ+cRWxKD
+JYEGZ6Juo7d4iPAmvx2Pn2Nghfinr3A8+88R3UE7+AfR2P7jalC1nm/Q+3NiavaVAbAlEVOANgeg
+X78GBLg0bplHVrUGDhthfg3TIIzoxIAzzZGim+Q5MabYYQE2tBRHSnCgEpP55SsiPB+F7MDTRAIc
+g4w8pJvTw1e4M7LUinLGQua2vjCcoLUqYMCCJHsRIHa2JUuHVs4IKhM0maXlhDBBfKPSccWQdPrK
+tvEJYAMa9hZvLw7Kgk8QqxSa0AoX7qiUSVT3+PPiQGAU3cDt10/fADEuiJm5+Hx5/M78PDv89YzG
+zgXaZeFef50XvULEueQihgTMjPlLInFze8mCy9Ljixw+QRNKBl2Z5ny4OA/mV6twRSpzoZY21ESi
+K8owAQBG7C7Pe4vjHpPjHjudzOzDuLXMk0NANJtcBolJ6kqZPV5/L9yxytR4L9zLPCLF281XiL/W
+1w9tenHt/PV8ApkEU7oRW0pzb0pQFC7ky8y2Lqmrp34UZkD8SqLXcsU9MYAZ4yryFVnHX2tg5r/E
+ydpeQx0AuhpQlrMwtpugYSaVSDZiNo2jQhOwBVIS2opcYdD3zB+lrYuAjF9d+elLtRZt3Afv0CkN
+WUgQhVA8sjIcJaTrAGW575IskAf697y7cX7T2xi5gUYySzEG9JeOk7xM45EZMcwr0dpGmCu4LPMa
+0LARYJlj/GEbEfS6vn6Lln4ppMAsLkuWsNZCIgCjnAWAB8qQgqj19UIi2ygDsTvS3GuPuKG8g7yn
+y+4mZeH63o0I1HhUuEiPHXknMfR8ccZWg9bte412rRY6cR4739l+N+yRQcZLsgJTGL5FGXqbodUh
+srBjy3ROS4tk12iOlNx2jSjGNZyz840otsX5MkU6hdnr2MgmbuGKN5Y23f82IMTNvM+wOYwCNvTM
+X9fiK5cSpRPIw5TSbHV1qEU+w3MZgR2BEYFF5Q+BTFu9sGFHaOlBWLiH2dJ1ZpgXdBRuo8xXGFgi
+gS++09VMvK7pz+f0G2d5kHqP4PAXXtxE+LsjYzRE91Qg8ijAwMt8IBJmGQA7ZWJgt2/Z5M6/A3IE
+tH8F2YGzUv6xsublpG7G9T9cSK4Vy0nxijeMO1OvGSf6BmHIPx+s5N8OVuI4BiKQk/kTX9mVn3/Z
+t4ELad1DoqCNWSc9cIZ2U71FhKh33NB5keISm8/x7O8llEc6Dy10lrT2RtL1ZwR69s2KGhMNp9LA
+ycK9LaeNlMTcqlnOPqS7yFMEtKuhjRI3bC9vp4ocgXWXCSKW7RxnBgmEq0UcI489YmyYj7boFO8X
+OjWDj89BMMUC8ShNbIe6/NZBsKAr1KEn9RPaobrPPfMiL0Vs2fdmRAW5U/EiySFsQ+yJcxkYYbTM
+yOd1x7Z4Q+eSsH33HXdcl430fPFBrfRi+JLNxMM4Mo/WD36SQXxq6xa3fNUNHKUxXh83CnXXCqUA
+gA8yuw+ry6jNcZgkOd/YB8QcO45IwpwWSfBiQLaxQr38JVCvEH5g97I8dXKY2UOY3Ji32jgo0HYj
+p9TS0CjH0ZJmBuSeChDk65L2ciKsCirq+2kgVVdwbwGWxstzYrHTvoSvz20zqVKWyaVXoejhABko
+1L4wKeX3p/bU7UMPWwVWF8PcW73/5NjexWJpiykBdmCPT/V6YYNQwpmIN0KOm0B2ed2c0pXDV8fv
+9LIBnrgeSUbMaTtFGOIsfKEyEVvcDVpumc+hC4LSMxFauL4e6BgHFjZisvZSt5bbYhiIS0UijRNi
+aS89dWcwrFN3iGBk4zkjKVbBIju/3eqv1WrwgzopS3xKzJSsALkSLiVWpnSEfbAj25IhlTBlBxjK
+0ITQXCnUAu2IDS99ji5pECbZnS6G87kVstVMCjPEyef0NHKKbMzzUNl+FvaJODKZLYPLyaW5L7c2
+oVTIzMS4ACA5j9KKFFpMpUjDGJv+DjaPMLN9J69itVw1TNbfLPT/mw0fxRo10AxfIb6I8/gCCfYr
+JtivenpQ8V5nBRPiwAKkX9Idt4ofDSp9ElOiGpoEpgGAaY0O0mGw1YYa57hMBw907Zlnp94EIFaA
+lAQqJy8xMLR0AD6B2nNM7hMPy3DWZiaXT3gnF9hJvVlLeixl0eBMoFfs91vccxHPrqGYnc6bY715
+PrfUPCEOfV0ULQH2iWn4uD+xoigqbwPWoYQsPm6DCyBwXkERQLmmzLgxx6YMmgwQs/wNFbRSp933
+BjmsgfR53+h8H9XR5nNAFH1EFP0ViOI001GEKWATnHp9mvEpqwD8rZTvqCNvGWReBMTUDL2ZYMzT
+gmpX2+xE2xnmxjffQQKEoZdK7HOAvncRNyFwoQ0WbyoqidwhzbI7JPnXxDuB2dProZVqvkUWhpOG
+5yVrg5ivbfbQN3JBRa0QUBdpV0YQhYG0ZhC4DyVBvDjxrOdrr45f4nFuxao+lLxq7Z9HVnUiTuYm
+sJNP1L7OX3Jfd0uhbeK0JgtY/rDdvSSNpbz9c+KJUOOogYnoTm4xGaph8LyTZhKmC9Nf8Gqn2gWX
+uCmUjkHZ9wvHkIUgIYJUhzoHAYID6cnEwTL9weBNHH8uiNLLcCmMA0mDPHrO54DRLoR8CG1EKVxX
+KI6AlJJJ6L0Vn/ksefPyQgAlauyaiQ/G40J6TO6hoDxZaL1XZwH/3J1n208by/aFSd+okt0btt4M
+FytA+Er3KnhB2BNu5Jjm9ZrVrBYs8DrV2J+mhllBW+V4HmAZwh8VvO0HFAzUUTzrXwXlmarNYrZa
+U2WEaSo4Us5sVFSqT8LIluldyg+ARRH+rS7bFeU4i3R2idpYWY4DgVzPPVkKWsXDxPueyo5GgSnV
+fjHRcyMNtyrfmBrsbRweiE7qcXVEobpqWUZp2lXl9xaayMh5KcRpNPogw0zPwTKsCrxXVSVdLBg+
+khXwkaBXihS3dBMuGq4wfSi8UdGUofXTi9kUb0xfcDpbAhnnMgpUxlQpm7cfVLX7zoYAAW16EmFa
+m/dqtLPnZlGcD7ZKtKjGMGdEE9/sZNWqYTDQzC2cWyNzU17sA6Wi1ztsaiHCxdoS9jso/VCKcUyU
+EemlJmTSv1xxDLXPAnMfTTqBzC8NIsO2LwwnRnTnANGhLaAMRZRcvrEs/sUIK1zw3x3i1cUmpWMG
+cXVjefOQ/z9toOkyeBrkRxpWkp8b7gSGO/lHw53QuIjhXpH3oaziqDSPOlUCE7v6Xh7x6kQmphU2
+YcUIoIsr4Aaq1cU3j6e/PJ7+8njKqY3LZ0+OOdDIebfBq6C5AE7CowJavAtqXmkkL2wD7RoJALEG
+y9jZ6lYEshWVs+cpF6ipqlXpWQsSPedd2CXslnnBh1xpAdOX408DmjJsC0AFvODsQG+k61aclLZh
+DxNJDVhEXGJ5NWToHqDVvodCeQtzsdQWT73L49JqVvm7RinQFjFwWsq0dbJwm7vNvd0Vvg/cxFvy
+aSAMhkrjxKyVEKEiSKl1QTIQ2hG/rRV2RJV14pxH47G2Ao9kYjtbWQmQ0D8Fd2lHvbUeNNP/rWb9
+kRs03QF8xby/U2qi+8GSF0IF4T0BFJDW7/335Y4cYEc3bmv56Oarjk4fxTE8gaWeV1/7S6qjbZYV
+plVLHeGRYWnDvnOiVV6UR6sMMIkb2UnOunMpwV7MivivUJh0sBjDMrC7vVLXJzC4EwIE373HDpHL
+KVfkZBF6EbZKG4NyfSxI1kmQRca3dbF0lOzXyYfX4SREjX8YUN8AzovgmlQthZi3JOol2gXwClGA
+0WWnZV5her/ZaJtmqFcJxIJS51SPz66CChn8wZItbTSfTBpfBhXkmyC1XmawiPtBeB0MOPxxVZWf
+s/esAEXdaCt0Au86sYWld0Zoq5DKtOwsHNyLLRdPE9wZHQsgElTV2L6nRtLpmF/e8j0iPBbMjzrs
+Wfq+Yh0GSwMfEPXBBJ0RGqEEBXU5fh39ik6AzZa4oecTFamdtzkc5CVutZpvhj73WYbDsCOyRZ1u
+4obAVYRu0mtFnbA+i9g9YuK0QrU72LGH0+HgTcxQ3spE8WlI9z9JMCnePKGB0PcMZwof4jQN0TS2
+CdOVCSzD5K4CbP/nyiDIyLxRHTZFWQO6BquKXTPDTVGOS1ohk271ykfoYcCloYzBBALbQZvbYYQT
+BanYCITT7rOrUMtMik4KAyjvky9stPTrolAP1X/IO2jmolwKZ1e2zp15fVfcgl1fN6/D5r/smVNi
+mbdvC5cf5J8cLUQ55h7LViSWsJ70G8xkDppeeB0JCojKwKMgBIviDVrB9YlyNMoW9UgOUMYLo9o6
+Qanl/WnOcZ53Ty3Ay2jcLmHB3eUSWpAWGt7KXA3cCzf2+uQQwI6UWCZWzQCYjkQfgbAzHJUM8xUr
+sMd1rFZGJMaz2xNbXATw3i7ikxh5+pIFEneSTlc3ZT6PYY3AX95DhiYktRFpZYXeFdS7PCSsVhMn
+k1bnjZJNI/TZAhmIgQ3dlcXobl9pRWGmcou9xm1ImmTOukFvlcVlCWnNXNZE32nmqiXUNnQTBtoK
+NFdTajy7Wo3YeHZgGM02bElPNBYubyFuk05EhEMnoB+6xd/Cd3pT0vBS1bKiZ80HNi2xzh/Ys0yM
+9cC+1V6uFlpqG2SM79xzlzQFUkxOBIhP+/+i3P2lr0wKmgjMcu/zF0BxwS07wQ4XbmpGiG1eaMyZ
+jtOC5w0A+bxfHMNPNI0d29qG9KUtwuOXeJZhPPEL9cqRHluf3B9EwchHq0cVboAxuKQsiw4tQw9t
+D7hMaeb8DyuAN1aC4NvEF0SWOjhgWlWmQg17I1Pp9m4mkDu8CMxRVMtB87lwY5Gjwwo7lfdfn5Do
+vzT85o6U7yrvQPlujVZ1y/CEJPyvmtlwCXrlWLXbAyTaBCRa3M/IhUfG5Im2AYESDOFSmalrJBjR
+/MIqbOk7MRrVV7uBz17btZ5UnFO0Ehb5wzapvmvbFHYI6LYBZFloCjPYPwJPV2g43Va+Lq/8aDCG
+4aY661bVDjtWxUbyRzg3r1qOhRY+pKfMlE/GboEGcVPhTNIXeF71Yfw1h0NjJ7LHNH1Ka0N4pJKE
+Td8bQIoZn/TxLjaj7Suy+zDIMu9C6/SY8wloUMJAOdc3s9mhLuwBpCxtZo4jb0VZuWRTNHcW/YN6
+Gkv1rOJJU/J8ihBDEVNViBTdlTXkGIp7uDG62ELbv15HnggsFC+UPIRiFDEymcnIRDnod0wiMwc2
+MM8RnkYVEySsjqh5ElJJhBy12hJa7HwNk7ZscbsLqwLirsiZU35mj/JRqEyZGDSTqcxYQgIkjESE
+t+NEq7j6qOLq12qoJJSgMzzuHLzl+3cferlA9LPBikCo2BA/b+SGiQlJ5Ve+lZ8hksRmfKzFyCsD
+5BUIZxvVZq9NLmLwpC5BCgAP9BMlibRxqKAxDcX1oa7sPxu5EAdsYbS4sBaGQ281vJWA4sF4XL55
+CZmdGxVB69tgMClULROWcjT/bttuaXKXT1b/LSQLQMZSuKY2e/NeductJQ2e6eIzYeTKFLOvWYTI
+Kc4fNBUA2ecLaCx/XZoE1NLJSWiXUxSLzQ/Pt46fEKSX7SvoNJwQiNECA8dlesEazAqPQaYXaIQL
+NIIFuqIUHIb2Skgel0Kl9AcksezSZpD4N2+/LSdvV7IaHpQydF/K0+SydQrfgLVbV9qbaHm/uMKr
+PPmE80Pi3BKCa2kK9xudLLdyyLfrwt1sNhubX/VlvrP5rEk+h+yi8yfTlS0wLWwakfRWA/MLryOQ
+VmzWNtw66l0yI0X+FT7/+AidfEIakmZklQMgfbFR8MMNgzAieyFQU8kUGgyDBM0zYox8V7HpXZT5
+txB1Si8qnCRAvuH1CsJmSQhBH0+OVAgvHs9fuDRQdKSxZvIsdBvI8Dhp0rqu2MS9pht6q2TQbgpL
+cKwc3bkzz7KAWltrulN8DEsgIOz61Wpv4V55Jf6wEsjf3jgfCOd7QTfl40PYmFLI5Wb4ojl4RfJ2
+6AriUURSQjJe1E6fjwF9pbixci637wj1W2og8BPoDd2mhmIKSNxzWlbDYvVzjMZamz1K0+C6q94m
+JKLv2KODPKG0e2m1ZlVPNWMIUc2Go3UaNnN6u31KXVQ8hH0J8jl8q0KR7VxAJ2kJz2h43/XlFSom
+DQJb9xury9UxKG2RmWJotSJPtI/vcI5jn1Jiw1+Ht8HAjufzHQB0LKkTtSLpyc3e+KOxgcr1Znl/
+UY0/nh6/r3PPwuEdlWYmirnrDau6erD2cjlSygGJzMBbUcztQ+U0d3J5fv3GPPDxcTplZ3eqViFT
+wBIy0sy0vqd7GR2ApgaFSrCcLejujzpRJC/QEx+2IzerX6dTqCYblhPliYloYPPSbrwBAZd5LVTi
+3hIEL2W+4xhtTaA/znzFzr2Rwk5W4LgFK2w1d3e291Y5hLdKjitRilpBdAEMJTovjdDbBvD3KHS3
+gIsu+h4k/OJGq52HopVL6eRd1tez2iVeiQPpqE6SQSUUuT+fRwKbOejJrlQkl1V9rV0KLK6XFBCg
+C7vP2BzRkLfmnBKpsiJpiLHdVE5tsnSup+gJ6aHbITjLSCYuqWZfuax9qKQFYGSlOoFnb4KuUbQg
+IOtuD5B1oz0FlnoKfECfhRjWI1gkpOUaenK0bYvMS7NF75xLQ5QS9KW+AKoLOPcV6RSRsphd1w1s
+VxaWY48dk37g0cqB6aI91PEmZ+CGRjhO3dA1U5ru9kKgKZ7u7TS/SlMQoDsGWK2AbYAf2Fcbm83G
+gyUC1Bp7N2ynsjjYVRWguXngNeyn+vbjXJlyVG0SiTmmU9w2DGXePsH4a45TcXrHdJl/jBZBcvYN
+xjx8LIkxJkOvk3AB0DPumHG64TNdkhFqLh2LkEMAnYDmLVlznS0WgHdaM4BNoPOa242n0DvtNHa0
+0sd2mYdtpDagfwOvzFzvR8CKe4SNYIMb2voTNYrvre8PRqMEJZM8Vd9brbJC8omgoHyAC8VgkZCb
+fuX3i9kQSL5VZeoUWKD+krmlHU81ElDQtDO0u70Sd71AF2E+oCj7ZKoBfsvy60RiRCHsSob9gDQ/
+5igNLMtRVgVF4g3Gfrqq5xyLveY3yPEiHMF+vSIDR0J6fsHkbH0F0ksn5Nb3r/zM/yUMblYUIqOh
+GPnKmbDR+ANf5EkJb81BkHo3w5WPOjOBCoSUZJZdlKDezXCzhOVASCnhUEIWMhuYFn4wVvIcmEK+
+QzjRdlubEgzLBsBMAoNgfsoCdra/WoBIIgsQn1hAGKH3LbJochKMgIVMVhZUkhQLLAnGgjXg+fC5
+CiCP5MJeUemRgQfUO+dr7nwlo0jAOcUHZ314yHUCzqqHO0xf4xkTwp58pdD3/nsKgl+sYAUKWIUE
+GAsgiY4NKmqyYXgHH5TqnT9d0WqIgeaibWdKVdaIMmIVkq6vY+tIXxK+nJV4CokWKAV/4IuVweCb
+XyCEtwEI4RcI0QwMDoH6kDGMO+QrhAo1rxU9FLHQS/HGOW7vVqe/vePUtzh7msmHDPoDY3JMPsbm
+AjjFmDtXVpPUNoxa0lE55sCr3VQWvkDIabAqP8RAXnhyqm+dO0hqzB18rZ67U2BOg8HX97eldNiu
+YhiWR+Q/FCL4ABmyCvYta3XTKJzS8asIVQQaRMUQcoZEjiLPILCPgfA9kIt5IAJkghC+Nemwosc5
+UkN/yKwvx/7EqGJlCWY6WZAZJsp7GGkZKUQZGm3h18N4y0ghcmvMJUVQkFW+QugnYKJXYxQRC2WJ
+N5EDYPyBHBArcsCbyLEa8kWsyAFvC3dUdkOZOMYiEUSnMImX2paPEQbn41TugYvRpz9l+4/OLfM9
+Kcm4ipxaVffXyxB5pV7SA3U4bVRnx6vWmhNbFIotWXC6+LikeCw2pmLxCq0uWJ39TFhTMFm410Rq
+63VvLuquZUSwY2uOs3qSFC5J/kDCCyCk0HfpisTkt5J8mxayiTsAK7OJ+GI2PGBZmQcjixnYYdLK
+LMKfUq9IqhcGwYxaHowiPS8yLIHGV3KW1PlwEcXiBHmeL0cELiWWdHk+tQwtSx4sJV1uAqGofLpD
+4fq3kFDS04XEMngpg0lC5/OYMeXZBOFckk3ELGcrhYOVE5mf/a9P/Io5/2fTrQjrfCEquCyD2JiW
+cojwsiylY67Dl7Ig0Uu+RSghfpFt+yTV0adSpG0mUXJuSgb7Vr5Gdi2er4pp2Hw6DltKysRtPumx
+NBu7lJRxhxSXrkrsKoMR+XyEVI6Hq7OJBJRLEMX5lonApaaZiS+yqyBamZo8HURmpgt/PC4kh5Bc
+iiQQrICZiAML6VJ0DL+UkEIppabT8x3T4Ut9yxPvhWy5uJKsSKsXs2DYUtLToAAEp8EyBCyRzoUs
+xejlAggqCrky4euvkJSI50JSCitJqojrYnoVsZRJk9r5PDq8NEtJLSp4KYOmv/M5dHh5FpPSLslp
+RpcWUIrNjIjSTKX4zIhYziQo70IOEbqUXFDd+dQisDTxEkiKwHzihXvnJTbJ3dEFE0vXN9HxkrjJ
+poTr+OUWz4T4YMNxD5eS03HCUnp0XdsPHPe2vHhxgGpqD9FhpuNefGOGlMs/9Ta6f3xf7573etX5
+eRed29U65wP0U3deh1+n48ztrvW459jk+G7tfNPp/nF+3pufn9edJx0HvuHD7niUA4o4h6Tqdf49
+ergbuQfexvm5fX7udODjc4l57gs7cBt4BpXQa63JBn++Fwbj4IXU6LXIPratMCI/5JUQSdwoDfsV
+VjJwK8HtlG68VNA/JRoU+vP7Py2jxESWmP3jEtFtoy6RuZGuOse/hbafmsZMSRk/du6jbiQOqoBL
+6NzasXvgWo+altNCU7joSyhauC/LFRA9Mlh8aV+7EXu1g7ZXgRO5Ro2dbqNXhW+0HznBS7vivHCC
+1wPQJCX09or8co3Q1KXWXvTX19fM3ofYe9lrC49koM9hmqVu5XKWKVNK1344Rk34euXDmC7/oGft
+ih9BgnQWrCnF5nsYRz9tQevZ9CmbYfMXi8J452scxAHXQ1VDaYvSQ1EcmxJzUKjMzRfZxJHgit5h
+kwoK28Fkmt0JM1EMKEuO8pqGswft1DBXx2OLTNq9g7HAcpYvPvgVUcJjaVQezTTiDVut/4H6iGQ2
+z3uJ0FqNcHrRTlyK991QwWcGLzSeUj/Fr9Not6cEHVMowD20E/fG7sKS6rlTPIiVZ79Dr+le4UWy
+4XNZaXtY9ZoMOAMv6Q577ggW4YDX4zW9ivUIPXwMAzyaz63Hlnj5U7xw1LWMupZR1wCzozX8zU39
+VBzh0WyklZswu6qQU9OUR+vKv4ZJwqM0XG0cI5awcVKIa3iwvn6FZo88tqI6gYGiBRJVPatuVQcO
+rQ9nBmsi7Sn5AlvSmAkLnbjkKzNH3BvLAw46tzcwAgxkyfoAaq+iurS0WNSyQP2+8fr6sNrcV3PO
+I3/nje2ZO3DaM8+G+Vm7Q6N8I9iFoGF3aD5f+Nn2x2RQkILx7NDp0E9r1h30WMpx5V1SSQAnGNhG
+kwZ9NDEE3YcOLxb6XNKl48iHz5FLRahEIK2v868b4874dLv5zClbrg8o7qGgpiRYFm+jX1mkxHQG
+dEkVW05ZHOfC+UowQYxnBGi8j1q2rO9R2tJyDQsuudTY9kPXxvO3BcqbqwS7MjHf8CTPb5knu4Qq
+ZBbujUqPol1maC7L1yhVTLiUnqVv7SyrpIh7i87/toTEKAFRDhohq5BC9vamG3zt/oShxru+3tC1
+kV5v4MgF822llNzKzxW6wh5AsQ5eBV8xD4AtaZL2OGefz9HzAREYZmPXlkZP4oyj9FDdMtOqqv+q
+p1opQoozv5KMVYihhu1N1B/ivWU+X2vQl779pu3jqtsprE31VU0UJpuNJReV0ahLg3Plp0aDHUBX
+u3uNTVrKhorrCicAKIMP2PAZKsqSTrlHF8TKdUDE3aGJP0X8nQT+oBZH4zugQVq5cun4yKb7M95S
++Q+VDDUXS3Zkn4dJEHwhS2oPA6c03WJYdFAicbzrqh0xS4Q5n4sSw/R1En8JIiAD53O0u45EZ7AQ
+V1qBBsnaibzx70XCflmct08lLwcSkYU2i2kk8IW99aHyM31xIAeE6Tvcxo9GUUzXqJuLkF4pVNqq
+KiYC0CpVgA602uY6sBLWOrCobcvRoc8pdJzlAvcpcJQPtCgQKYtc8GMK/u52cxeCdSNCAPF6vZ6x
+qmFWScr0+gnlcTwjPicRd1H0NeSSORTKyuUZcZqSBcelaMJobS2ofw6jgZii8QoTYpckGkEtZvqk
+xB8S2Mlvvcz8cvEq/fizuD4IUI2eQkjZSZdS9XAOFsj7CM82RCil2qtCG8C/grqi1LIArUSN/Wg0
+80fsaOnPR/fFZiwgaPGngBp0cE/Oa4Bhk95z7lM2m55riPV8A9PuW+hQGPBUQSGfky2ouGIf/nyO
+wRVqgmc9ug8W1v6f0hLbLDeM0gxbHJPVTe+eDP0nQYQq/aLFmd//7HVz6XqLEQ4D3jjKtYrSdvWr
+upTBGTB70RCQKFGa5WODAfG0Lhsi7ZzlJkVAkHePs9AC/sBodVuZjJO35bgpVE5u5JXuZ66x+01n
+qUvichVlPRiPhRMLcS2Lp1sV2nbaiyxGYbPuakHBOtdzdvmy7SwIOoP88BhzVb/gBG4ut7NIyYxu
+RcUqMnSJauoQMiewR3MNaogJuanhxVHTo68WceaxxUJdD6JVo+MZoVnZmn48RutgNFvL/CteX8/V
+ruoLroPkjmxCLbUf1mBHp/S6Ri5WY7XIDOJy03EfndXNJrEJKV4QfeBvsiAaFJcGrssZ7Eq2vMo5
+RRwGmAlB9afg7iZOhCkDC+nFQC7g3EAKUBSoxi3CSg4PyWK0yUOKPtUYhquTeDkgIIDNDBeBh+Zf
+DGRE1x9kQQmayyRjqjmzSXyVOtc7py6QzWLIilsa7+S2qqm5VXWWp6oTwEyw82K+3MGNHnobJHtD
+ydp5T8jWUMZmn3c68GV3m7VnvW4DHk8cjN1oc8Yrz+r6tS8Htd975+c3TyxUDBUBFyJk4lnn55fn
+KNODjOck07OAw7fsWseBoMtG9/bXHmQaHtReYw3VuZ3P8MTBNnBWuxsc9rq1aq/DJTmWe00V2I3L
+bqPZq0LAnXd/GQCr2oLw8/Pu+Xl6fn7as9wEaKRrpKJajYV7CTgV4Yy9BIiBckW+x5YLkEe/4Xgc
+jPwxlhVZaBMm84EYa3XvAA/fPFDGY+sxlYG/D5VxKBu7AX3w5340B1Z+7ifB/OjxZB6m0eNsPoj5
+GdDXDX0B0z//a5Zm8/Qqno0H8ynMenY3T9Fh/d08iOLZ6Go+iqPIhye0a36TDedpPE9n/av5DbRo
+fhfP8C/BCu/m4/BzMJ+gw6bzy42Fe1uwvIv0lYDwyAtts9/9eMK+hrgfAXU7M7oJvUyUJn5UlxGM
++Q8dtxhkFo4mi309MQCjZ8evjuevj359dzh/f3x2OH/x8Yf58Yezo3dHvx/O3xy8/Gn+66+/Oq38
+dJN488K7ta2NDcu1Hlkoecav83MAUZiXJxsQdIBB34n4z7nJFXYbRDsmeVh6+UDSUT7p8QNJr/NJ
+/3qoAVXL/n4eTObB7RzmM4G365v59dX8ehJG8PAheDKfAABF82k2n/bn09v5IBjNR4k/mOMfTsY8
+nU/S+Zsv88/wN5iG8Ae5BtPpLS7RXGOOFJjaHe98o/vHxnnUe3K+4WwYE222NwlGAZ6/SeDe2CC4
+gJfuKJzM7npPNtS6gLLMYu5ctSS6Mldvw2xPfg3h/+773GgJ755czFW+K2erUw7yKT8YiATQT/qk
+gNrMxMRrnnh5fupe6c2zKcN3B2cv31wA2L45ev/DxckhLvrzFxvu0Sv0pQvfV+7H968OT05fHp8c
+XqjQgfv+47sXhyf4PnFfXuivkfvi6P3ByW9G0LULWU/PDk7OoBwMsNbmax788+bfz7/35uvzdfjn
+AUZ9gn/4UsU/b+7Oa/OaN9+Af/PWvD1//hz+eXP8NweOa47/5vv7+/iAP3qf4z/Ijhi6C3/3uFvA
+3x/4h2XP8Y9e8P1/LPf0zeGLg/c/AMuOOAVIAEk0bvzx3Vq3gkC1of2ZXsJ2l9wRLUJTodESMEMm
+WyZNVuIO6DiK8ljYmbtRfwI4zZWFuTDo9ScbQBbl8dgkyHwJCBmD3aM81Flx1KJ4qyUNcKKMQvi3
+Qd+uOc5ysXDR/d0LwEmnbw9O31wcnr48+HDYunMPPhyfwhydIBS8O3512Lp0f/4IyCwXduN+eHNy
+cHrw9uLT8cmrUw48dIV3wtYtAMLbo/eH0l0hx19A6Iu3xy9/ygefum+wAbmwAwlV9PVZwxV9vyxA
+FgUeuy9PT3Mhf7nszJu/jtyzo7O3h/zx3oRlI/zMfXd49ub41cUPHw9OXrU+uIfvX12cHrw7vDg4
+vXhx+MPR+wdMXcJswRjD6N+XTEZGXH/9goJpEoCDBR6HJg4m9KGUOI+QtmQS8Z++2vtO0JZ1skAD
+Mz+dZV0BAbWy/CrnK8EcI5FN9QpiNTWgmygZq1pIQKexmY3G+AVgz+lmGB6HAqWGGPn8EtZXCsQP
+3tVhPzAvqMR3EPJZlOPpV7ytnatDOmcuBhsCF7UUqMHqy2s4Ri/fci/5PDlMpeE0geodyio+ytey
+Rf0vXc7ca2tB6VWRRuUv1NkcufDuX4l36hJ2GYDAWRKMUWxlvQKRFSC86OwklW5Z+GSICkPtUDFP
+ong9aPRpEOKvxQnq10ev6Qgi/IvXteIhua0esAYkPEPUAYMGwZO/h1K1h5SqiO4i85xSrcjqaVj9
+KGg3S8y6pUm4e2mluYRHiO3EDQyYc1qF2XQ4idMqiMdLpYP5hRu50CgyNAQ0IYkAo7ZhSC1A038Z
+6bkmCgZQxv82vpG3iJHBTUrqMliwTLZ+jtq4XTzS7AFj6v5t46ubwEJ3emSKTdX9d+72cNZhPTJk
+yEuQ0RdY7/3xDKUNxcYtcHgaraYu+RfEVtPxbIT0SrYw7N8mtmmomOx+kdaSPUUm1ZpYVWTBodwL
+dNoYpSHa9OpYIVmOqtpJxxqxESnBMkdlMiSCzCNET0FKpzpsfWNE39LAPyU6yDzhj2AaY11x5DWQ
+0z2ZjQWLm+mYXLpq1V2qq2sW3JMeJkTFTOh3YZsVJlZkwmqZkQ5jZALDt0EVp7cOxfVxp1cyLZyB
+anOBl6TDMfLJuP5ylSsDPGxaBwrwbNgVWITKMBTkcyhQRMhx2rrFQXISeElO4cLDZt2jPJLsaxUJ
+lPuk6jXbkuhJSHAZeVP2YmJZbN09UufxbQnVgTfkvkbsIwmGJ656kTBdhK8oZ+DVbDdcsSXh4b4Z
+IYKrAawDdXMZdh3azSAM/sH+BL3sQIkYLo20ivVAA1CFVWHHVcrgAr4WedfX0XSb9hMFeFt2+k8b
+xZ3OnxKVZ7ROyO8omyf104zgBiCOOhmY0IuDbCTJ51BDWUgvimnzibqEJJjitsYSQxgMKkRZVM/Q
+6JuyYoMuBiJvGbQNq55CUwpGPFGHJwLXoZ9hKc2KS8W7sLCMNTgbZ+FJYWH2DeM85igZq0mOG9no
+o94L+fhSqYaHmrI4NZK43qK81SFsqXCkYCJ6G5Bq0sNxq2tEkZDp/rpagG5ZZYgQAFTSGTCso9O+
+Hx1kp0CGfxAYRUvElCVI3VnaMMNBkByMx2oszaHIIy3dAcY6EApYh7d90u+qswMmPeDVqoLCHHQZ
+Q1yoFHDCaghlXJCZICmyr+4+mTGDFcXrVWIwVaaw7reqdY2H2lNtukZr5HpNJD7UnarCJqxQfHMJ
+5ESbaMTU6BkTg+dGC6K/BCgkhyjRTVGgOZ+XBqMfJDdQ8iCSR0vZkNpy0Rn00HKWCbnDk5NWRaav
+/InJ/pTKMJqg81lbJounNaDBgjEa0vQrUkBbr1ROg6Ai/cvTBVnDXmZQVxzjAeo8wVIN7eXA+RzP
+I41DYnSPcS8P0ciGWgT01EseAGUfOm13X/RyAvKAHWvwoBRHqzxlVOAA2ALKK/et+7q3KoduCqpm
+Ibyy0YJ2ziehPNNVXASADKRTn/VHUx9t6UaSLi6JwtqM7PrD+2h8uMt0D7VzDEBLehTjFbP/gaxA
+Vf5cqvdPNBn+5yQeBLKQP93Ki+OzN4rkF+5g6xU7BQDAlBVlUsqpaBAYe2O0eCJKmc83zm+qG24q
+2/6B6yOyYEw7nE/9FDyIfPM2zl/Mzy83HMhJAZRBRGJXgRtBjHCQ0ixSERDk5VNgQ/CM5BMwKR+I
+HaAaMKEqnz4hf4q/ohbkg7BmaCkgINT/OYQsUxEzn1uWWyx4fd3PJ6cicyFVjwvoAAVEJGohB4Ma
+s22UW7yLRimGzpAIU3fkB2GH3Kc02AIMoSFFJqqjzNibERTUr/0k9Mm08BpCG+DNwS8qyC4GeTpD
+oXzNNeAZ/b1MxecraO8N1R8ZDeZKdDRKyYVj2kf6ZJtLTTM/ydD7Ab90EOnwq7DSRVUUVS1yhWgc
+thAoFOm2TtTiczdTDF+qIoBeeVJm2UTVgPRt+Y5OOxT5lSPiTaoiNvDoUmVBjoxgOHfvceeWFubF
+nr1ghFiARjNvLsq958woASJH7woEzSwiUCYWn5ghW9honw0pVs3VPTI83K0xT19ciI/0HBkZP+UO
+ylH/Km11Lbn9ABvfJ/e7lj/LYvacYPVc8s+9pNF4Lz0rvBW5UW93Fn2O4ptIBa01FwsX944ZJGxp
+gWfhwL+QrWPBCr4a/5VKR0bFqhbkEGgMfxBoOjzAdr+ixLSwgCiRWYRjO/GlQDPnUOFPtHYtk1Qs
+obUhAxZW5S6eVdJp0A+HIerX49lXRbh+HQIhMqhLjY5Ch/Dg1bcFkTKAxcAKI0oOUik0vkOWx1Qf
+DyDCyO2Wjglau5S9a+Xz5/vuqpKEihJJDI8Y5NKVpeeGDy2gocBn4epG60PhXFoktjzVPy7XFRdD
+bXRUS5hAOPCGAvPNaSFvTG48A7Q/ZZ4woxo1wOr9wu0GtgBchrCW1rZBYHMH8eQDgfq98praUt4I
+BRQtFk7PUe7D7pWhllbm/jILPpAEBfPDmhqPGYf3ZRrbUgX9ha6zHfSuzavsjXfPzqhbKsnhOKAj
+y5Z9H4wBuSAhPsZ60AJ3KzGOJSLvJ8lERnnVTMEtxZ4kFYUmligbfUBf46Vt7SbWEzMmZ27JuSmL
+AhosB+Di0WCk6b7HdGkpHZpJs7nE08fDYRpkyDPpLzPmeS68leG5NaAo4oMwhkyHUkSgym1lBdc9
+ZdqP6NAlUI6Oq48963HVtwPh9r762HqMQgrruVX9EQU0sHfDNipUTclRPbs9h6lThzdVa9/KOfgh
+kYf1fEOUkYsmbs3WvRHmUzvj1syxuWGAhVG4EiglWeXAg8c+82CAyRo8Kpwlhtgkco1hY6GA/nYz
+Wj73ITBH1+j6z9Yc8sxpD4D3tw05QdMhi89U2eLmCllkKkDPJLzp0snfSbus7DGbFSidQOHfh0Ko
+6+jxB5Wl3JK2KJFNVfcab+ssoN8/2ei5ApelGukfc/qQcsbzclA9Lz+Z+52+qWRYi2CnYDAzOAcx
+8JyGM+e4jT7W0fv0aXg5Rm/tW+QUW3qf7kRV8UXXEurSM10uEdtsJNUCGh0J8y6PcCtyMWUrRjUB
+D717A3n9I/SbyRl74zKZXyXzcDKa03HPBiqwFsuLpyXFaa0H9AOGwi6Bk35ASezv5BBSbn/kKgBJ
+DPc3T+p6GtFoWPLPTwcn71sVFKX9yQkW7s+ePNP6ofsnxmyQWmMPb6SYWV8FgKqBVEYGOEWWF9PW
+K0IHMp/XIzPKv6Knt8ALASmqiwBRLE9CSG83KCX0ygxOR6WhPgIEznqIbKdkjzfsP+zn3T/2e9X9
++Xk2d6rz88jZGE3csWe9VBs+yu6IkVfUwuP7xWO3MggHRCQAQLEyZGUc+4MNIT0wOH1k8YD06wDJ
+5N0PwhRVzA/U/oiuVujumPVhDGRqBXcGK6fUQg2fevdR/EbuKydBa+MPO4prHbXVOI82QldWybs0
+pjonjTC706K9vFOzu+c3tV4VlW9C11BSZSKsBjuaf3nCKsKsSjBLgxcn6JFJls0Mh3txIfzetPrG
+kh0aS3Zaz7VY2AfOOyOSPnx4RdJtMNiSliQBQCXFXkDSpDzNgJ45NLUTCYd0QBT9bFvNRn233gAK
+VxNHmNKtED1UAbj2k1HqVK4ATi+DIKoMFOjWLcc1SxB3AFHlXxfGxUjFOVKdD/xB/Ty6yjIgQDY2
+RkCjzy6RdtgwiAb9XocPuk6Ybmxu7u5a5KgNNuXMUZvyfZ9WuBr8lr9of8aTRBS7aDrDckNHgXVY
+ZzKjI19aAzvUgxQyPYjDLrEGk4ieiIHyC2SM5RJfknPhhLamU2OHnuWVEw+Xz5Noj8gh7xYG5U2n
+rrwVIXdwJblJHJTayc9u0ss7RuN7bwe4yyheo3ABCreyY1OvO9CyqOJpZa4gwYV0c4FK5E0w+FKp
+fR477cAb2Lm07rELy/5zsYClEA93uylfdwu8iX3s5uIl4ZQLbPHx0oE++iX/Mn9VzbNhx6UG5nRL
+pcM69M4rO7owXVFwtw/UmK/uMA9mo32wJKwyzxYEa1SSiETGx3gRTB5UZUA6JVXv2DxiAvqWz57a
+koae2Qfiik8kQLELC6rHglDdwoRLdmFMYlfyzshR29aF5aDxcgJMQ/JNAF2QvXYD2PyCNhUr9YEz
+OvZF4/I4Zaog76GBcB8agoXRaVtOm+gETI57DP1YlKJfQ1i8vv5SqyWXdUQHYZf0F6oq5vdTPGIm
+sUPrnq86H6BzrYMlpC6ZjPxlZqW6jEuXepgpJJSsr7MDD5rTBcs5TvCUR8wnira6UsOmpwvji7bo
+Pk3H0oG3mxSu29C1YXTAwAUKIh3FKVL0GNS5d+x8XbwrcRYfMuTFL3IRQL9FevbHqgZkZMwJdvBA
+Cq+MI46OfSxOOZuwd52RC0ajiGuT6qFkpP0NRAWeulL3yctnN8mL5N2kq7WXej3j1k5ChxHi7MdO
+yA82iefN4VKSk1F+mpJlmXEiZL7GCXqmrxp1axvn5388elLt1G1n3j3v3S96ePvo/PzRukUKCKRq
+kX4Opx0YiKyF5d0SHSUrwGDkKTAdt+QFy7nzKYEaPfaQcRrSIjeTdhotyffokb1bGlm8oy6Xmzza
+Rr8BBy6OUdv06AW0msA8vnfQ9s32+6LmQxSd+7KJ9GV0xYzAPmLLkY07yC9d48IA4n+oZT7PoXwo
+FFDZgYneD+CTwVGwfgd0yZGDDEeZUsBqx8uTKmPF3Mb8i2MbK3mja/TUHGFc/Zco9VJDfUPOCe/l
+gMXr6zHiRpQmV/FeHjlo95WwDvraoJ1YHZ9eiuNTkq8S38Xfl+roMpYKkrS1+4QyjvFwkQ+URbQr
+fqtNx10L83JjccTTqNyEA6mOVaF1akkPapreZN8ksAtd+iTchRbSmswkn9tEhHEJe4zuBDda7Zzo
+dQc7KaXARrfWIq2RoZv2WFC+FT4WqqDwo/rYotv6dJQEAbYBP/O59XwWIYMx2LdIOiL7EdQxvQdw
+Tc54coOqa74TLQxQ0qPazVlKGi0G3nQO+n6/GTxdX3+/v/VEDHzJndFpnAFchtCvMBqS5VzgpuKp
+W7nx7yp4W6DC98eR1AZmzI94atBUghxsmGhfmcsX7O8hGlnhRXsoDvJ+t8cKMVn3C8sNhFMYMb4f
+WZyruLcWjmjAA8eF3nq/2Ida08pfML1zihTFgZfO51JV5DMy3y9p8qZ1xS/ZU6e9VvDogCPdZfws
+aKI2itYO2xmwN2LNZiZSCJSPZSMY1QbNQw5j00flRiAXqKHHRPp4DfcI/t7DH+w3zTaafMf69faU
+O2NvA/31vlp1zzqYurUilavDNZ13pFSddCzt/YlUMBIOP8Q6vMlJxI60hhF08EgqQVfVItPJ7SMH
+CaTCLT0I0XeZ3FPvZV3ehXLvtdI1uVwYAswl9l+OsOJyqtmuQN0hAE5YMr4vXSDOkQBiS/isEJpJ
+z4soZhOvhkqBWL6W3FvvVbkNWcWLu9b9JB21VHZXuGxsSUR2VGs2YPqq8HRcXMWYFi9Rumk89BNo
+uKlNzp35FTdE1fIFuYlYakPzqxnNIaHeuyRPOvHDNBi0soVEkou8q17UPvSS+XyqkGeqLmyTVmem
+ifgyUc+92S7ZmOWFpZoMu5TRKWzoTB/ASEYndzNvwYpxKBQcY2EXjnw7EOplmbcPBD678HJQ4C6X
+YOQoMgA2xjjJlJYXawWrtiMONTZp1R4VVDMS81GuHC4S2prs54UR6dTppmIaZMdD0jNSESYivrCz
+VTmCYo5aU3tuhkXVDd20B+h17IVKO6GeBtDrAdCbaealrrziN/Lul8QThWMQOvaY1kmyxHra6uTC
+eNfk43mExGLu8vtl0q1s9J7QfffzyCJfO6vPXoQYZLliPh4Rv8UKoZJ9iyyrXHsbpqTQqaKQ8O7B
+455ClVqq9kC91y5rIet2ZNAOMzNbetE01SW5/aAdI6dxaC4heWFdbRNVq2K1s6ribxBPdsyPunFZ
+xVJl6qUrpYuKg0M2Qq/VC5v0ntVyA+r0t+KmSykc9zfbeu2PUdxeufT7n1GIGsU1NZ5M0SBpQx56
+Lsdx/3Odd+ysg0W0LDO5tcgpS2YwgGl1wyHtS9oQkT2ezy/EbsiEwVCbcimTrMkpdfkAT2G/BM/r
+vUAdzmV1xNAvEVEDB+h7SefKjt17nb542LkGC6s1QepqWd5WqFTAks+nhjGrFqglI21kFQ/5VMs6
+6LW+lbTFvKKbQroVTBJfYL9i0n7LRcU4PC767dTHwIGQJnq6Uzoa2tjyNe7CbcRndG2EokNCA2nQ
+SjCRiFmwEV6oxIwpr7A0xUJef7ghHWxEoTd1dnYlp19+I/vN0t8dkv4iPaoE2aQRjsp+hrS4Uvlo
+SoaJFFLyYAtZOnFy+/csSO5OA/QBEBPFhOfaJI+29HHbpcMi/0Mky9RSvxVCSzxkYMmkPhBGUyt3
+p5mvCXQUydmHeL7yzytXVV6Y0iSPD/CdvATXzUgKlnXRxawhgT1FQZHJJ9GFhOU7IAgDHvk/zZOu
+91Bevqaely1M9ZYDkzy4MLSOUB2E3Efmz1l0zs8FWRRw7qV6SHh9BHXUk55NylWLMgthN4Bb4pv1
+df7FxXOIJ3Xaq+6KCNt6dfxOoIu3MKVQpmFO0bk/XF+HGYelDsSGu3QnTYFa68rNqY60Jq4Jhq1b
+dxjevvOTz7Np2f0ShvJNgnKVsIK3tlHPhV2nDirImcE6ukODLdfNJqSWpyOb5ukIajP+m6OPp1vb
+FiHT3FaHtCJt1B0tSEppg8QNnzTKZALeqVtBy8zfeXAfbcEW0MrYF56hYSQQb+tSB73ATWf12BVO
+mCj1VwZw0xjA1cdLoimwH97UyeolntgCzA7D0QzwYF7FUFI0XOgWFfqYAh//g9nc+q/M5tNnKM2b
+ekFgT+leahF9tm6Wgo4jXAPmScND+JdT/1Ms7CI6ROUidEIUJOq4RN8h0weRREwhRwyvOPDK1Rlg
+4N9tS+atEDoIWfQOVAoeDOdVxWRteKRYoIAckj4JWUz7d2Y/ZouYbF8CzcQvXoI4NumRj9rEv3ml
+akQNXSRs6DQWM7NxTFRaPrXVRwELA9mycGfR6kGAPgqdasTsOTtPebt1qLeBhAVrlCif7Flv4eI1
+wbfqtHjZn2ye71u4hupe60JNkDicIGb27xmAbGljGU62CU4K6b4K+Qrwt/83gD+F8d/Y3NsGJNbO
+7UcoDFA8XntJ5IUKiAQwSk+AjKZRFwZsbBOBaEybQxF60CGHoY8HgwGceBhdBUkITEfgwnYj9NjM
+wVrLb29LxC5hL6uH+pTdlZQwejx/KNoDPvmBwu38ZnZPRH0LeHLSYcYdD/IW6OFcu1bQyqJZq2Jl
+q8pL/mqjSDrgK1tU7rXSE0Q127pSGyTafBBczkbvULhqHp2iQTg8bPSHQUlcA+NQ8QpC+Cacx/vD
+lmUsQjTQVzlxlvQjTsgRfWLjb8EXqaYaTrBtCjDsUf7zTf7zDo052fcL05hhAhT8TvOpMDJq6Oat
+MJEgQlBB1emUmKPKYIQNG0FiNzaNKpR7ipWU2/3CjbRVm0fn99KIx8K08sEq6MrUR6tmRiZo06Od
+H6nENa01kJr9JZruUAr3XVEWMpiPuuc354Pv/tOj3wu0NmLZnbXuOXyeD9BE+Fr3Uc+BvrhRb+Eo
+fjFn0Aell9qCyaNzW3YkZ/UkqBctPACu9dUAPH9e65ynT/CS/k0VMgpN/nuzgCULCLaytsKZqFrx
+vmxyaOGgn/fwAWtEG5Yow3q45WgsHAY+LljkMbRYjHm1jQHJD4lKNKh+h1aj/NqwV821XJizwdVl
+mJNwE9ymvKAujITAMJDJjhbeH+0CKZxewYZw6dPPF3rSo0/Pz/TM+GPAiVL86mn7AQvnT0OW2kTL
+QLPcuCkjp2rebyQIwSxCn+Hh4OMebYOoU0yUE5sDK3A+cNLa8oU2a6OK3ICpy5uREaJfVj17QZ0Q
+JAMuGdnvniuVPVr38oYTKpWhgZr6Ra1XRYMnIknLCoeoKAcogPRVxvA5DIkwoiNIxF2DGP5FwHXi
+ThukvvaMZQHZgEc845aVJbOgMvRR5dS9nIXj7AJN49AhQaU/oIt3YQRJ0H1mBWVQaKEabakjqqgA
+NRFPsxTVuq4q05sBGSJFO6TSES8JbSuojVbJQrTJnSX+tDKb+OnnyixC66U0EOQGuUL1Q8NJMpBU
+0AaVD8GDAKYSuOegfxUDXYEIonIVjKcVutIW9/GALh7FsIcDMiTT8ezKmxpDDx9NHFQY/VUQJdID
+a5+Nwwl0ZxZxMzAovYIuVXC/R3KgcjmixsG4Vy7vcDCnlf7VIEzQDQAOLrrtlmbd6Qtbzy8Z/w7I
+KO4lp8X2cbpREs+m/EqWyOkt80ccBAQx/bIzqwpUiA/is/EXT81wPLI+/4SVYDIbA2leGcI0jypD
+dISk5jvFicKWw884ArI9zeIESbMsGMFA/xVfppXPSMDxaMBoAjk0GseXlWk8HfBwVhBpDOgJRSQB
+TXmKd5xw1LDwlIChglYJK+ksnaLpjSy7w2GYRVp3LaKcs0iOvMh2jf5XKjc+hN1c4fU8/EnwGfav
+Kl/ERcnKF3YWD7/ZtPIFAybxgCbqC/kjInD8Mk1g0/uC5ve/sM0qjKp8SYHSgBn+kmZ3mDPrT62F
+scKBtFdIClCIizcWl0zsuL4b5jYtjVTOrY2F+wC+fiww62NIBkgR0PtWY3dv5/8hO7z15uzsw4a9
+OW+en9fR7J5j4ZZvdEcp9CuLSrb1h+VudA9qvwOO6olf2BtqYm/2zs9bZMLGKdkizaKn2KCZn0PS
+rUreUpMsgaz4Pcpb6lrgZhl73cjV1r0isswnc5lae92em1csQgnxopfH0zgcJp4mPgWQtDZwdlqy
+PVp/QK+talK1KkCT3G8tHEuZnSrvummiKsnDT94+nDC5CGXCi7Xo5QZEGB0rb1wMg3OvrO15f+BE
+9aoVu/6k41SosY++2syCNcWKsKaIv4ZOEG6ZWs8Gh9X9xr5KczayAm7jP+6m3qUj88i5QeTUwn22
+s7W93QoMC2WWgNpHF2QLU32gKTgPeJhUGuwhMz5kqoet+NA+S7I82DzGd2SrB8Mj8rSnt1prEBO/
+TPdpIFEcoiUguuTh8kaLxA5p4GNN0iY41gDwSZaBgJulNJRFmNPGiARrTSEZ5ZV7NcYQUqDEKCtA
+c0Lke+guDMYDTkrWhWj8KRlwUSNyekU+NPEXUTG5cEMjs9i0CeIO7FoST7AHt+JbmHS1UJ+rSwQF
+JmKSwkJUBD9amuta7310DXfEuifoGS42Lv0iC5EdwcaUwM6H1QfZGRAOsLtTcwM/MSLpW0cLhl61
+DXsWcErpI5peuQHaCbL8OCLDS8otufmu7q2R90udYNkDOQamsB0G1Gvx2nMBkiSVQFMa4g+dC4vp
+iAmYWIJNQMMnCTh1SOKcwo7NV0n5hgO84Abtj6lsaDn20vD0qFzmobIFR2Ee5bBMuVJTzuwM/4Ta
+v6R0h6W8l7EPK3Z7pX1aaVdY5OGZyifvy/AmXAFqn3IFJ405T4U5H4Sm18O8B7C8a6+Cs0gzUS5/
+0flY3tvbi3AEFep2wTdmygUghOB4my4tCR4j/Z1zh7fk5C7v1s10v6acj/UMy3SmmfrQRuQN+Na1
+nLwV+f+/UAypl+Hlnef7eNfGer4Bv33NIGtaAKiJi1YNWUVpK3Qpan9+vrEPWD09S2bju2P2hnXm
+j5RpP3V+pC0cVYVyEt7uEhb70FfBczTsHHWsfTy2QMVKVA+5Z0lUtjDLoiuHVCAr+jSlMKfWXFNW
+ALmO46Gd0KV/XVayoAtjObOAaOe7aCxy6mleLlPcW6IYsEhzXfHCHaJ5prpNRpntiw79Ok9gb77y
+rMacDDbr4HkDXnZ7T7p70owzmmouox+WpCl/ojVmYL+voEvwM1yQAWinM+cPhwwyV2u9zlJTIN+f
+ipj4U5WCL5yXrRbqsnIZ2KhzsSNORASNmYjtR7OU4aD2WiYWX86TqFOS5fIFZmmKxM1VyeLjHg0c
+J9tdlQyjqiImz8+7k9UiJdSzPj+/F9TR+fnC0lz9NG8/eaQs0V5lk/GfIouVp3P/tFyl84vqVQ8K
+eiZALBlkrnU7GVukUiMr6qfp/0U9UCzWc/eQ+W1Z75/W18ou2vFWtqg1GAd1wZLZgE/On5w/QauZ
+aLeYrT7nKL8V9GzBCPV/JBNTtVZkYBesapZzU5yDjnKJJmdMq8wVpU/smjMXVHeqmJBCQdo6c/eP
+86jnnKc5joiMJKMoucw8LAUv2ZIlA+cwfEVjtZB6yVitO3Kv3Tt3AHGGPdhee6KNtdxIsk1JwUy5
+cCngs4hY0Xs3jhLUHhpk4KWra0GHlYeKPlQ1mYLbb6np0Fn00JVlju30E3+Sag7eLPNBtqa0xtuC
+pO9H/9pnf0gmH4mmDOBxi6Qcvff/Sk3Z39QVe27r/sPBycG7U5jA92cHR+9PoQLN+XxH0u6LAwDZ
+guRayVnH/mUwhoV4FVz6tBJZ7mpFZNNBA9JTACKZeJYGF8LTkrvEuJlCVjlmf6AJ7cdWDw/Ubc45
+99OJg2EbyI/9A1C7BGAT8xvaG917F4Aeit9wfRu+YSuHxb5Rf/LImePbE/v8SfePjd68+8cTQOLn
+aLkcpbgOZIB1housZZFh0a8iA5Rs6CXq2zJzYbVpJhrNIuRNcletOW5uyKTNGQrmxLjhBuhgccZG
+YCnzJHhOyXydbthlfsG5D0myLRsdOfxhO70nZW/n5w6/OIXXOXagxCx51aK2evtWURAukB3HrRDi
+iNW0RG6UVrUSadIhu1qRJJuHCf0cTlmc8N9aqz1jQjfcjdU4XKOHVFaJMKlbtNTfMSWbqeL7wu6R
+Yd66X1+idilTHy/+LEr28WVRkyxVZZNNWsaB2NnVHSwA1U8KTHUEdbt73+5tfMPYymPAh09MUsA6
+F4Y47fsNOV66AeIsY1hhIUeFxCR4ymE92IPWCtj+d2sFgZ72+5VHQxdf72qvDE6Q2LeqqUl4PsLv
+B+ZKSGwK4yRC5Sx5S9OkRrnbstAv87LkUhcm5TmIvEutvRvrBjBexzOsoMpjzTJQ+dajNAQMAUEX
+Js4dBdkcLbXAfFRJyEpI3paSSyQ9NIZFMxFpkFn/qFaNWwCpLMza8fDZrsOGRlLEvc3GJovtH2KQ
+Ucl2+ZiNzNXC2CZIhpVQaCvIORKule2cmNw4bUVpGlP0rrUs5F41I5Eeucw8515xzL9q6/zWE+le
+3nuOKbWNtfIAnqNK4i1xzCynFp2Mq0XTVbR4r3zCY6dXyK9d19BhuO+G5QbIYTa1WzSgJSRpR0Kt
+0vErVATgsrOztfVN4KJPfWsd4Ee+nNfPS4596cQX9X18ui9J3RjE4tx3FmXhmJJASjaCTzr9eOyK
+BucqgzvoQdinvZqs4/NZL5MiHKoOfYVoGw+TBQKmw1++q1PBI8ppEvcDdOxFiPkqHAyCqCL8klFh
+QUYHGOpk2e9X/DSaYgY8X375+lQdp/YrJM+F59UdPEL4g3RjKHx8XelHaSQOW/GkdAopIHIKiabw
+O0vGlf71FHp3Cd0MxpVBOBzioWllgPkCCMVj0nmjEkzxHHvaT6/hiVEJnY5n+HoLDzw+vbqtDMeV
+IXT0poLXP0eQfdSHf5fwF8LfBP7SymiQVEZBAg9o7wjCYZhGf0EayAEpJnFlBPmmkHB6XaHT3soI
+ahhh90dQ/yj7UhnNKiN4u5mElSt9LgsVhMFtJYRyw7ASYpNDanI4hVJDbHeYQBr0IY1Zw5tEnN9O
+KzBeE7yyOahMAj+dwWhBggma862gZl5lMq1Mriswr5UI2h+FlQiKjKZp2q/gUEXXlRhaFF+Jo99U
+nvvi4X4lgZFI+tDHpA9pEwgaoMUgeA0rCQZP4B8OewKFJlB4An1N4B+mxn4nM0x1Df9gshJse+pD
+SArNSX2oLPWhlynUAs1JYbxTUqEX5/IwLClkgKTjIIAk0FW8AVZJ4X2KpUyxhCmWgKINeH6ppFgh
+hmBdWRAA1EIxpANwg3uEOGSGRDcJng8gYpaI5c8Vbsbyp6IPKC2hQs2LjQfPuSAJHgZs5HYaVCQR
+Sk4t1Cfp4dlmmWxluUaNgXWJ/5GBf1j/gcJMlSw3elBcgY1BDlPu6n/Qx4J1ob6pPeaZt2qPDPzj
+8X+osNAz92Yp2iE+gG1KLZNOG99tyANLXfBzGfjdfr6fZYKfktmq2+ldFE/TMJ0PhCdjQNHz4Naf
+TMcBGwpL5/Eso98I3cfPx2H0ea5MFs6TGBJK5A5Mf3bnmHNbtxVWnANevvGTAaqyALgCMJIyshGK
+qtYjQAjoCgzqwqBkFqVT1NhHIg1PHTAQmN7q+Wl1Q8iD0tzUSNRb0mFgY+X+ah0MBvOXiH7hGQML
++xJQ7PwQjzbmh4Dz56/DaDB/TboX8x+AEHsTDoL5j3EYzd/G/c/zd4Ba5u+DmzmyM/DIwkn4JZh/
+iKfzD4A/5ifBIIYHYiD4oY6eoAIOPjHhKdTcv5rzjRn4gb+r+GZ+ivYXTvF+2fw0C+CVdqH5xwhK
++xih4uj8E+488xd+//NsOn95FfQ/T6FV2fwl7xX0m8AWBS/RdZBk8vd1Ek/k+1k8fxWmhCTnhwOo
+7PB26kN/D2mznv+AuHt+ROef8yPUCKcbzvO3qMEyfxfA3MEAYObjWQb9vRyH1GXE5tA1H/p8ehf1
+ocVTEfdxCntxMD+Ywv4JsQdpii17AVM1oAbjoS02bghofv4qiO7gMR3Hd9hMXJ8wM/RzxHYz4fc6
+/oxDyurc8PL3DKqnNgAmxN8ZDPkpfZxmMCuns0ts/Cnr6UDThAlOeJNK4TC0kOIVHg1DJ2kvmX9A
+r4MnsImGWEkaj6H1Z1jTWYJQCU2OcAKhmX3xehL4A3j0gxCHAev6hIh2TtrAMLQAkfMPSZxxWurG
+x4h0gnG8RMRHgMjXTJvNT3EWzoJg/glRN5C5TtWuOVIJtGo5C9I3/EamqYKuyC3F1nfvl9iWcumR
+yUJAfV/RN5QBeEdS1XV+P3+0XFmOxVwhuRLLV1eTS7e81+R3oJx3PKm3CJwwjSK+VlGCViqJzmvL
+Lkt+ytsbkahFHw7OUoAiJUx59IDOZez6D26eNhU1h/hgcjm+mwuVwTmfkc8RzzC6JEMbtNdMPXMz
+McpG02doXFqL1jR2rGGhNT+d1y7p7RJwPzzjBB63+EQZnrK+Bp/K1HmtH/wNjxEGjiAPkLj4QMec
+8IMBZKYBfiMMgXKNrPAlUsKbTKejxS0FeKMruPMaVgWbFSyY2hD+hVgX1owVhzpjiOlCjAgpBpOF
+XFGITQpFVYDc4Q9Dc80KVbNC3axQNSbEJCkNUCjahefJ8xpWw/mwEpEPi6e0uSrgA3OoinQ9ONik
+0oc/osb0aowPiBH13QAe7cMmOq/d0tE6ymBRK9ycbsGaa1BS0F88Y3GHX1Em7tafnKOcGvL3qt1K
+r9NR62QZuL8ujC5qQmk4zKTpMsNhknHzLsWb9HM08sQdfuhsaZUwJJeFxFZXXnfohm5S0OrGpZkW
+yMfimJ4/IiJujtKPOUk/2BFtjnQUl2Rrxmncxn9QySs3C3iyXeZA9GHJb/lgK6mCkDJdmXL9riJm
+rf4VKXRd3rHekFDGoajLmLRhBkEfFgvpKUEW0soZxDMioEnjBnWT4JXkt5Yv9EhQ/zVjKpu1wXp5
+V3huKfW2UiJonktudM/rZUDsKHnHUN8CkCY1Bkqq8QGvAJ8CSTk2D6ymeEg1TZsWUNQFO5M5CbDh
+HvhKjvDYnbl9d+oO0Ha3u73ZfLpbVHf9h86tDUdrhjOxFaoxKz2KZgWPooa7utV+C7Myv4UPi3Qi
+m9RdLgBSsY1CWbZRe3ZRr/WetDagvR1YqLnQDafIXQnlKzHH6106p27P1+keBr/dorNt/towhGN0
+ZrFa77RW3FC/62DhF+i6+0sT2kN6O6ZLX8SHqcGuhaW0AXAh4xxPt3QIuNQSde0FaCkz57LE84Gs
+fZJ9FmSeqvnPN/7hUaChqiR1mErJIo8OhL5etsQsebz8EBNvFOQb0mjNVa9I0P3jPLUee8/3/+wJ
+vrC3KKo3v3vrVn599zav40yY6lb8JqQcCkQR6nve/nWJz3RAT4yeCh+VNymqmqbXoxUY4ut6zxvP
+1wioRbf2N/JHzbqAEPDJGPad0r3g39RjFp1i4cL1tKFh8nytVttwN2o1SH5fuGekJA9r592Xrw7O
+DowGnfcK/VgUqNl8y847sE3IzJ2lrDmUb2yVz+kyAyqVpPN9R3dM3yWKBOxBstx1h35Btfr5+QYl
+2t8wVYEauaPILun68I6GKverW0XSk29oFutHfK1dlOrhhv1laFvAHhsNxsGln3xTY/fnz1EBcWUK
+wOKIO2x0/xejyhG0hP4Au5LrUqHZ2NlfBYTYWe2HuuRWQ59Oo1dXj77WuQFQMVb5D+vRqGE/j6ny
+BF+PD7iebj/d233gxII0RT3zVOsuQJdsfLiFBn+RH/+uvdFp/Wfdqz5y6//z5LGNTHoPFayiUuFh
+3hzatwgSy4SdLAh7WMcsN87BZIoXp2oPynHvjZOw84VR3ffmERmjjtjLXUL4911BJO7eL/C8lpB4
+kS5Yvh5C9MD99sKu0RvrV9433M2F3T3LKufnWU9HdOyWmWpz4XRsuvqDqqTw3qX0zhP793m3Vl2Z
+seMI7cnw2w8eDZoxB6Pp104ew8LRYT77+Otngg8XMPNKaIGyY/sbhO9K6/x8g+g41NCrwGChRp+a
+v8dL6Swz4WN9tP24mPBxvsTe6ls01h+1Wg01FB5ZD2wYBV1MqGu+37NJDbeDer5OB9jWJzAedqXq
+dP+o9AD0ziMOOT/f5K8qPDrOE0NP9vn33e+9Wq8jhrr7Pbx/v2/llVeS2eWd9e182mrmxlqDIaqu
+WdXkoTTP6T7TvvVQmq8UsRyfG/H1lbpTj6wHsp2fP/nmjJeARgOtU1wjaCBYKHLxy/cTC+ocmbHl
+SnWEbFEkRfKoxNApYLWZy0K9KRBhESBZrwvc0ky5FehLdzR8Yh475ABB6Ir2JZf52wESnWWEoiZE
+73DL1st2RloXO7vPWsLiGuxMqKpYEaqKdHUh8hJ7d/PZ7pZDp2XoauYlG/PEaxappETFd+GTosXV
+KnZqhd8owPLvaIjgcxRkrwopKCiB5g/IJBgeKtAljhVxKt8kvMV+wRs6i1MNyLhhdLwWiVqF9xtO
+yu4aqO10SVq98fjJK0b8EWZ3VBgg5kePmKHmXtPJyDd0uLxtac7U9Nhkw9GoeTBBA/GdsIW27mW9
+vfk8XqTdqP6aj7dOgmEv3yp2RPYPGwWNgTKxyp4XEhDMpA19vm8mPVoAqIpwmBXD0wUWmrrT8ki+
+9JS6w/LoV+KIME7cKyPFBzlLx0N34BU9bbSXCapKYAvPLIYzDGX0LKHgAdNcaBw9cdpoz3vN8wbr
+65gVXRstMDb0yM7vdH3dDsnBCIlgpmh00SHDJpgo9cboiH4CP4njjrxGe/Q8lC6zqtURV3Tthd0R
+GQxfs/3uNUxftL4e0ctkfX1CL+n6egovDue48/CSzLVDppxm0Kxr984w5oSu26TWK67lxs72U3W9
+h63qFVynuRGaMMei8cZ16u09iWtRrQmbvN18/jx14HXmjff3mzC3tV2Yw6QT15pAOw7hDV6aMCtB
+N6tO2bLStOoNYVldrWPuWh+zX+3ve7W+2696abu/32j73ubTnSd+lXO5lKNf8/Z48ELPN/L6Km9E
+eUPKG5bkhTFskKFu32vWZuzoAsJ8CBsrT56d9/77VnOj8cS+orZDtqpHdpKn8Y29iR4D/Jo3E0Mo
+Ez0Jnxhp/BrAgZvVSZGhZDDRWzGBCaHvGQyoX4MRg+HDbs2wV1OvjwM69DbJI1fHKL22ue3UzO/d
+XQcG+8pDY/8+5B3AW7MFLxMve96Yz7HT2fp6cwO+IKJBg5hxp/xLtEbl0lVMeJnP0SMadL9jp54M
+xDyAuvpOC4beMBlNr+j+KnM26P3t+03HzZ7YY3PAaqHjPG/iSqjV3PETD5LYWdULq9N9r9kZboxb
+Q3PsmrWp4zwZ73ubmKVadccbmIWS97FVoikiPwTY2ZNxDabAKAT3u6o3hQanXmZGTEsSeg20a7zv
+7bWDblK96gH8PF1P3auqN3DTDYQmNzZgL3z+PJ6n7qzqxe0ZwJuRKeRMIWeaUSaKrg16c6+5ufdk
+grLXra1nWwVTdkusnbO8qwYe3X80fAXRdbm2KcElj+xkYlSlKrgyMTAguXnVpRnKo15QLt1FP7ed
+oPVjzqGVaR4w5QQ/5RLERoIxJ/ghl8AvVLG+vjbjdL/n0oWGV1h7LZjP14LutGckSEsSDM0E45IE
+V2aCWaEz8/k414R+SQEDKAAZTDRaZ8f840tPLEdI6AEz64X0CdRgMPBSjsIbjfA1pq+DNI37oY8U
+mDejkONkgDb0gF5L6pwP6qjLXFBR/TTIPJ+AY+pZ//nPxcXRu3cfzw5evAWS9uzwhF4u/vMfC68z
+5qN/Ovzt8BXHXS1lBcL4Vxk7KMYenwDZLGMnnrrYPvKeutce4K+Re+ddA/ZBRxjujSc85qAdsEPj
+w7Txap4LsJFotDdfMMlq2pBeM/3EnMJiyVlIpUXgZYD41F6bMEaa+Ld2w5UepGqZIzzJ0MLCXTiG
+XTh+nrTjatWJukDLBN24mvW0o0HTnqpqNftfI4viqEPDJn7hBb4vLtiFQ2Af03LDYF3IS24sUhuC
+7lfURsZbROJl+/v77BMEmCG0pT6fb28+2362AxT2U3QdJLcuQNbtzEvU5g7IHhtZzUwHo8faiW1D
+h/4lzSeLXY36Mp9zv6DOBOib514NnY/ZqrNZPkG275kOgI64a6IxZ1SBOWvvS+IzI/4s3yRjjDtJ
+K4C+GROaVQOnpdvVEY4NJiFK6QImyT7AxnHiNd133qb7ytNnx+qAi2nM9XX+rfO0AUX5FheA/LLc
+F96r+fytht7X5Lwbfe2iG00TcX7Rjv6YYuTWZ+Q+E3vRhVgNWp1IAHfciuQ6iV20G0VrxwC9j9pF
+NSfjrou0DcMJwd+mG+1POUT2i7nq1tdLBiSgHhV8crO88VPe8nAm3NQV/XBzYlwPr9Ck8Ct0s9V9
+22PnL8sVaruRupw3hWaKZWI0sujcyPSfyu51gk6Ibvd488ID++Bv+JwFuQb/tCIbpCfcy5mojDRf
+UFBHOxyHUYZ2pjEgzZf8w4MNEoUFOjuWLJA8B4zz5f2uy7O/qTwuwKEGZxS2eG3s+1KDwVuyWWp1
+jwTc96yF+7oOm8ap9wFefjl4+/Hw1DuB18P3ZydH8P7ONcsElnoKRIdXqIdOj5frYe/gWpNiYZbV
+fdFbkQNIbPtH3Gd/rMfDkjQ/2ooNhyJ/zDcl+HtlqT9+0+Bwoy8uVLMtKLNyb7nWwipU1/f7V8EJ
+25FfKoediV9QGlhKXKjYND5GFIxusoxU7GxczzA0kjcw4dqcth4jvfSs7S53T9VUcFknnZmyV3kX
+ERl5rS3LGyflmYfFzJn9k/uj4/6UG165uB6Y4x8w1w/lc/xDbo5/yBWtF9HKwn/4X0x1F6a6ZxVq
+/ScD2lyR95sGtEkD+jsOze/lQ/N7bmh+L4B/9vAKCFOM9wN4ZdITpo7ITahRkaA/EOn5m/uz+yv6
+Mi4QiqeHPxORaDAogdosL0gHB/2IKYhdRuVJoHcR03xygN68sBC2CCtLucBILzMXwVKRkdGEUJLm
+Za1Aj5B5Yi0uZiVGyc0HvKQF2jWcAvhBCcsQBCbTgdhbTsBv6DiEiFIYrW7PNP6fGsPBrCBwDbTw
+AeWLHIFT3I1+kbFxWezfMjYqi10yuRtQWpoXQXWQZCpztKFnZUnHtg5vcRMIBkxfV+KkIoe8wgWj
+de3uZ7dy3UMz1UkYpC6m+owQJ5K00PG9JjYMVs4YjX4gHZ79b1rCFh5XVjgrVjifL4/P+roaoP9S
+e759RPoGpBHhpIGiAAWFaRdTqUuaBkXaNRB7CXYqZieiJBD0YslMNVGS0g6fA0MaAvd0zyLOuJt0
+/FrYClmEiaRvZqfdZs+NOmm30WuFaNVb8v5V5YApXCiOcGk3tPOuRodfa2xpQyVBBmPw2jZ9ULCo
+xWi3alx13+8A7d36Ag2IOgG1HkoL0KsPespYanGcrGjyVZBD7FlnEJCPLcty7y0L/Se1Jnmib2B0
+UuTKo4AMqT4iwyNI9QN8s7Zc3tC/vKQYCF/W5OLDaY2CQv6f/ll+AwgnJhAuYSmkhancSUA+VcI0
+s6l6iPspH/cO3oB4NVzKBnlewM6LrDyxRUiulNhx80K04Vk2UHx3wIxssIZsSgbctyO5Y/bSB9ha
+BTVX8C1CKnE8LGWjMhlL9dnKAdbx0HYcqN1Gb4c65FvbspA7iq5yTTco+Hvmj1NAUMuRmYpck+ns
+zNxm7gqjk29FSFJixfhLiYcKyEQAR6iAXJaLC9S8zWWSQTLSCJzPcceHgJSqHvPHmD76/IH+uHKz
+ZApjSCZA77onLFohKSMmx2J45aMNM7EV2Y7hO+86SO7My8huJpFNRGwyUOE0h8rHLPQusGNEdCjP
+tBMYAPxu9Ni0PoSpjMi3L7gwbn1BnuSYQaInpWBo8Bnk3Up/Ql/ouANq4M77XtAOkFby/AXLD9fw
+vCYzBFa6t5k4Eks6a+SgHka7FXfWrglp4Ukb4IxLQAMYor9dNSdYehNIVui2HJ+phBDo0lDD3qWG
+vTUidivaDidEqgIRbYu05HGCaDDhX84g5gyZUHOj0TJFRo5L4TIpVfmzLP7n9s8UZYhSboyWBU7R
+r4UpuToMpOiqtBeH+V6o1NgPqISkaa710o/QD0aaBdOKXyFLhpXLuwq6rQlQNNdwdd+QO8QzG/Tv
+rkKTTrOlTnhQJP0cUWaCQjxBspK+myJgg2ggSecLrBUaocfRGDh67Qfh2LazWuBsJLWmgz6Fl0fz
+V9nNX9u/FkfzFileHkSDKjpAf6Y+0FOmO64gJ949zX8e0KfBlSJljaCc2Vng/gBdNU84ADLLOStq
+OAI2bEMGe9J9KRgup4fWGHJlrWL0tMTZKIj8ayvyg4XMXkRSZkkVBXbSzTpRLW7FPTemsVdwEleb
+Cq+saMYSz/gNbVhJAsm69iNB7wTQItG8arUFf0zwwCgngfsTGmf6llE2JcfLI87EbTegoc4VCMm8
+EldUZjZMYygEkLeS5N9MFxcHY6U5ShdxvBo4HwbOf+7FbV8SuaEXwdDENb/layIXpxM13PIT6WsC
+11/RwAcn8pta9yBlm2usalV1P1YzHbrYcp5fs4XdgVhYES2sqHR0Jb1b6EPOKRA2Pbc1GXsOIXTu
+8SM7z6XjWQ2RIL+gGoWct7i9ZqP/Wt5KeS9dX18jw6F2LJxERtUqz0Pb0Sc55T3QJPu/6wI066E+
+EHz8op1viln66Ai9qa/MXqZ6qkkT7HEno6mjfnKf5fqMabLi/7vJUgvo/8vdm3a3jTWNgX/F4nH4
+AE1ITZAUJYKCMLKt7rbdXtpL99PNZutAFCTBpgAZAC27LZ2TTCb7NlknbzKTZDL7nswkc2afD+lf
+Mr9kquruWEja3c/zzuSDDeoCd6tbt25V3VokVsqbk9Sv0Y3wDSSksbFGANNJOHVCsVZyz1AXsMyZ
+ZLXYMo8FRmec9UJpD5rwY4c3GGutUXAVbfOlf/jVL4EjqQXHUkKMM9qX+7uCA5R4G0GhEm7RpSXH
+ATFbRr/hjUa3jwkvjqP19J/G4R60nkWXUVjcmkxbnvx9q9XRuDDMttuRNeAvlnMErxGOP/FEZldd
+RakBkWO87oB4G+mMIWXlM+qS+UQztcWRi6URF6Q0Fo9zn3rjj0nC37xPWb/LHWUoPuRRo7q1NCOK
+c7zWhIKut+mW6ssU9Ou2ISdbbWv1qQnIu6dBy2Bo9H4yc1NniqPJGvpcchA6yRrcS7KXMXQVlDHj
+Y7G9lxbbBka/TBSuA1lkCiFBGYSy3feks4HddUi76/ATdxcx+2xzsZ9ybxHL3mltbW2JEmDbOxae
+dopzD1ooKagq0aXXQhdJ2n2Hn7j7WM+SH/5Ma7wotVq7JRnlsqJNrTn7c9WKPM/2/W67XSicgj8A
+PJrVGeZOPFy1jY1tq8QStn2tyCfrBFnsgPyEG1iV2Hs+U3WDeNZ1urbHf9MXKOBqYhOXp7C0qJTS
+5Gy7AqLq/uSX5TqAiMD/GU0m87tyG+iwQ9Gc4KbtRbnTbmRue3MMK+gENSRirkflCazJTuNAgCtN
+NOxErsDAqeyzxNP+Fgx2xhhsjTtwwgozDRIUtLaZeIl2sh9+LFv9qaNcwWinUmYTg3TC/Uzy2cia
+xIwOHX4CHTqM9PGRroj9FHfIKNBTKfwQZQyJ2Kewc9+LC0UiWu8iZrp2FDnvIvzxXPw4YD/eRfwu
+kL6Qt4D0Gd0NHkTE+byO6uxraAvHF4t5u73ZE1sa/7aUOZPTswNZ7tUt2HB7u7/dtqJr2AqwYvzP
+Av+UB8pnSceyov39fXdow+/sM6tgf+ztuUO0prKvu5r92V1Dr4xftt3uTn9n4O72Btf9Xs/t9bYH
+O25b00U/iXheXIac19fcEEPgZne1qphVLemDVUNGSxukDBRFLssPIdsca/ZjpLAl1e0GDiti1ryq
+MX5qX0fEVaM9GamFfvLVInwGYkq0r/4e2/A6+lz7QkAaIJfZN5qtvKYulpY5+19EwSu6ZbovbsVq
+AYOq3rvpSaTqixLG6aq7NtXH46UtiiNXfI39a1YmdjU77be4ECwmHZyfcGTOmBruOKLEepiRVlNN
+vdIuBN9EmDi4ougg1RzN23mJa0HGgfCr67yJ/A83WApkgCrDrBztHoUqSXpaQA3GbAmwEq9V+H33
+s6ITbWGADATVAQbovO5qC6RrJh+rASPQ7ul6ezQIZ7pbDK0g5QmpemafRJM70dR8vfGU7QTjM6Rn
+pIm5nx8CZjLpF1W+deXVNvW2nkXGiBDdCr/T+Tpy1C5tf41w/TpConAvsmEiOTujbWnlL5t8QfoB
+H39gwxUceJwmmxR4N4/VdWx+C6NdIiqE83l6FZ3cCnO8lM0BIXDAAINabxcYw50IY7mIufKMDpTP
+XPyNbgLit5By2MBv6QP/OKCWr7+0E6buc7uhlQZbmPUb3govL+fv2Tmj26M0o4JflBaNpoKh8lFV
+XF2wlywRZZFScMoQVgkWULZ4S3RzC0042Bq10CyfetJcYXBbvJDW8nF+KHHAeWrAAd1qzGTDpTX/
+cOO0/p2Wg9t7o6scb+TN3Y2l5RV6Jk4SWkkxSzijWJxXS5v5B8of6nqSQC6S+M0iun9vTC9G6oXI
+WcUTQWPLpSJZ94Ym/iiCfVN3avN8UmOkFdajiAxTeBlTc+CmAxz3W8BpXVwsCJGRVh4dtcZLrGwt
+qMJ+wy+bNfVF5LtD5+cIfSkcRSQVrL4lWOFNzAYda/KaBOCO2SgJN2+F/MImLs5vhQnwTJTeLLqF
+bJ5Bv29HNZaZKV5IfxcxL4QZ80LAsi1s79GioMyIuX45JlgTZNXGMMSMCxW1QYw1i+aCiFTmMF2d
+fgP7XZ3J0IOI3AxuR84RNH47Khl9cfGBXeHLjeYotmjpJKKKTK+OGb/HLv867r40qKruwkdxjpGX
+mNEKRToG8oimKpgNCvNp42TxtwP/ddBigljf29EnWuABAipjy9vrS7VHWZoK1h5/0pEnbtPQtA6t
+GcwG86YGMRAYN8izq3XuJ0uGsaC4k/fxzbFTo70oqtDJKGhnnWCgxnFcrsS8M4yhNA6kZhjH1WGw
+CiWvNlkBD1aJfRxZAiZm2J7ZIfA8VLW2/RLwqIfrayujK2u2WEJnfsZnf4ieSuwqVexpGMxxwL4G
+EdHsh0Jcr9TGkMKAI196lUTZ/XuBpS5Gu47CJJ/C5PJvkQTycYqiEGN1g7y0wUpsIiulQV1gCNOa
+QZ3yOfIW9YO0Wh/jPfg6fSo1gpf2JTLh2rUt6asg6VxN5XEtRhUOztCxqqhXy65TjwF/crYB6aWX
+TdTF5fSmfqT3IpBsm+F2XqyAGdavwG3lfEXzJwU6UWaNLf/x4Yi9Btrvj4AnRvKuIxdvCmuWCYVB
+XaU77+sJnlaxqKlqnEk1KjECRpg/YryFuvpC2QadbMP8gO0syw7QfgW4tkUWPcHNapk7l1GgUvey
+5eZTR2x8nRjoveAx+NyutHtf8EPNLeutVOAiJ9Zcn9OUUs1VhuQ43qj57GpS7tUr4BUBFFomOlZr
+rIhU/yDzwuqhcVSBFlGwcdBayEkqA9HgU6sRE7S6tEZRkBSKSms02ol0Am17JQSR1jCKWLuMWNPI
+4hy4Dv87puX6KnIeVGzeHx08ZY6RDyNfn4riYb/kdkTUT6lbbnjma2qAH6QxUV2F47i4gBFxtTMK
+CrmfqcrfL608w0DZjXW/WVoXeLuv8IQrzIFr9X//sfXf67WZ9C5rH5Ejs9Cui+u0TBkyzV6jUpMh
+YYbBCum3xlIXhbEDyDePjPHIeFd5HBiffUCoeJFDanCv6xwdXULfGLRF+RMUZeNj0936obLUTrmD
+gZNyfqHAXxL1MvqL+IaEfmoomGpeCIUiAl+BZGB9BUIZcLC6/BBK6BGH5KSoohAw4ebQ76wr9H1/
+Zx2SAiPxYwk2vNESw3Ika4yTpOQsGzE3MRCbcJxyQ8WOFbJXQUacF4ZG8Lr2jRDnqVSv5nL/W9wU
+Wp8T8oycGhbVkvniZoOpEwm2y4lKDFdkcluR7SVIEDA8B2O6lOdFoUdlwOgWWgxUwYta5gdeSJOL
+POvIym3nyIrRGuYKMb6AzzBUtum2YURWLdks/x6VtKWybzRF87zQY3DQMoq94/uJbkXyDe+e+/45
+6ZQxyDBo32IWgbKuJ3/t7+9ndvu9k4tvEi/hZfr9xg/YuLu3F19jxA9o0ffzYCIG1zmj4U09K/Q1
+SGBwhT34LAKAeJPQiQywLLSdg3jMdAvPlUVJKtqKnCeoaHYwScbU5hdEUkDV7LCKSTgFrErFukUK
+e2Pc7DFtdmlJpxwntKGo3rvYE0zVcBDH+BNzHwOeyAHke4txjoEq9vZ8lw1lBkPJp2Opupu12/kG
+y4KcXvtzGAiMeurP5GA4jNFOI9ZgdGmsvnK6MIf1loY1JstRGkkGKwhDiWEMvtvOgoK6Ew47wp9h
+kkxhF2EzdE6EHdfo+1QSEQWSyZRDP6tAH4g/mu/M/QQ2xTiG/2BN5/685EWgdsJ5xBI52UDsKEjV
+XELjgnWd6h4TRUnQJHWZZG/b7Zh5MMgStFHmdv5BpDsonOjbUTNxFnF/yJ/ZkAmwcWWxVXpJLPWY
++53wZsaarQXMIii8VDsyLspTwY0HtDHbYumcaqGlbO0RZFKw9lQ50hFJJq+v0SYgk9+to/hJ/CIw
+vT0kAUwMJYXJfx0HmVcwdxDSYnk6TLENpuVCLpNHVxC4g+av2SSdSj0ZNeAZ5NDKYONqeHBWOW9p
+DICWhWYVFTKrKHERixEDYIskQKnF0uRQKwb45TdXEezJa+axEBUYXRyOMNgoQCCfhsV5iy3u3OdH
+G+z8NDj22FXJHM3cZ6iBWDjm0s+g/QW0P2NnBVfeAI57Vsp0jJFNkJk7M90zxTgr8HbQ2t3eHY36
+g+1R24o2/YiuJwd9d7i9vTPctu0O3nT22vIrXjKw273t3nCw7fa3naiD9XYdt7cDjdAf7lD3+KgA
+NYGhH2hO/umkmCKHolnqVxdC5IMnHwagdUUHtUGpPPBZG9FY0TFFw1ggHqJj+V6KVMzOyW7fCpGK
+ZfB204WjF/+IJnlH+WWFmo2+yffIEW3SiDJmSpKoC0YWIy8qnThsQInNyT/6tCXk0hbzG70QaKuT
+TmIcSdxRdrTpzUNS0yLPAb8upv5DsfCO/HU/wVKhlnO+XKa5LJ9IOq/NRyftKMO9mIgx3prAaemg
+JSRuHWlDjn+7WiQTs+tarZ5getQYcj+l7TY3x7KAscyA1vOxLPbgwENnkMSZTxY0jPECxsYj1cBr
+XI/LgF66U0QSL9fNK/E18VUWuild2u32kRUC55kzujaXBpF4/wjEfyNvt0Xhvn8o74eJx5jT6Ul9
+n+JttxQaBbt57p8Gc0D3ufJOCfJgAZ/NNt3gnKGJdw6D9Y0/JtDu1Dtnpxf9YTunXDsoJKJzrusT
+XK5zbt/cOD+sXvXSHTJxQOKcAR7MskQ0EbRtiIBdQ3zVhMKxOlyssA2HELdfIUFvAnQGCmFHTdmV
+L3BwrN/S2FaghfI2Id8SGGTCB8n5yQJIL3IjBfKYc5+Yx4U+TEAbOMKsRXvObKMBcVJdSiBsYGgD
+Y4bPYMzopySnAqs3C04nl1MZBQUlmXMU9GFSaqzYOhrEnJfxDLs8abdPJfq8k+hD7Neps3By54T5
+iQGqwbdoviK+B86usE4n7k+Xaq+xP3kF+N4tf38iPz2hyV3U4uVbmNlJsPAWP829xfXcuaK/gVif
+Yh4H58L2rthv+HWsSsXKX3BU5EqCt7qgf6WhJfGdb50rWPvvfx1eVnBSoCRDulTR7CAs4R2q57//
+A2IeI1sLHXFm/gKZdFilOV711WAcINKsFpHQiKpagaMl6VVo8SV92tw83TuSaEXixgIQK2ei8a3T
+TocaOK9FgxP/PfIXOSzvuVzcc764TIlzqq/tiba2xNmfYtIP55s/vZPmm99oYWkR6SgXBq9cihVd
+59zAk84OOjLmSs0JvTAirUTMP51jLGcUZKZL70xdpk0JunR/Wkz/v3BufVMZGxxizu9X45MSgzJN
+z4dwDLQ/3Slu/t9/DI4YnAgtkNk8h4c1D1IBNNlXbutIkrPrQVwXbx7UQFmDC1b3Uw0w1UVj0MS2
+S7hHeCzwzy4xX0Ld/k1d4VIDW4G5CWBuqvn3jRN0UUsq/oUpmqFOla906bgXfX7/0QNhJLV5GFzr
+qMZAYhq3xAq3dJciNbgSViyNrBNp60TmrCDLfQHMvRbvkkVo0+5SxBxEtCT8yCl8Tas8LriXEbnv
+0CzRNJgZRgPVRkGH9/mBu51LeYdUzuLtjTDnSsSaMe+ePZ/8mniZBJxd0wi8nxgK8CAjb1HVtmiN
+FkNriwOfv6hthJaDhhTyEesDEGVjAzh+hnEuQbAXaapvzNfa7y2mOhcKHnTAIMJ2WPhvPx847/DR
+c47oL3VX8rxQt4DPuGhfMrSlg/aAjBalXpmhJUYdJZ9X3Y8HFygBBsX6lvZjst9tt5O9t8FTtAFJ
+nDOHpbqFvf2ksDIVWAtjXCzToZDu4Dl0hK3WGf4YqiulEiHDH00SPyhqLH9eF2T587xwnsOUnhf1
+4Z4Q2kbEp+fFJ5rWYFQOFd3KaKZqW8NiWkjHEJv8ECLND4FfG94RxLAjfFmz+CxWChM47rMt5nUd
+td9PlV2eOYBGW5xHxn2mUafZcEb3c+G8K42bsrHZXqSbgsCRSr/ZQcqKL9H1BIbhiptlo1+MqZ8t
+s0WS9bvVQf8m5ik6pGVEuMtwFhfvpenKHOjA3D/TDVk4NQzj+UfbtDwjmxZjJshm+JUAO8rsoai6
+21FB03aDjfQFbh+K+yk0AWOlxezCqSOtpPHsyZjtWSdxoknCPSDNEaZ1tiNfcIzqOptuuQbP2Ldk
+WmtNpaCpFM5mdR511t7CYBAt6qqzqIyoNI/KJJqMjX5uNjaq1m8yNvp5hbFRtaUGE56f60146ut/
+vAnPzxUTnjK9QbJeRzwUfkSVWp/qWSraZH6k3Ku03PoKnyY4yPwXAvr2KofyRPMlB9LyuJAOShn6
+Ttfg2UrfL84GamOw8Di2MVLP40J652cNfvmlzj7ZBOQpNwHh9M8x6Z9O/XQDEUX71rMVYdCJczw0
+/YOCOUAVZbuQr+8/f8EMQ+4Wvj5Bxew8KTTDECM6ouiwuLlbIDOA1BZ+XUz9u4XQL98tuMnpQ257
+iiXS+FN+xz4Qv/EbLmo9FEKXKmPfit9YLmwCH0rzQFnKrdweGkZv+Na07npYuonCL5QB1kPNGou9
+USZUDw2LKmpZ2Uc91K2lnCfVw/9OBNhZNVrlNglF4O7tFZ48Wgn6giKXtTzAUqJaqf2euNH95hqc
+i5xgBCimIENNCjKg5Ne535XX1rKFCZOLrNSP2+3YGDsOefMMqQHeXLXboT6wGy5MpZXR5v7XgjFi
+CtbQVsqP7ni+l4zneNfC+59PffOWOG23LTU4EG4c4HJqAHxwWkRZE3wtAWCbJ6NYDWQUIzddBeh0
+f0UtHaShDlIm21iJDwAK9dEaEA2Z3rnSBxz9OpzZgmkgFZfpvBLn6FKMiIS3/bEchA+CQcyEnleF
+c79wHheGb8OLQqfhkaRaCYtmyIlW6r8s2NVUxIjUWKVNUMY7jKh1NS+TuObKucBY0WgPOucvjTwK
+Ss8SUwISgh5NxqO7UhZTaO7H+1nQ9bLN2Fn4yWYsxrPYfwvTX/hvbceMZzHHi1Hpv1fwzBZFsLm5
+8AAT5f0sprSJpjdG6gaVOSXHrLZqGAs/5MMI9/dTZ+a7HSuh3+pClgY0Kw2IQouMaWC5YOFyJmhG
+dFopqTInXhh32kJX3vEpZDSFmbeAKQDIUKU8R2eHFDAs7FjZ3l5qY+ZlNZ+nRb1ay7Qfu6tOcMHT
+b0aOYurpt+TpC0cy9JkjzdIdwcynjn5+hY5k6mOnZPOYq3E+0wzOXhXX19arwidxueuc6aLro0Lb
+9Vbhs9wG9obmEco2qbRXKPa66i52iXCNmQy+YNuDOOAuIi0VoAjgskJCYPTp7KjNMxbXwOw8T4WN
+oBMyuzcVc+AlGb8JONpB4t/DIHamIRxZwNleiu9S453cc+wTR5jBlQzX2HpQVSZcrWG59pRGxomB
+Tgpkn2TWppmM3StqMw35ZNdFlyGwc/K9yKBzdDbMhT+ocW3Pwgtw0rrQtt0kx8Rz97jxw+YZ77Bs
+AmFZRDEJG2RFf4bGRiIpjN4kUZuIq1BlTRXuDk4kMt4okelAFDD5PFbtmSZVX5uGoOgbx+wCIqnx
+jTx+dOMCcrpOZN32JlMjScWdQoUHqiCRwm1GqfGjPTgHxcJhhi8l7QnUJHSl9+Os3U72u2MbTYPY
+bPBeLWm/nzrJpn+mAqioAX2hLCBkbM0294939GwdVkYu83KDKMMhssJje4VjXWgcQbGfdoDO6JEG
+Qy+DLRp2Mi/tZDjPWK1TaOBRvO/nCi6k47D06xiJ1AsJkBlaYHRmewCIhc8XZtFuL4z1DyZ8Ay2m
+uEZwQs46dE0+7/hn9nhGuaQA51L8L8f/QvhP9XyJixfizTI8QR453cfasETLO5W9YTfiCo9TmxP/
+dO8yIAzJ0XTldP8yUCwhbFnac+ftNrwAZm8PDthzk72R2HHhL3AfLLCnt/58/HYfOgMMYFvy2L8E
+tHgLfNKFf8ER5XiKFdRfeLMv/sKvzwCJ/HM8zHLs2DrBFHYnBneUAMXLbdiC+/6pHW/6pzAN+A9V
+RQumFjIqcS4Va8XKeTneT6+vAQ5sLriUC65QvwLOYX9/zri7K0DKU/YnC9Q1vsLDukP50Ob2Z1cA
+4U3qmK/A5Gp6A+sBzROXsSgPYu7EmzMYPHwDvRvfiNnNnVP6hHBjE9FiUzPIrDM5zoHJkbvCj3Vq
+7OeSHvtzaZm8kJT+ZD1KzxPC4X2tSiNTscNMuB0mt5aS9nS5uGeB0x9p8wKE7rk9XtDo90OyXFpw
+51TgUNBAE8Gy2kAzYVeFCwmdcF9lOvKV7juEb7l1Y6LN4KVh1Lb3Fhi1iHj7s729My1ZTVHjjvsd
+6mG/LZgf7nd/ZD/cb/URcZ/gmRGW+/YK8/83erq1siNAwIDoddHcH000yDVgjoqEZa4Bmp+wxprd
+R9bsfuHDiMhbDNk2fS5flX0CGFlHE5yYyC50CwjF7DLgvJ378rgg4YrZ7pNBw1wj6Qy3/LcorrKf
+vc9CppsGkQsF2Rqr1kpMUBaGBE2kCzJv1XPuNOInBmCHr7dO5/GlJSOG63wZoGeigVEHqfaVbXso
+HQoTzQKzgDEOg6v9Y8lTIKIIvait7t3mUtKNCXy5jYbmEkjQuIOAYLXJt4HXxW4Z8xpzZBXfsb/5
+x+MlRClhH7KlZBwlYVBapTi2dxuZWt2s+oGudCK9nnSBWuTRw3I+jXJKjIeFmRKjlEnD/PjLj/n4
+h4/5+HvtnvB9puemUpX1mvAXIk2NsjnCtJ014fqEdz1/pQW10PpqalPUwpsRDCNsBrTVrrBl6DQK
+clbURFLTP2aBuPE7Pb/QceYUy0J8auaxpDepplmoJ5OktCUySXbepCkvlgbOFLIgxW9TJrlarEzD
+V7shEUOimXVvRJpddyECJwEZGKufshQjr8ufQFfkCtdnaqBhPguees/Y1DRPuJJVsYFhiYFXSfPS
+itVKzDtUlVrXV5HTpZkcGY+nHs+vhv5XCV02JMtWWGtynRVG8UhfYxi26I69JKEOVjw1Oq5bcWMy
+GmgfOemKdU6BEKp1To2gpumYm/FzA/zcR2ceeX8DveaOHDK6+DiomcPh4tWFljfv94aKzVjGTBKK
+zFjQbEnszgiD2OCeR2mqsvkZihJlUhRiSWP8GEPMQ5bFQBLdmIphCVo3brqbzGI3M7EuK2Md/5a+
+XE5SDPqTlelKVnf186n05JbyGXE22Njq7rVMuwmDdrBams9oVubFzJ2KzlcmpDTXhfLWw6zex+32
+xobErJTvvfRjNjBrpbR/QV5OcT8t28RKc8McrnPlcN0E2tBhfIi2fbFI2kZ2Ok6KxkTQfb5Jvl42
+6qryG20kdbtaG0ppV4e2Pq7qzhbaVQaeuOqgI0M8l11s5uiuN4OHOx3rU5qBcKRMfX5Gl85g4eHU
+ZkikaMdrDHKRmaT7NiZ0rAslUAtSmnginUAVIoRIX7r1HlEdl0kRQCq1+yrd5zQrDQqtT4DvoyQk
+wRuUd3CY5jjrB0ha47QyQHRTjWrjRViUO2IytZlAlwSTGH0zYR0jNmqeG+StvnHSEgeuCMYJziS0
+ipLYlGRV1yC68tajmTWppXj68cxPPVJROa/IqS4t2XeF/n3yrQPh5TGuCcOqDT+8vo43/FjewWWW
+TNRpxuwW/lriNiHeDMcgcWJoqtyf73VBSJ2ztwuTjix45Bdygc09IQjnIsONswGUJmTCYk7WUNbC
+JBl6pt1CmE4V3HSq2MsDTuM7IUXrgG26WIujY2bbIv1NbkSQzNaK6F4IX8aY3Fw3umgSvQZJJ6nw
++nrDAjh2OnuhXKwF7EzOOSLlybwF5p4AyCxQrsRtsrjRZreMjSRJtN3O1gvDXiivTF6xwnk6bJKr
+6BdNaGynGvXqdOb7ErwvLZtfYqWl2PzJ9TVjKiNKGTaHqePfT0VEIp1TZczKQvPzz5ZynR/B/1ES
+tHVWX7pJxasXPdU85Q1+MYfF7XTidjvh93W4yPFHcI4fM+Y00wdd4TnhdNpYHl1/o2YZ9XMqMs6p
+SPj58nNq7lNCp4Wfav4OEhoLZw7TDzAI1SNCgYR0eyCGA4XZcB3oscKihst5mI9hGZhF7zrrTpc2
+7PJxw9gRzZzGgkMP93zuGxzHQgXrnGssx5yzHLYz/wiG42MmAouuzaTKqpTpWYPECVOYjU9Sbtia
+GyknbLWzU7mzUwepXNq4s7m7CC8bh/4lY24u8TWeN3Nf42/wTLdvrs7jeaQ5dqQSiZD7mAl6obE6
+caYLN5ynmERTdKaeYYC4ZkUa5Y3OSJeLxWj3mgWUbBQzR1dy+EUeNMs4hrU91sWhlJZMN4gncPUX
+Ilsjini48Cy8MowuByRg2d/a7bmljhiWv2whUqYqSTMLULttpO+eU9ZOi5WLZNjAKWHB6Twsiiix
+NrowLxZiBOS1k8UsKqsujaCs0rCvyuLIoyrqZMCaOl1bJ/D5b0Hg8Q5gwx0bRhILGYMCN08jAZcs
+yEZxfb3YK2wMcwDLS010XNsj1ww64IPUw2jlM5uYIwCQs5EzhYDY6HgXD9LEpyoIEkasJ9M1zuNw
+LG1tddUQ+bho6qGF3HAU3Qa2D7sFQs2sg0aIlvCQ2MNEghuxtVDZKQS5hkkjwR7njF/Gm31/UR44
+U+WGPjcN130cStR9Xlpyg81WfKq5T4kaCpCUpRCbKcAl5mrhVbIlyg5NxdFu9z6LRAj8bBnaaYcS
+i+a7+qBIFJIBY06MIPJeiEwppkDkJREvAZxKCIuypVikBuKU8OgZ1o9XJwexNlKQE/5MDxFaqZ4Y
+jQdkhzfBz6hMIgtYT/wUCiiuWdJUDzO5sHgLU/hXmbha54S4SyOtX+BCgWmSYNgTjOASZBRCB0hw
+QZcc0hNFqhYo5F6zbmXSnzoZ/IcJRSe96WYG/xGyiFgbgUnUwkkx5RvC79141Zc+Hme4QsFDcpZj
+6Vzh1w/wnxayJtPMgCQ09JgMq6EA1fnsacg1VFhN9RQ/pZM2w62NoT60NJMgiiV4TaSp4le2hcbY
+rBUtGE5p55JHo214FYFowsVadneZwam1AWfA9XWyr6VWPs/4/Wg9f8fudNhRliy5+xIH69ZFDGit
+65KWWGVzA2Ztu9BlV7cmuZay0hZIX7XVTlcxcpEi90nzVHyK23rbSgPtpsSLmOAgTrilPFsmTeQo
+RGy4BGxsjth0jq5h6UXU8CElZcXL71xlxqP0auzqhwwgsiUdsQxctm1XOLWTzAwiTMmPi1LYG93K
+6CITIcI3RJJjM3J9XWLxyUPn1rfTW8Xich6xjOFaEJ1MGyoG1eaXj6+Nu+23+lfkIpWwXQ/cgBY7
+Rv/KvPy2qNJDTiq8H2ztLlwLJJOVXdEQoXQeP7Dqyy3bKbs60Scy5qPtPdA9qlRNdogy830VPcZc
+l2i/CFwv2iswjJ22gSnMMFfv31Zp3kke+mr1uqg877AtkKRvzuPXfIXG2CI2b99Us7u/0w90Rwso
+y9029eQwidKs1uagTYz8g9gEfYeUUCQIjoUlA2Y5wGm+RvEKb4MYvGN+WCAJSMKLiJks4Md+jL9O
+otMQIE0ZNUAau2Hrg3fUt9FQ5IbyVsrFKZlNHGUaSdSD4yXAThXWm8K5Dej6Zl1PyDef6gn5JDtB
+Ax0j1vib5f6Qeuo/mC3PqDEumztkPAQ53tXTNxnaDGDo8TfFHypINQ6Hm9w5Wu96kU02NqVBNPpc
+fmX4XL5Zz+fyKyNYuVFpjYi3OAc94i/PJMtmor8oNb06pq0Zz5baq82FbZiOVoPYNvTbFIpX6+00
+Sy8O0c2asUelnJKVdpv8okTadjPycdnBotBQ1AxDjBZTvgEE863k+YPbZHW9bkhdLVAts1DJhP/U
+G/SfUlvN/7Yw5soTzxpFF1O/Bt2cwnpQYD7iB8X6+QDoyOAp3WAwRtWlmYepInMeLlWjsx9WsRGL
+qar4yipXb7A/YWecU/i/5ztoo2sGDBcmO8h0LxtDxAcgGHFlnoIZiMyx4KotiQL9jUYBmsaSLBtL
+VhoLMlS0EBQN+sHaW9hJajYxtazdbugDC+qvmHkExxsPUw0EZxnPXNBVTiOp9nHqoNdH5qGrZCL2
+/4O19r/MWs/HYzePvCmrbIWVT4whr7K4KKcSToX4S8GRYV6Jl1Sl3gdFTUrohwXmu3xYrJmYVZuC
+tDjATfSw+JVRyEsLXke1KTQtSthiuR4WH5uFuwH2v1nuZuF1W1hfFs4PtvPlx5EkA6Rf/ppDcE1w
+FgqYX/4mwPxVgJRALAPzBzoaftBHGPHjdjmh5rTpxqz7WwKT0kt/uECxj+uNMJytSnSQBIxJxDgT
+yHCIv7v4N+M9+AL88AdeAN2kY3kmam4TpClhaYaJuJaNLRX8gzJUB4mYE6ps5N8u/u2S3w2z7zA2
+rG6i9KDpxZdNL35oeoFWlNa7jFItvcs+TXo44JS401LCg9HW0q1syE/4KfBfKqtcuanauCwbWngT
+c13E2hudaAkMJccWlAQZZpBg9l2RUeTRVsd9UmMiU0RZIhlr0Zg0sU9TM0cXl8V7jBbOf/rPeWaR
+lNm7m0PLV4KlnMfqdzyjGKaSWySvE5gBRgK+1fpdJ+r8roVJ5H7XESvL8F5NakPNijXPPMvqYV1h
+yTWpUYePzGRYm5Pk+jqTPYj18gRMsjI8aqSyJjypERT0UXFj+ahxWEXzsIrysD5B7iu1sAalG6s7
+lOp6VDStmpiQcZcI1LZWhLLaUUR/nEFE9WP4zQRDfb2bpcDnWmqfNYQ/HvjrKPP1YWsxv0qadlMx
+xP8CWDwVVSk9tX5xyvpKDP8Z7RLuIDP0tKS8KuVg2GJlrWfRLM1OWqrua66DwxSQhbxKuZttHcfJ
+iSX9LGyV9FHzzbzLKzclCi2cDzAvr2EH8OV3cv0Tlg3RhLlMiogkDE1KklsyMeOtjGa01RKaU/I9
+iZB70HIbZzV+WI+ARHuvMiMtIpatdscK13HH0myJwpMTq+qK9SqrCYz2GB1pjjLUBhxlQgcAv2Tc
+E1lainsC5Sz4kYhXIksogNBDLbaRfGPGPRGlFLRIj3pivDFaEwXGF9WYKUdZOZILlFSitMiyUpQW
+KF8RcQW+aIi4Qm8aI64U1pPMOYAFfJKtqXaFD1GnRV5EDXyOhQWoYmaWGFTn01S1zwHbJZv1ZG02
+S53VpVqAhnW1ngpqVzqf0Wqk1EKzCrTaiDxOy400KYCrTXA+qtzAIoF6H5UolNJRwDHw8eko6JTv
+bmiqaaGeNfNSFHqFT8pJ2rFDSiZq14Va1Kg7EhMRZtErs5VWQbklTHjFSYHKsFmxHsy4XVNRF5IG
+Ydh0QRgyGjdukBaXwKSOfqLhwRZq8d433EZKnQAFycDjjmNcJiitCYV8cVxk4f8PgbDkRteEARoE
+L4dBU3g8lpwRt1VTbutqMx8fl66+l6yyUA3JGu9lZrLGSqWmZI1axaKm6iex6k+yX6E3YdxnjdpE
+d4qyEidRWqgn2UffwGAvzdx3Ucv7P8n+SBcyitnmjVyEryNrbYYbBxrncED6rzLq637mPM7KIeqe
+H/IIdS8y/0kta/60dEFe441cGN7INEIWW4WkwIiFWGOXlgQyFOHR2EPOSWP5nhkWbKYc8CKrWLMp
+b/pMeNNnGmAK3V7rkWZzcB/zCt/PfOguLfnM36vjhF8iJ/x1xljgl39EFvhrfTScFadoBC8y5IRR
+E/+CeOEXkhd+oXOp4g+jlAjTC435xbcrOMgXjRzki6Uc5ItMLLn/KGN/4aID5IG5vJcBmtrOvXWZ
+y3ufwFzey36dHYDBY97L1K0l7q2v2d66kzlfZP692g308xKM/uLXYfRLDaPvIEbfyXzo7rsSRr+p
+w+iHiNHfcozGP0R424P53LAI+rZOCPsOhbAvMn4X9IVa45cZ+4vW+Gdc4zcZRbB+s+4av/lkYQBj
+jqsQ1m+ytU02zqPwhKICaTGtMRBTtLnJAjGhwltZmPNbXLLb0Du5jKLXjQPFPoRuBX9zW7FSC6Wo
+yZzHKudWN86RUjx7SslZriDj22PPTlZpcNMdZ/vIbm9u2oX/gU1PfoQh7RACMOF6xZ9udyLOIuxJ
+Xv2vFUX6gbR5KIMEULJ8uJK8ElG8d2a4b8BEmbfpxyxz7tbXvBqsoZ5IF3h3KOASMWCgdb8wqakN
+w60DIvtIQNDtWgkQtfGqWX8UqMwt16iJV63XQsg2MbN17ZRXodwWJxxm3eUjSC/XGsBvahBF62Gu
+wDqL8pBydZtzqwn4DKjJgjurWPg1Ov/7lhbZhEQpDPqsSnRpWlR/HpW7VgaNzBRDy+ko49dnTmog
+PFK0lDstrt7MiQ6z9CNxmNwkTIj9isDOD7JKbm8iZp+e2/vNOgIKXdLqcFKUQtPIGxHcpc01QTyR
+RtXcJIaF2GZG1Qk3wFZR/epHVWtIsmJYKQkRtWNa5hyZyBjh/BJXfMyH6mhRwktOL29I4qAsIN8y
+vuh25nxXlTleHNx9yKSOrzL/TS3T9CBbHmLrq6w2wzY/dD4hw/ZDjZW6jazU7cyHQRgZtr80ODld
+BaFtTTgsfVRSyaNSt6gt1PkCbK+619CueZ+/vzhO53m7vextqaHo5qsMWTLcfl+t5OS/auTkv1rK
+yWPLTdG3s637AlG/cABOmfOBO6/oVxvfRpZG9XioH5l8U9MflmzclNFcg1IAl4AAj0cyhnNK72Ou
+HvIArLlbwT4fFtwUHD5+8LzpAqbebSXSExK3RHELSBcgQHpKXl0PngfsgZIu0ySwv1iHTx7/Ibp8
+8jgQP+q6FU6RDSB5oEwM8etH4WXNh7f5GuoulvQ5w9dVy/2hzEKut5zKULRmSG+KpiFJQa2mFsj5
+OUOBoIRknkSM+ppP1qvZDOgvNdyr/2qut69jM/SRm+80T1eJPwW3XULhCMhxHczWmANmN6ip+rxY
+Y/okk1Urtyb3uSvEtHXjKOnNq9XAmfxd1Cm8qNO61epU9klJFgQ0AWppb71K48RqObdaNqt24zC/
+6JpJnXD1Zyz0p1WNN+KUUCd7TYxwSRVd6PnwCuHBzRN7eY1CrfJhx69RxV8CUHVrZVrUg8atxYND
+s6hqjHvk8ZulFyyeixsYzsYBZoRdBtUvjoBYlKmEd+xC7DROTrxyVgCNMcX3ZPNumLgkAVp8oaER
+P9nquzVm7pRmWgD5o1v5gnzJHEQAY6nM2pEKdxkFrVYn8lpOi0uMrZazHkhhZAFCzcMg5A78hxqW
+DR+mI7UXsDNaLZIWbxzkA9ZY+Kcw+gud2tWBXjfERt9UcnOsh7yTjmsBWNYE7PWCFKbtJUp2r6PQ
+LAhRYKWILvCt7SXiXgNDkLMPKCCSGNaz+Oy8WIYVRvhPyc4qEw/WjPDEM4RF/vWSba1Zz6M5BUpP
+y0GbVLAad3ZtHWZJxS7iIsQyUmLgdUsdlRAdGBc1RELXQYtn8PHxovg6zBttRpgygOevivNDVMvV
+fKtiMkkCa5Bbb6OOnsk5d4mQUSLaekC+tqLAoNP8Yhm/EecEVb/zvr6BItP9jKI3i3BeS3bfRwKQ
+jLC+L52oSjfGYrsckcmn7gqHEpwoHosLT/14+X1mlw5hLf6n5sBT63YhDYkLQU8fpw0w49SRwUli
+kiSXDbsnW0mjMkn2JdXPDKqf+JPUyaac8CesT9iOXqPOtJ6GYz4/9Lym+iaSmqlIlux4alokJ5EN
+1QNgzdbkQI0mq/Nbszl4wTWVp3HWvBFpHk/wq3lYPFpFy+eZQct5eIdlFCSXNfixq2FiA+P5g2Q8
+DaOzGhyEodfnsaS8vsjHiExxBWvtfuIt90F3Up+8aMnvPDX8zmW0wEwZjlsJeteTNTi3CQ+dY9s7
+to1E8Tcqg9hZli4um4hJZhCT87CZgWMWz8eogjumL+8ny769n2hfx/nzxXHZVk9qtmqlNsFQBpFH
+XnjaWWKtjNZJHCn0eYnIWder1dQrHyjv1ZYFAj2AS3ly2jhvsQlW8bnsFm4dQfebCoW9ceZLDrmK
+F9sWbUVR7eHS4TdsbZozabAvwnd1lS8zddLAJ024dqlZUsCHcbK0raDAYi9N2LcrG8XvC/yeWs+i
+VYwAcgH56/iyWWKhzx6FxTmsxDuLmbJSlRIBrxCghpXAqngHK0t4c99heKvlRDCscl1Q8WVSxPMl
+1Eo2XmK/mmBZZsG4yUsRvo5WQKnrlOGElT4RTli1DCcsWwNOqXlYYK1VQJIta0ASknvzLjN3JGpR
+76YnUTOjil+gJ6p2URAm3G/i5oZxVz/gjarS+f6QTS5Jcwk/7kz9H/gZkMPfXHmFZVybKAp1Od/P
+EiyOkxyjLbCPn6eLbNZox6XkshusOTsP4wTr8YOaytKkgNLc/0H51qFiM3E+YMzmJaLG94WYLwpw
+h2VRf4k7Yy2+NMeFkrzcJHTSqfRvtEs+5WwcDw2xs8H2qnYAPNOCOY7ESavjQE0CHwHVkSv+faaH
+fVBa/m+yksGVitptvJnADp1qMZ/1TVYVTaKGO0UtQvDSBjZXN5BpDbRywiXteA1Q/7rFiuNTdNzy
+OL7pdhWJhjsHugWEigZaSna/R7FJ9suxSUJpcMZuQiiWrooDywSaGXPxz1l+dGxIol2eWHoorywo
+SlGiEr/vfpZ05on1BAmW8wRNtuzrbjlgFHBsdR/pzcnG6COzCdaAeGEb2WTyEkXzyRSuP+j3Ru5u
+f7sP9IyK9vbc7WtMmLXpbjuDobs72B51d7S3ff6272zzUqvwraLT3x0OeqOeOxped+2fIvsn/Mwd
+Or3eYLjb622zRu7S59gUe993+r3hcLA7GnVHtqijDXteWsGfik5vuD0Y9Ld3hqOOFe3tDW147O/3
+cMr8q++zySkRxO8FQeT6QigwKCLTuItSgyTWngMltCzsTgtD0BAy3yBxS/HWZt1LAtf+BP2gK/SD
+dLB8tHgZoHTpbbqoiIX6JR5PM/UQvJwZgoXyX8LmKRiXeH95G5KRXNbOR6ie3E9RPbFKlzW1uJVh
+SX9Hzom+4lKuC4zRSBnbrq97PsXb3jCujscRmSNEe92A28ovkkImu5f6ZWFUwPkgBRIxbJeywiVe
+IoKPakTN6QnfI6oedQrb1iTxNZBB6gCaEGKpKM5cjD9WqibYNwnKuq2YvdflPg+KAIvI4LqGrd0G
+6q3sVbxVsjZ6eUampF0vuhqDYeHHa/R7lRHCiSLVfZuu+JZvLeaXQp4Rl2keLYPZQtdlUo15FL6N
+qlq4CX42rUEQ1I3755mlcx7Ol1vpqcMi0+jhLpXujWcjy3Qz5M8iYSTnnGgesY2i6CFis8L15WIn
+4tGm+4nyDKHTz0t5x3NBAhJnCah4O2i4W0O79E8lqDBHDst4X+qJSRCUmEKZMJzT8ZNWYn7AERGW
+/BUrcgbzqwrIOnLlbZlwwlquKNBi5mD2ipKXl86g6+8kBx/WBCjBqTx0NKbUxpIv9TlTyQ96k1Ry
+FFWqPY8q9Q4is+IHce3poTXsG++BczedzyOarfcuclA9eDtytGvuN4VD96/PC4fd4ZIdbeE9yRzt
+Wvte5jB/Ve8d/AqTs8g7jKDoMgoL7zhy4tx7G5Fm8MFz7zy6uUGRB7gPd8eL/P0PNcohw84miLai
+d5cgSucljgIjXqLDEPAaR2jx3xjUTYu14HzQ3LA8aUwZJYCsDDYbrnOVxQX73cXL2tP4bMHfdSkQ
+hNc0IhZNQ41pXLLSsdHyQBumPjB9+MjjZHqJEYoOIIjw2+31CX71g9HNlrC5O/P0WNHjJJgkIM5g
+PG1gt6ADD07mFkoc8YwsdD5PZ0VUbEKfUXjRurGN8NJXQJ3Tq60kfBuf4Y3Q1kX+HEgtdmEveYdB
+i1iCTq5p5d++fPZ1u61+81VjSwh/B82vrNT2+Nur6Ph1XNR/48T+STojcsTfHs4j/MtqhS0bUwkW
+7+fR1kkMPE743m8laRK1nHjrPItO/dChvHgHBfCsxwvAphZ0l8zT8KRlZAeOt0Q5ZSQ0ahRADaOi
+5bSOjudh8roFx4IYz3F68h5FvSg5uQu0/MSKYbhbM1iH13D6QDMv4osoXRTG/ZdZm7lcyNoauIAx
+TF9roKBsWr1uFwOaOCO3Nxp6/EYC8IgxWJ//9GPeuYZ/tz8/c1L4c7LZmXbfTbqbo3DzdArFsRNC
+cfd40nXZnzH+mcIXO+zv3L8MgR29nxTO3G+l1Lva29nWWbst/ttiY/NFdFEqdBbVWnk0P2231f/V
+iliKiXuurxfX118IWLU0Gt6yAaKXgjZU7fudU8mzOuf8Z5w4df5ms617gEVbSXoFtEzpEi407p3L
+4fz029hAJkGbFmw3ne7pMVK5JN0CmnQcZZpMb8Surx5nrZwM87QK19fVr2gkFfhGN+TV05qwF7eY
+jd8UPrhkmhU4IbUgHY/DxzgGnK+YcK2On+jrk9NA/kKLMGD2L4BOBkWn1YITGqfK1RcblanizoIT
+wOtgLTSRhx06Q6e7VkuYnIRbRZTroS6BlMWiLMgtbqhsAfufBT1v1/ZS+RbmAU3fNJFQLWWpwzPk
++nAkoL3BJf73Fo0zcPxy7toMlkdjDaXOByaiYtpqyWRhm6XK8DUVxs4LOOow4whTD2GiHi2ircbb
+LHxMWKcRkEMkV7OAVEGxFnzWkpkEos15RcaE/ZTtI7pmKF9coj/KYt/XwvAeSsb6hIVjutLw5B0u
+S2kUZW4ROOtNC7qW63cZnGMuKSxc2Bj1h6QAFY5WDw4soPK23U7Y1CwNVnr69SN9nMDOX/EIuokS
+X7mZOuap52HEJRhklLxjzI0Bby5ldrMKjN/DJzdlOOaUwaD0KeankdqkMwvTAXSdCyvD6PCAZRuY
+YDk8QdIU+tal3wLK9F0YF60YkNwOTq0zCwNBUxlVLWwvBKRsFVkYz3FD4YcBtiNKvLe2cwQ7OplF
+c8PFWqbcbbfJ00EMM7cJ5xN/7mNyWvbdDbRxOi/5CVVmHMTeO+uElL9HcN64u9vbvcp5k1lud3e7
+Z1uZtb097I9spwWkNfw2jq5amH1MbMwEGhjtjmrqb7s7uyOMJp5Zu91B18VUCVC6Mxzu4MmfWT23
+39uhsNvWrrs7HNpG1gpBvzAJgS8c0zCRCtdlnPJ7DpkzvtMp9rKxjMc+KaZjGdKE4oehCRmcsPOK
+80ji6GUsVoifGoXoIxYaJShexEYJxpZC40ABmjlwg7uD7k4NbHo7ABEGG3fg9rYZbHZ7rsthM9zZ
+dncZbLYHO93tfwths73T3XHXwTuQeMoot9vvD+twrjfY2d3mcHV7uxyuo2HUZ2AdjEbukIF1tN0b
+/lsJ1j5spnXA+hQEvziPyqDdhs+216kPImZlWWAX1/VNy8WWZdQduiO2LDu9/q6+AvFHrMDR0QlQ
+o6MjkqESsRD0inylYSnsm7gUvSUue1OmTlwCb6gBM75xBkPYwTUTon3NJtTfGQw5ng37OzsjuX9H
+I4Zog8HO9i4mm4JvB/3uSJvxoqRVNmeFB6HmMsiCqywqqJQ6iyoqhUYholJslOBcc6MEUWmuzX5x
+4wyBTNWhAsOALcaLls4C1x2Mmqu8jJNily5rzWrQz87OOkj3XRS+rqEHo+HuzmCp1G1/yK9iDMGV
+ybxSszCPbnW90k2lPaZyt1zuoDaZv+zVvmT5RtgX/eYvHEy8omWc5veJFLLT2dmB42DJRPQIOJto
+XVuzSTqdbE94lmHel2yKMbm1NB0R6ikGo2H/13XEs9hMpqzHscwJCz2OCyumXoFjCidppzP1Yznn
+ELkO2FrDOgre2x5wCt7fHo4GbGfB13xjDQbuYMA21hBwZsg31nAHqcqiKkSacTLHTZNVYRlQjMja
+bXQeRmECr0RmLBEWyKHiz41LTDkGRed4dTIDBhxEOpBIzwO8L+XgYdKr7U2mzoV/opMw7O3sFjCB
+kb2BmacWXJZzzoBdPEeBlH0MItsZMfcgoZ6ewg5lBS2Q44Evxj/g+1N8fbw4PSWZFF8fvy+ir7UG
+qOCJbADq5NaZc4Fhf05YHqszdWMDa9Mbjfq9X40bzHErsQV2pOS1o+GjTBqDlGawu/sxPRaqHxPv
+x3YE6Mb97HSEH/aGw3UVc9BF2HT8YC5XSksbTTqddIrTSzEdKIhLaPw4SacglmpCL3S92xt1d3+L
+TT22UZOpQGgLg2uhzXCht8Ggtzts7E1PGHQ5jwsLBHasNOr2RlSJHUefT3768V23u/nju97pj+/6
+IfwYdH98t30MP4bwYwd/7JxOO5+fjVd0c0GRD1EQmkwRGEOg5XU8wojOUdz4Ozu7bt8eN5NyS88Q
+vJHSce9gYiUp5GC5VbAtZrPUo5zC9gcwgnX6BxT4NGoi9BMx4whDsbkxoV6KZNHWr+CAfvBxXl9r
+w3R3ge2vGeZS2OiIJDGH3LpRoCa8wVOoUB49HHE2GeJ0+3WH8Gi3P+xzkjzcGTR3rpwQ8aoyhTWn
+SJIOMFG7w+UN77ogEX18y7RotfzZzs7OMgxqHR3Rqh4dtShFVxKwVx9K9wrGDUSXGcV5mX4TcWN7
+uMw+bXRg+uoWjdhIgVtwwLNDjTBRnGoAen6qIbDYqTYcANHCUw0Fxl08meAk3N0FaRBOJvg5cl08
+lIA72u31B3QYQVcjACQcRQje3V3o6gJL+7s9KD3DlkbuANp6S1DaRnnzvTxgj9UBe4XNAjcPnx7i
++34P5dF3xLaPevDzSKCD81yun3PgS03lgdDeTFvOa1UsNMBQeleVso0GZU/QCfTJ5GDqP5loTcFJ
+Mm1Vy+7QqVd6I9QUpeI7aQrsclL9OCoVfTFPw6Lfq+uUXg0Hda/uC8a2Wu4OG17UdwLsbankMSmc
+WeFd482z6OzwXflzEMzKJcSJlAu5KtkoVAx63Yu78/DiMjppel8/UXyjzRR2kfaS1K+swmujFmf0
+qYbrVDcyT8zxyrnvPHZeMIL71Hnmu+3MeeT34P97/qCdoUrwFZDbp/7jAPPGs6+9V2h/pvLMPxX0
+8CllvTq0ilJ49K991ADjy69J//iUNOXOxjOZ1hzafsoSf9JQ7vjoZ+h84d/x/dfAgYlpfRklzFtL
+7QPfv4MNH2udorzwjLqD6nevr+H/g+vrL+BMe8y7fwR/Bh9uvLfmMB4Fl1A1t54ijfdm8Dtmv9nI
+cHJPJndkpPHHQeHBfnuKOk7nDnR5c/Pi+tp6weRPpsj/2X/BQw3jeH4WVX8ev+DBgp8CWaBrg5rw
+OMBRPWUCuZXQaqH73wsWfPPKwgOxthYakD6l5oGV4jVTWZOG9dL/WuRWtu4Fj4Jz79R7FDz3jmwt
+T0JqvYTT1Sm1/BIzMgJ/mPrJFCgxAKiuF+cpMgtuvS6FUcNS4Akg6npeBIWo9oebsj0pDzyfqEWH
+ddBznOMZN9bvnsWlNS5NpIITqQ+4bj3De3zt5AvxlNzdrdO0DYCXGHK1zAjOBRu5dVUzBZYA2JDd
+lUyzYDoUL47nW8dKAtfbdO1xEoSbm16nE+6lkoMNpyxdrUxLpzEiPbe7Uwd2khKE1gW1e+NGukD3
+QowqzJGnXkiJgZj4ELA89DHFMPzANMPIxs/3FmOZKXkyB+lhvwvMvjWzg2zfDSJr5mQoGVDTXmLl
+zsz2YmxgIizspv5MSrk5zgTA16+Tcre7wPlYJS0GrUfd19jKxzJg2EqKPNIIWMa6VnfcXeQfsFXg
+Pno76zCVKO9bhZ+QG4+I8jtmTs3Qa4aCUARsb4qxeEHot6e695/vh0Hk8asKYAtRXbh8mYktWcFm
+h75+yYhyexDC6oQOy5tIAlF/VNsPKtYZ+zkaoj4PubJev0/KYt1H+0V4xoe9XNgRcpsUQ6JAHYMJ
+i+9+Mm152sk+hxPYi9vtGGUAmcozwGl4FA0XRIHlehqtb1qEwmgJEWCwXafDJLCI3dTtbtvL55aa
+F9Eae0c5wamj7qhfr5Uf9VZ0ZG5befdG4hFPn1uAfCTy6G6kdHjAI4ODZwM5eEyt6yWylcghrxIH
++17OkQ93XFeomXb72y7jyN1hdyRuChgfPZds8kKxyTOlfLqs531P/TIfC3x6lfE9+WRh07lwzpy3
+bC+890krdeyjIhN4+PfBqUdm/Yf+Mf0skIe3rvwr37+EM/PK9v1zYOWtQ/+QlRxSyXP84BAPpOft
+9oKsCfC4WqjjasMdv0de7h2wZzfsu413gpq/hUV7y5kI5/319YyjtDZcRG7480oWsLykwL5xy4UD
+/127fSJkZ5DXrjI0xzkBic0GgeJIvixKL7Eh4JZes2bu+gfCygFtHEDAeB0U8m8ZXMsY8Zl113lC
+A3sLKCTMM56j1K5/FhsTImTb3t7drRV0CYM+casRGyw3WW+0vbscoWkbNlNN9BJi6gmhCnfoWsnf
+oNywnIaJZc557EtJUMYx6hOoBdgHk3iKleaAJZPeNFhM3CkGzJgsJt3p1Nuw8MmUMFJDRQmjOp14
+L5enrcVaslE5cQm1Z1PYNtiW1rZ574+5dazZrVLTkv0+5/dCmAbXxoITP7QunVNnRua25xzbZHMn
+gJ2nzqXTB9pxbnsnWps3MqgBHee1wO/1t4fiOne7PxhyasK4xJguwKk0RzMusaxbneCush78cXr7
+c1gDIRZoBohVpTaaUynrqEt/USIVADwmGFqtn1qdGdsml7a00vl88uOPP93e+qwTWPbkx+mHm+vp
+52dO68cfb7db2ldmo9eSi7a3PguswP/xxx8t+/oWLCdOhRVMbWzotgtfYFyd263lmL5hbeAZd31N
+oTqRLUdCAXTKZpZAMT/Be6Nez/3N9xXJyXJf9Xd3am+utGNy4O7sdrnuhrqDtUW2vVl3EDfrDuIG
+3UHcpDuIm3QHcZP4Hq8Q3+NG8T1eJr7Hk7qDzqhU11BJYxPXqmbiej1OXNXYxDVKBB3cUr42irlK
+Ia5TrmiF4lg2CpWyJa4oW+I6ZUu8vjqjlAOB7p/ERSWQuo14gp+g/ny40+vWKlPd7R0uJLjDQZ/r
+GIfb29yihLEuyM/0gdl1l2+VOqNBYN0Ffxt6VUPFIOaHPBLxCG9CPXJez/mxtdutlYN2elwC3R2S
+acanKd2FNK0EytSS8VomUxWB0+CNbamaR5V8S7Plbm3gDULBLsdUOMkCufFu3+0v0wnIWRGd6A9G
+dAb8NrMK1axS5rSpTS5nx6FVmgiIuHjJGIu55khpMza13LinIhSqW6XRtpTHRtx4Y9Ab9gdNSKRG
+KJp3VTIQgCugCHILIeVboRsJfCLK6IEzdUcnvBvJWIQOXANE8Lo9QJwPW4Od/pCbnO1AMdezu9sg
+6nKTM0A3ztWzuTC2frkkzIcU0xZFbjqYWwvmMW8MnfFXqaXfcyqLdFgM8hIM6T0JLbHTp5kNeqPd
+FTcIdIHGZVWmGMCZbY92dvnMOCbOlVp+QVJKf9RdInrNgP05tT8U7FINh6bUZqEzJ1w8Bfb3VLC/
+ObBWdkx158RXYf2xxoJdBpcW6kXnMFhn3mm1iPs8tYUoLQGCN97nfmjjxQ60dU6uPHOkGjSruss6
+BoNUXZLQQu+4fQ4Oui/J1R3HXNkTLCQxnBFu95Azu1Qi3ali6M4VNPFCZbg77HfZhQqX+c4kYOlC
+ZRsVbUs47/fOMUg8hww/3vlnRHlA/Doj8w+Qug5J1XpE7OlzG6tlznMNqAf+VXBlvXOOnAzhia0e
+CniCUCQheoANSAloAS2C4LNxt92+xJ+v6OfGk3b7Avs68I+cu9fXT66vXwUL650dgOjlzdiPGB7e
+k8B6jcfWAWD0EcXe8F7JolAUHQAt8k6gwevrOfwfWNCMM2fNvMVmzuE/6PYUHoBHB34OX0E9bOfm
+NaDAIWl8j5wDEGCtA5jjewKWc8iNmvBzh8HkgG2Wbn/HXX3TXROIhmsUhAa5mERTbHAHKGAtXUEV
+2grmsq4XnkoPb3OHS+yTVo9UqJToqpEa7LrdnRWKWS0MA8reeiIbFirCTwIrIX4EJGEir1jOwjvS
+3qvVzhFHQezwdn+bK8363e7QXUk2Q4vpAIB4RB1md+BCvTrOnt+NpkpPGSqLn1hty3wVzUbqxM7R
+WDtHI3loLlA7PEOlZmooNYEizODNqR9x9eZpu93pLPZmXGg9B/QtJospUQbyNdBvlP3z62vjGOZF
+8vinAsNvZLHhX7KmL/zTyflUkUfrxJ/DOXPhnCviicLSCezOCzu48PAcXXTg+AxgB364sW8SEGbP
+nROkZdjUjWYGsz0EylanDd7e6QodM12eG+xjGtQuKC5nSwiireWX5y6/PE9IM6Xfn9944diwunQH
+ve3RCms+Nm5YIc0AqNhD5+bC3yz206DrpR3oycr8DP5Kvcymt1kH1f6pX+xn8Em2iWEZug7+73cl
+UoTceCnFa4EEby3CSTL1o0nSKaRGG63Ytrs79bYsS84BbmymEr+aW1PbthgMreBFtC03NnBjkonc
+x5gTsclEzBIrGtsJs8RSfG2Ctgvd7b67XEtOdmFlgzzgcAe0EVFhLvFbnElzPw9UTJ1mJToyIcy7
+qhwxRcUjIG2OdkvKNiwQEnwR69enwVwYc3qtlkgmhx9y6abbIibH/bzw/c37gLNJXLwPWpvdlkcA
+3tke1oJiZzQiNQ85432+wvApiGRABCzvuEq1kqKblIfbEc38P+lcoMxYiIE7g+6K2x23SwYbxLV3
+e6iKj9fmdMVdj8POISvySW9LpoN0KN+KJrGF2ngSTl0gIys2rkpEYWxeGJ606SOtCt94NCFArr0w
+KGAPCgzKrBiEzGQK0oygbjGCc7B8AJpZGrqXF2Ryi5CqE+gGwmadSw3kvrK9jZcFMYkVu/3+SgAm
+zOGdDj6gz9EUqLVQaw26bi2zT/Rv1bWXRvekoKTM35DgOSj2ZftAvJHqSsuynUG//lIbTbeXS3R0
+92wkzIy2lLGpreeOQFxlfhDclB0DHN84xK1rnUeYQOnixJKBRKSpd4qXOhvFVpKeROioB2Ijet61
+2/BrI9KKI1iNECQWOW4fZpxsMU2ToENwrgTxFtCEdPYyycPTaNl9XjmJhtjIwrNR4myC1A3oqFcF
+jCKuWH6JhrBOQvsVhOy6/YqrsuLIAIYVzYuZrS/myGO/dLCXBuGw9WG2v46xWCjv993Bjm70+ePV
+Z7c/X4IBWS0G5BRPzSmgWjTTU29nWzKSDsJM/CbuctAduEsvZWuPE0CAIBU+q2tdyYYBV/cIXQ9I
+HB+I10dh8U9tIeZyEUBSXUqwtHPcdIPBUHrSyFpaWaPZM7PA13RWZGi5nMtm5q9rXBptwDSh6wxo
+tMpsk+MA58rGotPJ9+bylqiY5FPgsUNUeADLDIQb/luQBXNFFzAjF8uIOOs4QA3KAs0sEv7jRlNX
+kSnkcrPS0Qjo9To02kl53EN0Z3Td5a3CETDofWyrUKXZMWYC0sMszaLNV/lRfh5mdJM5Na1Deugy
+UdfCaGco9L5Dt7dCTE3K4YTkWRyq+0AgqPtukE3CTXeqKGi43wvQd0WsGS5+LJFyv1+beyQOrHBz
+04mlliJH3bZyhslRkoH+9vpCxMU4Bi6G/OEbt2BMeDgW5jwZnPvjORB+0hYljnIswZwgiO5ozFS7
+hI3w0dZNj4zNsh4xqwNlM820spmSJulTTXrQAFkEoQfAzcVs4FywiiAme6h4L7S5n05q5ZN4StYP
+Y10ni3fK3SV+CzUsYtkmi5ZWwhItW/ABB6LYrLm80J378SQKcg8dG2iWFLfLCifzKUA6tO3jLApf
+K2gTaanfhMTbcA1db7jLtca7cOJ0V7JPtXxv4SOLz09gZDhRfaywyufhvgqM2JkdYEgvVPoFuIyu
+yPYBTHchQ9/KEIfAlFl2Z86it/RH/dpTaSg3f3/X5frG7d2dASn3xW3r5Hf/z5/9k2nLaZ21PkVb
+FBIjLaWEGKUE24kcUpXgzQ/w+rViSa8rzPgIw5lKptFSrB5nYsFqq22HGECsPUcOhCUsWeEj5J3a
+HG1zC8FJ1jc3N4z6R7x5Ae/F/qYb5JN5gOoTbzGVBmEww+5wVKtzIsXrKsIGjLbQkmFbo36tWzqp
+4mzrw7/5s17roOX8mz/HHv8ue/x59vj32OMvsMc/81ohPP5j9vjn7PGfsMd/yh7/GXv8Ja91Fx7/
+hdeawePf91r34PEvvNYJPP6y1zqEx19hj7/KHn+NPf5LrxXB479ij/+aPf4b9vjrXus+PP4Ge/xN
+9vhb7PHfeq0YHv8de/z37PE/sMff9lqP4fEvvVYCj7/jtZ7A4++yx99jj7/PHv+APf4he/yPXiuF
+x//EHv+KPf41e/zP7PG/sMefeK2X8PhH7PGP2eM/ZI//1Wst4PG/scf/zh7/B3v8R17re3j8n17r
+PTz+b/b4iwBrnO5/DpDE5z/xWi/O4fl/ea0Cn//Ua+V5y/mFLdsvbL1+YQv1y58j8P/y59njL7DH
+X6TF+OUvs8dfZY+/zh5/iVbol7/CHn+NPf4Ge/xNWrZf2Or98rdo9X752+zxd2i9fvl77PEP2OMf
+ssc/Yo+/S8v2y99nj/+APf6EPf4xewCMvoTHP2GPf8Ye/5w9ADhn8Pin7PH/8valT25jSX7/Cgvu
+rgaaKBbJukFBiG5JM61ptdQjabp3h8WuRpFgFVoogAOAKlUXGeHbDt/+4iPC14btvb3etSO8G7HX
+l9H/5cx8J4BHskrrcChUjwDefeTLly/zl7/Fgv/KAphsX0Dw2yyAOQcd8+vfYcHv0oz49e+z4A9Z
+8Ecs+GMW/B5NjF//AQvYpPk1my2//hMWwHD/DAIY7h8ggOH+EoI/9aw3EPwZC2Bkn0Hw5yz4Sxb8
+NQXv/w57glFPIPgLFvwVBe//Ngv+Lgv+Hs3O9/+ABf+IBf+EBX+fpuz7f8iCf8yCf8qCf0aT9P2/
+YMG/YsE/p/n4/l+y4F+zAMboJQT/hgX/jgUwKjkE/5YF/54FMHCvIPgPLPhPLPgvLIARg1n3/j+y
+4D+z4LdYACP2GoL/xoLfZgEMVQnBf2fB77Dgd2lRvP99FvwhC/6IBX/Mgv/Fgt+jJfL+D1jwP1jw
+P1nwJyz43yyAofoWAhiqawj+D62p939Ki+n9n7EnGKpfQvDnLPhLFsDg/AjBX7Dgryj4NZT+FAce
+8o5h5N8juYCZ+h4mc4YhLJRPsPv/Glu+rOktk8B4pfU2bEyaWnyZ33C4l9TmGLUWU4wVV+GWOjnb
+t0vY/Fw4abjRckx2hEDwawruGe6MvZ5JxZXwCPj5Bm0w+TUhConWHHUIUUicXdCeZKxO/DN1xoHd
+cbzFFNHm29uzh2NNNRLTTv2EOYzAe8REs6GYbm9L0f+UrM8ufT8aMDU14NauUMnmAm1YAhKe6Px2
+QjIVZFfdhNtfkFx3Ii8m3vqIyOjewKlrQkxcTApw5/48iO0b9607QUx7aKAX22/dG5e8pLpJFUnu
+nBjfcwdxEuN0Hg2gTr0B4/7gwwW7UUEBrN0Qm2yF9gXZOtpv2c19AeVE1K+JI3nmC6aAQI4qnFst
+e1Lia2FloB430LsFVZMnr8QUPIe4H4ywU/hv6KArOiPuHWyQajO5F79AJsPPWGkFk0XesbQz7/eO
+e3SFbJROjKGP53eRTvD7uTm7chfABAyRwKD/5BF+t5LdbMEkVE+LhS5bEN/Yk5qRiNLFpBTo71sI
+LCoFVhS0PHEFsa7grRmJ9+iUy34gdm81V6nc5dVbF9XfCVUsT94XtiO3XdYy5NpeCmAhDa8iVC/B
+EE6FnauoKMILesV/VjMQelzVwoXalqe8DdOVQSUSqXB5/P6v+okUwTy2iHvbpHqK3g5g6QPxIuyO
+LV+g0m5NqqTiyp9yUkHobWKNXEEVBunC77tTuewp/gWchS6JsOA8FxNJkM2pth4uapXkNn84o8aO
+BM3jkjHfH4t7k6VmUE6q8CbBA7M6zT5cc71ipYOUdk7amLiQqlSWpMlCfWgrUb0njrkzfzyYyXMs
+Kg/P2AnWToIp6kWVXibUxaeOpt7Lx1JQ6gn/ySg1FCZGCiIhpZ4ISo1EelAoalwoaiwRHvwEKPOs
+QplZzRh5no6UfvK5n8B5FsnzlMhzgQrySPemRJ6LusLyeYDE9WaxiDl1DDHJeZVAXsD8u/Br99BT
+Byn4FXTjBavWtV+RVbpPYJpqzwO0BIClXlUr4wbrzbdo528QA11vb1/DMBQlIpyxZ0OsJ9vbT/RY
+UK6N7VE2VdrULmqk/uSka1QSPj44ONy/j8ZCTV+BE3dNaWGvd2KQ4X8AomYdeQnWk/H0i+Bomjzz
+rud7XGYhMwIjs/D1eTOpJhPTrLbGN2Z+0D0wCSb2jrq9k02i9EiiHqkb8dIJ8mHzJjgQr+DEExaX
+1shDmL0ZSVdPToz38KTjl23oLrFgpVJlWcFOEFZmiLqBmA7haEBS9iGSrxT4q9Gyoh96bNQhItsB
+Pg2Pjrub1AP8rOoAFQ2dcmU7d3xw0t83i5NPHFu5B/9a0OQXU+5SvA7ZhZZv6xikhvFB6Gd1e4MY
+Xkl7hOJ+pnOs40OlneoitEoBfQwnBfzhiysCqtlWVzsIMAGn0GxVnYVaIAGlzT15TV2MoDlEJw4O
+zCpHJ0LGB7P/6MSoizzjTX5aPJG6LUq71+iPne4e1xgLDkeebl+DMwoDk/80DUoE2eall2mdWgAF
+wSW83qKSJg6HxWPEJFYNLtY2JDCvmeFogDol6PiVrnmjiuJvufTiaiXJaMMomDs44IuVoAG5jBXh
+7LiKKyLTMZac8MK48i6zz5grK5uxX+XXdMs8DnpXtcsj1k23ypPa+sAPGPhxOKHN8T73AoIMNT/n
+sIvDlj5HmGc4aCE45zVs/ogVdM0vwKUre8Zg2z0Yvy1/slhkIk4Gz+PFIsTnsJNHRZa8RcdfcMaE
+bV7EiuF5Cmcq8VzAM5oI2NeGVZUwctYwa0DVx8qmL04wMA7BHC+0mVJN6vCzScrR0q4EdzxhMGcX
+4nnMnt+K5xl7vhHPU/Z8Lp4vtSsbzRzimoygu727Grw2NCPdfr+/XhvGiCrGFQsLZeSTbFYsVItA
+KRGmdSVCYMZ0tcEpWmbi5Sbj52a+sp2GTJEzJdYNj2vAH4pOggkAmcD0CGbe1pY9NgBEkT762EGk
+sqk7Jtg1ZVnF4K+OdYAneWVxejrvd7sTCCbHCPgE4XQ6haC7xx67e4f4OI369AjhlNJM6LHfnbKv
+3YgF09Gmu49SwDwzFYSDigrCMNz5cTT8bOeXowX9ve0v6dUCkc5H+JN94z/o7WL4HXuEh9Zo9+6l
+E0St6aIQGEWzvq9zWwNqDFK6H0SlAuUpxKctGkFv11zcabiPzNUFnC7YXlUpYhiNKj58Mf8d5qSL
+NGoIUHdlGz7wdGZEpWQUQWhAqHpZZ2dJNgGGjHz6nc2FoTvThM3FAs3FbJYHscgJMBvF1RAi8P+f
+tkjH8soXDlZFqxsOYc+MULB6ftTdE9XLk0PY1kce1nHPzaF8dGuruZFa07Mec8GO3DdiO2lL6MP7
+qKLZtE6lKYfTW8Oz3bA7QpMpYbBNnoIsMupkXoP8iIUuPs/mJT1DyPSiCJZqpToO5wp64vRGmktC
+j3G/xw3bmP3HBr25pHrcHfAdrqxJ30zCMAbJeg/plqYPlNjtelpNwKfpU+e1WFWTUq/5TdqUVr9p
+RqWND9LSs/FlRUG6XanhS9WwtBlhRXkV01LRB4WhD5jYzSBu0zt4YJYlrhXysZGpD4wUEOpWd2Y5
+mmYQRvoDaG1kOlv0ek3+m5kJ3tEOUzkw0GcvsItIPm6XXmqLnZ6gcdaLvA08EJ7Z4uIReZR6NQMe
+ZEL6+XdSsZOMxmIB3ElMKpoR2vMj34Gclb7Bf2cHXncx7MG+fTr51Fmhb6g0kxoOO2yBDJ0HJ104
+J5yc9A/2j/a7Jyc9j6AVlYOOdLEQzje2kLTKvd9Bb2s7Pfj7cc9Hl2jRA9IoQwWqlaCLdQ2LhjXK
+GsrDDFDy2hVRIUUbqnWq9gWqvzloiwdZCDbPU4S34FgvOQFc5oiByfBiUF15rUIz19kP/d3TzuJ0
+CCMy/G54Ohp9urCH1icjB17YwdZpzxl+d3o6WpyedpxPA3g8He2SV5nVeqL8DkizWeVNzRsDKVtK
+trZqnPDhnJFV9iRZ5C2a4ouFLd2IwIQL1W8WsYTR1Mx8S74our2TzcxYtYraHgcTSbl9UbOKPYja
+8i1bmAEhEAY3mUbfUC5BQ9THhZvV7vdJ3mCniLzaQX89TJsWTocZOqwsRNh5+uTs65cvXr+Aci3H
+CSxGiuwiHztnvY7VTvHsthZ0YQu1pclWmNTLgAit4R7Wswt43aJvpuruxLcN8t2cDI9F1otFyTA8
+e4f9lQbVG4wsfJoXAhoFvSqsGuQ65z4c1fh1csRglEvsH5EmG21UOmI6ed68O8+JkPbshkCa9zwg
+Q65cGUfv9ALo/GxmO4oVRQU2d2dH1hbNLQlVuGfca1h9P7xaLaiVMozM0S6blM8OzFYD60pTAt1K
+gZHzkHDeyLPF/fJcwV6jvnjF2DqDNtjttuozbvA+hBxG6LxmmGHDfMlFk+cIU11OhBMTjvkfSvnU
+mvMhm1RudcbdIhNPpgIpet3GXwhEhyh0zDqKPpF1Z8+8hZOgfYOJhvC2qW5K1h4f0TfGfUuqDCsW
+xS7PCEb6xIhgd7/suOdHqBz66LhfdmqCyPxw9Kjdyi5BXttp5zHuKdTP6DTGpwXd/W/cN8hdBbey
+425x5e10E94yQv3cfAizc+STuz9hcUs4BHcRfRlQEtjelyM1wXMkXVZqfl4XC31PzFF4TJP+oG80
+rz/e6x5ummcVs0VZj4Mu2R/xi+5cuEpxI2okXi1CZ0QV4wQ8TK/2/SCUhBjGZsPjyKF5wlXuQXDf
+NN9+EDzHxuEdjgQwFrAhzgosESNYCPD9FeGMwdyJLhP/huZOwCPhvjSOCrxtMGpbUZI8+tU8ziPt
+p23NyzixnA7uaIWctSRwjjvncGAHwqT9FPFXamMVMKv29vbWySPE1dAmOZ3wbwcbBYzmB66LyKbp
+TnABePtrkuag8xAcAeFlcA0fL80iM1uT00Ry9/bIpbYOAyuNApQ7s9gni4DMDkU6SpVwElI4Ah4t
+gUOUHw7LNqKsQSLNap1FLds9FrkcOHMWOZbiwjnBktsJA01gzpg5bkL/ZDXMAedI15ggamPE6v+g
+z00MUSOs6+70HD5qRjhIOeM/1JtkiAe7bI03ySr8LQF0rJ0+sLrrqDlVT5Dq9I2OheCAonP5yk6Q
+XUGQi6PNFhsV5oDvSGsEfo7Yksht0n2zl1sqabD9v97Q5HYWia2M1CtMdPlQIuX1e/2jgybkML3W
+yIdw6Ln2III3V91BnQoIQ9nSRratd7hjZzspdy6YueHDLo18u50/9I+7XYmxJNbpsDtiyoi5ylu4
+6xH2iNKbNkl/jlZ5I0DO8U7XCcQcVs8l5FXqbvcIGktfruH/uB4aDRQ5qrrvbFL8Hvq2+uC5SO6w
+1nvX0m6CmROxewndYXRzXZ8oVciXepytDD2TclLWOzkRMyHWzwzaqMCMYR2Irwa5wXdXaCujNBO3
+6WsZ4FEOzaLM2IOCIGtGVIdHh3cAOgxQLOelYgM9MCpIEwNIiAbD7zooAWozcZC9E5xO2vDjtAOh
+EzhCMMQlQ/2KZMiBZ3iwA59SQBanJEPiPxcfOQgLidKmU/v01AngAa3QbCOnxZuwf4hSEzLmegS8
+Dxl0SUQ2y8JLY4WmoPPWHPiGx0yDTMYLEZnSctCldkRwGmguWEUeoRtf04wkgdlqkLbmbQg5c9Hw
+Zlj7ohoIRYkgFFEDhKKk60OUFik62EQG3cRIaTwrMYINxkrxcNpnrKLO3fHaWkQrTvhGKuzFi90N
+KlVVPzRKAius9IA1q9g7Qhl6o+l6Wr+XHlmkz3Cv62lIk/oWZbQ3HrKMzrUMgRHB2VwvJ/QtnMNa
+skl0SN8hwsi57S8tmMbVGp7DF3oxrmRVUFZWO29bC6udti3HaluBhW64h7XLc3g795N20bZ56Xg5
+T4mH5O14xE0ZF4ivCtlgVMv51CK1Fx4Py4F8mH5tWUvhzsTdP9QDliyrD1Rr3J7fwXBR+HOaCX9O
+/aPKBT72c/+Itb5/dD6l8Qp3fjw9fTeZwst300P8c0w/pzQyn+38Eh7GmOTdBL9O6OskoqGBX+EY
+/pz38N0RJsM/VAI6p2Leqcg9FfNPRQ6qmIcqSMUmA69P93DaOj0tMfn56SmmDbvU8ajDkJ6e5hSp
+f8yCEwh6h8cYo3dMI4QZsaDHgj4L9liwz4IDFhyy4IgFLM/uCQtCVgKr3AEGMJu7NOeGVjtrWyOa
+W6dAfGn2DGu9OmJzh2bUCGdMcwZjNnG7hBgpxRnfYTrP7jadp1Q0y/aSz7qEpvacZtiEv5tq767o
+HTOQhR+TRZIsrhZ5tCgW5eJthJJs96Ia5/Hi2bPFV4uXTxavFq8X3zyhOG9ZnPsRgMWqxY853hgX
+4bl/035rWIRGUjF2Z/V1ianZurwW67IwxTt3n4j1OJzissWehH9XfHEOQ3cK+5ZVX8UTinWhxWpf
+muKxPC9ZnviEqRDrefIp1Kr36vWi//zxYu/lY9i+t4a9/t7IgW+vv3AI0PmcFG3ORpCRTFKUi346
+Wezlk0qS8lImgQVNSWL3WqvOPYjLE0FcgOcxoibsH+9LtDI02XYq4rXqSR22nOxZdh3lj8IiQklU
+20az8NLxSIuy5vDk2Hx3xqzVub0QLMSNDBg+VQsm7B1EWbuHMb/qGRLXAXNysh569uhIsMs9aApp
+dwqpBtAR9jNON6lIINQGmpQgiBycrVAFHxYnQrih1QPyO4aTsVNe5tk1XamjpIrMdGwLJjYc3KNJ
+K5StsjQPuOhWibPuCewFc8EKJP5cOLSZ+CWCwvODV4ogRjL5udZpEz8CylR3+n7FvM/PVJpr7f5q
+57KBznq5WMB5EE7/+YPuYnGxvR3tTB76Y5X+iXRYn9l0mLzWGL13eN6q1aJ5XC13bCha8j0XCCLk
+jvHlBO8mlnSTLwt8p88M0Stvt7cT1jRb66uZluxMryfswtfcvCjRJFFzOrsAEY/4JbXshqlo0bnN
+0PIvpLmQ7oZ+6hj6/AaSLOv9im5KG1EddyYVQQlVYLFAtDS6xodZt4XX3iHKHVG7ErYHmMbfhnFp
+0a13ENuhjRYA9I6SwqIe4xZR5mGcIEOOEQPMR7zx3jruGXDAcCBM9HO4FJVDPestxDWQ+JfQzaL7
+l5DHNEEX0s2lqloczLx3doYr3z1bMs/cRnQDRCrgy5eRGTgqDRlHhAwR8UPINxHbBFwT7Hk93H8g
+OJqO6DSlq3PeizPeSJRtps+NGhPaSSqtwVQQoNLx3TzV4O0wXkgwbzVAQMjHzV7PDLl3tN9FB9B7
+3ZOTY6cuvseXpu0B3VYJ7y4EjsHA7w4O9nWKuIEMFiY/rCguKBzhrGrAgTG4PkrXC7ULgIQAJxM/
+tot24nY5eC2KSPc4vDGZJpmO5egAa8OwGFSAyYdlDx0OE1++Z5TArcGy1cHlatrVacUaRU7xLMi9
+jIBzuyfG21O+Z/b7d8BLEmoeAiSWtIfWwPnpm6NLKk1mVc193UOEwagDL1TqFi0FvDLaeiAKSnPN
+S4q6hNUepN5K5xMShd3CMIpQQXKraLxcap2VkLNsXXebKSLERd2ReonGFWYNJs1DCVNw3+zISoyF
+5oZAuqDp7x+uxFe6pzuOVEgSe/v7dwQGhJIOuoekhdW8NKPbsealWUGw69qlWdjACEx8G8FK46Ly
+GlXbqqOxjwaxRguvrtARI7ckodK0qzpg4P2UKMzxufIaUPEo9cFeA2pefIhpYzrmZF2EGkxNiZXJ
+6wPXckFMb0w2pr9k6CPylqRRQ/1HFrHmvqjUvGByzyulyoKu5xEFWVMcIxa65jU9Zz4OIOZM+W5Q
+mma6r549M9Cx5s9lrYYRAzioq7HRxbeggwZnI9V2Gr196hE+K27S8aqPMMrvbuglrg9ctRtJoaak
+xsevqejo17UmCRX60Oi4ihxacdbkoMfxM+hS2xHX0HEBA0w2bpkdO15avQumLr6v3p0iP7Z2RVib
+n1yrr3uHqzCZXdNrCZ0xD/c2TBRNaVc6/jF4aiqaWwvs/soycu4X9X0GPTnRPG6qJ9QnI1Ozbdhy
+bfmpYZaKVcjVD0tFCNgJZC59p+nXniRhb+rzrdDk029W4Jk3JEcYAbqmPOkbpxT6cto0pYBArJxS
++0dmN+X6wiZSG95tHzJRwi22N4U1h1Fce1z5jMJrgb+xa0VNmVRWoO6qiuubq5JxhzA7Pt8/2dS7
+uCcyZf2VnYzm0mYV4j1+IdUXrrDWoCpWVMQDcgeU2dyy5qi7oQBytHP/IlA7UpRCyNcbqY+Byxdn
+0iAalgoDE+uNmlEmjSl2OSkP35lULDCJSjRVZcPn8j6SFLaca1feqXbEz/wyKLnwhA77KQw2wm8i
+TCVTxkIUCrqVzZSjIbrjzVj+sZS+8AyUIh1lALwy89UcI2/gxsv6d9R0zDqP8Dc0HTUE+JOfuhXx
+2/Eqd53oFkeoDewfmuR9EuG6LtJDkdhK4FXzpbzYNzpC+cCmS9SjA6P+EIfF5xvFSVfoiO53uXUA
+tz4tFIossn4EdshYP4KuYKwfATUg6ze3bdMFN3MTXWXvWvmAKQUB+WcmqCbjcQLQRHXI+WJhK3/I
+D3vsItRFw5sxGQxDFNJKRvTdI0Rd0mBWJDQCoa2gm6ByOBtpV3iV7p8BJUFvY0aA0L0jsQ+g2xUu
+tWRQ6xsA6uuLngDVxaKHHMzo/QrDk/ln4JfpfTFM5FOFu1C6g9/hxCfTvdSLYQzlehNqBHtSCoA4
+GdDxMKgIYZwx6n1yaFSmRm8ozmb1M0GxKrjuBydmksqRz2rCEPRcUT+XrMEP1psds2aHmjVgwWOQ
++w1+bnITKe0g8RBCDKxWzmd5keSCDnebYjJkomPz3OKy78zv7XY3eWmwI8ZAoTRjsUDZ1A6kCnpH
+J0eHJ7AD7R8f9tFIOQLG5VM7etANdnpez/EiNgBdj/l5hl+kWXdg9LVMFd2oUEsKsh/3Bsr9cRnk
+QbmTeyXlfrRnxIe5C5Ju8zqCLB6gm0wEDf1f6Eck4cqOod3ufjfcaY+679D2OtyZorFKTK5Nu+fD
+bo89JviYQYwj9jz3Z2FeRE/Tco0SRePwUnGQE2pi9ufhc3xF5zNx8Ww6vHIstkD+Qh/Ag4jhByO8
+l1cuNfWNrUbJYnDbmCoV0ogcGHludSQXAZyQxbtgbguXAUDW8qDvHTuetFkKoPKQH61YIEProcbv
+isQjjP9oLpiIAHp3ce7mvRx6hXOZdNVl9rsKTK1tldkvZjM2oeqK2YSNbCL7sNUJIc6etOflcm/m
+ue3g6A4kkByROK5yklQqS/OS+U8nj5TMCaW4WCzFxeJR//hoPSB+b009dBEWZuiW9JdElieHcEDR
+srbmRdTC+QXnTinKQpCLvuBnevBzUHYu44vLBP7DPu1qT5/Ny8yk6oYglXhPhwfj2yW6q+oU83Pg
+w4DD6iRxUT4L04t5eBEVNt7RjOFQGk3jd+6lPxNG3og2OWCjzm5nQia5aa4GxpBmGhP6PYv0Pfn/
+pcXlti6ysvX9x8X3FhriFD50UhK9xQOi13UTXhsyiOOuoGAkgOLdJdqAO42ePLgcOEBLEOEyRRZV
+NNKe495DwvY5uYl0OiIrf+4mHVnGw0L9hhQFwvPpn/Pa5xxqiBxQIfND1gj4XTgmTz6HFe3rPjRh
+4PLoAjo/ykXNamOXNiIwOB9IWRk0IztaG1a9uM+SODSqRKKPnQEag9gZumxA45CSMXQkS8uctJoH
+ZJwh0tWtaO/z8CrygK9buoUmiAwnk9fRu7K5kaECHtOILMpw/IYmFOJACJKKHOowlQrzI/TQhaDt
+cTLJo3SofqooeBIuoTCCkyBLhSBnBL3tR56WglT+bgmSkyXgMygy1P7L6OY6yye1LqOKZ7MofZ5N
+CFmOXvDGkjMHfB4nWRFRjGa+r+bniXnoNQ1V6hpE2xnmWk+gS588y0rMWTYKhdwBbxPMTGQyLbcM
+L2hYrGIWppbLbyriqPBux0lYFPQRtdFdkY2XIVpUKrP11U+U+IzD0g5rjRHdsErbmDWCtyibYQzs
+Gij+ayI17QhnwrCstPDDWpLrLUF6kNYGPYOREb+qrZBj1TTSw/ozY0tDks+SBFMBgah8m8ZpmKAG
+b/V1mX3x+qtnzUVrWcsBM/+wLpMfih1LiQhinatGjokGgvznIfAxngztHGm7w0n3h9FmREWtkWby
+X1Ghnmi/JLL5RfoGTrxwcBGkmBK24qKVQiaCVkQTkVWjSuW9t4tSk1Xi0XOOzmAr3XF2Fl3FJZTr
+Fa42xbz5ksAL1HYJ5w1JubzIjS/SLI+eJkl0ESYFuRusZJxQB7txJ0Ihy8swLqIJr37lHSfx2l4V
+q41C7Vqx3CU45YFMWL2bC3upVDXwIHmrLyOfqYbLRP6tPvtdNX39YSXeSOWZ2Ki96/aP9rkAvsGI
+lOth6/JNt0+wuu+Ej1eRilVup4xyLp4njDEMXmscpjjtzqMW3Y1OWtdxeUmsagtmkjSQUaDh0iJv
+aba3yG9Iml4ppSo9J3kVF/baVng+tmh6RsODkW+hVqp1YEmTpFrnIbnC0Rx2R02Q2hI2YJeMSR70
+4E+77ZRD68xqs6I60zy7esT13e3cGXG/ot1ef2//4PDo+ATNktaUWjqIhmmbrDLJwa2j3KBUG5yi
+VInTK2gudOnFZfzDm+QqzWa/yovSokvAEhOarX9S5CrI9secXvUWmmLalb5HjPdUq5rSPVcHfdsJ
+Kmm0+3V0TCX6l4DbGYbn3O8N5g/qUpnBHPpcRh8j/yOwG21l9jMfQf+wy4yYQaklw/HIj+EPTQTY
+ygu/tGNdLNYdzB7IUmZQSioyKFA8RnngL8yGQkfBl5Nw5NDgfNNwpP0qnG1vwx/tiikzz4nHUTHO
+41mZIYJGsCGKXc3SwutYy2FMeIjIKZkRrzfDDAP6y+LGGLeSl5gwqMXczOBVBJwp/KncmG1sTrGx
+OdUsK82ZI25KYmwOgfQHiWrOGONW8pLNmZmaw3Ejt7f5D5UuaLxBYspKma7KinqH/6hlVa2UzOpy
+VVYvoynLCn7Usqq86Uxga5+yzCY+R7fSPnMxinu12qzXvVhnq+K+9Tm5U9/obO7eND+QDMU9b37g
+umfudfOTJpNwn5g+SxmY+46rzemf4UTnnjWgPhiH7L5qfECq5X7WhAahir9hmmbTJMty95FpaD6P
+L56mZcCCZjezcXixfp/+wbiw6KOC1al96MQlU0wI+HNzoFjZT9dmXr9Or2XuPl+XmkfWgIJRelzJ
+SPsGW8hThbvjBM0YrMKv74jZ+7URoAbWQgKJAx7WEJQ9I64yAhINRx1pEQw1rU2HwKjqLhMsqeba
+5eFLeXmI4ujeblfJpZnaJPxleh3RHmp0QLBYvGMbzm606ypPyCUXV+4STiYz0KOft3tLp43q+/iA
+5nkDswCW70Qk9n5j70SO90ZoFiC8kdAW5MxSitK8G6FakPH9sK18rZ2zb5mbu9ZH22doo9Cx2vwt
+D0J315Z13L3gEd3ds492maZpNa9S5kVw0F+RVeMhKv099r/qjOdFmV25z/xf2Y+d4DH1tOroz6ve
+xK1JBod38s1u551fzbMyelXeJARUFHxifeJZn1hSRaUdtVPFbf9EG1peMd4nwIzsWtiMbcxwYGka
+2j9qXI5dRR5EvQ7/W9L4eG7SW9nefs40oVRuvzDmJrDs7p3drziv/lQK4c0JI10NhK1KdiqsKzVU
+dNG2UN2M56bfi2/9oFhTzSjxB2Gz6FbwvyWHaHYoLmxBmTIvnaWx7I/swrXU6KLWC3AI6QX8hF4q
+tIGHD3xK1D4YTy6fsMNbS8+7dQUTEE8vogQ8tYhMP3Fkda7Cd2y+MNcqVhXHTsAjdGrRgsYbVDlu
+vNwiIuIxnQO/8dlZ35p61dxWPG0B6XobT6KJKxsYtmZZEZfxW5wQZXQR5W5LWLW62OrvsfzvP3G4
+zvQWtZutz6dpgXICC6Zn0am8oqmk0OAEo6bjxfmJ+RzJ639aLeN0Vf2/L/N59L3b+n4aJgX+wCp/
+wkr55HtLDRWCmKYljpDsUPYKKnVaWpUXW7a49rLFS7fXxbtGFUf8etjdMBC8YDWlTqE4txWmosNb
+D1vdaleLOtO+F49fRVCdkGt6Nbu16NTjra9QI9e1XUsVY53LJ8GV3yxxoBuhCLU1S53zDUptFj5b
+lZmifEbInTCwsBaWZ1EVrIHJalvG/pktfK40FiHbmPXatWBx7cLwBVYXst/pMumKYE1tpTZ/FbyE
+fK8d75ouHc/jCxi4Ss6Y8J1K2LZSq5r4neO9o33ujXL2jo5QZkAMDjz+q9KH6Awh9buOmz7032xv
+v3nYNdBx2ZQf8WLUGgpMVmso1AypTS9MEm3qpVM65Ed8Kju5j28GiJ5B0hYDayHnf6TNf+0mcpD7
+rxjVFzgkLBpwE67VsoSB0e05cPJe7s7y6K1XSUAxUTPCJpUlvU8yJyO3BdzT2BfAkJTOQ19AgFjD
+R3E+nidhPtLktY9t7gcbUSWgUzP/M87NOI7AflJusm9pJMSISI0ow95jx9rGUt1lXNzEYjdt91Bv
+RCEauYV4V1EwU8MJlOcX0nHrN/WLduYyy6k40OISwbesSRdyv939TiQ+LT61h6fXH43azi6Xg0jp
+Um+kq+4u8eLkS/83oKKPla60OBRabfubwPJaVvsbz2rZYZqlN1fZvHCQGxzB1y+FZhKsqNYtxOPD
++qULBAYjtZYwMy1q/K9kK6PIfxpUWa8Sq28znuTU7nx66jhnw++c0afISRIUxA/C35WoZZ0lKReL
+p0EUeT+1o4gKrGvlGvkYxcPg+CjiJWPgZcETdgVS46C0L4phWqWoShYOz8ntmVH9AQ4qn5WQ6Hxe
+RkvqKSmKjHzrgdV+Uu0vmR+i+CBMTChSF+yCO/K7gzR6kEtFyTRCKWbU9mFJtvNomEYjmk5ty7fa
+n9s/sfk7OtDCwuWcj6suTSnxQ8vl13h050JqyPJJOv6yKW6n00EwJPz5YHddE9qQLQ7Zj9RyQbSF
+OYuYlpy0ZVF1vrZewBoyOzzpDsoHsgNKaD+REAR/cq3T1NIIiW6RYGcRUlar/XP45b6guQ70VU1v
+eCvm98iqT7UGX8+94N2VrZfLJKy10xqHc9gOIRblqKOzonMv8REVJ14rnXF67QTYoaHoCmqctnVh
+825btXequWFUWc5rYp6xwBpSsSOkHECLO/QEFBenlcyICGJjd9veTmgCPDOuk3L4bLS9/ZWgZl/h
+FRKj3m920qWjn2aQ2zTnAbsT8ZeSJooXtpFshNC9G0gHHn9C6doIn+ZN5BY5vQQx12nJV+HMdFiS
+cyGONMCdWIyuXUXhjiO2uT1GVU9UroZ+9h/SGJCeBUr9f9O2oCzLDQU5dWOc4qaGz+/W8Hml4eE9
+G/4qKtc2vNAbPjY03LktZLP1VkLGljuXrSxWtXJ2t1ZKG6YZa+dUPE/v2lIuVl7VWp7kl7bFI7Jz
+TL2607tVV6tevfr3qO6awalWFzvbWN3Lu1VXuBraIDtoFvwymjYLblBg4WHgPiSYl/NTmFYsOXOY
+ele+Atr9yNjGR3dvI5b9SMxgZ3MzpYeLD2rnRJa0sSBhXfOB/Sn3DlbU1tqymH+Oe5RUYagTsYe6
+88j/OvgaPqgbTuUVuNRnPvu6WFTsq2QqdwzcVjM6qmLS6bYlc7XcWeRvzbF229sS4p7h/VJ9y4AL
+YL9FCnWMYJzeOAr4tToyzu408u15ZMS3rFQPi6+8INYuaL6CLYE48raNfAOWNqzv3sA9zpiu5JgC
+2LQ92rRHLOlAU7hNJEsxhaxvgT14wX4h85Qw5gle0ivFJiQ6QyGoj5wVTA3nm006DYuFSVZP/YpQ
+feo0+FFFD/QbzTGfjPKtlsWV3MHk5y+UlJ85xnkxVUcy/sIu1SUzQ7lUJkoPUlIhIM2EfKTLI4Td
+3k5PlfYzrTR+vCobgsCaF6CdRgyE6wL+u0XAU62rLI9aCOQWjks427ft/GEvsIrKeP7MvhF903Wb
+JUKd2qkYrM/t6k1AhDcBn5yejuga4PQUj2wuImMgvsW7HkFffOm4QrRa6fwvlbJaDUUQVU6PPevc
+ck88C7bzXhfWGAR9z5pCsOdZubUEZl5qdQZQNDTYQygyaGT5oHfIRD0w1645fZMXUXbvUMdu+alm
+5sdXq9WOEJlJxfmlPtXaeOQNcAbL778pHIZXYkE+JeTTgkVhp8HPbfwuBCA5Wwm0TFQ+P5dTgLTa
+awchi18V4RGmjQskegsBSldkR4iVhgsNOgSyV3FVMb+hazv+SB4tOYI2WmnxUtU8FtM7g3NVps5V
+GepQDLORjwstc4ISZnmGDk6g20kAFiJAWpMRfxG8QIVvVuBT5zZE3RZRRgFlFA8E1OeggDLCofWR
+1Y6HBVPNGC1F3IQRfyw+IScN29t8hPnOnZDNCBwHElnrxeLp9jbLMRk1rkUWC1tc0g2/Q0HKLuQc
+cAXG0k6gcW2Si2BbE2yr4/GvSf0D2+RM7XcURnR3MFeNRdWX12L8YlRyIdRwgtMcYtb0DuixKAif
+qSwp2iHI1t6Bhs5Lyr7q8uV2qet6oUqbkB5zmbyC/2kB3W0xza4obUktLjWNQlN6HZlnYw4x56UQ
+P1oVLIikhkQEpIm6k2JmwGThft9MUWq5uPXkOsAmveFsWG7C3qSb6mqkagTmuQAjLJdbNe01o6mL
+qk6gfnqZ4gVLP2Mon6bUercG+oMXaoy9HxLWOy0kN0EHKmhx6I4JmFx0+gxqOYfeIy1O+CqkN0Hi
+F0LROAGOBCGmEynZmdo60YQndlYUAFaxDQekOWKPKSlMIiUwAyacQU1drNWg3R4jHjpUoRiOR518
+Dj03oAJVqiUD92EtqPHeyJbpnWAEvmKX4BQXz/Jo+mZKk1dycutZ0Iioc21ZmSy5Plm0KNXP3MkF
+TBSy2ZGdeKkptMNLoVEaomzcL1W8CeqJpp00egfTZvzGZJkmXNfadX064C3JMqv2+mFPOUnwe8Cp
+NPTwmO5jvtMbKctJYGIGCUfthRJZ/R23hzIXSV/n6PEeTsfupa4NBM2rK3fDs0CMw/5TbYe0aaeM
+S2DirXMgLwVwLvCG/4Q5Bg9R+hZVNSGH/OItTvS08zbKC8jetyz1ULBI8HaCcSeTZ6ganUIuE3oN
+TD39mE4pzKOr7G1UicNefZYk4m1Br1FnmH7AzjqL0mq+/N0LyL7yPpFZNFlYVBpOhQ+HyvcGieV+
+JERkofddzGdI44nCogb19cSg7L5r0bfLSZzfrRCKuqKI+VVYvDGYwaAl5En/qALTaDSyOuju9/ad
+6o5U3V+W5Gc5Kr8N8xTaykzSM9eoOKwpFjB2jOB4iWDEMEVTiRmmWvkI1id2IR4u8Pq0QM33eILX
+nEULGg7cYHLTaH3r/AYOG1Hre9Rb2iG/HN+3ZuH4TXgRdVq/gIbK/KD/ovEb+Wg7rTIjPWnM4KrT
+ehmFE8alh7BdluXM292dnneuol3orx1KvKNKAaadDVRBhzrfeppCk+IwLVvfxFkS0p7hFhqNKXWA
+Sji0FC+ZV5GJH3Fu8pbWnBe57LITf2RZAgHmAQG7FoQf7PgNP7gvJPhBTBP8CFPMgDJ6MfVKl9uO
+wDv+CxuPuv6c4aJIeA0gs6U3WRqpkJLALn4ZUhi9gyMMhNX+9EK3MT1gT1XoBjImmomRh5HDk4q7
+FzmRcpumrIOmJjQvmyr5KrL16smjl09enz1+cfb8xeuzrz979ers9RdPX529eHn2my9+cfbt02fP
+zj5/cvaTpy+fPLYQ3f3oZKWO/0rdydzf/bjfhSMUELWXP3nUO9o7JsBl+L13cnyoV+gWuKtwngBD
+4QJhvwrRoKHwbnkqb433FlTOauN65rma4kpFqSVFoyxTmSAjLPReBQbWvNyP+8Jq+eCgv3fA7dyx
+b3Q7zlvWAhxc0s2ANrEpF09vvJSw8iHxpsJ6/SPmTHGTjURYRWuDQ8stdEx2/TiDKgDvQQ9Sm1C9
+esUqh4+Y7lkMW4LX77p4lIUp6VnzcroDA8afX8EyAP43wQTj7OoqxB+TaAyrIIdDBPsFLxLMB15Z
+25bL7jgOuI3Mz+dRfsOtaiAtqpTksNWUz5mCxhPIn8yy4BuqalxFaMJItepFe6wrqYVoZOPCDItT
+1jWUgnXfc9iJvwjTCeFwbvWW7goQYIEsubv9t2x0PzCASWob7W9NlhNS44ZUbVByv3STFUiUJj/G
+DAMJulCoJqAcBg686LQuiIQpBDx70RL4x6p9Mh7SUcbCL9v8vCNHO9Aadtqx0eECXqVfuNbwo94I
+s0Pbevt0yH1xktQDKAfTIEC1jepH5iAyRFuHIgi5vXnXZepE7xyY32N+7p4zdjrv6MOCDiU15ClN
+XR5Nabd4xdXMFOabY8aizZ2lbv2g1ZJrRQE/HosqQoazBzzKgCoza/s99841Koa9EW9gj7wL3aGC
+mEbqTEDeY3XWFZ0lugrvKjWnTXIglWgiDUqPVE2RqChvT4PwIbR9Z0dofcBwRcNwxODvEK0J7Qeg
+kWpxODGMiTgHZQ7XkPGrHSHMG5jLM+lfnoO8QAtI6Yd7UEC3GNao8kpjz4NC7zc0npNADPYc18eg
+Uj10KgpjNw+24uJ5+NxG65dii4zyOJUeo9ADHscPEU2ukhhePsAJL+lVYGNrHTSeybyqY1S08kMB
+g585HpDELpL5zI+li/ilPeajsFxucPnSuDmR7luU1zY/4iQwF/7Vq++Mfpb5V7N24WP2kYQQwHSR
+AqTIpGNp6kZbwo1JgdYcnGzXXsZFtnN8fHCy06t8MZf8+pIJXSFCi2viCUW7KAa+D+YIluG2VK5u
+3VaOK/sIfShZYhCLX558J0wf1balpVPULe5o+9qW9qWxxTW19KL6Wg4ab7y4/qayT67Mk30PKk8i
+L/akb7AGNTVtOusPmId8kLty2diPTRWrxQkab7y4/oZv6sbc8EvAQ0yJodz7jXpB/GMgf0Ey/kvn
+EZr6RvIjgkwgu0+o09p7J9AeKFf+m3Mbhg6mD4vFVs9nSxJVGdv8B+UAoYlHYROw8WE152LqvFWR
+g9WfoE6rPtU5I0NrqzGC+gvIvPqiyln1iDxor6qclqmFlY2l+oiFaY8mLs2UYzNaYHoJuTdfLvEy
+VWFCMCA8HcnsThuhtOD0m3M0MGFgzFFSM/bL5nTRubLvTgMyMQFODIHOqgPBLHEkxEx9mBBhhbGG
+pTbpZ457iYLHCUFcMHKKEun68iZ5GbMMngoZWbudOwQjP8xHig8F2n7sM/MA+vnxk/7HJ48+Ptmz
+eNRg4vNNxuMx+ocf9/d63W537+O9z0U0yAAiahsP1hTBTkT5HCOkWSeUkMI05LeFVy6a9GGO7o3/
+VtVzBJV0z6HtUN5NoH2A995NuzegL+eBfUWu6oj22G9djQ651pvoBjK5QHd1zSmHkwGv4LxqDpIP
+PnfMmaWdq/DmPPoqnNmJjH6OusKlY/RoqzKPqjmSTqOFBwzIF08Nq8hCdYeHZk+g9y/8wr6AlFrX
+DLHTCK0XvobwNRhejLwLOGYyvnjuXjnBfHg1IqiIq3OYOTY+uheOR68vBP80X5LyFk3kOy0pmL+6
+hfYYZ0N3cKmPPB/wCQz25ci98uf2xB0jEA8c8Rur0BnMsKej/AIOZO4V6mELxSACcdH35plHzZmF
+49KeEZIdnuQ3ncD3jvaPOKwdP4yr474bbzyXF/7teR6O30RABk2H0DYy8Eux7VJguSgSHUd6Av0w
+2aZ7NDxMLF2U0YaltwKsPqkJBeYG01ekJUCy6paleIiBQa3WYMxF3ZGbELAZQr84OKaEX6nbdj59
+9YLb4V76YYeLdYA83YaTSU0CUBFT3EHsUBUuRCmuE5QFsF+5l3XYL/7iG1w9xYs0ucHETCjjXSr5
+khd2lKwJZ5zofBQnwOoiFBZsn6mTp1Ldwi3exDMkHOvkEFe4PVzUbcfQexr6bEI3OEDk3Bv33L12
+n7jv3DN1NnzlfuaX7hv/zH3kd90XCMEp+X77jf+GcFSv6MT6YsBW0A/8LdsIH+FJWCb5gc4wP8Aa
+eaRx/y/D9EKw/49uxv+XvWthbttI0n+F4l50QAjJJEW9QMMoWRI3rvVDJdt1VcdwszAJSbglARoP
+OYzE/379mBdeVrKbzeauUqoSgcFgXpjp6e7p/hpIVkcYMgkaNIaK+1vFl8tagZBAq9q8BO78M+/O
+Sp0z2z0zbY9wUOHRxoIHYubjKdfu7hJSoMgzL9E09KyRZoa18ihUTUi0UrjvnFFXpdjeuYERuvRh
+b3EWYqKkMNJEtoGWu+n4zOt2tw1eO9Z7KAoE1yqv9R7SatzLe+1DXM5Y8cWBtERFAoBuU1tv+Pu9
+8i791G1rKTE35qhd6snyVuJuC2H6DE2XYVf6gMdKV0Bwr56/1QT3yv7Q88hW44oMwRy0N7FurLfT
+q1m5ap4FULk8q59+sV7ZPSuS3wz32rfaTnvGxitoo/9htjVfwSSs46ytAiN7KrOr3sBT7Oa180Zo
+ntSMPFPW1bXxwRba1970gRFzzrT3x5kABXHQMZD2e4HDrF13ltYdvnzHihSs/KK0kZ3Z42vv1r/Y
+z5I0hx3VvVBqq9deaXhkvX5KpN9NnZfwQV4+v9Yf5CV//4l3PX05c36qx6+eGNqFCfs8+OLXPZtO
+aEh25hL92fuJy/voYSv8hhUa+IH12pnY7mv3dc+699FZeuLiNjMhndWY40bnzjmL9J89POr+TIkr
+oFLO2nrjhNZPzsevELPPtvapfvOEsgUhyko6z4rKZVFSuYgJVFK5qLRGlYt42gKAHX9d5WJaexEO
+4sLkuX/n2phUb8eV1vIuyAMtjPTNrdFROZobKOG8OJNso3TSxHFLVSVbAR5u7rwporYt9m+iJdwK
+8mI1ahU4C0UKEde47VmIaidunSqb0ai2KWXxqwnuopLgPKGYWrQqpn5trc2iprXRPJHRNJUITdMZ
+jHTJPDW1gh/58sJdlLmqFoWPeKheS11N3mvsWHu1OpNfT1JF6iSHv7s6NgSiqvm7uMLGNUefMbP4
+lXuosXRv8HqNegv51DeusQx57eAm0dIOeOLzD+OM/Mq6kkWrrqShOYVYT34C7IEj72wLmIjEdkFO
+lCmE4q7uiM7ADiD25pp7QSK1MV6ijULnaFies65zwiQkijuZX0pyiWwyf07eYj4Kw3Trq3S3y0IR
+XMgkatGdh2ZapBaBUogzuE6APH5IozUV1uSqLo7oVMZm2ve3cqa/GWAFokSixcGnjDz6hVO65k7u
+oOvqOFAVMwYaZ6UlNiMBtrag2YFnIsRr8K02377HvdnZAE+xeZ5qnmLDO+snL51uZuNCT0Y+SMOP
+Mf00e3xcWzfOrYXXsHHfgURdNMwYx3gfrgWtKNSiZ5M3MR9Eg+FHU8SisqAKsROoC36vts4LSfmc
+e5txWL54N8y9FYZy2Ln0SP4vqkS+6yNHKg0riiodRW1XWYOisviXPa9Jz7XbdfUTqSPbxXC8mr+8
+7H1xKbA5KRCetC9g1cI/cuRvmhGJ6YAeaA77kg4Pj2Ai5LbwOOt+0+1ZZSvzinm5iXFlafvccGvZ
+TpVt04Z4NJN/jiooRidfbYnd68W2ZkemMaoOU/ihawP72LSxINrwIeEK3Mypw/ZpvYm5jnIMK7ko
+5qFV8UKUXURPhxx5EhAl0erREXqwxqL1SStDLAslk9vs1jt9AILoPiR4rI9GUW436W5nTkr2fzQk
+uTkk+nA4h3GAj5zsw/vThOCmZjoWFPUrwpAf/fFSYwT2ekte+QWQv+XMmXt4HDquiRRzdaAOV6Qz
+TZXCkLAJBewtNT7ihiMoasrJc1udgVd7PVaOIIOxdpUgOFwE9aXe5Nwbht9PDf9xNOl32GTfIGaJ
+nijpNJkpA3O8QYhzo0gv3tL+Bh9SnFW5TdEm0LpcW0300Fqiw6erFYKQKuwInf8bGaHgYbh9dhs5
+RRxm82Adlqy1ufKP16/OYXYkMVAcK665M5Ltu+C4KnYCiYCEaPCp6LAVXOaFzYhIfua1wq9JBZZb
+R/wlXHJlNGU7bSPBnbUy2xiRQg3JiIakQXnTZULa7SlDASOYAZCfXhePEbZGVJglqi/Y3cCY38J6
+ey7sEoT/TWGPR4doYP34ODri31NxPxiKhPkLb3RC1gSHx3R3dEh3p326Oz2mu8Fw+PgYIMLJvrAa
+g4EZ9UXZuFLmtr/sabOIwnbnzwfDE0xEME+4G/ZH4nZwOoSyXxzNenA9PHk8OtilHIeHw9Mjqvbw
++GA04szD4QgzD4YyN765e3RQftkqULU3h9YfHhwBSR/0h5BuP38+6D/SdWVggEZQ4aM+FX5iFD4Y
+GqU3VWZrNFFHBbBscoFscZzd3d3ZsULTsQ+tn0w/P1lqWzoFoHDkmXBD3TVIsgafydr03zpS01ih
+8DIgiLHBKTqt0SngA9ipdLCh/crYsLS7BlaDRxVuFT1MGE5pOL0mvjkVrUEJXALBaOibvGG8pXvA
+FCqZjS2C6CltzXi8XjVvwqh0LfZRLGjjvgj8lZoKFNrkifrVDpnKUF/S3qMTsNtrwNG4Iw92ZwTq
+dTgdk30rbcADlhg5ifSrDyTcAOyUaBzV4GwLaWlDcurjGx7GmCb3NjW8W9L/0UOMOIVxv83tNm1g
+I3KNzZNiM6SlkW6lrAx/EHUH6sOECMNjORF6WDhHw8OT47qlrWEQ3cDZtDGKhs9oo9JNoNeliFyH
+RyqxF8OF1xWA8gxmV98eHh+btqJAfF7aRMehJw0ZxXfPvEF4MEaU36oaHXX9P/4lRPMu2HbUnYRx
+U2stQ9u/5YuMQl5nenPgfWFpbAjOmrSQC2Agi5kZRvybYR8Y9JW3UBxObI9XL7y+b80hEcOb5ClI
+vCuMj6buV72BbbuYA0F50ZDPa9jT6USzIX0Nk8cKnBvbLzHtVjC9mdk+/uc5d2e7eONN8b9zN+O7
+O7nWApIiMOJ2ixl2CVviS0SuPApkc44BdMSHdBXvQKlS8lXJVRwzyiU+mswUZRPE3UNcfz9EwUra
+b6ugBC16XjnlpPNSZebJoxtkQUIV56vuOu+bizGswpIn0jiWGarS50CjSLsXy9VZ/iboAQodgv+t
+QOdBr7HQkOyAWSBN4Uu25MLySxkTv72NrTUJmfL46LjVRUXaFLCVSRZSeDogLRjFgosVRg9klk5H
+3DC30HfgWFrCN8qpT2L2a1LFjjlf5YObWO8G1hgRv7ZljH+j3IZRCtsKMftcictC8X+FMI6HGajE
+QhITdKK4HoGC4tB1/S7KiGivIkltwAd5Vkx2urY8iYENWBi8xojmGxMZCbwEratj+InVoWWgdGSx
+DTtnHsUFMPdMIgI6DovV/itdb/28F4mTrN0uzQ5HfvZGSRQ9M6zpXz3/T7uznu35cL07+xatwTGC
+zzgHCZDsqkGOkSsJtjc0d0b8dbgagrBVa28EzDIF/IEOJrSv2draFmPBjUoBZYnF47iJ/aPTYxE/
+8HR0SOEtY4rCkxlEhIJydMIHgzVUjBo53/2QhfkFkyGkCU7piJhZQpsysokW6jaUeR+hddPDFbwe
+LUHgh2fq2qHtLCnSeaj4rtZAKYZ/lhFRNQ1voSeoDBTvoS9UuTE5yt87iCCmDHairl1tVkOuVdfe
+Uq48+XsYZxzCtzQc0k8T9lZPRIbFa5/+C9zWsASYjucipXt30O9zWwStJ5sBsozSt3751q3lx9A3
+cWghGUqDePEqppVEEUb41lMP7O1taHjl8TfGJKOrzhQEE0o0jhBhh8GzyLHYBUOaSrwHdoL963fv
+Prji+s/X7z5euQRZcZMgNxwuXm4eHxH2Lp+oBK2rxhWENGsV4m4ojx2JGbpNk2LNjvu4d5n3nuAq
+Sf+1N8AVD+Js5PWdzLPwbEzEZPG5i9j99+GSYozIRxhgjsKxqHj10fNsHCGEQc/TI5NOo5mj4UDN
+psKqLTVq5iHNGYuBuHr3/tWHV+/eulotzw/eX35wmQ/jWmAOQ/OMsITSc9Zv8pSpdWhJm5cs/Pry
+6vID14sjm3jmRIDOryKM3otzlk0jKaUnJzLF3PtRjyYMSVIfEgF1b4xKrKufXF5fvj2/dHUQE869
+N5jRZiAynn93du0yq1lZs7u7uouIY2TZ4iPmyTs8myUFqigUvyFdjL/iW1SAFFB+2TD/sk6PnyNr
+FJJuwt87GLpHhzLltO/DPQhnRmOkUF4a174zEJnkLGu2qkl84Z1kVV7X7inwNcNp+9PZVs8XBobB
+lUgKFaLuNLBKrRZiQCc5VuOm7Ndnb/98Wc2Pw+egXG+PH5ZhLtzJE1qreM8Gpniwl5vO4ZQ3rsxq
+zAQyLoOP4hECbsyVT27LghGqMnwu8UbGoSw0hfbyuIW4qdXnA0hEaN+a0LEX1JFo8wiiPH4r1USJ
+JEcwHCDo7bSVzUQzBm6CouV6KpkS6sARFl1irmRl2d9ag16+h+zsLfTFLL5KilOm//oaOAH6JgdD
+kFSO7G1WLUDGoBKvhluYa3k072Dd8AksrZRtAyRlvoss1tgzAF8xgnTmvBShMB+YLMwXslOVfgB0
+z4VnOsHer2yVFPgRdh7ZvKy4DVLofS2WB+RqcB8P91V/oLMo1aM7+fBk+KTBKUKf4JQhfR4wQaPh
+6ej06Hh4eihka4pZfXpk77NGjJz3bvfn6WadA9eF16vsnO7GCE6BRnrX9F35JM1vM73BxfYiaLEH
+TMPP8C5yMXmSdFZBvOnwZOl82uTk1M2CAfu8zD/GWXATCviK8EWf8KNeJLbW5eIxRThe9rzErjUR
+I1+SKnjpLHuJzWquTkO2NniaXKvpJeKEIcfBBGS4Bg7jrjR1bnMoryo/9z6cF2kou88ycecWMREC
+BoRp9LSHVAH+sP99jO7151AuxsybRGl4k/yITOErXLIxLBiYYUugNGlnMOhSvN3RQb812mtZ1pId
+/6fCtTTZpwv+dttobdyIViqrCytgdCp9x6udTPiyba6q0CFOVkicbM4k5UxEif3hhzB7kywKhOYS
+xn0Ufy/fP0/Wmw/J+TJaf0qCdCE0CYzD68HswUDlpyPUNdLdsH806tso3UxFWM9uEmMZeMEcGFzJ
+CHnd2bgcWE8PBQZdkW3yQ1e5tYdbM26e4VtY1mPgnP5aKB55ZPW1PIT6QpDssTiHN3WUVdVha4Ar
+bON+qMLZkF0tK7qFKTi5TqoFpHpXlM88B+O8Ds6CkMai/yx6aIiWfOabN+iLk38z9KVKGhHQd/pN
+QeKgZxteJWSabtvuEz1Up9OleRVhcD3nqVfxpNI12tTSoOaJi418avRTAQmr+AI9wHNFssueTcJ9
+l8SfOnn/St6HLayCyjzED8hHHYE+6ujZqRfgUbQWPVPEoiZ5P8Xj+lQf1ydbqvvnzejgyRn9Cxv0
+s0I0KQQJMiJUPdBKCwNzSo7jjlXikdtsIs85qCQhpQQdVl0EmWFO2i2hUVUMKZBhLTOrvOyRNx3H
+xqr0zBv0cDRCj9Lznb40rY5gY6BToC9plItnttM2Q2OcCeQbXYV7kpi38otlpVBVJrqlyW4awaty
+PJC/qyBZLjQYlLH/lrHtqzG0oL8yipbaZEq4+LWn+9ldsCpD59e3LujNjxuNjG8oGluD1fFEqlVn
+iRec6cwxWRCipSaMrhmHclw1plBaDe9e7BC5DIp1zxymuceOU6/ejthRNBVDOhBLhVIKE3LC91IZ
+dCwRfpCac2ClNW5oMaXU8qgUtE24V4MdKx1tKuuNvM3pPY3ugW8yepQBy7npJPES2E6xMPmUtzk6
+620JAvVWSJ5aUWMyuSHw0CEsZFE5cWp3QRb/p4D8wyMOtFXLoEV7yNXBXmqXcnDI2K5hIaWqvjc4
+g/vm1eI3hntzvx7MTRG38lt0xnxf6vxG2rnIL0CAk377riR4KLTYlUQF/atMioL3koIQs4UKgJkH
+r2zZytBs/E5tG2oCBFb2Ry1z4j2OewfY8zTMMmX+LmzfP4UqVK9JXMeG8tIrW6GxlZo62Tb1ya4Y
+gNDsYrX722072ewaKMoPuowB8qS7u4LoEQ7nWKFbghC3sMzgfEux5MMxS5DO0jZQCavMlJMa8Hko
+u7IhGe+OaESn2alYnUlvLFgYaLcC01dQgcwhRY5xXI+GP8AFgzT2965T4mskiC9uqhkGmd9HptlB
+lBFmm0kwVfHll5jOXLRTeInUWeyfywDruLitzHbmaG2svBRsoFvQ9Wh3N4Krue0Uu7sF19koc4hn
++6LNKrNMQGWIjeZgCm1z6VipN32ArQ5Nh4GWpF0RVbtCfYWag/ube6iZwg6HsrtKT+vo6Nto44a8
+WkQnPC2d1lpJnYO0OCJCC3S8sAqMYIy+DVvnQfSFlT/iBvnELaKtotLKtNlwYkqDfdxplaHaZ+xy
+a+lGXYHcq87X0NKuKmF9cjbWJ6cr8mMNIDI98PgI3wRHDJJ0srJRuj05fBIKi0Rgu1ojMELVNsQG
+sF68dQ4PRqPBH6LzLxSdL8JPCTQqfBWvi/wpwfl0MDw9EoKzwMpj2fkOFUhd5dfXXUWxjO4Hc4Rr
+EDClkALkbR6+TfLoZvNyc4mqkHLiu/jlskip5L+Em4vkS0zXIjHClmJIhV9FLv9DsPoNBavi/7Uu
+ZP4v0YUUP0cXcv/b6kKKf48uZP1/QnK+KcnCN/+c5HxTkZzv/pCcn5acb6uS8+2/UnJeNEjOi9+/
+5LwqCY+rf5/kfGvs3be/keRcVhvc//aS8+YPybldchZEzyqqkjPChI7LZw/0eFwfwF9Rc8vru7Cd
+e2tl5UKOljj5tsn/lixIYWQR1j3LLXlkiuaICKDDJip4KM0Sq+KWxzTx32MmSw7xfg4kJ8z5pW2J
+Pgp3MbPUcSKdsBBjFM0LgJ9Gtx/pVfUiUUES1OM5/AH3GrLMyfW5MlHUz8+4Td3ulnZoDmNHo2KX
+mPXSOHSZwyezKdgssV6D17dCPTpSfhfljNm0So2ikwq7W6NKlglK9T1ZPL7zdNm8CqiUKKzWgYfc
+z/u2HMEGswAyQFWwIoKiyuywIhJun8YZSSvakEqfokxIagi4D/yLLgJJGdqljuutaXizTz1Fi9Gb
+JXC0DS1P+YlFmea4fpalU/JaU1KRy0LjB2MEZQO7tUkbGl+D1w7QId5khe5Ab7NmicaHrXyQ8jeu
+iJxs32M2G4ORphgg+kF2kdyyRTeEKqK8XJOG5RqrxZb4pQ/iGne/dHXFvLjIDaXhs1ObPJHXsEik
+BGWdK+4R50dcbsdfHyMNtF6a+Ch25FqHVhg6tLlUEF1Ei4/rBbxU06cJPBe2qTK6InlYQ8+GLdOE
+sdI6xImppQWyl07EBZl0UDuO7u4G/BPxD2etkNkUllAsrLTweWUQYvLWqXT7v6Ll8mO8gjblDYpE
+jhqCK0kUKVeVLOfrKkhkSMzhQbWrULlAx61UrRxYfjwIqZ6YTlobrHS/rnWxSXFbU7w4BWl2BRUm
+xD8mms4NOQuz+sW58zAwWGQjGGV19EMv8B9UEVJ9KW63bmE+LLboJA77KiZiNTI7Xm/dtUpebyVo
+9cq78YFcAQdISXWdKn09rVSdW3O5DO+UYvVOmyyrseRvsaDVCotyJVWt6wZV6/ofVrUWX1W1ljVy
+GyB+m5qiVYasYGVY16H4kMKWRY+s1MPy8Ik7k3A4asa4facyY8gAvD5nkNGrzRhMlPPCVPceP6ns
+JaWtXe4zkNXyGFQUvUej0ejkqYJZcUleBsPj0eCE4RyPDk5GfYOFjMoqmy7GNMncZ89g/szz/4GJ
+kd4+WyTz7FmI7OKeAOvcv8tXSz+S0Uy8bg+jX7QHJup53V14kiEeZ5OXjxmySMXRfgNCFdCeBQZd
+ASGOGtD5EwX7G3fuoyzKO4QOiUBMFN/lBtn7FbD+wW1IMh96NEJ6nMR7K1nYIrzvhPF9lCYx1kgv
+04tUPjC+8aITLBYRjk6w7NyFyzU87nzhiCXZPiHm7cSCmWYmOrKGw2Nb+v0hg/0+zJ1lKXqb0Pyx
+NQusR+jFebCGjlbCLM61imc5DWeoBCG729y0u83IjjaH54wNsvZ2GvUfX6J4kXx5fFS7JCfA1jyn
+8W5/UqYg6AL47K9T92zvv38I9n76vuj3z/t7+HNxRP9P6GZCNxO6GU4m8P/gmLIdHF/Q/wncDCb4
+ZAgl7NHPBf6nbMPBCT4579PN5BJuDvr9AdxcHOM7k1N6Mrk4x5uLCd1MJhez32vDvt/b7++dYtUv
+j7GaPtd5RNUcTKiaUX/27X88c+6eBEFZoH59VZpTtzKyggydxEG55vNwnWdCpZR5Q7QTfnw84J8R
+/ojoXTmQjE8F7PIYnChuSMzWwRzjNwmXnCz/mCkKD5K82p3xngoRTkUkTItasgB1Iz/Bgn/tBZzE
+QbouV+t8wz4BXrRlRB/onTI77CxwR0qTIltuYD29iuMw/e7Dm9fSxposY+XNOcYZguUdqVxolIp6
+gPMEqHacXy54AxKhh9Tj7zYLNmZVD/LNMuzKqCSdbpN+F/ltXJy41G8pECtwpiG5tOHVzoCY9um0
+y9/inJF1uo643xNQO92ZM+2SyI2DhxaXeE2pSGAniTgWEin5+vJzEd1DGl7vhXQzm7W0j+FHpv3Z
++B71LtzU3BlQU6eDWa213Xl5pPDkKg1ub8V1tg6XSxpmdcw1+xlDM6T69vPkdfJFAuzUqw6KPLkO
+MQocVgW7eJgC9b0O2QdNRcGi4ZgXmWgSfsAwxXBv67vgF7SmWn+XkAEmkPgeyB7MvCDbxPMONmqC
+1dHV1TLYoLYxT5NlJqcd/oZpZxFRkxby4gp2YqDsr2JxIdOvYeL/L3dvvt62se0LvoqEk8sAVyWZ
+1GQLNMzjQU6c2JZj2Zm0dXUhEpIQgwADgJJlifvPfpd+rX6SXkONACgr2fuc21/ni0WgUCjUuGrV
+Gn6rTrCk03j8iRAE3xY/cwizZOUinUzg41lRzGDPYuUdXOjnsM7ylRm8XL3K0TVuBUj05IDFndw/
+cEF9OFmpxpAbfpJ4msEsX0nrZHqIaX9xZm/da/jGvPpQ74l+ezManem8pqSKvG7g8vh+3+t3rCRv
+rPZKOFRf5VkR36+47e6FCVM9QwtntFLHGgJ5ohke5/cqdXdJqSUhcKOuFo4CZX2vsna+3sFDlpc+
+OPrHenjsH8E+h5GMzDZwaot/MbCPg2ZlWIsrE4hH2tM19hiEukZiQQ6SQwU7WoyQPyiIqIerqBra
+1NwIyfZRslUDobm99Q7UNeHp53xHzmVv1XUQoEdwZ5AnPrrXrmrIecoHWEY8Jy9HraJxIQBqCQGg
+hZghIwJIqwGts+lCBKAmqo+NMCySu6GGHqzGeB2hTH30k3WGTqPl7yCCiIf8MeVMggZkAOlwVPOM
+Egnb6eidVEUC2cDS9u7cUoXV7Lq3rQvHzua0HYNhgP7YcMKi5N1m8u3t4ImSc0DlfIZiyhnykFw5
+RW7wDDuMP1ZXL1gwPIXD4+2tupsIBMPxzzbqpELfu9EUp/9qP/QnfIGzHGXMgex2HFwKIkGRPBU7
+gh5g5K9mpQgPg2YHYdHkTRDSwGFMjiNd8taqmswwPjDmoV/DvcP2kAFTmxESd9cOugl5LL+QxQfM
+bBW9HkP0E+Q0VFjkI7chbw/hhF5TdIFmA9EqLVgQ25DX6xdJen5Rr8B+cE6Hl3UMJk47QVzGp+l4
+HTeUFZW4Xl2kZyjZn6kXx1k6W5/F9QVflbi/ACWEcx0HWpAhKbvS1tmcoJLPoG8hQb3NghxkniYF
+HLRiu2ZJjhvfOu536I0Lpyt4MVsvoEPT+ppvqCLomzdZpwLltc4Dm+76WTxNM3mN9NpcrceTP1DP
+wwk1TMbxhbq5zmRGFXeTbq64O86z69nFOkbmlJdFmUKvcnsv4OYLZIYzYPvhJVoLjPF4iLmgApfr
+n+U1/DlPc7hNp3AMtbomSxDrcR1nEt1iFeBCtngal5/gKeRWl9NUX9JusgLzraRxnRVVysgQKgW4
+6vGnHPf5WQzDBpVAWGfYiooqWR+szAoay3VgDuCMvaLrREMMnUJBO62qVnUxk/WiSzUQKGL4lODJ
+d35+YarhJpu6QHrxKVmfxFA+mmvaCcXZGbpLyhRsBMxT+xbhHdQ9YV0S5KVKsWqEt1fpBCY1Sn/W
+43x8gfIAvEZphfRUo3vTQjwrNzrTJJkWzPMUZRXrp+kk1TfkzIl3dbU+w16drlyux8iCniboPnm5
+jqI0/MrlejpJivMynl1Q+jTGKLIxT53LBBWJ68nZGYUdgBlF8+iaL/U0su+uV65gZPUUQpkajuIU
+arXyeZrB1vQZGvBp5bNc8Pfg9SRIoYIluUa8/Y7zQotjpg+FMXC3yJnKu3IMvLG6sy5huK/kJcWJ
+VtfX92JH71tFT8bjvbq62rjaIsnVYG9v7wF9zHMYNeisECkUcGp4mcF4yUsi9N7xv78qv755jdV5
+9CBX24lbJThyvULTMzwFlkVVHdCQ34uFHNyDR7/coG74vkzO5HueTvC4BDmmF5Tytc6kXRuqXZVj
+zMzvxMxr0Zl1+pRv/sUG9NHkS/HA+1G+cXIiY/m+evth//3bp68PVVTfj4f7nfF8xedotz/ob4kT
++t0Vh/T7UDyl30fiE/4OtsVzut8TB3TfF3/Q76Z4Rb9b4i3+bvbFB7rfEe/od1e8p/SBeEO/j8QL
++t0Tr/F3qy+e0e8yKyJl5yptXKG3VFAEkzL8HL1Ex2ToYKUD8QJoj05E0XCcQdqhSTuDw7vM+dSk
+spj4BGkGPPhkFcFbegmpz51UhLXH1AOTSpKCz1jyH9b3ivIqLicnOBcC6DTzyXkFJ9cKv/e2nXqC
+YdYpZId+NAXmClLemZQs/oLBpt6blNOsGH+CJFMenW4DGAWdBLvEn/NkI51A8guTPElO5+cniCuQ
+qH54bb0EOxNJASD5mVWD5DweX5/wGd1jsesX8TH6+ogqe2lzYPuzgdPEgdvaIJIyJFfrC3Ds+Njr
+JUcf4WCVHHn/+Z/qEx6iedHpzZz6fm4aNn0J0FLNll9b6FGKxBH4ygbMlamPaGP49ME/cn/lf/px
+vRKMggfB8AsBIPPpzpMHB+8fubf2ZY1NfL7B2D26Hr8YyxRo7DcGZAay9aVqlqoDcy6ZxWVyiFX4
+gKM0XJKurLWxPWTthn/aDu2qkcs0VZZiy5tJ/RJGMVha0KIDok3a0vV6bUtDAu9q29rVBObj9Hwe
+JYt2xgTNAmu2xqOyZCxw62V8UZohW82xX+kebnoPW6RT0LCj18u74jhLRB4riq+aJ3IP/0fuMZZV
+OxXRnBVOiqii2ET8HTzGsDH9xxjZtzhKj+F4Fh9Vx8OgWl8na20rxzBdXxeQjgNt5aVaI0ZUCodY
++KmCSYFJmLv/ZH29ur21c1tTFZP1du6twNzGPQl2MLyEVX51ASTRNxUIhqdADj4tFguYQtDb1zc4
+ycWy+akgUVFOgHE80wrFd3igJNAl+EU08tHPBEvsmTX7vQVqiBYS5/K8r8/yPyuUJz7MD3bNA+81
+0Ur5YMt6cKjpsHy41/HwNVFjztBn3KhN/hnoryfRL/L7yAXIwgbtpxvMcJtMm5sdmU6auQZd3+kH
+HZiLusN+kFO3EfyS5Fjd267O0zksLOgYdgTHsl7UgFtydA5VzV6qnZfbc6LS3/EuzamfdKraeDn9
+qUo/pJ36DW5Q/OSVfqIGktPfNtNpDBcWsq5VeT2rvvmG02TlD0I1Vd3+8J6rvX7N24DrCu095Yef
+m3dOJEtwx8vvFCvBL/8RGnYax1/b30SNQmo5Ip4nGnXzKbZ2PfJeMusBpNMnlXDghVaams4f1Lz6
+wV097+10ORvlo3chVvBkFl+jyJlg8U7Q1tc29oa3EKnYkFCNbuRuxD8uRyrVEkgHe5Ru5AiGDqIp
+3Rh9r0E4vWOJfGdv7/j9oaFNGznMMuzTgMKOoEUFmVA5HDlKdEnQf1p89liZ6JXxJGX5ryVn/p2N
+P09ISfSBYkJigI9GStQ+WWEVR1qZECpnqjL6qsOKA6BttvOa0dLW0PKYQoUlbYm3FUyr7HRQK/G7
+S55gFCspTKdsaGOxYUJ8r9xhQd20lT53OY4GmjIhKwmHK6HtGxsnYscg9y6zY/iuZbFdOk4+4gaq
+8HPTFkvD9ePHf24Zt3EN4GFdzGhgMfaO9Xpz0OlUN4EzTJ2s4KggWhQZnurZ81szBhoGgJTT1imL
+BrQ2onNl3ada4fPgD41XnJ/zFEOnVZpjDehfZSkYCFgUecAzwieTZC4xIQ8kU9efmrw1LiakS2Zt
+am5eWVaM1IW0EwoCZ7eygV438EB9qYwwcG86LSbXktDYPjOUbKr1q+1lVqvGaisxtAKDieBqzpVB
+lK1c77SYkqVJEM1yhIG2Tq7KGDVOZFG4IZ0hZMELq7+SxK6ZVPds2J9EIbmbgljJEsFTjZq+Mlmf
+qzZGP/oqOxuAyt+wDESjotGNW9MwFzLhZ+ndINW9GTxzSR8RUIf+UcqoUdPQqYo1QrXsCH7u1+YV
+oEeopzMq1ZpEOPrNUr4pS5Cz/ke/VlM3l3UxyqMyMBjkUT4iW2wkdZ5tNisvMDcZhtNdRAqWUD+j
+++ZjbePtVfPTacpePtg3CZo4ELCvgoXAGdTWnai4z8O6SZ3Vk1FBrd2QxodhO6M9YzCYt/3Cj747
+o3TEVN3pyrGkOZ2oqW5StLrazGWNTp4oTxqyuF7SHORp7m6A9uPTQ7mquxeGAY9n3L2r1NfGWYoH
+xjjKyFkhR2BYE7nuXq+82mCnru15occaI8s43RjVC+K/CNI1Z+bB5yuCe+7oubsphaDiSlNKafVs
+oXvWmsywLf+EoLFXeVK+kGQ1YGmKPAqgrs6p9FfaH7rZ7SnfLKa0d604cd0YI6CyypBJGYRSPPS6
+g+8xm1Ru8BKU2DRx7fwldjHuS2tRQrLdeuHXBoaBR0Hd0rCZaqaJo1JPDBgvY4KjdZk+3TuxiAq0
+4zzyvoF2HxXHqLe1wtkntrUn+gk0Z/c3yIOVx4pG0bWyC2Gjc99Ji4pAFCSG4HTZ94fqMe7CJNvg
+OsBo/Eh2+sxfcNUTu+rUWsS558lsB/XB1MoUTOCaPn+56PwyY4GvEgfMeaT5D8w6NLkqjoOFyiH5
+B6vaZjCqxPGqJ/LTbfLWsDbdGxgsR7WbO3t01yauZ+NXVoC9X2eJy0mwbbs+ZzOZYxZDYp/UUXP3
+lluQ1cpmazYDclZ1QxOUgfRiGzyJ1CQMmm9uYT9E5VH/eFFH5UJSdcaq9yjo1mLZns99g7PGGpG5
+015nU21tInISIG3gGVgGq5puWntkaXYb+33EaGhQmg4yU8q9ihD1O2hQblsOJ84JD47d0vRxyAR9
++ZAjL8CT1Zq2hu6ziXESLdUJobGiJ846c2z2+/0H1eW5hUBykdjiLRWs41JH6rizFMqMitQ7cpOe
+DVF+6c+b161wHXc2xPZidqm6lC4Bb3NnAdjbowuyCbmrKRz2A3VlSXou4xTRpja6u/iQpevTRKC/
+RhL550kHvO2G1jN+fP8KhvQMq62tY9HJPwlwFsiEqB5qeurD1ovilW5bcGBV0kvgUKx3vcfQnife
+Wq3c3P3AxFcM1rzHD+g5EIcpRi4uq5p2uaFzEyjGkG59+xmjdgB76GbH2ZxPOLv9DD2Muk5gbw6f
+zma9Hv1QwAeG7n1dwOn5pezAUdv07M78Dtau8uHmWYM+bOF5YjlbXBuCb6FKWY1CatrrlRxtKJap
+vd4W2buhjOaDsXkjbtrn5J/VSnXWfcThp0+B6MV5OiVDiFe1xO59jl5bKHc4JWORV2itcjCvUcLg
+Jh6iQVsj7Re0t+C0zy+z5LN1+R1C7sv7g3KCUnKdNC6y+dR8mW8rcqiRhZxxCVfq+h1ZZ1wm6v7w
+okQdtbx7m5zH9tMDrCDJUsp08hQmrbp+zyXKy/18Yt2hxaZ9i7Y26v451dC9s97mBLsAmaLKQGuj
+X8gUA+/QuOR5Fk9n6uZ7/Ujas9ClakRRzi5i7p46Pj1Mv7BLfToprijxC9kL0FVRTOlzaZYdmJLI
+isq6RxmNc4u2My+UdY6bxPY5Ju2NNsExaa2y1LRYiKskOvJ+SU4/pWj1PkUj2zfFF/h7YIMW7ScN
+3AFtANqO/EzRapg6AuWHG3kKWLUy9FkqedqS8uHR5DQ5So5HPmyadSAVm2G95s0+W3qXz0kDYIVw
+EEjNdZ3hk65TXalPa30nmKi3vu6h/xW0shQUYjXH4NNZEROZL8mw0htX1UtKCpRdoCkYg09TgNZi
+sbDBgk6TJbYyV0k3GA2QgrVERW3sN8LdwhMO/EXRcANxSjgN1F3s4E4yhhM60sAGMEdTchrkm1gu
+MTSlod9SLmv8wTgctFIu2Dtueq6d5PACGnKe5HIl0IqeJjWVNovLmKYzG//T/EeJH81++sTCoqmH
+Dk2FvydYcW3Ba1hTFZpoOZPd4C4HWw8R3mB4D/bcZuH1QcwtbbfPRbVDBy4rFV20vZMT2vMxavd9
+672L5rRWhWjumliA1ofpSfPtzcDmiJ+a7qVYuYk1vT0lVmiHLQA2vqEd8whngs0nPyMXQ4mONalM
+k+ae49b9OhobNdPmZdpK48jarWTkiGTiNK0qNJ0j005t+D1o2mj3rZ74ZMUmYbtvclxHyRnUS8to
+2Y8OkXbKEvivIoct8PxjpbgnivOWLHsaiC3qY7XVY0wiWGR5/Rbj5DLf9zzhQ+6B/P2Df82KeJUo
+PX6UFxTRsxtO5Xlz6Dcf9eVilxYg6P89SYZ8oIoLRCB4juFd9CMhtbO1M2feUgUOktEf+D/HAkqC
+ECoKKzM8wEAYOu+HhNGbDhIFjHAAxUV/0CnzD7zjVlKj4DsUkajtEPkqkd6QpuR3DYmMb6uo3ifG
+dc7OIlNMxjdYP+r3F0n0LhGvE9T3P0sc05aXmEka8hwkOs78HyiGwgIEttIq9ItzzLS7Wh+trfDL
+OLi8v8AQlIGVJ3fylBGGHx0modIxyoWnME1D+075nqrEF8X8NEvcjFZaM/sboEIJQZ20UjqzvgG+
+vp3SmfXjrHnfmY0xVTCyyWquBTAcaw7I5um8rklTJ1002BzfUm3CDUtl1B3yzbif0T3CpkK5bOxh
+qVVx2JG0tgwMHM2hFce2scC2BjDp9EMrHgeO70eaVFDYjAy0MOnPBCVy3cq8P9FaCcPAX6J/fUN7
+iGUR/pB0640nk300/kbLAAzf4XvojeEJKOTPROeSfpnLM0rVExxq6AsDy8oscX1RBcL4ynDsLNgx
+jq3kIsMqS4M2tsUxxGuNMDi3NOoSo4D7ETWcZF5GS/EXSSC+p7sf5N2P0HEyv6Ou/IaA7n5BEmT5
+0X7XWXm3/J8VPsuPiY3NYpTetvRFlByhHN7JyJWx5jBKcISVgviAAr/xNR284fg2nBQ3/dUIw1rv
+IsVFLi+Lzyt2wVHZcWqqa2mflGiswC1WRcXno7JhBvib2hUGvMWQUZGi9WhwCeeLCYmGLAJTG08o
+WkemNbQlNF7UsqpIB6XBWXtBHrbJpNsq4idVL+rAVYO7ptmwR49s2vmr2d26TLN1DaV22BgFoZaN
+vhF0fUBbn2AN2O4SdimNs0iYLMOh0fXLoTOlFwERCxlKuHAromJH2n5sPjoZySHFmMT5UIW/XLCN
+GUd8Rm4SX+UrPppg+XQ7jIccKtveMKBHC5gkQ5meu+n1MIbCqvQU2O7zRVdXU3hPyQBhLDJZxzIq
+oBNiIyZitANYIpmuTsbV4f3rpiKwJfmabhNvXPwQugAyqIdZlOmK4ehVEgZBNb67+BhLWVZ8jN9f
+Wnyz9XvIxlDz9ehhDzTz7fW5l9CTrKSFdMeEKs3uvjGel8jMsQtZWC/InsDdSwwURzLk1u6oJX17
+u6suHaREeeQI5K8cL3S/V6cRHcOTBMFyohI9Wq1VlwwlUJF8HVWUsqCmPZ2hXAv9tv6ovKBvq87u
+XvhJ3QQztVevIiRcrZo36dqa5gi4aSqi3Rap74CU1yKvRVGLuMYZmtYYy76S6qFM/s7l75gjrL2J
+Z2JmLs/onYs68qbIb6Db8QpdzWcrdTEfXzBYFl+j3xZdsKtWPP88RrZpZXKa8YV0wZLvyDsqU15D
+qehtjwXhL5czgc1+heCW2EkJn1q3nAlO0lQQ/BKiAV5AacTrkN8TYcjAe7PrlTFcANNQJytcrTGh
+/qxI4z083a+QXnlF6pot7xyzXU7qNvN8Q/b4yeQgDxMxKabERKBFWVgLcjs7vIavTl/ifhYOdm/h
+ZRLdUb6wEHyYQsFlDExOWYVH+bEdiENOlsaZksAA0lyf9OAOAbZDOdK88FllwJ1ba7s6vM+SGNnS
+rJ2bBhrd69R5ke6x6Hk7sxxBK7tKwReIc0N7J7TM5vRXk8B+/7yo9fSwOd2sqFoPZp2lWT11Xtu8
+TIebAWoGdM+jSfQIttJJ7Zt3hKUFUptVjWfJGpXLJUZZRLi9ENib5tDeIoyIPhub4VRFFhjMN7KD
++RYBoWziGRF3LRuUtDYbe1n4GinO8orWZ6jf8XjnuEsrTsevJY129t46+i2hkA/GhErNXyCcpDEt
+MCJrFiPHnRZlWl87IH7xxhyXHxw6Tsp5/ktaX6hsCKrY9UaOYNESNFJZzGwxj2XvEZJbcoT8du1Y
+F0Acnr2zqJ5+lZ8VxPmhLsC85ZLe69oylyalpc5pbO6snag1nMO+OX6rIfgV+8te+aI9PQRCAwhn
+BrqjZs1WPetKPeuE3SQ0GEe4UHRy9hUDDIceA4tQK+kytRe7WS0dGxQBXmamCncKaFjaaFkSpVjr
+YXd33Rj2GIUt1hOc1/hdyX38H+nOG6fDZE1Mn2moRJ2p10vdx+hNATMUerAiB31JWTWNyPhhRg+z
+xsM5P5zTw7l6yK5iJKQ+hTGdObdmYPYlybeboDTT7sSGTR7O/Th+iB+q12UF2zJCqDyHgya6n/vW
+s7coIcz0yr6qA/uM8ZnWhzHWs2wt95FQJsT+WVMluIH0lEfDBK9hRLS0C589lQDtdtPIQLXRNKTs
+GLDAjMQ+jgSi81kDsI8DYKXNOW1OaVZ3u71do4EEWu+cOTWEc8kZBQX+euWYh+ybArToC86rZwQD
+Yl4ZBpcE4a/kW/akOzOTTvf6SW0LyQzIYXnUsEs/Rp7YcR0tte5pLTmOvCt5jfEBSA1FqVO8gCRi
+GQ9rS0cKXFYIX/eeqgRPmOv9HL0Jdd5U6VOXv6FVrvZ7xLotf4c0iJC/LuNcs36U/YNOgfzmhqq1
+EE/RhEt8qh2YsOeK3D8lGansR7qhU/KhnZwovOmIkmmICQ6cttSyy36eHn+qnYIjDMVgsM5nMH2g
+VndaFEglm9XVSNBQ9aFg7HxpOH5Yb9iDZW5ERwY9QndnowExt4HTuc2KmPedAbLuWI5+UEfQ+Z5d
+WfSZbaSm1gR51XhWyYnwltOdz6HTrDmvvDOX7+Ho4sWnRUmQY/x7UAtTKs4WqIaVYiYpVMFK54ko
+PDi4oN8NX73TV5gmgR/Mow86YTLnQscqPI5K0PFykumsTgkmyrrKKcJzx/XE/cXztvXb5qMp7R2n
+abRFj9CjnJ9kgpA++uaFfYNqSefpGzehkj2E14f6umpXBRNbdcEOTAl9wLoqi3M8yLmXVZJ84ufW
+Vc1RBZwrcgJzr+p0CsdWBiimG4VW/BbGunaIiPCuYoKVsK4slf2buisEiWX/GW1qV1ZUeRf4szY4
+HhaRB5NrzS9gV2jonwsJmjSAY8U78rZAPByc3PIaDgZzvxBwJIT90Hc2+by4CiRe9Ys6emQq+loR
+PZKo9rQJNGYb7AgStOOjzcajbbGpHm03Hm2Jbakd24RHiriR2fXIxwybUOsQ39zCUjltILY2KRG5
+2cGeedBXmTd3dlXinoAbnXtr59G2evJI5d7u7+nsDwXe6fzbg0e7g4H+wq58BR/tbu4Ntnd2++rZ
+Dj7bfTjoP3q0C9+gtG2hEvhLg63tzcHDh5uP1EtbQifpgh/1d7b6u1u7OpPug0H/4Rbizm7qNgyE
+SYPTI7XKPvA9q121GE5emH6v4QxWDSWGfGkNSF+qxfpo8Etg2MnnGcLg0RsiRc0aLwGVVGGpUKS8
+lwMdxQFKCouIpoaBrMcWxpFq88OeMvTIorj3z3SIz7MRsEsw1bKAXpfdUvWgSHI3gkeVfMSHPllo
+CQUE8t3YfrdqvqYarsW4jBEGJfh+/wlcbA3Wv4FFEgSjfjh4/DgPHj8eBOsD0ecTfM3uAn0crV7K
+p+DXdJgqHmP5WpIIjS8Wqtl4SkG0o/w8k30VSNWrSWe9Td2Lcjy/DIMigs8jCBZVCGUE+S0TAcjz
+z0KbuJsBf1lbQAh9PkWt6zmy03OnAEIb2JNqZG5C69j3pVNUZHkvD6SXsQJLW9mUCdo5WXpyfax9
++M4/oSUjKJQXbCLz9tt5cXGrzI+svI/aWWll/5PEKb5JVetXPkiincEmSlqk77RTTI0vmMUnX4Hl
+t/toe2sHljq6BbhiafiocDztPtZO0Lh1Sy3/Z+3iQh8d01lha/CkZERnpdXXehNLFajP3e743Ua1
+iiuwPhg212aPQK6txYkJPN+Q+0JM7io4qvXsAg6TtYB1hEa+G+Psy9bmyFx2xcahOAEjeAaF+L9g
+Ex98X9/2g9v+Qvwiy8mKc/G9vH79dpNq/EMdWVvOxyopn+HZBeqqT5A/OlkaIiHxXU3+ElpXWBvr
+0tfJ7S3aCMiQgz+hC+nrZIh2Bgxs8T6BrU9l17ADPmSIg9vbl4TeYLR8Vsk/1v4Ptfip3jgFrpWO
+kLoY24WxbqBCInn5jizd/AIt2mAbpMllHXdRiPf4wsjwYFaRCNH5hkjNJNExPmKSa8gcjmas6PVI
+yJsHWltRSLOjxqe0iw18MTafI6Gd9U14tWnLi+LqhnGEliDLUqs6OucTtn5FKJxIS4Qsc2eUO+vM
+bYmQZe455Z535nZkyEqHqAW8apVxXChoNZTD/tA+TgK736FQU2qHZFmW5BSPUgFd7uzOci2ASNP3
+yrtMDuDiDfd4zhhO6GFh6W+b8y36lPi5LbaCaVcWMPiBCun4Oyou3cnCMng9rVJYfHV8PlRC39QR
+9hYo7I2NsLcY8vuOGJZfiZdLYrdIC4uC1/hOwatTeCydmwolzZGF6R4qhBSJEDFTDtK1/C3Lhm1X
+XrK5VKn5oLIcWuEySgqTISMlA8utI/ol5SiRcTLCpLStxEWsoVmGxroKWIYSj/FQfZQCoI3VkHs6
+X09YKEDxHREYpTzK12vOGsMFhXjU0poyUux9IgaP69FgXTs+W859pa3D/5RcP0djKGlciGareE/N
+GCkv6w2VDKRpsKUkg8Ctwb4b1cBpSqEVJQEP/hh1H2z7AHyExS/Epbbct6XHqZVs2blUZVM0aFQt
+artMUR5CJisnhB1FyOgSDf2EZb+vchSkWmDpEkzdkuQq2HV+QUGnSxXyB07kBRq07J1TaZ9xlB7T
+a/ALZ5QallGIiDdm11ZhaWRsDdpqk0kkl46O0aGfjNpJIYHNKlMG9o0axWWYyialFVYrPpfihAIO
+fpNIPtQRUh0QppsZly2r1Yop06yBwo+SUautPhyyI5Zb3qiZ4AehN88/5WjOZsC37AZRKdZ9hOB3
+yzovLgPGQ7DavSTAdruqjfdGrZQllWXF8rP56WnGBdkJFMBz+WBQhWUgMLuiCwHZORmVtDGGCGKX
+kqwU81KMSzEroxtq97sLtAHvi1P6YAVXXAHCmQDOGdi3wxpdHzpDFurHt7cv0DkNWod4Xa2p1oca
+fSjnFV0vxFkZwXqcAQW9KCNyhJyV4uYyTa4omgsQ5izEIM8TyncB+aYy3wXkY5S3XyErX/2Glc4Q
+5PVXfYVp0F3Jr/KX8tRl9mNyje+hzJov40xeoLiIr2CBvikmFD6JzKPC56Vgu0TqJ7zAfiqhk6A9
+vKK7uscKceXkhblxVhZTKUSl58YQeYRCcnkdOhnDRjELgUZ/U2p2++uefsjkN9kwuX20jhmjHwP+
+YYYHn0rsGLRf9jOyb+WeXh+X6hKmj07/zaT/FoTwAN6BXoYMgchwaqov/nZX/X5r1u83KAotIM9p
+8Kcw+Jd0ReM/hfFHGR7JeM+SEqdJIK5NBpwg7shQjlOTA6eaFpOSpUNfwAuzCt5I6Q4u5xM9CPT+
+lTVPxyqOO4obu5rmZFDNc9+ShptOIjZ6n+p5BY3+7NYYG81VOYGlu1+NQw/+xBij6RCdBU+Bcnsr
+nnidnNWh9xSOjld46YmPM3n7ceaJ9+S3xPd07QmKt8QpHALxRZKF3gsSlHvilxQeHhx64k2Sz0MF
+C4U3nng6m1WNpEMEkoXX+fc1Ij2KN8UXOE/ldMDCBeZ9zNMJdCyF9fEW4hDa8yj0nsXjTxJedS/0
+PsSnnoCDvfc8S+ISLrdCGY1RDHahfFzAcPmQvw8fgxso5GmGqfD+u3iOKLGb/RCj9VRck82HptPg
+MIndtbWFec/ReFlsbfM1d8PWDn5xAhfwve8LDPOx9dDp2a1HVs9u7bndut13OnUbSgPeIUEp8/au
+6d8BtvHlAC+gJi838QKq8XILL+Cdl9t4AS+83MELqMDLXbyAT798iBfw2ZePsKvgey/38GKABfbx
+iorGsjex7AEWvg2Fv51PuT8GWCt7qDY34TGKyWFYnsKwQHeGHlNIT8iODj1JR3FOwJz0JOGEwcdB
+CT1FXD1Lk/XJ4hNbG6g2OW0S3lE7Cf0RVleBOXyKXC5wS6urqLUyfN5zw/7BV2nTO7D2Doxf1wyH
+iXyrqtwJainhHgE68Zc8f5wJ61jJKvRMZcJlUVA2YkkiYpGDkZy9ITvXEl1/LhlhAhGUxmCmgNtb
+j+zBrCIPZeXwJQTvdOqFOIQCca6BgGXFmLmX+296ZTJL4lq+S1t/1zaoePcuqtfRB9R23PBlpZe8
+9tWG61ZjWVcX6fjiL1XgL38DCPEfRH4PgBC/cncfffgOyaeTAtAxajfuHPh9OK4j54SKshxd9d9Z
+iWlGTAr+IktSXyHrBu9xoeg6RLwSEMxpXF4zwX/r7m5st1jRJ3CD+6DvWVs3MQkd49w5HzqGGj/8
+wd2C7LgM99kz38l1R3t2ktVxJ5/CT9QuKfN5VxdJkr2wHq0nG1YazgPK+tvSIn9zivzNLvK3jiKd
+DB3P9Rd/JxYVLt7wXAnEe+qmdzBV3pTR0R7sVbDTwAZzLF6U0QwYrOfG8rOhkRavpaAAsykl+xt5
+Zlb3wKdBNq2Ct7Px2f4Zf+YDbMON8oE+vi7FS3rur74ob29fA8f36DH+HQyeRK+h0l/QYqBNlLY2
+A/GxdNyl/iw7jTl5JUkB1TriuL4xzrS1WlYMzKiXopbhb+4R3JGSHahMvJItI86JcVoy9qLapLgd
+o8WItUtrajRwNUkgwQcORFCcWPwaMVxawPMN9QRe/QL7Ink/olsrKmPVL56F8NpTN+tESj32qcUj
+DfrKwiZKDvjsBc1us1WF4QHwmkIUkAstcD1jylgnGf98Jvdb9ZV5SclXSYL+tdZW+70tkkGwEgUR
+aV+7djdKYKO9rOrR6uovZK8DvXQcOj5WlsLgh9KIBN+iMFD0H/t19DrHCC/aViAITMyhkkwdzkrf
+Cj3uKSMDlpyhSFj6HvIJNSxFJp2aqrBGK0xs3I9S0vZdU9L2O7X/D5TT2ZKq30q53f/mF+RcqcXR
+lki9NFHsZaVsI3o+Qv9aam8vlgjk1k2do7ac+9FawezTIgWnZX63Oc2wzN1oMrpE4Ul5LRoY5V0I
+5vCqzLxACKmchZlwCVWGiicIRrPaSUlub/ced5MYCyQNhQs/4rnxx5JWzfiC6A3WUG0OajDREgfG
+Rg6TLTJUfJeUcdIObG8tvR6M1XdKZ4sqLOw+mGs1FCgSQf686Ez1ewmnnYAMiEmgbFQv0oVUK13Y
++ZNVLhZliHMNTabUCSRm9LGhWPtSQEuB3cOYQl9pLHAZmiyxBBMLseSPutnsvmiMa6Qfo+FH+EYz
+LGr6ca9YGE+5NVvJ/7OR2zbKnFuZHWfKjnnOr2LnjzsnmXRrTKuRvgpdHBut6WHpLmqIUYj7ABIG
+DzBiVUJIaPUqUBMxy78WQtMs7jPTjDFfOhG42kj8Jqx4B05/rZ/WLmSpBq4l1AaCkbITWLmvxPXk
+ayXNSR0j7DzqD3MDxJavraFybnWWS9RXUaJhzO3tKjblCG+OEW4CfwNTUlvCfZErHe+QiLoDruPi
+0rRp3CS3bDWgXVSY1h/k7LaDR5fc4NYwWFiyltvqB6WsSB6TJfwT3Yc3+F6YC4lEUq8niyEiqich
+11l+A8qHwg7Z1QjxaJ0E5cG7wHTjSg+3EpOPap7bKtSpM/lWKVrBas0KeYIXoZStVdtPn8TtjNNT
+G+d9Lsn6bBB6Ul9UaXGOvEewKMRHRO8eyK/ADN9Jfg8PqP5gt7c0A9pb2Grd89w3ColERvcVdfQT
+bNNor1nVKJ4l0TFiSLx6WQLJlBvIkCMW8AzuwHaQcUJ/YdmTOiRuYBAY47JbSlfp1VL6m+G3fXRd
+dV4P9CahfVgtzuAy/7tMyAoSDIsTwTtCIrfPbswdOUnAJjn3wB8594rLMolBw3kcgWoIVZhyNGKq
+Mj28zqO7GXXkqTt3UXEqTd6v5O++/P2cOyz2id6SZDjwDZ4B5HpY6iLDPQdZalSGpQumOfysY/6d
+wtUpeoT+BOsFMY71BsRWjymsI8iEeNq4okZ5dMNGzvmGm1OgKbOVuJ9PFmGO9tcYuYuQLqAkWNhO
+TWAjdBM0Fl6aXBnYDTiCHqqCfWAZTaGCLw+YnOQb9q2gPZc+jcCb8ppT9QvW3QL6vdeDXWQ/F9QZ
+OAzItebItV7lyLZyNRy2tbbYVvlYhUeVbCsOWYNtrS22NUcNjNIIQl/Din8DnIT0KlQ/7Nkn/xZV
+ov5aTn5jI22VjoHKOxD/afdFuHju+jFODCaE46+43/BbjM8PjQcj/ZGsEf+uKCZn5TSbl9JXUf29
+xIC3+tdyb3zRdHN8Z/k7wt+Ps5WGr+YL22tzynAS0gFS/cUo8fIvRvjlP13ems+X+m6+cP045RV8
+Ce0HpIslXj6Xl+RhKf0s4eBFcXr5h7wu1U/Ly/R5l8fpvut6Spfc95cIRqa+zzfysGS5dcLpRuAc
+wiFb0X/IzEUOrLpKPqc8svvqgvwn6eq1vkILFro4wAutE+KrN/oKh54uDtRFofPTm7IT6V15/ca6
+xvfl5YG5LKw3qZSKpNLqpy7Ooc7mB3qKyqcrKp0ENfzX6aQBddL7Wmwa955D4Gpl5zZ4ccfrtuGt
+u9ybVxpo2599irzf0/zxoeIM4QbYP2kkfZgfPc2PYQCHY9+FRRFHxmNVWJZIx4EwWWnMvppVWqzr
+ci3XVuFYLTVe0KXf9cK84wAvKZdDLgpNHSxqACuteQay+k4WrsmsLscmg4qCqY+5pbf9vr/+vWcJ
+4nm+4nM2xpm2RxuqoUVSwtOTxCMgFxh63SOWoI/eakwa3ZYlrt9G0NWuoFW29u1oztF/U/nKycBr
+TfK/+QU6VH2CZUc+LSvS/2TF9UNZcZ1OVqRfyYr2JFkho9MV8hpZMd4fK667x4r27WjuDhh6U/ll
+2BTeIuUcRJU8MlakmeuK8cFwCbNysrDX/fOcOIXDxNrcaQ9XmyJWzqVqTiBNmOPAiPufgEMwPOFB
+gyc0PC6bk3B4WG+YNIyMStG2oxQxYuSIOR3BvlPQOBSAxIDjiG9kJI9vWpgue4/kaI6jX5JhE8kH
+GKrvCajnhyQao8sHhkeWMNaIHt5hBWXODH/I81sdsQ9HbTtktsDKXV+VnI2Nh3ha1FzXEE6cbIgo
+w80R1KA29tKn9nX0Ho5SjFHGxVZRjgZYWVRtqAOXmEcNGy4srYJE9TmBJotxr1d02uoAS6swqWA4
+CxiEOUZck9aGWKcU2pg+1vtFKrHPs8jn6gR31EX8GypCACrfqwFPoh+SBjSTJUR4ZQsRopQA5mAs
+1ryTE7Yj8obkcMgHjvcoD07EJkUeLRHSCr0e6ED1FmgC29oxbhWupzUy4S5jOBdMbTzirV0VpH3T
+Whwf6KSZHL3NjzHmAf7iBKy68TSf51QtFEG9w1rpELmB4Pu+ul84p/J3edP4dfuxXi/6nKCjOehH
+R9vHI/sGdXJROVwmBuz19lbtcx0cPTAukRsmQTkLo2wBTleySQoukGkLR66X0uLiNtrE6H4LnvYw
+XHGAHjU4XN6aX488bWTsyeELhimVWuH44RkcytgOBIwkGg8X6OSV0kBWdi+9b/XSO7ZJhqUsdUba
+JqoYbYaFdPHoh0X0e20ha6wMIOU3laJ0O2hkv0CzVGMXjx9L0OFGEpnVjyQL0Fy1REf3NMuoEphT
+5FAAfkF2dvlIj18xStrIa/itG9lTSm+DGMbFAgOtdGaHYsN7FHqfkuxQMm8cw3gmcbn0N/IHPZR5
+4dUmXanZEiQhid+GNrSWck5SJsLKGJsMq29vt9m+WtLEZdbTTAmhgbe3j+CnsqZvZQnRLKwteIGL
+ZsKnoKoUeFA6VP5aqaoQ1SjjGqGqzvfx4ZIKBaYymVWZrFkZ1XhYEwqPiCWU0mvf6SqoZ1ngfA8M
+hBhCPPmylgHDPGUoyoyhCQoUbCVZIHW2ZJgk05TfW3W3aPrgMxOpEMc+GD7Tio0XCnhZqzaeWaoN
+B9NcIk8KstMvMZDn0bHaEavoAy9MdrfQU7RS/X5WwibTjJNoqWWlbxvG8DD7iavftVXDWfRHaQPn
+aOeNecTXHmy21+0sDNwTeShraGc5JZadnvHX4jM4ovB9My8rSLDem4xhQGacbtWV9EQBDUnRyRIl
+tLGatO7nsxbuUCcskXWWwbqel03EIwvsCM8f4VIoJDzct5GR9L31TS3l0fcFDc2l83FLdiHzKamF
+fWu12yK2UNhbu7CVg5ojkP4hf1/VkOXUyfIWkz44VZBbGKRPnHSm2ZD83h3aYnatulV3OJ/LIO9+
++XcRm2z3HqdDLEGSm2L1igMkdSfWFK+PV2yqNta8r5hFq2MM5sudgdyBOIvGI0WbRtWap/FVCfaw
+Go5xiSv+9kJMIk1RJ5KiTiP/IpoELnAtUrELJGF6s5gCgb2IporTONN4Uv40+pL4E3GGjmVjFne+
+yCFhKi5QjyFmksJPIoVnt+g/HhtJakXno8wHtlPp+wvkJWzBaWUJTscLCe2HG9pDdGhjptgsMKnb
+szqc+spfhW9Z666RTSUB1Saf590e8oSr/pwgIi3z4dvb0jbCxkywDczR8nB+lBTHBJ9ze0tYnxXw
+JlpcX4yKkFJc7m1UufJvtTOwVkXaBYtsBG3MVf9Drborpi3FgxFVigGkELMH35qhA9Y8gCrvILYM
+j/CuuqRsrJwPQvwaIesBR0Dnhzl39BhIk5hGDcnTWdSQWk1kT1uCojnxXghfbnc5K4Kh2FeyWFfo
+dBa1BFdQtCzAwzXBm3E2qsKiQOftC5ky5xQ4y8gpNvanYrLmMT0UPM8CJYGfwfnA6czoAqrD8xE9
+2CLirn2oJxd1hkUx5YV56xR1IcaNomZQ1BjrOhVZrzcPpN7zLJpDY/pQ43GUDS+GF9Gz3IdFM1lb
+ozV7Ac+m0dlwOpzik2kQXMgncDqdrF8MgzGmQ8kTFV+7//hiHVb2GaafQV+odMwghw8Wr8LPx2VM
+CRYarN7/ZNGyqMXYcoXja0VJoEkvc5+QdsWYTnQKu0hTj5nMMoO+GlPcTXPe8ZhjwkEawXjlQagU
+QEZDWC3REDpA0BkCNyP2PHGcpM9DAnce/VRqIIDvS+TX4OLXMjiP5hKw9zyqmN+9jGI2kPGzqOqO
+pJvdHUm3aoWTlFWBjOdRlutjzzklnJOXZTD6AZhecU7zKPQv4dwI6RXaPTVNSPxLKNAJ/4Raug0T
+3RLqaoLq8bcphGIlVDrMdBlJUFw2+ly00Bixxy4DSw962dKDQqVO8+iS9JhKiRl0M27w9Ao1i90g
+jbx9ovqz7+7mFmfUwVhpjogUp+IEJxp2pMNANI7WyPZdS2bvDi5Vl8W6XtwaX5S4fBtscFPsyn6/
+p1GXkHbocJgNqXLYfAeFxkvfkGqG1ktSWqstJk6ViQQtg2/KEZltUnTQru81zKN7vc1NVi5Li8yO
+1yTI1fAUnr1ED6JPBQWA3GDbcUj9BgO9dLwDuU5H7TrA96Csb9Cy7DpCV1lYF3VpvGD9BI79wVJH
+WDTO7AdkfXiJetxcnNra21Oi4p9L/1QKd7qYjlOL6bhcBOJ6dEomoNF1qHbh6+jnkkENffUMWR5I
+f1aOXAOo5TOGxl0e735GQ6umxavyWt4ks1gydmdYax+tcfviiwxxbqkhdDR3inRSx7h/fYHO/FhK
+QOyGeSwD+i5oVoStCGTfKBflZrWZhVp9ASVLS2BEIMXhQn9nGC/lBU3Gsgg0Sgu/0ReSM++o0bB9
+yFxFkGS2WEdgY7Znxytp0E4RWWUGhCPlDDKIKzor9HqDx3zlmmitcCLDMFMnqwddttAqy6JV2yUj
+a62KWq4KHgoeINn3hCIibQ/I9MBWQtkzuFAzuKmokmdgaR66bG4XDUuEQs/exR9I8zjkmRYvvcjd
+6E43SvAcJrqgsBaOBDosLUus13kTa6o2hxWR4ylFIXJqZPaE3NnNuWSHfJPtQ0lMHRFLfsOPI4rG
+Qb2YI4IH4RBS3WPCPHDz1ZxPnVhkJjQjTVwsahvn5llu4a228LQnxY31tkT07/V2VhVMvza/YxbM
+ws9xBHiqm2KYK8bxnAU2ChG01ytRiDdUwriStBOakSOVQCsaCbNrLOfjzW+H2QSrV+d0bpmLQp4s
+gRf6gnGvYuyv1O5X+KQAdiosFEPZyKq6VuZDkMpSnwFRqqfn83I7mdQOYvolVyFcPkpjqT/zhrH3
+z930VkYR4d2dF0docQZKcqGMv5TXwCoQkHldvEQOZtGGEPjGMTB0A4/gdjeTZrJ0lxdwck9nJmhJ
+2xpPx7cyPFzXw4bLwvJ4Ulbw6K/kWJphg8NWUaf/0mn8WyUE6QMM3shcqlC133e+MkYXTvWSfSNf
+M8P5A623QTOUkh0a0fPI9i2xdSRyOuJiPC0m1xxI2X3Jpm8/GvtZGZR5iK865qgK0kJ9hRBK2EiQ
+jEV5PS3a9rXfsT4qYqyCFHpYWbQaUKb+MOHz2SOnJQZADckzKYm+oYBzMAm+WdVXIy9SEXz7luky
+cIz1+joxfd6Db2SgunptbdFRFwfYHz/6ey5B2X7Lo3uo38RPqLdjWvUyhan7jbf2Wy5+NamoeKw4
+NSl0qkZO5ie1eUIaD37BTAcED9dDcfRTfswaXI18ZkcgMPL1YaliD5QooLm9LfFVHfHY0Ey9Wmix
+6WNyqddRKQM0sHU0jay9cbFVNBWuQFOGMpsxiS1xXpaBLf/vjKpAEb+07XLC5WKYapQxsQQnMRIc
+eTnYMtf6UgdB0R4XhdzFdlQAGVZVJHZECidumAs/tuXESy9sAIijX7GSbktSZ9TqQqPJat1fLUFO
+4Jkyl1AAFVWBkGVZEa1bRrFz65s3ku2ABloRlClD/0lWUNx7Fa6jKo6y4ljwjwwhUayvW62ZFUzS
+s2JtTeXT7xt7BRmQ9axAkN6LIoIKnQGXNaUrlIGcF9FZYSp8WbiAiOTTIE+2uNIJwHBVQwHBq8qy
+w93DkWORC+RVTtM2eyMD93zMp3H1KeEoutIH36YGS19803qNEcyA/5JB5AtGDY6PoDPqIzN+JBJL
+7EoGf6l22KH3rlQUByK2kO/taWfR+7H1CvWsNbinBfAPMDWmMFTwc2GHh7sqLIXbRaEGGqVkRdPm
+ZRdtXmZYAOq9Z1igsB1x9oumfY4ziomiMHY1RUfUsRxVcnYfGLKiWUQcGlQfNnL6JOZa9el50gqU
+1H8kfiCjB++jRKBBps2JRM8gdRpI3VnlUWPUkYNbNpAJnAacgby9PStwfZhOFtyXierLqX5CQGXG
+Fv7ufl1txffZ3cNG4aFUDsk5jHx+/6rCIcSZLrqiQSjT9eCTHSmDg4mn8vdTcReU4HPnaRPjXhw4
+j1nfpB/+4b57UcyzyW9pkk3EK/ebyZ/zpKrfwR5bi7fOIxh18cFJwSnE3a7q+BoY8ky8c3K9mk6T
+SQrdrhvyvvgqqOIbJ0sDs/+F8/B1caWfvHa/PMnMR58R6X2pDD1gnb4qRq8KF/XoixyIj/L3zwLl
+ED8X0VugBOKbIhok209+LkZvnffkLMc86z8XliftL0g/5MniA1xLa5V3hTrl7+2xDvO9SXnEKW9M
+ykNOeWFSdjnltUnZ0QKa5tbrBnX9npZlAyB1b0+V867gkvc0cul7lfJQpbxRKbsq5YVK0UCrr4t7
+1ueHouHvRxWEhSDdLzXHXTTiRauczwttz6AZ6MK3QQA/Fgp462MxVAN7gC8vfi9sB8vf5Xurfxaa
+gfsCL+MkUDBjbETBrMmXYgjV39tzYr2wU50bolTu4gSqD0d+ONX6qNPg876O2LFAxAM5AaUzFdoy
+Uvfp2sAGClm+aGC9NUTOhj54V4jvMMSbtuqgmbvgcIm/FdH+xntin3m5PsPSgWSdpedmsv5UaAEe
+OVlpCC7gwxtxsWuGZahZ+OFkNCzaUYmxmPGHbCwtvFjXG5pYILagS2IJgih/y7gJghjjcosjyCDz
+WkxqbLjGX/WGMAS6+yuGF2IW6kTyUHDBzxnUzXI8i2MjemoKmcpmmEFf8g8MW1sHxD9Z4h44bvll
+RxaWpdhPbqEO7GrtJgpzpvAb+YOhJTyyuGfZAOigRHBfUWfa0RwnCeHv5uM0qYyFl3L85C5it1sZ
+CakiSzD/dUqGbm7Gpn92FeuJVMaswl0dsNUcIVMjo9EWWMBZRIE6b0mruhJbgHjpKh0mOEIn0FfD
+RBSnsHVcJpNnaV2F0FOYSnNCRRqpY0f2Fje2+60+8mXQP8BXxk6fRDfU6LAv7IbCN2ToZpKDkgic
+xgyLiOn4H2kwosSdYgyeFzuuenOasckG62V+micwE28wojpDqDRibHJVnsWVhPAP+TgSt9OqCzgq
+TsIbCcEs+yQ5O0vGtaq3Ofmo6RI5FRG1Uy1WJtbLq6qvW9WUk8WkNOuMM8x5LusP3Bpd6JonG/LK
+lvHNYnsHudGQ0ShqhjGEMYMzKmH4XaPpPPfQWPJFfGcmjin2zExie+FY7Q8UTWDelqoaKNjroSIA
+o1rOi9CXVyX9iFKmk9MfvwQkwPLRjt0ToD02eZMOGYPeMqL4onlHTeXWJ2mq1TAUszbGCF6BnYpt
+Oq0+LQ0kN/duSdSBuphCk+luLjfklenrckNdWj2uOioeFWj0GGKIUuqYlKS/eNncKN1XavOKpKCU
+qlFo7Wma3zFNi+a0jNU8zJvzMNfzUMggbfayKHV0LZTbNOZ2MGp1dYS4jFx/0cxub0yT2LWNjt1J
+MWTiojCRm2uugrTGMsPoqtwyPWstsT8GunOfRjoe+TyCJRTNqdZD/jF7DCoIRmk0Dit+MIZvz+m1
+2ZJpK6FPztDgaOZM3EaVh2cqTgJ/52w0a/XmODxT3501e3POlmDKSDiQZjWxNS2qCEhFBI3j1sLG
+j9a5OMmpCRdwo5cAcQB5Lwuk1a42XqF2UCWstXPBK6ZPiyV1FkvaXixp12IJlPntBDbGaZQqzWgG
+U+ciKsWUYztLA3jcZluydH8STdXXYJGfRRPGkrgQZ0KZYaA0Opo4ZgQrW+GEQ1JH69v9vYc9eXe7
+uz2UFvh6o0Xjuru/O3I+Gk6MBfAZc5Z2XVQMBJzf/YUKTWf6h2T1XJloa1NNQYodLJdpMNKX0VF6
+HGasPUpVOMaLjmHK/oVhUnWYjfxxNIsuxDw6C0I9Jy4E1DQbOobhKT0KHHPx9voMlPU7WqXjJpJZ
+a6+5viNM6ljBC0WeZmS/dxYIa/5HcxG3l1S77Jn4CI2ohOQO6crhVqIzG1gjtmRlKM2SoyH0lcOi
+csR01HLUxmWrtl22anbZUn1v0ZJCQoOoR7JgWBwd8rKWoG5vgDrdYcHTE8FBiG87j2FPTeAYtkFm
+JzkKmoDxPqssga1FnUtpUogbq89RURvR0kd1yCcodvp3e64UDPnLfHfP3V6soZJCpOs4ukmrN8Wc
+cJLbYHYEdplsuCIs5PopJDp+CXjD/E8s/DCpeaNs+hR0FCAFzmeZj94zFxkexuMIuDHUSA9jtVT0
+CQYPMLEZFmg3sVhxICbwrihQeqjq8T6ZZfE4+bfVBRZxNBD/Wp1eFuVYsQauxcuyCpVcoVxWqMAK
+UfALskqINmUlyDnKVKKWlShkJXKKomAm2mns+GKmWpvcJrkNSTtL/fQM5rYgzHhXOnQdFh6uWqBK
+iIZjQSyl1bt5mbBcQb2MGDlnOTUTcp+hf2BsK3+uYlciuwoLLjpD1UFtqzeG3WB7K7Gx5RjFERw0
+4yD0i+ga/QdH50VoiYhjYoNZzp+7pVewAEnBUgQhamEYnKMmQ4TWatQ6PupJy0VPpozkLx8l1Amp
+jK5jYfU+BgFoThLYw/9VhUjxVxUiFj+5b1GsRLVGdGjfa0IeouH9Jc2y98k4SS8TEvegxdTShzQL
+ugv8+Pbw6cv9kzvL/VoeLl7Wm8UM17DPtymIX6tcoiGp+Bw33Q1tDUFBoHJoKSPNipp0XBS0CUTn
+saCj/FDZ4Ngz+c45XKisai7HnXPZZKNpCytK0GmgJBK1tHodJAFrd57UL5ISupGzvSyLKcvu0FyY
+eyTGfWlJsUuGdFmpt7cd2QtC68njGdCemu3RmOzYuc023TkXaMdDG+6O/O2M6IWqzMNEZ426Cu9K
+9YPu9++o4x0PoTTUGRaKvCyZwoUaDelrfI/hD77SzBfpRFbP8M/bzFKcxNHTsoyvgcTTr9l9Dm1O
+zghG0EzrLOgcDHTgaIHqsXBw44Q8W6QdhHM7WJWxzlsiuz0JH4DIVnqtLjq1edsPUfMmw2t53poO
+3Gii3GvqDvW3q29NbHpCPxsnbIP1Pjkj9xxKDGFe2UwXC6BzZg9RoX4eM/4T04qbhY6DnIxkMFlU
+kYf4B1mx2v4K6ovZ94GNv6wedNu6+Wg7CNgmQHVjI8Nen0LAte2NnhpJl7FIW9UYZ43+HwjviIdT
+ojgeo6FQC35RGf1IoMJgJOfAChxYLxDpo1q58dZcSMSNP4o09z2x4gVr3sILa5tx+BS3o+/wcUKx
+EjWdUfbpPKEE9/lIogRyMsbasXIBwxfWfNqRCe5TKaOTt6yRkIfgR9bppqSdiDRHrnmnrULIh0HN
+OxYK5ypp0dVtyGMUEIkK7KuKqYeBnrLQbci9oXc8XlPcQXlL2Mmon8EmNT5m2/XYolP42PcZ27ny
++1EfORh+uQXgEPvqsKOsofmdXCTKw5DYLuMpNCKxJOUKHpcjqCV35SbakOehe19a6gQrBnuiw4xb
+JZNgWr7rcDiZdSQzHc02eLvUhWjs5MPi/D2DscEAIhPyr5FKFdQ+wJ6MGwdNNyfdQkbt/IyMwbmR
+sH8d8gLkFMHBUaAvqExmMajks0gTV5GbL+VYgzz6AWpI7wryq1BvssW2U/Ol5Vgy/zs6Zlt1DBrG
+L3GPZ8s2O8XNnE5n3GrCDaHcbhJ1+m/36XTLjvXoeNkIzPSRKO5o0UNnqH+0v4qs1F8b7DNr/2ub
+5HZZ4kqSwLPMw8Dz8uvuN2z0WVsrpuaSiV+48c03/FhK+j4r740SZ0nNs0SShI5ZUtqzhA8tCKqi
+a1KywO0k1PX+DaFmOyuNe9NJTIZBf/oaSpd72bzCPIvTWLnpdFsyXjS5ctUHI2pVqPQXrf4vO/q/
+DNxFyWbqIdMGGA4VjrLV95YVp+77clnfr9CqJK6AlylcHo7kvORB0DOZbPZDQzVC7QyiO90qbuzm
+kz1eUo+XQXfjzHpg5zrq7XJJb09sT4KuXu2yL9cxiWmcgVdALIhSBY6EbiXJS1e35hagiOrWfGm3
+qqIV2ghtemVIv+prud3hiBOUNzsc2GR6ATqdnmPd3P4eW82481vo3MdDkNMQ6BCYK7OunqBvqzGo
+kQh3jsEUGHzyiDWGFqyAEOw7K2ZRigJ/2JLPHWfaWa83fazwg4ZTlJHOeI99Mh3559FMejwHIVxr
+ToB9Vy/gozNRHU2PUdpuBM+XrMqIlCrjXIriF7DTzvQGfGlvwDUWhQghsX8JDZlqHnc8mkeX4Viz
+EZfQoEuo0zl24pR8SVyvqpJLmlv1mUljjEZDFZcxi84QhYqbgWdYrMWsVYuZVYsZ1GKmDVLmhNkC
+/USfXvaZ82gCpRZian8q0ZP53O6O2QZz9qoF5zSVpiH9cjedtyp4blXwHCpoef9giW00KmNfid2f
+oAuWmFs40ITNRUhtDAzxp8/D3HFIGzePTjv9wJoSfhaNmZOHZreyDgKDs4aqM5qw06iCqYratEtO
+uJY6C19bwE97vdXrjQkcRofna2tWhuBmKucwbNmX0VR61gchXDtz+JTm8FRcs1cnNNWaNqd6GiMY
+xRSmtZnGUz2NT5vTeIrIVrF/Cn13HhhNzjg6DfUCik5hCp9CtS5xGnMb7AkMZYytmkzlBF7SWuMd
+ihPZtIUBIWL/ulWVa6sq11CVaz1VxjSXpzSXp8HXvzjxpzCnzxtfNdP62u6eaWNaX9O0Pg+veVr/
+1bpSifeb1mNFNi3JOwqoM57b8+guKVuvF+sdQo97TLG55oSPFjf2C4VPeFehOLzjQO5fcXv/kpgN
+Y/4QwmKoaT9nx4+53OHHdPcwUqAaHItY1Rf6Apo6Vz0YCDo4oJKuUWWLu4JDo9bj6kDH88ZBhD/x
+leJtTnGOwvG8+ytUiFpeNd1Ai3WxC9MeOvkAc9isv+IVUWeJk8ltDvLlGTK3XBDnUZV0mNvMYW6x
+ypldUqb3X8S7UzyAHKu5nBTmxM6qRB4nBrzcJsaA3R+XnY6od50UN7N7FKLcbhKPSt4YFWjN0sNQ
+czByezBcmcMCCgOGPnZ7zJRjd9Ciix2MO9jBONDBxYH3iy2AwV3VYSN/SaPaY80twAy/31FRIDgS
+fewEZeeqBlNNGvDRn9aTc+fJuNd7KpV92mY1RkwetagTx5iCjRA2Q2nswKkyebATNvfETfGDb4IE
+aPWYZyRw3EjWLz+Po08x2gSLA74aBOIP9KYRr2K0jv0DKvlWX31QV0Ys+y5WoQihFX807RwHD7eN
+r4Ylw3kfO76vs8L/EEsHlbex9K54FQv8ZmJFt1BW47oT6shHOZMKLaARheCVeJpQSM6P71+FE944
+4HQQNHAZ6whd5FGQTP6MIztyRlgHTjEqNj07nxHeymKM9VTVtU/rb2J24MGnY2wV/XyIbV916jro
+wA+xdiIZshAXEl+ZRFFSLaUlcTCspVVuo7dKW2r5mgp/qwuRdpROjVjk/owGtW+N6ctYOZlKTzhL
+Bnijwt3XPEmlgbmrALCsIkpjM0WS9kkiQ9xPAuMQSk6jxlOUgSBM6Cq1kwz21HcdZaj6NCl9NjBu
+c5wdlBMpz0dL4t3tnpTTdZTqulIGN/JCrfiaJJmUpHERF+SiieYiPJu0uJXqp/hE26SmlsWhtEoW
+3HTJr3WmhS5DV0Je2FLVliPsF2lu/VH+/una/P7smHV+k/k7Qpu+IJ576ezT3ov91/sf9l94QkoV
+7ARbuWzLb5SIWpvTWELtkW/fuaLxpCEaTxzRuPvU8ml3iYhNNXdC232xoXyBoSOjcE1WGI7MwkrS
+T9tAThIKI5CB000/rPblrr4btj7nyUhhbAsllZNbTh3uLPeOCIG/KPr7Z6w0QB9j9jVWa5OUM7Kz
+WFmAYrLcLx23cbSaUJm0Ibm0uxv0N3d6SlW3yROLDVBhzgFBgqn1BenPAm9x/kHptRXLKVAOBneV
+h+/a/kKaCBlfA+0VYSFVWN7DtlMxh5OSr1HRxvNHb1no2BKbcFXkgaMZCqoA1a0vpHlrrSYUOiSv
+aodk7yKJJwqdF7345fXqNznLIm0CFUgTMxglIKdyUYqau8weEPwI14HobaJ5dLSqsi3DXd0rRQ1V
+NJZB9VqatIcB2XOqzrW+Kv6Kh7901bfc+W947F1AAs0fah//bzzeGZDgq6sRXQF9lG7/VgkLSdHk
+mQLuvsQj+oxhbe0PslizHXzsR9yT6W2LQBLp/C4m+EntjxU7gaygRx5/F9t+Vd/FR8nxxslVUX56
+haHeCJf/56Ss4G0Z/5aFZfq1qM8OUXHDIepFCuwFkK+kFD/Fy52lxK8xKsVS6aUkf0v5m6dkzpQ6
+9D5OfeXEpUd+c2DzCGnqeBq4seRc/PpaA6A0sOxxPnIMOAoAd3f0tyq1Lcjow9CuGNuFljJt86eG
+L4hMYsPPvvjNcDcmUp66cksbvUvD9ynwbiVZ5kFfBTfQo+gUB5WgnhOr/ubOk7i1WvooZYrXogF2
+t+r5jnpZtXljviRdCOB7yDRYeT6kotbqhdRoW9gel8ebP5g4o1y36tfvZLGz1FfehzdOV7C5mOWa
+oG6pLXz7p7m0HCZc/VaZjpK0aUAKtQ3hT8mtQJ4gtdSVqe9MtlRVEMox1vmauKGFYLvmcitRHSXp
+8vI6hbIuFk8Ks5wmXIroKQiraKMkNWkl9m6rC318N2gYPZkuhboZu37TtTKZPWn+VEl/smNNy4b7
+Xv2rz3RWN49TB+unw7JkhOjZoaWvnqXGgARHSSBLTxWzhIllq29wZbDjyHt0YCuTyftkMh8nsEtI
+S5k6JXNp02yMCdD2+Yhtq55CAUUVPHD8I11thPS4qRZWqRFia5WurblTXiHLInGEHpohA4ZXUawQ
+ZIuh9D1CHxNyvUBHi1/j3jgggZlBpvIzKdRkhz0MjkBdGc43+EIk8XlSyv6AVPuWn/FckU94rlgO
+F1BV9yU6n9q5QzSVVZ+TcWRZEs5VGv+bq6ROONnIr6IsmqE/VxDqfpgBGWfSfBuNyUh/vJhLVx3X
+kWre681pXEyJUFQoy6nEGCHlGtsBBnOQPqCtjQJSjG16LO94YmTCnZ/yDblsjhpFQeaJ3I+PLcX7
+f9XiMF+DRaKnL1kkLztOw1wu215RsFTUYoH5C8yuH4tKTQwKsGINQsVdP8Z4GPfv5Fh7tpreJfMX
+q+eDzs6OVWdDEVavXqSuPXa9gagOkofCYDg+2qMV83KcKEO6+m6Oy+6mEdKECCYVc8hzgmUA3opd
+mAWk4qpmJwQKpPo1Zk4AJ0e+OzUh7xmdi1VLngEmo9CTAZValt47bcUaqayp1PQN2dpWloex20uk
+9zDfF1lk+AsgZtnGXA6NE1xB1hz6v2D4AtRyRPOjwbGYwU//eDiH/WXIfnFNw96L6IysCcUkurAt
+aRH+eYPrMTzDy/kpYsid8lQ/hx3eeCe7E+sGiwsvBL8c1kK/GuYLQW3go7/TCOfjUSku0PxM348l
+ZoLdO0PJqVZojHiD7JnVc5AO5Aun/xgPXAk6TpyjfWtz5tzC2bNQC5BSMHc7H7yKMvX8PEv0i5ql
+ziPzlKLrCORE+o9N7JCtwfo3tZ+iLmPw+HE2zI+yYyhBpL3on/PFAnaHoxIGLz8OlvaQYtZMM0Uz
+xEbSGMMycrqRACfghGh1lDKApe4ZdnVP3ugeKzivUz0Zqokg8uEfNIibM0ZEfgT8hYupqPnijAOe
+wqp1PMuFIp/GIb1BasNxKloUKZwtAk15o3H0NrWi8iQpClTnTN8j1FCZzUTaQ+iVAw/duTy3qOEs
+EDPLzCJtoIgADaC9pAkhcm5tNsjCD5dycQlFh0Zs8RaxtqkyaizI841b9G/pwMTuwI7ua+2riUX4
+L9OWDSBUC30eEzEuE+KfxSSp6rK4Dku4mlVh3sEfIwxb6vjsjnzixpSsUKEPOJnooGnJE6UDtAT8
+ZSm1nQF1CR3ZMVqw604PjZQukY0PBLbx6nXqgFNZiHACR7sxjtaLp6lZ0/NWTsvNqbW30CyCTpCG
+9ug74n4FBmRwi69IJEytlMpZKGnbb+63isfKDFVoc+ulYTN8nT7gBtK7v07bbE4cpRty7C1VXpoC
+Q4gPZkaATwVD1bEyMeqz7tXE2IUKc05K2Hc7g12xLVzDyxM31353rsNWrm2x2cjz9OsnM17SAg9o
+wiaXvozsJhG9TUYtUEic/I5kZKFCeVlW7U2CVMpjN4bqlqEcj5Jj/p6QrXmaOvHLoIo26XqOU9Tc
+HqS2voGmSW2mSS0F3poVdqeCK0I3lns1TQbYKoBdCUY5cCuh33g3OoLPHqP5hgnP+N9VFR6Tr1fo
+lVMhBOsiQKdHT8oRAmA5O3VCkE1A5THHw8eQ42EjB5fyU7xRl3HOgN5D5y4a0F6ekHq39k0ELjdX
+uXCwtN82OPSGkysfMgt1yCzd0yXvBuZM2ZAlAcdTN+UAGFVtJI/3cegrNA6mslLggY6E+vwTI99k
+gaISWEyqwVBTGCBKCYo0QuFZX0t7XDdn9fHbW0yXh1gDTuSnckdo7I1BoBC5qkYG3vSyKAVusyTO
+M3aP8qmIrTM2YlrkPiFPSwWgZpoWxh2Y5McfUmSYY+VYGVaxAPZPge2FcUq38iHfyc2Qb15NZwlG
+qr1Mvo/zSZbI5NfxdTGvnZzozSkv1ZiquzN5xSPL1y+S0/k54Q3phLMEKNDETvug55r6CPOPh8z7
+c9rBLAZW5dUEiFd6lsrPKmC9tHqbXL1PkEDBWbYMVwcL8e7uPnFdppXxcHuzhVXaIgmwahd2j3LR
+sp8+d/do02v8PvT16g762hqfzgZdWfuNHj43J5MJi6lc6SCC4k4StrCnQ7OhTCW6ykfNzggDRiBW
+VlN6Y/GqNfOq+d/kVZMOVrX+Gquat1lVNcuvrVl+3pzlzztmedsbj9h5FBjB9iBy+BloSFpgPppE
+vL4HESeI5m76XdPBECaVKBeN5dY6+UHFaDOIEjzxyyohdxq9cjoJNzcsFIZ/0V6xX58Ceedx/8Y6
+duKR37rj7UFKAxJLGgBtmiAfKE9MnaTCaqalEqcwk66vpMTsUsaT4Ruh/Abhm2SQenCGMM1dZ1bE
+aUalcOl7Zeit+b/na2su2Hhgi352Atq9S+zyOrAmAMc5RRk/A9P6hoNFBhN41h1nm7/jewtlSiYd
+XoSGOsSPRkvfg3x309b3d9PWg3QpdTzppo6f2vvNodlv/nD2m5neb06tldiWccxSf5zyjPjLC5Tf
+7V6hJ/9HVyjXzKzOIzwCGnzvruU4Tb+2Kpweg7K/Mvxv/k8O/9k9h//sXxj+s//PDv/Zf+Hwn91z
++F+kDYuEA/TvFq9dQ4NnlkxAWsppffzogJyPOLhPHoTPY7KIoUwN3O6Xqe0OxgFYcCfXkm/YOdTQ
+pFgMAmRHlklBTCnaEOB1qj19b6OBkLUk7b+y9QsbuJJNHEp6uRet7wweKrypXvRPOPJUVFZhn5a+
+tG0btE5ZCjxK2xqtwxcGDiC/ZH4a3N5qtil1MG5NnAcCU4jLxMpaOllHKC01rrvSJYysLNCkOZbW
+6tSrIrGMHeUAJtQ5BF+0I9izE04tH7mRqWykNilOFZw9Akj5RS8OKOBR6po+Cb+MbORH2YZgBHM0
+D3w85SD8b0kgB1SzYCS7Og5CezDZYzzVfsd3NMOM0MfuEWJ7sjM0J3Jr264NvkKTn5oZ9wpjTiqN
+UuQsEbraQw7RuvVoWxm8GTRb1XvfWHPYqu+fLQ2ZbUDIMGTKiSG+22iCzLUu0gkQBY/M4nES3N56
+avWvT5BOriNEAhDmdcjJhvcyZyCD2KIZLd0HTYkzQV2+ZuTahficcfBv67jtayzd7Z5xHtW2HvGI
+oeBYi1CGpTbzqS3oYQPIuy3uqEEia5AwORjeo7Jd1QhLabeoH/p55FSz02YJjnRRKUvVbLCkPoi/
+r+amGeifHWkQEzpNPIwvsF79iZyX5CBsMAhgYWw+subPNw5BZSp6XfhlA+NnqB0oLtH/PA6Eoq7l
+X6au5X8Vdf0lbfgKU0NUq1b7w88IXsTDRWbPkEVvEtowW0NdmY70LQGSMu1qJchmbgbiSqFPiM/y
+irchKVta6aD61ndF1TRXH6YS6qmSxiipAlwSc4l0sBTOyUStnY/mCOM0D8I5D+K8a6CVc1m5FDFJ
+zDpjYHUCKaXLgJSGs04opfQe0Fudry3ND+cwtGXIb2856nOvt49jgpvTPBAGlPasbU8hcYvOEM0I
+eYIURzFr5hOyeFxo2e2tifUBXwRi0NVVDGSFE2OMk6RVJMV4zuLb21POBbsMQo6i9+PI/ysdtxyD
+Ku3GoOocwq4i03sjT91Zs/Qu5KklxXUhRNUGIQooyt95TTTWXNQyLooyCjspH6rZkQm9GKM5spp/
+9/sINsi06cYlBxIBvU0WYAHXynPRAXIZVeFPhULaqEyt57B0G/zBmUVLmrTDz1ziYoTd2ShDUpKh
+YReRkmw5Kbm4g5QM/XEXLbn4a7Qk+O8lJTO51m1SkklSIlpkRHSSkSGjJDdJjlX85F6k5MKQkgsk
+JZMOUjK3ScmcSMkEvRVH/vgvdBx39X26TCHl3YOYqEI7k/2c6nl/mmJKW/5UFnoP0qJKcxbpX5mW
+9qubO7suYVq966O3t5VjnC5n61nbYh1hAzurt/rV6v29j2BD7kEqJ12kcmKRSsQ8nv//p0eYeCuf
+IJcftryVGmdLydJLUPyGDyJHAOv1VlN1Dip6vRMCfxIolDeHx9zZLl6kJqiftPtMO5dtJ1kmibQ0
+zpCiFUtTY1h5wxsTi8/naEduw6DfmtHnhxz/A4UCoTwMVJynqe5hMEmrxf2OI9EPlhmUE95R7XFS
+6Di6Kvy+aKa2Eti/U0amC/VlrydfV/sk9j77Jrtu9Owp+2MqvkvF76n4LY1uGk5WAjqyvH5NpuAW
+ZvJP7hleFM1dOo6eGZtNkqtJJKu8OWvQAi6PfNfTZbU1gQOKdeNv9hCrNR/5KGmwDlu7O8qEJDKQ
+dq01oNWCxcaZFPbe3q5ygpYYxpjrw0VavdRZ/BhmEPkjP4vFoBdbiJO+FlSZMnu9XxDCiywoLVGG
+ySBStHP5NSXwpNg6PC8/0ZeL1pz7DTV9YQs6wGpK8nkG7FEyeV3EE8Ti/zd9VckwtrZ2dra3tzax
+Gr5fRt9l/g3KUULvMkVv3sQTqvkhAXF2opcZqVaJO07TNBe6CmaXPDtbCFSmM8uAzC54EcdKZNdi
+atoiDQp54rQ2tO4ckcgCdwIjsHGiVP2z7OqkAnEXoqRV8TLodglz3Nx/Tds4yizgVJ82umjZ5VIM
+Znq8XghWxRWBBZDsx3Y7+oj5Ya3bCMhIjOOIMh2pdRMlQ/wVCv0M3rF8o/VlrFFpSkVS0X3FNEr2
+hdMopy24XmQZ8NHvM78QS+dTuQhk82qtaCzlvMwt+D00ZHQgNx0ZSRONNNGu3nfAmCZmi7BaV1dt
+sZSc8VqMPEzg8GLjLVVLR69cuPpUFD3Lr6L8fOSTQSdLpNwRLd0RrYSx9ykdC1DfbWNp37nGnqm0
+U7K66k6gV85QsjC7CnSfj3JGREVMEkKtIX5D0QPFnhhQnHrJOJr+F7kFG1vJ8AHKXacetmPMOdHf
+VD6Y0rA7ajACayHmlcsAMePToFFDbebV8gJIq2dAoq7iclJRsDNkT7Tph76FzCUHS+mTrUcIlYjT
+DMXF8PMGZwhHU1KmTIvQTzessiO0JNPF8Vy2EnT5MD1SGihicrFw6EK+wK9EhXwsx9EW2xfVV8X2
+FkYFSu6pWGQKlY7KIYK8m8M0MIxCEAR5NOjlt5tGOLm77fgy8sqVLITSPARJyD7fCtDCjQhu+Zl3
+8xa9Hk0dKdnXkBwyGHfjkS5C4mokLq5GInQMZAdXIzFhClcSB1kj6UTWSDSyRmKQNUwBVqDCpImq
+oSdyYspe5L1ogPVgHiZvEM+ga0viJksYioIhKDyoOc04j7pc0yBROCiKpUZVJixzg1mseTMCYyFt
+WhlQYDLVCcZevQho65cfsFWwFKOiNHuFQ+GB3FQ+8ryolhCxQ8kUTg415VStHtUWWjbdX1QNK4YN
+oPZiaeOM1rhQOHnW/lZYuyU0FT6bLLjefckh3VX1uoCT0AVwfaFqq8E8kRYzXe9qeKCO0dZBRJtn
+lrhqI9SzqMAJ8NiIgUmekpJRpLVe9myVV9AozWxtamE1AaC2DH6iDWvDI4e7ih43F5HE2qyt3UST
+CLP4rNUifGtG8pbVKNRwr0N39jW7sROdNK00QgEic1hIL0yH5VqTTEFI+BxISw14gT0nbQhzG8O7
+jBp45TqEIhdHhYRu9e1JNi6yLJ5VycQLy2YN8iXLvXRqkC9Z3PmothANsGCnSnzt1CvM3V62wBfu
+3JeGGuPZoOhIzLHBrgYb68Ige8g/j2SqfElhdG3baDhDG9JsoOM6XyuJNhAGDHwvTN4tHfs59oNG
+hHNE6RC+IyIJGud+7FotmMqbUoJmgqKKzvHYrDQ43v6Ax9WRkUJBb0spQFNuZDdiJ3yN70lDlgbg
+F0WgqI2Vhv1lu2XfmZOOUNpfGSLCVQBrNmA1dxGoTFnN2PO7BkFC+c4nTQwyanpw40qkVM2Hygre
+VW4qkOWjn/JjDH119Cv8okk8L9tJGmfFuRe+yn2Po8Z72Dq6zQrIoNEEeZ2lZ2U8TTyaPkqjwTcJ
+nOwnXBCG2Wq+eJlOkkJmjeeTtPAkH4TILp9yG9kFiviUHyXHjRLYHpW/kGC/teo2PZcfSKfxuaok
+rMNPjZfEskpOElzMFWeHfes8a3dAPptDkxOUdVdcUppfxlnaKqxKMuqdfOPkqoxnM+VpcHMVV29g
+Z0tnWRKurlYbU3mzuKs0HZwjzLo/vdDY0Rik+zCBUzA5xrJQMKg2LuLq4Aqdq6Em9bWPylo/jqqj
++bHwFLOL9ibzURv1cQQMMlSAFmleM64qjN2ReVHExx3yHcRMbbyJEJGtlzER3s+6KsnrMMZIMvnh
+uARCT7Xs9bADKk5AKyQ11dXUliP1u48QmNRnBOTV2aeUaQyZOkeQJ/uMBLyhy510qCyqjSJ/nqUU
+mzKH6zFeR19ghAj93kWtsTzsbMk6ayplg+bRHuGEapyyOIw3KMzLCwm6SG43M/ZGvUjQKkOmjLCD
+0llNIFFkjzbfYAdPidPoAwW49CjoB5T3/Yc3ryPvMb/z5PE/HsgrTzAPPy0uE0IT8xMbWgwGvgUb
+Dye+UftrpYBTZogPFyg+az8PhOr1iA++c4xeoZfIaK4vo9U+bbRfsNlzuoj4Ho5mYavot4d0NBKJ
+JIQJEcJc/JhK9C8L8S0R8+hpwgFb7iKUiUMoySMr/5vEsv3yEoKJiEmxRTBjTTBj9G8bNkrpIJod
+9bwf4UxswtkupZN4dnxME1DCeY2jX/nCIWeJsw7V0oujOLHwbd1FmtxNZnOLzMYcZzYXN+RmoBwz
+76yDQ39lzavkrqorEgHtXxA5jpX1T0ysaYV0eox2feMm2aukWdUsGh9Vx0NYXdfQmRRb+TN+cwYr
+bhLn50lZzKvs+jCpX6nly7lk6MNZNBvNNk5OLuppJlsJBPVSFWFT/apN9WcjNyYUBtjwKGJzr3et
+ymhS/Jl8BhQdH1fz2QyxPCT935+kJO3/JS5zDmmFEZ11ru+JkYOxbjyP53XxEmhdJRP8rN1jss2z
+xj5RuftEolQvkO8KqlmJGRr+LJbtHQntHQgdvDpYuncktHd0z1n+Ws4I62g0WCX10xp6+nReJ75H
+ybT//ejLTMGS6W0InzWZ5f7hV+oTwSjF+jp5Kqy8romclz9zhdq53RzWnnnHnhdbe17i7Hk/czzQ
+xvbmRl5rWE+2Tiu7GF66kyP/PUXdoWibDWsOvBU8LbeiSH2FHW+cE0QXOz7y2+x4ozKK9aYNnDpV
+CnVduyrULO4R9q7e6Es48TobfSA3tQ8w+/BzGIFEFm9vYHlHJw70OW6MYjXUqjeNaRraThS6s5Ki
+pOhIKqIq7JplJMU4pFdcCgRslIzUV6G2PW8qzlATXyqtqVGSGAPc1a7i71KDks13z5LXjlB1+gyJ
+x7Mq2oLmYIl4v0W/cHiE9G2tLX1XkSuyP9ja3hw8fLj5sPcRzcbdpD+r4Pb2OvPfVeJNhS5nfn57
+WwZN2zMKAUODsO2cpU3zPiBGzBJEefsYO+irAgrS2lpP9nCRqKGVcsnWEDshg/DcWzVV3PrdeZQb
+oXyA21QVpJXPhFBblcu+Wy7uvkvYrSSTcymEZAmZJU4X6oNaL1QBX2fjjVBX2xx1JZpdTyb0Rr7I
+g2Npg1ji4OqErBsKH142W1CiDC5HS9IyUIr9TVG1lHRV61OQ1NA+WV1eOSHzKldZVikHB1FZ0l64
+adgLuYmW2rZqI2ZWrmBUplmUhCXYTk3m1o2u1FyKT1XVZKZW7eYN6li18Hsa9r9OnZ3Bh2dknzln
+yQ1e2I3pahuRK5LREXRCFSpnET7NSC86dbjh24UrFlTue9K+wlCY201jRONoMyTRJEFhr/dN4QdP
+vq9sGgETvUITET3bm0YLynL1zF+tAkek/xJNzllA3SrO+Pkkd6+Z0lkzXIu+tXi44o7zipL+YswE
+W4xqgTcb7O2la4ur0tTWCoMxuvk/sbvW8w71IPWhcUZpbqlf79NhbmslR76OWNJSqqApnelMbkAw
+MsLoeahzCn4KrywaoCUsOqb38UpYbULVsFRwaqm+3U1OB4quzoiwm5rWA7Vt18TztRoNejBRQ/gb
+UORNvX9sbskoF3qLOsn8TkGssz5XI6PlaNpAfcW1aVW5NnVsl4umRmVXsFTcUvJmGAHkpjNex3Wh
+4m+wNFsjh9NX1BLe7u/t9ggUX2KiQ8JD6B6YOondNVu4sy4Tfsv9ztelt2JkbT7asdCBO76lJNQy
+C4WNEHcwblRv64NfbwEwBw3eTz9qcyQdnIZTnztmSgMlf6Wh/phLVZLCbCkjz0M2FBEzy7Xoe18G
+q5HKYglYqqAnSwPJUkTeP3Lq35XzJCf35vx8BSbb+FO44q3hHK2q+DxZg2x4S0/kgpRn/sRCO6T3
+CquiY6uiwItVRZZskBAEJiqfskxd4DyHKxAm9lJwvR/TyIUBMbqxNluBpHwnkgGwb2931WWQbKB0
+I5+wAM6KAGH0/dsqcLbxWFO6x9JV/Ze0p7VV/6XR3DuK/7JT8V9qxX9pK/4l5H+pny/Kpt5fPbG3
+1sVCfOf2lLG/ipuWw4Tow3EkE+cA5h7S2PpX8O6/RELMIig6EUlZVIVQ9MsFUJRViqJaedWBXQqZ
+4paQKV8mfWqWZEQMUtRE363a320fzFc7DuYdx/Z86bGdgiJqRQLKQSNpckxarab4BUVCcVeiFN4f
+zY4DPoxLQdZMxW7DRzTTUvzaPJg3S0nJWg6OZyWFTy+P0mMgG8wRLRWCcXBMI+CS91+XRs2+Io2a
+NaVRsy5p1CwYkT8LDBEyz1FFIbsYk3YmTciGuotzFaMxx77AcG3ymByPsHdCBSvS1b9jxnKWm/BY
+ncTmQbO7sVgmPLKjVzt6+vZ2DEV2pXeMgBm1cTDuGrU5ZITqjeGnPYSYKp1F8YnuLuDaZTeVhNgy
+Ht450BRn0B9H49HYlXNiCLrRvJEme4mxr8cUYdEdmnHQEIvORi0BErynpJ6rln9mqyzPWxvfSwJ6
+jznXOcV83RxH4jlrSjwF1GuO2Omqj6FWTUfWsd4xaAYoUB546w30rAas6ZjNY4QgaHWlnHpojMaC
+7GrYOHTMmjLBhfi9i/qXHdoxLrPq8m77BQjim3g2kr8h/DOuAGfa/gKm4iz21wcU0hohFrbQFjW+
+RuVGdCO9/STmurLVIHI91DGax1LWFFm7/u84j3+ng8dvZNsLnESf8MAs69uLO2ohP8bc61IXEjeQ
+qxWX+UahdMu66ia1AUhkzUTuFxLYLnbcPNzjS9y5f8SOM9FzZInIVrWrazo2p9yI+H6qRj9BhyVX
+sOHU/lF9kVZAOn+qNuLJxMc73ZWBBJaumasb4sN2NRSjJm70o0Ni8wxGQ+h5C0RtKqnxk6WzCSo0
+kr8h/DOzaVrZDjKIGGDHt+geopoACxWUKDORwBB+yTgiOtHDBnCowSWunGBbtplQOyahE7JQ3koj
+ou2G8Y8yCYIab+7sKkmgOfGZsEMNwXbLtrhmmGPLFmiJM5hfNyKSsg3LqLRca8uA7WxOTkqEv3mV
+k5Ah6youUlhbDYslio/ltOmHuwStQfPYubtlHzgv9brVAeHvMwKWvEZHm/HRhN3BTbaP+DJkFDK2
+Ua1jCkA5W/DmVo/PusqGLJEqgWGiUHsjWG46fpM8RSU0Jxf/rrpIl4hhroJ64DF4Gy1kC64cC4IH
+5M/hv0aXDNiHXvAvWaW49XInonaxsA45YrtXqkHU9lSjpO347QcMDO3OL8bgGTU0CDjbJDpP4wlO
+vLajZDsmxv3mJ6oGaC4u6/Jebxr7JeFNBpbQYVl2Ge3T9hFRBz09Nfm4Z8fjk72bqLymcxf6685I
+7HSPhG5JRAi81sCgsk8u3kZ3oubzDHlmP9Ax+hxipAwd9zRx4vUj19WmCsLaFD40ZSR25cqm6TyZ
+yhqwWtu/opm5+dD4GlpPPqPFCv53N+G4rprH/mTYOOir1Wz1M0cQbO8hPsXzRfYqQGWy4ghHuX2H
+dj3VLIuvPeHlMInhJ4XZXNYxxqEN8w35OOKnrNBxPy+lLg2dG314WEjiUfR6RZMz1V8ORoX6jMQe
+5rf1t/cTq5rIhSg5hhF6lHZ8OaU+hYXveWGjZloI4m9uGTHIphaJaCPesulpWcoIJP+CyCT510Qm
+yd8XmRh0emOn/bTo5NaeFnjcL6bTtH6ZwvHlYz5FgkkcyZJn/mGBbInBQr4H77F91w6I7IFL+Oww
+ipZmQDMdch9Tuw6OQ0FWDLTXEcvFMaaF8W4NZNDZ7V4eBK9tJCyUNmJ7C99q1Rd07gLatyhlQAG5
+OZXE/SyMSIaZJOD76i6wgibv4wIkWJ2dSOf9pqWAZL+j9h7TVVSjBUS9raruhFRPS6AEtPYpTxJr
+2lwRE9tCYEpsDWPSoSRM2orNpKXYTLpUk0mXatJ1WVBpcubLu6by0gqJUFkhHXYiHRV0y1xuq0sr
+9AA3PbTDLDeinMpoy/vYk9qfyY4S3CD7fRnQGUO+qqXi7J1lK0IughvYg7Sl9sU6anjAM8ZVwzum
+UQEMLjnYtVila4xa7XmBigzci9YHDzH0aC0diWgr+it0CzpD3QQIEmlcMTD+vCFgVPx9iNhwx9Dr
+XXM5eKSuuSKbqlWBor4rtR39S3kIbOvNw8q3nJYvKJyrKZxxL802qFq2yEcn5F6HtjCH6soKUdHw
+7aDJBqQKp2POcvwcq1sECcbGMl8I7RiqKarM8jGUNHrUsAWywqJjNthrmcWkFQ27YTvNbxRBc9GU
+EjRfKREvDg0abEVDopSAxAYxr/u+KEg7TlMz0OYftRIhkyeGJU52NRS5S/R5tyVDEd2HtjugZTTS
++dyK//HvHoKys6Pd3rln0w6/0rTDu5v2tMlCCgKyEISwxutjNcZ4qoWiYOxnOnQWcRx0ECytFYli
+i1DFDqEyRGolaZCpUsJYdZEpWDZxpDzwFwgSuJBMb2G0W3xivOH6shMLBm4oMHwaVB9eANamQjw5
+BbXH/QonD7rBt+fu8p6j9Fe5sUvLCZSAZp1OrfNOgjfXBG9uGDVTwFxnWMybRE49oVpoMxAM2Vhi
+w6xexgVamQVa2WvcNrfPgrBqJOBMtFOsYgPNSG+b/rUi82kG165LxyAqKAjVLvT8LJocsP4WMaCF
+HiX9lfsUgotyieax6BydQo9O0aF5xGaTLEJuU7yh+DHhCBXN4VLZqGJtxvrT/cVubdZXaXkt3sWS
+EhowXgxJ0Ra6SIZXcqXIArMEiPhdgqs3nLDQV5ETREqClSLgHzLKdihQAi9tyF7c07grItwxnDw5
+zJkJx05p7nmMz48dmLgsegEKZXuuxe1O6gjwbGLVEnchfTikIpdNKb0yRmcJtlK6nmnLFWh6PEXv
+XanQfJrwdK2jp1KjWkT9YfFYBxkv1qJNFQY3PiqOYenCz9qgZY8Pi/pr9viXKpdrcn8tk6/QWUtk
+yE8oexZXRV1ypbu1w/P2Q201jlu27RaxYXlFiDuedRqZx46ReemajcdkZI7yPDtZqyZdq/KO99tm
+52FXJu0JdHQceh5+E04zzhzeDe/ncblprHJIaFR3ixoagqwuCXNjMSh/VFKFyGu0l0ZpUVPiHDTl
+V93WVFDULxUbeV1XChEM+0gGnXhOR72GrU/zSdtwh3JggWKJEdfdcq3njgKkm8QRxklzHWusE8Nm
+Ky6exG2JbYGaXK1MyDMelvx+DGvDmNnUikT+mdkBUxD1rUSxFGRArTMrkbCI+iLBwMY5xQOxaPxB
+5UTysZADlG1u0gL5stC/jHAw0BOeDR+bNnF6Xlqv0Inxjyp6E9cXG+MkzcSrqgH6/0KGz0lK8bbq
+DAjwAS2E38mT83v5+wYTX+Cf11U0L/x+IJ7h3Uv5/AvefMQ/f+Kfn/HPN/LZL3jzfRUNHvSNyuuH
+yg9uvueZuLbT7zMmWyW+ky+h6nNAqk+8+0n+/kqpSSbNErNory/KLDo6Fjn9LeSTOEPMF3lTZXBS
+FRkmzfHPWKbPMifyAUZEU8NGIp9HvQ9VMMIKhusDdBfKRlUWQmmYZClhMYSaAkbf7PH40lam7FcJ
+wJoh143d/B56aGCwuNEg3JQZooyCkWfRl4rsAaPfCiviRHCDSXPMMs/UdvhNNfqmcoK0hn3k/OXS
+qKPtwaPdwWCz9895ZmErwZyC8zuZzfIdzkudFebXOlv3Ro8Ge5t2CJqE6iy1NB9QHL/3iGyhk+hL
+7Q82BVp503XixOhxdyNovaIcgx2mKRgqT6b0ZYqU3O/tqieP5AOtTths2gn2Fws8Z6LtrxVHk+Ku
+KTCPnf7jOJM03JknmjaRmaUh/dCSKWtVXaeHn2tz0EI3D+ivPxH+Q2xL/5BrfAvdOaQunkIDDkjd
+hP33iPuPJgbPtVOcSqF/nrGHKY7MBywWF4v4Dt5G7x6eSB/QYWTvEZ8U9/YY21rztNkIV4LSgWNk
+siIj2oU6M1l8gAvU6iTZxruBpJBZWgImVUoUIFfuBQdSGzYOu8e2JrdhZJSq6fWyImXnbymxzMiS
+roQNDXfWUpRoWwI6L+GZvppXJIxUUZ4haQarSN2jFUPyeZayGQ2aZlYCEV/sxTbsP64agZ4rFehZ
+jIHNy8gOax1HfaypxLyHIZBxDswx7MTNGHrlde3PlYnLi3qIb0aD/uNoNhqvbe70w12+3Em2gBrJ
+s9L4MarNfFlNHZwayqkotjSJtJ/hNOUZOnpD1KGGD9DkygN7LNEDp+j1DhAhWrjdpUW5Mu1dmRZl
+Wl9H/aAJVMWLrCOrbc9pf2ox2KElAfPh1Nl8td/Al2Lkfymio/JYfCyi54X/rhC/Q7+FXwq2ESK8
+lWdFEA62qagy+rHw9/ZEozzCJryDKKnTlsE52dvTXJV7nFHHG23bvKdokwOg8rDLXEJTr72HjgRE
+la0fS8povtFfIq3d2nmEzQPaR4sGGl+Ky0bbg67hq5vjXFqszKXa2HgDhT2H9lCzNzZD228+DIyB
+vF0sLoE3sL2i7tZOXzVzYsXi5lozVm2PZSszK3E+VMMP6PO5Kw+An+BbRKreVXyUe1Ox4I4p6SGj
+8QXDISlR/sDcjFWlzWieSjManNdozv6q0qY0MXJIhaJi76tRHvVD/53NKMGi4w3c/1Kha19AX+wb
+CV+f7Y1RFh2xUdoH9jRJLJY/sVn+HzCkTYPlJxYBpvRLDDBM/GL0XNL2QAxoffMI1dHLSsg6iGu5
+uxCJRFYGCMJQOz+cpXlaXSSTX4ryE44iN9qOy6qzvJYenXlDhtGcnNs76nCijYg+ZK6TMxspqJr5
+u5t7g+2d3X6vDJiTB0IIjfulQk5xneocaKfBmpol5T2otfWLFm0PoCSmTRT31qHzt63cPQ1dtlGz
+QT4HPIt+yf0PTRKlpk6rSdtOkxRvxS2ypFO4ESWXaF9CG0wBSw02lXKoBAa8qZSIxwCbSoWOgvlR
+dRw8KQhHDuZZCbQ+Zv1xIbCnyggYsf4T+CWfpxJYzM1+uP2o/6Qcwd9w0KdL/AmBvaNr+Am3ki24
+hL/h9hal4g/k2O3/zz8qv3yAVwGWh0zCV3um7OqZJfRrcw+pl4pyaCZmgzohbW/Qtca+f23t+8Dh
+/vPnSuDPn6QSdQb5loifNQ8gGwVMbe75MBr1UCmReTTQ2BFHoxwmsCkhccSP5LYOXR8K7qaWkjBq
+DhKzu9t5743CcKijNxVQ9+fcxKEhL3TS5WReDsipSotoJ73PXyRJnrRqkCflv09+akN+dN0k+Sll
+k8sW+akd8lNaPk1/lfzUgqaXXZw7I64yOx6T2ilIda++isGGVIw/Og19qDgWk+G7raHdbxcIR6lN
+geU++hfK/SzLnRX+6wrO2gH8w0n6Bf9a2kE8psJJ/LXekdCL7HVlR6/XnLzTnVrbbvdfXzP5zoJm
+plVyhs3FDvP9+5ywgfQmGLAK+H3VYP8VHYN9WgOIOQ52UiCYSxkrnwCem2AmlXK8czaLJT50Q8dq
+4XXsojABRX7TyKT5OmDbyJ/NedgPi2YRluQLB8LWUr9DbdP7ioAT9dCwGyowBTBiX3C2OlKTn6vo
+zypCsYmln7Mo2FDTnfcVzSzFjvym/ZKiD6nIU3PQge0k7cDpRX5jqIy6ZUhmje6JG4mOwy4dZ5WA
+H0OtE4TqrzGiLKdRncIXJGgoRc97WzlWxoppLy0zKak8uYHGD7DxtZIrqX02VK5ZIjWKfVZykWae
+SF+lIfn6248a/vDdrvAY/gV9GhouClmnUVNGMj3lTZQNtUSnkuIc6VtjedYPx+hab8skxw23ctdA
+ZdxySWdP4rGMUh/6nY71HWHpFnxQbGFTYKAgZeB0gU24iAgI+MyYBk7gphFBSx/eJsGFEixNLMEi
+n/Hw5an1MitnLkxY8KkF0+GvcspdyBqrM6B/WEeu2DmU3SH6hdrLml8qkcbwkoQZc2iu01+XvOmd
+y6dmCM/UEELKme3YreFiMKijukVyvvdoQHtYRR1nqmLjKlQcUvOh6Z5r6YUxCIbX9HBTnMV+Ja6D
+RaVEJVr9vciUfq2SspbTKCaO5Hk8vrDbfzrynUfUD7NKaBmPOEWTTX+OIXNCHe/Bz6JTtJtHx36U
+K3blFqvkEERAUSwiqvgMdxV9tDmtWMwFPJmz4PtKXMF+o/1o+nu7MBIyxKNu3hmMp+uAyzPrLBhm
+EbNB/g8+Qy9gbKinKySMXtEmal6w5q1orm2FClnRLuti5XRer+TFipp0Kx9frVzF1QrkH2Ow3MnG
+P/J/5E8nk5V45fEhFQOTQ+WONjY2nphvrVyk5xdJiU5o0MKVukzgT7EyKwuET4MS0PkFfYOhR1I4
+JhZASGEFZvE4uSgyqBHmliaoG16w2Fk1GDGbGDtuXvmInqgWqNwMz6zNcCuMo0y4vUpSWtW3yA1c
+xP6ZOEMHlphcWBxTigGWQKO3jysezac/w4VjCIwLYne7d2bil3bQwv2lDkNaV/G5k4h+7vTj0a45
+sOZ/IvxI/3NAKC33aOxFBX+cxi7umFqLtxnKlLQ9Y82bMjEylrALkszWHRjzAd6PLJV9pmM4GxGA
+YlctoQDuwgoV5EMaWmLV5538566UXnRLKurbW8nDSRHFwT1EFMhc2nKK3OLOGjgCaNynGuEILp5V
+liYLm67tJ6icYfAqg66zWM0/2pl6vdU/kGFr5X2VGSXfj5Udq1MkyO8Om0aerimnDv05ekti8vfI
+RzSYD/Oxt9bHErUjljZW9DBlO1vJbdBGAYyFCdFz45hXVBU5NryojBaAVLY4k2inIQNxgp+WR6ut
+rxuM0/5tYum+QKE+y/dLtWMZxq4vCm02boDZ89uokCumsOF0LBOUoS1Yj/KFhbGkGm2FM9YI1RZ/
+RYcAN/CFdRcYpa+DjqS1mk6qfWsjtjSKTJbBlsDZ8nHtOPA0vjFa+oWw0YjGRwKDjmPGPSOL3UA7
+ayqIpv72Q2FNACfAiv2RLltmi49teFJp2PLWJANagH+jxKV4KAiw4Mh2rPX2wVoCpHqSJf5AsvF3
+TUV38+D8ThIuWDsoonA/m2RKkvA1WXAZucdQ29C2iSF2rwOrIDcJzfE27Aoe4nc7lBdKWiyXSuku
+lZyUPbZip/fPYuimREVLhgSVcURIdO8Ib+il6ZxYYOBu7FSofJyfZ1ZOlFuqVPQ4q4aWIaMtJMw6
+pVTxUB1eWEoVw9kYpVTzYXE0P0bYL/xBVbj8jXvRP8fW/CsySRHQ26HXK5gzTPhykmRJjdiURtsJ
+89HePXBhyvUx0h4w1rL0yyXrskRkNGf1Y9TtsJGoAY6JMKPQn0QuW5utE+jHPPquFpe5X0XnuZIV
+S0sm6C/COvKA0auCLLqp8C6sNtzHAgbZTtzPJwuW3ico1s2Qt64aiIq9XqYtj9Lk6vb2CtZXcSX8
+cZSR+6oqDDPa9770coRzYIm2X8/Jv+Mmg/s4H18UJdvR6tuDszPg38UcEsgTjsPPqjt+SuKCTBuE
+irm+NLxR5lrcyyMlcKgwNy7wzwTupvDvPKrEJWeutTGwmpvXw3MOqdxn727c9s71125v/bNotgZz
+EXPNOde4I9cF5BoHAg+rJh0m2WxN3pMVlTSpMzB+1/DUApAeBpfROdT3emjZLJ/juU3a25I44TIi
+wcDa2iRiAHKoYiAuGQx8bW0aMabDBabaH8I5e6joM0uFLiMo/jKwzG0X8PUFaszw2H17S78XEiqO
+J9sZza4LqcSVo5BhF8oMfcrQdzP8mUc3NMDJBJGow0ro2fkeZ02YLcR3NUpkHAMXsqhBBggnxPvM
+9vUxlPi7Jou4tYWy4i+Z/12Fsnwo4zsbknDh7gbfoTWb/Kj9OQInjrSg6TulKj/F0hhBC8MO7fZO
+Cev3OwsvkDxNBpuPeqf8yhW+4vBustArfr4fXZHjvEzdX3KuGe2zw3y475CMYKHMJQf9Lfqm1Fd9
+rqBW2H4tGdiyxYG7XRnEJ0yzuFvsHkeuuLkdWi/A/U7j+aPm86+VuR1+JcMj9NjCneM7eb7/DETJ
+9OcVnE71XIej3RWe0BbtYf+vmTvoGBX9mYsrotfiNNrfsGa6QDs1d66LK3gTJg3+71LhXm+a+420
+jYm8kAju4hTNjPnzsI/BRnGKjO9VRPMPiL+RoOxDGqxFfAwzTGPK27sICmcau0d0JU6dvYMt8qZp
+7u/Dk0ubkgUhlAzfbtT59nZi9pWrrn0laOwpUMx+Y1sRKACygibIb4rPpj6qyRniQOl2U6NHn0Mr
+GyRgptV94DygrEmv9/kJAWhHaBXzGcrE4NrRBDpTfEZMc7qCpQGEFkksKhP2rf3t9nbf2t1wByEq
+b5J5H6MHBV3iI73frao4BjpV549lfhw06NgrCWtAEwe2WpR90Sj5/EWhyg/EvvSDeJpllBvVNNDK
+kb+PkjEu4AqzcRf4XAOhPwhjeYXF73c8E04Z+B9M9yNGrNqPToc4emYTGQa4bexbG+EVm7poRJl9
+kSVn8LPB8Dyv4UbUxUwnfChmCxYtdNDBU+4yXD3Slx4WXX94+vhK2ayfrq0FOOWPTo8DhYBgfQqq
+hp/HrnCewVfhEdRjgVvR6sdcwLb1UTteKppbtraJ045t4sTZJrZ2eyewWCuYVU1CRxvFSXBzJWcw
+EbhDfNvFUjnkcp9G9k4z/I7kuDAaT7vihh+ODmG8wkNd96vFfxtdVMawLwuW7xTMEFj9iI37tQq0
+XWyCRrE1c6rYrdTNVr/W7jfFd22g4Uc9vffA+sExCFw00JMmqi82pVY2OmTQYJ+bcBlK+11plTLC
+E0SajeJsbS30lQ1mEoR06ThNint6oKMfYaf7OT5A3/NSRYjb3WYBaKmVy1LcY/mmI89o9Mm/q4Fk
+m+Qk+q3Shska3UgbdN7efqeiXpmz9PummEzP8cTla2aZFhaN4dLnYtWABKOkxtkzzrBTkb3rByHp
+d3gO93pkfv4doWS0cirbLL2oCCQdMW0gz7l8keRgO4PNHhq8/4ogVL8SCBUa1D0Ufhv96Y2C7Fwg
+SWtPat0HmBGXxx4BsGeq+XsPH9fZaO9hWGv7ZLbr/qGAGj3LlFvR6sAU9UKKJsqMiSJ6rIu/XFlT
+3mtZXv5vKu+ZbKoSpWWBaoOEnEpY3CFZ5uVSlK2BHjUpOd7alFKVPBuSzbsVnK4/zOEALgl4rp2O
+iqg8yo9hR4aftcEx7NaFDXpQNL29uiIR0dqqug8P8TI6FxMUAlsGlJky1O+qZqOKQ4Xrij6OEpTI
+VDP729XILJMSS7KgFmWGUOyZTR6zDuqYaeLoBuuDzE26CN9Tc/oDSquJMqxayv6X2jD9jALVR6TZ
+qVFZhD7hYoDGedGZhZ+sjNEHpNIjE/SBNqep7UkokcAk2pOM60ofJMWC3iKUkK5tuCHflEgDLwlz
+SSggCCSSg6+AznTMpPJuPLrb205w0XsqlfIgUFP+AnswwY6kxTygQ8YZghMV6J/ndGmJXVpilwY/
+Y2SpAeY5p+jfxmTzX60XTum8C15O2BjASgFl2ZWYEf2YNb3SjbLYRP/Klaiu1nPna/aOpVDqJ/9N
+pY0uffZjYPALEjBru8w3FeaBvL2dfv8JWRn+Uo2kiVf4M3yj1FPS1P9PRxXW9NlizVxp1V56pvSl
+lwo619TauWZUR4OQdSUYxTFy/GnCtjcNl/WxNo34J3rGkpPL9mBve6u/HRBOaNdqq81qq7XtrBO7
+/ufMAE0SkCBq/yEFLz8l14iMj5caGJ6wBgkYhS5VXFXKo6kIF4S4Y3RlA5GxAxSmwmEw+Qx8kyzn
+zHrkBrnnNAeAhVJc8xJKso0qnDwWqAqnE7Hja0YOlxWx9Sr00Na7YEKTsJoekYZ9XApL1enGBZYx
+Xf+N1fVKoZBc2SNi8v6SGXyXVX+VnLVmZVEX0ghhNdlIK/aJUyvVevv7xgQ2fJulyJAB8soI60Un
+C9QzwRwQauo6A5nYd4LJo0TtFKUdSs7BfjF9gSaR5g6BN8rmuDcLVQNVtgahbGPxlK0Q98JRJ9re
+PaJUgUVkMAyZU2Vqgrm1gCAbCtJWdB8HvM71FBV1IxizKDujdtRO1I66K2pH7UbtsKIhJFb0bV53
+Cf9SkOUzBu+0EVp/0JNQFCJWNt2bDDaVdLFaSUBzlHBwB9b204yplgRVtKNUEg1HlkPlMfJjppyq
+MP58QWYULA98EVbRI1GQKYIlJnyqk+3UT7I8WC4wqQebCFgjHt0Wjan8SfAUowueCLEKSvDKLWKL
+isAC6IVXwlkGdN8o4K1bwJ4uwH7vbfu916ovvqMN3eqCZ06Jm9udJT5zSlTm6zggTatCK7KFiW2t
+4I/lwDyH7h30XaOdA0jbc5P+wGwNmJQPmLbtpr3DtF2RO3ocfvQeHm1uat1OQ0u61ZfWFIheq+qP
+Ind12oJNEfqk6uwShLikUct1KBToVDPnf2zRY+7hhwKj38FWqmOBWWYy3y15aXNLv2XX4bUeFqeU
+3zWHZJexKxh4YNm3f2u+RY3fFm7EaFhBI3MZwlGGqbpdqB067cYxlw+bsCgqWrUqjyqYTmfcSFLs
+wjtuwsLu5p90rTW/Ibd450OKCXE068wdaHNCfkvKlGzOQdXO2qJbpuBOfhl7W1dDBeLmXMqhQDJD
+bQ9DJ9k4GXK6UYBHf9bogc6prh4cH60P1DNHxR45/WAlNfXzkSnYyWdr+plTc60B7J54bXMujlLf
+rrz88iEFENmPz5NSw7a/iOu4wej82uD/tx7H5TnpCCp5nO71tDGufnS0dTyybziaiwxhouhTeCJg
+LodMFHLeIj1vLRd6vifCnc91c7LaroPJ3A26gUDiyiQ+Zg67ii4yH89XSExxEod43vw9scDBFBBu
+FXDM0IEyqGoZfSjhVgbnVmNcmTnGlVmUNTCKEAlHaYUNKON1gS8iO4gacvudBj7vG8mavElKmBbP
+LccFVepigQV0GSpmwbDZBsQfaZ+p5xJweMg1mxOq3D46eKL5rrGILKNMefycFQ12tFatHemrqAQu
+p7FoSwGEbxb7saiCoA1hnyz0qSg3miI5V2RcTg3Xngdo+IyQn2ICwywqDDliGRfWc8OHM8CZNOdh
+AouBiDTssIKQtIBRGl5f5VyLOmzQtC7IjCbKxo0l/iivcdUOrWs0sKdT6eN6BD1mzfBcflJ+WtAH
+bW92+cC8Uczd1cu10WapCuAFsh5QkJgKD8PNNJdiyHiJQ/L9wzOP3BN00avkNavoLsoDYGPbEtpB
+QmwSR/wwZFSCLfQOL81O4OxnMOMoUmRylBTHkc4lPuSEDpgY8LHEUmSF5CPJsRv7w+SxDjedrK0p
+2oAI4ghSsHFyntQ/J2UFLR0WEeK3n3BsJeUKXn6NYo6+miM6Ao7mOPxqPiUJLhC6Bkj1SSqXPWoU
+IovFj+fOkTJhOpVYqru9xv2gmcF/5CTc3norRGnWCZ51fVbAt9eVJ8WKpzP/zFF+TV3SuTluqHBH
+nVCLFPSIc6RR7DZuieSuUKeXAsbGEoXLIApzPw2GvHn7KG+FLQC2CIHIWNqqckllGjGt0N8HcR8j
+PKDDqW6015xeDX1+aMeQZ8QzHoTa6tLVGkVxVsBkODnG61SZEirjofBntQ60RHRYStNNKhVRLGyM
+vFJbU+Kyo6UNe/3oRq60cLW/UGGyF4wS1upmsbyPs7v6ODN9vJ85kcLsLtec/Aq9ZaZINbdlGJv3
+YyE2bRZiU7IQhBQ5R+vYhnl5v2/My381FAlI3+LHqhGYRVFDeZKwlLVJQBahjoCAxtSWbtzeTgu9
+d7xOES9SR5RFyS7CNit24DW5q9UOU/BDiiTtx5bv4Iu4gXhMoQBrFQrwc9ECRH4Pb4jlESEajoUt
+kDsZZ0UxSyQkP1FMyqzwfy1EAQncUl73zYQob7g2WthZjb3QUlqWPQkCZslxgtFPqRye0G/HJzXi
+0TqKFdooBnuQwhFegsOOF50KUpzhPHIqwZ8Xzdi/tLOrWhfqk0MrRqdtRl10wGX5hRUlk70XOVCm
+vG4Jubj2TqOlyV8rIHYbDq3Wkss/VUeq5ag7bAHzkez/0fVMN5WZOJqqGvfZiEGtuctg6HKqCMf8
+vIHAXbcTlAE6GvO6C0oUwNvBTL4wy0qkMeGew6MqZXtxWNBIZjDRRFwXLZFIoXmubi2+GhNFcwiO
+04hMCLmRXOyawTJUO1rOkrBI80DtfEAMaKFynxLAbVc5BDFaMTieQX7nlJH85eM5MUBD3gXzLh0W
+u0R2KVChaOzFXJDtRyFrXkbXscNk0Sp3Dz/Ahn3mVwlJoo6+twZhFf3ASh3uBzurL57pDDxCOjak
+mp1yAe6GuFIssQocxhSx/HdOKB/BhGHvS+sAluGJPFkESoxUCITsxKrb2DzdTIgmXSQkHQ3Cvtkx
++CVixPUcghn1h4ZhG7DLTxR90EnballuLuAwCpX/qYDTCoxQrEFNgGe2+jPRuBbG6a6Ofrk7B2Z5
+eXcWRGn/YmXBesho1EB5rPwNUd5WfxdyW5I7NboaNUjTiKI5Mt8wbSL850aUm3xUYEgbZCPx2y4w
+0fISf/lrJdIWxVtw7qK2am86bQ6Tt4LhbkqUtvaEW74RwHq2Q7KJcczHtwmvMMmmiHa8e/Uazqgi
+QIZBWJufZjpgqtNX2xieuAd9jKMf7wgbZRuyiy8xgXL/iWSMpqNBzIUl87XTC0OFMw5t4uDQQg2T
+owIPW1dF+ekVRls5x3iB8tz1rkyncXmNedBW5Dt5DorZlLCMDmKJ8UmTUge2BnZ5GCjN0vqWwsC/
+RVtnJ8wIEapnapqUkvfSJKoxiTVUFvFjelL0er/EatJ0T8T4DrhgcqpXyNGoF/kmp2k5Yh1RaALV
+0YPYCS842A3EzylPGtmMyuoIZRbuCmBMhTtiMWtWqxFG+SsMpWjNe+2AqgbluTNWod3rTnUH91jZ
+L//aylZwz2qoG6U1a/BIw6vd9ZoZsVYL+iFsXXmTcW43ox0qBfcmw3tnLd4blh2z31mT/W4kRLHi
+wqqAXIQkT8+mB3E0zn2Mejvqh/3bbmuSEzjYjecZjPfzC7QTnjxL62q0JJ0KC7Xz6JZ0czKdFEXW
+HO/1Vq2D0o1NurQIUVsEKfKZqVVJ6AHaRiGw7KUUWoajarUOcXM4fcFTHip1th5Hc0fDqsobs83R
+WAspyZ4FeeTxRnFaJeUlN70H5O0GJVaZhGP3xyr6ZW9dBcAk5IUMg4rCQEmP8lIfXMa29wM2b6zz
+ALGNdWtxps31I+WCHY0ZHIW7DFV6pjIsOzaBCImkqB4wHVMF2ugjM7ZY0FeqMyruDHIHx0604FKs
+ow7OMu0xeqPHqDIYKuqlSicu4KWFXGCFu6aWkGEN1lro8wbOaHdtBaYodWDIoxwhylAQHBskEHso
+A+cAsZxCaaoYE4emqtGogfgiWyU4G2eKJQOlMB5lSR8ViekoyX7h4deJ49008W+z0urwQ4eaEWz7
+wO3TgQYRdlcHupuvmHmBT8mDArH9jWNCYlpkhrNytp72OdacXhvaip1deRgNFqKYGxMaeaBzOHlW
+BrVkqEYK3SxjzmGpOgRg7UIIatLeGIfwOenTTD+2gKwm0bW0112IunbqOdBmkjBSBBm8LVBVhSOI
+N/hK+bVXdh8O+o8e7TpvqjQsIK+bpxxThnLWJv1YGRGw9JBKJWNQKqvE8MZF3RCZquXqwxeeJy0R
+mxIkuKEAUGvAwXoEhvPBoAbCxDwonZgHEpAKgzyVjptK6QQCkkxiiQBT5TV7IhXl0yzz+aNH+JHI
+W/vh8ODtBlu0pGfXvuet1cHat8dHRDplHY6/xXr1h7UxFq6VziCPyqOakHVzXlk5YrpPGbYALpRm
+IcaVQ4LKonF82EMZ5W8+xqNPaJkuFotlMRHMcc2NiSDZcew8DmbQ66WYe3W1NIEGaowsAGP2Lomu
+MvE+aQWmNpJ6aeK9bYPIobP+I5FYvvrqpTa2XNzElhNvEnsRkbXkHqNO28vC9gBXS63IhgpMPenA
+y6dIby7q8CYKsWztt4XJh8sN67SAuqHNPEzTF0ljDjsAKZt/HUiP+bd5dLOPdgJVeJQXooCdoBBv
+E/Ehge+KG8kAhauDxfFCzCEzFDshR5Fn198XVf1KhmwKy0KcztHEAQl62BeXfDwKvcHDjf7GpieY
+0CXlu3j8CY5fb2Fihx5rbSbF1FuIMZRuFTGfb5g7XRykysvOAufzjY5knRX4p7P03M7FKaKAQst0
+knxfFJ8OjfyqlfyCbG3fxfXFkgzvE1yx7QzWUcpJWlYgPWwWho54EpaJzUlKmT6+SCbzTIa8lQf1
+ZviC98lZuDS0AY6qPZzPrmmMQ3v+ukc02F1/RXSDgBk2y9pyIZbNkTmysZ2Pbm/bviK07bRqVr0s
+sClwAr9wG9+dCFue7juZw+m680T1BjeY486jLA3mXgIfTyYmavrJyfv9p88/nLzY//nDwcHrw5Pv
+Xh88e/r65PuDgx9PTnhFzubR3dmIus7mG2kF/Y/83aTXg9tqPsMgsRVVg4zfD4sIs+UoIvbH80A8
+xQRp+n6Otu+LeuPk5HD/+fv9Dyev3n7Yf//2KXztxcHJ24MPJx8P908O3p/8dvDx5JdXr1+fPNs/
+efnq/f6LKJsjr0hOIu8wLC1wt5iCHf3i4A1JVRs7rjR9a6KPDBw1o3qaaETrhlyWgASU2LpeKrCU
+S7NpsvLoUdA0AdncfSQOSIKOdmUIvGGEeknkzNO6c54i7zivLg6v8/Ey2mowW0qNMIPFWaioKCMN
+HKgYZ/ex9h1CnCKyjt9Wxl1N5uNeasJUM201MqslcmdtXvLvFjiQBUrGUpt2P627pscqafQbhW+b
+sldXky4tNnpgu/pYVQdZEaiGo63tVIVzXodPRcV0X9ZfHqFOic7JWOIVMhbWQ2ctdPKJrAMW/4Ly
+160OD9Th/BRh82CBFN2qfbYHkz1cdg+fWZ63t3p1tdZe09XrkTPw/Cno7pyqKTfYSG3dC/Fwa29r
+J5TzKXpy483xGA/sKHB1w1Vjxca80V8nnZ3KrrtfgdNuMv704vk+0cp75vVt1yE4BVVFBlwZdQqa
+B5DnDzBpSIej0t/d3ka8KbEJre8vaz1x1wg05m9vQT505PceP47zIr+GtVM9eeKJ1OYp0xzeSOO8
+9qG/vVdTKZfG/Z7ge1ew9StUYURPHMN6W0kr+txslkwQbxEOfpM5lYfAicOUPDD+nCNrGaVS29be
+TtOFCd1jAV0psqumw9OyjK+hRPpFlZEX45UXQjXkFgzZ3yfn+59nI6XAdB8WG69g2mGrRqaBG94a
+nNkKlr/7wUY1y9La91a84Kh/HFpmumQdZOzefOUMkIrKYOhkUXMlinnEVc6e7I6y9V20zBpHu8Px
+42w4hmPQ/Gi8vntsrdIxHYeqCG3CEPzt9lbJI9G1UtN6jDmaXcuNSxzpuhzjKRrmEloYyigkqdYX
+jtDIhRebp4ZmxVubrXkr/9tbq9a8/03Am3lRG/BNHFl4WMBDGFZpDKNCGlsbCpyOhhpczRp5Jw+a
+pZkuHTvErIw8NT44KCKPOrg8M4bwDXVJbhYIXg5HAftswxIkkSqDp0SeNVdzX2Gxz6MMgVItGyDZ
+Pa9gPWQp9k7MvZNi78AsonUA93O8R+4ow06qC9VJYgVWKkw+SISUkrttYfOOClBN5As/j26ytKoP
+zsJKTOMZ/RYlHgEmb+QtcIh28qG8hWk9/kRXqewGuimTMWTkHBcxnFcqZe9b8SeemzvzISsRqxOm
+mBP+mhxwAxUxSYd0Q5WgR3/CX/42XKgaheki2Ej1gKGDDcyIMfYuXXrCHU9ODURuXgIOil/5ES8a
+L1CaTRzzhXj0aGtnj6hiJ02EWdaBDXE9PUXQNP7FYzIimY3Mrc8nQiUchHWw2x/0t5C+duSa0ZYt
+M+2iN3RHprMyPrfKeoiI2R3ZuO4n6HAmcz5CQO2ur5bFWQoHCM422BbZsmyIhCuz9feAQHVlk6J7
+WVgfaFZXrrgCHtWq3GAgZsuKkyc/N/dZd98U5VVcToBZOJM5N8VFd/fIc6fMtiUmd2Y7wdnNeTcR
+Eq0rL6qVZHE74rwzSxZ/uZZZdsVlZ5bTrBh/kl8aiOvuZgJDEpPhvZwsg4fitDMnnA+B09WjNngk
+rrrbOS5mqi/2zL66rwwx7vJ10mEbldHF0BU3ruTaPU26IMr0MUubZ/wT80/FPyn/XIT6FKZcr0xZ
+GE2o4V8159fO+Oecf6b8k7XLUvsb4rdAjsJKsOIyWNsIdgjwozM4qT7FKfwGjw9j4DGf63lKSTNO
+ktBLFWzRZTQ3ae/kWoqQb5dGrORN9ZInMJzsozO8lWsd/as2XsPkic7hAv0OoilcSBa/wEu5iKMK
+bg5p7VNFUryVkzi6gBvghHTFO/ZJbOztrWzmeEEvNNr2WSU6resoS5YyX9gv6KYvfyHjF1S/tDPe
+NR3tSYF6GC7L7tel3z2TeVWnL88Zc04akeW5zjkXDdfyXFPO1TquNfMVMp8a6OU5K85pzYLleVOZ
+V02R5TkvOOfPyN3sW7qujuFpuap2ojpAKmrKYv6Z8U/FPyn/XPDPBN6/c8h9Z8zP4S37ftq4zxr3
+88b9WeP+unF/2ri/atxf0qkTbw/Oon04bW7uPdxadt6yD2jEggT4wnb/0Z0HNMi8iWp/tMlkjiKm
+392hRTSYPXDIAfMBFrmgHX/IbgC8rVcRb9wZ/W4OLfpBO+WQ2V/e5sb0u7vEY6DNHkmZYmRShkU0
+a3FJ0JpZkymyyeGszQy57Zx1skF2w2dt9gc4qVmb24EOmbWYG+idWSfTYZPbWZvZAKZp5vIL0IUz
+lz2gM9LZPdhN4nVr6EITipbOmvJMWUfeRV3PqvDBAyr+j2qjKM8fTIpx9YBkBOuTBI/j5cZFPc1G
++iAf4SGqjAbDsiUeGpZw8qzXIq8HT6qjY8yaYxkf37/SQjXfnEnLY3VE8t6kOR8LOcYDVWDlP+D9
+NW+4cplWaQ1HphqOTFB9isBwBqt7ZZpUVXyeYKgFXAOYnhf5+lQVNkkuV5L8Mi2LHL9IL9OLVH61
+EueTlXgyofC5cbZykWQzeAyH1TIHClVteAuOiHIDZBolg8kkbEkaVgcLkeQUMQe2kbHSSlj59PP3
+CQWEYI1LV4ZDgh5sPFwAQ3ljSTPOHb/eGQMlCMelttZoHxVyAgayo0Q/yYkVwxE/YKKj/esln1t6
++iZSBrRC2M8r2dq2f48i56sWOTcTfrVF5N2Y6xc+Rei1K7fR6GAfH5Lw11O1gJXl1O7MDKaziXUV
+aw28Ktmz3seiL03RkfUZGdfl2nqK0oLL4Sl2OZCnOWrIo2uBaJnWe4E4xd1+XiaNPl7ty/AsWpnJ
+iqX9SOoOTAsv4urgCg08Z0lZX4vP0Q36+KJ4PTnDn5OTKsnUFQmy0G/JTMQTx0UHiDKMrwz9JO2R
+tOaYHadyFPpoCTIeP84Ix4KuZIB5fgIVgSdphJp/vAHCGeyzY1Mt0Jl09XOj+j65mMZH+XFUo0Br
+2C03W99UqpwsiI0tXKmBNAaPM0MjtZAN6XDfFq45orW1zeOhVRgF1iVOUx4k2PpI90AWNZ5oQTZW
+X7Uio1Y0/bCBmUdtcULu2CmNVCVopYaxOCGI2PBKGfJZp5TDv8UeFwvGw3zwjwdrD87NyH+yJWx3
+lycXKA7iyF5GvAXdeJEXelHfE/ADF5veQu0I36AEtWSK6T84isLjB+fC72BA66PkGPUgaCZC3wnC
+GkWvxGX6W7uWc9tzbVShhXdaIOyI8RH31zstiiyJc7q9vfUlapucWKsDK1JBEmTKtWxFHkAraUwj
+mV06Y3r5fHoKTENIuS2LESVXXoYLUqgzML5IMbEUZCDwZSni1qFvgueRZZm34a198jNE2sqFK99O
+Awyj7HlS8ptQUEbTy0+F903vAYZVgj/iuZ9SZ0H2rm5PENNQ2rikUNAhlE5rtkul1J7AbCqHs7im
+WczQODyTE9571HxONvhisYAalWv+aoqjfHuboTUlgmfBgYDml+eFOAnoJuhu1VpCJoVktJ5iHMoh
+2eJiTF7TfWG+BpNRNHUDgSEL/eHc2M3PldnROMqh51EgPD8W82CYrUXP/Yo6cQwzbqFozDhabmiQ
+mMOM2eck4EKL5YM5CRQ0OTo7hjPGkfef/6l4Pu84GMmY5xiSoYNZHEun67FyFxXYqlWsPRmvIhLy
+BLaUYcCt0ObK1BpuqMCWQ8MMCpEmBVGlYwvjshRqZ94aCO+Ic63whnTskW+5fHUFVsDFCuq3V268
+NVfdvfFHkea+J1ZwLBdeWButnuXsf2DpgRua/ESejo6OBbo+yFQkCjnO8iUTnWELCNywgPaivjW3
+IhxJGdg6mwecoL59XhmpF/D9FRB6dGEgfZvMQKFA5DNypKe4aebzNZljmQIJh65W24ZVzsAuByon
+7izDvLfZeE+DS5g2aJ2QbgONqL6nzeFVg9Ewm8RbExpLR8aySabLsm1tWkGnONjEh+im234nfCXs
+B88wVdo63dRlnFfE0If9hZPtgDdH8ao6LKZouUQWUU+hsjm8Z5l+ibiq0vM8zBdwutUQNzeoujgQ
+0tYtbFod4LRzbEmlJo3YQc0r4AChrcEYTxNhez/Uk7JV3NoaTrx6IeqC6FGXrZL7kkuqb2+Pjhei
+yDP3TVRbHTbNGS78wfaWPRoLkk0qFhOFjMh5mpRr8bdMcz6gZU4GRbRlea0l7NZvc/chBq2X8dxz
+H49QvGngMZ2BlyoJt4bsFm8gNj96o81z2qwovHJl3FvvZEsTaQbLvw22jraKjidkBjumWIKGqx13
+crVj5mqB3zS2RHBnGWLMR8iOhpjKsoHxHUzveBnTOw5uFLM7DrQnxgwm5OzxeDgjpndmM72zFtP7
+9Z1+Cb+a0fxisxQFNtNpl2K6gMghO2LDHma+CTxDlzMM8BiOLw7wz879JibADEviCQUfCPtCCaLZ
+bk7JsZnGBRtaTG0+nQrlE4RQOMmGFn0npnVqnp/olJcxnu9cMTGTghMnjrnWtUusRqIEKnKBLbbW
+HJdDlcn46y4ht2lHJs1HQ172rkg3OhQsh7qziLFQTrzhjdxMwvWBkNsGdg95+4Z/0BemrgC8i2mc
+q1MP4uPGZWJimEq4xppKmlfJc4Uw1DmBYEuyMzECj3zxromn3uM89msvktP5Oft3OQIdeigBA+4q
+krPYJb6azpCHSy+l0WuLKLoFNLMrhwwu7HV8Xczre9TDzmjX5s2ywVEvYgb7hffJZD7usMpz35K5
+3MouUb/oV87IFIOyNuVGzcws5EmW2HftPtzc276PxJ0k7VDI3vbmo0GYdMnaa0c2V5IzRh7c5HBg
+zDmKbSBRO1pLXOTmxUK66OgtW24LyD04XZkvVaOUozIsZZ6FLA62fSxC24kA4QDCWQSWuEny2ExK
+/NwWMdnCIlsaVdN2T89OTiL4zgLdKAorN/lx5OwzUjilTIh4wZE9Kiy0LC35a525NACXEjErg9dk
+Axgyv4vdkSf/hFj18skmnC3yBG1sPGXEVAu0xFJ3yUaVpXBQ7ItyfWCfMgT8LUo8biRH8Og43CTw
+3q8Vd9Q/hgR60aQNjoPQu/slZc5zRzbdNMjre/vv3wO39fPT169enBy8+3ACVx/3nUOMWa/ffrhI
+VugEt+J9u1avfeuhnV0qzZFQMF4QhBg+TeDpt8CpogKPp7BofO3p++9OPvz2rvkxJQwk8yhB5sSt
+aYp7dxx5aAzmof/y/BRywJn+9jZ93B/1w7VUxCr4EcH2j/wi8qbzqiYDstPEIz9ELRn6X5j6gCAO
+QpURM3UTIGOSXd7elk90mCUpCVHh4xJZL+zscl0HRiLo8xqO1DAoihWC71aRh72rxyxxxqxw7nIc
+UewKLwhMYPBsCb1UEiMtCiipov1ArPrlmqqX3Yx1Bg8jo6iDM3IApPpueMHIm0m20gs9Xf1hFdHc
+8L411YfJ8a2ucXbP1igiU61F3sbK+2ScIPYJG7aZ6SybEXROr8MP7/efvjl59/Hw+5OnL+EYcbJ/
+8BIO5jASSTxlqU2wEp/VSbmCT/SLb/Y/fH/wgk4br968e73/Zh8OIS86z/M8VKhYWpkm9UUxwXWA
+c0jjVSYTjyiHW6f3+2+efvj4fv/k+euDQ5j43rsymQJrUyYrcIYh7Z37xot9uDj4bVk1nsc5fhXJ
+vKwOtyte4daSyaSMaeHW6M3H1x9eQSNPnj99/frZ0+c/QmUUS0PlQa8r/7IVxGOtWnV7/vQt9tW7
+V7iEVVVmKfDp1BXICKMtXOu9X96/+rCvhuYttMy7KtM6kVUHvrH1xtuPcOIjwnQIud/E1/QBfgtZ
+N6ZJFVo6cru9jnnx8e2Pbw9+eQuffH7w4tXb77p79GP+KYcTxQrpHPFQj/161zz7+Pbw+1cvP5j2
+nOz/DBPHTLh5Xl2kZ7Wec9DAFYKZ9SzrwI0/gSSInd2HO1tfU8pvbQ92dlApbwm2LF8gzftbYVso
+5Dwcf3nyG1y5emFxKmN5EAaOZW/70QDPwGjPvbm5B/n9rZ2Hg4eBPxZxYEWMLfzU3uszOOplj7Um
+N1MyzXlUHWXHw7HJezQ/BubGTYhS5zZwjHFZykC8jG03PdYeJqjxwnx4ntRcD9QpdW8lyn9WXH0f
+Z2cHMzh1ouMyUMRVFlypaYunQ9ZS8n20Cl0i8+DMs/OoezuP8w2VsfFhCWwMVC3xPZz4YubE+Jn5
+UlV4or4gcWIQD/j2Nich64cUDiFnVJL16pl0n4TTX7CQM4VVE0YsYLqblyB+4Pv0/OIXVEu+ictP
+nrhJcjx/ksEsVPc8qdvK65WuKl7Y5SxgAf2FOjybwxGi/Psf7/W6agSvc8HoS/SX6vOapvO/0Bm8
+Hu75VUOu7/dBI1qiL6v5etgAG+vsJ7/jnQ1dge5+1I/hTFMlDQHkf1ldlA3BsspE6IwCNXq0+XBz
+5+vHskKT04fbu/2dwD5ELaM1hUNrMN8wd4jLQpPJAtE47TPLCYmUyX+8xaQp8cxiIYjyft1thrcA
+qzlPxVN0UzUdFx0CzR48HDx6GGyQr/L+NK1hNUoa33kYh3maVnWSw1HXrwM1aWkb2Nzc6W+hmVLp
+bz96uLsdbPBSQjvujfONj2leP6LTluOUyu7Sc7TP9ve2dwe7wXCOQY/HMGyn8/OsOB+ZS1/ul14Q
+toqYiTNxgebT/s5DjPqO1tH+7mCwB/vgORq1bW7vPKRYpQ75QvNnn47dwcaf4jq63Og6fohT+aCT
+cRRX8mk3dyj23ZeXcANmA32Kqi6OjTtlN6qD8gVPYnESHXmUBMwDs4OaGsDVLJ5TCgrApol3PLT1
+8hRC4SaPcjiQ+MRGoDI3ub29WQhLB20wExHfxJ7cudwaWYNGBm7og2huRaGWqJWlcX97i++oFXyg
+02XZzp4QnWsrF/VCY+8p5GunNNXIpGUiA//Q1FTI98hy2vGC6J5EsCrHGey6GpNTouXDDqo3YLjj
+9WGSsE74irpHg2bkFGRcoWTy3vAFshAuoZWuWveaFpddJo/kofS7Nt+mkZ7or2HBz3E6QAY6l+kE
+yc3M60JOIRoz616FY1JEUn1BKhL2JZNrbElUyu2tN6/PHnnyC1dxWr8o4zQ3MaCoe94UZWIVSqZ+
+Ti/L8iXGg7rHcJHA/s2QlG3uwFzdqIJGGTDYM9+8ETQKtJ4Ynuep8r12lkGbkD91CDm+JaVwzYz5
+sGNPorodSrgZxExpsInMTS5x044nzkYn+c1O5bre3PQbKjihfhI47K3VE59s4I+5r1fY08nk+cU8
+h8UlMUZj0nS5DbQUrHWQ2iuhZWMHRRf5fnHGhaKwjxaW7N4hgavKIVVCOJ3AvOmQ4rEpz2DIzqtd
+nVSEEk+swYuG0IwGoXopWNRqNSN4La7T0SsY0hDKaq3T5iJFCPD2wu2Lt+wYD+20DBMKyB13Ob0P
+86gW1UZaSf4yD4A3t+dRBsupJcKyfI9rtO62yaiE2r/2vTEP15F6X3iKO/bMrusdo888Mwja0REN
+XSAVDmyffQSo0u1InU/VwOUpEVA/6BS23d66r0gmFrbbd4rBQSkR+QUYnofg1Tskq0COy2KKklV0
+rwdGKbXo7wjrSq6OQYg2FSn56tt15wmmsp0aWMzU4kx1KFRn+qZq6vV6q+UIaqcT6ARH8QDdlrLG
+lntnpOszCMIPNDWsOg6kZQ7K7J2PypzKr182ADXAstzHqbsxwlfJGElN77bhGRoGyC2t16P5oxfQ
+qlwBMK0dos2bBgPSe4ikjcvjKytL5CO9HJUQo0S7uNYStddZr4erL6B218uOnk//6w48d54i2ieW
+zjK+dhShA6TVBh02dqqunKcnaBWoMuhrN4vK4JIXdhy2M2Knd9EgkXd1h3Yetke4BAoXth1oEOOw
+RlKUN5kBDI2T25t3EqlFTPtf5KHEjjBkPxmjaImI8dQBXaNZ1EUV9IvSGZtWlPNyWr1jvqjDej7q
+nAxqkbjlwAzQfI9dkRZHIpkCyYoEw+5JwfyKNGlvPNXsyh2v6kxWkOWu7HLRXUAinAE9T0djHQYF
+LmGmYDB2iMyLqIgkHBo6UonOEsdwNih9YEE8xlC/IyuteMWWN7JInrxQxINUhtSFB5FGEN0e2jZv
+9tnzcdRnwmdomdzXR/2wQaCSVQKg1SRQU+ja7iXqCfkkVFngbP2kduktcR3u0aTL/OlJdDBKogMo
+YH1dJLdR8uTJk4G62FQX2+rikc6zKzAMjUgWRBahobq6SaiaaKrXZFr6om9LBF/5iWMWaK91YMaQ
+yKt3vQZhbnM/TdK9jEXqKFj2fSA62afCCCbfCid4zNv71/8Ev6OJrmYENY9pP6XQK9YeKAWkvtr2
+LF1ER4UH7Y6wZpcqzswzHEJnwojXSKd1Iz9I4m2fkojTdE5NTje9E43I2u+4DKQJw1X9pl0bvYE/
+rpsMxBL2IBgaJlyK6JmHn8bXpxSaDmtGipuVPmkn8BIDyZV2KYz12mjNwFT9/R1jXHcch43ciQ7t
+9mg96RNEk3NMpj7gI7IhAriltcohZgfKQMs6LsS3evgNVtM6G63gYNQwGB0dYN56weNC75EcRuiO
+CMwl8vOq7+quc76ZmFRIQDNIuLNOj3lHNV7f0cc4Z6B+WJS9VIdW6TpKGRUM82Join7mEGZ7/rCZ
+lF+7zIQmulLNFWJ4KiCX+i3fOvQZEs0WEl7AYcFUapOQE4SzbzGdSgWstex1Yx8LQqtKY7afwxbp
+c6iQxjQW2MvLr1ClfGLTPnNaCZw7Pkyqo6i1uL9gdGZ7cX+xD9BW6ag2TKwShTEmWLXTeVkn5tic
+OCKsvqhttZUkeaxhwkltCYgCQxFcyfrQX0XjBjsvHtRVZEsEtVHkF/UpJn68oVtcMHpFGMObxzn5
+d6Jv01F5TBIGdV6VrMr6YPHUwfqNJ85+LFesJ8hdZRaXFaKBwVcHfVuM0xhGgaiyDExHHivdu0Cf
+cfSbBxrf53OgQ2X1nv2k+SS0ztNIi3m7Uu2U9Q9Xmltqc3vrZoZesqgnfMW/QrnI9Rm37w8VSN6V
+xKy4hb20X1ZqBbfVQ1NdTDbafBTL+nZx2ERpIpw0tyKoCBTAT1eypKqAEY3zlSt8PmUhLx0b9I6t
+qd5I9hMCehWkNCeEHRymECXR8HhSUK08i+4aGRBxTk6j26yVzcg2ecBAlzOwyTp1MSIlBzpSBAbZ
+ftIfMdlk4LpR81vLOYck6ltn8XUKfGyf3IN2KxRz09GeclVOYMkjyIHW+OC5ZO9tYUCOrijuYbS1
+6D7z6QyPQle+xx0WoLmOezZNZ92Au/xy5wFVhQKypPY6Ko5MhJXrBElTyUfyQiTHMoPCXJEP+LwC
+nJld+lo0EDB5MGGFPCii/zFBs7Uq+h9/QGdYOZWMNI38VTgWr3K0P+hZmNWJDN80Kea1dZeU5SgL
+LSfHiqI73pCcdJ5j2ThdIwr4CdOYTq4F2ut/pIcTCiJmbnEWw7u4teXzmeRIMELga8np+EpRNOl6
+xtTaE9OuhxOcYJ4Ydz2TWqiLrmeyGaIKKCB940U0YsiWPjnvesLT8CwQM2zuam7Nf+Sjmnri1WYS
+LQOZfezb+2zmy65XOx+bQ+S2wNHap1PgHWxjjBRfgZlsNXnIzhEdB8QGkHw3J4HFHOQvuOfthR44
+d7iCzR2cN5kG2BkwppBkcGXoGMUHEyuJyIwYMoiqr0dagu6tDoaWnUgt+4gLk3bGiZaIYrVxo+cE
+Iv88a3FfxAt7ySBIOi9ODqtkPXoykHaFH329bnGjWp0xNT+LUXzKNl0MKVUlYkUqPXOnq+w7PFqX
+fBxwhv5Ct0vO5ToQ5/7dc528p7FfOQljMDbi604IU/WOZXZunzCmavrJDHcvX+fVc35VkwyUwOIl
+UjONZEhjqxZPh9tTp1JpVlIUFVUD46DXeOBzzKmNE7JVq/AUIi+P6uNRw8TaehSM7DtbcGynR0el
+sO+PQ5qq2tLUJkC8Im06xykOdeMdjZcpRgVRC4LFF0Tt9Vmr1KdBFMs0JJSNTUxjfnbylDeGVKPT
+n2LEans3syRwyoOq63nC24h8hJwGcRvynuzoLT13Q8ltlv9AkCMZd4eiW7T/lgHzO4QymZiYqLwW
+C6dSw/t+TQst46g/jB8XwxgWZH4UH3fVoNFbgS2cXPBO+9GvNXHQBwIGa1JPCAYVxj8Vg8Cp2jrs
+7M3eJdrITZG/aCMv7uwgd0YUeSdLkzpZbDMgwUHDlgrjeclSEC2ghG1xCFs43CERIUYkt8SfxpYA
+j78mM59m/Nw5o3Z8Et1MOiqSt0SRuT3P8i6LByJ31mEh17okIyVRaSN5hglzw19bu/EbNmlEk2V3
+ROKJJlKRO1LCPTnalParg+hmbwyoGrpG11q1fc+1bdaVS32aZargqoO2pHe+0O0D3KoRc/5aN5Y4
+falqVzdrh5NmWQycTk1S4hJWTVMTMzWS9lxqWA60RGJSOtoQkzlClBdSQqqM7fCTylBm0LFu6VkH
+pjOy02guT89XVKWR979DgxTworsjBzMxzK4EdxXl2AvpN7pfcQ2B3PZdlfFsyT4lys7tKrfpdUEm
+4cRCEK/biNUCbcEPIHq2NMi3dedStiBnS9mw6KANyMgt1CFMXjGye6BYa+ZfzNfhsGR/nJlS++t+
+YX2QmdQCaYQllGT5JsbQREsKR6Ff4KI17jq1UmmRpx2a7mhWkutoYHWwR4+K407Y9YQe+DJLZIMn
+tE8HaiEhd8RLO7F9+zFGbNDYVk+U/Ax3V+q3E9hizTRiL18aenwQBG21n1Mpq4NPWJiGexZuFSyb
+1pvJQk68O4DibF3+kUSNI+jdVxJG5DhapsJHHESMEoRWpTuPdvrQ4WfSkmmZpbRjOLDEbvAvmGm7
+y+2eNuuddfgbNuv3MFxgMfZfrMpLJjT/Qkdovfnft5ZQ1E7ZSpygvQDuB9Gzv9KWv2F636WWxjrc
+PYexel8BDEDp2wXM1cHOYPchzNUL/yntSWh2TlbkXzc7H2uzc22WXACfdYd9caweN/21ECWNnnx4
+//Tt4cuD929Onr5+v//0xW8m5dXb7xBFrZHtl1cfvj95vf/2O/hBDE5lKTm04wm4wjvLfl1yAxs6
+QR4EZMOYJI9PLSPC3D4CKVUEn+9QoBgDtZJvoa0bHzl0OZGObrKKiA1sH4s0GymWstwsOjmWwjbD
+8guHm0XuSIpki5bI2pIKNx/+TZ+krMMJqdGl0Q25h31QieHcUHVoKtTdPEIWwup+vJfdxUgTpjet
+e+3Rxlh+XYulye935VFC8aVGrrpmmjIY3wfr6RKbV4oupN+ku0imKiYJ2YZZmajj/6zhMGVY2GEH
+3KNVLru1LzXzGp1JobcOIRlaL9swSyhuOVMwBIHrhKWFMeag7y6BOjCIMiVyTsqsLun2IpIgNji1
+6CifNCeSszKt3Klh4S1GzPLsQ4DCuy3NHDrb+KozQ3GCZI2yGkeqhfOxu/1jyN3GM3mk7sEpgOZ4
+1OVInneTMOx1TWVKkds0KFG3xoILBdNOz94spzx+7nYHn8X+DdSn0eaWnsaWVTWaqyy6rWaius1u
+0qhujmIfVVMupe83qZdvFylqt99QrO2Qtda4dRs+Zh1ZnAnk+O7WjNMA3UP+qvd2oNILNXY7byi9
+PD7XjjNDXV5b90x/bOZ2tXv2JfzqMLGKIAZ/mA/VLGIJEjpfD3Xc3/Hp+roo/NJY8y0QHrr8hBPp
+zzl0SvWyTBhpL0oQrQOZLdcp7BebgkSHUp91M0mAgI4RJBg5kb3Nh+jLZ/y8spaf1/zrfl5jDK+h
+vLLO7vDKurDZn0l00e2VNZUPlvBF5+pxiy+6lE/aXuri2n2knevFqfvA8jcXV+6jhvO62JePm97l
+4nM0a/h32aC3NmIyu2+l93ffSgk/7C+6b6X3dd9Sm85X3LfOtPvWEtfhNNDrJM6eE6KAln1ojZ3t
+guUIRxz/LGV1YrkaGY8mGb1Lul2zZIAhTuQ6dpKi1epvuUC5LmfYZLu6vCrVU8dXjI9wcMoZJ1Vl
+v1PkzS2rQUEMbI3LCGD8H/oGRu+x+e0Odie2GIBzzONG17Xakbgcd2IsEvgB3ApzGfUXZPofrLZC
+DcfBzfq6RcREOfItOV7MrrG2gShKH1rcDq0dy6SJPD7QbyHGgMr3zI6OPCw1JEglqGFsAaakaMsR
+oI5Zz6dhikZPPJx41Rw9WCIqUVPh29sDjnCd28rk5xgNXaTwQXIS4cuFpNJmFukONynUvfbk0V+y
+cmZxJd3Zu57qztc+kopftr0enS4b/JucDRtVdvww25sXWdfHTfe1p+52bBE617ePw27DsXBVItA+
+lTqDpntfh+t4txufQXK4w42PRkmfUXgVy9Qlpxp6dum+cqneufzb7n9LjlBIcM0RCu8imQpHqOpr
+DoMcla42czFXTB1Mp1Ret2ytLGNsYCMlbfOlExubDqBp5khx6pc+hhSS+Ug3LGW5IrbSjRFWpxtU
+aeOeCAlVa9vM6X1Gm3/pXUd7RJFxBJOImhSKFvcF3I0kIQ1sYOygNmXvD3XsQXcVyIOamgbk84iU
+wmhhu5YNyoZwBpOOPUdAli7+b5hKvrK0gFlQrgPVKYdBcVQdw/GmZPfE21s/I1PHMuIaiGotGgwL
+RCZhclJFmeDZQKMq1XcFAhunciN2emltjdAcl1CjlD6C9nAda58fyjyRPGLfQSSMsW+TvJDvHRvL
+l0OFOQN0nI4k48g42SCPalhtGBXZVDTyc3xM5jpGpgDG1uqv7irAXNELQxrIywNXyZb6SzoI1SJN
+Il+Krrk1aMBFG2lCPtGW/kbvohCSm6WT/ZTaCuhG1trxMcEJnkjaYUk6MKqHsz5KNpEpGebP2mKM
+x6GR1Oil1HAIMZzOHz5LQ7DPmhpDq3hycDBcJczprrgesvqY2ZAm/z71JINMd4I7TKyjl3zFeklF
+P6T5h3qZbbM6Pqf7pHbNsc2hsSlTWM1Ree/YY9ua/YBjRSiLbO0FbCFPVIE4tM7VGhDHPsMqEiIP
+B43ZIwhWKhkGWq2HxxTX02whbMjp8vqmW9x/aIv7T5X2BAX76JUrj6dOqGtb+mSD+cA22GAGuTjE
+ZNMlTTZWPlbJyjJMIGIx0GnME96L/Xf9fn/LQ8G+jnxMkY6/Hi/pIq5eSWalU1P4Uqa0dWbWm8cj
+fxzdL+cyXYpo5xU3hJLWgfm9qtgnKZ9mwShM5KfoWkCWX0632QfPQ9TvBGGnSaSTsUND71haOUbG
+l0FT2b1UuAeHoA7uDvZKoOlw2osdvTDaaovsDk/9uVadVkAcrZwYxtfvRIvNjD87WiV0DHpNeIu4
+eBhtuhrVkZryYU1+8nHL51Z00DQGbjwJRCyp/qjrvEhW2kNJly1KxcZ10tQp9CuXeWKOinTOQzea
+RDmKqczTttNwidTVAS7grJM7gAuOydBqNYbMDExg1xGPj+T6K+1d8BAVEHy5Q5DTVsx35FtZHyMn
+RtqaLi7EQpe8wMA0rFrgBzA8coyxGAs8T+LjDnM4KKU0NLg16KGFc3rKaOpV08VemUANjRN+JYPe
+LfPqG861yb/kYfsBA2zIDRyHgnknFUikk+0YdjMjN1JdJLSaqBA0+cNSKH4phLM7QpOzAmk8GjPj
+1llg2OZrOvMt4aeAKWU8Bc2fVXKMtWOKniLsbo6TKnWpBvaGTWC6AMS4x9bWmmagzXdtwyjXUUqV
+QW5YfIkewmZYEi1PSLrkCUmXPEEZObVc1180xFaNOAydbv0UE7d4XVwl5fOYjFvEqn/kXSSfYVWy
+jAt/1vE3rsZpCr+nwPCUCDt1Cm/sbmOOcbXJP+ub/MJgF0386FV5WcZX3rHGjfWTNc8LGp9+sj4I
+LKEURv4c3gGd1xLTSYuUe9gQ/H8ATvDpfyvE4tOv68NYmTb1PXncbjvxKOEEHd/sB0B+71SvuatC
+mid2yCfQHzQRiZRWyWPfPXZNHW7KSMqY5c7NAlSXkZRm8TrGCZ/LHbO56eHRQsme+/KAIkqHbbel
+emTG7pqf45Zg+X0mNhqopFE5nZD+6rz9L4ed/HeisNxjxXwVhaUbEPJOwMdOFJbZ11BYZn8DhWUh
+yEbtb+kWnegFNVlejrqHBLNKPr0U9jD0xZiCE831veoXjKcIsxKdKuAVaUdf+o92B31EbOSDgO/h
+3vs+qYrsMqHYsm46RdzC8F0qWbrAiLFJIRdM9NKyX4WaT1OyeT3T6RcURMI8udBPFMTj0PKosYN1
+XMrAKhg0LLRDD04tD+2j6tiYTERGTXJ0caz82eUzXMcJhpxhlQZKwuRVJq9qf+KXyHAGthMzguPf
+WKt+ijJ7/Mpl1I19ZR9T0W70WuWrGvliOIHgIVfiVLvrFxqwYA6rk/EQLA0/mrvNlwXI7oYewA9L
+QQrlHx+389A8gLbzKkaILZMfurGJpEVbtXy7afli9ZPdDQjhPIJzEyI5h7X7qQX9P7SBi2CUqFUB
+n1/UtzrtT4w8Co8sSTPsGSxYaDN82/lmWB+dHdMbCwQdg/N8LpUFRiHER0lrHpl+jpf3YUzzZ+hW
+nAo60+EYVmQbURILpKTLTlYskXXgfIJpgzaRtKU6+Sxjp6+PVGINLYPOuupAHK4lY0UB6wJxaYPO
+tmORlI1AINcCJjyF7L0Qem07dAtaVgMDLx9qYzX3efaV5/OvPB/rj7cBmjTUQvu1s7bERGiJfWlT
+G2BpNJUpNZUpNZXBWZIzlQl9ypDwUzSDcj9sxSM0ZabNmGwcG/au0AKku8OjrSKb+D37YL/KEbDu
+rnhNIg2cDZBxfhxJMojNByJsnMq/UkzuTilgsDAYGbZXuQEYj6FzK2RVSc4/C0Gov8t23mYAGN4N
+3KiPuJAN5bZio/FCrNSo3pWH9IgkQsqBNcxg1bqhFlX8vK4iXiTVuExndUGxdYINs7MvqDvYQI69
+AYhEafGtjUv938lGuOyLA+gxLB/XdrB2dQo4KmFKWE2L7BsUuBCnbr6O3LJHlfSgKeR+YOtfl3Gt
+aJuHofmQhitmxzVWQkumnc2t3UEAR9FqBmUAv1P1etXGeF7VxfT21pPpXhc5g8aqxid+yyaDTH5d
+yaZ9ntVxInwnOEe8MgZuqVqJ4X+9f3nGm0la2KA9n9Hi13Ga2Sp/aYKykMRWzPXBGelJdEShtz2c
+Sp5oy3ploCn0qAkTS5AztAp/0h/pL0vhTmhqVlvVqsXamvUiLFL+uvT3vWcFdNmLofKxsZAuzMfs
+/ln25e7v0oDpY4o0obWlORoqTnXmwK3IyPqyMyhh4wEVRCrC9XXrfVhLuooEUdRRxWWfaIy7Kgah
+k7o6WHkfWy2VPIOnVcO1qawoOQYlNb4m/JfP9TAo16JEpWoypFtA8Et/6eMrKYW9GPt949YkRbaR
+fPQxr+IzOM0/efIEln1sVbFC/6dhQAJyqBBUuQCGsRKpI+abKdtQEmKjSju20f+gxNjRURV2exjF
+a9lOX2qr6cfudLHRrdwHKhCYvarpSXfGhCCrEtV3rQ+wSZnE1qr59gS2Fx3GK9RJWlchzIgRglbH
+nLPlBvqb+i3zgeUr2Z5IA2Tjec5QSOj1yIi49cxSFtM8lHGUPFFm0CN1EZJddkyoKAp0bC0qQvxj
+d2wf0Y7WgSsPbpzM/tpaKfh79sLdcKlNY5mhvtcmcjxY6nvwDYaXWUDZNjuvLeRg0jkdpwSOyzqu
+MesDYU0h6Ed20ix5EuDkRssHaGxpT4uhMkjQ3Vp+tVsLWZowgE2ijwqXO3ozF2WzN8u/0JtoeLK0
+N/PO3oRjmerNrHNZKgVdM7ChYVJq6MP6cTOC7bBWzApj1K9akWjr45F9E97Atvg/Nke55BrR1hE4
+kg0ZuNll/CRnhtgXqAr9CgNYdbJsaQL8pfjaqz4CN1t1WlKh5Tzh15hTQrZamDBsyQKjIgMvOUlm
+MIuAeyQG6hVzTwTiAFzYMQpMY9+K9IjyzjmliTlBn8I5hyzD7xfcauhyvbC5sFw2dmA2Y7Zh/To+
+kDZhJDihhvfiavtQaGdnaw0ZnM18O1U2Mg1fHsv8/kaetMOliFjV30CwBk72rwtctU779jYb+bCW
+cddYImEddQacskxFUQ35lSyMFWFwlYRSZ4WdqSyHCf2/g8VtUNz+mvTYvKcEIohX1JKJoHFpMiqb
+fVTe1fpRbls7o6q9/AudVQAP0+gpToK932+UzOfzsJG6UD26EFrM3eI2/2o3DzqdAZtRUtrileXv
+OkFX/uYgDsTS8Gh3PHRq7D7tclZoZOkypW6XcufzhuU1DJTrKRIucQNo4jkkLa0bGpZaJmIwpdFK
+zEoZGSOxGnVZTfoFWy9qDr5Gp5X/zsZyEZRNxIObRfugDadrZNjjZVhVBpsKDS1YHjQsKTjGDYro
+OjzfVgfDNuAC2YjDtEeDWCPGaHIH6GSljW3RcAZOHsXjclgQoFFhh60vjoeJjcqCkoiFH9/eFgow
+sNQQKBI3sLTiChpwVpRUaJGHzmmiC9b6WmSOIYP10hj31nkLvxVfXjIrxdguqyLYHiRC6e2tip0I
+Rc6wyObOdIf81AkfT9ZXBOdXOYVeOGOmHpBHxKRlbaGGMkWQOLTcvE91GPabhjJHOyn9hSBEi645
+lfO1nrq7HJZkLsTUHZIy+ZNEmUovPA4WKuhHl20cqoa+h9ojpkmnGX98CqsFY6SMKoKDbtNGg96S
+BYLvJEpaxjaslFJgSFpE7hurTFQyQalRrUdTAlhmMSxZoCCmmspM5Z+pO9M4IWcrkQ7sQ8qsYduc
+6kwC0eiqBgieW8fmY7e+7jOrxtwaXl9/LgXp6/yC6cQl+Hydz75e5FnnE6uTlsIBIiEmoAmKGN8p
+pbSMaFjgqNyLyVhNRSSOL+FgRvjmKR60kpVTeKuC4ymqtHf29rZ376T1El/B8tjEsxx5Xr46PHz1
+9jv02DwUqUxselbaSKhSbyGFpYkN00nx63AnuDdRZ7s8srdgSiz1CwZJB1V8caC9AyUWpuxg36WA
+pInQQB+MhCNV6AQzIW4UoQlrIzEvFm2tjNqz8HAyxJLh0KKVnVlXW6RMmexN1dvovCH+FaqRIAwj
+XfudxjWaC7D4AUnZ4NAGbAMCBkg8RUc1PqdDl+8CYThBJAmmsnZAGewGSNFCl8X+kXY6HByPKmBO
+ZsUMalUtuoX02lK9tZXX1lZO4hXUWSSkrEAthbWVl8facmMmdeYutGWNeIFIbCO60qKTx5uW0D9W
+lg2VpziAemMaz3xbfy3NpeLIqE/W9WzAJQC8+5O+O6NywqVCK/teL9WHfThSxxRmykoo/Fzr1ZVU
+BajLZD5O/DEudfLG/ktsnXLJPnj3gZ2hLWXJTdOrO2wY5Baqtd0x6aUKsmHENkqaSOojhIlnPQUi
+KSMHqHXy0t43rV4CHa5RtHR7+yauLxB7COZGjGGLoJ/ix31rqHK/GJWhd+Ha4BmzUqcALQZxrHd3
+w8Hu1qNtpNDkL291qumgzuir8MJD6OFlo9Att6mFd3KSVPDtOepHpVoPSZZldLS9tbW3hTwxTJci
+QJ7fvDPKwxuNjM3xXB/u7fYfotmaTO+gus1Fhfi92ubLLKCBLTgbHIfakH3jTTwTLT1sN1hy3v5a
+v/Nrfftr/eMQqBs0366AiXh1ldYXb+bAK8F3qoaqtkNupq0ZUcAMky7wSU5GR4FgCInpBvR8OoFJ
+8xZO28SEBX5OU12QoY80LpFIFGYq6F5eiIebD7eWwlPUGx8jbrM1sDRh7jWw9H5svrW3vSOn2r88
+0UT3RDHItd5//ifSms8PXr199YHAMTeQqI88XJlpnLHbiBqnlVlcVclkpS5W2FDksC7KxAvRB+sy
+LeYVVDQmGOhxkl5CxtNr4lyYnJXeks7d29rdGfx711YU+2pt0UTzkURCtwZN7BALxNceoMQMULJY
+dPWisaONnbXCe9Fq3FDkUU+tTIqEmbuL+BK6dYUmpuqeDaBhn6Dhc8iIvdbV68X0FLrhPb9QIasY
+wzGdPr9ydQEc0gr1BCSXuturDU/B4aMDnGxK4EuSrNd9Wr2aTufEIY1WO5NR+mg9IQMoZFshi7xE
+sZZs8QdogreWrn2LtUQPnRyGHuqJLUHt38q+utUNhRaeJtgipaTH9/TXN54XWZZIyURppb9PxkU5
+WUG6QR13BvkIxG5lpoX04Yr37VrMwWu+9QTcBWvfehvfSjYT7ewPkz/9AAeRL+qCGYmgbTRiHLBq
+dNSyRPKMZ6PFtkY77300zffWCExaK6o9Wc1rWkmqxh5UECtdNSsN7ZvnEzwVfIvda3Uk9B+skslK
+kVPPYV98yourXM0E1R/XK3k8TSrlO7ekb1asKptqQTdnGQ5Tep7DlJ5sfCvdSrrpJu5X/66lDdSs
+RckjIvampq/yy2JMW8e7uIRGIqeQVFV8LnPSS2/hgUOxo9QQCNRu4x0SJbTgo7tHOzubD23ikf4F
+4uF8Vi8fcf+aF9Y77R6wtg6q5n/h1qFB0fSRKwmap9lvJXXCSVXjdOVegkmEQm38OizViyRfIWtj
+XKWQsaRtB3PH7Dy58qGQMwzpASeKletivjKdV/UKdFqWjtM6u17Rqshkxd2GNr4Nlm04Dx8+XLqb
+lxsT6Jab6ST04dlUvNiniwvx53O6mIg3H+hipjcb2GqAAW9rv/5/atEW/5eobIv7qGx9VLn8Hvz3
+Km6L/1bFbReJ8d4AP3aWwsrBxfV5hSRRK//hrSVr3nDlMq1SYCnqelaFDx4QP7fxR7VRlOcPaElW
+I7QnjSg3bB4lb5IwKitTJjC4neISwPS8yNen6muT5HIlyS/Tsshpg8aX6UWqQLWx4kk3ya87WBen
+VVJesugbuE5z67kicvs4VwKFKKa0F0ujma3dYKOan7KznA+nNIwpUGM4OhmXbsMjqfoN8rOhy92u
+ZXDoeL//7vXT5/vmkUzgp+/eHzzb15BgT59/eHXwtm17o9/tyk4FLRbDFt6ox2yaJTZhvSURUR33
+2iyrRJnpLovajdZVS5+1SID9PMGI37Uj6LHcwobdWp26U3BVwmh2pHbmNat+67i1baS+dGXo/rTe
+cErtXcZJSvyHMu2GQsq4X3d8bWDZa/uzgAEuul9POl7fDHTEHbR7gzl8dCzOokxcOFF6MO7MGdQ6
+I7jsTNrSBI1YM/DZi45vbJkqxg0fl/tXdJt7tav8nUAF3kPEGf4QVFicaYwIVxlXL63pLrrSoeoI
+reqZ6p9pb1LYrM5U7I8SY3+wudHCEk5eKkxeXCwd5T/kRhiug9iFjoyPlrd2D+tYXt9ckGw3KvwY
+1VekN84odbAwiw9IkuiymIb9pyYZQxfBvv5rI4My6iHOn0v/BrOF8w1JjBbW/DhVWjWY8edylPwk
+ukEqCJvIacuY804601GLgc3WlhQnCa0SkKfFX3/qm90Jw03fzHPzbdwTYSAD9B1Y4gUjEvW2aSfS
+Y2Ax0adqklYzxOoIL4Up9lwonjmcijKZZfFYHXrDa/7aqcgbeL13YPbXftIdjcOWkF9oO/EuubuS
+8POnhn+jo3eV51QEHI+OGU7eAMoUMDnKj4cdXy/IUyOHPkb0raCLKZv8GyXrXSFku+RHCxkDVhm8
+kmRPCa+/4gJmSvHrOzRBTVL5b27i1+PBof3GkuqJ/C6tHoz4DvpoFyRz58lc6rOg0PO+3SP5sg8u
+EOy1paCwerKwpQ95NHELqgI0TpXfRaO8GI0Egd2Gv2Yd5gtc0XCW3NvbvFvv4Jx3VC0Y6G9SjKnO
+0qFsP0vwzvdqoCgxJHkBunjkSfn9hzevI6/HnCyGHaIT6EIewk7ooPU8kSctLWkzNuSu+GXYtPNT
+ODlwRJemAC4mXGXk5kfHvDtzNt3psGEZvUwn8693xhZBqDv9S+o1j86wp4mU3HnB0JUgdku5KfAu
+zuQFLwzpM5xFD/7xD//oH/9Y9f7jm//R+9YP/uea2PjHg3D4OHoy+s+jfxz/r5P/fXO7+Of6cfDg
+fOgqBLWeRG3W3j/+4QWP+yP0uZOE18+E983Aa0Loy671kyfRzs7m3i5sGI+jnYdb21uodqP03e29
+h5y+u9PvP8T03Z2drZ3ViH97CM6DV9tOCr/cpxcf4f1gIKP/0oPBNj3ZGuisg82HlDTY2cPAhJA0
+GAy2B7i10X8NlSaqe5/Q59TYUwPWfLSlxvTd4MmTAUVP39nd2uyvwVa9udUzWhA+jJC2/vlFXD6H
+0xWD9NzxXHrgnUUPev5RvP7lP47xb3997/hmILYwqNqD8xS4xwf/6z98fxR+hqdn8umjxe2RvgyC
+B2nLC9tEbC4s5r9GH79RHm7tEJUey6o8rYHV7vUuNuqkQn/jXm8MHLX3GcX/9dHg2MX9GOlIzbXk
+XjcDAXtZ2EoHzg5ZmtGMwBYaLuDtidZrzbMzNJ/AppxHD456j594xw/Epb48F9fRDbwVer14OgNa
+4T3G66zGyyd4eY6X33rfwuWf8wJurFPYqVWJ66Pk2FTvynpyzn1CSnhVq0txis3Beu3Tzq1e/GyT
+mLW1+olG8VvfHNWhBx0Un5fx7OIEiFNOyhUMHYS0AWYrXabnF3isSvMszROZY22g8vRNwrjIaySn
+ysfKKpwNI2TWTat8usEvjKima5tBWC/2N06zYvwJ+yeherXZNu+xyfLkH7m3EM5L9L3O+ALe4wfW
+m97aCRNbeJ3kD538APUDvTXyHs/K5MljzAsvw7DgI9nwYA0KpyePH2AuVXjofS0/Vf8sycdJB/SU
+VOCICs4k+LoYR55HUJNZnJ+/Q4PRz8jqZRvY39OKaEcV+XGkUqQA4sE/qrUH54GSQqx4gUj9YqOc
+Z0nFXz9hC3wRozWCYia7chxhjmOrjsNx9C17IUbet2szaOnUn/sYYG8NJvtC9b3uPG9tvAYLYg02
+e9SWZzzJrBsl3TMp4ihTPUddOI5rdPi4vb3y9YNg2RjoDpYNQLfxffIiASLYmGPObLmAXR/H7OJ1
+cplkWGf7xTvnWetVOU8vyiV2C9Dazxf1NDuY1zDRLsqVB0gy4OKJF1jtOAX+PalPMIrj0tUxz/Sq
+sHLfWV14xe4teiGtk+nSb2Qpd4bJ2SjfKhzyyvoU5QThqLqqb9sjQ8cpmJ/HRUYThd58Mhh9i6Lz
+so5IJE+Ja0RQoZO8zq/c2ezCbbZLEO+gB0y1PBygGfdDg9x1tmvVt4lqi66ua7q6Ku/UxNan6sa3
+H8xwcvjlSNEaT45e/qndAitsBBdTZwl0J/1id+KqNU947UKJaCtAJX4AjjOp8Q264BEwD2R2WdVv
+H8crF0CduGBeCXBLha7la4VaS1TV5TMn5lzpFJVM3Y2BCVGOrc/AHX1FFH+tnXrCpdNzD6rof7sS
+Z9RKzg03IyZs6hYxF3DefYvqyGLNWcArDzw5J6kBpAZeupboqZq8nHV5l7iZkRYtLxefulnvKNfJ
+fFpMrpeXi0/drHeU62QulxdaPlFZ7iisNM25J/2oL4h+xLCN5EQ9rnEa4DFvndJCnMZ0ZRES9Ym7
+uktmmty3HpO/XY87h23CmdDQsrWZmXz82Mm6vEw78x1bQDLVWZaXpTJNkmxpQfDMZFpelM6W5tXS
+suCZybS8LJ1tGpeflhaGD61sy4szGav56ZLNBp50c4H0gN+dLX13tuxduf/A0WlC3q/LzCId9uIU
+2QtYSSFeqiVVFWf1V4qgx9Xo7rJCT61RhHno3EQbLaEGQIHEZt+x7cr8Knsdn98vc3x6Wi7f0mGv
+gud3bhBPvtXF3LFZwWPJzRdFneNRBHfALsrwdj49RXd6/Eo6WRtYCkQU2nlnObzprRmIANrZ5qev
+Jmi+6OdrEfTymkkNxLc4RxQTrr6/jqU80Vvxf5zlQGTQhiGdRLQPQ8uOMMk7xr3Wmk66BTQk3cxE
+m2/9FvnWRh2q9SqZeTRFvg2XZ8DH0NOPK2k/1cyEGYAXbL+MTB693VHt5WNVIIsM7eWvqRWgX79j
+rmQp9R525V2jiL3cGg7kk+Vc0p+6D9usM8f5+KLojjiu5sxdlVoyncqO6bRiZo2cM83GIG4vza//
+5//6v3H2ULMmd1B5fSqZLD+6TGq5EyznaiZyx5vcuVfYH7sjW62z3bXJTpgxIhHvSbRPCK0tQqkX
+RB2x4CV4bIImeI1zkiNsIWaePmBF7pLetHT8jiqUg+9LV1b9dVMRO8CNcsM9oVdPTlDvK1PG8fgi
+gRTSbnZFaJHvIkedqHBZl1Euw0rNyOAZo0hQ4B8Ur5Uauqf4lMCzQj4rKh0fqKjexJ8jVa7WhTAw
+A5yNdUbpP+t5zv1rOw81QDcorV7lr+PTJNPesHiccF7AhOe8EeiCM3zlYz5FZUIyORzDRIPaNsLA
+yGXF0NDR+kCkUSLbIiq+RJGMqQMHYtG3UoCyPuD0rm+asJ1dT9fXxTpbW9DHonoNI8tYTe4jOMqQ
+Hj5OhyT42UNtlw8HH+ppS5xKueC8Uq6tESbhCmbeQkAOFuKtr5cYJG61LxE0Ejm6G9WndPYBhxbF
+w0ptM/Jj2QPJks5E/9/OJ+U6tgLbUzmtyUTciINjUOMpbhBKwMQcO3N7kxHobGGx09t7g2aGgZth
+nQHataD3OPTcDNC+5z6HyDGL4+g4EFDQ4/7t7c6j5hfiNfsbOGqwFtR8SaN4bXOYPq56vQGagRTN
+4UmDYbq2pskzzispyZYYKpE/12lQlkiBqpcpKr6VanN9EPo5cSjJZcqeCejh46aQd7NxRnMfHuEe
+kB0TWFrXg2gewHcbsWJYOzEle7/y+qaOGPX+4/tX6KhlYlyoqZ5bT5thYewFV1vrDUdkl40qGv0G
+NJb0rTVM61otggH1V9mZ25gxYZmbaK2jJZ3RH3i2p5dURw8o/orAaGRyPSjj1tdAV/yCIjdYq9Om
+NgXaCA73NtkiCJ4/Tkf1WrQZQmUXqhpk0JFHfax9r7eFM3tJzVFrVD7e2ry9HWw+pHoPA5zrdvkB
+ls+Yo7hOpDHS2lr+ZMCRg2j5DDh5fT1/3JfJpkorsURvLKJGZ2BXfK0n3PbLnmGsz86wPAjthHIa
+tVDirqZjpVH/FsXQQ3v8u923gFOlURo0QlCbYwzqEG0PYEo+LnhOLOnUyEJfXTaObjfkPCegTc7Q
+Fl1Dq1r8wVEv8brVyhuWywsUyMMe+nE2U2ot8/67peQQA0iJs26a1/930bz+v0rz/C3sKT/ronsB
+zGccs0xSQDSx+n+5+7Itx41ksV+pwm1XA81kFclaugskipY0LY2uZyRZLY18L8luoUiQhWkS4ABg
+LSrynHnwH9gP9jn2ix/s4xef41+4n6IvcUTkDgKsRbqzWEsRQO6ZEZGRkbF86aZAaOyax+g6Sq8K
+WwUxb4vFGGXsye1gnVRoxSNsfEVt9t1lqR3RiudDCsYpAyLIG6O2qgi5B/Ur/wK7SD6R7GnwnSsJ
+exuIvUWegbuOMry3QH+/RJetL8E9HVD9JUNG3R9viD6bcZgydDeQfUlC5q3jm1LwCTSjScioXNu1
+YLyO002bTRxVkAyiQcg5Vrz9CTF8FePMoNq64g3b7sAzmiaGC9puNELEYUtQLvrQhy7x4sYoXUoe
+X8XzCXym1vyKrld23PLXjiG+JsAqVzsBszls2e0Mut0taLamrp1l0Ghko0M0ajG0aZULwGa73Dr6
+LYjnkdmBksPugeOgsxDdiW2VDHJiFSUoMJ6gtlw4L6pzFYYqZK+Ffg6EfqXwzxwZZwf02lWtZmLk
+Ql+vwKXv7h6KEHT3XLoCxC7KvhREd+xaRQz7w6mySbZnLix2RpIQq4raV2mQYRBkRRuTLY045xva
+6fZwBGQTR2ZN/h6clGXcT7W8yQi6JM9C1me8RMAgiMV6XXMQswZwGcFEbd8E2zHhjWGEqMxkDCP9
+JcOQSrApa7F7BFa/YGKB0KHvNPEzBiPxQzmejffwiChK+197QLBt/4pDQiCsgTMOVKVeENDy1qNS
+6wW1njyldV5BSfYQBbZ3AdQQ8QcRQJz04PZIQhGQ6z1A3cpsFvlTi0YO/i1jeOk3BJvcW4RJOIsy
+fy9OhD0prRxMRyUuAZarvrTEhDxiWiZxXpoX796tmxbvb2N47ccPbxYV1FyVnbRQ6rXrUOuu9hK3
+3AxMBAEd+1gC7m+ELKbs241LeQQ8k6o03WA5TAjbfVNyw0i8428JcySMa4HPdgeCrRguSxmNlL/p
+TspRmX2LvAoZkhY52e3RZLyLylcV2qGXFj0pVfteIPR2dRI13BLLTZ9geoOioq0vompr995WQ32r
+Mr/FRZDfBs7eENZkMHo5dA7Zfr9p+Kr/vXXaUMcMd9B0veFgOLrfNPqvDl8M36+Z/w+9/eEQlS6Z
+MxySBiVW/5vgaNhoroeHw8P1sM//Xe/DP2vG1s3mEfsdZHDd8bpYrLP10ht6qBj4aXA/9p1/+d8O
+y+Dn/zhsCT//y2HFwnd+/o//Q0hPPw+OBi9Rbe4n8TBj38NTc5jz3o2O9Ej+ZGnIIgau14YOGx6J
+vxfqcGRopwr+oXRvFQmLLLSN8BpZQ73D0Y0P+UUwGDh0Z+AwmypyxpPcc0RV4C8YU4UA/LCoT3eJ
+OtzRuU/gRIthqdwftFh7xCS76iP9hxqoI/yczQ+fGMqAC1/RXDS5ZrIr3mbEBg5Ka+x+G56w6VDN
+c9PpyhySR+fmoA2ca6gdxxS9jKwjUWF4W0UwNBVVDL481KqDVbp/odYb5KiV8BJi3rqJEpXvu3AM
+/dpNmOxpefR4HoVzFtqW8oNTKme3a1YZJExWitEjRbdReYbsa0R/xDv5mh04+nz12PmkHhnnsqD8
+AfkB9sRZf2hWq9fil8/6N3+dWZc4VDPjer7JezXXItaUOCFCbMwZzG2KJlBS0VQMSCBUIXu6hVSF
+OjdytFK3buiAegc8aGkMdG2JdxHTgDOwHBbUXSXJyMRFiSIm9b4bHPMamUOTVtTlR2CXPCGSaR8K
+iEnkjtFg/Kqy+uJJF4a+knh3NQp4QXd5cDBXm+j+Upz/rHGQ0p8QvcZBVSKrpJUVl8qOIIjjRgPZ
+ggAvNWJloi1Q4p7fguyqkdcVT4CfNiuMCX2oXN91c5gnzypfQilGgImR6cxKdhN8Rw1B03Gj2a3y
+ZSwtt9lsjtFknKqg9QSoAaaPVkl/HHlsG5SEXmyOh6hK/WuRcaBKKKdoHCL0HJN7NPKMmgYxR+9V
+Uly0+vrFR8/8rW7SS7sJLNLu9eEX12KF6I7ZT+S8bYCCIJqa5UPvARCS3hP1nG0eA3MVxdQW2nkU
+jqPElV1V7AHWnYnHQVZ+/zaavb1dAkGcBo77fj1wGt8ahuFoLvV7qZmNel8jz3UalleGihbqrawk
+e9NG5D3M0VVfta2b9tYvQ554pd6ssTue++IRXXZYebwkTOaP7pQ5M8fDqH/lXIxT9KsyRbc3wiug
+6Hwf47sn7Fb0SZFsYye9wAuVZpNqoOMJbgohbAqF2BQ4RZmTgDNU+8GYogh/idInSFnR5gxgwpBs
+dJfB+DC6jcYk4jVyXswx+k/9gSgWu+WcLblgq7FECxbBwgpAXG1RCaWxhPQBhbwVEEAkYTnojEay
+mgpyZfcGc+9us4QgmGseGONtLgfHsvtdI6TOvBerl0fNhzl2ayWDFC3dBC3ToTkK6C6Tr8i4e4pn
+I16bbOF2YjBHVbHnIxykRB+jjEBKs3UakkKEpFBAkiGClnQ0LIOkmbILMjVYZgZYcuegCh6TAHbX
+xNN3Ni4ZJMUwh/J88TtWjdifDgrbSApN8thv+Gkp8YRnFn1KaTTxmPIv/9cxL6YO7ztsg99//vP/
+NBPcQX9/5MFHTHvRPjzcTrw/4SVftPFfM53JSplVCmji++bIazabLj6sX8CZcoHlf/7zf37RKWUd
+5pDRHeZmrv+0lQvqGeZYpcuftnKjLxnFtXKWL1+EWUHWQfkTNgM2YQs2Y9e7AewaubRFBZQtECoW
+9VC2MKBsZn1W9xzXKpQT8lDAOM1MNzcWHcyCmQFw+59zmMhIWVPwdDllEdTvKrjWXb0iq8d99xpj
+FFJ6L8i97hV0vqv6cNVoi8sjE5wlGnQjH9vohnjj7f5k0NwQeI2fOJFNPH69PQ/2/wRnkpTjQLPt
+kY9gTkfbDJUYcUzpoDVi7ornJaugOd36An+7Al58f05TVzEQdxzgSJg1IIzbecVXY3yIkdTneGcz
+OTjQedA3mncvCk/67mww5quij0F/qPgIOwxXmpEgwiENaLIJin9wNQkQY90ucjxCP9y/sN3W09tt
+j/CKUq10FwvEySraizbL/rWk+9g68HnQqC9r4hPpTwTVB77Ynx4c4L3P7h4A8fmv6CeYtJYen30z
+GmlB0g9SfCk12PAOS1488uCqn3S13X6rG/VeKGiVQj7Kywf4YhCNEOTot21Knn67bbln0AquplBS
+sLPV6Eo6dob2na1gd4neZXOlCRfZr8U7DOqlXi/nHxHDksLQiovMZ1TQE6/iaN5WnYNiaBkeOBlw
+0kKDbjIR9TXbFYp8WZSjUzY4GKkDcR4AE0FMFWxpcgYURZj3Vt05nvMoQFdsXpPP6QyKCXThHgLW
+NRoK6jZ4/N3QBTucINAV0arZBi5bfcEraj1dfPWksD0yvs09c+L4t7HH+479hv6jqHCrrlgxcltV
+lpLMmmWAGDn1ZrWSQmmY+seSdlRXuTGIRCEg1o1ItIC0/YKzIpFMBIJ4UqdahEc34AdOTnlQ65Nj
+/F2v4VQn9JG2S5DmguHb5N/ZGj01vWJWl8ixZ6N9EaS2EkpdD3snb9br7OL0ta0W0uXqPUm5nv36
+mi6CkzcHBxl6AvCoMFeIgik6I7UqrhGl7uWV0uPDE/KDeYeRpeMor4xkzBJOB0jW4RoqEJm648Ej
+lVeWgiQwbXglz35rtBPnbxfL4q7qOsGAKqBTDQMK4fUiMAAWrc2tWlHrk+r9HUqpd+lACBAGilkg
+X7CzzZ7dpEe0Vamkb/fg3TIc725d6xPzDhCNUCmm/uuDjaEbhHz3DZDVWiYuorYawh48qrFPw/HH
+Ks+VUU8rJUYcyqOLjOuU7FcPr9lUXm33oka7pmWArvJ61uiVBRTUL7pQMeAdBz+sGuRNRwUiMAnX
+YDVqkEu8RZy45rqvRujABQ4yfXP5IXfbtz8wNTKpcRh7QqtMu80pmhEPoLPqFV047zIMouOKuz3V
+oneBTtjmMI9sjvoseNRX0T91f+eoz9Vo9zAqwYO9w+A8QVUXlStdKQzhN11foAx44MB5Ih6jD0En
+zOMJ/l6uiiJN8EG5IsCXdHIHP+MwuQ5zeiBOAJ/SOf87y9LVEh4nE/wTX+NfTJrAtuxEi8sIv0/j
+aD7Jo4IeZ7oWio2MDaFADENIwkO2gJ+rNv7p4J9j/HOCf07xzxn+oYgQ+CBbv8K3eIoOYOFhHsOf
+RYgJwlUMPGCn0lWxXOEbJi2pZYDEGfAGODruXRIfuAEOPqFBJPwW3OWiU4gZKXBUyisPPE6JD3GK
+K/yT0RN0En5X2O41THLqjKqv97/AG1kKyUFL9M/B0fse+Tr5pPnP6LykfbrxBsN8eHQxOmL/hKnD
+o4r0C/OW8t/bkbTMvU/xXbQr6r1Py+kQkrisDtCwmRmc5H8o7fq122pYVXV6gfEJ2p0z3PWT7S0r
+5bs+6XQm63WqNKYV2XVTPEdlUA9ubhndkkYRXpOSBwdWqf0CzAkdgVXvmsYk9E5sfd00SFDdtpv0
+OHFTmxme/hJhqCCCYssaE6tG2Mo9sV+ngRH0M+IMblItQub91/e1kixSFIgTa9kwlB9hKSrriBvb
+gusHR1KOFgmVAtQJ5tdGW/eHdVSWmMu6dV2W2aVx4/hiaSmwi/WtsPcY4+qeY2pqlQAunAGL7Y4D
+YyNyx3Ba8Zpzr3dsZaa7HU3s4ACjrQ6UTOpHBziqllUukW8tQb6L7r7baOQXQI/ponEczPWwc2PY
++cjrucbAcwyQYqT2jMXxBNBsjZxbtRhAk5eBBk4IlRPQC40UgQYwk70lMIorbRyjtj9zuQTM5Q13
+1W/7rbrrC4QNh3HPLH5cCYUNOC4BkCDsPQbkAOLUVU95UyHbyBECpfn5QcA05Vq18Dkrw+fiYlYy
+r6hilhdIecxsYjCo1yFlDYvw9qsohxPebBdcEbO3XT9ADLRBtlkakSPrDAwHZWNUuHbqJVhgQIxF
+sOjN+hYcLDx/AZA+A5ZhYEwDFlYvwaJpVEUYLIwlyuy9sRzIziAZTJGlBOQwZjw1ZjylY50x6fDB
+oxCCu6eaztSCSOKsTfj9dItdBUvJzU578D+vaTmYooI0xgVFs437iQZ7SJ2IiqSWle6oh3HT+Tfd
+YWa8BM328fFrLsbZvXJVlRsvj1ihB3pirFI6oklZ8ZUSog4WWXIPE3Uqkbrkr8oRaDsOAHFbJZyl
+GxoFFlRZ/FPEo5R5D1ZfupzRdMDqMeDvoD0KOM3AtYYFVnfqU7qqFcOfNgBo0ZRAzw99iuGT5lI0
+6swfT3CusgcIjUlYCFstYuLmjcASacwtUsDFGRUbX26IM2AXOD+1t0B+0dPu5r25MAyKa+rg5enA
+HxtN880l1HY+btgjd4IYT0vsAdyGqGIlcU4eIunkWv6Rc0xPTyPneE3B7tglu2Fv2S37wN6xTxDF
+cSqWAZfk0Ia+wK+aA4PEf9SJekIWqMP6JCo+2Z7wJQr2S9T9unxRwhZ9t55bulIuFUymGgbeHDfb
+Xg1mbXmjchh98q/EMl3WoTBq6VWhask5l/NQPUj72R2S5Lf1mwUtswc81L4LmwRw5XCo4VuBQf2W
+uDLG5gBMyKy59C5OyK83zO+015aPYbA0SWBjWj09thMwOZab2rHk1paLKE3CZLLk1Cu1RWv19jmz
+tk+T8IQs0kpkNnnmSFBNUmkTk0Vhd72DufuEYkLfCfLYLDD6mD56iK9tz2o+t7oZq87My4T3gXms
+pd8ABpJe3yDtTom90nORIu+MtjCynym9qT2txBiT5OodghXK9dFjgaD+t70P3Vu+078d3Fo7/Ttr
+p3+nDUYXnFBy6pCSGqAwEheG5II2GEmE51UsCWK6Z3HRVTO26DvbLtwcG71qp/KSz5+gxSn75OCg
+wl2GOFJz4O0IxcKi0aGTun1B21EaKerqNdP3gGmlYqaZU9zs60/K7SUHZiOn/Jg1go63ge5eP8Di
+j0w9xcccOevo57i8Aa8aJxdji3ALE9PSiq5sbv78pDKTthR9EtPPj48rWKC8N+7m4oqomn3MSx05
+rslkQV+OV0aNDrnpzfE6YqwMXUsl4fzqyX0eOlJWiaTd3/pCtvZl3cgsmlZkpc+Uf26duKFnLG92
+qquRVvnN9gOaciXlUUb6g/68cjMyaY59vo3ryba1D+VeM7cOVLlJSRvBSYl8a+A1qjTJGUcdmdIo
+H+tkO00zwXtgPzA6EJvda1L39MhCayTpoye6jjh53BbewmfOLXOXok9gmS3s3WKf84vAZpjRpXU1
+w4xiPju7YJJZWo0FXfLWDHQPmGhghXvBWddDW+C67IpRvjiDlrAMcdWpxKZ5ULoUcefsGADfY5w5
+LyedQpJ3IU2wt9qMm+R2ew5sO3uQITdcwDqMu2r1w4fFLjSKJ5iDcHQGClwyA5HI195u0rIJ2d17
+AW26+087UfCjRA0EKsEuQiDJKeRyxlycZ0pnY5sPKSdaUjjhg901aE5sAHVM4g5Dtg0fsIR7gsBX
+KdJGQD7jlrzkv8IU8KWASUy0Zsu4e6HMXY1aAoTiJ4EQ7waw4J2HYenJYIRkSF/tPxKgmu1fAFLm
+cLYEkMKBPVEx6cLv2Ud/4lDU5BMlawBbva/ZBGzDomyri2MgK41OmeKdtfYf5hKOj4M6OUKb4Il8
+JHEdA3U+3SgPK6+5lGB/24T0uLOOlJf7i+D89cFB0Qvanc4Ggc4SZbxWTey7YbBFMMh/kvtPhu8a
+xXjvyP/PJV83+zgbXwzCLe/5oxoR64YbdyDGx71I3vOja2J1Bik5u5CYUgNZGjYsGKqgfFU3MzFr
+ERl7DD8sbhCfIBchA18Aoax0WSI2Dg6Pc5u6bd2UCJCeG/RjPjIP5fNR6fbmpGZPjk0RFrGj9i1O
+GNClI4Ao2z96P2j6671R48UR1+QM7YV3UY9d6O2vHaB3cLKwdvolqonlQQtY7JXS6xac9r47DVaD
+fCSvfegj+iYBJjaHn5VSV/KkSlZXQSmWP3rv95sNvy87NzU6d/oGapia457q6vpLDj3bmVpe3xnD
+AkQZMMAZnpgcz6/MJqpw5tEU88hXh6KokW8BOZMV91prx55HnEalXfx+uF4P1y9QjdnxjNllUqS+
+r+dm1xVGJaZoX9FS5HK1Q5JcVYFyCm2IG9Hu7tEVZI8sXQE2NT0SFXJPx0sAqef1rLwvGsC5q8LH
+bH3FVS3bvmOtsl0y+dq1eWIZ5YxbrsgkwOAZ1SCBVKvTnfPbpDridXDQxNO8gP95NfxznUx+P/IY
+4H8Alp4PPZMK6NmqbcwplY69CfQVSRR+tOlC22+x6kR61zTIevWNN8V1PQpIx88AxnoY6T4VEHdJ
+2hzDe/vj4VF7qK8qc4UCuAn+mUvuefveiDbqSTR95hWGRUsByqVCcg/jBnHVGTSqQK0J5TiTMy1j
+Dz3ijUtbvcKT8Y5NPg2w5jGJOZ8hzFpt3WhUzq7woCtRfV5L+4E5Y2EwlvYUQup7jfLDGYp+q2u3
+LyYGMYufTXwNBg2qoVk2VGfaDx1NqOlH4MKkXtgr1H+ri9lbYB4MxtXzuFAXFdNAQxLd3uvhTPS5
+JByR+aclDNNXBboGswQQa+MtSPVFRziqv9pQ+FElzQq3bjdm6zUuP3CFM3m7EdbfbujeTFQPFnYH
+rqyRLZkxtporo8mOvQ2NR4KxvOYYX0hd671oFwYaWSwE5PcMAP/IzOnKMF8cjJl5StEpdZIKlQHN
+D4CttqrTVaGoACVdYSldTWVNpbzfoXF1soskS7/WVXM4pzmUZHXMrv+eLzdWXvW2YJztagYnNwZ+
+PIWTWo+0F8Uh1bNOqXPP49cItferuj1P8E1maeKCbC3EeVmUxUlvilSXG76pKPNhL+6GvALEdWS2
+5IVbal24pTZk4EoZx995HW1VG6z25FFNDus9HfwqAqrkV5FoPuQbwZJpWiZeRWQ6TK+26YqiXUZd
+USSsuvgDHCHu0TOb+N4hR1meDI8NXFURGar1ki7Xe7pkaZ2hB5k0Co4A9/Qc43PHCIdSoUSKwbVJ
+BlA1JkSwnsXnx5VXwdxPQwg500GCQBgjELY9ctwgaBhHz5xV7BbkgQL3GpJz4EcPu2fRWB53DtC7
+0aC+83t5ohZ6RymV4RRSDnKz4YrzWRQcDYbJsEDnUJBwNMzwddVqvTkdrQfDVeekcwJ/W5034iPk
+SzEfvIUtM4JoiFEt7/ObWLihHod5tNdu+fR73hG/Z/z3RL6fit8T8dsWv8f891j+vuG/Z6K+M1G+
+3TmWD6KmY9HC8WuR80RmkE2LEmeiqdM3vpITABMQrgAQlVzFhjyiafUWHUSNWtzxk2BFB6OuGwXw
+n/YOlkbko0rbT8MGjQEJzAPhsCBNX4RKo2QWGRbo+r5AygTb3G23ccTKPPQenzXQvysa9fsAnM4e
+/OMI3HKzZtiMvX9zghwuPEPOBP1Y4PUkYjWZWJILVBqoYRyJfFHKUs5fpGo30KGsYwodkCM1yKNe
+5/QMfpA2a99tCnLmkelQg9uMUfTY9RrDxp7S23mL3s5FbNdOR6vvr6Jqn+b7qH1KLnuU0+tVpdNr
+sWlftPrbqYCSPnon4LdQpdQQFaBXdCUnVwH4FfQiExNvCNtU2MTj0Ukf9in45Lvc73gSQIX9ivp8
+IgFkU4n3dbwaKpOJzxleitPnc7yeWeH9G8CGpxqFt0SQEXTcwAATE9qIUAcaHonc+zmbRPN4kfvp
+ZuMMh/tDM16wChesogU7hj+SSnOPGEi3ffYfBW1p/DGOeFjivcfGJcZCy+cUmgLYOeOUjGQmKRrM
+/DG8DpUJTBiG/C8axoSXKVnNhGPKLv7GaN4C+cnwJyxC+iH7n5gsbiZJTiY+ZDgzLbDMLErxL/+D
+PgjIwKdDJj4F5cAfLBaHt/iXNxUv6A9/TqZYPF7SSxbn4ufwMorkp8Pb5dh4VFnmNz+hquKEKlqE
+8bzAqhY0gkWeLcUP5l8Uf6LX1RLdycMTYDl+T6b0N6Y/KOdPEup3ugz/tIpQ5k9Yj4ZFqTAywgJZ
+kS/JsCi7jlHXHp7yXJgYiZHnMXAR9MvfqBv5gv4mC/oGqyWHqZ5zsk6akTnSnP9NyNCq4FOOlGgB
+jYUzsl9KjjuvW/hArRS4XGhJx5zraLHAUdEob8ge6nZMHcSf5gq6TvN0u5hny7HshvFGJRa0LD8d
+nx+etjL1RDA0iVeL2yIjsJou6S99p8UNOdAVBaAL+kzBlxu8RYlywBQyRYPtMo3JQi2dpwSFc75+
+46ssJbMv/tCMboH5y6WZ2qIJ05oUl1lcwMmzKNAtN2XmPCI+XedktJaETdg+7rhPAPMLZg2Bbmdk
+1obVTq4v0bpt0vmIoA1bThFTD6aRMHdLZtyaLc4RRma4PLO4oL8/LVLYKIVV2qwI51jH1Zhgl094
+vKS/GYfh8Rn/ISguCB7+GGLlf6Tnj9Ed7LaYFX1WTBcCwAmGwxkHhYV8zz7ydwUPC6pjkTevojkH
+/4TWY7FacIO3xTX2hQe7AignEFiG8wX9IGf8008INTBNhTDdg8HdooncMr/DXgFWZPgq8CDK01VG
+GJARuGX5XTImBOEgHsEUTObxlDCBQ3A+IzT5CIwFYcQlgT8Mkkz1lmkRT7GBnKY6L6IQe5FTv/El
+XwI/xxEhmWEfVhOsdZUAn4cIsyo6rdYJYUAC5xeCres4ummqnt5El2PKegNggD934/jmDtf0dhoT
+cN5m+P1ukc8yZ8SuItOEr9U8P9wnqvyqMTwK+ooSN/6tzjFy+75+a47uW+ysvTHSgUHp+8PDJ5Xw
+XnkXR7B7mb05HDbRZrBzugEmZ/C+dzG8bbWaw9tOa4S5Na+xiOzgdcgP0ISwIijWa0cfg/cylzxL
+6EA8KUYWoMzrdcpMzgwzovd7wwcXhbnl8cujYIFecvr+KqGb08maI+MH8TZJV5fqzTvyXEdmdNjR
+4L3zMuhd/KgH1MAcVg2Q7eXg/cvRq5eYZFUHSQ5UMXrlQJLHrlVfhnkDyEb2AV0n0+urAP6nT9fh
+fBXBPGNlKg92hc/1B3+k18M//NAcvVI5qajDZhE2dscb6w0gKxQYiV9cRWoovlwV0StodXjUv5BV
+0EcHOoo1XPIa3kMHkYH5ADvCmtgX/pQukKiuhf0/zMh6Eo3ngL24fusx7t40CFnWgS7Bq6oBxtQb
+HlV1DzpFPRJNYMb9JvxzscZf6E6zP3h/AWyHeISnV5CKRXRvsNSgPzp81Ye/lGZ0j6rEJkcNWAqo
+bCRa5DwHJA4Hn/3mk+8+GQ7QSvbd6FV/OBpSNZynukEEOPgHF3pwC+sxxX4DCrzZrAfqEdivo5i9
+pZyEKrRyIvkY2DNIpspuydqUew+rs8snJrqb9SQzDSfcMNo2CszQnVCmGeKMVEOgCIYdIH1M4Si6
+YelT8BhXGZkPYHAYEtyQpgsgVdllaVn6hh2jaERVaiglHRAaCO+Bum8iYZ04IuwXKEDK6PgltN5k
+dnuUPFd7Zy5UwVZTCEexZsfycyZ8XFSUDD10iCy9duss+sqtxTAmjI4hZup/yPikTlmWw9VZKisW
+rt+4wNqsTYUq3aqtK2t7XG6S2wFgpL2kTqEwBdhJTTUXBIVUWvtGOTDOdYAgoqSlViir8xonIhZE
+NBpJL60NXQSZ8fh6cIDABaecTGqW7FVB8yAZCQhuBB3GjX3oYC7kUwWaEjxurRjaZQtXKEHlKLpk
+um3NVYJztanuIBzzHNm5hpxTjCxZxOOP+QMCYI1lZOq8U9mLn6dzhs1ozOrmuO7nZ9W64l0Pcgtb
+dZMuZIxcrMLxuSuv0XmyYRWNEYy60kVuSFpUtS1JRSq8u2iGFFfI0h3Z21qhsg27qQMWGm7w4KCa
+jBolF+TCor1dIenF5YpxGapXK1VwpCSWYskm0fxRi4XUbG5Qx07lws1tZLDvYeeNk4sgrzCE367m
+mWYHpGI1rxK9zLnohcVVjXU8Bh0JrMBkvHOBbUQoTBhiEZMqtqE0CfDSJqHQUZ2zXVhGno/mjWMr
+hFkjSJpztmNXm7OEBzDTsSWhQSBSIphko93LRSS96uZ5MMna3olKPIrCVp2KBF04LxBh/hqdXt6v
+qaojJVBUIqRAX/yB+/nqp82mzy01xSf4TVEjvYe2isAuPzKwZdZ3JZCq+JZyesSEmvd00bxWtaXi
+wra8halY55V2Arxh2Ru6PhYdy5EJ8eXCoRRf+FDPn4OA5O/rF+NfTS1/cfQ7Od7CPvr0LOQ7OX4I
+92DQ2Oy/EvZVty+Rb0fqw7hH6/UU1KMCfzOYB7D+SzAPi/+KmIeynueg3ln710C9mlr+4qhHNgJh
+qWvPRb2z9l8X9arbl6i3I/Vh1KP1egrqUYG/GdRDYP8luEflf0XkixbLqzCP8wdVRbjdOUfCsRjo
+sspxEh0nUPd+CWQP53e5AyEzDDdKd31jzzvkt1dsf34or7dKAJrtAs8xE1G6xRHtCTayfFbGjQxd
+22QjERR8Ve0hibdCA6sEDOX1IVGDEx2TA+Tjo1XkJ5wwECEj0M9fF0MIi1jMcS/07mVIh2bsaX2X
+uBmEFNlaqsoobQCaqZCpOjfS5kBmBNBGV9EmeBP2a7ewegGUM/pEHV2Um7C0BvJxHnGdxA2n8Iha
+EikUWaqsvrYxob2jqPan8DgEeqCuWl2xR3T/0YiYaURcmYg4VoiYry4fkIbJAM+7j37hrv0vRDOv
+9LmW5LzLeBbn+JE+4pTzBDqKuoFtKVjsKxNFHUtcYhG30OIu6jH8mjuEf7xXw/zI68tO8lg8leBJ
+Ycm3FhSnv6RmLlWzlDhgHKH3e8fbbK1vW69vaq5vaKzv8mnrW+0J4C+/vOcn/z+s7vLh1V3+gtXF
+kNeP2UNh69xvc8dm0OOpXvgr8anCu8+VpywtJ+Ryf79Vla3RuAK6Q04mJs9kXTMKPQBr8xkM4GpL
+Tx8dyQKD1pti/Pga7xA8/gEwmTlmExHFVzVm88TFrjyyXBFW91MbJq+Ab/kSOpOT1pMVWF6eCjw/
+RFfpkPN5bWIZtDfLMYg5tuTJsFwVjT29CW5rGnAHwDjA9fqkvVPYKqS+SwAurEJZy/IOkUXxRcue
+pueP/LxdvZBQG8woQkNO0JCT46q+Lce9Qmmw5+cBWvuwFNXnZfD1lJSVEFxYWfZLhwjyWmAH+ht8
+56beqGoW4Eh1iCHagTuaH1I4n5J8le6WFI4mbNwvnVgXpEMAvfBDEQ8oprjBFS5ky1Jdv8TB4yJI
+a3UMG68rrOJH1JKRy5NK9l5laTZZRVO1PIYkU7ke+VT5UJU+NbZDA25RJk2EJJBLuyCyCo8RFqo3
+AQKTGgjiJ7n9B6gPxqMAMOugbwFOdshwnMyQxM3ic9zEoO5shZsY/Iwh7Lgr/a0kbVa0BVBRrccS
+CsmWVlwFmCsfVpguVcLCdqfQ4SIPCJJrn2kiKnfobWz4kSCRmFtWLJ0M2JBBfX8SWJA3geMLWyKx
+v7VC+1Xedh7hCSl/jCek/Bd4QkoQ0rpJLya17XpPSKWrxHbrEZnOa4SdJasE3GeEu6REoBb0hWW2
+GwK0NEGKyqkpTUON/6JsVI0nD2LAjhp7rb77EILUwCl3jZQxHt+wtdnVDDCqfrprZNwzd1VDPH4i
+25nK6e1upOVxFCscvsh71zIO8VCHqyJFnHvc5apY+bNWLQE1Ym2I9TfVxi8cQQ0pFhRnjieR5/X3
+3WmksiVbfilEqTj4IzBOyaA1EpW3Gflz3N9XFIhirodF9DsYE5loudtiq/KmF1fdfdbGrAvLxi1b
++bd3uvJqNPgg1K2p5/n7+wCnck6uIk+OV+jLAhBR0MLS0OvHHv/tjj0tjd1wGEM6T7WQaO/oJT8w
+wnGPVJKpcfkSAu8oTpYErcfHNaFwUHcFBa7HIhTOa/77NO8uZJDjwtKWTojyYHjJ13lb50JORdWl
+fsjChgE+XvXN/TaIiWmG6uLirm6WxRwbOyad19484vgOM9aLSWnotIo80IRySWLlXNzoqCUItlng
+3KLFEBIDHIhFD/oE8V8mBacVAiM81j7zfCuJtbmLNyliHaPJyRL++Ev37PT0+LgOI5VbnZrevoXe
+0qTlQUot0SkEH3BXrFHAyesaq1GHOShpwxgmbh8eYeJ2u9PE7VaauN0KEzevS1lMKhK8i7QVy7tI
+w3wk1EfsZVGBknjc7YVbmMTfdwD3KG3gXF8qiwfL/EFYLZCK4UgVLaRtB1SBceo2H6JSDB2S0FTH
+V6q1tEsDaSZpSqqIFozD8VX0RUQgDsdTwUNzWbgI19QteqmMp8iDMRGSiZUkZkfU8w7rERKhrlzO
+irTNhn14wIzQiHn0xKFRWCaShIVCESmDMWQ9Co5QADxmNAJAUbL/5eI4flCHQhdBaLp53dIpE6Ma
+bVQK2iIhmH3DX12vNLidlmoEzR9t065uybRLBNb5JArupVHcvdgO/Hukn6jTdIsPX6/IaJT6n5Om
+U5jMvoHNL76F/QqeV3CebpJrgI/fhRlMJIbxNgJ2Yhke8dB3fv7zf/v5z//95z//FwwtyK7i2dWc
+rFIpOrbm1f0O8IvjdLFME4xE69+P0yzy73Gpcl8EYCCjGn6gdbTggF7MGLZ2GFI7pwhUXQpOP9oI
+rS67PekynKuLqcgkhtNN7XmSB/Yx3HQZPgGFk2XL6xb3sgUN8xHpljV/aSrycQ0x475O7UpKhbLE
+4uotUYontdotZ1JGG0CgKSzD3wEcqAXSE4a9R2XuNMFb0a0xtIwxtP6OYJlD6BMh8plg+Hjw2wl4
+j4K0jd6KP0bSvltF8yx0wM7MDuWpeFYrjqeMzcm7/3uYDhV0k38KIvEgY3jCbJBLEviVcTYTdJKd
+kdEHfxRN6MXHbVu/6Z39M2Fz4nDNbWc/QBqdTvfQmwNs9oy7H0EiC5tLcXCAEAKZSFoTT+8ODoBP
+zdN5dHgTZonriM97fKh7cb6XRYv0Opoc7n0P24dMXs5XsxiSEzQemvjDZJjEAGVZsfdthEiANGVv
+mqWLvZeZ+vCyq3LJespZjkQCZuUGLzIFGJYVMCoi3UOjZmuWMfcHa47xSyG+INzThx9KU47f3m0F
+kWWVUWZhpnj0NfcT5LtE+znwAcD93W+8zWcWbxMV1vafu2alDANAWvlV7du8EDELwva7uMrSmz3s
+5dssA17A+QFvgPd+1HP44x5aUEYF2wMuZfxxDy1sjqTtHrIxogtw1qHOqw/IsWpicXDwNQWCO/wY
+3eWumVJlpZuhSYHOgo5CCJGhEfmcAVLRSlZm5F4hy5OyKnEa2rjK5QwGX4RSqW0ORcZ1I+5ElMXP
+XTPGJgKJDPgJA2JCKFquncNOdbfI0EtFJRZAJh5cHSaYylhAhhZdFYP4kgP3U4aSWaSoxZ4+tMpG
+nzZAXkX1MDfs/Ozk7MSPgot7BxZ4D0nXuHC6gjnGO6roMLpFQmHFuEyIq92mdAZOoHMKgRdQAUBv
+NNkL90QJYo25Y2EVlhO+dKwvjajLGVl5AnuVdEXEzmi9VvdKhYfElfdVHDczUUBffcNaiCudFku5
+jUo3vciUh5fkot312gcJ3j4AP+6x5OIiaLMIntVqBjzJrAhm8OT1SfvN9gxWThs/DMDC2Ucxtu9G
+QSMyBAHCv0bB/Wtw83LyY4EWzsJvxd4bks10VY5cZdF5TrgeMc+EFqkyodPW34U1u0x6bdRLR0nt
+KEPkoAww9k7r9PWxLzbu8gxkhxO3YPfftH0X0tAyiPxYBM5XX3/34fOvv//qN45Y32qygktrMAih
+KWLBywgZHTOQ0Ff04fQS/WkFR+/i7jMkuH6xYXkQH1of2TxQsJP3Uz9nK8gCnNo7OBKxsU7EqHMr
+toREHn77rVXLtCpAsO23Aw+1sMfjQNZr8ZSt14X2aJpteTRV3m0CJVvH2KxpL5GRyfaB0gzSEcso
+XpkqqLwMbzD09lWA2DXuwwF1yu6Rg63obXhwkLkh7iqwB/bDQzLr9JMNW67M/DjtYXAP2fyI8TwF
+LD5U+hZtjoFFVHl11f1BOEK3RGw8j8LMzBGKGd5gvPoKajowBDSp3HhRqjuNE/KVdmtvdpIcQh0Z
+jgX3LqQT6UWzLSLGBzhXOpBni2LeixuzlLWRCK+SHL39oKyCiZlQVzTigaYxtSenYKF3nwKiIuWi
+amVFNF+FmK9wg20IogT8YMb18LwHZjGrmD6cIOCc3TGbGs5VJq5kUK5QiOHCqWVFR18uhCC6ymlP
+dBgul/M7gkyms7GlnH+qQPTJRSkIn3nbD4jo4BI4CBoh7iYYPI9Y3ZR/8zZXhzBZui+YSa6WfJgc
+0gg/QzlOsD0DVzwZJR8TzWrHmh+jGMaHcc5jGUco2OrjX582lP3iMLqOsruqzjvyk6YgEd6hi2ko
+gCQsH1uurz7uOQ2YE2T01mtnleDDxPEajus5vtGKiGzM9hyUyZT4yDEcTIvoXTQH8pZme3z7zPfC
++RzYfJjRZi6S8r0i3buMFNnJ2d7lqoBzwjiK4aAAvBSkpfN5egOb7h42n/t7A6eRNZyRY6yF6nxu
+RwRXKydJUWbGjb5o94smBeZMAow5W9AlajZImu2RLjpIRmUyv7OJxGzCE8SvIOKX4I2irjjlFYfo
+9RaI+v0CjkdAwr8WZ35BZYC6JxzbECC2Ng1yrBOsdC6P6QVWjM3Ke/QiAYGhOM17eueaFlFGa0Gr
+V1okWhDZDK0L96UTzGHrGR/ag4JdR21Py37mL4HQ2xgw9fpTH8MPToIYNXQXCuF5QTbYRjIUpa6q
+ycIG706TcYg6Z+QE08AIvYzkBKoIJhpMUCpaCCeIJCif4PGisgnFLofBwswREUURaeIAFOZ5PEvc
+GbvnW/Ln0BfYo8UkTb7VHxdsEqEUNUrGSFsnDB2M8PSq7WrDYD3gKLQqQg49FbOEeeCU9u2DGQPc
+29hso3YcchAxD3JSzzhrnZ2/NpgmPPUXsN9n7puzzskpUVz3uHXaOkGL2Mw9Pzk/75DnLvdN56T1
+2jN4S2Q7pSBbYAHdfaWH33799XcsL0JggnAXXgE4AUgd0hdGDuqnQQGlk8OI4DlizlcpeRlBmpLB
+3IXFHvw3TuerBVE19JEF3b8CRAEW77uUhwGJODON+450Og0L7wkGFnaTAYq0BR87HDp+dcol8K4c
+UOLDmzSbfJqukkkIhNsTCu6cHf3UyJakyQ/1OW9UzpAqzEvpPxjpSVr8UJFlYmSJAVJLyb+xa/hy
+O0du9uEqLqIcY6SUMr0rdaQinxTNHw0nwul/5vXH5l0nrPfbz99++/arz94KjkNd4GV4ewesVin/
+Z7/95FuRNbMdem28jdG998aMX0Yz2LTs7r8w0gHdSqkDx0fwnHShHiBZsOSjPsbLbXE1QYycS9Ru
+AUAlbyU+A0zN3Sul+QCEoJs3ggVeRJYG8e4twDgwZQs8RMHk+ZON1fyhMbdhcocQW+qgyzs4U3jz
+xbdff/+NQhzAeKAtlyjxbW26Tt/hUd2veBhtAcTQL3ID4ASYnPVnh3zPjSaf3sFQfWdffocufm4n
+QSLdiGs0/PHLhC4P92ZZulqi5CjMwjHuIC9f3Gebl2I7edl/aaDnjw03JyWO2aHsMTmJE6OfYRgF
+elp5QApmQApmnBSYcwEcCu0r+lCsacP3Cd3YAk/h2VSBNztGHdOl2EClhKG/kk8D9aR8cYz81XYP
+1o6vcq7XrnoOBiLzCN3ZRUW0J975XnmNZwbdBA30Gjt1bVZ+z5eaIrUGR+/d4aThuYx++l5/uDkC
+qhqNDcDzulxCG9z0yVpmrCZmimB5Fygcu5GX5JfBzaAz6t8Mjkd9I/WYUv32Ucu/Q1i5MS6ut/D4
+m7ffffndl19/xRZxAtnhYOpfCkwdC/Mdbxc6tzvHNhb0+bqWur+73Ra12y63a1bbeEa1bV7tUWtX
+xa+e39/aiiUJfQoVNIQdNTgBiAiNhyjaImx1PDYnDTe+PZMr5zxIN4w27so9n2/03cLa9QKUmIhO
+JofffP2OD5R3FPZK4C6K8v63u8ynvAxR8N053/OcQMt353sB+TaMmJMdAwNmBmsZyFq+/eSrL94y
+vGvwT96wIvVPX29Qrc/KZKzK+emGVZQ9f41lUTuoKvXsFFPPW5p5TZGvj+tb0dWYiNSq/tyu/typ
+/nxc+fm4JvdZdZunr80EY6hv2ucdHOybTqu6xjed49qE47qE85qEN69rht5586Yy5ey08/p8MxLg
+ndsQJXfv0PVo895vC7jm3NgDmVuUGfmy6ozpVq1fPpyXV6p5ters8XaHH1uCNyB4keq8gyeA4vEz
+1nykerJhhKQkvNZnins8OgBBJU4IKLZEfL/DoIv+MSPw80+YJsH+KVP8p3/GsEX/9QbIHh5odlMH
+db4Jg/sWtFr45wz2CaDjgGj/j71r7W7bRtN/RfGZesmKtgGCV8m0Js1l17ONm63T6XYVOSNLlMWU
+lrQUZddrqb99nxfgBZTkTJrpzIc503MaiyAAAu/1eXG1JpAtK6N+QoTqqGPf0Kt+UN+J8b7//v31
++4G5Nt6/h3N/T8fyGf3nR6/lqWjOxlz/Uj/aeDRYr8+OfPUwMvp/fH70P6jj/eCqR7X0WT67m2QD
+0zy50Y5nLlbcWHO1m0sOsWX1lAbhjVWU94LOTFtsJxfYzeuEuUxI6oTECszOsnfwx+ffvHj56vW/
+/8f5n/7z2zcX3739r+8v3/3w5x//+ydq2uCq1TuoVnfRpqd+SrcOX8oplmMyDy8KjwbYV8SiJ/3+
++8Hj5uoPx+ve123DHBQBBd3hYozk2VHtEZytnP/Lm5g8ohFWYmN10plc1NynCwdPJImNe5BqjP+X
++P9H/P8S/1+a+Ide9jrghXFM1Lx6P6CNfOaRSuzJlAFllDyrEuWBvwqF0SkKCqHFdAwULZWk5XYF
+3JvXQV45CTQEEqtflwFc/VbohRtRV53H0fLoUWKdw23mON/5jLdVxRNf8qtse6w83geD9XrYDwdm
+E6aQ7Qcd2GAbvVRbzp/RYVl9bldzBX3abU6jIXIsfdDd+qxmLpIdSJQX6CfbQj91kDIiSTmQwiOz
+RnJoH0JTD6BdPszy4S/FMFoZ7WTxzSodynG0TJ0u3GmdHLTj9sFJB3GGnKz0GHPVdJO5b8KyWN+8
+d5hvEWd0wutwNooPD/eM4GrvwaV7NQicRFoyDNAK1kte1IQce8aqaUjiHphTbc9Ty1pfgjxQj6V6
+9dfrKDIepRvao38AaBdPklk8rlt6D42f36/Xe0Yp36iTcaH1s1mcqj6sIjmYNlJ/Fvoni+kpuljP
+zLOHRzWS12wi2rwy6NoRihsp92Y0LE7+VwyFx3qX3MbzVW4sLFomvNl0s4Z9Lr/R07JmFlXZoY2J
+1lYNEJ3Z1ozgKNLyyKkGqzGaLKcIyvcjKXtVH5bT+Sod/5TE6XiX3HDgdIh8nRvMHsWvs+Ft/D0Y
+pxfQuDqJFA+O61ZZ0zJNb0u3ycKKT8U6H8WgcVWSpCx9Pktu5ciibEV3D5eL7Fn8vytY7mb+eg2R
+0tODd9Nk2boGo5bQzfE8Xs7+LW8tVwu59GdvFcetN8OfoVirLG7lU8T6D/NVK50PafZ+MU8fJomc
+hmjN0zGqLKpeHrfkDHTn5ATh1ij/uDyeZzdyCZFKOCqLwjbsG2Af/7aW76PV37/hGzVU9YxbN0qd
+7uiq+IfIRdzPup8rdK1tDTuLrkkMdWGzPkMmIT/sLF6vue2exr0t6jULtXJQZinpsEzy5I5mIvLW
+dZzfxzEC3NZwNqarNawWFaMh4AmVbGUoumzRkkY5fzGcUabWZLGkxWjwZSU7aIar8xAxNOPNMJ8e
+T9I52sBjcQIdd9US43s5rdO0UNar6P6YKrC76i8/ns+K4733G6ob8ykrdR3F7YcumbEbZa96r2g5
+dV58UVYAg6Nxz9w2ZXsKkDmTPprKbaymYbuB8bpdr6lOZu0pvG3J6CaPaNKYQImN7Y7QWQPZtoGT
+w1kkbJt6qu4Xfc683qOgnHluanfYqeUE2RE/OzuTV9DTlJxEBtVuwvnhITt9DiSam2Z1Pxjli3KL
+zkKN5jT1p339g4a8qxkpusZETsBS9zv6pgdtzwMy0MerT2t9qGbpMpVOeWnDQKdeFMGsejkQnZra
+LWf47a8N2lqujtOiK+DUuQ8pHpbNDybo69lzgOzMNKvEVCWmVkJHSlDHU4tKRrRBYEk7viktsahq
+mTbUEdZuNdk2HfXqNpudaXDpWit6PW/ydgnVkGDtKK9/d7XhqayXdeLjZIz3yVhaqZ8Jlr+gf76L
+uPVRmavzSFgXpADv6J+3NPheffL75szvB+NFNfyZdxtLWY7p7gJaB2xeUqaaChShDTPpEk+juLyL
+iDJZWrtRATBeoo7HprzWL8bP5NPVRzUZeyMvHJkY1FCL2mc9e2fWtuCD8bNpvpPXnyHO6Fbueavx
+h4cz442lte0o1j/yUimmoghiIPkxOVx0QeeYKOE6l4aFqPM9Ldn7KL9dfuGj3AL3catXZ5m5XsMf
+P9vvFgx5lK0CmR8rikrAsAtOE/OxzlOy8uPxIkvmWZI/yJ3eXYU3k52GnEYZgr8dg2nt+c6yp31m
+2fkYKSIfHl7iX4u6rowhPXYVETY1Pz6aapYTVKt4sWrwYqV4sdJ4QYcrRPXCMugAIoo0fXisRHYo
+RVadsf9tNNTd7Pk4jd8WRIAT1t/c3sbjBO6res3119/O76sXjv7igsB+Wr0T+ru32Rw4gI7Tli3T
+3vwAIPENLXROaBtBUdbWcyi88qKkre5F4iZnG66/PH3rFcLeFRXQncK79foC3qfSgCbuvYnzF6uM
+bgl7q4vJHjhyvl3udZIt87KtF7R2dbeQ5L1ebhb/srXIWs3znpdXhKlruIoLvUSn2PW5NTyfR+cb
+ZfXOu+cwPKR21TJjsxKO8yjbNL6+GCIY3EulRrYC8L4dAv5E3zberGY/Jvm04l4zANm+7myrL+rC
+seKesU6zR3EktB7Feo/yT/VoSZNtqzTeIzO0+rHcNrmDg4qm7gTASbnfIEp6SXQwW9EcYfXaSKKE
+jqIbPpiEBJLesp10lp2EThKpOq3U+4hrczWgQRrZLtOTXCRx5gtaF2sL/Y1Db2Jni+dp5Maiur4z
+ekzGne/abavUis7Qahi6TmxV5qMDh9wwdqgsaadW5Ww6gExWcrakY6JqD5TA5bygkLZwacrIxfLX
+CxoIe9uDB+i8Jc0ig5UcLeHOO406Uum2UMeOGhJo1Dl5nw0XezVfqcB5d3vtaOn4d1WgWB4jF5pr
+a3QaQkTjJCJw2FPLcuuB3syQAyrQY9/nT+Yv0KPhBL7nmMffrCaTOOvKYZ7WvCWvKxov1aIoNb6b
+rWhBlDQBq0WcGfPjD4ssphsLL+m5hMgACFaxqkcFyLCveJ0X3TtQt5E8FrNNF/OZ/ITczC03ZxBt
+aRfXPSSj+A3oLDPBoi5AsPiSpojfZUMa83nihfrY3NwsaT3PqPVEUx81Lvzp8ruLY7WOPZk80IFm
+Mo4oX6vhV0rebNR49zDqPy6KznWKfsVQwLjoA80n1e+r642eziKnvj+VQW0F09/TGUBJdPlwez1P
+jYPjfE69aJGOUfAGsGw8ypFGGo6JZ53cyucfOpklw8hXdT1zmmZ9GS/yaSelBVb4u9rUI/sjeW3d
+1pJMs9cfdB43aiFqcTGoaa3OorQcKB89gXviop1AUXLFeT8pL2Uw5JQFngltqG/L7dQye7WFulUs
+FqCMVr4xy8VKskA/s4YDfKSQwbhY8Aqx3NOSGU38Jksl/MbQ7I0oKDroKyvbUumDg86ekQ06Zxb/
+7xjkYY9mr0bpakxfpfsmykpfJNmIxkJRn7EiS0jJy5JBeCh4VN6c+plcMjuyoqFGg1pkcl1asg21
+zyy3d9T86OeDai/SltKOYDwKZaVsDeF7Nl+vs08rbsmx0UYzUI90bdswTf5PbSSRFooOdyrl7bHq
+ZhZdSIdWzFL/+dWH84vX5xfn737awIQW63a3ORBXLjHuLZvyT8vjtoj6jNVUzQqqouGdvcuB/9J/
+XaR2Wn94rNYDD2fz2cPtfLU82Az+ggAZ1fxOXaQ95nIPIAFNNEHW1thb86nuQ8G2ldZ8LNWqWvNa
+8miXVmQs8r3kseoIF9XMlVm0bECDUHM4lY9hIvQqH6PvAiAUJncvfSh2FB7DdM1pLkhtavogfSDt
+4Ci3jaqMMqXYefghjWcR28z1TW3ygsQttLcr93JVO90btsonAQwrPk8UkNnNbn13kvbh8nLiuhny
+EIH6UuKyRbRus1sfKKBAVvLV3FpFcjDtNpkZwyP4qKOUljTB3J2uuiO50DptjwY0voE/tEJuZRkJ
+/jW/mkd0q5H6guohhW76/jr6MJ0IrHbs6RQZJzdAyE9sfJTlvtrqV1d7huZH3A50DhzT4KnBrJwO
+/svPoi12EWn1hmolzT3VFDeNZlHwddUgOWJ0Gjl26ISeb4euqZcjMxP/cD7Lhf3NKyPbFo4jRxs6
+mEVGXcthZp6dndGAk5EdzcyT6o3Xfbr++U79QbMbzeyzPc3ZPEmPYjRCJU2HcKSV3Yx7Q3i/Cnt0
+hk2uftgWdPNxZ0F7kad1u1rS2HAruV2k8viEeNy6fmgtV9fV3F5tomltU8h8e0eZ9+1/Q7ArnbR+
+2IkSrlk/VkOS+c5C+7h9sDPc3DLu49ZwNIKVaS1gw8qZjKV5UFFE7jnedGfHyymtovYdxwEr5CPH
+Mw+E8Ipn23YkwHWEXaa4HsFj3wnDIgWQGiku94KylMttWpLtB9wjCE317zFpwvW5rxY6SKun1nQ3
+DB2sQZ+7PHAZs53Qwo/Q910RCusIBRzGfDcIrKMghPz5LndobLPeIxE07lguz7NJZgnCy0K67hHG
+zeUwg4K7nmO5aHN9s7K+jOL0VLB1DMnXrl4e1UeLlKOOdDhRLz/M1r/mh7OOXT3iaZ0hJb/Krmab
+mZHSnYmpJorUsn2TIrKlw4j7wnYDh6P3hehHDrOFLYTD/SJphJDTs0UgOLOLpHFkg85CgB1FCgJv
+O3AFKGmzws6ln9KI5rEwhZLdW/AY66JttBel+H1N14AXv0dkwYvfYyutfsfWAtZ6ccq9bru9gLle
+DOQSleG41H7n60WxFXVxGrA6F/45EoMr+hOoP2C6+usNKnczQe0TVW6ijPQ0+vVXY3JiM9MaoxkG
+HUljnp6665zY6ZvtkTGVZ9EtzXbazvqTQTvpTwfdlC7xkufDr4y5PPcG3R5vSpbM2sWvNat4Mi/S
+rqu0UTQs0kZV2jhaFmnjKi2O0iItXrMtnpBNi7bi4DgaKl//w2w5nMQGelffhSataUnOmlHyuKq9
+764t58l3Iyt48t3Y4vaTL2NawENRf2300o0lLcw/h0VwlUHwGxbh0yZj8S+T8SUmQym1NBkTkzR0
+x2RMCpNRqb7MBVXHX2U1JspqTAqrMSGrATPApRkQ9RbjKb41VbVMy7UIMCBTaUBuYQtmZnthjHWL
+MSWLMR6smWYzRrXNuP2XzfjbbIbEIJ+2GQUoGdbmI9kxH7o18JzPsgbDXWvw2zVRkIb5nAm7YnrI
+He4yzxMVywNu+8wOw7BiuMMdJ+S2F/oVvwG2GfQwcMukCdQc9TDBbLdIuom4B2Poe4Eo65qiCQxJ
+rlMEIZ8lLElTWIKnhOVpUXlaUJ4Wk08ISUNE9r2aWPZTjbhBdL0rWFJq/gZnxLknXNthAZwRvI4D
+uMstwZwQLHd8/Axtzljo+sIKPR4GPvJbYHwQ+iyEYbYdV6AG3wksO/DBM2HbKOXZjgg4C5gFw+yG
+gcO45SGcsF0bVpk7thcEVJvFQ9tlfiDg9mzu2fhpM8+yPe4EyMGEJWwnsFGOqgoEfCWjuuAH8J/j
++w7yOmgpCuIDToAWQEJ8CKKLz6Kp8LNoLrdttNpFuyFa+BmGHvOcMMCnXMiUzYLARgds+HbUFloI
+EyHJXgC6CBBAcOERMeD4QdcgxE84Jhc95tziHETyiBjwOZLYruVBMdBd5qIpgqri+CjiTB+E9PET
+bODoFipCewAIhM8YWhWgHOjFQQvqAgjLiMLIAgXxLdsHZ4AZQGG0lVTGwffhJEO8CFADPKPreyAL
+NZCji24ATyk8xtCIgDmgW4gcPGToIikTCiARFdloJ6wDg+IFHvcszw2hlK7rWQG0MPQD37dC1CYg
+Cmg0uExc4eClS20H00Br30E+uFkgmxDE9ohHEGi0Es3mrmXLzzAQzrIFCADBs8FA6LQj0GqH2uSh
+HRyCIfVdcAeVCWFDHEHFYPAlFvCv4CF1OFl5MMNVfGgAr5g7MVKNig5zoBojXudmAxWVGQwJmNYS
+PJlX8okLeuRh8WjLt7R7syo/2SrvUQ7bK8tz+cjL8i49+lrx6VZxX+Z3y+KB/J5jXtGT+CIk5odM
+gNE1EgNrHC4cMLRyAJxxUk/wU0NiYKdUysoDcAHFDAXnYeUBbAgoaQOEofQApFvCgQJUDoC7cCeQ
+/cJN/OMh3Lj6PQGKKn/fWDfV76l1B+h1p2DeHQDc3S7Muytg3t2p59S5KKiTUO/uyB7Q8BT31zl4
+RiwkdMdD+SjMK/nEzDZl9QftqUE/uDtQKXoc+YC2PKivPCiPeB3dtCdGarZXkICxdWsC9D0MUPAB
+yM+6jxaEDEeGXG1tAgveRLfo6Rg9T2mtc5sAnsKG8wIYXrfvAdz+AeCwkpVxkTap0tDKIu2mSpui
+p8WvLwKWwv5nwAr7Xk3hOnZhhBz/+jSMUANin49P4X5+M0ClIWYOWvx2EwWAiEYxWCivNBnXsBke
+fBxhgUr2phEhE/gSGLNS9lAWIABRpFeJ3pSCT+4w2JyyugmKipA+Ygteih6KeoCvDDVWlgplyTcD
+h/DSXA7TPRA63cXQ6S6ITveg6HQPjE734Oh0D5BOfwck7QQaZ3OjGA7YFjdrtiOCyNd26hmLUpmm
+FZlIrcrk62lFKdKcMnk0ragldaNMH08rkknpL9PjaUU2i8aBy/TJtCIdYNGuSkh5/12QNeQq9AHU
+3QbI9pgNIOmDWw247TkUK4L/GvK2aSQEiAoguwbhDmFZICeCkzUeF8yFnAFfuw1oDnEHgHUBphoo
+3XPQNGBLHbDb5LEDAVHXsDs6gziDtEmH8YJQHGpzdEQP986oRyivgXuHeQEtGwqEjvOBiwGpAaB1
+xO8BRgNsEHDVsD+AINB+4AV6GGB7BJgFUVaLCIjCnFC5FhuAvFAhgiNamGAzvAKNhdAjBid0QwBk
+6rUWPAQu6uI2AIkWR6CRLkAwCNEIKUCHEFkIsdfRBXgaAnkLalYdaPie5xOFPT3ksF0P+NsJCPtq
+0QesCjAY+KUHInjtANIjWNBjEtvxCMnLTtThCQyGQFAR6JEKDD/kORSeFrNwDk74aIyjhy8wkYDu
+FCbokYyLSABmMnD1oIboHtA3hB7fgAeozAfv9FAHqNEhtpHo1VEPTCgMKwykrQdA6CrMHtioh0Jo
+DQs8gEXWiIo4UQPWXzQCJBcS7UI7fD1W4jDWHC0MtqImgqUUIGkBFHeEDZ1FI/RYijsePA8Hz7Sw
+KnChNSEIqgVY0CggXTDC1mItsJcGOjnFjVXYJciaBxBJoUVgwg/QARgOpgVjUJUAjENX9bhMkCcB
+xZnQQzRIvIDAhMT4OlpD/0A3D7ZJD9xgLkgRIP56DAf3jEYBqweNcA4mCSopKHavIzuHwgMITSPG
+Q4+8UJJVj/Z89ILLQXEt8CM/CI6QCaxjQOoZGsCov1AmDuGA7SBzCUMD2SImQyo9H21CsAc9Z6QR
+sGcMXwJTIUUuzFHogh+QFIgOo6wOA41ISW1p7XhAjeXkooEXXIfoRREKxd4+GUZIMglcSMaK+AUK
+orUM9UMSBRrryb7A1kEwLLDSkaPSlhv4DtQcCgcqQb5DKYCB56MDSh0IxMiOevguSQwRHSLIyBDa
+pGPkTjxCS2QoYeDQJRailZxcDAwWSTnMPgTRcRmpGtQQVMA30BTpP3zHI2+EjhKcobocj+xByAdf
+hOLm+1Dc3yPMrk8SVbFwQIGRU4RJddida1FwI8zeKs8dGVgFdaicq1BZ1hDKGkQz0t6ugUqIMjaX
+5e0i1NbmVcafW66I3/Otlt/ulq9CQtV12VahPuzVBW8+u2Ax8JDLgYe6grvm6a20dOKUyrEe77Av
+Aeq7gwnTPaMJ0z3DCdM94wnTPQMK0z0jCtPdIYWdMQUJ1IGrQrh532Y1UCdLh8JwHTVSR2M53D9s
+bA3VYZj+n7vr327bRtavUmtTL1lBsiQ7SSMH1bqp0u22Tdo42bYrqz60RFtqZFIlqdiOpftm99xH
+uq9w5xv8JEW7abf7zz0nsUBgMPgNDAbAfDRp0oz5xInqtB7SPPewu++J6rSC0OLQtVL+BdjRNE0D
+8OCxL6lTJdEc8uT3ajysviNz+o6Zp/w4m3naj8nMU3+QFO30HyQ6OwUIycs3Tusxw9NIo/aYiSuX
+Dl7/2XQW4tqlsxCnLp2FOHbpLMSRS2ch3rp0FuKZS2chXsrO4cun+73Dl03ZC4vRy20Fy0ualkYv
+m92akOaBVr68fErzmuLBcv0vkqK09jtj8ZV2wfjXCzkLfhFfheK1nAZfiV9C8Z28DBTtgSE9IMpQ
+vJIXiuJb9sTJ7RfaBU7fKK69sfhcu+D7XL5ufrHuiPfyRfPb5rvguXgNrct7GbyX75vfwUc+b74i
+ilfkHza/0T6fk8/nIXQdKP97U9znG9P+b6iW3nAR33AR31Oib5Ae/RAl3835FWhPeFgfin+S80oM
+xXUoHsgl+V6F4gdyUPcJxd/leZCL41D8gxzHIg/F13IObl/iB8X4l1wFQDy+CcVP5DwWR+JtKL6X
+z5r/oDz+KM+af6eMfy+eoXA/yoD//dj8Fzzl982fiOgnLt/X2udL8vmSfd5rn+fk8xzReTcayx+a
+/0TxY/mg+SuRxLH4AaFn8oa6y1vqp+hDR9BZUcc65ieDPxLdsTxtfk8RT1F5UGKdymtWZF3LIY2O
+DO9Wacj82Cxior6ihGOonr4PnY5rIY0Dmi83QxhHc+j0XMZ3smheO02X8Z0umqdO12V840Xz2Gm7
+jO/5onnk9F3G92LRfOs0XsZ3tmg+s7403RpHM6My2TF6FbrcG5qzWTOxNDR0h45mYmgms2ZqaWhE
+XzuaqaGZzpqRpaGBfupoYkMTz5q5paHxf+xozg3N+ay5sDQ0LRw5mgtDczFr3lgami3eOpqZoZnN
+mmeWhiYRdMQ/oBMsHTf8f9F6GP+Lme1d4sBLdzaz/QsnJ1takn3sIn7ziUmnByN6rDLpPulp+6eP
+aUPShRYxCRofW1SBj/nVArx+iKO330bLjxvAraCxDD/3vZJp0NAkXktexIUKn9SH5yZ8WR9OHUGF
+nyP8Dt6z7TDLd7odZnleVi4k2wvGNPnE5jFlkMmkjVd34SHVXcioB2/jGwZEMFcgOVxm/CPUD612
+/BXrMJFt6rAKdNdWV3jkbZTncVa2IY/bmgnyjMvi7tLmPGgcz6cxLMLgiT9bbuDLm3jLGM1hMzJC
+DLZ87hgq6+T5LiMwbF1VT3xzJ85XISHGprgrBqawmIPKGpOtjHMeczbUXo6vM0GvpGgL/AB77coi
+PGOVbwSV+s/N+vLerE8/KOsG0FNlXmX10Nk5yUvVTev57e/N8oB2WetAgffAICPyTHzC/mLAGKnq
+qQDAB5BfDgkyBqFkW/i3G4Eux8BYm1CUbWgZKwW65pNBompc4mE791RtT59Z6D6sDTduNlpCCTfW
+5HICZA7a6fvP2rxby3bK6T6mzX7YHr6Lk2J4OS+K8ruD8DZxG1aamrVWN0gxfaftVyQ34nEIZina
+SAPZvv2DftaCqzoHtLWA3xer5SK+xv3hR48f7sPndRYlOUwL8dXkRx2YfW5/R8PsNY2kFS1bMPZM
+4j+8z2mnlM/iKVuI5gmyvZwvY0aGIZZPnhw8gt9xQWLsJY7cfOgaIqzFrGEcI6+khVmikPfd3R0F
+4c7rE4UxYgHe2FpH4G39IuzeWIhWkTMGzLj0XESdtSkDCpMSwmSsPjOaEhoiwvfpPD8upvN0vS50
++myFEh2IacndgFypvgw6rn4QMC8ZM8hhNIQizvEoM9Z2iTcl9YQNrn13No1pbUpvgL+onSUGKz0B
+ToJQ4FpjwkDTcRJnzwA0rV8wsimWhpkcY1+HoeoLaF7f6IilqtkKstW0Hc1VSzXEVlFdLPWYclWX
+mgu7I7XJPalN6jjasI1F4+EW9TJR/vZafFJp8Ymh9r/jS6DpoLM3sBkBNs0+7Z33f1vi8I9jAPg5
+z4fJJAU43npd85ydpr9GoxnjuXAFXFsj9cTXGoWHnwpZZ8u4o3wyn2v32TyJshvzQX8eHZgYk7zn
+nK2eY9R9BEAey9b7zKIri9TTsa+r7QJQhtLRr3os0JAC2lMFr3n8U134zcKlSmmvEhSH1vK7fjZe
+Uw2lmH+ktI4Bfysei6iYJ91KxWpKHXa4Xc9+a3DT2Rdzpv68dfgwlgE3frnlRSEZdSdWABNVTCya
+zAK/W9F0QVPcDp7ChduoEm+St0l6RRnQ1LB9FztstPU63tg3b4bG1bSqHwVYiDVzYQinckXZPPCt
+LKuG4XA8rfomygvaBleITGU5lhPHcim2DTj4SjxePOS5gHWcwMaa6QdOsED4IqZVTe/D8P06LWjn
+7HmwldSktL3y9bzz0i102e09HnT68WefPZTy0aAH14GU3YPBPpz7Uu53BgdwPpKyN2h1+61eBfrE
+04rZ/LRK2RWZrEougDLrfbojA9qz7BYwgGQfXvqlbPzv//x3gx9qWt/PukD+07BA3S1O3VpO3VpO
+PY8TuX02vVo2PcWGOq7CrbOdzBozglWhUtmf2geMA0YOXKq3+ralqDq8tkOMsNyU7olaqQOLahdQ
+xhbuSCC2kO2uY5n0WjaH5fXeQK8FJrhVhB/3pOw4I0s2a3YgQRBlcduc6fvmMDNryV5hln8mHz7s
+PXlEIvVT+ZA2rE9Cfyh4tV7p7AflGoLFq3hkc9kbV4K7peDumNZG9Qq8Iygndnnd1CberSbeuzdx
+4l5fLcKRVIUibeQLy6PuKW4qwLvEBvfbch/xJNK7Rp2dAJt39CeXu47I6pCNJr7wW+oG+4feA5Vs
+4BdZT38UsV/Os9xvZdXK3BddZnB/lfaDf6u9Q1GbP9ciWVi9bvoBTVLXWwZ317VJtiP2y80U9ova
+u6ofxXeM/Pq7qR/dl9lN0ea7hd4mRy80ZRmFnxuV4fUaFtiyphNaE3EwZKfXALMw8lpteXhdlVeE
+ynKm9ukMgaRH41NXGFOrWEvZ8li/+kmb78amVDysmtsi2R9pUJ7xAYrosy/KlpvcKKnfoRe2M/K8
+99RqIzoa5mseFKNkrCH4aIYtwe55C1Dawn4ZZK0WsVmvW3gSljp2mK8tu/B+Vr1/ixVTD1JJwoPP
+FDt1OzlsPHxXfqlc6j7b/VzbCijCw8o8kR2aF9K6IoOsMorcldKaJbCjNMdb6bBiym9XK9VVBsYd
+S3p4X6L3SkR/0pp/+EdSdsLAHXIA9EDY5NVZm2CLRrzrq1qciCqKV9iNjUPgJ0sYYClJoKXrCKn+
+3qRsIWJ3N1XCq3UoKbbyebxIrwZOPZX0gwimAWA3UeVMzkMxd20rtQ0WhUEXeAqfUERBKpga6dfJ
+qlXbX3eh9h5pA1PKIABUuGfxR9FHOrbtpLbEQl/+vC/Nnd+VZjk91WzYN21JqsVgy2ZHNkiUyQhk
+oW/c1gXQQptj1QjVcfJn5NnVUVhJDU3+H0wxaSMBba8IRldED/fOfgOr9/0jxuq9FBc/sONCJBN2
+3IgHGTvOxNcKz/fK4vlmwT5uISqkUKjD1d3vzsHjA777TX4RzmrI73HnYB9HNPDLcTZDcZ90ej0c
+w8BvhfOWLHj4abf7KY5W4LfEOUoN3oOGOfDWMbcpVzpb1Pm0FirCAUWMfj65Gu9dzEXjtBF6KpJL
+b6Ftp8s4iZZzo0zfoS39IgipN2ndsGjsN8KtqzRqEa3iW2JzZvutw7LsjQf+B63hsDFbjbtfG3ff
+j7sPW1s8p73rvVzGyjTeV9Nn6eWSnGdzABgDj1xh2JOwobX/MAwYwEJW6Fmk1QZHGJfesoKAEroa
+PMn3LkTD9bzICCXTcrSw/47PQm/vz1c/3WxfKjKHX3+wMj+4QlJtTZhxLSZUHZFsNAyGUFFR+6DH
+6B4Q0fbZ61Mn+c7f/vLg4593PwnC06Yc3Z6MN4f9p5+t23sDcXLy10ajNUalndpaC3KZr9dIce6l
+GGuocSye3VKCcxge8uBOgtPwtic2oebqQn6mkE9UA3nko9Nx+MkD5W32Skg88xKHEWmTXCamfGZn
+2+XGa5fDexiIRqvhmMQeh7PydTEIs9Qes3xQCwZes6TUdt71ekfz2Q5U/tv9G5IYB1k1ZorlPgkt
+zAl/Am0bc/R3R6+Ovh2+Hr46BmdY3XmzXDotsDLLCepRxCZtct/2G+ck12QLeZsv40k/Fkj+RXTJ
+ltviYpZO+xW+wg6kfg4wzyJYKN3E7u4qtLZzdVNuuJZ2OqECHcdnUN0/ZLrQSBWnym2VLlvXtmnx
+HJFu1WTqoY6rCSL1B7ruD6MLEkRwBilu+ChSRON2nl7GtTjSsTK/WQB2Jgz7ZWvYV/5cjBrDQz3V
+ZDib3nA2GVf9wYOEDfgCC9YZPyu1o97H5FiFdAupA/UFcOaoceTIFNc7GhrrPpwLVMDCoESv5ILy
+AVv5RZzlPrqGqWaaRxbatpEyNkodc+ecR8p96WRCdxSz6zSWgNUOdikvKCuYdcB4Gd4mo+V4gD/K
+vmJOVQhDMjB9riw94BO0M6dtnLWpYoYRDjVqdn8kjZ+eptn8Aoa6vHlT3uG/Xpdme1H6ktW5Yekm
+BdgF23hQOHY+z0urh7ZPMYP5+OkdWZvelbUSKzEtZW2pkFZcM6Kb6+a7hAH1d+hi9rEk+lARNkjs
+mK4mMRPf0AiHsTxacOx3Hk9WMFnL3+t18G50M5YF/RGXBp+S2w7w0++8PiRXHgE59e5GPcsUVyT+
+hsFlaM2RX7XzIDzcCc7kVRvIrO1pmsTasPhQnqkzdJv5a2R+iFksH12PlcjplZsG4LWKeiqOOaUh
+yA4NNszR9s2nU50C+FVHd+EBXiSMTaJ+WS+jDCLSiM3icwTglwPgUEPZhLggHYYP6jHUrEhVGxRV
+TXSs6+NUHvv1cRR4YA/HbWhJrNHc4/Y5MHuU1gYcJUq9cfRXZforTe/6xAzVugiXwSx0hv1LcxEf
+Q5MM3sXjgArc2ladTtMJiy64R6TgxTlUCWztLEouYj5lDrftBrs8FY5NNAHix1DZcqOpk7p0IjuH
+yVOfmYJu15WJlF8h6Kggcd7alCYBKLrAglFd8/io6KsX3715rY/SXg9/fH30anjU6Bfts8UqC8It
+O988xXvyOA6MjxYLTjYPvOsh4W3jWUSEDe4DkPQhK2xH8EuzXmc1kxtfcYimU46CcyQaYQW62DlV
+Vc6Nijc2T1QLmQWnzoydZ2IuU33PrFWZghOZ5/r6infqX9GjjWCQdwTAtp1O1QT0RzEmdWXkKxuk
+9MFI77fYz8AOqbxNICqfC/ycUhgWw/7Iwl3SagsjxknRn4/mnjpfeeb9ucBlmkQfHbS6IfCT5/mr
+NC36+j6DslO5YIvb9nOiLdPyAi3UzfJ+efG4bCs+wEVpq/TayOXoErfTxjSz0qwGD1jlpMkPENDY
+p7Gl3tIFM228t46LiF1M1RNKMfNy0uGg9EkiBBebWYlu2P/AhOg7VwU/i6lzlZOctZUnNbhggOZK
+KPshcLkVcaliAT6mGkJeCMqLdOnjzE8YjIZtQvreU8ZJ4HliYiaHy0NvW6pm/Op1L9Uc1his+kRD
+osC7u+r7VJoQblgEySjQtGjSef5NHJ3LDsgQajBazIQU05QTP51bnBqabigzc5KNLP8lEjVdTCJI
+zRoGgsekgRGgcsAyPDJRqABDwl2sQDdUfnBtLgKDFq0gTIJL3RO39UnvUGzVvZ0LGDymmXd3javC
+SkwHgROXSzWspHGvHl1hcRdJzb2XoaB8ioWuY09wL3BlLzHwPoL7DbKxrOYB1pcLYys0DqwnFZN2
+C5qWPQUudjkCGekyRKhGaI7TSmu2uhzGABFo6lSoTsr5oF+TkQgmMg1UNRSXjAsu9DAANTuqdXcZ
+9i9xvYE/N7753/qe61kwNvdZINvwhVRAghuR0trpBhrfWG3DmAYTOPzUkgcAgYF1BWE/dlLp9uxv
+mb6KL4bXS8t241irkODD2LBur8rlVluD72ODxq7Nh/D6PE0XcZTU5UkHfWCmlMnpOj4q5APZKF1d
+HRtrsdayKam2rbVxapTvjKbv5TlFLmvAa6kYKNSwxeGCBSegfKgYqOKNs/+LXbFHBkHD+3S6Rmx2
+Tk/58/R0vb4tAX1BDEs8XT1tcbioiQW5CXAByxvaJBO4o4XQs5kdb7LyvfL7nnGxwCH43NEeOB5m
+NM8ai7zEXZ1c0RZ0ZVSXuIlcSDXz6emW+I2SsTtDF1n5kvp/JBNWo1BKfadTTj2Pi7vuxvvpG/nW
+nlQmJgca/Wxldp9piPulo3QMo+uUbzjtLYoRaAGBVqmDy2hZKw4GXhZiKGDK0bREWhfVkxirXLr6
+aEtdQC8xzGLsQWvPbnEVoaotxdOlgePVL0rHxLXysnrEy+vn7i6WKaiK3AtenFHxFJ+UM8a6me2d
+DU3Jv5miAbADAdgw+0q5sTz8Cex50alhP1nQprGOvSi8NDyRP3HdcE49b+56/lyJOYxUQWK1vRNE
+37U2+RO7oiVGlZgGiXcgzLlPcDCoFT0Cs0kSljV8OclOkvJFP6qAagVGLP7NrVH+jdfbwo0WGvRs
+ioW/fAlWDfSRsx1lDkZVbjJ3UdHbEvmX9DRn/9BGn+OYExtV6BIaQTkPlaWFKXhl+W3eYlEZK7wu
+6H7izr9Nxyl80/5bE1rBoB2CtdqHaITMP4UtNUeB5vB7odkBqg2YPkMwo1V0+Uya95buEUoKSVnB
+/+DxwWajL76vTLlpYn55lRg8Dr/OPD16we0FZPaDh48OfvN2NCCc1Hush4+7PTZhs/fz6OS602md
+XPc6J6tOJ6K/3Uef0t9eB974ifC39yn/fcJ/z/H3If3dJ6KT1Xl8fj5u7tEGeG90kpxkJwXOQ3Iw
+P2r9K2q9H+vfTutJs9Uef9I/2TvZ2wPOeP9k2nywJ1ZEG4yYUpG1my0iCwcBKIEdfnKyN26y4/gk
+H38S7s1pq00JEDnxHvf3Duut8qnjLXtpwR2bRHxaojblo1HjLw3RwHPAxliMGgP6+HUVZzf0VVvx
+l4HumZN0EQ68A0jK5oVo7DVCwIGMyCEamPYYJQic/0Ye0aqYNQTt30cvoheUKknaDaGWbNFl/71+
+cDL9JKSKaUBb4UL9OAp5yIs3hk4BZeh3Bee+3/XG7dSOeFo4anGMFRLxQP303b3R9sWA/vfrouTx
+4nyAP0AEatMOltWwLEKl0G9EBpCM9sS0YVJXqmmne9ZX+h9bh2qEvAtWSZxPomWsz3dQxlDcbpzg
+524GyIiPdHgMmOgxaMUs1DqAlLZBLqY7tonUDF/o6Q4/M1oNQS7V3sreHUtpUFOVxrR1Dqxb4ooX
+7pG1Z1l8HtpDt3RTPnHWk9v5fBGrAuMpVrG0bmAVlz5y+3XlO7V760w6BkYrurvr1nN0a8HwJrcb
+g6MuV+34OmZ4FaqpUReHC91x+QAUZ9SR3NlJRj3YAoFjfwxDAPYkeJAP8EqRwpsIoz8HIICHnknZ
+3yi5+464Shf2NSufixcTQeTrKi4dLD6TPWoCEum0mqsX0vYySMOBIu6ng2grXEUqDVamQYSQEbbY
+s58K3bD9iPbxMICtv1n92F+IjFobL9PKJ9n66PLOVtjh1djH8Xnnzit1fwUTtU4LvKZfiZm4EWfy
+3KJBXdmXB2LIQjFsK/BK5p3jXeGMwrxUwBcVshBKLUtyMIVuI1llTJVCTZZDSyB3glxeqBmTok7V
+gbGpo93dndx0fzH0BoJ2rdcRdK+OwkQkEuPEQYBzN4C8k+NFWSEC19S5l2TQ44MjvzHW64keezgy
+cHkiYj+hcqSnPSK9DFymqGQU4Yz6nBztBW1aTvx5Ojy8fnpmhINrEg62ay9YyTOcpgyChVxB3XpD
+PzQ94+RxMMTpUNzfusS0GPxXMJO0BlAxFwN1N5HhB1/ijSggf+0Hsrd1s2uFqxCB4m7vic9IHrSf
+s+aK3wf0y1QlGkQBxUwuzKyA1DjCDGUokao8kdDJ4fjDLb2iqqP25k9qyBUNKMMDfyqPqahsEs95
+h6y0onbg5YnkEO0i9n7voe66h348tGsAX9U/KoIO10s5DBnApTG3YHAS5mtbRmzwZGqFd08qxLtX
+LN6sUy4C5XT38b2LHDY8xDbM7MhItBol0MxHgB3G5iFptQ7DRhspUtCYFlKtrk6gqm60XUhQChLY
+avTnVBJW4AN0TuIteYWo1XIXdfA8c5Xks/k55YxmH0pVQYEjlR1+pqRPhBCatX9J5wkXYePVlvCq
+MRT3NUNpOJVrnKI1h976nSCQ5BhvVsA+fdiGGCPVDyeuyCTyN2yTCJsxO7DK86s0m1IIBUB+ogQx
+ltSHHTeN/+PtSZvbNpL9KyKSyIAxFA+ZlgVoiFUUudZV2ShlydnaR9N8IAlStCGSAUEdK3J/++vu
+uUk4Tt6HdZVFYGYwZ09P3xPBgsAOsb+lAtZmsTLJeDX78P4dWg8Bkzgv/XG2l2Q+wF1g+uJUfYvX
+YVmZf7Zq9QHukf9Hz7AH1Ct8sCYqMXWFMCmhybFawe+Efp1L9CsWW6PfnSUWC5WYtNBr0EpjcuTh
+WYPLgyQRVGNI7u29JT57dhzXLRWauAgr3vE3FFT4PiotjX8U3eaGnunqKMODi6XIpJW28xtR0pFI
+ZyiATy0SHnVXqYDHVMMjTR06i+zliOrRTM5qQBPlqpGUwBn7F3KsSryjQJ6qK3c/1h/m4ogrAZah
+mMI2ANyySq44f9MjSBIbGreA6rHIUB/tdFcNHlo1h/WuVVyqT/pa4XysDkvpbImck3CulLegmxJ0
+MQyghAh4rFgM0OARcYFxWEal0ArtL5uCVtmSgmM5VxUbpXRGScxWpG5XthFF/B8gQlOz50qNJVY4
+7r+4F1MbS6QGEahaV4gg0r+KIFIHQfzlTqE4T51uaziN1mcTRdaslQh1xCe9dT8eiRM87Y2ABOhz
++btzjm+1USjhG9O7JHXxjcmxOg3D30M3qYNuUhvdCBBO0h10kzroJhXoxrotD2Z5y9Sbo4HNbDLY
+aJ0oCMbCXAEcxHb4eDresUU0g9ZdifEKWDkACcupcaLDeaQdr4IZpKFfWAMtDNF8Z2UECQ4wsoxv
+Cz13Cd4FaV5RwCknGBqbCfRi0pB8QDoTOCmzRPvFdCmzHoWzHoW9HrW5ossKfbbLxqEOhnsKSZXe
+3FgobDYSkxUaHWFFhcCD6Ektpgk7Mg9NtQzQ3p4wtRC0YpL58glHJx5kNxJBB2rkkkAKohakdhBD
+iWLiGdrcbtk9UMBlkY6EngkR4BTSlByDj+EFwOLu52xS8iW8/L7iCysS0v2WddqdVqvyQEMH8+Jo
+foeCFyNFWgTPdHahtBp9ABjZv6g3KbGtGPsUwWYap0fTfDFMUaSc4hYRwhr1hpIYAXLAwbdbr05e
+vcHoq8BeH78mGdsj0KMNFJz1PqHEr/7x8eSy32BLFNw9ti9JoNf+uH77tnmJf1+3UJA34c+L+6yY
+5IuHyLtST8DWLtcl8LHZeHXwMBtnKDwus2lWrA7KxQEAzihbrTzmzRdlfZiuZiNY6Xd5nk3TXH7b
+5QfNxzfNAx+9UdIDKnSASOxgCUdZGcDHs/l9ms/GdfoAKxDvogJvy27FrarQo0XBxlxKiNFX5kI6
+9+44AQgPDLIZEu4XE5Kq70pWDE+QGXULaguQnp+jdk8IjI2HRrWVO9e8Au4RwHye2dvKdBP3AUfX
+0RDFgwA06CnO5uEUw1MY2eISKfpA8yZHKOYJ5KF/5LnW3M41GNRzjPxoXVkbL/CKWh8toiwv6AUc
+CoF2fS6l6/PhIRRO4LENvIj/+lWn1UbJwf6XQSINGny/1WwfH5YB3XcSirciCF93OsevgToxdg8L
+4GAi/VoxmUNL3TCtMn0urTnNuthCR1BcY5i+OhdN0u0Zh9iNDQ0O+WEa0Ia6hiEY8APSH8kptWf0
+wbVuD9vt8KTz0s8wZGrd95s1jkO1A7deutZhQmADS5sAads4aTYDjFvQYlnIMQFGnnVfdTox4MM8
+yCjtuKOn4xbw5PHrl1nDz8LjN7bh/qMR6lLMJIa+Dms2Yks2YWM2FQavGoSfON73gYF6H/lJm/pE
+C2kLIsjE/6xJwiF0qyLdaxGH4TxwVnwOgAI14TFhbfGATZXVoVNW6F6AP+42kyJsRU0AwHt5R3LK
+n4BXbgGCyuNFl6x1/J2ND8eLP+L+uALo6q/eAJAl43q7HY3rrzuwJgn+wsvpiXg5PYly6G2+2Yy6
+AJyr+lPQmAXEw3oKs0ETTyEfvZyxmj8685d8fcYfk1a07vLHEGppv47W9UcYxxqXaAb1rBr+hOf1
+5X5Fs5d8Ej/yS/+pnrKST5V0tIXRhVIMqPUES95d1R92v3xAeMBM9vQDEO5Txe0/hSGDpdME2NC3
+fa0HfwQFcKCxIXtkaASsVH0osKRbwRVglAQUBaGIkzYTas97WPRZ4E856juDM1rsa7G6Y2hfLOmc
+L/i1ln/oAghFADdqidd8VVlpl8NhOD1Ds+k1n4oa1/VSrFIRNPwh0AWt/RkuQo7lgpdD6PraqRp9
+g1WXofIwLLqr3c+nGFWPOjbiBZx+eUyLPuHLM57Coi+7QLKJRV/WUxjpEhd9wEf1CeycHP7qeXjw
+J+Hgh0fWBPQHB+utP2g8BrGVPRJZKceIkEPYT3wR0EzDjtpC7+BXG4hcG9wz48/Q3xW6iHito+Oj
+tscwrk70LMj86IkJFiAaorUlPT2qpAHQv+fXF+/eRRW2Cfe7SFT5+yixauIhleCFCFURWmYjOf1h
+PqOq/0KFa13ho68Eiq92gu3IBrbM+OrPK4JIz7bKtARjhpDk17f8a4OtD5180znpfEsFStSavHix
+3Wm2g9iJeibMFBQpSMahlKR4YJNC/I95JX7evBK77eYS12YlYeRRq4EsLUZ2ghCQWjUq2ZqbZNeI
+fBCZYZdCDsJhb6B0fZHf7wpBNRZBgxS0bFHl0IzafCVU0dXfZsn+16I8uuWWWAvGw0tdG6fFEUaF
+kzZi6PTPh2TWpSq1dSVpkskq/CBKXcMbSDRmAOXRhyLnqeS1pCJZ6pDDCDXFK96IepDSf/k9aZ0/
+kVo58ZPax0YApHBCKmX/YwLP+JSQSrrnPQMFuoX/G/j/8SP8+QT//9fThtk97wwSuh574b3ADOYd
+YMkC/8zxT+n1ESv0vBfmI/KK7Xk/eIzUw6hrjuH/d6bECJ1lezoXcoDObXzqhWJc5/X/GdT7z032
++ngLHR3TkCvyAlRsQIE7/vw5vU9Xo2K2LCOMkWe9ehEaZU2/XeSeP5O6EjKFphIeJuJ9uljeZgUl
+IB+JnwvNpn5cyeeJTpXfyGRiP6mZJ4ri+Prk2NqaQ1vZhr72s5UENYrc5gCN8RMTex71bKlxo6ad
+Iatj823qWDnhpqlyTXSgttqH+1fld3PwYl3kLyxXbiFXYORxD9yo8gRXBr1GJJVQCNx6SzJzZ1bW
+dx7xsihBA7hULMUKiBEMOIN/KiwQYnH3W8bX4lRZkfZ9yIfE0voBqxWHhyIupGQpoBnHQ+lBaYiG
+9OmDEwOJ0M/Qwj3DHUz1gLqkB1JZ2TgOU2wcVyZPclWsUtJT1oejP/paMlL5Vr2otDS1ohkARc8X
+rlMzexyXYnSP3L/klxjfyz2TYvcUeGQ4ZbLhSzU/6GRWbDaXm83w6I5cixqfEKf0Pv0NDVX+Jn8b
+0rNwwFG0A3Ota2oyOHxqA6gCCKDeZR9ONKudduAePGh8iJCIJWHc4rPaPbwFhtWjEOwAQBg7P74w
+or4LFPURXPlX0ISCqknvok+avzoCwZfN5ursC75+4VfBVhQ/5yIvGbosAsqM9pLYF6rtGkchBann
+gZg8ej0Ple0jHZ4V4sproJNwAEQGikEsnUHgKK/i/aEs/3gomEZvQze8CJ3aprdf7N5+CRQ4A2j+
+HQr6wc5h7ryhEjIWdwp4PVxoJxeA7PDQ6++nO29GbkYmtZ+1x/I79yu5XRsfjxoB+4XjTLF3amQw
+Z7+Yybrh72BqsLobgJcbCaq3FtD8ihvnPdTwD36j5vr92T/i91DFjc1svQ+6GJDv15B7j14EPze9
+96Kjv1rVYpU/8Xd6Ti8C9rN+vUAI+JGrboyD+EdYlp8ErfwjST1+1irMH0mRDQlayzMkxeLPRuQR
+DnfX5CeTqZxNtlVz3G13Oon7qSfDIlav745a5DPGR3ZJuyNJc7vp0qzvLdd0YUJKJfWGVjf/rgAl
+A5//Dt8aJBuaouyzQoBf6bHClawSyNpKuzsk4JSTOwwEnrlD2wa5CwHERnoX2sD1gY8kcNGONNvx
+gwSE36vUFB+C+HfYBx+gTcgXxl7wgdh6BNgfpAjmd2AMsJrfrKrxjBII4Dc9fCSlNeb8zd7ETfab
+XIDv7TrgpNW9/t49nXQ93wf2cWKSCYmppkWmc34pewa7E9//qeNqqEroExTA+x4W4vDQWUHYyE6x
+vc/w8HfP4s3Gajp4VtBoGVDQJP2TW8UMGNJJ/zb8pxOFkI58ZZhNNLq8rWafUt+3vdanADWSESPg
+Vyq1bKOqxg/H50DMk8IgE1qH2PJVcCyLiv0hAuut4YXepZGGvdsSoMhC/RbtzLuPueKMcfeUrVZ0
+0UoEp0HopIRwDgSGTdS6IoMTAhv0HHLXgjDtSoNm3XaGwZczgEyt1XKgM5b6UHu1Z3BCJV44oyVR
+K620XKWCf6HdKqXaxqZRACOiF8g9mk9iwG8kYxOMuIJKOz8lO1yyRJMqJNuipyDkU6CKE6pJyQAE
+tU3fYdG5U3TOITWcA6lM/SX7MDt/hWqgcIUCXdS68cIK5JJ8h+qMyoAVVZC3DYIgxFAuugqyEv6h
+fexBxtwF9X1G2/UGcVlkwzsHev9U1rfHghNH4jIkUgwuOJ1Ssziifga7bWu0GVQIdsIu8ARShja3
+XR109JNZPy56qz4BDPwS8SvwLnkQ3TJhUiWsYbV+g1BEYfBDIWLcaiOvmmX/q+mR3OlahlGlUG+d
+V+itc9RbGzMGAIURwlJvhEa8o74Wlt73jB6zj2o0C5M6qkzxYqk3BSqtGMmWRmJUpebZ0aEK9vG+
+Z7L7ZqTL3ZFOUE1gKN6JGuktX/YmuAK3OLBb49BU3S/TvGVgzTIa9WYzdTpjDdUYXAsHOtXNMbds
+sYU1vbF+i8ca3dR80QQfHwkCDtAMBgEUzapM3NmZRer4mU2BAU2CyzgmkmTsWLOphs7auznWGMaW
+URvNhDxsM/nApMIYEuiXCVjgqpd4cAjuJKMfZmCF270Wz0zosXHm0LitsGap0GetiDtSuCfREy/s
+U1bC3V34ZJZWcX6WrYDeOpUQKbh9G5494jiLCus99qCHnO19kFV9cMkfgNXFgd2Sg7ApRLdoDZyG
+rSYNqGw2vT67FjocUzT7alHbEqHmbGEi3q5pv1qwo1aDJKKqm8LWcwDwlOAfacoRDTQAiYQgUJCA
+Rsm2AXK2I7zNrEYypxEE2mRMAYBFIwZKM9VIZgTFOKWX9rcICbKvUPIh2ANNgWGRSJENFAoKK2HU
++oAMSMxjZL5g394iAz7WLhRqEwYD2LkD3gOGbSAt0QZ8oMSX4+Db1eoqSbj2C0zIVfFB+Zf46itx
+rc51YA1RTstAIRnmf+a1mq8WXPwaggxoxG4z0OlGF09kh9jrn3VdsnKruc8GmZmt+Y0ZUyPyC9sk
+2UqVg6OrUGjnWyUT84iWQKEunqgHTP7qoVQb7MR/LXZUB1Z11DQRXqrmwlIqVJ3f6kw4x/m3g418
+4XIFFG7cbAYmNBWCORk6nwNYCpPn80BC6DlKq9gVV8Xjqy5e9livS6tpH9rqXfWDZKB0oVeW5fR5
+4jvpDJnT6AJFZG4yGkrj9KDY7JE42xjS4ovAoAKsMohrl9ZGhFEI0U1DpRiUiCO0j6EvirQdmEPI
+oZoH2vhaCIrYO/6nGop3UJ0E0neJ50Vq1hK9H1Cg8N/aEjrcIaAyczRoCISpfoev9izpDltwaU1Y
+5O/DqwbJ4L+1sywKAIclKYG/fCKzPe0CyhG/wg0TKi+B15AuGTEdLMR8oQ0wngqa0RcWwEYWLjw2
+lFzZBMPXdyewbE9WREFkSQdK4ZEcbalRrT4rPqNC8btnk51tmeJUq4r/QbgP/BBXsEq9LKOs6DLW
+OfH14hkGfzp9ddo+sTTCduim4BnDoFGkwaMpGfzl1+WiSKeZDl1gQobpFMFo7X6BkW2s1mto0+CV
+xZpMn1Ww7B0lw7YqEpX0T5mjQc1P2bLIhBmiF7hKrQIvx9qPFkaDURWQksqtY/dyGryXDQoWwNg6
+BROMkbHIsyPKQm2uSnhIC/R3ZgXekKP9q4UXM9nKaqdnCr513DpufUshf/r6lQjavb+UjbOPjbD3
+qdsPuw1lSoAepVUlqdjHhlWw2mFdy859qDwRlQeNaYBRpMusqApmSRxJJnVmaB52ly6ryj2LKAww
+XADvaEVRmm1TrZXVjQWZWVzkixXAxk069aog+SvjrDnzQRpQun0IflKZknhXy2yuqk4p5TrLJ3aD
+3k32WHrb6oBoAs73wvO2KsPztuzwvC0Kz1tIU/s5bBBY25IuJfgFwzxcza8B+/wMuxdvn2Yrsqnk
+BZy5B/BPwEVOUWV25xnAaKYCrWYynsZahpCL7bmETbc+PEzhdBdigrlfYASjJR+FOfkfW5Mjy4Yh
+W6gAbbNeiR5ct/TQ7ldULaYOnicyft1Ojbcy2V/i5WKj8Fb0NpzI35ytpMFjvc2gMWNXv9waOrMq
+4oUBDoxCKqw9AryG/evgW6tlxqbxI2xvjBbQbHc6VlS8Zw/FfIfp3TImUwZ4/n29KNEq4QVlLBcr
+fDnDl5zSu/g4hcfK60Z3rv6Q4jPbT97vHXpn3Rf9wBXIWaH3SxE9J8LwBp3jVyenFSH/j1+1Oh1h
+zkNjErHK6aLIQF6gGFfE9kd4E9dbzFkKMOjP0SMIECNFn0C9blPO2JwEHhjjPIu/ErLK9yGzl+4I
+c+C8xgM7x5t4szuNwOW7CJyZMvU6G6GXKi9MAm0e8gSyE1Z8ZaXAihfrJV4LJtNEBAm0N+mjiUk/
+di9vcXtYHVBlJCCz2uQnfMG9FyFeLhkClGz9kpGzPGLFrQoqKT1dpfuWnK9Ix8vleSCcc6DLaVkW
+GKVXPiIcD0Z4beLh4VLaEXtntd7FT+c35z0vVLl2sPL+x34XzR368K+ry3aBuoMk+MGtIYZJFoa1
+FlsaF8i8eoftL3LiUQc9vr/ICcoBRfcjWbWEMzRbjPylZI/XGOZOFhB3xeHGXW82pj+7QTV16Vwj
+iGciXlOm1z6CEWHjs+EaToRoxDCwMAaKXDIBU1HBJOhEK/kUlVvnVDK3OuwGutMxSTMf2inVDQGl
+gb2kFZVHsk0tt7TvWyVOa6+ICt5kMiRLEdsRgxfUq9QEqI6xtwvAYNQff95tJeqcWQmSXoSkTbwz
+4GrFc0ioSuSJoij1TRAVSnIfOCH39lPr8l1rqEhBmzekevAiH3SYNYmYsrBTEOKgs82ASQsOMZOq
+01aHVX9VX82qKo7JQ/MhO13g9AP038Fv5omqjEYcqbfG/gTgzJkZqM3ddZbFdIkYp2SzWfwB1boP
+OuSV+awhLpChKBYMfQxXhJoTUkbI4KMoeRvRBtW0Q1Jr0q2xqjtEIUTqHRldDB4Zu1dOTRJxTfbN
+bPSFDFjttR1rGtvcNYKIIeQl8Ug1VCDkaCgu9CQj8rmGjJFy51jHNrYInnN5V6m47bUQuxrGsnUi
+gWCjK3/MEDUs2RJ2Dd7B7nidUK9yxRL+cTMZ4i6RgHeq4pu6rRdnUOWJG1UJO2+1J4Fd6wQjjKK2
+8WicjfJUxNAWsSPsFAzlYtkiNz2mr7Ms9AVAcFB+uHlbf+Nh8C00xxunGHdLRE3Rr9zOC9id/+wl
+j3e5Fz0TDo0WQDPD/K2Nxg2gl3lJ1xNMrI7mlGRV55dxvQL0a10YRnrDKbZH9ypR5JCMQUKe+Pbc
+NVkeRGvrrvujTMRZNoGDj2Tk5X02XgS5soLbVQSDwsgsz3RWR0jjMsTwe9ewC+044Zf9G0XJVWq1
+mk2B/0U/q/RAhLmtOcpwpf+2iYdYqolFzQSL8j4mmwgJ/YLA0455Bcyy/ApRGVmEE2w5Pdc7KpP2
+CjKSt75gVCbT7c3mZu8Sb3TqtE7aUUXcO3RmiMl7qbDne4+TzL5CenrTrESNLqDL8XX5lGfebC7D
+HGHAlLvForxFil0kHe2WxiPeW42KRZ7Xh9ltej9bFF6fQvJawZC4Gz9ps6nBPpXRuk176gyTQXks
++CGnDXkiCl7o9PSUAeVJkR8oSN+pMhd1bKlJJmLPyyjP0gKDnS7WJQaxSX2MWVhJl5PX3F366DcZ
+xdm8WSwpwmZ97sZVQ2+SFYHlTF6OvYCTAD3CmptNBkfKcDF+CgJ0G/+XL7TyGLJdh+BEbA/V/4tI
+IKvReVDPAb36KmRrYOK0xgtsQ5SFA44e0uEK7aYL40gJZ1XwnPor8RUO2N/ZkQXXdQCxUNVSfRQ0
+FujiZHXMuBP6ebh+6RdnR52k/bJ4WUTFS/9VHR4DZNmCWI+5OKN7X6Hav2ez6S2QMeH8TMzMkYAe
+kZ5glCW7xzN2eqqmDLYVOw3IkwFvBNk1Q84pDq68mcu5k6tQrCsaE0MffoRdjAj5Ip8BAL5Ha4Pg
+6JY6ANDiLHaIJ5zTcTp95XpN+DIcIckVnC03mzScd0fJWjYYzeB1kuT+rD4K5yIJoQKtGJZu7+bY
+v9z/KsA57TfaIfJc3xpIo42EG5KCEo1gVIn1MqpiW8TNkVCnMDTBYH5EVmw2wgP5/6i7Fqa2lSX9
+V4jvbmJXbEfvhx2fXBIScDaQF4FATpaSbWELZImVZMAE//ft7hm9LI3hcqu2am/dHCxNz6unp6d7
+NPM1Lv1/uM29s+BRWaK2O5m6n8/PEa0iWOENmN4C/nPS89H4Do889watbRcNPTC2CdLba8/D69Je
+aW5Ihqs2srXHuNtOGdDLebHCbZMM5T/9keL8192K4VqLidgJfXSmn1Acwq8lzT8ogr2sTJYKDeSF
+3t9nSUhI3SuqilL5hyFuLbd4N4J2Nmg9YcO8IHAjRoSNG9M4sudVzoHagF6i4Qc2v4SKOlE3pLHB
+rq5YbEqOHfydWuuWwl2SCKbrDeeKW+4uYYlm7BskvJ/VvrlFJm9iQqZ83FLP2w8zp/UQdwp9p76t
+2g1nMnl/DSV88mLwp9youNA941UG4akbsEUPD980S6PfSpVIAxbpJIyWpaUS1/LvCbAX33IC0CaI
+gsDY8c3Fd2wHOSfpI4AR/92tEA4QnCPEW4+sfet9aDb80Jk0SoYIFijQ+RvqmTvBwvEbpGLFtYGn
+HlMfy0fE3C69ha7eAfvYZMM+Zu9DWgj4YzcnQpX+DD/P8BpTWAGORbBh6SI8CtRoIPK5IqLjU+nm
+bDGsCJ/Pb5fDSbNSV+SeF+534CHrfo7TEhWXvjDXy0mrg7hNg5AvBp2oL71GGKrgtf38eVUxRLjh
+j9xlXebnCwug6Y3mf9///XfcKshgE57v/6MFdqtwRGBWjC/XhiNHqHa7CRjWwBf83rLNjinykCb9
+FmHkkGV8kIbHo/OJMu3m38w8PHvjsn2H/3KX+HvuJg7/OU4in/90fExnJxG8lGuZrHHJWN8yKqW/
+KT31wAKMClKS8ZgC86Sk3P+hOdeMCKo0+xzFTkUHbKXeTvcDmg0c6gZ9V+/zgMlB6dw0QfgxYCHQ
+N+BEHNABdW6nSWB1iIQqKEWuYyie7Gwm81jSoGV9f1AQogXuF1xFLo7pDltgecyGcXE1W5PYQYAm
+RK38X5F3XpBYvH2NyHHNUoEpDzOl1fwDGrLBPL82m61+m7Ydxkxun8kFCM9mq3AbNRzUQMZEb6L0
+qxcU2ovK11FRH9u6LCmVDes/je4rcAe7F3Gjp+mqZLXxxSKZvXKohphSdN2SlSyFBpDe26op6dl7
+BhYesSyaaht5lpiiDIU8zZAkNc+G8dY6N5Fz1SlWqVmqRPnHYXDuTeNSe0zJlLVi4sz1r9KabUXR
+jWJi3l7ZMORiSqnFqmlqajF1rdG2JFulZGx3sVWKYthEMXHdqw5YNZcgnKWmqbJtShWKvH2qZlvV
+dN9ZgkZmXJF1cMTWCbJgYx3QNcRKeAH0t1iibmkbMpSIDckyTSIObwJc6DqLiIuGJcnEOTeKSiMB
+LTaMNMHF/YBOEjlBjGcOoNevZmF4yUQIeKNsICw9IHhDeN6hPQvirGo/Om8WbqwTBjAzWH7ZYgKH
+BeTsNk3TVtPXJVmwVV0x05SyHFimYVAS+8BVKE6zbdMqpMDa/YF+8Qkh0cix0SwxUdE1Uyuk5UUq
+hqXIhZRSI3XDVApp5WZqmiQVE1FY3VtYxHDPLH5F8y3NwaqybIs1MCzNGVvWqaDQidVSqw1TYjOU
+pcBUFlbAZ61qKmqWYZwea49f4YbUyBlfxlxmVY0xf50Or+12COGQ6CzDNPU6usIIG5pRW1Q+A3BO
+sPJk3dQfII7d6JqxH+htw7SNOvrI/Z8FrGkdNGI77sRjLL4lDVvfs2IOTqrAzK0j5U3o4GcKxwvS
+uWvbyiZyRqSD/svrzzXTLZsLWUph9E1Nl7P3JfEzFFnKs5SHWpeMfGTWZa9MasumJgtpa8TIViRN
+yzIQQaG/JIde4s55t2ClMYTEBc1rmoYspLuIcezHM3fudJjNwZkuKZYpzDV3okvUpIxWUTRDEtOC
+RejzQQKdagsJwwAE1u0QEo0DPOmMHLBGONOlgsisZ+Tb5dB+Z37F9b2t8eYHRIkA5UXlY+msxal0
+xoEHiwWUdR7wkZCZWq0Q5IVYhm7W06y/4JNaUySjln5NvxkGMQkjnXfApp6Awi30lq0SI3TYnYhP
+KdWQbX1DnnOuhvh42ZqkrlOfB+lEkq1K9QXOmQZT27GDXM0YpoO5JBXfF/hkWaw2mABl20tW2Mym
+lKLESmr+vjQzFbCvlCypzDdVtSw5S6uYXaBRmQ0Y3zjTKSyiF3Fq8FRtNFWzdGWNuLAcWiZbtReJ
+5xdVCmSiYbj23JuUM2DuaWb2sqDAYS2QsvcROGkdL0DHJtVoOkzcdmMcRu6rK38x9UBnrNmz5TSB
+aVslqli5VZI6g7dKVWv71pBtMoNL5Bss4lq6GuO4lm7dTq4lqjOZawlrred6SoEhXSJ+yKYWE1fM
+azFp1dIW0z5sdD8mb539Xc4nMMVLRAKrvELzCAP9MXkeYav/y8VsMNsrZVUs+ApFnTFfIaq160tU
+IhO/jqjO2i/RiQ3/OrKKD1BHVOMO1JHVeQa1dI91EsqZ6/yFEoXIdagherQXUc37oEOxMUu9b7Ex
+S8XN2Ei9weN4ZL4652Nj1of8kEdnLrkkG3Nt9E4ek7PkqFQz1PgsVaKK+1IlqfNkqlR1Tk0N1WP8
+m4eziV2dat7HeT0P56s4QA9necAXeriAWrfoEdkqHtLDeR52lh4uQ+w3lfNucqFKlA94U5tpK47V
+ZvKNPtbmrHXuVinHv+55PSZ7rRMmzLjmjwnpKq7ZGmWdl1ZLsu6wlYkEvluVqOLGVUnqPLoqVa1z
+VyUT+Xllyse7fKJ8Fe+vRFjvCJZIanzCanrFPaySCDzFVb8c85nfKmnmEbrxElLx5C0/ABd1w2bQ
+LiNb8ONv75wAT8CdQ6u2QEktfHfrReOl+7LxooHAbXhYDg8VT9xBY//zzo9P788OPh+effj842Cn
+kQfixUiaq5AFHa9+uS8e8sbjUGEG7uEUsN9DvI04GdCXnlVb0WVz00U6OrnZ8+bO1H0VX09f3s79
+/siJXUNrf9k7UE6Xb1Xn+Jvk7Eje/sX2zXhv6g3fvQ1Pj/3A2ftqDy+GN/t7b7Whtz11d+V4FOzb
+w/lMmuxtG5+WtjpRx4vJ3f5ipH4MPt0B7c729Vg9DYbeW8U5PlK/zm3ty/fhzXBne7p/uA1/39/s
+vxtOx3sf/bFytJzMj96O1bf+ifrt+8nPb1ejO8lz38tXpx/8A+f4qzfcPYhPfh7cffn+MT7d20/G
+czse7fqL0yWUoX7TR7s/oD0fZydKEkCaPJp/TZxjeXaq/DCGu/r1aP7DHO6W08e79p2DdcGiNISy
+8PfJ8c3UOdavJrv+bLQTT0/mHy4cZbIcqUeL03fy8vQntPHnVxG99+Xi9sL5+fEC2jM9UafAtx83
+wIML91DyDg63oR+nV6PdG2grtQme9eDT8Qfg4zCBfixHSuJDH01XOYD006vTpX4xUm6vx4FsQznQ
+X3k22fu2/PTzQIJ+RKff5WzcXDUBWn3uHMNYKP7lZHdmn38fTkfzcQI8lcaevFwre3ly/O1q8vNg
+ne5usvfx2lF+JKe7H+6c3Q/L8fyDvpb3EsYjPPn5cXny06f8efuHFweHP2T8B23OymJtQp68R55A
+W4Z3B4V8n46/zcbKbDYOPs6QX/uHJ9Kni8u7/buT24Od/dv9u6/Kwc57ebjzQ4H36sHdVN0//HoH
+f5f7h/va8EKzTmAsRsDP06Nv0Df97nRuL0ff3xLPnOOP8mT36PPJsYw8Bv59WIyV0+vxXPKGe9/0
+McpQ8PF6sgv5oV0nyof4RJKvT0m2bq9G86PZ2BtOJ/MP8eT46I7J8w/69/luX9l/ty2zf8Opoxzp
+R7t+cvoT5snFtrkP5Z3uHWGfb8cgsyfzo8A51iDt7R3ygsnVtx2UswmMJcjU5ekxyMrcx7a8/PLO
+nsFvHB//aO/jbBQczGGuJV8ubq5PFH95otz6+Bvn3JdpOGis2rIuG+omlfCi0+n8HSwiv7fFMVJf
+vUJjABYYt8vVfNcLX10rqdLvojnY+DuYhPMzbwLZ/pGuBgsPXmeW149SmdnrUqHpS8j3AtSXZuha
+r4mfqjGkBZgQ6YOtGbKRPiB6slT4rJ13JmoqmqVZoC11C7xxAY2lqoYKNLDmW4aABlYOSwMaWQYr
+Q1SXJdsG0KhgByuiusCBwKo0dUNVGjVZUXRhr2xNx2I0UyuhSxdpDHB8sFeypsmWgEYGmwzLkRXV
+VAU0tiEZ2HMQHFPUcwO8WBPBrkV90gzFtGkYZGFjVPA/LGKxZYnYJ9vQUKzIlEUkpgrDgFWZimEL
++w0jhAciTOicsE+mTcOgKcLmgPGimMQ/GHlRtwxLN1k5pqjrliJRt8DZU0Vt1nTFQulSdGExBvoa
+JIBgM4tER2XCZUqaaMRVsBqQxpINyRRx2YDZSBKoCCXHlC0ZpQscUUVUjmWrJKSWZgvHHGYlNsc0
+bKF8GWD2EgMNUyhfmmzLePJckYQklqFIKDsw4sIZrKiSjjSqYYimnmaaNnbcUBRRgxUTfF4myLYl
+pLEtqsk0dFso7baCQ26oUJ5QoRiaTL2yhAw0gSvYHlkCx1rYHtXCbtmWooq6DiNOMwsmqlBPqgrj
+sq3AVBXRSNBYlC9LkUVtljWZ6jJVVdFEQwH/o5lu67Yu5A80mjScactCbappTMPpwjGVTZ2UnAre
+oybkj2ajoBqmroskzJBAc2E5liYLp59ka7RGSEISSSedgsuJLpQeg0bUsnRNLKk6TVEDt0NFo2VL
+pON0RVJEUmhpJkkYCKwp1N2aKlFdoA9F0gMrgM2kx7ZEXNZ1JhmqbiniNVRXZWqzJmyPZRiSRFII
+GlXUHpPUgaHpqkWGleg2Ir9rjEf8/3JzNBqKdpTCJOeQiFKbocSzy98YhOh10o9evmRhnjDIUx6W
+qHAz7OzMjffJA8V7Y/l7fpUgvzGGomyWNFgGfoHTuyW4QE84Hm4O/fHEumXNMmxh3SwSBjL0kc0I
+CYTjaVyQJFutu8SPotMq7RUgCQ9jEzOkFo778Mv73YzxKpHPsBTWMVq26ORmhI30u5MwcN9gvIVe
+kMW3WLS6ycwNmlB4a7URuWDtSHZ2ry67y9cvRPIOCke4AyiaB8HMwFEwyHcZCSRsem1CH2j77QZe
+WMVrnfleiF+hoc0NIor57VV2s/Npg6GqYJFunER0YroUWyERxD7gezL4HWjL2aKTxVsO/D9jYeOp
+IgMrrqHViAxp2nWRKQZtk3ASp7fNoxRIMxwgqEQ/7LoBDGFEdz6LD/f3z+R22GX7cYsovRPaIFHD
+I/8hXWe9ibyEp2FsY7dNO0nsYnotM4uoFnhJys1vibYTuoEeEvQFFdbI0hrtP2lVPbyo236qDlDB
+qqllIypkpgPIimDwGVwzeDg1LVDH9Zohj3CXXfwMqB+t+3v3V+Of//QS/JSGFyb7OTKRQ+nNiBBe
+4BciST5/Xgl0nqnpPxFhQ0fpjQKpeDex9Se//tXz20HNJZz4r0zlv/mD+gBjjPT4L7nNIXt+xS9f
+/saZVLpuw2QdeH7e8xFFvkby02iETpK486sE7+OybrtbQRh06DeMXjaJun8HQ5CiCIMlAu3I3UpJ
+2pTBwSVoi10miFkMkZlzjVFEfn1fzkeh3025+rvZ2pq7ySycYOi/FPcDMSZycCjgSpEjiLmchswp
+8YrdOIno2nwz25sdA+eQTyR1JcZcYT2LAXGmeEcY1PT4/p6tvQi7jMXg3SZ+Dw+0mxdAAwh264qr
+ksVq9VQVZsiyoouVw8NTcQsv8bxhrzl6U9TOtQFGpimqAnzO56O0avUQ/WQQPXlaKqpcsinTDpDF
+yqYlGeVrQapoNcoiJmW9dAbBmxBMnKDFY2W1evXXZuR+8nod2qmfpDqysMBB7zIzKUBmRS2+NZ5f
+cp858eeb4EuEH86TJasZrzPjtP0V/KbQ9tndiqcxqu0IIMYKfX/aAIBZqpqP1ovksG0wk4p6kOHA
+MW3Ifq/pxNSecp5uT2nlnRBh4x9Q3xww7w3+7T1Kl7MOpWHb0UbDG/o+0wqgfVARoNwUNE7/WdMf
+NJ1MybRItRBeO8MJcJg912rjHa1UKvFGV6uPBbf6uaVHUDpgW2XqBOvzH6t4FlzxeBkmRvxUBaQb
+FvhFAhsqXT8eXjEmbpxEizG05f941Xhir3FD6t/vdXyFsBv/LzpsWUrdRCPXmU008saZlqAtEmY9
+ka+PMb3ApsJNEYQRg2zo3aMLA46xIskYHi5ds2Dxxt1QWNZaJeiuwmTFuYS3nVNs/jCFCgUlCJ4R
+m29xu4TplQZRpIIK5i7GN0B8VZh/XMNG4G5lEOQC9+jRCwleTR4wXVFcUt4UH/AyZPKfypsYlMV5
+kwdHCFssMAJfTEp9uaJxCBF2DGFcfEIk8BEiqhdj6Mi8jLSAoFzAmCEJggOYMOCyf9O/BhWg1qth
+EgmSDtztYtJhSaokiTVyBpPmltFCIlKyDhGRBk4vbZI84Iiw6A1+MXpDNPDBXW4jXFsWPbP110C6
+v6+s4ld8/R7G7zPp4Kob8qB0pVshrVxrPnUXADjwwGwifj2BSbAWDf4g4EnGFgfY4rzOhNMhtsS/
+nFq2NL2sl/0s8OgTdYZs1hkWiqSZWivNwFUHLddOrgSY46XJTHPQJh7THFyJoOagLTmmOtj2CakO
+2jJF4A58a0paq4iN1RJ9h5zVHIFIntRvjmxDAKDrMtaeDKI1a7E9r7tcG74JEUJ0OphnWvz+vmiD
+tK8hyYmXwXhYTC+9wRAL824SMvTdQ2dKJIXnRk10ydwke6JDQHCIKzRHRuzKceHS9kjkhjAnYrUW
+bLyACYQQZQVGFndkzt4kvTOQHVBnOQHKDd28RySVX7ksO90zL7gOL6sxLpl4NuJFjBhPCH7kREmj
+AjGMe2aEE4gQ1YuEYT+Dv1HBqNrF2/s4DFtevOX4uNAvt6JFgBipLPRWIz2+N2FFULFshwshhniR
+WdzOHbTh0KLsssV8ECJKdzQdOP1+utcWgTz67hR8735+Uz8eHDQ93HyDVwzGKIbyb1t4YtYLFm4/
+twGxBQEHdk3raSEyOAJzds/4X6g0C12QNzijp36s8bHIo0Gh46wLfWi3F1+hmLy/HbtXxGlKAQcH
+KmpwFOJCLQjd7owQXrCZprKyWn2oIB8cDqabhqInvgcIT+5jaT6h1DJ1iszDzdJC63p5N04814fm
++sTxGu7xqULpbdpaYcWtVjmH/BQTd50FfEDT7U02rD7r/2pFCEIxqMd8eqTdIbOfN4Agl9O+oYvY
+c3PdvlrfJObkvEaixjCBXTx/N7jps9igfwqn185wqyl7+l562sYnzHKJWUbNy/a0BFhSiAG14oG3
+3g0I8ilu7jdhesLLd8+fv8O4QRjZhpr9rj1l4SvfsRyfB9v5/B6cFX57zcuClr/Ijtn1oe+DX3xj
+Oe1qKi2/s5WvZBoxRdgURbDi2gNRX8lwKoJbD/kGbPocNbPd+0wIf4W/2y6okH4+dZ7lYkhbWGzg
+YVlbMHcw24sqQmwEzXEr49S43Tg7c24cL8HIa9k2/7jL3/Lt/nKnmumGO7Vw1WqvJ6d77Ty91SuU
+XFcib+4AMiDqRauWiTXlruImF3WG/lbkcwnAqMBaJxcqVLlJCf8QcY4I8Ah/FqzbcADLKmu4A0PQ
+cxBmLCvyoOhiuNmy+yvhs/N3DsuKaoh0RpKpWxanIZ/qSVEZ5qVxb5xgVNNJn2qvhGY9q6HNWlNX
+YLppcttP1vUGK2HN7Tyccc8Rl6JJ6MYUhBkmz7U3QW/xBWV+wT3ERsatWw6w8h68j7wDrI6i+BLC
+ColvOk8EzSKpXufYbZ8t7wFbBvhAwTCRKm4mvygIHBhYCNPyexByzPOEtlBgmPDPp3CczepnOadK
+TGayXmRxq9qWVi/sNR/H1YyjrHW4xhMoZcA98kZd8bmwHeZHgVGFQw96GB931ZdpP5aaTgobUsAY
+x6CwSp7Cd3JYGgaS7jrn0B72rP7maJNQ7vsATD03BVov1P8lrx8WCbYUwfv7e3RFaTQH2UqCvUjc
+rf9l7mm32kay/LkPsX+MNiFSVDayAQPCiod80MN0QjKBdE+37eQIU4ASI9GynJDGnn+7D7BPsM+y
+j7JPsvfe+pQtA8n0nDPnJFgqVZVuVV3dul91L00Ds2tjTj3V4VthLCi9NuqpsTnoHO3MBgzVYEqm
+OmYi557KDMgLCqypu3wlVYoG0t75QIRt0pimNGuIjotctEAPkzcCTTDJ+DCGj0tnBZEffL1pRZcD
+eiKDNvt+3uE6YDMlf9ISoYFC0j2QmXDRMcIrRhDenXsuv2xVA65mJiU7oXM60yGu4TZ8blGn5+5c
+igXZmzLnqK/2yNoW99iJ+xpd1VOhW0OJAJNRu3tzhUceOyIGbBR/PaScq1DlKzMs7L62YWJg5PFC
+eUXehKrlwNC11ot3dYBpckuIoiMQUBbfiRGIChu86VREdcYQrPC1xvmnKtP1hLQie16Isck/0Kx8
++EBzwpeMzWO8xFS8JmMj4D3xRBXJLuT2StH5P7oHtvRxYt+yz7dwQ/CCPVtqiw7wlViyEEKSbOpq
+B4opFetQ8EYJ0acD94uONok8hkbAiiVzc6+bhIlURVcxCMq2QpRYJpvQDSj+7UecIsQxez4dUXQb
+AyjwUgmiTkVNpyfIaE13O3DEXJWPK2h06xkrTU6E0isE1cv1NFwZZYcVQTU38dlLcdkxshhFrSdV
+k+hSZxMTH3TKcv215yZpofq8c4yYKaqOo1fsrYVbN9ZXEL5lRPzChUERrRNJffnnKBCEkgiFvCZx
+TLBLdKkoDJZoMkQ3pY2IiuY2RoqJbNgPZJznqLlml994bIV7pdkmQu5IqdFKRCuppXAawdBrgvr6
+Z7AsSrPZ9FROJ1Q8KacOEUy0pFLXgwp2rZRRBkDKF6Y3pzKHIjKkSAGU27yG2H1glWZsQQSdD0qt
+YVAdWcGmdy2MIrWFVjiI3VQJWjTLmoHJWXonm7Kykurcten8oFWaq+Zuiinj6nWjMJmbnXTA4iib
+nyHamFHb0BBbtWf4dIqqLqKKimedSGOj0jfIBc5gQ5HcioOaF1NsWBXRVwJCn5lL7KqTaU7HvNyU
+oRZ8d66+6dRuYZXOlHYi+eZ3UVPy+xkv6nRgHmoUvxBV97UvCSzaBH1+oHkNeEEJgfMdAKMDgFBn
+zMXvNe48S1c+FyuvdVjzK58Lg+XiKuoPNC0vlIpdjsCnNpgStVKRpGM2Q1cgh64dEV/YUVoRuqe0
+dPqt0GsnskeNfkQip50M7I18v8HPUHu2qI+Iy88H5DqZsL6CitF3Zb8G+Xp6olQubozho+VNuKBX
+vwfVsMZMVeYHToVdAw61DS0tlqzhauoTaQrMhZxkj05LiLpD8XGGliaLS91SoZLcUT3Y6a5nDOYi
+mQuxbwxYS9GqEGilZeJ5tCoIrXJbGjHmiFp5xnNrXVG9JcUVj72BnQBAhPXAj+ifBaLAQQJPmSGW
+bhWppYtRcilCaWJjzhboQjLC/XUkKYE08KKtVW28pD0Ml/nglLboGyVahigAMSP9hpieiaTdMJ8x
+raa1MEUtvb174NwWlhXz4jv9jLbWt6r8jMirV7rp4HEksuEIjzmy4ZB7wHIzlpJ/yMtCODBOp7H8
+TdzvtVY34bVVpifyA5b2NXR7WQQX7fre7dm8NLAC1H8IULKF3e6/Qs7gJe8nKxmV5fhUIXClGJma
+fAFsy1KValPlrgyXpJCo7LskzEXkLsPRa8Ywul31+tBkx/wu5ydUXvzDnk60zpW+12gmFM5C5N5E
+WHGLQ7jWE8pg1GTvmM8D6tmWNC2luYtGQW2hU9oMzRpvs3pTiS/Oa6X6jZK5qYfPPolKJUI8Zs6r
++IoawP50xEXbLiJu6Owp/wP5fO292w3fJdMDLy3ganvabE/XWx5cPhvFl1f81OvS0YAHMuti4nXF
+wKQi4rtdsIPy0Sf9ATS3tjzXnv50V9g6zpVE+HYCOy5myDWmjYUQ1Oej7CQeHQNR7JrLRlUnoRaM
+nZyMBPNValENZFs8Cz9jGzsbrWVJPe0Eq1WulnL5ReIUZQX+A9wvQfAE4ezmVzqmms7osNdG+z5g
+GsqmcFPk1plO/wV9J9MlfpCLw2+vr7fb91ul2/wpKPMTplwtp72jgaXAfqSdWM1BSp4VMYpaJtW0
+8qvIFvwqsgqgm+XTSkthXkj1q7p6Q32dFmxvW/Sq0o5lmMf4MIN5S8/D9PuTFBcZEYKqnUIkf0rG
+4hAR97o8xMfd3iDEWBPAy1zxuKjOcRQ5zq7wlwzk2aOo6aV+xK1zRwA/8kjJZ/4rz7MqCCjNyerq
+IbnSNw5f/LB3fPDTiw8Hh/sHhwfHv8DT5hruQRgD6XRZsiUWCy2zBAdgc8urXwCJVscqOqkAlfcy
+WPl8MIiKXqYPS/HZzN65LQsThmKP0LgRj1Hv7riT9FOafYFvhEo8xzg3o4az64pfouyYw8WPHsEH
+5TzyrXL/kVN7BCyjHzmu46sWGJDIb3q+E5qyYTaaXKZU6jkMFbDygQzGI97g9NN+6vjlRx5LfczC
+l3thOivnFfVuiB82Sh+p3KdEn84ve69eatWKo9T+OFjUhxAXi6rcQsk+43F8zqNYfOkrTY+pzq/Q
+S/SoiIefjjE5c3dJuWgo5RC9NUpZcIzVROYlau6JEswbMEssTZ1cdpFWxRVvstS8VtUSM5SUnqgN
+PlpmVRY5GkOYVTlczN0sXDeSXfs4lPCJMcmOAIsS/DOOrJRN2VrLq+vk2Gm9eDImBXtaH/uAyU6t
+0WjUHIXCgC/1lGrkUeqP68BLUA2rwg2MK4x9mc2N/Ams9KMF5h79v//6b8fzE3aVjUN4o69oomXC
+mJRYfowng9QAczeyoq4NMj6fzSVHUPS5mFuMQng7ozaycTI5O+O5Z9FEtI7G1y+pU6C+1l2ELnbq
+tI3J1ajzQuoUkfBVV9XD72n8lAN1ENY46z5aX95iD2Ve04Buo5Z1BhPowVo/7/bTaT+f9gOY1Tjq
+BQNY4N4AFhhXFHhr9Gxx9YB3vUSqucV+A6xr6d7PUSuq1pE3YHkSnNROJCusro47gfCcVWI1wLRQ
+JrOZDNkVO0N8u4iszD5EYOxhAfLLV2rUdxU2sdPIWou6nmz/wl8XkzEEBgP1ReWJXnHH9WEn8HaH
+sMleRSM9ByzuwROYJvFjxlh34cmgLh577BR9AstYJ1/t+RNXDqM+BJpoAc0AIZ3aFD7MK8zX6WM2
+UP+MwJyHgSCw309v9O985d0vLPdSN73gnPmYXHcMjd5jzfLcSZSDqfOHTyK9KJVT6Isp9JdMoa+m
+8B4Dusccarc6i46kD9bIWxCzNjufoFtgwy9BXEiIHSfHE8cyXMK1cgJ8febgiUF+mgwpcxFUv0Kb
+SlrY16hDgXsphIhMiAzkNvjdGyXxmI+dAaAIJjuMRzEKAWOMUAf9IyDAdaJ8N6Dv4KKKOhVoPWdl
+PqE6vzRUr2NmzivNJJZOlY7dR+8kM5DRZonbfIGbezJGNw3kpEl8SMS5zzNiA/A5brEkzDceUZoX
+2lxEH2O1sRbxudpvcZIBV/BH0FJti6dAX9rfxxIEdDK3YDa3tYo8xuK6LDkY5p1amFWjtLbqxoZA
+ryUa4dR1GUS5qASkvK6sQPbsonxvV7TRgTK+mlu7GiEiUi78pSOyko0w2FNhlTQabMU/r67emYAc
+JEYUE6rzchc9+VlxDx1mRQbtYuYWJVDUzkhYdmawTC36EmzDR4Rrqp7EOQzol5wl/LQGMFUj2+58
+emHB5fYMJwzCYPWQxFTlmqOumhIyqBHqkqY7Pkf3T0JfvMcLLBCLhCV0JZgemqEcpWROV6WcxGU2
+TIgFeBjt0uLeRGmlzZnRCFEJZDskX3i5yvuq3WTnRSOPzoVlKSC2qkZGJzx+PVe3kVxeAd+VYKbG
+hWf8Wj4rrahzRNFSJeg1JSbXxhfZZHSKZ4licmZitR791gYM7VdxTURZtamLe1NT7w9rPeALoap6
+qSyozTB/mYGT5r3QozPwe8wATPymmQJrILOiGk8Wz+JfzI9a4+koGRdIGDWOjmuuGCB8OyMuBi3E
+XK8mIwfjYX08WGU9awhTHuxrWXz6I2GZ2h0og5oqLoNxfMFzTl7f1J9oUJNu64BpXM+ohlPNfKN2
+oB4J2gvg4vPxZHghxyE9zcaTK9SL8VMNI+H8Ukjo6X2BeCUqx+fj2hBd2tLRV0QarAiTG481BjQc
+8Xn9K62Y8jBO5+QF65v2jOOVmoVIUEd1S4cGFHaizKsRVFZUt6WKGL2ADEvJiJ+qlYxO0b6qOnbs
+Ki+u7Sqqy1IVHNsr2/1IHlVHSTC6EbiFR0UUr4LXklnBSxXclopxVUPVBoUK1QauVRu41G16A1t9
+IfNAUi9dV1LbnqC+A53oWpY3dFRd+cALc1W3RxQcti9dSZVwcnTg6N8SLWgYeafY5ahqMfpFbraU
+1MRTnbmLi1Ax6TDLQsg+jy7ZZ3KgunAdgCP8Gl+OGll+zlpB0AqBpXHYDUIeatZQczpVSii113d5
+6DiYWe/rLZ3z30znmt28b/e4QB47Wd49rKruXrGw9+79htICfqHez90bTfV7n9lXdjKQesUXy9+O
+fS1MneQp531rhB5Tq1+lk48JVqRWFzkaTHX5d+lxsCHuXYdeJr0QDu3rdy9fCm+EWdXISwMH2UNx
+m8vmJiIlqGYnwxsgkFkKTUaLfQKQMzbKvvB8GNuJ4vVzgnnGJpiQY0kVAh/N45d8tKyK6AVtzl8r
+HgMKMputDR0NkiPX8Hr5Gp5k2XevYXPJGuo1g7Xgap2O7eu3716INZtON1VlIBZjXWO/dLP38ujF
+LWtsNNt3vvB2DNBuiU9hVnicDrDNnfa8ErpUoIMlInUFhKEcbCVqlKoT4KGcgUo0KVcXvYvZux0v
+rIM/pouN7Q7KMLwTbW7ah4Eqa2yRJm9vOW4lKKD/Q6iF2lCNXiyDjSPG4AvIeKSmKtpn68ixYfCz
+XjYARtrx6agAagahyPehkDkB+VPQ+zK/SUfWNDXCPk6EL7JqIGxime/vZp10F37RQdz5YL9I2IYD
+ehe8tEkXBjAANlC2LUwjTW0LOoV3LWG53ytWXJx3zOxO7pfPslO+R+e/QSzClZhO25sdPFsFd1vB
+dLqzpe6aQcu7F0DZtwH0wZ0H5c63zERXUWmKyDf3jncd3e9dK+5KDN+6fMMySiHxKuKAWYQ6dcyW
+nJskwh/QQY6EF627+oAqcHQg12iGetcSmsETScjSqN4EmT2iLoRGvel5WF9ioNZjC6yzsFJgYI7n
+QdSW9Pgqzsf8IAXmU3bW8lhLnIi4vl9tDK6jV/ju6tvaHGs/ZxRt8V7EU1jn7kc7gZI8hC8xANE3
+a5Ttf/OU9SRJ47zSLsmfREHXCU7QlKX1ki0vdOoLZXpBZiwbFvamvtBdVmq6Td3Nl1ndnYKkc7mk
+Q9OEZvGCX8e3VRfvvy69CxYRbt7hdvEMKLhL4NxRxUA3vxPItzvM1irpKe61mAOXzkDOUW+bOXAF
+9wrqXjNATesQiuzB9Jpt5kCBQ3zrp1JudHRA6dX9QRd/g/rOAP98GDxGH5R+v6Hu6DF/MaCqVM/3
+ulNdwa98THdQBx4m6dn0AP8f7ntTUZTG6fQwPpwe7h163gNHnU9de291wtfkGdSlm9nZKIvvt50t
+qASBMH0SbjXI8AgCxXtcG18GdxErLfpEgsxQkM956gRr/xI3eLH2SHC69WbYZI5fd4yKEOnQE/ze
+SIejyRPDKmdEibq4M+ZdaWN/8/rooGRjD5cZ30OnATMtuoDpDnNBPfZx3tzin0Y/XCAgK+iBUUE/
+SgRkiX+XPDDmeeMvCboZYfgUZJEMsxRK+Gh4u1Sk2TbzELBLPtRMmvUwPnS00/qymSX+524ocJ2W
+QgF9LYcCvosFKKp8KO4BRf1WMOq3wlEvA1K1bqpm0Agcg/xlOsqeiW8q97pmq3bQmNPgjoc+tMu5
+X/Yx+qLUwTda/9l7wa7ZHnsNoi87iD6ywzkCpsmW9ceru8tugNSw42/tojt3h/TuuBhMe7V+vwBi
+V3oY2q8r3QiiKkoee4KqUg+P3V+nLhI+b/41pfZeF/4pWvlmOVVEzznKY/V9lFGGWYsOpd1bR5KL
+jlXJXbRRePTgYXM2YhOQEIZ05mDXCBVA6XT/IkKy6ZzJOsXiYZDnGOFSjqJGOaiEJjaP/AKYKGAf
+4bc1qDdBLoGr9QFbKXobhrmCnrALF/803h0/c4VnB/URY4sNtP3D7yaa/+G3PWBFb2sgWPBJhNeS
+QAds3dudyP2is77rTfwI+MbdSeRPFLNW9HYGMLhh1OYbj9128BihDAZ+zQei32wOptNAMLA4WlW3
+PvQw7M4SWOW0TqDOcHV11Bjz4hjW2x01zuWVBx2w0YwZM1+IXVRS3RI7dHD0WtmPZ1JJ8fYWNRfP
+z/k3YJjT6UjJX6t04JN+FTl7T589f7H/w58P/vLjy1eHr9/89e3R8buffv7bL7/GJ0MgF+cXycdP
+o8s0u/otHxeTz1+uv/4eNFvrG5vtre0dfy3qp/1cxBx5fotOhdioP0L0DQC3tPgbR68sF7qMQv6S
+bAgYHesdnqsDejmISU/aG0KGKjqB6T/1o7bm8R9uA/t95zdm8xu9ft5Po4HgOjD8mQUgYHRAoRIJ
+0gIgLQBSdB4tHm4Qn1+YeIjJkyfN9mprc9NjpmS7VADXq4mHzvtJp9OemmGmapiFpzXD6B0Is5M9
+3HjcBpL3zW8Jm9vE+tgtg/mWLSqAuq2IopmYJxuiKqLFuyQttoUNb3xftse0+Xa1U/WaOY5UmWgU
+SiQKiYWJ5cKs64UBzEh6GQx7e7UNBE3ftkq3bXPXXl9F1UoWuVmns+35aAaeW4744Tosxnf2HLbE
+iuj6Qan+hnXX6Vh9tYEQh02xQrpxq1R7o1TbNGMpEouX0R2uzOzp8iWiL3h/OYHIbL29MQt8m+rc
+bH3CgYyrlWV5ZNy2OzksctSUIZDQZZtUaEyjnRjEAPUYT+VpQa+snsnQopgiqXmpjhNmgqrEc3oY
+pEZxSS9HypVEf7WZ1bX0bMuUumElmH2jYeT3O1bg3fIVuIqTfPxHLgGXofhMkgXtj8XEmiRL1iTB
+Nalajt8XlwMa4IS6WVTO36d9Oc16oEdEL0MHw5R+BoM753lxoL3BHzJQOch5qFkFkPpIKC7wb3d9
+hATdT7eZ34pF+9i3rrH6ssQJB6j5mzrGgC4bEivRA8XM/rdjtDDGPYgOKsSSN+ztgBnz3HO2z96x
+n1BK+flOKvXnCPbr6yCow59t+P8U/j+D/y+goLnfv97ah4vtDfjfhoud/f5kf3//Bf3dB6mjP3m+
+jY0nz5/CvdtdwZJnogRreFOULN6Xq03fe3PV1thfCI7tzf6kFbS26e8OFP8Ixazf6w/6N/0Z3P8Q
+0UmllekK/OvF9d/79YG/4j1YS9iv6lHvvWmBgstDFFni+tngpjWbimto9h+7/bV+N/zTatT3+w/Y
+h35j5e/9x4/6bt/D1gPvMXRqrCC/WMty99arm/3VatYMBM/ZXCeOU9f5m1VnR1RZb5WrcL6sDnS3
+pNvCbrOxISrtNOXvumzU0heb5eY5N4EoKmw7XV7f2A7RjgBbynpriqqWgswJ3aK+s+U3g7DeNL2l
+JWCQjeJdpx9gvmxx/W+YnVUWnzhhs9mWgMqyAssCeZPijar9GW6Clrw5wycb8ibHJ01x8+9OuC4r
+1TCpLF0+ch6FGxKANcyHLBv2nXBLdv+//wnlm/L6fzDxuqxDeOqE24F1v+Ogxd9k5LAHDbPW3txc
+3+xKVDnLs8tn0miBR/Kqijc3Wztt3+V1bNlG7sZjm+31VqDLVmHo656nA0TE3CK8rc02cMYLJWMO
+3N2Yd+AGfoDDi3lvzAdQzR1zr9sMA2hklVgnELhQjkk/zqtJoVxJzxKg6cLVUl3a7pPCsQgVinQx
+nT6Qvqnpz3GOETHhkb62G+Kh6OFXdGimC+N5iVkgofgjnZ5RheSglPKcHKDFpd2ZopjoUzOOLMAW
+3UWEq6z0vamqKf1yFJR0sECz0cKJVTpRq2gt6BttX1NgTLvgQJw6CNSMgoR5HJ8cpC+xYV2FcsmG
+wvkFmDrrbAW3vS5vcPpDrleCCTdvKKE100oDtEMpKEPLcR2BCYUzNxMnhayndW6g1+6tuTodFA3J
+CYq8uyjupAZxqHGHUl6IO/P4Sj7mBg/wUJK+kbwOrqVsK+IFnvHoBn3Gwsp4rnSYS+2iDZlYfXWV
+YHFOJ7jYsXKifkiuZ6dJDrQ9+cxBdBWGP7GmqlG5Ui0e4oEmdIiLh8Xoay1LuXa3dLT2CLi4Nanb
+8z2ldvNh1xJ6JjIWeuoNyWhUh8/5kluOm3iM5ILXFiDMIm2BS1Hn1KQA7FZZS5ThSDL1gkkqoKZw
+89SlnBj1GoVjDjpsymdk0cTwbxd8+Akx8imGwBhHcadFvcerqy3xcyVfoj0U73jHjP1/d3+63TaS
+LAqj/7+noND+VMRWihY12YYMc7lkuewqTyV5qC61tgyRkAibBFgAqKFErnX/35c4z3Ie5T7JjYic
+geQg2937rNODRSQSOUbGlDG8f/rLrO3b23TsAdSfswXlVaYWrsA94EPP6GqT/cKVwqk/mdSWG/jD
+Rj9Ke7AsTTp+qh1fDtvqGhr/JEg+2q8hOkjlNv5UattLDKSSZOMCBteLu4MIpP1GAUcyuZYG1Ska
+VOvuf/LZn3yc2axxQpv4PeBn4CB7CwdKztNZCN0DXflw+HIf0BjAaoo9CP9pVOZTT0bz6HAQDXi3
+6J6WoRs+nyo652amfWA/rsRs5upB8qV8nIu4wzYKKinfEzlackWEkkuzx9FehqIB0v9mAi/sC35k
+fx6jJ3wCPAdQfPi/XChghGHWsEw83cKvR2/fNPBjgI4YVbR0r/CCL2+hTh2uGTDicTTU1qVoWzoC
+qsxPimqjIGtnHphjLSwMF7deZQmE2plLyS3pcgsYUo61y7OGkf6yIQSQYo82UEToBEjJxnlX2rfi
+jpjnl8ItYUKoiotqrl1Ui8eDvQKXMgnJTemTDOSVYBaq8jhBJ+sEJSz4ZUVgHMba41Cq0EWQWtbd
+q1m1w7ZwzTTq2GFD+VvNJdOei8hQenjjx929MQ6v0tjx+ERtThpzw2N8AweKcoQYJtBoYYzBTHHm
+sCA1/2XAfDX5GcDqn7IT+hvWqgiriKUbo5YczQDqEbrsTN8n0D3D7dRnM9XZZH9UW+TIXOSotogC
+AiOYEEa7JliXZaogFmzTJymnIpZZUXCBT8KXC0NDCEbAIPthIUvp0XAYCwf4RjESNp2FjRIgjjtF
+5jIqMCPFIJsRGwFGxG6rURDsGAlGTASRzyya+gF6YoeRDGCaG37ZRuTSC0POIQGDLs85ljJwjp6V
+73f0w9paQHJX2WmahYyLKnObQfZGf+EHfLGiBq5rgwJM4WGX2IxoMbnXhW1rL4zljutMo57nZSxJ
+qw4mx68P5g1yD/MgZXsiRuIfAMF7hJLhFJBzUbVLvG+vjsJozt3f2prZI9KL1dWtHYqB38tuF38y
+veoDp9tsb3Aup73F/9IjtbfyO2IcWlPCxbTnC+fOcCNjizXfIxkS1sMshlpLjJFDHjcm42OjEDq6
+mce55J0Q/rsJ8n3cuTISsVcNf/ub2LJVU/1IzVJze2dlFijnyGtu73LDtHLFCaf5Wtuf83KTEhiu
+hVtsY343K3GMNzAGUTkTjD4Zcnc0HUWxvHzSJgCSZdrVFJ1bS5BXjIau4krcBu4ZRtcZUdrtZ7nU
+fBuq5ipw2ppsJSnw72ks/CcyPPL3SZj4dOO18OBgcEdnt9SyQprVt0zyYWcG08FTPCHdO4sb44Ko
+ng0fPtveIaIBcBU35w4PdteneMYFj9BjYC7CEyskHFbgMyyltzn3Lly4BNKCAwGUaMgpx0FbDAR2
+zBsqmpMb7pudL2yeSRoFB0rSIz7SJ6h3pfX3+UqeRT1zqYSzk1DqN6A4v/H0cK1JlwJtyFjFBW0d
+eiJmTAIEBaQib0TjpkBOKkyYxVQdxDXjBLxvSQSUbm1x/f0CEmSp/BXYkmOkU7iNhNDASSrgkl0i
+dYvxlt9p4tXNEhjOD7a2iIoDu47hb0NvZcVb6jusCsfbJGWRf3sX1C8w/+4mR/lGoAOV+bSZ1oSP
+uxEmSaHHqZIw0LlSyFtCdsCYoHAuIxR3z2D1h7junogqSjRUDBURIxJTvmYYwqujpS8hHArpQMgj
+wAqA7DjkW4qhUoDXDehsWbNabzPrnEuJVwsdqBKyhN1KP+RsaEo7jDbU3B/EH8vQ8vlr/puUxZUQ
+NnPu5wNMV5jSLTmIzObopoBlV/50NYUTXTA5FGpTLh2nLuk4dUrH1HBVNlY0vhF1OIZIg6p6IPfF
+KyVF5ydraQDgT9fY/B08UZldWBcTsBbXNYxTpVcwttX7aS1f+8n7CTPJafxzbeIfwjgPV8LQgKOF
+GMdJKd1YB9aC3puYJ18INhao0YHJ+YGhGNIYThQOzuJmdAQlRWlJ8NKnwB5jCm1wKiuBJSobgzhC
+x9Q0NnUIGvPPA29z1U+1KC0To3ODNEYxVVif9dgQOPsLpAWXYQWvS2U2J8j8N0imozjFXOhMQBvq
+RtXAxBNRJfFb0CN6SsIiHITbnHYiCqJI1Bbtb15w3sCkqx0YY2CxxMjEDcONwCaa8PUQ3Rx8hmze
+kETWPaJ8kwkB4J5v9NXhXQ2AVH5nd8FALN0AigbhxWQSceXnEDjeFZpssx+2+bS56UgZlGtt1gvd
+Gm4+/g42R/xmzzdjW1Q0zUztqWBFR5oVPccgcv2KSzLno8QGDfkfsZEIA+zmx7Ou55g7bwnWdbzH
+8/lOJk45jzQEP56BXcDzrVEGScFM7iKnNF5d3UH8NZ5MEEGk3O4kkXWK0NzLgclhrNCRzNmmYEYF
+n6f4P0yxIE0tF6zXH80xAPR4KdF2B+8Vxz6MdXF9RbGjBrA5QOBGUdfAQ6Z6oEEhlYkT+RrfrJMO
+BDjcUcSz1Ci25GyQdb9KNQwgsks8OtiHQEGMYwfS9AFgDkMOjujDjKoVBMoM/+kJCB+GEq/oGBY3
+vjbkBLzU1UhpBAu9pzSfBAuUABd+q2gEUkWEFjh7MOJuNsD4cgA+SVGgHmQ6/Z6e7BXgvP+ejI9A
+qhdYP3h9IyHVHBuprXj/jV3EmuNO884riLw0wFtwCd+K9dyQvGWSypDZKsRCbUFwfESq1JrsUfD5
+bIAW7L3G2Y0IiwDHbzwgtRu5BpNiyTOjgKEqaRxS/GsD6B2yFE1yiUPlSwlvGzE7KUQvOxpEgovQ
+EPUAhVjrduFaNFMgXHQOTYkwmiERjudJhBZQLBAIJa8HKzNz92+UrGhBppQVlcGRIs2AnG8w3V+P
+9f0KobHiU7Ia84BgNRRn8kJTnUsXwcH9JjuQZn8h05eEj7YooTkGoztRZ7y9icjXMCdLwvbmDqOs
+5BFQuuldSBKQ6P5i3Im71xdESQAFQNHiKWB8Y7/GCZJmD3dmqHfmQu7MuKP2JnBJ9BEil16HbGrU
+paTCxIiD6XwiFwkQwxpn4xKOJzDpjZ/YT5486/zsQq3hELBSXF7FsZBzAOUNYmH4wyPZexi8jkAL
+2TbYDcI+/eUUPRTKbzBT0YNxeZSGJrXOdmYSTHG021J5MxLg1lWH2miSLdoXtjKQ6iXOlxEZx1CN
+pJJaDBBmZ9WhnRt4Ztyh8xmxSzgrXTgt3M0iKDoiVCK95jyXVcMPRIWu1Rft+mKw6zR7y83DD6Bi
+e3oX3UIFSIAkwbd9v4PEJWgWq6tuxCHQBiF9WKgu/jMKS1gtHKewHt3c5heuixBDih9IdEB6l8Rw
+IEBjXwrlItViwsVBHSHPowOdkBJye2tmn1V+iDSciY/Ya9ABAWaLYppvBZviUHF9MUfncKiHhM+H
+RC0Re2OsIXkHzP3mm1FIrvMFtIrBMNG0rUDTNqA4T0LFFlLGrY6mHYo6m0TkKumVfd435zL4tPca
+SSnVEBhFKS4K2FaKq4SOaN1Obeipo1lz/EET9m0tWqdN3PDRrvqPZuKTzixZVmdGX5ACgK/pXb5d
++b2ZCIqacOMzuZ23+n7Fvjphi2Bqr7nStan24xH0QTcuSe3GJVmK217p2jrsJyNAhqPQLPIZzsU/
+X1tTUFEZw+0WQdusa4lxp712HpxzdwJAamPrCgOr+CJfDhHFDq57BxAI4eP5TfpBn2q2Z9Y8RwQf
+IHSedyodNwBOZn42+5XsmxNzhC9CENYlk7n9cIIXb0Wfy1qm+IVe58oMmvDXTIaHgPTRN2ip5qAf
+mxamYVZVOS3ui3DX1iOVDZYmmVqTBFo0Z+A2ctN25al9qWuM0xwlF0N+R/9W2XVGPdING6dYbaLu
+9uz8wFKIGfR+dZWuFTvzaJG029LUSARo+2ucYVWx1JR2ccbA/b27EDt38wsgRtI6ghxU/BimRz8W
+cvIwrUFOsRhybmlgsPyF5E0dR2TDt/viYWjISrnQEFf75nf3ACrQpgFEyTkFmgSvrqI58Imv8QMa
+A59YI1FfNJHvp5ukAQypsxm02w8IWW4HD3fox8Ngw3+yIcLGhAlIBxt72RP4//q6D1/n8VKDxYgX
+UdiMHj/e9teSoMJxGyEmbEsvjeIw6Zc1BX56JCDy+Kcx7PYoVheD0IK9u/LEFfLEIaw5T5z93Y8+
+cb1sfLbwxFkDuBN76W7e5DFXnP6ECJzbyAk20zteJnzDjUDKbwRScSMASMVPf+CNAEY8ucuFwPyb
+AHUNpMRfpq7jfhqnirXriY71zY3CO6bonJ9YehGin35nCbU0yWyI2AwJiTSecbO7aMsmkxITv08m
+xC4Cp7b1kP/d3hTPW/wvFyHgx6588Uj8FeVbD8R78fwIXSq61o12c1c0Rvx+l8J4xM3F2mEYXr66
+SvBwB4SehZFJcekqHmGsy/E0qWy7PBf1XQfBGT+JLvnSyZbmtoO3GcbXnC2di0Rkr11Dlf07PknL
+WxLwB5aAP7ZYYYXCEKostpniT3F+cAETL/MgTo3RRqKxsLAs1wa2hDCWWSUxcDOhV4RdTPxB6FUE
+6C9813b57A+CzWZkX1K7x+syzGpYPa6sSPigiw4CnZGhpyP2FREi3Q/lpOjE5ZE+gtwSQyj/vI4H
+PLqrgnriZ1up+zX2ERGbUXTsR2jsnt7Iu1RSC3GD0mW0bEojwsWFIQ0ZxBVxraVDXdDY/Ds3q3gC
+mK5q5dbSA/KalRjKPHi3RIWGZwLNn0KSo5H8yuPOE7zcJmFax7FW55mWiNLhiMDlP/ki2kds+/3U
+TGYR3ZxXa6FhsAx/r+2T0D1FgoCOdm/YL/ENPye92N1XUMC/WsgVb0UtJDxrYwLueyTi6U4mnoyj
+653wEYA4G86tJgLu8j3DPRLaRWG7PdJfW2F+rTZ810pSu8rBaATLSAXCARhzHogiAA36LUVjCfuS
+F0M7hpXHFL8suljznngGpEs4OqdBVaHImwtFssWfnND009q5hB8nRJ3XYEIseKc5FzJErW85rJaj
+gAw3E/F5IctmrZLWS3Gzo4of8QxDgu4gK2KyJDAtyVSoHY2eLo2Ynw4ztioN5XRXOhAJK4SqA1Es
+XPuYNIlx3pEby+S80thYymoOcZ7BOC00AF6xVEFPNpB3IQszXyi6kuVM4+rsq2EBtoQZFWeSj09Y
+cy6fKU/k47ayx1FeUsTcmjfwlhrSvFRGN09sxXMafy9nwC2st7/Fho/bagu0QCyMZbr9nSuZSovT
+2Ys4Fa1yJaZAuucx2m6dY2IOLvf5wUiQLI6vlJSml1xx8VMT6DYU8/ZSJJwiHneRr8DiSmvt5apt
++pavQrhlChN+kNRgpyBhES0OKw4Jp5ov43NZb+PNL7+DMedcO/Wrq7+KgIfWRkTMPq3cHB7vsp8e
+7b98abhGcC+gJAWIHQGS41kJxIUb3XErP9mqifEiUXx7d4ndmLeADuPTdXUg62I3JTlQ0KNNNqyl
+1nj3qXSjFfmGCPHFocoK4ytfSe4PEdtzUPm8hJvE7NfNWCiv2e7O5gNywzfrblCVMNYhInmUrpy8
+zYWjuLDnEYFz/7XhcVRG5kxoG5/rvSBnoZxRSPTG2U0Zy0QXkTBrIGMh2BOP8k3QTxzghsd9MnKH
+A4Jx7v3cvD4I2yw3rSB4lGFd9DjXOcb9I7I3VI7OCrDI/fhrHN5iFpCng0HNhVWQT2R4q05kUMbz
+5ooIRDlmZ+ImDyJ3Be1zzl3QVPZlnVNORZFT+eO4A2Vac6BEL+AMI4jiMF1BLkMBUtjVhuFtSzwn
+iTd28JgGutPuWWk8tBWSVNcqgBbJRjiwm1fhwyyP0Zhmyvbj2XF62Nt4YQiTL3FIIMpewmZsBN6/
+ACjYA/wbeewh/j3z2CP8W3qsTRVS+NHGH5fwYxN/nMOPLfwBssQmfQwc0dZ28NO//uX9xB5RHfgJ
+lajWG/i1S02dQh+bW/T+Ff2k9++8KXsTh8fejce8f8L/b0Bag1/834Mj+BeTYHpv6R9ozcMf9DfD
+H/TPW6x1jsnN3vJ/nz8HDv99bIam5SFvA/HTxwcjWO69+9o/911c09aV4bzwwCx+HGLEsjz0rjFb
+6aY2iBGxLPDVGF9tGzeq8HJ789H2o90Hm492Kvle0L66McoS6/6AujdNri6Is8s5d7Jx/Vz8h/Sx
+3gfs76HgbDFUBxBZfYMFO5+ulyqZpOHnd0jTN6NRxNVoFCLtI09tGF038dqNl00mmzKXKU9/K3QW
+sf1sJAb7moxepuSBjP3oJ10Fb/FfxZfxIKQIpzwzL3rPyXIf4/Maz0bKMSsjzOyrDxW8UuU05vEB
+gW5wdNFMZyW2VfgjCRF/sEKSGHQY9pmwTk+UqLfpk4mY00w9UcHDfdYcUKpyK4iGzgOToDvuW+Ek
+PLBymrFCGLJYpXhTwdB9OSyM/C/GLsMWUn2VE42/y/LyN5gx7o34aUYRSeNPeOcu2AP6PZk83JAQ
+cBifF7T1+EN/l2boNxCVrwHI6bV+1JXQWz9OKezxFU/arh51JVS9w1rj8oTAQBLtNcrwnkXAUJZ3
+499RUY8DMp50WyIsI5wcRUZ0Ymf1tqN/Bt8eMEVKn7Pry+Q7OhehlXdQKGR5pBPpNoyhTngRWg0/
+08WUskUd8NeCMzITqtq5KtF4GO0QgE0tsJuBzkwSPR7s+WTpLOxQJM8CaDlCa55csToRNjMQrjeS
+b024aTL90eEq8HPuvdAs1kKQA+FfFay70EN/Fpu5cSnJqDVyiYb+y4yY8sqMLiSDQT2ygzj9bNeR
+4Zvam7uTSXu3rZN5AKpeXUU6xuPuIhlboQZ3Hmxtb8t6iPS34Be8+hJjTomdrV3VJo/CoPt+bvZN
+A5FfSt4T/hKLqr/5O7ZuL8LnwlEXb3tovmLxmnknDaBwe5u386gt/sp2N9UP9IPFrrd2ZLB10ueX
+mFHAn0yeo38qb730hdsxTlvUyfTYPtj+pjY7XOqUYk9oOXdXV1NY2N2t9qPV1XKtrWQB4vbsb/HO
+4ElIgZ1Evo6t7a1Oe2Nz+7+a6Tq15q/l6zzyEy26mXr7L2Od7//3v9L/atyXweR1pY+1UA58Itxb
+YYON+Dk8R1uTPjfw5lw6Q8cKdhH+jAbrtAQbKJmNxUbiuo1J7uTG+LZRvtigsdigsdigsdygsdyg
+sdgf/Cu/3BTPqqJoalc0tSsrPBJ/xXtS0eB72fUu/1uPr8dBSgw2njY/CHlWCkHcF30ySch/ZRBu
+7A3UNu51n9Bx2O0M1sLNYLC2xnOR/IxXadTQQF1BNXb2LsKL1VWAbjTYLdB2sqtdApdqmIcmMJo+
+R9VTD69K0GpysD5cbz+BEwHoAidzPFxrn8DeDbRenoY2f0jUFLTpbE0qE88nk34nfwJgTZDX2QmS
+ziaZx+0Em0G/sx1sBSsXsGqTCcZqN94ZyOFebCYzB3F9PLRy3KEsgiewInps1kjhT573U+D99JNH
+5l421ZXe129iI9HuZPI+5uej1Bvkapfn3MXGPfgF/+7xnOsKGRt8Yu4TWVEaBWIZkH1TdSr5sqkC
+296Q92z0vB5RsG00glNM3xPUHeRPQqNoT0Tf/4iaq4EiDnCorSzDcrtcbKKME80FdNf1SP443cuV
+Ut++G8lPlBq8NMyW5H3rlIf2wqkZK8os/gQwB48SiwH3G+1AxuWgnACNTZkKAJdeB5b+CUNKw1b7
+tBtUc0vWnHhrn7gmTyakfhE3X2MJkG9ed1vWfTKj7gyOOrzf/FeKkfUBuYJQRYnZhdWK5hJ8StEO
+wFZ0ikBnpGoNoqJE4eA6LNiveG0gGWY4ciUFrsavKeHHxslkAgdO/CZoK7hmAhkZEZF+T+DtkOJ9
+jUMM8YViEX03xkBX0Vo4WGvC6cuhOSrteF5AY1yDEYyRD0rCXDmrTikUkF6mHbFMeAJMnCn5Kgw4
+4Xki+PgGCAoKc6USc2WIuTBhU8rxVYaq9DJ8GR+nyOD/jFfjHYwfgUmcmPwMKSMVAbJBr/A1VHG9
+i+3UjrBZMLA9kbohsKRLgNKsKBK89qF4/EEj4UKXMGJpkCSASoemQRo/WbHzOE6Tso4f0EzVFhk5
+WdSY1pqoyqpX2cTtFKV+x1vzgpQ2Yh3hF8r1CF4YFNzVVSc2Q/YFBr/0a6wSkHsyl52CIOX1sGew
+xAjOjeP/bpwQGG+IeOzofOl5AEcGnAGIi6xX/nr0hGeuSp5EnSQA6ZLrBtc0A4zRekO6ck+0KCaq
+aZpK7WAj8MZgnvmCqNaQiw7MGoAWda4iNfnf3AHHJGeP13s6sljuEGwxIhDTgo/wcZKPMjiSjt2E
+geabf1Kva22GcR3of6TvnkyUQi9ZXVWViKsy66EQi4QVN6lAb4e1UHD/pLUGMohcMRESfLBVrh2o
+DfAT0B+QC4o1UQ1t3VCYGog2sGnv+MSAsV9il10QsH8iXDNCAiwVCoKWFBdUkL+vJaZkL5L358DD
+ZMfRiW/lcx/o3O0465WB9dalEy3NXMFmdfG9kQveeCBCjkcg7wxk5u+BnfFdONFXSvF8yxeot1Dm
+GsyoSC2jnYxUvBxT5RMcgplARofofi7WnOKC7Stlhm4R0KLxCOh3bHgkvK1/gBk2LESHV74D4xpb
+3gvnKhiiuLseY1xBGvhPGIzBaPN4fEIdTwXApNoYW9FxBTx/VqQH5NgMl3NqIGe/cJPIFYwcJH9v
+cH02WhbIlRAQi3lY9lLCKgZv83jD5n5K/j15uYWuuGtjBHRRrJMjjJEbRFHrnBNlsmjQCgVFuTEH
+BqvET4H911fgAKibIggSv6wrn2zwOG9oJnSO9+i2UuJ4hKaztCLef+Xx+am3NlKbCyM6x+vK+jd0
+pVQrRecO5py1n3Lze1N7JxZWBTxZsS8kbDQp7QEBWZ4LR65+JYIgcdQcFUklmd+nn03D2selVapW
+N0oMp0pVZsG2LOWX1ZSq/oxnW+VXZao/38Du/Rp27xKPIhDtCBBt10C0A4x4GPYB/Vfw/8CB/xMU
+t1gd7RMcAGaYAz0SoQtczsf4BAV7foe4CN3DmKG9gP40gMXsSnzP0NfDnJEaHlGb8fLNB7z5AKjJ
+aC1UHfiSopzL8w1r6N1OPWLuRRnydeeqp9BbFfC+JtsIqjBYs0rFXRorR8IK/HGzB2Qzu4rFfJxh
+epq2T2Tew7UecO2axxp08WtqVPkbstxBah+FXWBAlyD5EZH6P2XcRKTcbb2k5j6Kvml3FDVea9rD
+oDjjHrJ9gVd7hywhfi16S3RvwAEVtf0Yy/3wboEGrHn2jszYD+hgTbcjT64DbUq0Yk0UzQWsaw5A
+YQlhwg5nwSjkkACIQHBlPwRCcI8Lg5XjwJDXgAHYO9hYe1+j2axcStKOZOXUBhssXCRZOMHA0Xvc
+E4I4NFBNEOJm7ibAW5U1K9TGHXtryZp38k0bd2tuHJ8O5QIZ+9abDymPodkT22raVlvXUfqNhYQt
+EzqynuN28aL5MmvgmGBwwEAYGG919R5XodHe48p2/elM/MjxbSpiLjV5ECR6g7kpTUvCtuDTfK0L
+WEFdwP+72fYQm1c+xQBKg8B1FYUuKUqWaj8EsWwF68puMIkr8VZocCj3a2DuguaSdGaIWhzC4xPu
+LY8g+7swK0YXAVS3RDUFS2kyJjzlzXF0nJ+c+Htl7cZDRfJPzSQTdd7eDB/ksD+IfRlVBghoqXOD
+ATu03g7NdMcYxCGXmXgU7iiFVY3P7Oi0sW8Ec45rRBkHijJXqULR6tqVq8jYcRVJn6dEte3Y8n9Y
+9ycNQ49Yy9r3XEXQRMiAfYXdTQpgoIfZJbcy+VKs47vGdqvxAUYo6pVYD+SROOoxjMbS7XMDFTgu
+0XmMQTeEMNBC/QLuQ1yGfVaW4QXLy/CKpWX4hWVl+JJFZXiPJWX4FRYog+YK9fMp4JpBGd4iqDlN
+NXiyyKYw/vH3cnUJ+U9hL8LvTWJtr2KgxFTnrBYo8RbwVTplyCpxgpezDfJpQvamk3NqRvk2PG86
+FaxHCbisC6MU2YCfMUp9G7xlw2gUnBHGDA4YZVAKPrAiLoOPTOWBDN4xZOeCa5akZfCUUazj4JBh
+yqvgOdT+K7iBFcmDyykblSHsrIcL/ApWB/AtLhLGQrBfwLKJd/jLZ339+tkYU096OHCPnBOAp+zB
+2FGWDeKSHdH1ZFCW7PnTl6+Onj4/OD3af3Hw+mmQlwxDh8tHkML23x6qt1nJnh08f/rh1XtZEpXc
+wibhf9EgqCgZ7eSgZBgC/+AaUSrmWhiXrCThulsyOYlgpH+TNRF/xAkE/RLtZDBetDbnAGoo+Pmw
+BOKHB56TLzJQFduP6hyMkV0I3TXWDG+TXpDSIONegL7FvAqmGFIOUlCPQ0gkWwBkrn/nQEFbvAUK
++SFfTAEgwzh8ws1M6GawdXoaF6+z3ngQd5p++CSWUnNAT4ZhFcijt7AVU5+VUwbPIUE+NKYCFpM1
+kZ+3MqhKnl/4M6af7tDR6BR7a4eKvgB4xGWYYoK81kXl0qGGKS8G2Vk0eN9PlKOmLqGwgVJzDc+T
+CZ5PiWGanvHK85sykmDs7ugKMG52JTvhT6iohEFmciUWWEIJ+RoVvHDQaR+8seQEtPHY0c3wLAOu
+mf9VBkDvkSLPWsZ6XXbLA217fG8xae6sjz0NAp78DCgobUA67OFA0aAyKvt01Y+Xo8mgl8cpOd/I
+B3gF/L3EcLcSVpsIRjDPgozMEljSPYQl2HcTzq5SkUU0pi9bOdIurBez25/HZQmHEqslOdsH5IQ/
+M/o5iEZFTM/n+ExegHFOBXnOXqLJIT0McvYqSb/S727ODrMr+hkBzxljmA56GufsfXxdPs3jiJ6L
+XAyqlIMq+aDgIPxaZClHTpzxLuPh82TAhxKlzPEam+YjN18/z/IhlZZm6SmF0qfy1CoXojYfnvWG
+wyufh/WC22/xJZGrDEx6c+fB5u4jn1SvKWlPoay9vQ1MFiuoLCFBvLn5cHNzE511sGyAeqG8ubWz
+u/kA7ySxrIsBXaDeg91HDzGwDJadY5goaK/dfgh9DKms57MLKMNquz67pLILjLsF325vPoBvz6js
+xmdXOL6HWxvw7QGVXfnsmtd7CGM5pbJrnx1B2aMHuxvQ3lMqO8K09TC+Rxs45n0q+wqQD2Vo77aN
+maPxkwcPHmGO6Ly5vbX1aMtnb6jmS0z4DCPcfLAFr9/hzweYnuUQfj3c3H60iUloseKhz55hN9uP
+dncx1yTOfmcDWv8ZWwe5c1MSs+d4euK97iAqisbft8r1BFAmJw/x3iAGjj1UKVGk+LohbVAxbYt8
+ieyz+QBEYU/HYeg3fbKtYrdAy0tMSsdGg/EF8EUBnFvx8y3ROCQnrLgBhmkYiOD9VHSe4r9dGWCV
+yvIsK1+mCF78K2z7nWgXiBIwDIDFjkRbQAcBRs+ya3pVCoMuwOq8QjiEMcbc9uhUlfoKNSIil4Z0
+WR5jZIbnwJaDOPCScm/9euQL8zEcBdqe2O2zqriKqwu4CRr4uXW64zdz/0RsTRa+av0J5O/w4NmH
+P06fHXx8//btq6PTgz/eH7w5egmsxf7b1+/eHh2cnk4mX1p/7UuEtsG+tF6/96mDrEmPw57fbLVa
+6NU6lXmi+SzOxoAd+dCaqJIUllQX6NyT85mIffGnOAmcdNM3qRZfiKn6JuZzKsOVZg1m2k6YaZ+A
+7Gw+CkvwD2LB9eo1xQBtUPH3Poo150sM3AVqWqvT8/dEYkWzbr0H3/Xp1HqiCcauCc46FNYEN0D+
+0GDBV7TVS4oR0neQ86qv6CdA0x4fl4bm8BKAFSFafHCoD0JTA/anPBqN4t7TtPczfvqUwA+NNWfV
+4CQnywtMQWmtjnFacEDv++P0K6raVPHz1Oh4nx/bJl5NiUXNY1rIw7g3BiEC1vXUWHkLqoxpTqtT
+44gJz49k/2ghVFNB5czJtQyMwy3LKkebhq2QC9bXW6Vf+DLXifqI1iOYtXNMrwa1mWsEI4p9lfOT
+Wnw5DN7ASh7GEdDMt2KHOci2DHRHUtz01Fxr+2jyLwT+nDrqKdzqqj8t9AfK+NmqEuLBt7dUUA2N
+GqTMKGvIHRAtmeia1QJDEPXJw1PaiDFun68Wv0kJoID5K48xMm09/6nBm38XEUO2/GWLwi7Um2h3
+TNQV8Oa4NZGvzFI4lo+Pc/KLJWkLxC+O5+PwHjzQXPYMh0vKC1kG8VTlnJk20X+M232Q9M5f4Krk
++tpmg71vfcCS4Pm0SWTl6Q0SgyYSetgL2oTCx/8gQKAkq3E23vvbVvxrZ7To8pS0fU1mfm79vuk3
+Z+5lEykNMtfYNKZP4/OWwuXtcbpWngTZdMoHorGODcNyjF4hK3hUX+IxiY3tyhF/61nD1QuhRg+P
+UkzEi2gsfvU7QZkcJYwxxjHyUc5AphJcaRQCZuWAKhWrA8ppBLlcqHTm4Tg+4xstEMUGW3/gn7Su
+YECicQU9HXO2ZuNZmAIEyXpZp3kATVK+pixELx92Ss8iyVZ1YMZxklI3rp1FPJHNUOfBn9I5UKua
+mvfQuE9CqWbMAV7FvQI1xNK+qKEz0vajUhRjWjxsRL1rJjE5Cl3x/Wnw/ffV/jfwjKFN2WQiBWwt
+B6PX3VT8M51HDw1Vmtro1N5ou3J1s1NCWsapoE1NfZn4HDb1kY/q35lAkPEtV92ohNZ600u7E8zb
+lqpNT/imU/K2JCRnjFN65kNI7G8jc9NVktgaEgThTKuWI8opt1c8jvaKtTU/OS5ODNxaqJGkiPJs
+4BE08yUJfABJSRWCojkQJJfk3wZCEaqWZsBPORX/TCXNL2r5jzURm7W7s2hbjKtCfBZH/dMKB/bj
+eiKlGt+FSn/P9bm/BSnMbPg8nU4t/ggHxIlbGdpcg6yBxEAMFsGx9Ds0ZmNUHCuXDhkA/hMoqg00
+clYHgfvN1H1UbytHVfWq6VKVpDkPr5DeInMpCaAdiB8okeN81ZkMkDD1+cp9YTiZ75GNJGWpU+cr
+O9njh/4eUm2A5MENT3t7HDXpTKUnciQO2wu6moRPE5g/LjRLEKQ5VFcJXozmgrPEmD1r+01yzUiz
+6HBKjTsVIp3ThVJgrBCnsTgd0p0Cv1KhN0pFWoa32GzwrPXm4NPp+xeHbz+9OT04PGTcsnNlg42i
+G1K4Q6fvWkWcJ9Eg+ZvjE6T/0+l5ksK+ag2tUm+biLYkXuJL69kBbCjKVLFgFIBb5Cc0Q02mZmoQ
+Kipim4Jr8d0zsYbyU83QQm99+9vFwh2JZDQf5YkidQ+S4/kt9cmRgePZr78a/E/jNe8vJnMeev9p
+YL6Hxqg34WxstsCvE5U2w+v2o4SsU3MhwONdBVnLl7Z41fQDpZ8lXJaGTy0uFKFDzsL31QH8yDJ5
+w3przPcvfr7hWNz2o+IVv7jIp3VWftMpDWya0sAmqrQwJ7AJC671W111mjZRngUcAt2nARjeaxqF
+vlZQEOo11rtjKC+YWHJjOunUD4zeO/X1sr6vfQznzPAJ+qi46m9W+zGHtDVL/WNLULcGzecrq/1P
+rRfKeQDFTeKKNAihbZU6ppV3Go1L0IwN8oB3TgYZAc4pW13lfHKnWXkV7lv8MklnIl1otVM0XQ8y
+MtKwmzjOWH4y7yvYHEakvPJOinyTyYyPhY731lKIAtzHe+bBz/mdO203XpDlknRHSmTTSw78l4zQ
+Jho3ePcgYRZrGhRwxqy+Et0XRYpJfEFJExRLOLqA+rZUwvBlmHGlnsXEVJ9huI4iU0Ra8Bo3cFEF
+2vOURqwQz8JGp+YqFJVVKKQWuJCrkMlVwDzjGLonPWHUd/p9q6C2ZmGF2SthVuHwT+NWh2CJhqcq
+aF7T584+2rFL6CJuB9kF0eIiKDEa/HcgkyqzYzAcsclcIEfxo1gwFShU41zSv2sGRVL01VU8R9kg
+bhFn0hRRtKbcCOQT3fE8wCugF3iLs/tws+2zX/Fu58HG1kOf/YYVtrY3N3z2C94CPXwIVf/EX7s7
+D3Z89k+88GnvYFhfvE16+AAvif7AQmgA3scx/n64swm/y5iqbLTRCRl/t3d3MZFmzK+SHkIjGVV5
+9Ahvw6KYbt92djETZ8xv2HB0Bf7ebbexyUFM91EFzGpMLbYf4gVZlxePoXiEdyjNza1HW9AM/mjv
+PEJXIrqQOsc8NmWcgjj3tvVunMcKuVk3VUTei/Eo5j+hV3nR5JXZxcUgPupnV6nH+F0vQVd0k40l
+TxrAR9FFUDK8caaY+S+BP2BJQZ8BXeRMLMZNLBQ3o3Tu3HS+dzQ+K/MYOt5LpSEgWlIIDjnHmMBF
+vTKGF4BOmsee6rvwkK05YSspYX5jLlm6j+4Mg/1B0v0qZ8NlHCEONhGIX5Zvx+VBigYLvWBFX0a1
+Ku+m9fbf57gs/772Yf6xaj5WBLg0FriVRcWWwhYtrvR7xq/iD/kq/pz1bj6iFQAy/8CYVj4Wu4qD
+rn0grAdKhtYCr+Oyn/VQ61Mb6MF13B2X8YxFiPnbl2g5cZHHRcHNEexG6rAhWxNUeRR3NamMaUQw
+sCEfVM7w/Tsss+FP6fgAcqBGFaLSVpn9etSkOFKu98ce2UlwGKsO2Q2l9WHrc3P3Qc84Cea4Z1Sp
+DV1wIFWQRA7Hun7a0zAa1gF4g2fz8wCZxCScMMf2tqfTYUWgI6TDR5CNYOocpegdTc1LnwyWg932
+su7B9ShKMZ5hgInl4xFafaD5Q8JQcoY23ho4qJCFAo6fjfmrYMAA26HFFK3OMCk5KBfBeBpmTfKN
+IbxyNB4Oo/ymyU0huCLCQDUemtZkeXKBkq7RsYf3le76WaUWRumE1f4EfCTpjIzKQFDIKAf+cPiQ
+jTa9hKzwwgrW4zkPT1gfLaQxUmgRk7k064VdBNKx4DHGusUn4QYGZZR212WLwn29F3sMcqV1yuy3
+zzEcYGWEQaUFbhviWogCYCRPyhtahWo/8mVTqlpuTdIyAtLyTO+9hMQ+M7Ys6N4RIKxhBz0mhxAM
+obenY5hdnvwNvcAE4UEP1XzZHPqa6rXEr+Y5A95pMPAooyH7Mh6O3me/xTfBZzqOrXu3fBGn9Iuv
+4/QzMBAFUOoCcG1lbeQL1/IzcfQdH1G565vpVMlTz5Le62yclk15MOVkYhMbGZfvdeIdG5HVSnVp
+7Sbe0w9vyOJT9f8pGQwO426cXMZKc8MHoldDLTCiKZbOGUqJwd80aZNNiEHNpUbtKSylMZN00Uxy
+4LHo8haZI0BnkiMi/J5L/J5qqMpsqIos3imxILlQUx4Y0DOuQGxXQ8xIgcG56xD0Zx2CnvtcDTVV
+umA21b1kJjm7sW7/gzMTgV/V8PsBs5nIa4YHSz6dMuuYBUfMYE2Cp8zicoKvaNe07+Azv4RnNkp9
+MxtgCBnTNbXfREMxyyYJN/WNvamF2J/Yjd/4W0Jvnge0apTH6ETQC95wHK5LPB9d1VyNGHWwnZX2
+3SHJQZ74CJYmZd8IjD8UAutn1OCbay9ZlUmZx2RL5uqtiGh8MIhRDG1+YZroBO/dp0uvhSEn8d6M
+AmYJBvy1VcRMzl6+1yXMElzke6OIKYZbvhSP1ZOrT2r1HM8/XdWTWj/L88/uPLxAJxeoEPHR5xj4
+lgsrRAE8dmtC38qG3ge6lLHBDiPy1KENoym5wQ3xvJCU+6akrKVkGOkrmqtA7dZE4to6lA4UlANX
+BUc1R5iiiKxN7pcteXpAOygeVMHP67c97v32U+NN1uA9NYQxOQVT935iOfvJa/zkG/RHmsRILCdH
+PwvKeepFWP6+cgyghUeLaL4yPffK2JauXHlg3SWZgpGIcK71Bya4WMzFHhfon+E8E34Xg7kezCkq
+q9pbG8xKCwhzG+hSvLjSdbPKAYjIMPbp0fsgwfSpFrtTwAbimFJzUNL+dxCmTQ/71aYytTX2eskl
+ADItJ8Y9CKAgGmQX6+Nrb8oW18bQjD0YCtX3l/hgmPWiwbKt88p3G5HsYD1J0zi/2yf9OOq5v+lv
+CYj3nl5GyYCcHyNB2YSQ45j9GbkPQGd0X6keja4J+tZpAB7iUo1F6Y1jJMXlBTRIiR8Db3PDY/04
+ueiX9NtRfYzAfdvP4/PA+4eA9esB0LAXZhHZQN1lpWQ87Smj26iwaJH25Sj+q+nrqyn4f+HAHwN2
++zVGek1AzXoadoN4wdGYf6wqB4dmRdZdU44vhm58YXHIFscSE4PxLhuNR6hDFPtTPb9OJaI4ewpA
+qBHPRK+LlxsbWOc2K06oVPClv4k73lmZKtiMG5htFHi0oFI8TsULPSsXsI2iVAG+/NYF6d8OlTDg
+f6gx/kMPSwPpjBq4u3hjUm0842RDbfrFgk3nUpyF9WtgZgPWvP0nJ3TJAFtYGS2/Wgasv8/UijbR
+BcYAmPjnMtU4O+tUp5gA/yd2jfvNVelS5Nuc98oK1w0IXYDfKuCvAdv4vk5HqtP0A359wdf18gcQ
+XzELoTHGQDrZCGl8dBHxmxuipQovWgcNRfimTX7r59elqlzyGFmn9/QUj4+CQsc7DblelCfROtCI
+eODVGmrwzmY0Jd/WT6fkrPFh+n/M+ZOwcLMYFuZc5GQpbtl+P0ovYgEKuJOYlwT3XBqcK60It9ut
+argL0pZiSwqcQEK9RN0RZx4FNJknvQJR+ox8Ssr+O8xOU5TcE8X0/Kl0DMyJcbOybM8W2cstFMJN
+YrQ5sDKY8zEMO7+m9CuLcmqZq8kvmjGsFSUm5cZ+qLflw61PsHYnJTnjb1xLN6/MTIX91MU5m+tS
+2igoryDmKnvgIMRRmDeJdKEfpMddDb0Mm9kkYkx+hh738/RoUo1xmFrIknXDwmnAuLIyJn0JBhlj
+o2oltIQTHVFYC9KsICPoYfXzOdXDWvU7sgxd6Y0KZ39lZUTYvk4oPZDUhh6SEn7RIFQR6hhx3m4k
+hjhS0662E3F2Dqgk95gHuY1ObnXvzGMu8ZkuYXrJg3F1X30T8tmSqwBY2WCe+CpgkkX60XEwpaZc
+AxidM7uSbaqgYuPQT5n3ip48P3C0ynl/vqwW71/vQ/NnMOC5DNei0TZw8j3MIu+UKrx9OtsEiJgs
+zbkifFmJ+Vu84kU3G8XrcHZd5GYkecgjrMUT9GD4EjTkvsijtKTEqiMeywTJYC85P49RLdEYYKy3
+gmLMdLuUlypr9KIyaiCxjPvR4FwmzcH8OdBo3mocRN1+4+m7l5Q4ohfDKKE/TGWV5ZRbpEGDLVou
+RlYNFb9HbVqSxwW1f56hQgfzUYjPGxxCRcgPaB/oYDZuXOF81MTgx9FVdHER540PL1sY/kcITHc4
+/ZZA5TqBYjvoFNaXH7hF62xVjilJSfp0cSZPUfazJUSmu5z71DznmX3OIwf+JnwdjZLf4hsi7eQh
+DixbVCRdXkA4eyCqj+21k/GQxzyasGjICwahY5HcSMweoT362kTlJKciSxmFzeWDdXVa/Hs6ldEN
+6h3agOJ9EBnKpLzS0LS3gQvY8DAM5Hzag219vndbTtdR5/55yjTsXC0BO9xmu3qJJzaRDr9IoUwF
+Q8AA0QUixFQWFdk45wm5lyWR1GXhlKfF4U+ZSAoxTyDONft78J3sb431VZtqMVW0DiVIUnHJ1S2w
+Cqa9OXFVMooGBpCwWUQ0MORc3p5ir3MJelUhVippuW2NbWdR+ZLxDjNyFBH1NQNvEfbSJY+R1aO4
+9YmZR615J1WtKiNPS4lrFqlQqWs3PgE5+yXPFsZIQ3qYXXkYYwJ+7mcIbV0pipORooesHRS8jvKv
+PTI1Q67xHIt+pUsmvMPgeh1ERP3qyrEe4fo4zND8gNs9mjoyct0kSMYuX/Y8ny7jF8Czmyr3t2e9
+wUhq4l2kTDVwkZAief/7fzU5ZvS92qfn7JYuFY89h1bDY9EJMvV9B3fZ361pjnpOTsY54hG75Sc7
+yOWdZNHNkxG/K536SzekKTqd/oY3d3HslfmWXl6mS/aRpHfrgSsURC8feYAdWPnOvK68xn/RfxoO
+1nTs7ieRHGsJKM3T5EUqIBSznj3PumNuHie4mjLs1dXA5Uyupcsk9mecIJmq2uv/EEqV2JJjjHSq
+MKzEgfxqlmrtZWjULwInaHWERH+IXm0tgY2xZA/z8CxG9cuxYh3hpky1JHrs3Mra0DvGPKki4MjU
+ZEt1Sh0BUywugYBLjYAxAsHdkDAfyjJM3Tci4QrG5WjYwsxuJKyWVWHj6A7YOPtObOz9jAygfV/k
+uTDePESb/ZsQ7fkPQ7QWgvogIXMJHOWxPv77rShKSGm9wJO/PA6Jntz15dHYN831HSCsqyzv/bvw
+MY4Sjxl6AgVeGl+tj0SPcqL6mS+MfnZP/AejbOXicRpru7j4OsIho3TTz64+CjRbwRi2HW2MiSKq
+rBYhi35y0R+gRhtDVGtmv+wswe7zcQC/XzoAvN5AEXNfElcjp6fy7TL3ytWP9P2yd8BfNZ6ZY5nN
+VThUbuLQlu5Dy6V4NBQsMRJgUyD1/+npfuSjcAG5CQmCwJFX1bu/0T2OJinmgPPjSgp+H4WU6Yg8
+UB5SnLSn3APlKJYGNF+XczWRSmjXnRW3WjFZjNMs5UTOs3Mr8Tuoo5u07Mdl0hWnLw9X2t/j6OR0
+OBV+EWIcSMTtEgwW6BhK7eYEpvIsG6rZxDxgiqNL0xtD9KGDASjRlPt1xD0RwgvDzqCTeFnmydkY
+GCa5j5zFOZ0/WrSDmtbdL/a51ZKAK9uNQcAh3uN2rWq/EcqqCtRcg08KBcBphAdb50leoElUJt4b
+eYYmk2wyeRqT2E23j7NNlOXMYCBqTDYDNiNLgmErpYaCtlowPgrFXF253Lly6LdyBztmx1rpYUvX
+0tw2XJbvKd4q4pnS12PP1dhL+D1r7OXssVeu6OfvK0sKwi6vs15ynsTcy0s+0BtluYOsNJCkV0jD
+q7dGy2uRxGjWObgDlss6M9RFcz7TyJJzFBpXFgEyCW7uQ3Q5r+H1mFeucgDmWRdoNl1dzTve6enr
+t89ePn958Oz09OPTVx8OTk+9oEQD4SlL6zPLRoJqiFCvrs9hMsdyBxpU70ROqeLDPkuvrXrhalLh
+4TVlUvcnDJnRTQOV2AYnI602v9atNhUcvYGT/joa4UFm6qw6gnbUXeUxI7vyGI0pgPte/jjey9fW
+fIq1ovG3jskknUEH2UXTe3bw84dfgobcbHmVEPH8PBdwPFOKqi+G1eiKPMgeOpuW/tRxBGhhDcDG
+QyQErv0YA3O8bL1KirKVFPgHIwkYJFYRy7dL+mXKsDRCAPeF64VFV2Qom+dZLhA2wiocim4FZXcr
+b52GG4ZwfRyfWCbxeH/BQww3a8StmDuIePYY3MpKOkRGm6o+Zg2c17k1dRGXTJrhzp0axUFH03Mj
+lWzVIiI+cdH1pBAzRmmM5O0jGMTTQgAN58ZcG6Hqc7Y9nr0SNVosJG5KNlwZjpTHYTEMwm0uxa1J
+cErhYGv1uh83m7m5QGjV4NAe+47OHQN1QYBgE2JX92rSlYng5pv1HSyWfdRNrjGeyTV+D8tY4UFS
+Nh71AFj4jmbMvdMRQ5n5RVQc9BJg4dC7NkhgK6S2DHNW4if8Nf+mwPfzznoTlTylc9l47Bkn9aDk
+iNIErgn7XpD5yrzz3LytkPwjwYpyXOjmtlKN6Md1RN81EP34yWZnvL4ZbKA2anNv9Hi8NwKE3z0e
+rW+aKH90socxgJ0biui7608XzQPX+RmIwIBv42jI13nAZs0OxLxkMllZiVZXI0x7hC5iQLnrc81W
+V2kpB752sm2YEUtdR9lSRR7HrSqiPAmM0+gCEINhdEIdc802nzlbo7kasJpn0N0ZJl2fmp6FyBF/
+SIeCczc+JydztKOpupo/H0QXZM50Z+a6ctpMBpuZJ7V2Bkk54lzcyLl6ydSIcz33WM5CaGqfAR0y
+jPFAilPDKE2rSoU8h26VhJkFR2EL7fiWp80ek7mJCEu6V4ZjkhzM/oAzMZ6CcV0wS+fIDZQ9puJh
+6Vz/1VWsFfE/GB5t+c3fEIZsbk6gfkbcexfPOA+zIB9DTvDzXXWrmUW4DcGTGSta08M5Ic68JnEB
+GQe96qSSmcMvlgXKtOlVqOVMBfzA4OdLB1NsnCsp/9hNz5YSVzCVWYIQUpMtdYjC1VWMKiWBbSYO
+nUwy5Uj2ti6S1Jefkq+IeVnMDqvxqUA9ZcKZ0zdPXx8cvXu6jwSUzYVhshy/nf7HBR979Wk4fJBx
+HoDs0/gsB/RZh+9EsQjg8DLpoW5fCEAmO/N/ytiNMS0YvsiG8YWC6+y0MdXDS663/AJDe4PFDx+0
+N332npe+gdJ3MeWmaGOejENe/A6KX1Pl7Z3dbZ8948WvlTj36rvuUf89JsBGB9rgcZYHHt4maqNp
+Q0tcNwa2XUNtBIZ2wHSXUAkf0uoO4ihv3vLrvoDuCPkdCjf6FGp2/gSnr8q0Y3nND8kYcsq6SquN
+leToo/BWyfBHcX4JEJQQjRd3qwXjVobA9dEVz5h1BwnUhTF2MeDIKCw4fcWEuDzixom0dhsJazd1
+CxTIUPqItnJt3CwvrlCwFPZxygbTC6iE97qfx5jdMYkGhVV+2jVfzOjlqW5Ud0TGnHSZw9uzLkdn
+lZ/SVVpwzlPMedLf9hQ3LOzymyHD7k5mp571RZl9jfG6xON5ajSb3EWbWP6FmGfSC701lZBQnaNm
+15c3zlmL228exr0kBxD8kA904i1gfvq+uTxpC1DRU369rYBvrKCNEjCKe2IyghNWax4TFnCBV+ut
+wQFNOBDzFHRlA2Eg7rUab6m6fQfd6EYp1jmLG3D40RocagKQ7+nl4q2fjvPEPf++mH8PwU8Ethv4
+nV44IO2aqW8aYJLMHubTVqamrKdCT6qglC2C+6N4FMFEspyy1qsB0Tv3SHqtL1mSUljZKTY1DIkT
+zbf8JmL9Z2iSgSNUTZE842xqCCPTMnYLAGow1BBBj+4PRV2M7ukAaJ5t2AXR8o0+FFgC6xWhhPPu
+a5f8UZ5WW/wFLYy1vp3m++GLT5cF9PDHGSn2FCzDN6fdPvDvsOg49xzwhvPdKY/lEB5t7uxSsB98
+/THOkfvJw3hKmK/XI7QeDX4fx/kNz/71DnZtWAQXGB3SCj15wfWFl3tqYS8omKHoH/XBl+FxzLBU
+Bhy8ZPU19vk2e6FHVy848xuJCq2VhRMhbIPP9s7CpPMMiQ6typuhj4meMFuzr9KWNUWMVf6Svrti
+1+HxGawQ73HV80/4T8qDSWGLbsRIb5jX8fwOZmTGentXoUY+uJWdHImnMs94Kd4FtL8/S1tmRKQc
+T9J2K2igfe6YGFW9woqO74OZlTHpF98kxuuQA2DzmklKxtM3DVmuMUvQZ1L9HFwhcds/C0wEBpxt
+U3yuzVBt2pZhGGb4cXB+jvcdl4IvztRlIa+HOGEO9ZekNLJIaeII8kaOBpYNGGmrWU48C7+oJGsv
+dgv0Fv3ZkF9I0f6ghe4FhTSxpbQWq6t6y0vTLIzTaGU2EYnAyMkeR2YYQjOZWT9hx9FJ1Uj3VpD9
+WAqyGKMVBkB95zPbAmbIf7Le5t0PZrSJAvxgZhMDLtKvkPVp/dI1S0murNnVibW8FasWSLc9Js2Q
+eSwkctrLpzWT5KoTH4EDdxMxN25P3S7L3YEhd9zTfInNvTf2Skj0xFgJTIGuHAB0vB/f0hqZ9QpR
+Qe4wLkzg7vX4ZOp2DLyLT6AB7S57wb28yq7O5VVn+/qJ2MMuO3Db2cBmsS1bPmCmUZ0o0wkMwshi
+rdF6kI1RrZLwtVTGWDzqUNd4JVlbfDVoySeqNqpVO4qB6SzNqryEqp8b1SX7+55cQeA198PgMegS
+e4OxMf57MgFOpsYR9oF36Yf9VgGIG1gZF5NS8W0EVpouZgct8YtVF1oATt/g7Jk5oWDElH3nmI2U
+kZnHzJkF53c00rS3tGqtyUeZVDzeBw4jzrFpxNnVRpwjbcRJ5pnStxLWvWLS2XOZdA7rFmAXvKek
+5HH8e7LT26R4+/RoK7ichgPCuDfoDtHR0QrTlz2Ax5RTMXH1LEZ/ZlBoBuRaW8wdUCM3Hafo4ZJT
+TL4NWAbxtUtAcklTlsxFszgNV1aaWe0w+Yt5QUxKaUmFX8MjoFsHq6unnaM1rwHiYb/x7rf9Ay84
+ogyVbmSY22eDfYEBZXb8AKyQYBrLu1vTJj6GOlvBD1+Kqi+5A5wh9WgnOOnf44ugBe9Dl53qd9nn
+JsxrNN/ikm6yhse+Ms93mPD35tvnJie2d7M89nXTif6OstfVW49OA/WPuVksVX5ft/wdLjDdZV+W
+txa+cVRVPg1v8Sg9a4iz1Phw+GqRj8MNykAIe2eTCYGgP699C5qXaT6fxfDLbq9Et/zP9dze36Ma
+gHqd3ykaKouOSXMgO3S1bFrm0FmcMu85/F00sa/Q3hGwYFcdypVet791gnJ3vpXybb8cDoDxF0qD
+U0WJYVBjw077y0I7bQNClUW903J7xIAxPANsFrQ3GIDl1zIbwU+HGWlCyJxh5vHq8Jhp5e0h47dO
+5fMMuw0mdbF19x3XTdEIWLeRYfP95Zttvr9nmWbbfFtLNdsU3Fiq71sd2QVp1TzyLkcy1zBoH8Y7
+4XjurnBm8jrfC2vKbg5X0R61e9GIdZy7cGyhZRznOqdVLCeMo/3FDYiYjutnWe8G2xG3OQ16pvhW
+HOddc2R3ZqG+K9S6rXyZTL7I4LK0qpLldCDGpbddqUZxUOrhP3QgLvgm6jEwp0sILQFLOO9oWCPY
+q2BvvpJGFp4Yc9mN9f7eFS24hKNXlRf8j6ysGMuCJeQCy3xEZElvC5d2BeB1H/43LzRFLf6E06kb
+A72Y0SdcVD7ydEQtId5rPYSYBjCqHhISqaRoYIF/x7b01ykG5xBeOPuCD97X5nCUqWQWV9udGeuh
+vjCk3jrLXMETx+yWT43baKANdU/48t+7PZquy0/hydhx3M7pZ4xbygPYfmFSg3a4SEeFma0IRNSg
+anBgKO5c8SSrR2bJ0c4MbWeuVIIRgpYKYklIZrqQ8RM8VrmYRTQZ9ynlmSMDrWpUDuGy9fIuLlt9
+h8vWt0XQeQOUpI5/zhdFojGueB0RdOYFz/nmplW5HUXHZ3fuYdkwOtJt+ecfGhRP3YjPzcxRiVFL
+GsJDcdFJCUCZKiTGgcosE6I7BMajRcJVoRYb2egMw8FRxKk8G1AovFlB62C14BOPbO9QBfOcjNJ5
+7j5ihgoe7e1bRHklUsuJC/7KGZZ1lMeVsKZJFwaP7oV0XP0p+1uPrSdDMv+IwXHOTTb5DYMDGj9E
+hbQMgfzBDXBFPxsPeur5A9mlmLmp6skHKEiZfLA04ghv9BZ/WG84CNI7/tN66w5tTbXdr6pGbSqg
+diUsbMUOZEYI7bSixMz4wYnkwUlsCzc0Vnk9RqLREy0FhYynfpQmgATLQgZtH0zJnKQbFp2sNbQ+
+wjwWEUv8IDMzW2AJGymdFnwwLkhB2hXKcdQlsL6soIBXpM5hPfkmzcpnINIirJDeZihfxEKpeqEi
+ICGR8tmlfDaA7obyB/d9dhb2jz0Ry3ed5KCTyQSK9kURyT8n7Coste3EzyR2sGsKUqgvQPVdTYhG
+AP3j+MTv4L/8ztQP+kZe4CWoMV+CQYK4FwkXoluQDEEGJFUZiYKocTsN8TL5Wtp4HeFQbfXxVyw6
+tDeSv9nHN11a+yUOdhclKTTlGBgphQY1gviV3coo/N1pnajtG68tMJ7yYGzfFYVNioo4pem8iAQS
+E304fHV3JHTuZCF04/wutyEBxtUBiSLVsfPqxTq9bAySy3i9UuiaVImQMmuFynxWJ7NjfZe9CgcN
+dI3XVlNaxzJxipGwkenRt7dks3/eM7QpHBRu5WJJYv8dp7vMNN1zG7FeZxm4E9+O057GTi41lpJU
+Pxg18fKMe3TfcZj2wg1rIz1SGnIQFDwjDie/iOxAqVUwDRqfA8+bqmIVcAxkCMGDX9R6uWK3AocG
+F0z8oqu5MwYHMThnkr3p23TLpmmy/dNa+89jpjika1ktXV29rNX8G2oqfuVSVBUh0BE3/0UWpLub
+W9L342McHqM/NcjqpF/0RllBylZKO0tJwcT9hsePmUfpq4Em3OPmDR+lF+fHmB0D/KF/24k0Pv30
+A7hhzhKopBnvo4ua09j86NxVs9BaZoyokkMkMXepsJgEMk1XY9lXYVaJmoytlzhQ5BDiSu60OckY
+xlys13XXvbUSE/u8PfuiE1l+12yqa4NLhxY+eQsgtekvo1DQo4MBVRIBdAUwdM0UkCpUH7Jfnnaq
+9zgf5mFkdGksqDMBwr6dULjeFr/lbfqde3HwUXLg2iAnUeY0me++NRmoKIUp6gqy6WedfAZzEkmR
+KpWcYcbXelqRwevBxiuJHh235DwuwsUFhkOQEKBTnvDEdm4Vl045gUlWNPyoRCuYXhj6X3FIzgbT
+8NQKNCpShVWPlBldl4/ncfs7RiQvLQXWeUFZY7e2MZvsr9xA/QWaJUr7+N9MyQQkCcqje/+/m53g
+OFr/+2Qt8Dv/uv+v+/cTI5nwLzG3W5dfdaiRTjMPASHJFsRXiOavEqA3Vy15DYF7VGZAQgDV59PP
+QR6k8VXzV4AnnrW4RbHRS7LRz3Wvf8Y637xtuRbkIdCNb887T7m4pb8jPtB8dBJ0eZR+iUmPJgHo
+N7Sh6phjT8XYzbLq5LECr6an9s9/39Qw+7IYL19AVuthKtIni3pSnfL7UrkbKhiy208GPaiCvj8W
+skxryDKrIMvIRJaJjSwLhSxtaxjSEZjZP8dW9s/uNExUSp5R2DXTX3YpDDgaywyiETHKfXy2xReM
+WObJzGMk8cEz/03mIhdSxKOsabAEkn1kllrxhKfFANlvRmVc6DyNBiBe1r5FSXG5z1DyAPp/wQ2Q
+Pw0wKj3ITeqhYvDpdwDuztigBhFpzTIUuJkzbpdrJRdFKgTy4Ak7CDOV1/JK57Ucg7A2AMpCv5eP
+EnvQ8aRuC8mciNLRSIp1tCvyAtfbWYmDrGQdEc8KfcVWDnwjGvil1Z/dfiPN1nE7PNTPIz6/Evj8
+isgsLe6vuz7Zvgtj5XUAFK7bp9ZK8aCGfzB12bfEQr0xUtnjDjhlpC6yX5GSMNQrIG1kl454J0O8
+FHELJ33Fi19iAKd53/oOJrvOiCTpeXZ6KuGvh/Dn0vPPGdJQ5MBQlt8XvtJdku1SLR8JE+au3unZ
+IIIjOGU3k8mFirc1W4PKk4LEiCTQCwwW1ordgsXrRsbDMikHBIQSN2hyC7BBuKZnFLFZEDYzVYip
+6s/z7Mpj7uQhIptJP+n1COxJ78HO0aqCnCQFMpufY+QAM4jgwq1TX+vjEWYSMUs4utM5R5b8wp23
+6pwsAwHOAZQB0HMVDOb3uueloB3If/J8muRjiYTE81QGvj9mx0SpsYPEVcZGjlyVkzG1MjFmldSL
+kZ1pMalkViyMRIoDzAg3tqmTpUsaWRkVzyt60L5lYt+rGIQOrXyLFxUSemmJQzdWcncJjSCC6oyi
+ZwqVXHFUciCZ7GvkvE9pqY+sxKBfK6kT92cpeL/Ukmm+dGTjfDMNb6Qa9dagasF7ZtKu4B23zI2L
+4HAangqC/Tp8hxTqHYpHrM+FpBqtunTRKo8bcz6DznVGU6Skr8JnXPxRuijA1c85vbwY+c1nDGWg
+HI5miSpg+OLvsK9X94gG2Txg1z77UEmzfcS+nrC/eFPpH9CUEvPDrt3fPSwwevHZJywRqwePL/Cx
+yy9s2K/4YHApv+GzzaX8gkViAaHGn/ioJAy+LFj+T6v8gDTUv9t1ZUwn9geWC6aHtPMHeAYLfgkG
+staoSQ5dr1ZXKYI4Si3aj2zlFS2ycO4pbb27DyyJqCDxAYajQ1GsrmPHqD4UqiAOj2HVT2TUjBh2
+RVusCuNXqrE8l3GmqL66STPy6gZXnc/V1/dur6eSC/kcOF9/RjZBMQUfNEfgoAa/G5lkCSUGN8aB
+tfFVBd/MRyIaFTpu1H81EPTVcjoHPj1hAvVsdfUZ7fdkgmQXOLtnbsnfS4ZI7CQ929ocXStCxx+K
+vBuQB/YDkxtTHQ6yqAcAtB6lyZCTWpjOmcusdts9YiLkp2mWAxuCut3GpyhPUS5oPDOSLLuse2ev
+goHE5mUHXOZ7x3e/KT7tPdkKvO4s37JEqOvIjc0b27LL9RwkV56zpSeV4uwbhwPNvWsZU3eseP0W
+ytnS6emyK2h16Iql/Ae7rXCUbHHvyCUBk2Zxrq/hcL9WfKg6ILW9u4dW7BLtB8+NcyocQS2ioLNM
+P1OWOhQeK74r91Kn1FUi7+Jp5rMxyE+85swE4V2bBZrPxoiFellboD/ZbWUQdbbFJmuBoOeFonL4
+hXzZp1vzoqmClB0wVfOEUiwezecmMIxHpcpFq1bGa+Gvj8iwn4nII7Jq7QXv2Cymjp31lNOm/AAb
+vnR6dKp1Jeu9Q/jfsgZ8EtYlD1E/Vr+I4AjIodU2xYYU6XxK1YO/ZcBgtvJyMlnZn0zyWCixHocb
+MyhHbYCa1q/zDDIN/sdAc947YJhAVutmObrOVtJG6QbElw0U4uBgNEC8TNKWwy5xPDC0uLm8asnn
+RMwcJEZ+H35f3hBGwgun+JI4qX3KILt+kWckdAmW0Jgl7m1tQz+ZKemfLTi4lcNYOaq182YIPsrq
+8I0BaaVrQC/YrT2ISrPycoy9WQI4JRtgJtZb+itPgd+rWlcfmTJyKYJXpqgoEOOhliMXyXvVFZ6J
+/Cq7A+Jbb9yNCVWJnyJ0M1qucI5WVeKHvy8DV70TxUbNGkUx5Y7q3s6U7JAayC2O49XVv9x45J8Y
+/klJBX9V1khfgAoFwB91BYCGWu5ZJNdbPIn9oNCqcmIUjwlvqzDUgohBy9UF5H9ZhnnzwaOHW1s+
+K0u67YhLqUnIy+U0CZIHjy0e3JkOU0eaqXp2Vjj7SM+gavok5lDYWYMHcp/GKKp3KbasEM8A5Ggr
+zy3JHeAuTy4S4FTeGqU9uctm4XAaRkoql/1fTMMuuwyl87Vws/OkFHtDueDk+FRrlCyZneHLqhT5
+Wt4wXrnevuP3kQf4ruqGeo2F+9no5n0GLMzoLIvyHnZEL0/DSxAgVy5bXR41kB2Fp6ureCN5KXJt
+XspIda2kOBiOSrxYexqunE4mR0sLh58VSeTDbVSeQdgbO02bK0pHkYn4M1Zv3Ls9b+Ux7EY3buLt
+2AXz/n//n//vfTRwYFUdZeziRWXnwuLTMPasj+SM3UoIckiBVxVOK58LsIA77sJFiFHajHoJG9K8
+mEwKiZCHGJJmMun7nTvIArJtfRFOLjDUzqyA3f95nWt8Z51rvLzOlT11c0037LaCQUzFtIpUk5l5
+0IF0HHJ3HbozbF76e2ktcliM8Shq870G6QnQKRxROKgI4Nyrv03WOrXaB8KBN5kqepCXs+kBh8Fl
+MT9H7+mS6F2ciuXSky+GRGFIgXHRy+wDsmn7wIY21TTTpaYp6FeG9Gvr0aNtQHQRp1+Zol/JkhO0
+DnZcPdiVFFyGujhX2qeUExhuihFZZAYjTD7TV6xSpKQ4xJykCIIxAEjjERvuNzvhv+779y98HZYo
+bO/FjwdCENiL18JNPyopfpUMiBKzjfoZuzrLBYsd6xi64zA2L2mX38u8U9tNnDeqGLQe0FlFulfR
+7yBzuvfIC72iuqzqQg8OTTS9Dydn+llc7VF0UWGiX5ShDsFC6To1s1W1s8I4L5llAnVwXVJIiOWT
+Kqh53iHtTOWbOYad2kJVa5K9pbxhyPh0PvcvLFpn2GUuslGd8Wam/SjXjcWD3t3MTvEzkYNnkW0p
+dzXKW/Aiv6m4GgnXiOOS5SezfSIyMzksmdmwa56q4xrGIOLVk20iG5TK3cKuEmrLl7yT0+kWf5o+
+Gu1gQI9Z0DV/XelN6c95OULMicMjtDhGtPhw12ddjhPH8O0Iyx5ttx9u++ycF4+guE8YdGt7E4p7
++HvzwdZu22dDXqUHVS6oeGsHTaIuefEFFN/QlzsPN0F2OOPFN1B8RSLF9sOdHYlvDvSCiaQY7DwZ
+xBylGGxbypBmoyBKnENmSo0R60YpUc6EDaL0YoxxBQd6ycfhJaLDyO9ETcHYdMOV9koYDrF8zLzi
+Bg7E9QuZm8vzV1dnvGpFqCMibEYc9Agvqd5iEIHD+ByWGaW0PVnEVUp+k/s/8aRM0pqOxxuhEE8j
+KYS2yOLnTQbMnh18ZGUlbqVQjMa4mB6J1oViEoqjXDRNy3Zf5/nDRBKV/NxxK+r1DjCCE7YQAxqA
+jzMY7lU/Rp9ESpRaFMklT1qE7sHE87haAl4nu4znNUYyq8/giKVscCK3/TzU2QqE3rhkvXhQRv+k
+w4IaMpjN4AVnJlOWnZ8XcSkeM8bfvgdpDkM57aVPMrTNCSkIdv54YzLJ1qInISaEebLh84RSdsyq
+6fK4XKVsW+ceyshijpxZcmqfSpiV1qWFbeBwe0Zril5saC0vKstAKckyVyldAPv1MlvvStnOgdKv
+ylZFAOTsptPvVspcQt/AurVpwiHobWEgbXnSjGN6jhNKmQWMrChvBpShBb7c3/Sbs45WiUpOFBou
+opLSowFaCxa6dLi65M5vewdly+QWw1uFWpQup1WiB6yAyusyPCgFg3ha/hAHSLppkMqlT0L1SIfH
+jNSm1ViAAswveHTB44oPG6t5rp2Qr63dt9S47WtD/lrQOyH8FhhbcQTHC2mryk+kqdatqYZTDK1g
+/u3EqOXqasqDztd6b6octdUGbINkjihxeFqlWNYViXNzMFYUiRHq4ZKK8q+YpbUzBKUxH2tXjnVU
+0UGeWxrKviUP9LgBQ/K7T+GCCcQoKpjhWuGJqGDoJHSo/YwusUz7HYmgYCYYiJmIYBh2IVcxOl4E
+p/aJUC9UMLFIW6nT4H//BQfPEdJVWAkaDWTsG9O6eKeeStqr1TjH//2vq/WT+xdweqfI0I+m9267
+01MFBZ9Rx4U20NNTfrX0eXksrh2mvuWm+S48+aGhJjaW0307U40DcDDTw7/iFixgqFFyIHJYBF4K
+IEcdzL4458EVPVJirWBGY7ZBH780sVwrTdcgtLMQSAQE2oMZaVorCHBJ1329ZUnKhZW8cwevwgt9
+JXFHXMH90is4VyCAGuqdiUSm/pJQMkvZJn0LhfYTMARG9AEaV9qbVPUtRLOZKwY7Qu8uZsif/ze6
+G5o5aI2jt0wHjVmdoFqRRvuKfsibmMVCJya9rAmdsSl0DkDoZMMwBzbVtusKw7KjY7wL6cBDU7wZ
++O5SZhasUitNyPglFghhqHMiEhD00GuHU0a9GEOGbC6Ze4rDM6gdECfbgOZLZ4x7k1n8hsQFMxkS
+y9ewsM8myVuxTFiBMvV5yy57HQ/PgG/oshEzruhQJLZIc1XLU/WJUkrG07qSscZ7cLlaUlXSpnKr
+W795bAbCvP+l4G4HM5AEClgklR+hZLz9AARsnz3lAvMRzOBrydNqPJR6y/3ye9JgnDp2x8oc59w9
+93bb4ToqsYTz+laTAV0pecB4VqN+PUfuexIQDRCwU/0ZwRVMOCodoGONmaVmhB/HiCeTUvr4kdmr
+dBFk6Qmr5+1rqsylQniqJXsyU+9OK1F2XQvmeTMi4tIhz+UhT/mBzfSBjYzjnGgUUOBRH9jnYGye
+tm7loI+sBT13b5l9R9+zNeJJeh7nRzyA73AaQufhSOFlIVtULGIvp2G3KTney46yAY4EmTyT16o6
+MMqVLOK4WumWwnFT0VnVA4V8GJufn9KjnZidHWHhEH4PZIpHDMAwrpoL72OR0ukLI98vWGgz+W+w
+qJoLir03Sj2OCN6xQ2WyvQg8z9kzPnMbPF9XwVObaldAFM2OL3TiBWn/RfsF43kXxp1hMxZqSrH+
+h1BoXB8Z/c4fLpPtnvhBwUPCv1M35KJHaDziad5lSadQGTN4QUCOao3n7G/2IVxps79CALLuYNwD
+8SnqvU0HNxjbTEwMF/Qj/v47FPa1zY/VWfqTicpt8rHDfwYf5ZX+KyOFNU7PhY4oMXQti9ze87Bs
+ctvsuLIfMisH9Ph8dbWJFZ+iFuOVYBLgbwpYvikSAftoIA+TojVTwpW9o6iyhIaqhfQhD8B/+3f4
+Dler1Wr9xcSSfcqBl1RrJieqQUqDy+sTfy+mLmLRJu7CvZDst1ju81TruMgroRAO09DQasMCfC1b
+b9G3C1pJQ48I4ywpwMmUlq400Z4diUAppVKhwzDyAnMdA2FgfkvIs2hcJ37zb/aa/cU+dJ6LhGiA
+E1jXn6uQd3HHDW+tmWD4dumzxmNtZ98ctCL7vjgSd4h3Ufn49FSKXI6oMMImOHLGagbYXl294RoI
+zgXfLOCC5129HCxz9SLCWWCWGR5eXyAklzGEuI+btQicvi2zdKR2rH26Pox7IKtxMfl23uv19Yio
+qHw1gNUO+lN/tuffvCEbDZ+eku05SiyvsXCWhuCL1BAsQNuWsF9b4WqBYno4Uww/0cKglrq9LgIY
+agk+bCKcwDL2O9+9IGKlZQwUkjz5Ti+IYu1xTgfPs4jD1pICoMMGcu7x4qNSCPUbt1l+b2yyJESO
+LX5j5JR85cgpOY+iqXSKcFB7yNk/dQldPOlGbFmw58gfE0BcCz7WkMpEOU0PmGArzXtbnmVxou9N
+Ju+ckXAkQ3t4J15W5JHgFgO/+M13vsxMGdxjDh5C45X6Vr9XKxtwEj9vKSvM2LwxZ8NEZDzVAzjr
+OMyFZBids0pzyhr4onM3yiHVHFcdfmF+hRmlSHr59ovzfamV4Kl0GPZRVcWNOQqfHWDpTdYQbL2K
+xUHi4X5dTFcCkCWQox+sS67leUOnUlp4O+fq/s5aZu7OzeUNCnaIsd6ED18ub+K/0CV5e6vts5dc
+5P8C4vobuiPf2toG8YCXvlFWS+9mWy3d6WKKsL1HHjRF3BNLI2ynzHo8Deg7s5adaF58aefLBEaQ
+Esn68AM9GhsJ1EUnmuy88fMgO+N8eU5JTJ8nAzp0cb6XtzK6/wxpYyoKBXukOVIq2Hgkl/g76j0t
+3iPHXMqsyfO+Lo1kafzqSSW+fpb0XpsJtB0LwO/4rU90VMqZH8UtsVaVwDtyBau6C4y3lat4Wykm
+2bTUYtYZSurxeipTltGfeEqzQZjUBd5xqKEX+FiVa5CwWjKMhUzeZee4wXmYU56i+/9taLv+dT/r
+lnG5znMr309aJUVpBQkr1aEYnyXQSZHweByrq/ejsoy6fYRR+cGsyrwdeRXRW6YdZ+XKeMz4INAO
+QmTjfR6lxXmcu4ZkxROxh7RkU876Ph4XD8+Hl6QNHumGC1IZpiOnMJj38ZrIY0kYV89UJ8ZAOfSz
+eRyfsFuKT00UNsToOQJzvT37gkmEDl9h0ptBeJzB8SnGZ7BhzcjKiu7d9/w1QEyFCHvpBQQis5Z0
+9g7r3KErGLdEeDUTEX526DfH/h4XGjEDdXMAsuP0PHzV+rOVRpfJBeakAvJrPraGxVF0Gb/FsE8p
+zfwugSYjaVVcWIYY83toJgwOvDbQaKAZgefPjVY1r2dpFRIMHI1y/HUe3jGUpW5H4Az0MyW3usbZ
+uGzcgMjWOMuzqyLOG70s5jlVgUqMsrxsyAGhc9dZkqKJ/iVw4H+8OGw0CWED17snk/iRSlufbXkR
+TLK+LeWXUsqnwEld9HNv/nr09k2LcBNmrhZBuDACKkVNamIW0NDrRulPmPEVajXog0bjMLpqcJwf
+/Cv9V+qtxdP6Gg0Mq5TSNhhb2dB2ZeRA0MKhfRZagq6NXKU5GfJgtB/3r4cDPeVOsxu+RPEv5nY0
+ZLD1Nj2Ctl8lKfUFpxcGhKkQYHKYbdk11gUDhD6XGJ8fnIcGbgjD8D031JpM7mPxv+6PBlGS3leD
+v/tAsGE5knj2SHg4+fvd4tI1Cij+njHA58sM4f5/J0PYfYyWpjqjaP7acI18GPx5WEO7G87yw0dn
+eydWRS0TDCIa95LMGsQdj3MdfWCLHlMGO7hEX+lGwyFI8kxjfJg5z1RA4fKDWjrA+Bu2orwul9mK
+WASy6BR3wtCVhAIJ4rYPaR53s4sUXTHU5SzpNfYa4mINUZe0YoiKBsJcyyER32l2xbxT990DH5NJ
+e6PM5BSkakFKG+ed74wcz/PqsHPeLA9heEgyx+7ujs9ec+niUEkXz5a4W1Q3i05DNxHRlOuDq/H+
+b4VpG/rl/3zzkjIqlTdBOrU88q1s93tpM2O8wVoWV/4JbE8BAo5tWjera1lZJH+xO67cZwIPFFc7
+5S6M76Mz3o0ZYgDz/HXqUod4/TEpEgFuMgGxKmoj5pSl7oZq32wwR9vYkFBfO5eK1Go8EVSzanNt
+6G+E+a2xevXIs+aNX+QIFpqEGV7jfABu46CXABOCsdZRHM2RH81aPKr/IUbCSMWVONbg1o5UbS/i
+RoT0zmFDWBkymXukSWk3hl7qMQW0ad5WqieTSbOYTMxe9AhEH3wxqx2llcQUfFapnZnCKpQj4emq
+Sf6v3P7WgcK1wRtTl42kHb+itONX5EY76FhrharIasEsTEdFvCyuhNkdmAhxXFGw2UEuRuayVYwk
+K+DUM6JzDB3AdBEOjGgePDfrJZbJiajErDdYat+7XmHRvjpddGd7gGXGpktH2Wh1NWNHYVddULOv
+4VCGuNK18X73lG42XiOPc0reFZy7SCsRnRt5KCI2JUZuz/g4P+l0mvgnPD7xGf5Q1jloJUF3hOqm
+RLSIQapjVQqMNzs++Z/ySDrqLOVqNMdYjFc3ZTDad4VhK/FbDGdibBczHDWs9Ei147K66nH7D8/p
+Wjw3bo+3wAjznTE02AcBIxqHz+Iu587X+HzxdCsI4985WX14fKWe/97dXzQge4UdrhaXKmeycVpr
+FCfouanQORwzhfHsAD9mHEDzBZuLZnE/8+a5r7Tv8yBzibOjv7F89b4II+vOj3T0c/f7TRajs3wG
+Z1pzWvMcnMpcrm8oh8H3tDTPMnQZ280vAtF+mRmg5oLdEoHUBFOgcEO57M8ln3l0RWBO12f4t6th
+BVNjA/GMNJ/cPAd+lInL8FgTlWmrmvrh8wwjbFblme3XFU56AXWfT9At2p8UMuzNtfCTDH54iLiR
+cVs0srAzv0ObjTqXOEsKM+vz1Ljb+DCxhmwk0NeBdcJhRJ05s1ICbLD1ti9MoYz6yvrhaHX1q5UY
+1UEQ70zwG1UGoJLB9Uf7T7hihhBJ0Ht6SkgCiOFXzZhhglS0f9IPSAUr6WVnpmB0Iq0baRzRk8mv
+TBmEkxDLMOKrbQfBzAAL2ihCn8xYXnHVpDNtIWueIrzpMpPXwZzWaVEqrhmWdwefv+XcsaTrxd3A
++wAjyZZuce75ILrA2fZbc6vMmjXDDNkvomIpor7E2bEZeeMp+Go+fbQ33xJOz63vXqLhQEFB9MoS
+42FaH1Xf1r/n8Vbtr0QMVl7XQJ82/aibN/fclvHYDrNmzirrwG8sn9YaVFAqOCKN9O9iEVLxpOGl
+VQsRY3CmjYj1Bg+BOkFS0MJLZl+JWzO3LA0J6QK3CP+CDN7JDUSs5Ki+Wx9g0TMOqOTk+DJtlqQm
+mvbnKRLqR1nO4iU3O6HISmpG1bYUEDkHIqwrYsIQxh3ybMw1NZwdnjkiqlic7nPh8scTjSAOqXDM
+jgpVtcJKu6J5WNmwVG/HJ/roHp8oi4xXP8Iiw6Ac800y2M9leKuhi4xD4PCJHeqJSHLB7VRmrHz+
+gzyEebZftzOGYOMqqknMxINmRi2RsHimDQNvpz6JmLnbZiLfJt7g4n3cyzTBcFYCQDhSTGn5gddo
+phVbBt0PdJAUz2SIw3Kp6EBzdNvSSbJqfWnuLZ6gUzoRp1yohvo9PQKXXWWSUqqw22oqZ/2Zygi9
+Uq6uxg6+2tw7IFbeEaxHg8bSEGbg8qA9rx20n0tu8f83quTbjzZ2H0lF/IclnXx4bm3D1YfnrHYp
+f7V+vObDC6hxaS/e1VXziWkQzbQ4E03D3N7rMvRIrz2ZABeKVu/8boi7xMKyNiM4j2lV236KgXeI
+tgh/CX42/iP0yJAhLHIkzfxopmTdN511M2Gi9hnXEqUQ/HJzdKlDBZqFuSnisUg+m2rFUhgeUY80
+QE04UpaxqH6hAYv2zDjb1UzNRkYwa/30XtcyEzkHH88VbPF2ZzIxiLEIjxshJEHx32XrTx+D8AiN
+T6w0PqjHDzMX580bqrtgsSKMOsrsXwVuTNityyNC3McBh5HJ8wDAm1luHiB2kICxol7gnvCjlZMp
+HCbzucLMafkmDCkP1cES9a/XlbtGp14U2NXJLUO4rjDlBHEivpz1NoiEcTxVkJkCTrRRh15T7e+S
+zuQmS2YdkPqhAJgMHePW7iQxE+tnjdz53rUAarOTGWvgrhBUl7628I5lb0bKsUC99TuOQucnKiuD
++YkqDLJKwVRBWT6ZcAESdoYSCtIhyUPl0JIrNyLoMe9YwqTA9lAHd97LyD4BUbAYAHcJWF0tVldX
+sopnmC/UCNW27NEUfqfQzjUFBfmRak8bqcAx/fDm6Onzg1PFoXxKBoPDuBsDcBEt1KSolgLTRIwa
+7WR4RxkZKRUpv+I8JAMIEG314qsGIQc8mEmY6KCmnSxIWCSy33DsExvYJ1HYJ5pigo24I7NVp+Oh
+2nphexomncTxlswnMfWmei1QiHyvdnPQKcKBhA1Tw6Hyg6yuNouwlFjNAATY0QJbmLGJ3O7uHu3Y
+rN2yTvKtsiZFRaWLkYvryWfXbQ3lZ25/MMOVlZMRtbWprTOtBPrSwrC4n6zpNKvO6mMThLpazqgF
+crH44H44XuCm2iPXBRjokOIDymIMB8zTueR0XFOfraS+4XamLjWzpvdrIdLgRM+zfEjXmlDKDSRI
+MBdesAaxZ2ehojc37pgil5VwtJUlpGWTy96VyzZuyZ9GyOyurypw3n/cMiRMKiowVap5RbpvXb+6
+NdFSxezYPHOD522kEhKvcMkqfroHWGb76V6rlUUEwfP1nFplFr+G/r9Z1WGXJsxVWHFO7sCZ6b2L
+e7XPvrA37L3iYN4ZOCRXOKQ/RU/X8VyE1QWG2OaKMIXTO77W55hXpJSBwp7pFxyx8+LnspgoIt4h
+Yl5DqnEiqvwdUlPPojJC6nDDPoTec1mQpI1XrT/ZXxL8NCJiH8PDzqGNwzyP3QuHnLcaZehdw7v4
+FPa0D3cuCl+EK22JPzQNW119B3htPxRz4SM2kdt+p/kl3DdRK3sjnzWhDbCVL7IVXs9nX1ZXvwjU
++UVYpkG1F+jKyswhNPHrN/Jr3ap22X1DFfJahfeyTHvfqm/ewzfqvcHdOe+i0LtUhkRFDS9h1cCW
+AMz3gBcCA0Us4W3qvndbqEz5q+NVFfEKKILqKwzXYw35r87ce+b//b/+y5uZPH2eWofrtdkz9nx1
+9fPxvdvn05PP7PVSWXEQ2a+LwzRlXvOex14zz18ulqoxBCPG7ZT1VTgXo9jvmE/fMs2EbjKbHjOp
+AY4Vg3/fMx1u732Hw+318g63vdXVT7rbMvz0HZ5iy3fr3xm67bvg3OG13HFdaHAn59zp5CySsJyt
+riaTycoLNx0+mKmHRJTERAfe4+TJ08soGZA1KGHT4vH95EkjaHhrlatoU3UjuS8rio3w1WBoUO/L
+8WnEdcdxStxmDlXwinygapxvHL29n9mb0Zygnqqh99DQ3yCUfJh1WynCHuMFSbDAq0ERMt84klrM
+WdI5XK3HPO/wI8PRttaRdqWV3s+WWktcvnzUvthVNqnCzYlteZ89R+5ftEWmpdzBd06MJJDduwtl
+d9kOXrG9RBUpH9pHdfDP3DsrLSIqw+W3FB+ZpBTBX1rBupIwM5GlTS/m844881AguBD+hLFGJd9F
+2e3q7sNXNeZYSwSVSCOzJY8qYyo7Vf7EZ2y2OokfFAWQdDFCTKeIZ10b8qmhZrWWwuRYjZuKwGAs
+q/ePxNVX6LJBT3xTbb9CbNvbTb/5UaG8pQ7SV+0cnTt0O98Dmyf+PPFQpcnhtu5/UaTrB+1HD4Fj
+5cbufylj93s/4PYGdqFH6cW0ea8Y+mw9alX/a0VycsnXpUzOKe2HycHeZ7Eq/zkGFiYW4CleVxS9
+9kgt21c91BHP+CsDHbKqLqaahN60AbLM9lkU3g6TogCA05fpK20mykSWjB5saYH3fXuZZTw9w3jb
+GNmUC1sJZ6hRpJJNGk2Y157HMSsxo1OY1q+HeYw0eDNjOfmnY/2p2TB+jML+ykDK+FGrOvMQbzvr
+18Nz5seq6VgRtBmITNhTInpa4QqlrjHyoz5hE7kYTQrbO39pMMOhqGRPDXMl6Rd6G4upvHBY6U4m
+XZl+rg2yKkUI7wp2pctZP7Uc5qZzRMtvI37MysyF94RsNfHiDQ5+9WxKADZyeyw4l5aJqAATKwyv
+Km3iKRUhVJu389pfbvzPgU2cPX4etVS2abuzxE5PBGLKETc2ZYz6uIZsqAqs8fbGcmMUV13Ch2X2
+PjT9YE4NnGnTcbOGFgfGCsioXLMQMUXmctQw4K2Jt6a4f3tzhtOc6X/0nwrtrbWnXBupWKjlUu+o
+TFqmDVkJ4gMHTmXoJ3jc09MzTBGmMmIJroMMoY2eMfiMyLM9nXKy+slNVh1+KzK2QTUXnOMGMcUc
+ZnhTEOekm8vw2dLvKWIpFeRLmHWKAdwlu6/4xIi+80LMwmmuXDewlkEHvzceL29nHXNvTRfbSYva
+IAzfxbra/soOrnvHj7k2+McEzSU1AhLCNyDvmhZdDi17FLrkdyYYB/O29MTv5PUbVKks1a9ECbAS
++bwL1/mh40Ra04WKDGsRS/+OH0QdRyIbGbtturQ2xWozIefoukdvym7xwKLoqTTiGB93hNqbgn7t
+Y5OqOblUmmWvROMVPqscq7xYgFUoo1MvAb5Uk0MQCTWzWrJBhDZtJjvLS7SToy1YONBQhPr+/Www
+iEYUlB/vzTG3yBdKuYiu/z6ityQ0i4hJGwBUAyALu07kJgsrPxd5k5b9HNEZmccYyvzJhEu3xotB
+fBkPKMsLnoPxZDKWCRwft61TwBlEvCalpFkohmFT76IUgFTkrxm3iiwvf75pNnUIywEM3Mwis9h7
+vZZCuI5LeSLe+lfLIF7evBn1TAr8y5M60QQxQusWfUN2gqfqq65QF40qO96LBOM4eriILt1wRDc7
+oziN8YoxSpMh5YADkX8JFa9QXUwJ/Y0ESIy0IyEZktggIbbFgBgMxoMJRel37XT+WspAXNRZEBtK
+EwSwmGl4xayCgYdsi7ut36ptVb8VV+EiojY/Ob9qS07+VWl+RQadCMllDXhT6T/JIXJ5s0/qxgDH
+8m7O9DJMv+hdRFTAG3jrBHZ+KZuVKv4aYMg1uxpejokSSnLnzwxpKOJPRGWj8sVMdF2/1BBhDhsC
+OqayIRn+cKnLDb5+tOpThnmUOs6INvIEDa3gQTSLFEPmYDZaDHGADcGUUontRSQ39ttCwGBWGNmG
+vYxo1G1apdhLtmCV64ss1OctZ2yfBd+COGNC6urqSk6Bbue3k6VyaUob87L/GIgvB8J4P4f7dwcA
+/BGQd3ews9exDoL2exsc9+QFSwMWJfZFukgBefySqxlTfF+RjZNS0lsZ0Y43TuzUpWtnlt92Gz27
+OZjhJc2v1QxVBvQTAyS0lX/+wGxUs0RV+V6bl4vo03Pi4/EvTU+oiiZENDmjsjJgWspEjG9HXOnO
+fpbWUTzoT2i/VFsVi6kB6TOn7RxaxcjdyqZjhq0tDf+s3HYOS40UOfJCJHLJ7Sklz1pOfDX9viQW
+wADUFJfPGUZYWFGLfDLqOgtmYeXXNifi2d5lCY5//r2MmB0OgrM0RmyEuO5G62UjcedGiWhlXKEp
+KT1MgUDIAwTPf7qcVmzHDeGkQ7keza1YnKpDJOT4J09s2d5o++x3fnnwTxDB/qA7hfbuxgMfGCAq
+/qP0NeIoEU4wM68wcquZ1jMe95LPq0S/pI299HG5l66t+flxemKY26cnysYuJ9G4sNCdSAxp4hOJ
+ZnwhN+X5YrnpHNYIhOM4TmH58QEAAMOX1EJ6+jNzAct709wXTJtXcINnwwF9rVl2vHVsH/PYzEks
+LBv7HbWSsJO5CfWwvHlLPXKfAclkpnl4O8zOEkx7BHgelS9l4PGcSAD2vbj4WmYjKBG/PEYpwaGg
+3/Mkos0WrBjXvvVBHoDV+hrHI6EyRxFTdJ7LvlPVZya6inBlk9rKArNQ+vMzLfN0DWRBGh6f6NzP
+jQSwRu6jF9wKD0Om/bDQT/LtVSoVZ+IYYlwjH/clScdcOi3DND+OKVIjtadHJ8zYjfGKejkImrcy
+NUMKawT8AlnkUatT+YaUiviKyZJuNlj31nIomk5h3rJYpIj3pbScNxNjm2HRijtATGJCjMgCTZsb
+LdjcudKFbN30lrIA06Rxauie5M98YxzJN45DirbfOBTxua/Txid5DZEauN9TRpZFHrpwN7rFRFDk
+YWiCgbuOcLKK5b3rOF/Ot4n7T7jTGBnhxoT0gKbjigTkbAgTSvD+2bq5YVmIzNjxiUSTks/hNKjg
+iD/aSztliJQrIoSbidqZ2/6mxclq3NMxe6IZNYkmoRUF8iUmi1XNniQcO6c+w9RgGNTYp1sKXrsj
+/oIYL+fZOfa8E8J6RlApmcRzKd5KtIlhmzXsUJGMKlAZnZqO266bLiLjHrcRtnaEVSwe0KJFXilY
+TBFLwqbM2hKbuXAk76ZMjYAnlxVpaTMg7sbrUmZ1KSV5hLfV9uagF8E2GSfMdbyMGfIVSmbF4TCm
+68oirDgi3gzyUd76urTqfWrzABrQ8NDMmoPFZMn0rsL3FZgFpn6Sb7BADuM6clBzlB69xjaigYs4
+491vxG+RG7XlGM0gzTJUsOWNNMvjcxASUYJZAukNKCWTwSaMcqVyoHTbMCr0UL2DS3GarQ/h9HKD
+TBQpKVao5CDO503+TVY+FepBvQ7GwKUasQYVIyneGpMUo3foKtKMX3cIvqEiwUj9JDrpCq0lerqK
+tcjdTh5N4AI6uTtTpRxc7otLXcdkBVCd14FKDQKBSulO2wqa+vkSsi+/V3RJwBJ7vY8uSAkeDvk1
+E9niGeU6tBq88afmK364jKW3rg644hirTPHX25EiYLf2FUNlZY3vrP2pWgxVby7Kys1FNbdpheQh
+qQFevPdWmh8U0qWlEWGWYeNC4xtcs2uRvC7j/DKJr3Qor7eixPMJdxnktIkMvKDfmdRwKyuJgi7q
+jo0Wo4uCcu+h55O80UjM298ZRxeRnm7GW0tnXTiY9wFie6D9wozHRvikYY5JIIECpBuQbNacSjLz
+gqCwLwgqaxKbtlipNObIUPIG4oi0jXP55PJmLhZ6trKBsTLHCStmX0T2cr4uItmt3VO6BnNZy8jD
+ibJhE1i/xLHLn+8ACSfXQOMopvnnf6iRrN+7LXgIKh6AkpaxlmzTekQboOTv+HHbYfTb31JK8TdZ
+Q8+3AUgE5Moeyit4YFYanuave/kygWy5OkmFszWNHAhHCMuRU1GmlEcCP4hieWjlEsXVJSr1WogF
+rZj5oY1NfIJuYpVwCj3LGI8+zfl6p8sYfXRzEXE+dRtymPdjKKcpIxACcdQkdTzqlQT26V1StTly
+GX0+ywY9rmJaRyv/z6gdtjSlS+mWdSse1xHJPR/O2vO5mkuMGZtEg4+c0eUsBEksh/E55wvDGbVr
+Fjky1IsVLKNk5jewfUCblto8KTdJfihFNug8kBpbOcYwNsTKiyW0Pf2swGQjZ7DiZPS8XGyO2nUv
+fr8+zsm447jxMzw1Phy+CihwOrJDJ9oi6HKJUaEzkwwmLukYgLyQrI4A2WJuWEqKUidvhi0zpsno
+x4AbLuNBRjruKPwnanapDo7Xx5WsNkxxBOSl6BCN7JTgG0IRz+q5PH+YpOfZ6SmpvrroVxTNdWao
+virEqSXr6zdDTHzKuLAYeKdngwjGAmIDrPF641N8VmAycLTbrvdRa+gzTq3Mgnu3yfSzjwYin0W8
+EijHK5J7t9n0c/B5n4+cP2rwulliIwGxxDwV7533UkWL0CuOjEFU2+FXvA9Mg7LU3t5148Qc0NrF
+ZUFT2Qtmr3GC6+q6PdSebpFe0rN8Zj6nCnbB5aouqnUS8sWQmi8afOxPl8HB4tzjYddTuVoCOnB9
+YRY4l5IRJso1Jqo5fmNzeRoNnmVddMCobGxCzRTWGgwkrEAFkep3LItsC7CuMqggNhXtYDQsQbfD
+4u05dpTgfSgramCVUE6PWIceiSifb19Z6AgY8lkPG9ahHzicuhschmaICGO4ps+47dZMDuMi6/AN
+/v7IZ35URsMReYtD2UtY9g/U75V8/Fms+l3R2rKGPYBRnCGm+5uVMLVCSuiyQR2BYRBGPh3S4rJ8
+MkldDj01SJLeKmW9zbOa/w9B43Spq+n52VUvlHXdGJsbLRNwk2OcMnNZhF4uOq4jvN5+j8DayM4b
+hQRXgMzV1XP3NeJlXps/0d5z9/EqDb+fvrvFm3qLkgj0FzTac4V1ri0OoIEeYACPzV+MHizGcDLp
+qSSBHC0dLHWfUxW5K8TLdnypJpGPWzhQFGPgJ0ywifGZ4pYExyZnLhBIKf4QegRoxNZE35O8ZS8U
+WQgSDwgnbhmxnO6NhQlgbVHHjCPelFY/44g30cclsjFtsYB0D6aVJb5eTttn2nOefs9FjAkiJISj
+LQcXWrrZ6Ga9zNa7g2R0lkV5D6AGMUzgYeYWZHH0G0eU3rKF1d5n+7ISz+9k6nspRFiG1Vyk8hKT
+A10lPVhVr70DIBpjPh/4vetChmNkYjiP5v0Dh+6xa5zGC6NExGrky3b0o5btPMtKtBlSLT/Nf4R9
+yXNgL0Gaq0cyFEdXXWygunWmOo37w/K2+N3HdMFxrWvIZrsUMHIm2M8G5EfgibRn3LhWPBxR+m06
+xt45sMZxz/06CcuW8JUVo8Xzfay1MuvnVLzOZTl1mx/pe0/RPAxFl8khzU2OLu470P28zf9CWwMA
+KHxw++c6wIAPb16U+NTxQUPeacob7/bmnOiK+vNCGyqw0SDqxn0Q5gGjeHz1GmdwQiM4QTweI540
+r3aFYoKYuMdY2RALAJAq5u95gODUNUs2NW1Rv+ZhPWSpUDXv50vlVyX9rvtq0nDmLjxTnYfxdkrD
+izivhNZJQ89Dc8csNJPNpQiEVsa9lGLedUoj+sopfOAp+0MRkMXKu5jIvIsrICdGHe92CgvkvnXE
+MJbWdRVmuUuKP4ZIOhKKw/xzdk0RGSjaVWZHHuMqlIKM+5seDWzG58Hs7+gTs6dK1ELhX1Bb3fM0
+QFIX5zyoEt59Vk9+KUL+SaqqYgGmLJ4RBHCG55dnmWibA2Z8vijdzlhjeyWY0jPKBXfFwqz12nQY
+2k0mX3Of3ym4nPXepg7k3K1kxbKlSjv1IbE79atrNfRUbnZuTNG4alWFU3durZfyvXDys5cPB900
+VnrFahD/Y12AGxXbTF2mLkr2y8+uUPbi0AxzmKWu0ue1FVd0vLUIWDIXhI0qbIKXmpGwKtG9FuUF
+bhSY+1dlTKJMwJg5+SmacSDb6cgM3MVCK60SaWZGYTPtpHODWGUAiEHpWzEa7ND4IEWnjqBi2byg
+Yv3QNiellbPsTmUhF1kcL4L93DS8VeUq7L8BvkMrd/KFaY6u8p32MN/phcx3uoQoqQP4e6we66mc
+G+upNGM9DV22+YMZfYGoiVR1rTlS0oLXSFLy+SUtP5PTt+mujT0Ad/fqwu14Zp/S14up5KwXNtCK
+Tqd3W7h1YbbjNhOf9yG6ijl4HcuKatghr6EuRTpvOKbDWxG+RfBzdh37+qWC6GAHOx6Ppw6NYaE0
+S58dBxsTsj7H5LJLJ6qyk0DU17krAf/cNpftGxkr7pp/YkbnZvT5fdsagOc35Qd0saGuwJRWxdup
+ETfmqyPC4Ndc2Pe+zYEbeLTZ3tqRdgZfltBgCnxkJz6tkkwY0dtcRos4ShPglctf0HYFs0ufgsQw
+OAXhu4/6VuXgXJL+YIi3PSlwODfAkV+/kHiYB0wRccSqWweve1tG4mO8m+l7lg039Ngw0suyorwB
+rh2GCd/ub/rNWd2WwEVxL4rcodLW1n+wLFFP8EvVjj0m7dDuoGrEDwGwhkPAO7PcA0V2Mq5QXcZc
+vqoauIMCwJVlVxpjcg+SucJapkX4l/mPc+QwA6Ijj4RMb817ox7xm9fzKrYGOnCEFaLGvqJJzX5k
+WlYnUyQZKxVPF3mbehgNc9jf4AtiUnYuiPPWVleV/4fo3Lq9d32iTAKNEYlv9cgcYQzk5EpmNec2
+o1qM0UVz3lIXMaKytrU5El/7c7w/ZhglylOqvPCpoO6Fv8hcMaYcZQ4HDnkC3iytkdW+3Db/u0C9
+g/a8QgEjdhJRayl3k/Q6eVOttEJL2epq5tZxR7Dq1uamTG57Zp2WeGr7rb9f0tjZrUvgnIK0z+rJ
+uCeW2Pee6lScrnihKR9SgFwyiFwxpLH4GkCqF/e0kZwQU+WLwFXbIRG/yjB0BSEU7s9QU+6p3ZPx
+5ctwdh3kb4+QXA3eZxT3aw9jPSSFfXxl4DYZsLyyXE2fzVQxIr0CNM/7aDraZJSyAPZKgACFN6fz
+rpYGFTiiKyGeuOIECVF07leTyXtLGkExxa4znZPdBX1RYOXO36YH1AmGPpC9oU+K2PhqQCAeTNwB
+NTkqD5aWc/nHssOVMNRwUjMcV8OyYKly8Lm+XjCbceE2UHFApTWZ6pp0FiPTknusLWdf6/6aod5e
+YFU8EXOYBuGDJwfvBY4ZmZwUbeP6WXYt3facQoWCfcC+y8W/zZKULgLQgWMZz14ahsivs9Z0DBpV
+r15DwS+PQ+rcrnmCi5musXIULB2Pe/fVxgkp473Dy6V6CL1bYLemnj46K21xdcQ5OHE6pHth7dBB
+dRVIEpEVuaMfK2/Cd1za2Hy46bND7jb4LpeCx+vvohI8mKJKSk8cl7xvQcVBgT8oCQ6gEXXxok+l
++p6yJAksZ0g3uaGJso2vbsWivkaoOKRjjAnwsmmYE5HN9lREHEy5sbrK4Uc8NKPQiJSdVgtM7KmH
+GC3rzaLGjL51pn29K2Cb6LWGsHS/no5XYyvwLE6kzqiIyKC5igyaGqtZUd0ZqdeFHvpQilVFPZ7o
+YPZWcJB8Fo+grTHPR8BpbjeMmx5VkT7gaPgS1zV+5+Ehepzu+DqZLZn87W6T+m3Oy968l8M5L0ml
+eEH2yyLtwtLSIsdKaoccF62DWnJkQNbZQORJhqPq+mqQOFKOQX2MSw9vOHBoyAhD00dJgQ46cVFP
+ozwuoN1oVvb1CmnQ7tl94Z4t7+G9hX2ySiLoJP1aSDUjVzCqFtDc+9ymJboZtURocdgh5VSDR6lV
+UZ7Es0+uat+6hBwt/NsWcFhfwLk9MseIxRreJqkYdDb17RXlTeJ69pZZz4sOl9Yi+JSOJEX9WLSz
+M22K+AyFG21g4l3nDA2H/0HcO7vxAAhc8IFxkPik+nr0I14Iv14CJrwOvA2METOZ1NyClAtbM80a
+osVGJIOd+14leC9Mf+6+3HXy4dKT7zm2Uk99uGDqDmWqwvsV+lBD+CaxiE28fWdyYIjYz36Ikum9
+SKOoLhxniVMOcc9yjTLeov8/yVcVuw4HKZ2lYN2TqV4lLRO0I92ru9NV5EoKyDNH7JSuM44BL2Uf
+qLh0B1DkTtdGc5Ka98TAAQgEbc13CrGCP1mQUhPDqHgy2eAQgdznK+Q+d7YePFK67p9/AIRcCE1Z
+pIxJjTtbLU6by8spewfzs4kukS7xw1J4JwHlpiOPCbzXOfGr/e1X2HbRI4W+qN921wCYS5hLOOUd
+Y5I7EX3cniOG9D1BDtyWns0wr0LxfxgX2eAy7h2Nz8o8jhe16VaqEIBLQ5J4zkFzaDSc/czpxlDg
+aCUN2TM/LYFZO8Mgxgaa9PeWHMzciZcY3nY6naFbnav2q5l9pRXHyEpweNu1nAIvSVBDi5Ne1iXG
+meyNC2Yy1IXJUQ9Uhq+VhNSFk8ng8YYj+Og4nDVrFbhbIpwxG2A6Ih5JA7O4SY59ZDDEwJXnNfa9
+L6zZhAsn8N+yjlE4xMJfKbIUP6fzfCZdWTC6HY7bikZSrKMftiSSRU3pwRds3p3N8kqRbk0FUtT1
+H4gAMn5sxzxg4zzNwkiyXYXku9zactOSc3NDW3Li7wq7QWZn7DzrjsneLBCGeDMNPrWNJyzrPygw
+zHqU59nV+ngEzVklZOlPN6a11vpm0Emptk/mBs9NMHSuNAPBYzlmyQnrhkphUaA8WBI2q6Kvguwu
+6BWmrWv6IvwtlF9YqdVHfmcUiNi8TfRQMF/2/U7feHkTXlhuGJPJZeU5YWfGOSkw1PveGU8ifCFi
+fV2qdF53Q8iFTiFXXdtzxjUmiUVpB1Kcv5hM9Bx6STECvENAesMkv4CuspKB61YRlxOvSa5wMVar
+soMrG+50I+wgXFqn52ZaZlVvCEWguHe68RdJ68BFf+bfkPNZXbWpbVDp/uizOO0CGYmvTCY9cSKe
+5adQrGOoPbwRpviN9Q+HPBkp30GXf0hPNBoX5iLW9fuSClSZl2biV/g7k20R2seDCnxpfX5iAthi
+oKlrLDc0w0nU52zKrmpxmKXV7HMdNUMYcdcu4WJuWl0lPlk410SFemocU2RGYZPE/Uf35gfEqqVx
+o6xaGBkY/gaeg8rk4q7eaeNc3bSMHFtxUDqlFh+XCvTxd75EqHu8JGfpnpFWNmOEWiJrY0HGKw4B
+ngf2qo5N1NAVUsG5hpq+3sKeBochehlf2PpBG+VcWnjtpoZRruoI5WAaXohMqCKL5fU0vCQ2KKsz
+P9VsqafTsCv4nqMwc3nkfZXFIx6uLKFr7H1ZGvV6xKpFg3fm+y/yvSYYk0nE3oS15LnsfTWak5QK
+cJ9yAW3rbeC+usge5+GxN4yujd6YN0xS6xnny2P8G6HfZWgeboL7Ts9W5wJkh1ZSbO18Aw2hPcsJ
+JvucWcNctxNJw56F4xqLx15hoe0w+JyKuNjM/lYPxnn9gIVGsoW/8Fm4GH4MkdtamqgswLDPDAw7
+xMW6NxdVzEUjt3g8Aey9+dWmaPNauw38yBEDGUB+Ci+1oCqBL715e+6p3KX1CnBadYVfHRXSTGVr
+/S38stTdnE1iB2icQE3duweEDwMML91GP0lRwW1/f5f7PhyDMB2dsi/+XVAzV+jV9/5vdqtJWSSI
+3W9OzLay0ptMzh+HN3XSem8pen+WR92YxJaGyIQ+5QAzGxLmAZztoggsy7pqdZkUILNWxEqFcdRZ
+mAJkvjMqpXHgMG9m2PP8OTWrb14ph9ajqYzAyg4dgFcdmkRY6+S4OXdwluvhnUb31yL32EMQBV9P
+JocoPL1bvJ4jifIWLKZE4/NHy6VCuWhfV1e/mhlaCzJO+zo3YSordb7U5kop6Rmn0EjprtDmXL64
+koQa3xz4OhhhLXcKcGnXTd+ZoxZ4NTue+huo96YlePMC/T8wIpNcKUo/c2LELk6EB5vdpi43qPGc
+xCiGcJAYobLnLHaJnSxjLwcAg2f/v5zobyakPReqWxJM+FkHgSSaUhybU8pWO2UX7FZluMyqXJwm
+c2IlDCYHlYkWl6fuDgS7t9bmJtMmY87B6tQN1TNSCPV0pmUFmKcEkDkwGzVQzF1Jd7zrddRPYRjN
+UoHsBtuUyXd0wqZOTp5W4g+MmMeOWyIxDqtgEmIh557Kct5ujigGtXP99ldX92eE215mHR83/qvx
+5G5I67m6BTDhZaW9EGBm8L8uwNmvAI7Iw/5tcyTWp7H+ZO4sEYY+CXD4pBjrOdHYHMmPvn1liDmb
+dYriymKo9XjxbetBnN4y6/FCrMeL//R6cF70zuvx67etByZcXrAad4h99M2TJv7aOeFfqxMOZhrs
+z+Icu4OM9LkoPYAcqQl5Gr6v48102ZznH+o5z2UOrVLlzsrN3FmaRdHp0OWd74d82cR7lfAOlqmX
+WDR5AZlZeoKICaWoqbkY6M0YWyoHvN9wyPkjWYw2IIXHHQBtEX6Aat8+MUlG8gC3uB4a4jr3QBIN
+23IyE2KPzXDWpPUeH8scuXw4u4ZTLr/AOB7VmD1lXeq+UYUUtae0RPArHduJHYTn3yA+fqOsd/5D
+ZD0MZCOUmUqoyx6HUV2e8/BS4oSCygE3b3LM/WWyAs4Y6tmPOGis25kd8Kc79YP+sok7hhIaVByc
+ZSIFLZKprhaJQypAjs/uomW5WWS3kBsIQGBjebQJXVCrAq+MmInUBY7hiJl5J55WN/+Vh2oTGqM8
+GSZovOMJzevHZTWvpt41nZc53I3ZesIUx0SC3botSlXZOdI3xOlkskIIw18YxIdrRzU2lL7O4vk8
+y4dR6SkcJYMrDOUj6b/xMs6JTi/d2PgmJPhI/0BUC3jnzjiXemUS9YpBfgvubfHYIm+ysim7vcEE
+FiBn+IgBFyHmgzsi5ut6MLVTLMLrA4mHj7DAwMNf6ZsK5t43QrB9CS/+c8j54ocg568SOX/RyHn8
+OOzORM71m6QuSITjpaaBhwbjKq6ujp+0l/NQwKsduvHDCS/bB3dGRvqFkcuW/UhAL1Cf5j2YJvN8
+pMUmFTr7Dip0dFcq9FduUJ/R6uqNHkkZ3szVHf07R3JZI3HXig5eSpJ29SNI2v4iknYFJO0AFWE+
+0tDeDAI87wph9qVgHUhgFRgi3UBEMs/D3jJakwVHtLoTdn9zBuz97/+F/6X0Axh4VxjU59UrXL4h
+w/qGnErz3GE19a0ZLumevvidDyTpdPFk9eTSuXOrT0lQ/k9LOY9m6fscb7+5gVCMpkIUYEEYZTNK
+6x6XMip3nPI4USkDevOhiHM0dI97mB2eghbRbWfVfixaXc2WdlFIOiBM36zDkBpnZbrOA2YGsgyt
+pztL57EVH1GKdhGewnAGAxAVwSQcbmvLNKnbwvzusI6NpGzgwD1nkOHlhomW+0YcDDxItAOeigTx
+KXekdDN3sR6zq7KtzgrGPjvey40HXrS+81Aott7IRfFi6YC9ZzcjPBUY6umIJ0PbpKA+1GLOokGR
+oU2TO5R7PC+Vp9NFsXSmaK3HS+TxVNdHeXQxjBDw2F0/Oj2VCWZnlK+vR8Oz5GKcjZ13P/OSSahg
+/x9oZzBgI19WMupuaItNl8JpNKvZLjo1iYZFYjoy5gDepeHNr46XhtEooernSTyAfYcjh0qvs7gh
+HFJ4yrS4UcBiNcReYx45tM17+u6lMepW490gxljpeTzMLuMGAA5GbsWPeeOtefPyjsajUZYDfMqV
+l0OK8njRRMS8Eav+5G22Nryf5AqU/azAZMFR2QDup9tfckmCxlZro5XSyjTPKW0eWUCwO32/gd/7
+mHAXU8dMJvmdAf//FJgfJkWBURv/JyDee99HaMwugTPvGd80ellcNBBaUURPzm8aUYNiPdkANB/q
+BMgmaQ/j78SqCQek2602/i+HV0G5XtQpl0bupDiSmZXoSdAFTlN4cnTDtE6Eu56bj8pMIL04U4MM
+hW6mqsLE27pTSQVLngoG5HEMeTOXnYuqCbEoopDBhnQwFzKc1fgSQxbw1ZG3bsTDl53P/7hPHC8P
+mDFXJ5XiiH/J3cZH87KQkBU3sOxpEXj9shwF9+9fXV21rrZaWX5xf3NjY+M+1aEqf6Bdtqte+9Gj
+R/fJatsKqwRfrqP5Z+kmc/F5MXNcN8MzNGG/xfDFGGLO22hsNDbxf+Rw5o1TTMQSO2MfkTEVu4Va
+r9s7rYeNhy/a2x93Wrv77e3GZuvBxlajvdna3d1ptBvtDfjnQWtraxv+7oq3uw2o/HG3v3m53nq4
+0d5/CID9YGe78bD14NGDxhZ+tNVttzahKgyoQe824VtYhY8PX2x311s7O/Bqvd1o7W7vwp82vWo/
+6G40Wjvbj1rbmw+xbOtR69EOvt3aeDDAOjCQhw/2ofMHm432w9bDXRgTtNVoP4IxQsVHfZhOF5uA
+jtvYzDq2Aj+hnXXVzO46tgP1Nrfh/e4DeLu1DqPkP7C73Y+PcEj77QcwKRhjo73b2trZbMCwaMH+
+9pzh3hfuyr97T/4vWd3XbXh48RDmj9XuBF7fujPyhm7uxmy3trZhkNuth9uPYJ23dx/h/2FOm5vy
+1y4MceMVblG79bD9aLAOuwarDJh/c+4n9Mr4hyrgLuLrAezxzsP1rVZ7Zx1/PqKfm13XRw/lR6q4
+QcXypxrgw1b74daAhgfNbWy1u/O+aMihq/ccCnB0NCbcJRhTQ//uzvzk28+PdmxZtFdbrU0A3Y1X
+u632xiMY1c5DWK7NXZjV5gPx48EGLfyjB4/kCyhq099HMOKNwYPWwy2A/UcbAPZQA1606e+DDZwJ
+fjhYN+qsy0rYdJv6oXZkv7i6lY5fyXH+gBURrj7zlwXAov2wQYsCc3qwuS4nxH/AkBobBU0UpkCT
+3KVJwvwaONUuLY+cBf9BH4lK66qSsdrUFC0GX516l3RicCkGNEBopb3d/hFrMh4tWJGGWBI41TjH
+fXxGcAXc82AX8WB784HxtAWHQVcF9LVLz7vb/IHa2YTtlVW3Wo8ARb6CHW5tP3zEFx6/3GhtAl4F
+dLfdbjxqPXi4qX7vtEWtV3hWNjZlG/uIqrc2VQfyAbvm9dSwWg8fbMkxb7a22m39sPOwLSvioGBP
+H8AMXlVW4c87rTyg322+8sJcexEQAkG53H4BE94abLUIse08egVQOVjfbfD/Anlpr+M/r7AWLMGL
+zfbHB39/47D4tcSiUQEEPuxvX65v9te3Lzf/fg1E9XKz3374cffF1t9DOOT9NnA8my+g9E4DacP5
+3hVUhhIy1D9EJrPMo7TACwTU6sHPAQaQ2WSN9bY/Z+TnyWAQeP84p/94DB8Px+hCiHxz1ut5DOeG
+QARTa7+ACbT/Hu6s78Kvdn8HlnS42dj6+HCwvtWg/+L0d3CWj/5+vd3aaTyiigA3H6EAmtnE39AI
+Fv89hHPSb18iIdrYbBE/0Aa6DwxAC059G6g8EhH+BriLF21iTpBAQfEO/Nv+uN3dwDJ6bEBBf6uL
+9Aup56N1oCbwf3zilB4OEBRBFWoFuB/4DBmiy50+NLX7on35qN/egNHjUHf6D3nbsi8Y9MPaAAr9
+dp3ao2FQu/jrxZb6Qr782xNmTxSW6U90jH+4u9F+JBV8/1wiCGyc52b2iTsFJ8yb3tHlxVMuNqDB
+bc4TMu1r/ze8iqQIhSKX0ztSNfDEB5QTNbeypFL9QnleiYIBFhxmVxTTWyW86OJPGYcbnYCllzEO
+i9K7FJWBkM+xCCtZeUWux0/HII/nyd/xz2VaeU+uyCJfg/WGLrqHPFWpUI028eLaiP3Dx3UZrnCP
+06MyJ2/RspqDg5o64yG50TzVSOdxs7raPAu/Pa1VraJoe266jJlfCSdeI5/I/8z4qvl6VejQ5zQw
+1D9hO42K4tIZMloY0qlZcWsKmpsZQAA6Kw8Q5pqYxL6UqSuE5sxDt5u9ZReiwXvCOWJX//FlQcVt
+GTd492N+BOeqz3JUFKElyNnq6qV7x3Uw4zdZZd2VUg81TuzMXzokllCfrY+Tb16jM1/7R8Mp5Nih
+iYYWZijV6/BqdfWKbl7ZaXiwunrAfx+FK3h24+44T8qbZ2aohb0fM4t0xsUt5VpWVy5DeeVyoa9c
+ZkDydBaIs/nh9REu0XAA1cLzgGyMLk8z8+VkKpD09WRyOpkcLXGbw7dhUaf1gMHOBD7X9ZzzpjNU
+3dfg3HxdH27feD0ron1v5gI7q5sryHpx8bXMRs7FTGZG5f7elguF9URsnd+RhXjwYMdnf/C4jr/n
+8tzEaSgDYBmJjEQESWGVBr++xtzejAKIaus4TqYDM1uGvAXHjANL5jrml5Fo1oa68pdmMmIZ87nU
+Y6vEiO1g/q2AEkzFq6tAzb1qqnppVC4GW8ow0rrJmtndecp9n7nS2Z2iBHqjwDOd2DZ+41A0qL3n
+xnLcwQQ5gjEqt7NmzGAdb5FkHMHWpqWMi9ANB51xs+h8xkgWPBzI6b3bAfryFJgAt1L82Q+ypmcU
+FmSXoF3Uu5NJsxu667gIp8uiUq0grU9lzRyrqu359VKqO4kyrd5JxKnkcPP0h0egd0R05sNbXcWU
+YToSm/nO3LmOClqP1YvjjZPAlWbIznVkR4MWp6ge3wgN+szRulqiROLzgpHJk6LjefLtSpnh9GU6
+Wi4E8Vx44HVy03xTAnj1rX0AxrX3xgFAJr/yFjPFBJJHLgFYyxBdndMw5T5aqfTROj5hKkA3BVjj
+cs3clMKGTU8LDtlF2e94RkoZGUVXvkuxLBoMsqu4x5P9BGhXWJyoNaaXB8NRecPx1EpmrGUtIZze
+3qk/leJMMpl0EfZg1Z5FZYTwB88rII7IkiRtXCVpL7uiPL4og2G2OjXPsQG5Ywflu+U56qgK+5YV
+mBGFX010NK1fbf+BHDTveQDjw3vEqywnYWLQ0Y8ydd63DEvuwTBJX1FxsAFgfQbEpBu/T4ZxNi6D
+rZ0NK31ftMxkBGLK5yCmNP3e5HtmkrRapHwrzxxFhHQmyMjSl2U8rCVbqyQiy61YKOk0lNbRsgTr
+AoKi2GZ2Pr1qh9wW5CVFRLXzefCuSqsrpNB2Vxg6Ht4jElvYV9TriY6aIm9dIw67aX1RKkGiqbrK
+Xset/FXmPONjE7NLJwAkwc7wRDS4ucOtI+/beeNyt2cGkuYVYcJynoqMiqEvF15a0jujob3SDrVK
+pbWA0jL/qc9kh/ZXkmpWPqsO0hksr+LWpeiS4dQliNZ8HiwLM04VMkkVDihuSicD8iDI16AaSwW2
+xhMcD0wIMFR23gCiCpRKO7zb1VVuyriVxnHvkE5BxWs9btGIfeTYqmvLRiBhNgGrd3nYIPWj6T/Z
+UF5l5A4gHcKIxJ4onwrrHRHQE9sDTL4TxPfEcLYQwM2DVrPLEID8JlQUoz+Z6NUI+4Crz5I0ym/w
+qYc0GIp6HXQNs1nNPnKgPeQ3vbMsG8RRis7W+H2ExrzyQYSbWKGOmq5mPsOSXUwmN/AaxobLIYE2
+RleyRVQ9Nql6Nod4qHcZlg0BqycY5HxlQxCSrkm5baJ/Xqfz+TLEnXzjZP7C5VUJmDNsnR+Edb6Y
+UzYiW/quALkuIftcurukCtTtTGTAq3DXzzpIYyLwXnwNm5z7tjeoAuSTRUNGc/CczRg4AuI6xc6e
+spt6lppU5+DRWdsEw2uSNZqmuTMCL1SCT2Ge9rqxf/bdvTg4mwunSPSdkxFob1jNZ47IDwnDjDTJ
+ZybcfEbrZTTZLoYN506sC1POe7fj+jkhy6bP4qyo92PB/6nolTQnzQY0cx+NtdbJUEtqTn7MYIH+
+w0gHC0aq3g/MkfJY5pyBgPE97aHxbL/zGfFN4zPWJKyoTbrTObxe9u8TQr9DcLRFQAl+SjI35btc
+g5zbghtQb0xSFojFnJ6WhpQ1h7Wvce/lHASs3pWae4/vxL3nC7n3VHHv2ZwdjdIflEE+nr+rUkHw
+HRtbke31/soZ5w6JPTWkRLy2m0zc4uQs/J7OkhmX315HLvS4ulmZ2qxozmYVP2SzfqhmReyCwa2a
+mhU5vcg+a2E1yAxwqMiSJWG6ukqsWietq1eKcCWB7cvYgPKhH4sIviJu74kK37yE/sNSr+dzdlK9
+y/VBFe5NpUG+ogqXlHSQ4UhOgJxV2aViPrfEgaBwAwHnbFLUkhJ/bvsyyjNnsOjC9aujfgWC2aHl
+zsM689+heEuVQs4GdcRfpZJaQe8VebmV82j+oagEZ+3z/Xu3quPp5z2gZ809yQWrxtM9zeOqkadq
+5OoXyOZ85NAxr4fqbf8M9vUrlmVrvEMghyup4Nv4S9SX0fOUDxVIXzYNgKCm08+YOImfrfFyagx5
+vhZm9qwdqKUzu88ghhpbLkSNtcM5g+jx7jI8MTqZuDwz9VOKAmXuw1FwUMI59txZLZfMrTho6nih
+AsQ+b4MUujKCuOrjRwqMd3/71gFMF2S+hoN1LhzJGij++7dilnbgtNgH+D+go9Wx5IfYt290VGOj
+lG8BLJe+yCmC26ejUdCPWSTsLOia8V02Go+Cni5F64tgGDOnNUZwYVR8Kw1H8ItL/qIIbvinyNoF
+Z7yQbuyDK+C+8WkzeAXFowTOD1YMDmJ2FhVJlx6uMVtfHOXBzzEbJJfxYVzA8Is4+BAzceMEPfeI
+eAbDnOGFaXCVM8vuJTjImY5fGlznrJKKFwd8itzKAKp/xF3HdMo/Rz3Yqr/i1p9M28QEn2L9FPwR
+MzXro/FwCIJ4kJe1stc8/W1af8OzsJTMypIVXKOOhU+0CE71Q7BfMrkCKihE8FZXIO/CdyVPX435
+sYvgmfF0mF0FHzAZCE8Sdg/6jaMe1vqkDuKL0kzTHfxZMpAdcrRbC/o5O88yaCc4ylnF+iZ4mjPK
+yk1D2M8xZe0g+JKrbKYvc1a19Qne5DzotEj4FLzOmZmDIHgmnmVIhOC9KAhe5bAr3Cgp+DlnKrJC
+8Dxnbwl788e/c0bOyvzpA4xRBvzgJR+xhAdhCO7l7H1+87J8Oy65EiD4BN2JMA7Bn9gj5ll4RdGv
+g3/mzGFEFbxQxUclTCr4Ndd7DlsWFKX1jDsyKJnKZ45VXhnPL7l2k2hz8Nz49n10EfxuQJ9e1POY
+PYvjEYaNCH7jJ+EDbMUZ/ykzRQQXsB/SWiz4BZZQ7MFpLH8WnEMJvlZLiEk4jEV/b+PplKUWZomn
+QFrNgnIqMPZxHst0pCzWP/+pfv2mfv2qfn1Sv16oX7+oXzlL2e/qKWN/qN+l7iDVPzP9M9I//1S/
+AIcmqhzw6Alnd8ZOdgfVOedoevfowfZO299TOLePOFfMeZSy81S2eEJX+z38Zntna+OhYJluf3n5
+/vTZy8P3/wyGKcOH/bevX798H1yk7N3T/d+e/nJw+vHg8Ojl2zfBZcp+/vDy1bPT9y9fHwQ3QC9v
+q1W87VZ7p7XjmS15F92HOw+jzV1eyjtb2TDb8j7FPdbYeNR4k102Njc2Nxsbu8HOVrCx0fjl9Xtv
+qud3lupU2a9af7aEL1gRmg+Tye2UmQUtYXbzIQmVQxrM5iIpD+PLhB4v6PFZgicSVuJsnAx6KGAW
+dJxgsipJ020vG54CgeaBjrLhG0SdnAsfxV00f0AUBDQZ/hTi9pOfXU+fY49ZyVk8ytjHhtH1Mx6u
+J+7BSRNf8zAyIlSFpBJ4tsixqwju31elcqKtJNOFniB4h3EPhI9uiZ8Co8elu9Yg60baibybDab3
+79df9rOinDq+gSONoUxaxfiMs8YAxhGF85hVVYX28O6jYuU+H9t6LgbXwrzhn9kId6kon5o8ApqQ
+dEV4I1jl7rgosyH+EiGOFFJ6yWMx8dJDnirjmTBt41GaOJ7C1J6YFFVg3wPtOy/ya7zEVLLdeASL
+iJq6WBG82guMl/R6jLr8nugQQdydTVRnKpyV4rI9O1dPm1VCM63wEuBxh4TajfKrpOzvw8ICLgSG
+pQi4bCAnd5QmQO3Koj5t+SYA5jnFNUUCjfnCB6fAIvUDEV7H6344fNVoYpHvseIGKMF1gNkvMTI6
+1R6B3JcX/XgwqHzzDl8c4QvjS11bft8d9iof7r9+VutrKlfrQMXX3oAjl16Mo4u4EM6YhfTcPRqf
+ASXmrFERHGMOLhCUUQkC/2YFD/WEt48eE4ng8cIEGRasgCZHeLeCURS9EwbLld9wI1FjGcmLHpbv
+uJ+esNFgfJHAfhyrn295q8Etf8ZMH8TzeIP4IuqiGj/hLCbdlSGAn6cE8AYPPRVr8EIycAFPPUlh
+nGH6JSV196ILKIAVUgJofcAdEho+/AIkB5rVck9LoLY9vhoNXcCrJGGf67dzFrMUzbNvi5sC2e1b
+eUiTlvg1VauQtMTiVBcDXlgFjC7GAupDoE/5NxG5uSRixGuLxJ8K9Ev/cuwLNeHfaQ2sk1alBFa8
+Za65P0WxuVKGMjoSni5GR6i847yfjsOBYb7eXsnUCjcc5dnfsK6/uqqkdfvdcfdkdVWs+4BfEUIR
+p/phGl81/m4OgOjDNC4ASYKoe6yWj/H7Y4CYpHWemjCDuyEfxOomvO0pXuIQexCeU3Iv2kdlsz6U
+ftUgffcqCfCg9ivA8AMOUp35rwnCWC4Bp2QJi4lWpxRaDjMo5xLKwsxn53hhLALaNfFKRMKTTIOE
+Rr5xr+mTcL1CJn3NlRQ3Xd8pKm1KTmNbXR2jqE6/fSlRN3tWdqXxCOhmDGQSzQOZ690ry2bfK8bd
+blwUMyofQUlzpHv1/cCuhow+TgVtnGjsK/QXkzvOGhhVqHYn2xFvMYOtXE6/1xJaEVXEPBDEPX8P
+CEzcgOWnF8DY+MKIAhgU0pFynCH3VFfbU03KpqbUFO4FXhiKepOJWYAdTyYIV9lAqMOa3tFXPIY9
+ESYCSWQjzRqf+fefMRzDZ/Hp58ZVVIjQDwlGXZcamd6UwgRy+JhMJOaBpZI1Liicll6tymNLxU78
++Qa+qoKE/bZ5i9jlguFiH5L9PH+JmNfBOih8YxQ6WQmsWCudsiFstB/AgZyepRJ/hrfRKCmCfjpl
+WMgPf9hT/L40dE/Ds3TqN7lpnHiHz6jd+3/u3/9Hg8c5ew2SLyw80NhQm6ivn41RVdT6UrSG0ej/
+Dyqjc+zLMhAA
+`
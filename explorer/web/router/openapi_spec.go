@@ -0,0 +1,148 @@
+package router
+
+import (
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//routeSpecs 接口描述，新增路由时需要在这里登记，openapi_test 会检查遗漏
+//通过 apiRoute 注册的接口只需登记 /api 路径，/v2 路径共用同一个描述
+var routeSpecs = map[string]*routeSpec{
+	//区块
+	"GET /api/block": {Summary: "查询区块列表", Tag: "block",
+		Query: []string{"sort", "limit", "count", "start", "order", "number", "producer"}, Resp: entity.BlocksResp{}},
+	"GET /api/block/:number": {Summary: "按高度查询区块", Tag: "block", Resp: entity.BlockInfo{}},
+
+	//交易
+	"GET /api/transaction": {Summary: "查询交易列表", Tag: "transaction",
+		Query: []string{"sort", "limit", "count", "start", "hash", "address", "number", "block"}, Resp: entity.TransactionsResp{}},
+	"GET /api/transaction/:hash": {Summary: "按哈希查询交易", Tag: "transaction", Resp: entity.TransactionInfo{}},
+	"POST /api/transaction": {Summary: "广播交易", Tag: "transaction",
+		Body: entity.PostTransaction{}, Resp: entity.PostTransactionResp{}},
+
+	//转账
+	"GET /api/transfer": {Summary: "查询转账列表", Tag: "transfer",
+		Query: []string{"sort", "limit", "count", "start", "hash", "address", "number", "block"}, Resp: entity.TransfersResp{}},
+	"GET /api/transfer/:hash": {Summary: "按哈希查询转账", Tag: "transfer", Resp: entity.TransferInfo{}},
+
+	//账户
+	"GET /api/account": {Summary: "查询账户列表", Tag: "account",
+		Query: []string{"sort", "limit", "count", "start", "address"}, Resp: entity.AccountsResp{}},
+	"GET /api/account/:address": {Summary: "查询账户详情，valuation为按time时间的价格估算的美元价值", Tag: "account",
+		Query: []string{"time"}, Resp: entity.AccountDetail{}},
+	"GET /api/account/:address/media": {Summary: "查询账户的媒体信息", Tag: "account", Resp: entity.AccountMediaInfo{}},
+	"POST /api/account/:address/sr": {Summary: "修改超级代表github信息，请求头 Authorization: Bearer token 或 X-Key: token", Tag: "account",
+		Body: entity.SuperAccountInfo{}, Resp: entity.SuperAccountInfo{}},
+	"GET /api/account/:address/sr":    {Summary: "查询超级代表github信息", Tag: "account", Resp: entity.SuperAccountInfo{}},
+	"GET /api/account/:address/stats": {Summary: "查询账户的交易统计", Tag: "account", Resp: entity.AccountTransactionNum{}},
+
+	//地址标签
+	"GET /api/label": {Summary: "公开地址标签列表", Tag: "label",
+		Query: []string{"category", "start", "limit"}, Resp: entity.AddressLabelsResp{}},
+	"GET /api/label/:address": {Summary: "查询地址标签，带api key时优先返回私有标签", Tag: "label", Resp: entity.AddressLabel{}},
+	"GET /api/mylabel": {Summary: "api key的私有标签列表", Tag: "label",
+		Query: []string{"category", "start", "limit"}, Resp: entity.AddressLabelsResp{}},
+	"POST /api/mylabel": {Summary: "新增或修改私有标签", Tag: "label",
+		Body: entity.AddressLabel{}, Resp: entity.AddressLabel{}},
+	"DELETE /api/mylabel/:address": {Summary: "删除私有标签", Tag: "label", Resp: ""},
+	"POST /api/mylabel/import": {Summary: "从csv导入私有标签，列为 address,label,category,note", Tag: "label",
+		Resp: entity.AddressLabelImportResp{}},
+	"GET /api/mylabel/export": {Summary: "导出私有标签csv", Tag: "label", ContentType: "text/csv"},
+
+	//投票
+	"GET /api/vote": {Summary: "查询投票列表", Tag: "vote",
+		Query: []string{"sort", "limit", "count", "start", "candidate", "voter"}, Resp: entity.VotesResp{}},
+	"GET /api/vote/live":          {Summary: "实时投票", Tag: "vote", Resp: entity.VoteLiveInfo{}},
+	"GET /api/vote/current-cycle": {Summary: "当前轮次投票", Tag: "vote", Resp: entity.VoteCurrentCycleResp{}},
+	"GET /api/vote/next-cycle":    {Summary: "下一轮投票时间", Tag: "vote", Resp: entity.VoteNextCycleResp{}},
+	"GET /api/vote/witness": {Summary: "超级代表得票排名", Tag: "vote",
+		Query: []string{"start", "limit", "address"}, Resp: entity.VoteWitnessResp{}},
+	"GET /api/vote/witness/:address": {Summary: "超级代表得票详情", Tag: "vote", Resp: entity.VoteWitnessDetail{}},
+	"GET /api/vote/cycles": {Summary: "历史投票轮次", Tag: "vote",
+		Query: []string{"start", "limit", "from", "to", "detail"}, Resp: entity.VoteCyclesResp{}},
+	"GET /api/vote/reward-estimate": {Summary: "估算投票给各超级代表的收益，单位sun", Tag: "vote",
+		Query: []string{"votes", "ratio", "cycles", "address"}, Resp: entity.RewardEstimateResp{}},
+
+	//超级代表
+	"GET /api/witness": {Summary: "超级代表列表", Tag: "witness", Resp: []*entity.WitnessInfo{}},
+	"GET /api/witness/:address": {Summary: "超级代表详情，address为maintenance-statistic时返回本轮超级代表出块统计", Tag: "witness",
+		Resp: entity.WitnessInfo{}},
+	"GET /api/witness/:address/cycles": {Summary: "超级代表历史轮次的票数、排名和出块情况", Tag: "witness",
+		Query: []string{"start", "limit", "from", "to"}, Resp: entity.VoteCycleWitnessResp{}},
+	"GET /api/witness/:address/rewards": {Summary: "超级代表每轮的出块奖励和投票奖励，单位sun", Tag: "witness",
+		Query: []string{"start", "limit", "from", "to"}, Resp: entity.WitnessRewardResp{}},
+	"GET /api/witness/:address/missed": {Summary: "超级代表的丢块记录", Tag: "witness",
+		Query: []string{"start", "limit", "from", "to"}, Resp: entity.WitnessMissedResp{}},
+
+	//通证
+	"GET /api/token": {Summary: "查询通证列表", Tag: "token",
+		Query: []string{"start", "limit", "owner", "name", "status"}, Resp: entity.TokenResp{}},
+	"GET /api/token/:name": {Summary: "按名称查询通证", Tag: "token", Resp: entity.TokenInfo{}},
+	"GET /api/token/:name/address": {Summary: "查询通证持有人", Tag: "token",
+		Query: []string{"start", "limit"}, Resp: entity.AssetBalanceResp{}},
+	"GET /api/mytoken": {Summary: "查询某地址发行的通证", Tag: "token",
+		Query: []string{"owner"}, Resp: entity.TokenResp{}},
+	"POST /api/uploadLogo": {Summary: "上传通证logo，兼容旧接口", Tag: "token",
+		Body: entity.TokenLogoReq{}, Resp: entity.UploadLogoRes{}},
+	"GET /api/download/tokenInfo": {Summary: "通证信息模板下载地址", Tag: "token", Resp: entity.TokenDownloadInfoRes{}},
+
+	//通证资料
+	"POST /api/tokenmeta/logo": {Summary: "上传通证logo，需要发行人签名", Tag: "tokenmeta",
+		Body: entity.TokenLogoReq{}, Resp: entity.TokenLogo{}},
+	"POST /api/tokenmeta": {Summary: "提交通证资料，需要发行人签名，审核通过后生效", Tag: "tokenmeta",
+		Body: entity.TokenMetaSubmission{}, Resp: entity.TokenMetaSubmission{}},
+	"GET /api/tokenmeta": {Summary: "通证资料提交记录", Tag: "tokenmeta",
+		Query: []string{"owner", "status", "start", "limit"}, Resp: entity.TokenMetaSubmissionsResp{}},
+
+	//提议
+	"GET /api/proposal": {Summary: "提议列表", Tag: "proposal",
+		Query: []string{"start", "limit", "state"}, Resp: entity.ProposalsResp{}},
+	"GET /api/proposal/:id": {Summary: "提议详情，包括赞成列表、状态变化和生效后的链参数变化", Tag: "proposal", Resp: entity.ProposalInfo{}},
+	"GET /api/chainparameters/history": {Summary: "链参数变化记录", Tag: "proposal",
+		Query: []string{"start", "limit", "key"}, Resp: entity.ChainParameterHistoryResp{}},
+
+	//交易对
+	"GET /api/exchange": {Summary: "交易对列表和当前价格", Tag: "exchange", Resp: entity.ExchangesResp{}},
+	"GET /api/exchange/:id/candles": {Summary: "交易对K线，周期 1m 5m 1h 1d", Tag: "exchange",
+		Query: []string{"interval", "from", "to", "limit"}, Resp: []*entity.ExchangeCandle{}},
+	"GET /api/exchange/:id/trades": {Summary: "交易对成交记录", Tag: "exchange",
+		Query: []string{"start", "limit", "from", "to"}, Resp: entity.ExchangeTradesResp{}},
+
+	//行情
+	"GET /api/price": {Summary: "当前价格，各行情来源报价的中位数", Tag: "price", Resp: []*entity.PriceInfo{}},
+	"GET /api/price/history": {Summary: "历史价格，每分钟一条", Tag: "price",
+		Query: []string{"symbol", "currency", "from", "to", "start", "limit"}, Resp: entity.PriceHistoryResp{}},
+
+	//统计
+	"GET /api/stats/overview":      {Summary: "每日统计", Tag: "stats", Resp: entity.ReportResp{}},
+	"GET /api/stats/overview/init": {Summary: "重新生成每日统计", Tag: "stats", Resp: ""},
+
+	//其他
+	"GET /api/system/status":  {Summary: "数据同步状态", Tag: "system", Resp: entity.SystemStatusResp{}},
+	"GET /api/market/markets": {Summary: "交易所行情", Tag: "system", Resp: []*entity.MarketInfo{}},
+	"GET /api/auth":           {Summary: "超级代表用WitnessUpdateContract交易签名登录", Tag: "auth", Body: entity.Auth{}, Resp: entity.AuthResp{}},
+
+	//登录认证
+	"GET /api/auth/challenge": {Summary: "获取登录随机数", Tag: "auth", Query: []string{"address"}, Resp: entity.AuthChallenge{}},
+	"POST /api/auth/login": {Summary: "用TRON消息签名格式签名随机数登录", Tag: "auth",
+		Body: entity.AuthLogin{}, Resp: entity.AuthToken{}},
+	"POST /api/auth/refresh": {Summary: "用refresh token换取新的token", Tag: "auth",
+		Body: entity.AuthRefresh{}, Resp: entity.AuthToken{}},
+	"POST /api/auth/logout": {Summary: "退出登录，吊销请求头中的token", Tag: "auth", Body: entity.AuthRefresh{}, Resp: ""},
+
+	//测试网水龙头
+	"POST /api/testnet/request-coins": {Summary: "申请测试币，同一地址和IP有冷却时间，超出当日额度后拒绝", Tag: "testnet",
+		Body: entity.FaucetRequest{}, Resp: entity.FaucetGrant{}},
+	"GET /api/testnet/faucet": {Summary: "水龙头状态和当日剩余额度", Tag: "testnet", Resp: entity.FaucetInfo{}},
+	"GET /api/testnet/grants": {Summary: "测试币发放记录", Tag: "testnet",
+		Query: []string{"address", "start", "limit"}, Resp: entity.FaucetGrantsResp{}},
+
+	//导出
+	"GET /api/export/transfers": {Summary: "流式导出地址的转账记录", Tag: "export",
+		Query: []string{"address", "from", "to", "format"}, ContentType: "text/csv"},
+
+	//graphql
+	"GET /api/graphql": {Summary: "graphql查询", Tag: "graphql",
+		Query: []string{"query", "operationName", "variables"}, Resp: map[string]interface{}{}},
+	"POST /api/graphql": {Summary: "graphql查询", Tag: "graphql",
+		Body: entity.GraphQLReq{}, Resp: map[string]interface{}{}},
+}
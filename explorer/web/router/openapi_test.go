@@ -0,0 +1,87 @@
+package router
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+//TestRouteSpecs 每个注册的路由都要在 routeSpecs 中有描述，描述也不能指向已删除的路由
+func TestRouteSpecs(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	routes := newRouter().Routes()
+
+	registered := make(map[string]bool, len(routes))
+	for _, route := range routes {
+		key := route.Method + " " + route.Path
+		registered[key] = true
+		if openapiIgnoreRoutes[key] {
+			continue
+		}
+		if _, ok := getRouteSpec(route.Method, route.Path); !ok {
+			t.Errorf("route [%v] has no spec in routeSpecs", key)
+		}
+	}
+	for key := range routeSpecs {
+		if !registered[key] {
+			t.Errorf("spec [%v] has no registered route", key)
+		}
+	}
+}
+
+func TestBuildOpenapiDoc(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	doc := buildOpenapiDoc(newRouter().Routes())
+	data, err := json.Marshal(doc)
+	if err != nil {
+		t.Fatalf("marshal openapi doc error:%v", err)
+	}
+	ret := struct {
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]interface{} `json:"schemas"`
+		} `json:"components"`
+	}{}
+	if err := json.Unmarshal(data, &ret); err != nil {
+		t.Fatalf("unmarshal openapi doc error:%v", err)
+	}
+	for _, path := range []string{"/api/block/{number}", "/v2/block/{number}", "/api/account/{address}/stats"} {
+		if _, ok := ret.Paths[path]["get"]; !ok {
+			t.Errorf("path [%v] not in doc", path)
+		}
+	}
+	if ret.Paths["/v2/block"]["get"].OperationID != "getV2Block" {
+		t.Errorf("operationId invalid:%v", ret.Paths["/v2/block"]["get"].OperationID)
+	}
+	for _, name := range []string{"BlockInfo", "AccountDetail", "V2Error", "V2Meta"} {
+		if _, ok := ret.Components.Schemas[name]; !ok {
+			t.Errorf("schema [%v] not in doc", name)
+		}
+	}
+
+	operationIDs := make(map[string]bool)
+	for path, methods := range ret.Paths {
+		for method, operation := range methods {
+			if operationIDs[operation.OperationID] {
+				t.Errorf("duplicate operationId [%v] at %v %v", operation.OperationID, method, path)
+			}
+			operationIDs[operation.OperationID] = true
+		}
+	}
+}
+
+func TestSwaggerUIAssets(t *testing.T) {
+	for name, encoded := range map[string]string{"swagger-ui.css": swaggerUICSS, "swagger-ui-bundle.js": swaggerUIBundleJS} {
+		compressed, raw, err := decodeSwaggerUIAsset(encoded)
+		if err != nil || len(compressed) == 0 || len(raw) <= len(compressed) {
+			t.Errorf("decode %v:%v %v %v", name, len(compressed), len(raw), err)
+		}
+	}
+	if strings.Contains(openapiDocsHTML, "https://") {
+		t.Errorf("docs page should not load external assets")
+	}
+}
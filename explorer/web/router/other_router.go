@@ -6,7 +6,9 @@ import (
 	"github.com/wlcy/tron/explorer/lib/websocket"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
 	"github.com/wlcy/tron/explorer/lib/util"
 	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/service"
@@ -15,25 +17,15 @@ import (
 func otherRegister(ginRouter *gin.Engine) {
 
 	//获得数据同步信息
-	ginRouter.GET("/api/system/status", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/system/status", func(c *gin.Context) (interface{}, error) {
 		log.Debugf("Hello /api/system/status")
-		resp, err := service.QuerySystemStatus()
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.QuerySystemStatus()
 	})
 	//交易所交易信息
-	ginRouter.GET("/api/market/markets", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/market/markets", func(c *gin.Context) (interface{}, error) {
 		log.Debugf("Hello /api/market/markets")
 		//resp, err := service.QueryMarkets()
-		resp, err := service.QueryMarketsBuffer()
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryMarketsBuffer()
 	})
 
 	ginRouter.GET("/socket.io/", func(c *gin.Context) {
@@ -47,32 +39,38 @@ func otherRegister(ginRouter *gin.Engine) {
 	})
 
 	//验签
-	ginRouter.GET("/api/auth", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/auth", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Auth{}
-		if c.BindJSON(req) == nil {
-			if req == nil {
-				log.Errorf("parsing request parameter err!")
-				c.JSON(http.StatusInternalServerError, http.ErrBodyNotAllowed)
-			}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
 		}
 		log.Debugf("Hello /api/auth %#v", req)
-		resp, err := service.QueryAuth(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+		return service.QueryAuth(req)
+	})
+	//申请测试币，只在非主网启用
+	apiRoute(ginRouter, "POST", "/testnet/request-coins", func(c *gin.Context) (interface{}, error) {
+		req := &entity.FaucetRequest{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
 		}
-		c.JSON(http.StatusOK, resp)
+		log.Debugf("Hello /api/testnet/request-coins %#v", req)
+		return service.RequestTestCoin(req, getClientIP(c))
+	})
+
+	//水龙头状态
+	apiRoute(ginRouter, "GET", "/testnet/faucet", func(c *gin.Context) (interface{}, error) {
+		log.Debugf("Hello /api/testnet/faucet")
+		return service.QueryFaucetInfo()
+	})
+
+	//测试币发放记录 ?address=&start=0&limit=20
+	apiRoute(ginRouter, "GET", "/testnet/grants", func(c *gin.Context) (interface{}, error) {
+		start := mysql.ConvertStringToInt64(c.Query("start"), 0)
+		limit := mysql.ConvertStringToInt64(c.Query("limit"), 20)
+		log.Debugf("Hello /api/testnet/grants?%v", c.Request.URL.RawQuery)
+		return service.QueryFaucetGrants(c.Query("address"), start, limit)
 	})
-	/*
-		//申请测试币
-		ginRouter.GET("/api/testnet/request-coins", func(c *gin.Context) {
-			log.Debugf("Hello /api/testnet/request-coins")
-			resp, err := service.QueryTestRequestCoin()
-			if err != nil {
-				errCode, _ := util.GetErrorCode(err)
-				c.JSON(errCode, err)
-			}
-			c.JSON(http.StatusOK, resp)
-		})*/
 
 }
@@ -0,0 +1,31 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+func priceRegister(ginRouter *gin.Engine) {
+
+	apiRoute(ginRouter, "GET", "/price", func(c *gin.Context) (interface{}, error) {
+		log.Debugf("Hello /api/price")
+		return service.QueryPrices()
+	})
+
+	//?symbol=TRX&currency=USD&from=1539100800000&to=1539187200000&start=0&limit=20
+	apiRoute(ginRouter, "GET", "/price/history", func(c *gin.Context) (interface{}, error) {
+		req := &entity.PriceHistory{}
+		req.Symbol = c.DefaultQuery("symbol", "TRX")
+		req.Currency = c.DefaultQuery("currency", "USD")
+		req.From = mysql.ConvertStringToInt64(c.Query("from"), 0)
+		req.To = mysql.ConvertStringToInt64(c.Query("to"), 0)
+		req.Start = mysql.ConvertStringToInt64(c.Query("start"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
+		log.Debugf("Hello /api/price/history?%#v", req)
+		return service.QueryPriceHistory(req)
+	})
+
+}
@@ -0,0 +1,43 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+func proposalRegister(ginRouter *gin.Engine) {
+
+	//?start=0&limit=20&state=pending
+	apiRoute(ginRouter, "GET", "/proposal", func(c *gin.Context) (interface{}, error) {
+		req := &entity.Proposals{}
+		req.Start = mysql.ConvertStringToInt64(c.Query("start"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
+		req.State = c.Query("state")
+		log.Debugf("Hello /api/proposal?%#v", req)
+		return service.QueryProposals(req)
+	})
+
+	apiRoute(ginRouter, "GET", "/proposal/:id", func(c *gin.Context) (interface{}, error) {
+		proposalID := mysql.ConvertStringToInt64(c.Param("id"), -1)
+		log.Debugf("Hello /api/proposal/:%v", proposalID)
+		if proposalID < 0 {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		return service.QueryProposal(proposalID)
+	})
+
+	//?start=0&limit=20&key=getEnergyFee
+	apiRoute(ginRouter, "GET", "/chainparameters/history", func(c *gin.Context) (interface{}, error) {
+		req := &entity.ChainParameterHistory{}
+		req.Start = mysql.ConvertStringToInt64(c.Query("start"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
+		req.Key = c.Query("key")
+		log.Debugf("Hello /api/chainparameters/history?%#v", req)
+		return service.QueryChainParameterHistory(req)
+	})
+
+}
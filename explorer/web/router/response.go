@@ -0,0 +1,145 @@
+package router
+
+import (
+	"net/http"
+	"reflect"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+/*
+查询接口同时提供 /api 和 /v2 两套路径
+/api 保持原有的响应格式：成功时直接返回数据，失败时返回错误对象，状态码按错误码映射
+/v2 统一使用 entity.V2Response 信封，错误信息按 Accept-Language 选择语言，状态码按错误码映射
+*/
+
+const v2Prefix = "/v2"
+
+//apiHandler 接口处理函数，只负责解析参数和调用service，响应格式由注册的路径决定
+type apiHandler func(c *gin.Context) (interface{}, error)
+
+//apiRoute 同时注册 /api 和 /v2 路由
+func apiRoute(ginRouter *gin.Engine, method, path string, handler apiHandler) {
+	ginRouter.Handle(method, "/api"+path, legacyResponse(handler))
+	ginRouter.Handle(method, v2Prefix+path, v2Response(handler))
+}
+
+//legacyResponse /api 的响应格式
+func legacyResponse(handler apiHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := handler(c)
+		if err != nil {
+			errCode, _ := util.GetErrorCode(err)
+			c.JSON(util.GetHTTPStatus(errCode), err)
+			return
+		}
+		c.JSON(http.StatusOK, resp)
+	}
+}
+
+//v2Response /v2 的响应格式
+func v2Response(handler apiHandler) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		resp, err := handler(c)
+		if err == nil && isNilResp(resp) {
+			err = util.NewErrorMsg(util.Error_common_no_data)
+		}
+		if err != nil {
+			writeV2Error(c, err)
+			return
+		}
+		c.JSON(http.StatusOK, newV2Response(c, resp))
+	}
+}
+
+func isV2Request(c *gin.Context) bool {
+	return strings.HasPrefix(c.Request.URL.Path, v2Prefix+"/")
+}
+
+//abortWithError 中间件中止请求，/v2 请求使用统一信封，其他请求保持原有格式
+func abortWithError(c *gin.Context, status int, err error) {
+	if isV2Request(c) {
+		writeV2Error(c, err)
+		c.Abort()
+		return
+	}
+	c.AbortWithStatusJSON(status, err)
+}
+
+func writeV2Error(c *gin.Context, err error) {
+	errCode, ok := util.GetErrorCode(err)
+	if !ok {
+		log.FromContext(c.Request.Context()).Errorf("%v %v error:[%v]", c.Request.Method, c.Request.URL.Path, err)
+		errCode = util.Error_common_internal_error
+	}
+	lang := util.ParseAcceptLanguage(c.GetHeader("Accept-Language"))
+	v2Err := &entity.V2Error{
+		Code:    errCode,
+		Message: util.GetErrorMsgByLang(errCode, lang),
+	}
+	//用 NewError 自定义的错误信息放在details中
+	if msg := util.GetErrorMessage(err); ok && msg != util.GetErrorMsgSleek(errCode) {
+		v2Err.Details = msg
+	}
+	c.Header("Content-Language", lang)
+	c.JSON(util.GetHTTPStatus(errCode), &entity.V2Response{Error: v2Err})
+}
+
+//newV2Response 列表结果（只有Total和Data两个字段）拆成data和meta，其他结果原样放在data中
+func newV2Response(c *gin.Context, resp interface{}) *entity.V2Response {
+	ret := &entity.V2Response{Data: resp, Meta: &entity.V2Meta{}}
+	val := reflect.Indirect(reflect.ValueOf(resp))
+	if !val.IsValid() || !isListResp(val.Type()) {
+		return ret
+	}
+	totalNum := val.FieldByName("Total").Int()
+	ret.Data = val.FieldByName("Data").Interface()
+	ret.Meta.Total = &totalNum
+	if start := mysql.ConvertStringToInt64(c.Query("start"), -1); start >= 0 {
+		ret.Meta.Start = &start
+	}
+	if limit := mysql.ConvertStringToInt64(c.Query("limit"), -1); limit >= 0 {
+		ret.Meta.Limit = &limit
+	}
+	return ret
+}
+
+//isListResp 列表结果只有 Total(int64) 和 Data(切片) 两个字段
+func isListResp(t reflect.Type) bool {
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct || t.NumField() != 2 {
+		return false
+	}
+	total, ok := t.FieldByName("Total")
+	if !ok || total.Type.Kind() != reflect.Int64 {
+		return false
+	}
+	data, ok := t.FieldByName("Data")
+	return ok && data.Type.Kind() == reflect.Slice
+}
+
+func isNilResp(resp interface{}) bool {
+	if resp == nil {
+		return true
+	}
+	val := reflect.ValueOf(resp)
+	switch val.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Interface:
+		return val.IsNil()
+	}
+	return false
+}
+
+//v2NoRoute /v2 下不存在的接口也返回统一信封，其他路径保持gin默认的404
+func v2NoRoute(c *gin.Context) {
+	if isV2Request(c) {
+		writeV2Error(c, util.NewErrorMsg(util.Error_common_not_suport_request_url))
+	}
+}
@@ -0,0 +1,51 @@
+package router
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestNewV2Response(t *testing.T) {
+	c, _ := gin.CreateTestContext(httptest.NewRecorder())
+	c.Request, _ = http.NewRequest("GET", "/v2/block?start=20&limit=10", nil)
+
+	list := &entity.BlocksResp{Total: 100, Data: []*entity.BlockInfo{{Number: 1}}}
+	resp := newV2Response(c, list)
+	if data, ok := resp.Data.([]*entity.BlockInfo); !ok || len(data) != 1 {
+		t.Errorf("list data not unwrapped:%#v", resp.Data)
+	}
+	if resp.Meta.Total == nil || *resp.Meta.Total != 100 || *resp.Meta.Start != 20 || *resp.Meta.Limit != 10 {
+		t.Errorf("list meta invalid:%#v", resp.Meta)
+	}
+
+	info := &entity.BlockInfo{Number: 1}
+	resp = newV2Response(c, info)
+	if resp.Data != info || resp.Meta.Total != nil {
+		t.Errorf("object response changed:%#v", resp)
+	}
+}
+
+func TestLegacyResponseStatus(t *testing.T) {
+	handler := legacyResponse(func(c *gin.Context) (interface{}, error) {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	})
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request, _ = http.NewRequest("GET", "/api/block", nil)
+	handler(c)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("legacy error status:%v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestIsNilResp(t *testing.T) {
+	var info *entity.BlockInfo
+	if !isNilResp(nil) || !isNilResp(info) || isNilResp(&entity.BlockInfo{}) || isNilResp([]*entity.BlockInfo{}) {
+		t.Errorf("isNilResp check fail")
+	}
+}
@@ -0,0 +1,40 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/service"
+	"github.com/wlcy/tron/explorer/web/task"
+)
+
+func taskRegister(adminGroup *gin.RouterGroup) {
+
+	adminRoute(adminGroup, "GET", "/task", func(c *gin.Context) (interface{}, error) {
+		log.Debugf("Hello /api/admin/task")
+		return task.QueryTasks(), nil
+	})
+
+	//如 assetIssueParticipated 立即同步通证参与数
+	adminRoute(adminGroup, "POST", "/task/:name/run", func(c *gin.Context) (interface{}, error) {
+		name := c.Param("name")
+		log.Infof("Hello /api/admin/task/:%v/run", name)
+		if err := task.TriggerTask(name); err != nil {
+			return nil, err
+		}
+		return gin.H{"name": name, "status": "triggered"}, nil
+	})
+
+	//?start=0&limit=20
+	adminRoute(adminGroup, "GET", "/task/:name/runs", func(c *gin.Context) (interface{}, error) {
+		name := c.Param("name")
+		log.Debugf("Hello /api/admin/task/:%v/runs", name)
+		if !task.HasTask(name) {
+			return nil, util.NewErrorMsg(util.Error_common_no_data)
+		}
+		start := mysql.ConvertStringToInt64(c.Query("start"), 0)
+		limit := mysql.ConvertStringToInt64(c.Query("limit"), 20)
+		return service.QueryTaskRuns(name, start, limit)
+	})
+}
@@ -14,7 +14,7 @@ import (
 )
 
 func tokenRegister(ginRouter *gin.Engine) {
-	ginRouter.GET("/api/token", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/token", func(c *gin.Context) (interface{}, error) {
 		tokenReq := &entity.Token{}
 		tokenReq.Start = c.Query("start")
 		tokenReq.Limit = c.Query("limit")
@@ -39,11 +39,14 @@ func tokenRegister(ginRouter *gin.Engine) {
 			log.Info("service.QueryTokens")
 			tokenResp, err = service.QueryTokens(tokenReq)
 		}
+		if err != nil {
+			return nil, err
+		}
 
 		// handleTokenRespData
 		tokenResp = handleTokenRespData(tokenResp)
 
-		if tokenReq.Owner != "" && tokenReq.Name != "" && !strings.HasPrefix(tokenReq.Name, "%") && !strings.HasSuffix(tokenReq.Name, "%") {
+		if len(tokenResp.Data) > 0 && tokenReq.Owner != "" && tokenReq.Name != "" && !strings.HasPrefix(tokenReq.Name, "%") && !strings.HasSuffix(tokenReq.Name, "%") {
 			// QueryTotalTokenTransfers
 			totalTokenTransfers, _ := service.QueryTotalTokenTransfers(tokenReq.Name)
 			tokenResp.Data[0].TotalTransactions = totalTokenTransfers
@@ -52,11 +55,6 @@ func tokenRegister(ginRouter *gin.Engine) {
 			tokenResp.Data[0].NrOfTokenHolders = totalTokenHolders
 		}
 
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-			return
-		}
 		tokenInfoList := tokenResp.Data
 		length := len(tokenInfoList)
 		tokenResp.Total = int64(length)
@@ -73,22 +71,17 @@ func tokenRegister(ginRouter *gin.Engine) {
 		}
 		handleTokensIndex(tokenReq, tokenResp)
 
-		c.JSON(http.StatusOK, tokenResp)
+		return tokenResp, nil
 	})
 
-	ginRouter.GET("/api/token/:name", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/token/:name", func(c *gin.Context) (interface{}, error) {
 		name := c.Param("name")
 		log.Debugf("Hello /api/token/:%#v", name)
-		tokenInfo, err := service.QueryToken(name)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, tokenInfo)
+		return service.QueryToken(name)
 	})
 
 
-	ginRouter.GET("/api/token/:name/address", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/token/:name/address", func(c *gin.Context) (interface{}, error) {
 		tokenReq := &entity.Token{}
 		tokenReq.Name = c.Param("name")
 		tokenReq.Start = c.Query("start")
@@ -99,15 +92,10 @@ func tokenRegister(ginRouter *gin.Engine) {
 			tokenReq.Limit = "50"
 		}
 
-		assetBalanceResp, err := service.QueryAssetBalances(tokenReq)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, assetBalanceResp)
+		return service.QueryAssetBalances(tokenReq)
 	})
 
-	ginRouter.GET("/api/mytoken", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/mytoken", func(c *gin.Context) (interface{}, error) {
 		tokenReq := &entity.Token{}
 		tokenReq.Owner = c.Query("owner")
 		log.Debugf("Hello /api/mytoken?%#v", tokenReq)
@@ -117,35 +105,20 @@ func tokenRegister(ginRouter *gin.Engine) {
 			tokenReq.Start = "0"
 			tokenReq.Limit = "40"
 		}
-		tokenResp, err := service.QueryTokens(tokenReq)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, tokenResp)
+		return service.QueryTokens(tokenReq)
 	})
 
+	//兼容旧接口，需要发行人签名，见 POST /api/tokenmeta/logo
 	ginRouter.POST("/api/uploadLogo", func(c *gin.Context) {
 		res := &entity.UploadLogoRes{}
-		var uploadLogoReq entity.UploadLogoReq
-		if err := c.Bind(&uploadLogoReq); err != nil {
-			res.Success = false
-			c.JSON(http.StatusBadRequest, res)
-			return
-		}
-
-		if uploadLogoReq.ImageData == "" || uploadLogoReq.Address == "" {
+		uploadLogoReq := &entity.TokenLogoReq{}
+		if err := c.Bind(uploadLogoReq); err != nil {
 			res.Success = false
 			c.JSON(http.StatusBadRequest, res)
 			return
 		}
-		//传入data格式：data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAKAAAACgCAYA...
-		if len(strings.Split(uploadLogoReq.ImageData, ",")) > 1 {
-			uploadLogoReq.ImageData = strings.Split(uploadLogoReq.ImageData, ",")[1]
-		}
-
-		dst, err := service.UploadTokenLogo(config.DefaultPath, config.ImgURL, uploadLogoReq.ImageData, uploadLogoReq.Address)
 
+		tokenLogo, err := service.UploadTokenLogo(uploadLogoReq)
 		if err != nil {
 			errCode, _ := util.GetErrorCode(err)
 			res.Success = false
@@ -153,8 +126,8 @@ func tokenRegister(ginRouter *gin.Engine) {
 			return
 		}
 
-		res.Success =true
-		res.Data = dst
+		res.Success = true
+		res.Data = tokenLogo.LogoURL
 		c.JSON(http.StatusOK, res)
 	})
 
@@ -168,11 +141,6 @@ func tokenRegister(ginRouter *gin.Engine) {
 		res.Data = tokenFile
 		c.JSON(http.StatusOK, res)
 	})
-
-	ginRouter.GET("/api/sync/participated", func(c *gin.Context) {
-		service.SyncAssetIssueParticipated()
-		c.JSON(http.StatusOK, "handle done")
-	})
 }
 
 // handleTokensIndex
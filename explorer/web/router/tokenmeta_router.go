@@ -0,0 +1,77 @@
+package router
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+func tokenMetaRegister(ginRouter *gin.Engine) {
+
+	//上传通证logo，需要发行人签名
+	apiRoute(ginRouter, "POST", "/tokenmeta/logo", func(c *gin.Context) (interface{}, error) {
+		req := &entity.TokenLogoReq{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Debugf("Hello /api/tokenmeta/logo owner:[%v]", req.OwnerAddress)
+		return service.UploadTokenLogo(req)
+	})
+
+	//提交通证资料，需要发行人签名，审核通过后生效
+	apiRoute(ginRouter, "POST", "/tokenmeta", func(c *gin.Context) (interface{}, error) {
+		req := &entity.TokenMetaSubmission{}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+		log.Debugf("Hello /api/tokenmeta owner:[%v]", req.OwnerAddress)
+		return service.SubmitTokenMeta(req)
+	})
+
+	//?owner=TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3&status=0&start=0&limit=20
+	apiRoute(ginRouter, "GET", "/tokenmeta", func(c *gin.Context) (interface{}, error) {
+		owner := c.Query("owner")
+		status := mysql.ConvertStringToInt64(c.Query("status"), -1)
+		start := mysql.ConvertStringToInt64(c.Query("start"), 0)
+		limit := mysql.ConvertStringToInt64(c.Query("limit"), 20)
+		log.Debugf("Hello /api/tokenmeta?%v", c.Request.URL.RawQuery)
+		return service.QueryTokenMetaSubmissions(owner, status, start, limit)
+	})
+
+}
+
+//tokenMetaAdminRegister 注册通证资料审核路由，在管理接口上监听
+func tokenMetaAdminRegister(adminGroup *gin.RouterGroup) {
+
+	adminRoute(adminGroup, "POST", "/tokenmeta/:id/approve", func(c *gin.Context) (interface{}, error) {
+		return reviewTokenMeta(c, true)
+	})
+
+	//{"reason":"website unreachable"}
+	adminRoute(adminGroup, "POST", "/tokenmeta/:id/reject", func(c *gin.Context) (interface{}, error) {
+		return reviewTokenMeta(c, false)
+	})
+
+}
+
+func reviewTokenMeta(c *gin.Context, approve bool) (*entity.TokenMetaSubmission, error) {
+	id := mysql.ConvertStringToInt64(c.Param("id"), 0)
+	req := &entity.TokenMetaReview{}
+	if !approve {
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
+		}
+	}
+	log.Debugf("Hello /api/admin/tokenmeta/:%v approve:[%v] %#v", id, approve, req)
+	if id <= 0 {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	return service.ReviewTokenMeta(id, approve, req.Reason)
+}
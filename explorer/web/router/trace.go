@@ -0,0 +1,44 @@
+package router
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/trace"
+)
+
+//traceMiddleware 为请求创建server span并放入请求的context，使用 c.Request.Context() 的mysql、redis、grpc调用记录为子span
+//	请求头带 traceparent 时沿用调用方的trace，响应头 X-Trace-Id 返回trace id，访问日志带 trace_id 字段
+func traceMiddleware(routeOf func(method, path string) string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !trace.Enabled() {
+			c.Next()
+			return
+		}
+		method := c.Request.Method
+		route := routeOf(method, c.Request.URL.Path)
+		ctx, span := trace.StartServer(c.Request.Context(), method+" "+route, c.GetHeader("traceparent"),
+			"http.method", method,
+			"http.route", route,
+			"http.target", c.Request.URL.RequestURI(),
+			"http.client_ip", getClientIP(c),
+			log.RequestIDKey, log.ContextValue(c.Request.Context(), log.RequestIDKey),
+		)
+		if span == nil {
+			c.Next()
+			return
+		}
+		defer span.End()
+		c.Header("X-Trace-Id", span.TraceID())
+		c.Request = c.Request.WithContext(log.NewContext(ctx, log.TraceIDKey, span.TraceID()))
+
+		c.Next()
+		status := c.Writer.Status()
+		span.SetAttr("http.status_code", status)
+		if status >= http.StatusInternalServerError {
+			span.SetError(fmt.Errorf("http status %v", status))
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package router
+
+import (
+	"context"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/wlcy/tron/explorer/lib/trace"
+)
+
+type recordExporter struct {
+	mutex sync.Mutex
+	spans []*trace.SpanData
+}
+
+func (e *recordExporter) Export(spans []*trace.SpanData) error {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func TestTraceMiddleware(t *testing.T) {
+	exporter := &recordExporter{}
+	trace.Setup(trace.Options{Exporter: exporter, SamplePercent: 100})
+	defer trace.Shutdown(context.Background())
+
+	ginRouter := gin.New()
+	ginRouter.Use(traceMiddleware(newRouteOf(ginRouter)))
+	ginRouter.GET("/api/account/:address", func(c *gin.Context) {
+		span := trace.StartClient(c.Request.Context(), "mysql select")
+		span.End()
+		c.String(200, "ok")
+	})
+
+	w := httptest.NewRecorder()
+	r := httptest.NewRequest("GET", "/api/account/TXYZ", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	ginRouter.ServeHTTP(w, r)
+	if w.Header().Get("X-Trace-Id") != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Errorf("X-Trace-Id:%v", w.Header().Get("X-Trace-Id"))
+	}
+
+	trace.Flush(context.Background())
+	exporter.mutex.Lock()
+	defer exporter.mutex.Unlock()
+	if len(exporter.spans) != 2 {
+		t.Fatalf("spans:%v", len(exporter.spans))
+	}
+	mysql, server := exporter.spans[0], exporter.spans[1]
+	if server.Name != "GET /api/account/:address" || server.Attributes["http.status_code"] != 200 || server.ParentID.String() != "00f067aa0ba902b7" {
+		t.Errorf("server span:%+v", server)
+	}
+	if mysql.ParentID != server.SpanID || mysql.TraceID != server.TraceID {
+		t.Errorf("mysql span should be child of server span:%+v", mysql)
+	}
+}
@@ -1,9 +1,8 @@
 package router
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/mysql"
 	"github.com/wlcy/tron/explorer/lib/util"
@@ -14,7 +13,7 @@ import (
 func transactionRegister(ginRouter *gin.Engine) {
 
 	//?sort=-number&limit=1&count=true&number=2135998
-	ginRouter.GET("/api/transaction", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/transaction", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Transactions{}
 		req.Sort = c.Query("sort")
 		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
@@ -29,14 +28,13 @@ func transactionRegister(ginRouter *gin.Engine) {
 		log.Debugf("Hello /api/transaction?%#v", req)
 		//resp, err := service.QueryTransactions(req)
 		resp, err := service.QueryTransactionsBuffer(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+		if resp != nil {
+			resp.Data = service.LabelTransactions(resp.Data, getRequestAPIKey(c))
 		}
-		c.JSON(http.StatusOK, resp)
+		return resp, err
 	})
 	//:number=2135998
-	ginRouter.GET("/api/transaction/:hash", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/transaction/:hash", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Transactions{}
 		req.Hash = c.Param("hash") //占位符传参
 		log.Debugf("Hello /api/transaction/:%#v", req.Hash)
@@ -44,28 +42,20 @@ func transactionRegister(ginRouter *gin.Engine) {
 		if resp == nil {
 			resp, err = service.QueryTransaction(req)
 		}
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+		if resp != nil {
+			resp = service.LabelTransactions([]*entity.TransactionInfo{resp}, getRequestAPIKey(c))[0]
 		}
-		c.JSON(http.StatusOK, resp)
+		return resp, err
 	})
 
-	ginRouter.POST("/api/transaction", func(c *gin.Context) {
+	apiRoute(ginRouter, "POST", "/transaction", func(c *gin.Context) (interface{}, error) {
 		req := &entity.PostTransaction{}
-		if c.BindJSON(req) == nil {
-			if req == nil {
-				log.Errorf("parsing request parameter err!")
-				c.JSON(http.StatusInternalServerError, http.ErrBodyNotAllowed)
-			}
+		if err := binding.JSON.Bind(c.Request, req); err != nil {
+			log.Errorf("parsing request parameter err:[%v]", err)
+			return nil, util.NewErrorMsg(util.Error_common_request_json_convert_error)
 		}
 		log.Debugf("Hello /api/transaction")
-		resp, err := service.PostTransaction(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.PostTransaction(req)
 	})
 
 }
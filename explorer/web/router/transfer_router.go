@@ -1,12 +1,9 @@
 package router
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/mysql"
-	"github.com/wlcy/tron/explorer/lib/util"
 	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/service"
 )
@@ -14,7 +11,7 @@ import (
 func transferRegister(ginRouter *gin.Engine) {
 
 	//?sort=-number&limit=1&count=true&number=2135998
-	ginRouter.GET("/api/transfer", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/transfer", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Transfers{}
 		req.Sort = c.Query("sort")
 		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
@@ -27,26 +24,24 @@ func transferRegister(ginRouter *gin.Engine) {
 			req.Number = c.Query("block")
 		}
 		log.Debugf("Hello /api/transfer?%#v", req)
-		resp, err := service.QueryTransfersBuffer(req)
 		//resp, err := service.QueryTransfers(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+		resp, err := service.QueryTransfersBuffer(req)
+		if resp != nil {
+			resp.Data = service.LabelTransfers(resp.Data, getRequestAPIKey(c))
 		}
-		c.JSON(http.StatusOK, resp)
+		return resp, err
 	})
 	//:number=2135998
-	ginRouter.GET("/api/transfer/:hash", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/transfer/:hash", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Transfers{}
 		req.Hash = c.Param("hash") //占位符传参
 		log.Debugf("Hello /api/transfer/:%#v", req.Hash)
-		resp, err := service.QueryTransferByHashFromBuffer(req)
 		//resp, err := service.QueryTransfer(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+		resp, err := service.QueryTransferByHashFromBuffer(req)
+		if resp != nil {
+			resp = service.LabelTransfers([]*entity.TransferInfo{resp}, getRequestAPIKey(c))[0]
 		}
-		c.JSON(http.StatusOK, resp)
+		return resp, err
 	})
 
 }
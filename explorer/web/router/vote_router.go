@@ -1,13 +1,10 @@
 package router
 
 import (
-	"net/http"
-
 	"github.com/wlcy/tron/explorer/lib/mysql"
 
 	"github.com/gin-gonic/gin"
 	"github.com/wlcy/tron/explorer/lib/log"
-	"github.com/wlcy/tron/explorer/lib/util"
 	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/service"
 )
@@ -15,7 +12,7 @@ import (
 func voteRegister(ginRouter *gin.Engine) {
 
 	//?sort=-number&limit=1&count=true&number=2135998
-	ginRouter.GET("/api/vote", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/vote", func(c *gin.Context) (interface{}, error) {
 		req := &entity.Votes{}
 		req.Sort = c.Query("sort")
 		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
@@ -24,65 +21,62 @@ func voteRegister(ginRouter *gin.Engine) {
 		req.Candidate = c.Query("candidate")
 		req.Voter = c.Query("voter")
 		log.Debugf("Hello /api/vote?%#v", req)
-		resp, err := service.QueryVotes(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryVotes(req)
 	})
 
-	ginRouter.GET("/api/vote/live", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/vote/live", func(c *gin.Context) (interface{}, error) {
 		log.Debugf("Hello /api/vote/live")
 		//resp, err := service.QueryVoteLive()
-		resp, err := service.QueryVoteLiveBuffer()
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryVoteLiveBuffer()
 	})
 
-	ginRouter.GET("/api/vote/current-cycle", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/vote/current-cycle", func(c *gin.Context) (interface{}, error) {
 		log.Debugf("Hello /api/vote/current-cycle")
 		//	resp, err := service.QueryVoteCurrentCycle()
-		resp, err := service.QueryVoteCurrentCycleBuffer()
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryVoteCurrentCycleBuffer()
 	})
 
-	ginRouter.GET("/api/vote/next-cycle", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/vote/next-cycle", func(c *gin.Context) (interface{}, error) {
 		log.Debugf("Hello /api/vote/next-cycle")
 		//resp, err := service.QueryVoteNextCycle()
-		resp, err := service.QueryVoteNextCycleBuffer()
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryVoteNextCycleBuffer()
+	})
+
+	apiRoute(ginRouter, "GET", "/vote/cycles", func(c *gin.Context) (interface{}, error) {
+		req := &entity.VoteCycles{}
+		req.Start = mysql.ConvertStringToInt64(c.Query("start"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
+		req.From = mysql.ConvertStringToInt64(c.Query("from"), 0)
+		req.To = mysql.ConvertStringToInt64(c.Query("to"), 0)
+		req.Detail = c.Query("detail") == "true"
+		log.Debugf("Hello /api/vote/cycles?%#v", req)
+		return service.QueryVoteCycles(req)
+	})
+
+	//?votes=1000&ratio=80&cycles=4
+	apiRoute(ginRouter, "GET", "/vote/reward-estimate", func(c *gin.Context) (interface{}, error) {
+		req := &entity.RewardEstimateReq{}
+		req.Votes = mysql.ConvertStringToInt64(c.Query("votes"), 0)
+		req.Ratio = mysql.ConvertStringToInt64(c.Query("ratio"), -1)
+		req.Cycles = mysql.ConvertStringToInt64(c.Query("cycles"), 0)
+		req.Address = c.Query("address")
+		log.Debugf("Hello /api/vote/reward-estimate?%#v", req)
+		return service.EstimateVoterReward(req)
 	})
 
-	ginRouter.GET("/api/vote/witness", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/vote/witness", func(c *gin.Context) (interface{}, error) {
 		req := &entity.VoteWitnessReq{}
 		req.Start = c.Query("start")
 		req.Limit = c.Query("limit")
 		req.Address = c.Query("address")
 
-		resp, err := service.QueryVoteWitness(req)
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryVoteWitness(req)
 	})
 
-	ginRouter.GET("/api/vote/witness/:address", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/vote/witness/:address", func(c *gin.Context) (interface{}, error) {
 		address := c.Param("address")
 		resp, _ := service.QueryVoteWitnessDetail(address)
-		c.JSON(http.StatusOK, resp)
+		return resp, nil
 	})
 
 }
@@ -1,36 +1,64 @@
 package router
 
 import (
-	"net/http"
-
 	"github.com/gin-gonic/gin"
 	"github.com/wlcy/tron/explorer/lib/log"
-	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/web/entity"
 	"github.com/wlcy/tron/explorer/web/service"
 )
 
 func witnessRegister(ginRouter *gin.Engine) {
 
-	ginRouter.GET("/api/witness", func(c *gin.Context) {
+	apiRoute(ginRouter, "GET", "/witness", func(c *gin.Context) (interface{}, error) {
 		log.Debugf("Hello /api/witness")
 		//resp, err := service.QueryWitness()
-		resp, err := service.QueryWitnessBuffer()
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
-		}
-		c.JSON(http.StatusOK, resp)
+		return service.QueryWitnessBuffer()
 	})
 
-	ginRouter.GET("/api/witness/maintenance-statistic", func(c *gin.Context) {
-		log.Debugf("Hello /api/witness/maintenance-statistic")
-		//resp, err := service.QueryWitnessStatistic()
-		resp, err := service.QueryWitnessStatisticBuffer()
-		if err != nil {
-			errCode, _ := util.GetErrorCode(err)
-			c.JSON(errCode, err)
+	//路由库不允许同一层级同时存在静态路径和参数，maintenance-statistic 在 :address 中分发
+	apiRoute(ginRouter, "GET", "/witness/:address", func(c *gin.Context) (interface{}, error) {
+		address := c.Param("address")
+		if address == "maintenance-statistic" {
+			log.Debugf("Hello /api/witness/maintenance-statistic")
+			//resp, err := service.QueryWitnessStatistic()
+			return service.QueryWitnessStatisticBuffer()
 		}
-		c.JSON(http.StatusOK, resp)
+		log.Debugf("Hello /api/witness/:%v", address)
+		return service.QueryWitnessByAddrBuffer(address)
+	})
+
+	apiRoute(ginRouter, "GET", "/witness/:address/cycles", func(c *gin.Context) (interface{}, error) {
+		req := &entity.VoteCycles{}
+		req.Address = c.Param("address")
+		req.Start = mysql.ConvertStringToInt64(c.Query("start"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
+		req.From = mysql.ConvertStringToInt64(c.Query("from"), 0)
+		req.To = mysql.ConvertStringToInt64(c.Query("to"), 0)
+		log.Debugf("Hello /api/witness/:%v/cycles?%#v", req.Address, req)
+		return service.QueryWitnessCycles(req)
+	})
+
+	apiRoute(ginRouter, "GET", "/witness/:address/rewards", func(c *gin.Context) (interface{}, error) {
+		req := &entity.VoteCycles{}
+		req.Address = c.Param("address")
+		req.Start = mysql.ConvertStringToInt64(c.Query("start"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
+		req.From = mysql.ConvertStringToInt64(c.Query("from"), 0)
+		req.To = mysql.ConvertStringToInt64(c.Query("to"), 0)
+		log.Debugf("Hello /api/witness/:%v/rewards?%#v", req.Address, req)
+		return service.QueryWitnessRewards(req)
+	})
+
+	apiRoute(ginRouter, "GET", "/witness/:address/missed", func(c *gin.Context) (interface{}, error) {
+		req := &entity.VoteCycles{}
+		req.Address = c.Param("address")
+		req.Start = mysql.ConvertStringToInt64(c.Query("start"), 0)
+		req.Limit = mysql.ConvertStringToInt64(c.Query("limit"), 40)
+		req.From = mysql.ConvertStringToInt64(c.Query("from"), 0)
+		req.To = mysql.ConvertStringToInt64(c.Query("to"), 0)
+		log.Debugf("Hello /api/witness/:%v/missed?%#v", req.Address, req)
+		return service.QueryWitnessMissed(req)
 	})
 
 }
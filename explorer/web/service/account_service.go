@@ -1,15 +1,15 @@
 package service
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"strings"
 
-	"github.com/wlcy/tron/explorer/lib/config"
-
-	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/wlcy/tron/explorer/core/utils"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/trace"
 	"github.com/wlcy/tron/explorer/lib/util"
 	"github.com/wlcy/tron/explorer/web/buffer"
 
@@ -116,7 +116,9 @@ func QueryAccounts(req *entity.Accounts) (*entity.AccountsResp, error) {
 }
 
 //QueryAccount 精确查询  	//number=2135998   添加数据库索引
-func QueryAccount(req *entity.Accounts) (*entity.AccountDetail, error) {
+func QueryAccount(ctx context.Context, req *entity.Accounts) (*entity.AccountDetail, error) {
+	ctx, span := trace.Start(ctx, "service.QueryAccount", "account.address", req.Address)
+	defer span.End()
 	var filterSQL string
 	strSQL := fmt.Sprintf(`
 	select account_name,acc.address,acc.balance as totalBalance,frozen,create_time,latest_operation_time,votes ,
@@ -132,7 +134,15 @@ func QueryAccount(req *entity.Accounts) (*entity.AccountDetail, error) {
 	if req.Address != "" {
 		filterSQL = fmt.Sprintf(" and (acc.address='%v' or acc.account_name='%v')", req.Address, req.Address)
 	}
-	return module.QueryAccountRealize(strSQL, filterSQL)
+	account, err := module.QueryAccountRealize(ctx, strSQL, filterSQL)
+	if err != nil || account.Address == "" {
+		return account, err
+	}
+	//估值失败不影响账户查询
+	if account.Valuation, err = getAccountValuation(ctx, account, req.Time); err != nil {
+		log.Errorf("QueryAccount address:[%v] valuation err:[%v]", account.Address, err)
+	}
+	return account, nil
 }
 
 //QueryAccountMedia 查询账户媒体信息 	//number=2135998
@@ -173,6 +183,19 @@ func UpdateAccountSr(req *entity.SuperAccountInfo, token string) (*entity.SuperA
 	return srAccount, err
 }
 
+//UpdateWitnessMeta 管理员修改超级代表的主页和github地址，保存后刷新投票缓存
+func UpdateWitnessMeta(meta *entity.WitnessMeta) (*entity.WitnessMeta, error) {
+	if len(utils.Base58DecodeAddr(meta.Address)) != 21 || !isTokenMetaURL(meta.URL) || !isTokenMetaURL(meta.GithubLink) {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	if err := module.SaveWitnessMeta(meta); err != nil {
+		return nil, err
+	}
+	log.Infof("UpdateWitnessMeta address:[%v] updated by admin", meta.Address)
+	buffer.ReloadBuffer("vote")
+	return meta, nil
+}
+
 //QueryAccountSr 查询超级账户github信息
 func QueryAccountSr(req *entity.SuperAccountInfo) (*entity.SuperAccountInfo, error) {
 	var filterSQL string
@@ -203,41 +226,3 @@ func QueryAccountStats(address string) (*entity.AccountTransactionNum, error) {
 
 	return module.QueryAccountStatsRealize(strSQL)
 }
-
-//VerifyWebToken token验证
-func VerifyWebToken(address, token string) bool {
-
-	//校验token
-	key := config.HttpWebKey
-	if key == "" {
-		key = "WoiYeI5brZy4S8wQfVz7M5BczMkIhnugYW5QIibNgnWsAsktgHn5"
-	}
-	tokenRes, err := jwt.ParseWithClaims(token, &entity.WebTokenClaims{}, func(token *jwt.Token) (interface{}, error) {
-		return []byte(key), nil
-	})
-
-	if claims, ok := tokenRes.Claims.(*entity.WebTokenClaims); ok && tokenRes.Valid {
-		log.Debugf("%v", claims.Address)
-		if claims.Address == address {
-			return true
-		}
-	} else {
-		log.Debug(err)
-	}
-	return false
-}
-
-//GenWebToken 生成webtoken
-func GenWebToken(signatureAddress string) (string, error) {
-	key := config.HttpWebKey
-	if key == "" {
-		key = "WoiYeI5brZy4S8wQfVz7M5BczMkIhnugYW5QIibNgnWsAsktgHn5"
-	}
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, &entity.WebTokenClaims{Address: signatureAddress})
-	newToken, err := token.SignedString([]byte(key))
-	if err != nil {
-		log.Errorf("create token error:[%v]", err)
-	}
-	log.Debugf("%v %v", newToken, err)
-	return newToken, err
-}
@@ -0,0 +1,87 @@
+package service
+
+import (
+	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+//QueryLogLevel 当前的日志级别和按包覆盖的级别
+func QueryLogLevel() *entity.LogLevelInfo {
+	info := &entity.LogLevelInfo{Level: log.CurrentLevel().Name(), Packages: make(map[string]string)}
+	for pkg, level := range log.PackageLevels() {
+		info.Packages[pkg] = level.Name()
+	}
+	return info
+}
+
+//ChangeLogLevel 修改日志级别，只在本实例生效，重启后恢复为启动参数
+//	level为空时不修改全局级别，packages为nil时不修改按包覆盖的级别，为空时清除全部覆盖
+func ChangeLogLevel(req *entity.LogLevelInfo) (*entity.LogLevelInfo, error) {
+	level := log.CurrentLevel()
+	if req.Level != "" {
+		var ok bool
+		if level, ok = log.ParseLevel(req.Level); !ok {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+	}
+	packages := make(map[string]log.Level, len(req.Packages))
+	for pkg, name := range req.Packages {
+		pkgLevel, ok := log.ParseLevel(name)
+		if !ok || pkg == "" {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		packages[pkg] = pkgLevel
+	}
+
+	log.ChangeLogLevel(level)
+	if req.Packages != nil {
+		log.ResetPackageLevels()
+		for pkg, pkgLevel := range packages {
+			log.SetPackageLevel(pkg, pkgLevel)
+		}
+	}
+	return QueryLogLevel(), nil
+}
+
+//QueryNodeStatus 检查当前网络节点池中的全部节点
+func QueryNodeStatus() *entity.NodeStatusResp {
+	resp := &entity.NodeStatusResp{Network: utils.CurrentNetwork().Name, Data: make([]*entity.NodeStatus, 0)}
+	for _, status := range grpcclient.ProbeNodes() {
+		resp.Data = append(resp.Data, &entity.NodeStatus{
+			Endpoint:  status.Endpoint,
+			Type:      status.Type,
+			Reachable: status.Reachable,
+			BlockID:   status.BlockID,
+			Latency:   status.Latency,
+			Error:     status.Error,
+		})
+		if status.Reachable {
+			resp.Reachable++
+		}
+	}
+	resp.Total = int64(len(resp.Data))
+	return resp
+}
+
+//QueryIngestion 数据同步进度，高度取自区块缓存，每10秒更新
+func QueryIngestion() *entity.IngestionStatus {
+	blockBuffer := buffer.GetBlockBuffer()
+	ingestion := &entity.IngestionStatus{
+		DBBlockID:         blockBuffer.GetMaxConfirmedBlockID(),
+		SolidityBlockID:   blockBuffer.GetSolidityNodeMaxBlockID(),
+		BufferBlockID:     blockBuffer.GetMaxBlockID(),
+		FullNodeBlockID:   blockBuffer.GetFullNodeMaxBlockID(),
+		LatestBlockTime:   blockBuffer.GetMaxBlockTimestamp(),
+		TotalTransactions: blockBuffer.GetTotalTransactions(),
+		TotalTransfers:    blockBuffer.GetTotalTransfers(),
+		MaxSyncLag:        config.Get().Health.MaxSyncLag,
+	}
+	ingestion.ConfirmedLag = ingestion.SolidityBlockID - ingestion.DBBlockID
+	ingestion.UnconfirmedLag = ingestion.FullNodeBlockID - ingestion.BufferBlockID
+	return ingestion
+}
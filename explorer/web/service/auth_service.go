@@ -0,0 +1,310 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"gopkg.in/redis.v4"
+)
+
+//登录认证在redis中使用的key前缀
+const (
+	authNonceKeyPrefix         = "auth.nonce."
+	authRefreshKeyPrefix       = "auth.refresh."
+	authRevokedKeyPrefix       = "auth.revoked."
+	authRevokedBeforeKeyPrefix = "auth.revokedBefore."
+)
+
+//authMessageFormat 登录需要签名的消息，依次为地址、随机数、生成时间和请求的host
+const authMessageFormat = "tronscan login\naddress: %v\nnonce: %v\nissued: %v\nhost: %v"
+
+//QueryAuthChallenge 生成登录随机数和需要签名的消息，有效期内只能使用一次
+func QueryAuthChallenge(address, host string) (*entity.AuthChallenge, error) {
+	if len(utils.Base58DecodeAddr(address)) != 21 {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	nonce, err := getAuthRandomHex(32)
+	if err != nil {
+		log.Errorf("QueryAuthChallenge gen nonce err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	now := time.Now()
+	message := buildAuthMessage(address, nonce, host, now)
+	expire := time.Duration(config.AuthNonceSeconds) * time.Second
+	if err := config.RedisCli.Set(authNonceKeyPrefix+nonce, message, expire).Err(); err != nil {
+		log.Errorf("QueryAuthChallenge save nonce err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	challenge := &entity.AuthChallenge{}
+	challenge.Address = address
+	challenge.Nonce = nonce
+	challenge.Message = message
+	challenge.ExpireTime = now.Add(expire).UnixNano() / 1e6
+	return challenge, nil
+}
+
+//AuthLogin 校验下发消息的签名，消息中的地址、随机数、host一致且未过期，签名地址与登录地址一致时签发token
+func AuthLogin(req *entity.AuthLogin, host string) (*entity.AuthToken, error) {
+	if req.Address == "" || req.Nonce == "" || req.Signature == "" {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	nonceKey := authNonceKeyPrefix + req.Nonce
+	message, err := config.RedisCli.Get(nonceKey).Result()
+	if err != nil && err != redis.Nil {
+		log.Errorf("AuthLogin get nonce err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if err == redis.Nil || !checkAuthMessage(message, req.Address, req.Nonce, host, time.Now()) {
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	//并发使用同一随机数时只有删除成功的请求有效
+	if deleted, err := config.RedisCli.Del(nonceKey).Result(); err != nil || deleted == 0 {
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	if !verifyAuthSignature(req.Address, message, req.Signature) {
+		log.Errorf("AuthLogin verify signature fail, address:[%v]", req.Address)
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	return issueAuthToken(req.Address)
+}
+
+//RefreshAuthToken 用refresh token换取新的token，原refresh token失效
+func RefreshAuthToken(refreshToken string) (*entity.AuthToken, error) {
+	if refreshToken == "" {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	refreshKey := getAuthRefreshKey(refreshToken)
+	value, err := config.RedisCli.Get(refreshKey).Result()
+	if err != nil && err != redis.Nil {
+		log.Errorf("RefreshAuthToken get refresh token err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if err == redis.Nil {
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	if deleted, err := config.RedisCli.Del(refreshKey).Result(); err != nil || deleted == 0 {
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	address, issuedAt := parseAuthRefreshValue(value)
+	revoked, err := isAuthAddressRevoked(address, issuedAt)
+	if err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if revoked {
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	return issueAuthToken(address)
+}
+
+//RevokeAuthToken 退出登录，吊销当前token和refresh token
+func RevokeAuthToken(accessToken, refreshToken string) (interface{}, error) {
+	claims, err := parseAuthToken(accessToken)
+	if err != nil {
+		return nil, err
+	}
+	expire := time.Duration(claims.ExpiresAt-time.Now().Unix()) * time.Second
+	if err := config.RedisCli.Set(authRevokedKeyPrefix+claims.Id, 1, expire).Err(); err != nil {
+		log.Errorf("RevokeAuthToken save revoked token err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if refreshToken != "" {
+		refreshKey := getAuthRefreshKey(refreshToken)
+		if value, err := config.RedisCli.Get(refreshKey).Result(); err == nil {
+			if address, _ := parseAuthRefreshValue(value); address == claims.Address {
+				config.RedisCli.Del(refreshKey)
+			}
+		}
+	}
+	return "ok", nil
+}
+
+//RevokeAddressTokens 吊销地址在此之前签发的全部token和refresh token
+func RevokeAddressTokens(address string) (interface{}, error) {
+	if len(utils.Base58DecodeAddr(address)) != 21 {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	//吊销记录保留到该时间前签发的token全部过期
+	expire := config.AuthRefreshTokenSeconds
+	if config.AuthAccessTokenSeconds > expire {
+		expire = config.AuthAccessTokenSeconds
+	}
+	err := config.RedisCli.Set(authRevokedBeforeKeyPrefix+address, time.Now().Unix(), time.Duration(expire)*time.Second).Err()
+	if err != nil {
+		log.Errorf("RevokeAddressTokens address:[%v] err:[%v]", address, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return "ok", nil
+}
+
+//VerifyWebToken token验证
+func VerifyWebToken(address, token string) bool {
+	claims, err := parseAuthToken(token)
+	if err != nil {
+		log.Debugf("VerifyWebToken address:[%v] err:[%v]", address, err)
+		return false
+	}
+	return claims.Address == address
+}
+
+//issueAuthToken 使用当前密钥签发token，同时签发refresh token
+func issueAuthToken(address string) (*entity.AuthToken, error) {
+	now := time.Now().Unix()
+	accessToken, err := signAuthToken(address, now, config.AuthActiveKey, config.AuthKeys[config.AuthActiveKey])
+	if err != nil {
+		log.Errorf("issueAuthToken sign token err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	refreshToken, err := getAuthRandomHex(32)
+	if err != nil {
+		log.Errorf("issueAuthToken gen refresh token err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	value := fmt.Sprintf("%v,%v", address, now)
+	expire := time.Duration(config.AuthRefreshTokenSeconds) * time.Second
+	if err := config.RedisCli.Set(getAuthRefreshKey(refreshToken), value, expire).Err(); err != nil {
+		log.Errorf("issueAuthToken save refresh token err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	authToken := &entity.AuthToken{}
+	authToken.Address = address
+	authToken.AccessToken = accessToken
+	authToken.ExpiresIn = config.AuthAccessTokenSeconds
+	authToken.RefreshToken = refreshToken
+	return authToken, nil
+}
+
+//signAuthToken 签发HS256 token，header中的kid标识签名密钥
+func signAuthToken(address string, now int64, kid, key string) (string, error) {
+	jti, err := getAuthRandomHex(16)
+	if err != nil {
+		return "", err
+	}
+	claims := &entity.WebTokenClaims{Address: address}
+	claims.Id = jti
+	claims.IssuedAt = now
+	claims.ExpiresAt = now + config.AuthAccessTokenSeconds
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	token.Header["kid"] = kid
+	return token.SignedString([]byte(key))
+}
+
+//parseAuthToken 校验token签名和有效期，并检查是否已吊销
+func parseAuthToken(token string) (*entity.WebTokenClaims, error) {
+	claims, err := verifyAuthToken(token, config.AuthKeys)
+	if err != nil {
+		log.Debugf("parseAuthToken err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	exist, err := config.RedisCli.Exists(authRevokedKeyPrefix + claims.Id).Result()
+	if err != nil {
+		log.Errorf("parseAuthToken check revoked token err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	revoked, err := isAuthAddressRevoked(claims.Address, claims.IssuedAt)
+	if err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if exist || revoked {
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	return claims, nil
+}
+
+//verifyAuthToken 按kid选择密钥校验token，轮换后旧密钥保留在keys中时旧token仍然有效
+func verifyAuthToken(token string, keys map[string]string) (*entity.WebTokenClaims, error) {
+	claims := &entity.WebTokenClaims{}
+	tokenRes, err := jwt.ParseWithClaims(token, claims, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method:[%v]", token.Header["alg"])
+		}
+		kid, _ := token.Header["kid"].(string)
+		key, ok := keys[kid]
+		if !ok {
+			return nil, fmt.Errorf("unknown kid:[%v]", kid)
+		}
+		return []byte(key), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if !tokenRes.Valid || claims.ExpiresAt == 0 || claims.Id == "" || claims.Address == "" {
+		return nil, fmt.Errorf("token claims invalid")
+	}
+	return claims, nil
+}
+
+//isAuthAddressRevoked 签发时间不晚于地址吊销时间的token无效
+func isAuthAddressRevoked(address string, issuedAt int64) (bool, error) {
+	revokedBefore, err := config.RedisCli.Get(authRevokedBeforeKeyPrefix + address).Int64()
+	if err == redis.Nil {
+		return false, nil
+	}
+	if err != nil {
+		log.Errorf("isAuthAddressRevoked address:[%v] err:[%v]", address, err)
+		return false, err
+	}
+	return issuedAt <= revokedBefore, nil
+}
+
+//buildAuthMessage 生成登录需要签名的消息，时间使用UTC秒级RFC3339格式
+func buildAuthMessage(address, nonce, host string, issued time.Time) string {
+	return fmt.Sprintf(authMessageFormat, address, nonce, issued.UTC().Format(time.RFC3339), host)
+}
+
+//checkAuthMessage 按登录请求重新生成消息与下发的消息比较，并检查是否超过 nonceSeconds
+func checkAuthMessage(message, address, nonce, host string, now time.Time) bool {
+	lines := strings.Split(message, "\n")
+	if len(lines) != 5 || !strings.HasPrefix(lines[3], "issued: ") {
+		return false
+	}
+	issued, err := time.Parse(time.RFC3339, strings.TrimPrefix(lines[3], "issued: "))
+	if err != nil || now.Sub(issued) > time.Duration(config.AuthNonceSeconds)*time.Second {
+		return false
+	}
+	return message == buildAuthMessage(address, nonce, host, issued)
+}
+
+//verifyAuthSignature 兼容 trx.sign(消息的hex) 和 signMessageV2(消息) 两种签名
+func verifyAuthSignature(address, message, signature string) bool {
+	sign := utils.HexDecode(strings.TrimPrefix(signature, "0x"))
+	hashes := [][]byte{utils.HashTronMessageV2([]byte(message)), utils.HashTronMessage([]byte(message))}
+	for _, hash := range hashes {
+		if signAddress, err := utils.RecoverTronMessageAddress(hash, sign); err == nil && signAddress == address {
+			return true
+		}
+	}
+	return false
+}
+
+//getAuthRefreshKey redis中只保存refresh token的hash
+func getAuthRefreshKey(refreshToken string) string {
+	hash := sha256.Sum256([]byte(refreshToken))
+	return authRefreshKeyPrefix + hex.EncodeToString(hash[:])
+}
+
+func parseAuthRefreshValue(value string) (string, int64) {
+	parts := strings.SplitN(value, ",", 2)
+	if len(parts) != 2 {
+		return parts[0], 0
+	}
+	return parts[0], mysql.ConvertStringToInt64(parts[1], 0)
+}
+
+func getAuthRandomHex(size int) (string, error) {
+	data := make([]byte, size)
+	if _, err := rand.Read(data); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(data), nil
+}
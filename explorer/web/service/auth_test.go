@@ -0,0 +1,123 @@
+package service
+
+import (
+	"encoding/hex"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestVerifyAuthToken(t *testing.T) {
+	config.AuthAccessTokenSeconds = 900
+	address := "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3"
+	keys := map[string]string{
+		"2018-10": "f3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		"2018-11": "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08",
+	}
+	now := time.Now().Unix()
+
+	oldToken, _ := signAuthToken(address, now, "2018-10", keys["2018-10"])
+	newToken, _ := signAuthToken(address, now, "2018-11", keys["2018-11"])
+	for _, token := range []string{oldToken, newToken} {
+		claims, err := verifyAuthToken(token, keys)
+		if err != nil || claims.Address != address || claims.Id == "" || claims.ExpiresAt != now+900 {
+			t.Errorf("verifyAuthToken:%#v %v", claims, err)
+		}
+	}
+
+	//轮换后移除旧密钥，旧密钥签发的token失效
+	delete(keys, "2018-10")
+	if _, err := verifyAuthToken(oldToken, keys); err == nil {
+		t.Errorf("verifyAuthToken removed kid")
+	}
+	//kid与签名密钥不一致
+	wrongKid, _ := signAuthToken(address, now, "2018-11", "5994471abb01112afcc18159f6cc74b4f511b99806da59b3caf5a9c173cacfc5")
+	if _, err := verifyAuthToken(wrongKid, keys); err == nil {
+		t.Errorf("verifyAuthToken wrong key")
+	}
+	expired, _ := signAuthToken(address, now-1000, "2018-11", keys["2018-11"])
+	if _, err := verifyAuthToken(expired, keys); err == nil {
+		t.Errorf("verifyAuthToken expired")
+	}
+
+	//旧版本没有有效期和kid的token
+	legacy, _ := jwt.NewWithClaims(jwt.SigningMethodHS256, &entity.WebTokenClaims{Address: address}).SignedString([]byte(keys["2018-11"]))
+	if _, err := verifyAuthToken(legacy, keys); err == nil {
+		t.Errorf("verifyAuthToken legacy token")
+	}
+	none := jwt.NewWithClaims(jwt.SigningMethodNone, &entity.WebTokenClaims{Address: address})
+	none.Header["kid"] = "2018-11"
+	noneToken, _ := none.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if _, err := verifyAuthToken(noneToken, keys); err == nil {
+		t.Errorf("verifyAuthToken alg none")
+	}
+}
+
+func TestParseAuthRefreshValue(t *testing.T) {
+	if address, issuedAt := parseAuthRefreshValue("TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3,1539100800"); address != "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3" || issuedAt != 1539100800 {
+		t.Errorf("parseAuthRefreshValue:%v %v", address, issuedAt)
+	}
+	if _, issuedAt := parseAuthRefreshValue("TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3"); issuedAt != 0 {
+		t.Errorf("parseAuthRefreshValue without time:%v", issuedAt)
+	}
+	if getAuthRefreshKey("a") == getAuthRefreshKey("b") {
+		t.Errorf("getAuthRefreshKey")
+	}
+}
+
+func TestCheckAuthMessage(t *testing.T) {
+	config.AuthNonceSeconds = 300
+	address := "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3"
+	nonce := "8f3b6c1d2e4a59708b1c2d3e4f5a6b7c"
+	issued := time.Date(2018, 10, 10, 0, 0, 0, 0, time.UTC)
+	message := buildAuthMessage(address, nonce, "tronscan.org", issued)
+	want := "tronscan login\naddress: TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3\nnonce: 8f3b6c1d2e4a59708b1c2d3e4f5a6b7c\nissued: 2018-10-10T00:00:00Z\nhost: tronscan.org"
+	if message != want {
+		t.Fatalf("buildAuthMessage:%q", message)
+	}
+	if !checkAuthMessage(message, address, nonce, "tronscan.org", issued.Add(time.Minute)) {
+		t.Errorf("checkAuthMessage valid message")
+	}
+	cases := []struct {
+		address, nonce, host string
+		now                  time.Time
+	}{
+		{"TJAwZWjvZUsEwZVrqSpa4QV8Q4YX1i1s4b", nonce, "tronscan.org", issued},
+		{address, "0d3a0f4c1e8b9a7d", "tronscan.org", issued},
+		//在其他站点获取的消息不能用于本站登录
+		{address, nonce, "evil.example", issued},
+		{address, nonce, "tronscan.org", issued.Add(301 * time.Second)},
+	}
+	for _, c := range cases {
+		if checkAuthMessage(message, c.address, c.nonce, c.host, c.now) {
+			t.Errorf("checkAuthMessage %#v", c)
+		}
+	}
+}
+
+func TestVerifyAuthSignature(t *testing.T) {
+	priv, err := ethcrypto.GenerateKey()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _, address, err := utils.GetTronPublicInfoByPrivateKey(hex.EncodeToString(ethcrypto.FromECDSA(priv)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	message := buildAuthMessage(address, "8f3b6c1d2e4a59708b1c2d3e4f5a6b7c", "tronscan.org", time.Now())
+	for _, hash := range [][]byte{utils.HashTronMessage([]byte(message)), utils.HashTronMessageV2([]byte(message))} {
+		sign, _ := ethcrypto.Sign(hash, priv)
+		if !verifyAuthSignature(address, message, hex.EncodeToString(sign)) {
+			t.Errorf("verifyAuthSignature")
+		}
+		//签名的消息与下发的消息不一致
+		if verifyAuthSignature(address, message+"\n", hex.EncodeToString(sign)) {
+			t.Errorf("verifyAuthSignature other message")
+		}
+	}
+}
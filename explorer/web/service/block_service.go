@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -15,14 +16,14 @@ import (
 )
 
 //QueryBlocksBuffer  从缓存中查询
-func QueryBlocksBuffer(req *entity.Blocks) (*entity.BlocksResp, error) {
+func QueryBlocksBuffer(ctx context.Context, req *entity.Blocks) (*entity.BlocksResp, error) {
 	var err error
 	blockResp := &entity.BlocksResp{}
 	blocks := make([]*entity.BlockInfo, 0)
 	blockBuffer := buffer.GetBlockBuffer()
 	blockResp.Total = blockBuffer.GetMaxBlockID()
 	if req.Number != "" {
-		block := blockBuffer.GetBlock(mysql.ConvertStringToInt64(req.Number, 0))
+		block := blockBuffer.GetBlock(ctx, mysql.ConvertStringToInt64(req.Number, 0))
 		if block == nil {
 			log.Debugf("get blocks data in buffer, get them from db instead")
 			return QueryBlocks(req)
@@ -33,7 +34,7 @@ func QueryBlocksBuffer(req *entity.Blocks) (*entity.BlocksResp, error) {
 	} else if req.Producer != "" {
 		return QueryBlocks(req)
 	} else {
-		blocks, err = blockBuffer.GetBlocks(-1, req.Start, req.Limit)
+		blocks, err = blockBuffer.GetBlocks(ctx, -1, req.Start, req.Limit)
 		if err != nil || blocks == nil {
 			log.Debugf("get blocks data in buffer, get them from db instead")
 			return QueryBlocks(req)
@@ -85,7 +86,7 @@ func QueryBlocks(req *entity.Blocks) (*entity.BlocksResp, error) {
 	}
 	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
 
-	return module.QueryBlocksRealize(strSQL, filterSQL, sortSQL, pageSQL)
+	return module.QueryBlocksRealize(context.Background(), strSQL, filterSQL, sortSQL, pageSQL)
 }
 
 //QueryBlock 精确查询  	//number=2135998
@@ -106,11 +107,11 @@ func QueryBlock(req *entity.Blocks) (*entity.BlockInfo, error) {
 }
 
 //QueryBlockBuffer 精确查询  	//number=2135998
-func QueryBlockBuffer(req *entity.Blocks) (*entity.BlockInfo, error) {
+func QueryBlockBuffer(ctx context.Context, req *entity.Blocks) (*entity.BlockInfo, error) {
 	block := &entity.BlockInfo{}
 	blockBuffer := buffer.GetBlockBuffer()
 	if req.Number != "" {
-		block = blockBuffer.GetBlock(mysql.ConvertStringToInt64(req.Number, 0))
+		block = blockBuffer.GetBlock(ctx, mysql.ConvertStringToInt64(req.Number, 0))
 	}
 	return block, nil
 }
@@ -1,6 +1,7 @@
 package service
 
 import (
+	"context"
 	"testing"
 
 	"github.com/wlcy/tron/explorer/lib/mysql"
@@ -25,7 +26,7 @@ func TestBlockList(t *testing.T) {
 	//req.Number = "2287351"
 
 	//resp, err := QueryBlocks(req)
-	resp, err := QueryBlocksBuffer(req)
+	resp, err := QueryBlocksBuffer(context.Background(), req)
 	if err != nil {
 		log.Error(err)
 	}
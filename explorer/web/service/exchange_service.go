@@ -0,0 +1,413 @@
+package service
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"sort"
+
+	"github.com/tronprotocol/grpc-gateway/core"
+	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+	"gopkg.in/redis.v4"
+)
+
+/*
+交易对(Bancor)成交记录和K线
+交易中只有卖出数量，买入数量由本地重放交易对余额，按 java-tron ExchangeProcessor 的公式计算：
+创建、注资、撤资、交易按区块顺序依次作用在交易对余额上，同一区块内有多笔时从节点读取区块确定顺序
+只处理已确认的区块，交易对余额、成交记录和K线在一个事务中写入
+*/
+
+const exchangeCheckpointKey = "exchange.sync.checkpoint"
+
+//exchangeBlockBatch 每批检查的区块数
+const exchangeBlockBatch = 50000
+
+//exchangeSupply ExchangeProcessor 每次计算使用的初始supply
+const exchangeSupply = 1000000000000000000
+
+//exchangeCandleInterval K线周期，单位ms
+type exchangeCandleInterval struct {
+	Name     string
+	Duration int64
+}
+
+var exchangeCandleIntervals = []*exchangeCandleInterval{
+	{"1m", 60 * 1000},
+	{"5m", 5 * 60 * 1000},
+	{"1h", 60 * 60 * 1000},
+	{"1d", 24 * 60 * 60 * 1000},
+}
+
+const exchangeSQL = `
+	select exchange_id, creator_address, first_token_id, first_token_balance,
+		second_token_id, second_token_balance, create_time, block_id
+	from wlcy_exchange
+	where 1=1 `
+
+//QueryExchanges 查询交易对列表
+func QueryExchanges() (*entity.ExchangesResp, error) {
+	exchangesResp, err := module.QueryExchangesRealize(exchangeSQL + " order by exchange_id")
+	if err != nil {
+		log.Errorf("QueryExchanges strSQL:%v, err:[%v]", exchangeSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	for _, exchange := range exchangesResp.Data {
+		exchange.Price = getExchangePrice(exchange.FirstTokenBalance, exchange.SecondTokenBalance)
+	}
+	return exchangesResp, nil
+}
+
+//QueryExchangeTrades 查询交易对成交记录
+func QueryExchangeTrades(req *entity.ExchangeTrades) (*entity.ExchangeTradesResp, error) {
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := fmt.Sprintf(`
+	select trx_hash, block_id, exchange_id, owner_address, sell_token_id, sell_amount,
+		buy_token_id, buy_amount, price, create_time
+	from wlcy_exchange_trade
+	where exchange_id=%v `, req.ExchangeID)
+	if req.From > 0 {
+		filterSQL = fmt.Sprintf(" and create_time>=%v", req.From)
+	}
+	if req.To > 0 {
+		filterSQL = fmt.Sprintf("%v and create_time<%v", filterSQL, req.To)
+	}
+	sortSQL = "order by block_id desc, trx_hash"
+	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
+
+	tradesResp, err := module.QueryExchangeTradesRealize(strSQL, filterSQL, sortSQL, pageSQL)
+	if err != nil {
+		log.Errorf("QueryExchangeTrades strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return tradesResp, nil
+}
+
+//QueryExchangeCandles 查询交易对K线，按开始时间升序返回最近的limit根
+func QueryExchangeCandles(req *entity.ExchangeCandles) ([]*entity.ExchangeCandle, error) {
+	if getExchangeCandleInterval(req.Interval) == nil {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	strSQL := fmt.Sprintf(`
+	select exchange_id, candle_interval, open_time, open_price, high_price, low_price, close_price,
+		volume, quote_volume, trade_count
+	from wlcy_exchange_candle
+	where exchange_id=%v and candle_interval='%v' `, req.ExchangeID, req.Interval)
+	if req.From > 0 {
+		strSQL = fmt.Sprintf("%v and open_time>=%v", strSQL, req.From)
+	}
+	if req.To > 0 {
+		strSQL = fmt.Sprintf("%v and open_time<%v", strSQL, req.To)
+	}
+	strSQL = fmt.Sprintf("%v order by open_time desc limit %v", strSQL, req.Limit)
+
+	candles, err := module.QueryExchangeCandlesRealize(strSQL)
+	if err != nil {
+		log.Errorf("QueryExchangeCandles strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	for i, j := 0, len(candles)-1; i < j; i, j = i+1, j-1 {
+		candles[i], candles[j] = candles[j], candles[i]
+	}
+	return candles, nil
+}
+
+//SyncExchange 从上次检查的区块开始重放交易对相关的交易，直到最大确认块
+func SyncExchange() error {
+	for {
+		finished, err := syncExchangeBatch()
+		if err != nil || finished {
+			return err
+		}
+	}
+}
+
+//syncExchangeBatch 处理一批区块，返回是否已到最大确认块
+func syncExchangeBatch() (bool, error) {
+	exchangesResp, err := module.QueryExchangesRealize(exchangeSQL)
+	if err != nil {
+		log.Errorf("SyncExchange query exchanges err:[%v]", err)
+		return false, err
+	}
+	syncer := newExchangeSyncer(exchangesResp.Data)
+
+	checkpoint, err := config.RedisCli.Get(exchangeCheckpointKey).Int64()
+	if err != nil && err != redis.Nil {
+		log.Errorf("SyncExchange redis get checkpoint err:[%v]", err)
+		return false, err
+	}
+	//写库成功但检查点没有更新时，从交易对最后一次变化的区块继续，避免重复计算余额
+	if syncer.appliedBlockID > checkpoint {
+		checkpoint = syncer.appliedBlockID
+	}
+	maxBlockID := buffer.GetBlockBuffer().GetMaxConfirmedBlockID()
+	endBlockID := checkpoint + exchangeBlockBatch
+	if endBlockID > maxBlockID {
+		endBlockID = maxBlockID
+	}
+	if endBlockID <= checkpoint {
+		return true, nil
+	}
+
+	strSQL := fmt.Sprintf(`
+	select trx_hash, block_id, contract_type, contract_data, owner_address, create_time
+	from transactions
+	where block_id>%v and block_id<=%v and contract_type in (%v, %v, %v, %v)
+	order by block_id, trx_hash`, checkpoint, endBlockID,
+		int32(core.Transaction_Contract_ExchangeCreateContract), int32(core.Transaction_Contract_ExchangeInjectContract),
+		int32(core.Transaction_Contract_ExchangeWithdrawContract), int32(core.Transaction_Contract_ExchangeTransactionContract))
+	contracts, err := module.QueryExchangeContractsRealize(strSQL)
+	if err != nil {
+		log.Errorf("SyncExchange query contracts err:[%v]", err)
+		return false, err
+	}
+	if err := sortExchangeContracts(contracts); err != nil {
+		return false, err
+	}
+	for _, contract := range contracts {
+		syncer.apply(contract)
+	}
+
+	if err := module.SaveExchangeSync(syncer.getChangedExchanges(), syncer.trades, syncer.candles); err != nil {
+		return false, err
+	}
+	if err := config.RedisCli.Set(exchangeCheckpointKey, endBlockID, 0).Err(); err != nil {
+		log.Errorf("SyncExchange set checkpoint err:[%v]", err)
+		return false, err
+	}
+	log.Infof("SyncExchange block:[%v-%v] contracts:[%v] trades:[%v]", checkpoint, endBlockID, len(contracts), len(syncer.trades))
+	return endBlockID == maxBlockID, nil
+}
+
+//sortExchangeContracts 同一区块内有多笔交易时，按交易在区块中的顺序排序
+func sortExchangeContracts(contracts []*entity.ExchangeContract) error {
+	blockCount := make(map[int64]int)
+	for _, contract := range contracts {
+		blockCount[contract.BlockID]++
+	}
+	positions := make(map[string]int)
+	for blockID, count := range blockCount {
+		if count < 2 {
+			continue
+		}
+		block, err := grpcclient.GetRandomWallet().GetBlockByNum(blockID)
+		if err != nil || block == nil {
+			log.Errorf("SyncExchange GetBlockByNum [%v] err:[%v]", blockID, err)
+			return util.NewErrorMsg(util.Error_common_internal_error)
+		}
+		for index, trx := range block.Transactions {
+			positions[utils.HexEncode(utils.CalcTransactionHash(trx))] = index
+		}
+	}
+	sort.SliceStable(contracts, func(i, j int) bool {
+		if contracts[i].BlockID != contracts[j].BlockID {
+			return contracts[i].BlockID < contracts[j].BlockID
+		}
+		return positions[contracts[i].Hash] < positions[contracts[j].Hash]
+	})
+	return nil
+}
+
+//exchangeSyncer 在内存中重放一批交易
+type exchangeSyncer struct {
+	exchanges      map[int64]*entity.ExchangeInfo
+	lastExchangeID int64 // 新建交易对的编号为当前最大编号+1
+	appliedBlockID int64 // 已经作用在余额上的最大区块
+	changed        map[int64]bool
+	trades         []*entity.ExchangeTrade
+	candles        []*entity.ExchangeCandle
+	candleIndex    map[string]*entity.ExchangeCandle
+}
+
+func newExchangeSyncer(exchanges []*entity.ExchangeInfo) *exchangeSyncer {
+	syncer := &exchangeSyncer{
+		exchanges:   make(map[int64]*entity.ExchangeInfo, len(exchanges)),
+		changed:     make(map[int64]bool),
+		trades:      make([]*entity.ExchangeTrade, 0),
+		candles:     make([]*entity.ExchangeCandle, 0),
+		candleIndex: make(map[string]*entity.ExchangeCandle),
+	}
+	for _, exchange := range exchanges {
+		syncer.exchanges[exchange.ExchangeID] = exchange
+		if exchange.ExchangeID > syncer.lastExchangeID {
+			syncer.lastExchangeID = exchange.ExchangeID
+		}
+		if exchange.BlockID > syncer.appliedBlockID {
+			syncer.appliedBlockID = exchange.BlockID
+		}
+	}
+	return syncer
+}
+
+func (s *exchangeSyncer) apply(contract *entity.ExchangeContract) {
+	_, ctx := utils.GetContractByParamVal(core.Transaction_Contract_ContractType(contract.ContractType), utils.HexDecode(contract.ContractData))
+	switch v := ctx.(type) {
+	case *core.ExchangeCreateContract:
+		s.create(contract, string(v.FirstTokenId), v.FirstTokenBalance, string(v.SecondTokenId), v.SecondTokenBalance)
+	case *core.ExchangeInjectContract:
+		if exchange := s.getExchange(contract, v.ExchangeId); exchange != nil {
+			s.inject(exchange, string(v.TokenId), v.Quant)
+		}
+	case *core.ExchangeWithdrawContract:
+		if exchange := s.getExchange(contract, v.ExchangeId); exchange != nil {
+			s.inject(exchange, string(v.TokenId), -v.Quant)
+		}
+	case *core.ExchangeTransactionContract:
+		if exchange := s.getExchange(contract, v.ExchangeId); exchange != nil {
+			s.trade(exchange, contract, string(v.TokenId), v.Quant)
+		}
+	default:
+		log.Errorf("SyncExchange decode contract [%v] type:[%v] fail", contract.Hash, contract.ContractType)
+	}
+}
+
+func (s *exchangeSyncer) getExchange(contract *entity.ExchangeContract, exchangeID int64) *entity.ExchangeInfo {
+	exchange, ok := s.exchanges[exchangeID]
+	if !ok {
+		log.Errorf("SyncExchange contract [%v] exchange [%v] not found", contract.Hash, exchangeID)
+		return nil
+	}
+	exchange.BlockID = contract.BlockID
+	s.changed[exchangeID] = true
+	return exchange
+}
+
+func (s *exchangeSyncer) create(contract *entity.ExchangeContract, firstTokenID string, firstTokenBalance int64,
+	secondTokenID string, secondTokenBalance int64) {
+	s.lastExchangeID++
+	exchange := &entity.ExchangeInfo{}
+	exchange.ExchangeID = s.lastExchangeID
+	exchange.Creator = contract.OwnerAddress
+	exchange.FirstTokenID = firstTokenID
+	exchange.FirstTokenBalance = firstTokenBalance
+	exchange.SecondTokenID = secondTokenID
+	exchange.SecondTokenBalance = secondTokenBalance
+	exchange.CreateTime = contract.CreateTime
+	exchange.BlockID = contract.BlockID
+	s.exchanges[exchange.ExchangeID] = exchange
+	s.changed[exchange.ExchangeID] = true
+}
+
+//inject 注资时quant为正，撤资时quant为负，另一个通证按当前余额比例同时变化
+func (s *exchangeSyncer) inject(exchange *entity.ExchangeInfo, tokenID string, quant int64) {
+	switch tokenID {
+	case exchange.FirstTokenID:
+		another := mulDiv(exchange.SecondTokenBalance, quant, exchange.FirstTokenBalance)
+		exchange.FirstTokenBalance += quant
+		exchange.SecondTokenBalance += another
+	case exchange.SecondTokenID:
+		another := mulDiv(exchange.FirstTokenBalance, quant, exchange.SecondTokenBalance)
+		exchange.SecondTokenBalance += quant
+		exchange.FirstTokenBalance += another
+	default:
+		log.Errorf("SyncExchange exchange [%v] token [%v] not found", exchange.ExchangeID, tokenID)
+	}
+}
+
+func (s *exchangeSyncer) trade(exchange *entity.ExchangeInfo, contract *entity.ExchangeContract, tokenID string, quant int64) {
+	trade := &entity.ExchangeTrade{}
+	trade.Hash = contract.Hash
+	trade.Block = contract.BlockID
+	trade.ExchangeID = exchange.ExchangeID
+	trade.Owner = contract.OwnerAddress
+	trade.SellTokenID = tokenID
+	trade.SellAmount = quant
+	trade.CreateTime = contract.CreateTime
+
+	var firstAmount, secondAmount int64
+	switch tokenID {
+	case exchange.FirstTokenID:
+		trade.BuyTokenID = exchange.SecondTokenID
+		trade.BuyAmount = exchangeBancor(exchange.FirstTokenBalance, exchange.SecondTokenBalance, quant)
+		exchange.FirstTokenBalance += quant
+		exchange.SecondTokenBalance -= trade.BuyAmount
+		firstAmount, secondAmount = quant, trade.BuyAmount
+	case exchange.SecondTokenID:
+		trade.BuyTokenID = exchange.FirstTokenID
+		trade.BuyAmount = exchangeBancor(exchange.SecondTokenBalance, exchange.FirstTokenBalance, quant)
+		exchange.SecondTokenBalance += quant
+		exchange.FirstTokenBalance -= trade.BuyAmount
+		firstAmount, secondAmount = trade.BuyAmount, quant
+	default:
+		log.Errorf("SyncExchange exchange [%v] token [%v] not found", exchange.ExchangeID, tokenID)
+		return
+	}
+	trade.Price = getExchangePrice(firstAmount, secondAmount)
+	s.trades = append(s.trades, trade)
+	if trade.Price > 0 {
+		s.addCandles(exchange.ExchangeID, trade.CreateTime, trade.Price, firstAmount, secondAmount)
+	}
+}
+
+func (s *exchangeSyncer) addCandles(exchangeID, timestamp int64, price float64, volume, quoteVolume int64) {
+	for _, interval := range exchangeCandleIntervals {
+		openTime := timestamp - timestamp%interval.Duration
+		key := fmt.Sprintf("%v-%v-%v", exchangeID, interval.Name, openTime)
+		candle, ok := s.candleIndex[key]
+		if !ok {
+			candle = &entity.ExchangeCandle{ExchangeID: exchangeID, Interval: interval.Name, OpenTime: openTime,
+				Open: price, High: price, Low: price}
+			s.candleIndex[key] = candle
+			s.candles = append(s.candles, candle)
+		}
+		candle.High = math.Max(candle.High, price)
+		candle.Low = math.Min(candle.Low, price)
+		candle.Close = price
+		candle.Volume += volume
+		candle.QuoteVolume += quoteVolume
+		candle.TradeCount++
+	}
+}
+
+func (s *exchangeSyncer) getChangedExchanges() []*entity.ExchangeInfo {
+	exchanges := make([]*entity.ExchangeInfo, 0, len(s.changed))
+	for exchangeID := range s.changed {
+		exchanges = append(exchanges, s.exchanges[exchangeID])
+	}
+	sort.Slice(exchanges, func(i, j int) bool { return exchanges[i].ExchangeID < exchanges[j].ExchangeID })
+	return exchanges
+}
+
+//exchangeBancor 卖出sellQuant后可买入的数量，与 java-tron ExchangeProcessor.exchange 一致
+func exchangeBancor(sellBalance, buyBalance, sellQuant int64) int64 {
+	//卖出的通证先换成relay增发supply，再用relay换出买入的通证，换出前supply恢复原值
+	newBalance := sellBalance + sellQuant
+	issuedSupply := -float64(exchangeSupply) * (1.0 - math.Pow(1.0+float64(sellQuant)/float64(newBalance), 0.0005))
+	relay := int64(issuedSupply)
+
+	exchangeBalance := float64(buyBalance) * (math.Pow(1.0+float64(relay)/float64(exchangeSupply), 2000.0) - 1.0)
+	return int64(exchangeBalance)
+}
+
+//mulDiv a*b/c，中间结果可能超过int64
+func mulDiv(a, b, c int64) int64 {
+	if c == 0 {
+		return 0
+	}
+	result := new(big.Int).Mul(big.NewInt(a), big.NewInt(b))
+	return result.Quo(result, big.NewInt(c)).Int64()
+}
+
+//getExchangePrice 1个第一个通证可换的第二个通证数量
+func getExchangePrice(firstAmount, secondAmount int64) float64 {
+	if firstAmount <= 0 || secondAmount <= 0 {
+		return 0
+	}
+	return float64(secondAmount) / float64(firstAmount)
+}
+
+func getExchangeCandleInterval(name string) *exchangeCandleInterval {
+	for _, interval := range exchangeCandleIntervals {
+		if interval.Name == name {
+			return interval
+		}
+	}
+	return nil
+}
@@ -0,0 +1,89 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestExchangeBancor(t *testing.T) {
+	//与 java-tron ExchangeProcessorTest 的结果一致
+	if got := exchangeBancor(100000000000000, 128*1024*1024*1024, 2000000000000); got != 2694881440 {
+		t.Errorf("exchangeBancor:%v", got)
+	}
+	if got := exchangeBancor(10000, 10000, 0); got != 0 {
+		t.Errorf("exchangeBancor zero quant:%v", got)
+	}
+}
+
+func TestMulDiv(t *testing.T) {
+	if got := mulDiv(9000000000000000000, 4, 6); got != 6000000000000000000 {
+		t.Errorf("mulDiv overflow:%v", got)
+	}
+	if got := mulDiv(10, -3, 4); got != -7 {
+		t.Errorf("mulDiv negative:%v", got)
+	}
+	if got := mulDiv(10, 3, 0); got != 0 {
+		t.Errorf("mulDiv zero:%v", got)
+	}
+}
+
+func TestExchangeSyncer(t *testing.T) {
+	syncer := newExchangeSyncer([]*entity.ExchangeInfo{
+		{ExchangeID: 1, FirstTokenID: "_", FirstTokenBalance: 1000, SecondTokenID: "A", SecondTokenBalance: 2000, BlockID: 10},
+	})
+	if syncer.lastExchangeID != 1 || syncer.appliedBlockID != 10 {
+		t.Fatalf("newExchangeSyncer:%v %v", syncer.lastExchangeID, syncer.appliedBlockID)
+	}
+	syncer.create(&entity.ExchangeContract{BlockID: 11, OwnerAddress: "T1"}, "B", 100, "_", 300)
+	exchange := syncer.exchanges[2]
+	if exchange == nil || exchange.Creator != "T1" || exchange.BlockID != 11 {
+		t.Fatalf("create:%#v", exchange)
+	}
+
+	syncer.inject(exchange, "B", 50)
+	if exchange.FirstTokenBalance != 150 || exchange.SecondTokenBalance != 450 {
+		t.Errorf("inject:%v %v", exchange.FirstTokenBalance, exchange.SecondTokenBalance)
+	}
+	syncer.inject(exchange, "_", -45)
+	if exchange.FirstTokenBalance != 135 || exchange.SecondTokenBalance != 405 {
+		t.Errorf("withdraw:%v %v", exchange.FirstTokenBalance, exchange.SecondTokenBalance)
+	}
+
+	syncer.trade(exchange, &entity.ExchangeContract{Hash: "h1", CreateTime: 90000}, "_", 45)
+	trade := syncer.trades[0]
+	if trade.BuyTokenID != "B" || trade.BuyAmount != 13 || trade.Price != 45.0/13 {
+		t.Errorf("trade:%#v", trade)
+	}
+	if exchange.FirstTokenBalance != 122 || exchange.SecondTokenBalance != 450 {
+		t.Errorf("trade balance:%v %v", exchange.FirstTokenBalance, exchange.SecondTokenBalance)
+	}
+	if len(syncer.candles) != len(exchangeCandleIntervals) || syncer.candles[0].OpenTime != 60000 {
+		t.Errorf("trade candles:%v", syncer.candles)
+	}
+}
+
+func TestExchangeCandles(t *testing.T) {
+	syncer := newExchangeSyncer(nil)
+	syncer.addCandles(1, 60000, 2, 10, 20)
+	syncer.addCandles(1, 90000, 3, 10, 30)
+	syncer.addCandles(1, 100000, 1, 10, 10)
+	syncer.addCandles(1, 120000, 4, 10, 40)
+	minute := syncer.candles[0]
+	if minute.Interval != "1m" || minute.Open != 2 || minute.High != 3 || minute.Low != 1 || minute.Close != 1 ||
+		minute.Volume != 30 || minute.QuoteVolume != 60 || minute.TradeCount != 3 {
+		t.Errorf("1m candle:%#v", minute)
+	}
+	count := 0
+	for _, candle := range syncer.candles {
+		if candle.Interval == "1h" {
+			count++
+			if candle.Close != 4 || candle.TradeCount != 4 {
+				t.Errorf("1h candle:%#v", candle)
+			}
+		}
+	}
+	if count != 1 {
+		t.Errorf("1h candle count:%v", count)
+	}
+}
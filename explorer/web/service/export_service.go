@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+//导出格式
+const (
+	ExportFormatCSV   = "csv"
+	ExportFormatJSONL = "jsonl"
+)
+
+//TRX 的精度，1 TRX = 1000000 sun；TRC10通证的精度取自 asset_issue.asset_precision
+const trxPrecision = 6
+
+//exportFlushRows 每写多少行刷新一次输出，保证客户端能持续收到数据
+const exportFlushRows = 500
+
+var exportTransferHeader = []string{"timestamp", "date", "block", "transactionHash", "direction",
+	"from", "to", "tokenName", "precision", "rawAmount", "amount", "confirmed"}
+
+//ParseExportTime 解析导出的时间参数，支持 2006-01-02 格式的日期（UTC）或毫秒时间戳
+//isEnd 为true时，日期格式解析为当天结束（次日0点）
+func ParseExportTime(val string, isEnd bool) (int64, error) {
+	if val == "" {
+		return 0, nil
+	}
+	if t, err := time.ParseInLocation(mysql.DATEFORMAT, val, time.UTC); err == nil {
+		if isEnd {
+			t = t.AddDate(0, 0, 1)
+		}
+		return t.UnixNano() / int64(time.Millisecond), nil
+	}
+	ts := mysql.ConvertStringToInt64(val, -1)
+	if ts < 0 {
+		return 0, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	return ts, nil
+}
+
+//ExportTransfers 按地址和时间区间导出转账记录，边查询边写入w，不在内存中缓存结果
+//	ctx 取消（客户端断开）时终止查询
+//	begin 在第一次写入w之前调用一次，查询出错且还没有调用begin时，调用方可以直接返回错误信息
+//	开始输出后出错时，在末尾写入一行错误信息并返回错误
+func ExportTransfers(ctx context.Context, req *entity.ExportTransfers, w io.Writer, begin func()) error {
+	if len(utils.Base58DecodeAddr(req.Address)) != 21 || (req.Format != ExportFormatCSV && req.Format != ExportFormatJSONL) {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	if req.To <= 0 {
+		req.To = time.Now().UnixNano() / int64(time.Millisecond)
+	}
+	if req.From >= req.To {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	precisions, err := module.QueryTokenPrecisions()
+	if err != nil {
+		return err
+	}
+
+	strSQL := fmt.Sprintf(`
+			select block_id,owner_address,to_address,amount,
+			asset_name,trx_hash,confirmed,create_time
+			from tron.contract_transfer
+			where (owner_address='%v' or to_address='%v')
+			and create_time>=%v and create_time<%v
+			order by create_time, block_id`, req.Address, req.Address, req.From, req.To)
+
+	flusher, _ := w.(http.Flusher)
+	var csvWriter *csv.Writer
+	var jsonEncoder *json.Encoder
+	started := false
+	start := func() error {
+		started = true
+		begin()
+		if req.Format == ExportFormatCSV {
+			csvWriter = csv.NewWriter(w)
+			return csvWriter.Write(exportTransferHeader)
+		}
+		jsonEncoder = json.NewEncoder(w)
+		return nil
+	}
+
+	var rowNum int64
+	err = module.ExportTransfersRealize(ctx, strSQL, func(transfer *entity.ExportTransferInfo) error {
+		if !started {
+			if err := start(); err != nil {
+				return err
+			}
+		}
+		fillExportTransfer(req.Address, precisions, transfer)
+		var err error
+		if csvWriter != nil {
+			err = csvWriter.Write(exportTransferRecord(transfer))
+		} else {
+			err = jsonEncoder.Encode(transfer)
+		}
+		if err != nil {
+			return err
+		}
+		rowNum++
+		if rowNum%exportFlushRows == 0 {
+			if csvWriter != nil {
+				csvWriter.Flush()
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+		return nil
+	})
+	if err == nil && !started {
+		err = start()
+	}
+	if err != nil && started {
+		//已经输出了部分数据，追加一行错误信息，避免客户端把不完整的文件当作完整结果
+		if csvWriter != nil {
+			csvWriter.Write([]string{"error", err.Error()})
+		} else {
+			jsonEncoder.Encode(map[string]string{"error": err.Error()})
+		}
+	}
+	if csvWriter != nil {
+		csvWriter.Flush()
+	}
+	log.Debugf("ExportTransfers address:[%v] from:[%v] to:[%v] rows:[%v] err:[%v]", req.Address, req.From, req.To, rowNum, err)
+	return err
+}
+
+func fillExportTransfer(address string, precisions map[string]int32, transfer *entity.ExportTransferInfo) {
+	transfer.Date = time.Unix(0, transfer.Timestamp*int64(time.Millisecond)).UTC().Format(mysql.DATETIMEFORMAT)
+	switch {
+	case transfer.From == address && transfer.To == address:
+		transfer.Direction = "self"
+	case transfer.From == address:
+		transfer.Direction = "out"
+	default:
+		transfer.Direction = "in"
+	}
+	transfer.Precision = precisions[transfer.TokenName]
+	if transfer.TokenName == "TRX" {
+		transfer.Precision = trxPrecision
+	}
+	transfer.Amount = formatTokenAmount(transfer.RawAmount, transfer.Precision)
+}
+
+func exportTransferRecord(transfer *entity.ExportTransferInfo) []string {
+	return []string{
+		fmt.Sprintf("%v", transfer.Timestamp),
+		transfer.Date,
+		fmt.Sprintf("%v", transfer.Block),
+		transfer.TransactionHash,
+		transfer.Direction,
+		transfer.From,
+		transfer.To,
+		transfer.TokenName,
+		fmt.Sprintf("%v", transfer.Precision),
+		fmt.Sprintf("%v", transfer.RawAmount),
+		transfer.Amount,
+		fmt.Sprintf("%v", transfer.Confirmed),
+	}
+}
+
+//formatTokenAmount 按精度把链上整数数量转换为十进制字符串，不经过浮点避免精度丢失
+func formatTokenAmount(rawAmount int64, precision int32) string {
+	if precision <= 0 {
+		return fmt.Sprintf("%v", rawAmount)
+	}
+	return new(big.Rat).SetFrac(big.NewInt(rawAmount), new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(precision)), nil)).FloatString(int(precision))
+}
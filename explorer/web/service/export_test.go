@@ -0,0 +1,70 @@
+package service
+
+import (
+	"context"
+	"io/ioutil"
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestFormatTokenAmount(t *testing.T) {
+	cases := []struct {
+		raw       int64
+		precision int32
+		want      string
+	}{
+		{2985719, 6, "2.985719"},
+		{1, 6, "0.000001"},
+		{1000000, 6, "1.000000"},
+		{12345, 0, "12345"},
+	}
+	for _, c := range cases {
+		if got := formatTokenAmount(c.raw, c.precision); got != c.want {
+			t.Errorf("formatTokenAmount(%v, %v) = %v, want %v", c.raw, c.precision, got, c.want)
+		}
+	}
+}
+
+func TestParseExportTime(t *testing.T) {
+	from, err := ParseExportTime("2018-01-01", false)
+	if err != nil || from != 1514764800000 {
+		t.Errorf("parse from:%v, err:%v", from, err)
+	}
+	to, err := ParseExportTime("2018-12-31", true)
+	if err != nil || to != 1546300800000 {
+		t.Errorf("parse to:%v, err:%v", to, err)
+	}
+	ts, err := ParseExportTime("1536549252000", false)
+	if err != nil || ts != 1536549252000 {
+		t.Errorf("parse timestamp:%v, err:%v", ts, err)
+	}
+	if _, err := ParseExportTime("2018/01/01", false); err == nil {
+		t.Errorf("parse invalid time should return error")
+	}
+}
+
+func TestExportTransfersInvalidAddress(t *testing.T) {
+	for _, address := range []string{"", "TDh2S3T3whq9FxD8cmTbBjSCvhsLHmqftK' or '1'='1"} {
+		begun := false
+		req := &entity.ExportTransfers{Address: address, Format: ExportFormatCSV}
+		if err := ExportTransfers(context.Background(), req, ioutil.Discard, func() { begun = true }); err == nil || begun {
+			t.Errorf("address [%v] err:%v, begun:%v", address, err, begun)
+		}
+	}
+}
+
+func TestFillExportTransferPrecision(t *testing.T) {
+	precisions := map[string]int32{"IPFS": 2}
+	address := "TDh2S3T3whq9FxD8cmTbBjSCvhsLHmqftK"
+	transfer := &entity.ExportTransferInfo{From: address, To: "TJAwZWjvZUsEwZVrqSpa4QV8Q4YX1i1s4b", TokenName: "IPFS", RawAmount: 12345}
+	fillExportTransfer(address, precisions, transfer)
+	if transfer.Precision != 2 || transfer.Amount != "123.45" || transfer.Direction != "out" {
+		t.Errorf("token transfer:%#v", transfer)
+	}
+	transfer = &entity.ExportTransferInfo{From: address, To: address, TokenName: "TRX", RawAmount: 1}
+	fillExportTransfer(address, precisions, transfer)
+	if transfer.Precision != 6 || transfer.Amount != "0.000001" || transfer.Direction != "self" {
+		t.Errorf("trx transfer:%#v", transfer)
+	}
+}
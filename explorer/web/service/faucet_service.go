@@ -0,0 +1,288 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+	"gopkg.in/redis.v4"
+)
+
+//水龙头在redis中使用的key前缀
+const (
+	faucetAddressKeyPrefix = "faucet.address."
+	faucetIPKeyPrefix      = "faucet.ip."
+	faucetBudgetKeyPrefix  = "faucet.budget."
+)
+
+//CaptchaVerifier 人机验证接口，token为前端提交的验证结果
+type CaptchaVerifier interface {
+	Verify(token, ip string) (bool, error)
+}
+
+//recaptchaVerifier 通过siteverify接口校验，兼容reCAPTCHA和hCaptcha
+type recaptchaVerifier struct {
+	url    string
+	secret string
+	client *http.Client
+}
+
+func (v *recaptchaVerifier) Verify(token, ip string) (bool, error) {
+	if token == "" {
+		return false, nil
+	}
+	form := url.Values{}
+	form.Set("secret", v.secret)
+	form.Set("response", token)
+	form.Set("remoteip", ip)
+	resp, err := v.client.PostForm(v.url, form)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return false, err
+	}
+	return parseCaptchaResult(body)
+}
+
+//parseCaptchaResult 解析siteverify接口的返回
+func parseCaptchaResult(body []byte) (bool, error) {
+	result := &struct {
+		Success    bool     `json:"success"`
+		ErrorCodes []string `json:"error-codes"`
+	}{}
+	if err := json.Unmarshal(body, result); err != nil {
+		return false, err
+	}
+	if !result.Success {
+		log.Debugf("captcha verify fail:%v", result.ErrorCodes)
+	}
+	return result.Success, nil
+}
+
+var _captchaVerifier CaptchaVerifier
+var captchaVerifierMutex sync.RWMutex
+var captchaClient = &http.Client{Timeout: 10 * time.Second}
+
+//SetCaptchaVerifier 替换人机验证实现，用于接入其他验证服务，设置为nil时按配置选择
+func SetCaptchaVerifier(verifier CaptchaVerifier) {
+	captchaVerifierMutex.Lock()
+	_captchaVerifier = verifier
+	captchaVerifierMutex.Unlock()
+}
+
+//getCaptchaVerifier 优先使用 SetCaptchaVerifier 设置的实现，否则按当前配置向 captchaURL 校验
+//	启用水龙头时配置校验要求 captcha 为 recaptcha 或 hcaptcha，两者接口相同
+func getCaptchaVerifier(conf *config.FaucetConfig) CaptchaVerifier {
+	captchaVerifierMutex.RLock()
+	verifier := _captchaVerifier
+	captchaVerifierMutex.RUnlock()
+	if verifier != nil {
+		return verifier
+	}
+	return &recaptchaVerifier{url: conf.CaptchaURL, secret: conf.CaptchaSecret, client: captchaClient}
+}
+
+//faucetKey 发放地址的私钥和读取时使用的配置
+type faucetKey struct {
+	conf    *config.Config
+	privKey string
+	address string
+}
+
+var _faucetKey *faucetKey
+var faucetKeyMutex sync.Mutex
+
+//getFaucetKey 从加密的keystore文件读取发放地址的私钥，配置热加载后重新读取
+func getFaucetKey(conf *config.Config) (string, string) {
+	faucetKeyMutex.Lock()
+	defer faucetKeyMutex.Unlock()
+	if _faucetKey != nil && _faucetKey.conf == conf {
+		return _faucetKey.privKey, _faucetKey.address
+	}
+	key := &faucetKey{conf: conf}
+	_faucetKey = key
+	content, err := ioutil.ReadFile(conf.Faucet.Keystore)
+	if err != nil {
+		log.Errorf("read faucet keystore [%v] err:[%v]", conf.Faucet.Keystore, err)
+		return "", ""
+	}
+	privKey, address, err := utils.ReadPrivateKeyStorage(conf.Faucet.Password, string(content))
+	if err != nil {
+		log.Errorf("decrypt faucet keystore [%v] err:[%v]", conf.Faucet.Keystore, err)
+		return "", ""
+	}
+	key.privKey, key.address = privKey, address
+	log.Infof("faucet address:[%v]", address)
+	return privKey, address
+}
+
+//getFaucetBudgetKey 每日额度的key，按UTC日期区分
+func getFaucetBudgetKey(now time.Time) string {
+	return faucetBudgetKeyPrefix + now.UTC().Format("20060102")
+}
+
+//QueryFaucetInfo 查询水龙头状态和当日剩余额度
+func QueryFaucetInfo() (*entity.FaucetInfo, error) {
+	info := &entity.FaucetInfo{}
+	conf := config.Get()
+	if !conf.FaucetEnabled() {
+		return info, nil
+	}
+	_, address := getFaucetKey(conf)
+	info.Enabled = address != ""
+	info.Address = address
+	info.Amount = conf.Faucet.Amount
+	info.DailyBudget = conf.Faucet.DailyBudget
+	info.AddressCooldownSeconds = conf.Faucet.AddressCooldownSeconds
+	info.IPCooldownSeconds = conf.Faucet.IPCooldownSeconds
+	info.Captcha = conf.Faucet.Captcha
+	used, err := config.RedisCli.Get(getFaucetBudgetKey(time.Now())).Int64()
+	if err != nil && err != redis.Nil {
+		log.Errorf("QueryFaucetInfo get budget err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if info.BudgetRemaining = conf.Faucet.DailyBudget - used; info.BudgetRemaining < 0 {
+		info.BudgetRemaining = 0
+	}
+	return info, nil
+}
+
+//QueryFaucetGrants 查询发放记录，address为空时查询全部
+func QueryFaucetGrants(address string, start, limit int64) (*entity.FaucetGrantsResp, error) {
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := `
+	select id, address, ip, amount, trx_hash, status, message, create_time
+	from wlcy_faucet_grant
+	where 1=1 `
+	if address != "" {
+		if len(utils.Base58DecodeAddr(address)) != 21 {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		filterSQL = fmt.Sprintf(" and address='%v'", address)
+	}
+	sortSQL = "order by id desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", start, limit)
+	return module.QueryFaucetGrantsRealize(strSQL, filterSQL, sortSQL, pageSQL)
+}
+
+//RequestTestCoin 向地址发放测试币，同一地址和IP在冷却时间内只能领取一次，超出当日额度后拒绝
+func RequestTestCoin(req *entity.FaucetRequest, ip string) (*entity.FaucetGrant, error) {
+	conf := config.Get()
+	if !conf.FaucetEnabled() {
+		return nil, util.NewErrorMsg(util.Error_common_not_suport_request_url)
+	}
+	if req == nil || len(utils.Base58DecodeAddr(req.Address)) != 21 {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	privKey, address := getFaucetKey(conf)
+	if privKey == "" {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if req.Address == address {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	ok, err := getCaptchaVerifier(&conf.Faucet).Verify(req.CaptchaCode, ip)
+	if err != nil {
+		log.Errorf("RequestTestCoin verify captcha err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if !ok {
+		return nil, util.NewErrorMsg(util.Error_common_captcha_invalid)
+	}
+
+	//先占用冷却时间再扣额度，任何一步失败都退回已占用的部分
+	now := time.Now()
+	addressKey := faucetAddressKeyPrefix + req.Address
+	ipKey := faucetIPKeyPrefix + ip
+	if err := claimFaucetCooldown(addressKey, conf.Faucet.AddressCooldownSeconds); err != nil {
+		return nil, err
+	}
+	if err := claimFaucetCooldown(ipKey, conf.Faucet.IPCooldownSeconds); err != nil {
+		config.RedisCli.Del(addressKey)
+		return nil, err
+	}
+	budgetKey := getFaucetBudgetKey(now)
+	used, err := config.RedisCli.IncrBy(budgetKey, conf.Faucet.Amount).Result()
+	if err != nil {
+		log.Errorf("RequestTestCoin charge budget err:[%v]", err)
+		config.RedisCli.Del(addressKey, ipKey)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	config.RedisCli.Expire(budgetKey, 48*time.Hour)
+	if used > conf.Faucet.DailyBudget {
+		config.RedisCli.DecrBy(budgetKey, conf.Faucet.Amount)
+		config.RedisCli.Del(addressKey, ipKey)
+		return nil, util.NewErrorMsg(util.Error_common_budget_exhausted)
+	}
+
+	grant := &entity.FaucetGrant{}
+	grant.Address = req.Address
+	grant.IP = ip
+	grant.Amount = conf.Faucet.Amount
+	grant.CreateTime = now.UnixNano() / 1e6
+	grant.Hash, err = sendTestCoin(privKey, req.Address, conf.Faucet.Amount)
+	if err != nil {
+		log.Errorf("RequestTestCoin transfer to [%v] err:[%v]", req.Address, err)
+		config.RedisCli.DecrBy(budgetKey, conf.Faucet.Amount)
+		config.RedisCli.Del(addressKey, ipKey)
+		grant.Message = err.Error()
+	} else {
+		grant.Status = 1
+	}
+	if err := module.InsertFaucetGrant(grant); err != nil {
+		log.Errorf("RequestTestCoin save grant err:[%v], grant:[%#v]", err, grant)
+	}
+	if grant.Status != 1 {
+		return nil, util.NewErrorMsg(util.Error_common_failure)
+	}
+	return grant, nil
+}
+
+//claimFaucetCooldown 占用冷却时间，已被占用时返回限流错误
+func claimFaucetCooldown(key string, seconds int64) error {
+	if seconds <= 0 {
+		return nil
+	}
+	claimed, err := config.RedisCli.SetNX(key, time.Now().Unix(), time.Duration(seconds)*time.Second).Result()
+	if err != nil {
+		log.Errorf("claim faucet cooldown [%v] err:[%v]", key, err)
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if !claimed {
+		return util.NewErrorMsg(util.Error_common_request_rate_limited)
+	}
+	return nil
+}
+
+//sendTestCoin 通过fullnode转账，返回交易hash
+func sendTestCoin(privKey, address string, amount int64) (string, error) {
+	result, err := grpcclient.GetRandomWallet().EasyTransferByPrivate(privKey, address, amount)
+	if err != nil {
+		return "", err
+	}
+	if result == nil || result.GetResult() == nil {
+		return "", fmt.Errorf("empty transfer result")
+	}
+	if !result.GetResult().Result {
+		return "", fmt.Errorf("%v:%v", result.GetResult().Code, string(result.GetResult().Message))
+	}
+	hash := ""
+	if result.GetTransaction() != nil {
+		hash = utils.HexEncode(utils.CalcTransactionHash(result.GetTransaction()))
+	}
+	return hash, nil
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseCaptchaResult(t *testing.T) {
+	if ok, err := parseCaptchaResult([]byte(`{"success":true,"challenge_ts":"2018-10-10T00:00:00Z","hostname":"tronscan.org"}`)); !ok || err != nil {
+		t.Errorf("parseCaptchaResult success:%v %v", ok, err)
+	}
+	if ok, err := parseCaptchaResult([]byte(`{"success":false,"error-codes":["invalid-input-response"]}`)); ok || err != nil {
+		t.Errorf("parseCaptchaResult fail:%v %v", ok, err)
+	}
+	if _, err := parseCaptchaResult([]byte(`<html>`)); err == nil {
+		t.Errorf("parseCaptchaResult invalid json")
+	}
+}
+
+func TestGetFaucetBudgetKey(t *testing.T) {
+	//按UTC日期区分，与服务器时区无关
+	now := time.Date(2018, 10, 10, 1, 0, 0, 0, time.FixedZone("CST", 8*3600))
+	if key := getFaucetBudgetKey(now); key != "faucet.budget.20181009" {
+		t.Errorf("getFaucetBudgetKey:%v", key)
+	}
+	if ok, _ := (&recaptchaVerifier{}).Verify("", "127.0.0.1"); ok {
+		t.Errorf("recaptchaVerifier empty token")
+	}
+}
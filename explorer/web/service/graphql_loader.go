@@ -0,0 +1,395 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+/*
+graphql 嵌套查询的批量加载器
+同一层级的字段先登记key，返回thunk；graphql执行器在该层所有字段登记完毕后再调用thunk，
+第一个被调用的thunk把已登记的key一次性查出，其余thunk直接读缓存，避免 N+1 查询
+带分页的列表字段（账户的转账、超级代表产出的区块等）每个父对象一个子查询，用 union all 合成一条SQL
+每个请求一组加载器，随请求结束释放
+*/
+
+type graphqlLoaderKey struct{}
+
+type batchFetchFunc func(keys []string) (map[string]interface{}, error)
+
+type batchLoader struct {
+	sync.Mutex
+	fetch   batchFetchFunc
+	pending []string
+	cache   map[string]interface{}
+	errs    map[string]error
+}
+
+func newBatchLoader(fetch batchFetchFunc) *batchLoader {
+	return &batchLoader{
+		fetch: fetch,
+		cache: make(map[string]interface{}),
+		errs:  make(map[string]error),
+	}
+}
+
+//load 登记key，返回延迟执行的thunk
+//	查询出错时同一批的key都返回该错误，不再重复查询
+func (l *batchLoader) load(key string) func() (interface{}, error) {
+	l.Lock()
+	_, cached := l.cache[key]
+	if _, failed := l.errs[key]; !cached && !failed {
+		l.pending = append(l.pending, key)
+	}
+	l.Unlock()
+
+	return func() (interface{}, error) {
+		l.Lock()
+		defer l.Unlock()
+		if len(l.pending) > 0 {
+			keys, _ := mysql.Distinct(l.pending)
+			l.pending = nil
+			ret, err := l.fetch(keys)
+			for _, k := range keys {
+				if err != nil {
+					l.errs[k] = err
+				} else {
+					l.cache[k] = ret[k]
+				}
+			}
+		}
+		if err := l.errs[key]; err != nil {
+			return nil, err
+		}
+		if val := l.cache[key]; val != nil {
+			return val, nil
+		}
+		return nil, nil
+	}
+}
+
+//graphqlLoaders 单个请求内的批量加载器集合
+type graphqlLoaders struct {
+	sync.Mutex
+	account           *batchLoader
+	accountStats      *batchLoader
+	token             *batchLoader
+	block             *batchLoader
+	blockTransactions *batchLoader
+	blockTransfers    *batchLoader
+	lists             map[string]*batchLoader
+}
+
+func newGraphqlLoaders() *graphqlLoaders {
+	return &graphqlLoaders{
+		account:           newBatchLoader(fetchAccountsByAddress),
+		accountStats:      newBatchLoader(fetchAccountStats),
+		token:             newBatchLoader(fetchTokensByName),
+		block:             newBatchLoader(fetchBlocksByNumber),
+		blockTransactions: newBatchLoader(fetchBlockTransactions),
+		blockTransfers:    newBatchLoader(fetchBlockTransfers),
+		lists:             make(map[string]*batchLoader),
+	}
+}
+
+//listFetchFunc 按父对象批量查询分页的列表
+type listFetchFunc func(keys []string, start, limit int64) (map[string]interface{}, error)
+
+//list 带分页参数的列表字段，字段和分页参数相同的父对象共用一个加载器
+func (l *graphqlLoaders) list(field string, start, limit int64, fetch listFetchFunc) *batchLoader {
+	name := fmt.Sprintf("%v:%v:%v", field, start, limit)
+	l.Lock()
+	defer l.Unlock()
+	loader, ok := l.lists[name]
+	if !ok {
+		loader = newBatchLoader(func(keys []string) (map[string]interface{}, error) {
+			return fetch(keys, start, limit)
+		})
+		l.lists[name] = loader
+	}
+	return loader
+}
+
+func withGraphqlLoaders(ctx context.Context) context.Context {
+	return context.WithValue(ctx, graphqlLoaderKey{}, newGraphqlLoaders())
+}
+
+func getGraphqlLoaders(ctx context.Context) *graphqlLoaders {
+	if loaders, ok := ctx.Value(graphqlLoaderKey{}).(*graphqlLoaders); ok {
+		return loaders
+	}
+	return newGraphqlLoaders()
+}
+
+//fetchAccountsByAddress 一条SQL批量查询账户
+func fetchAccountsByAddress(addressList []string) (map[string]interface{}, error) {
+	ret := make(map[string]interface{}, len(addressList))
+	if len(addressList) == 0 {
+		return ret, nil
+	}
+	strSQL := fmt.Sprintf(`
+		   select account_name,address,balance as totalBalance,
+		   frozen,create_time,latest_operation_time,votes
+	       from tron.tron_account acc
+		   where 1=1 and %v`, mysql.GenSQLPartInStrList("acc.address", addressList, true))
+	accounts, err := module.QueryAccountListRealize(strSQL)
+	if err != nil {
+		return nil, err
+	}
+	for _, account := range accounts {
+		account.TokenBalances = buffer.GetAccountTokenBuffer().GetAccountTokenBuffer(account.Address)
+		ret[account.Address] = account
+	}
+	return ret, nil
+}
+
+//fetchAccountStats 多个账户的转出、转入笔数，一条SQL查询
+func fetchAccountStats(addressList []string) (map[string]interface{}, error) {
+	strSQL := module.GenGroupSQL(addressList, func(address, groupColumn string) string {
+		return fmt.Sprintf(`
+			select %v,
+			(select count(1) from tron.contract_transfer where owner_address='%v') as trxOut,
+			(select count(1) from tron.contract_transfer where to_address='%v') as trxIn`, groupColumn, address, address)
+	})
+	groups, err := module.QueryAccountStatsGroupRealize(strSQL)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]interface{}, len(addressList))
+	for _, address := range addressList {
+		if ret[address] = groups[address]; groups[address] == nil {
+			ret[address] = &entity.AccountTransactionNum{}
+		}
+	}
+	return ret, nil
+}
+
+//fetchTokensByName 先从通证缓存中读取，缓存中没有的再一条SQL批量查询
+func fetchTokensByName(nameList []string) (map[string]interface{}, error) {
+	ret := make(map[string]interface{}, len(nameList))
+	if len(nameList) == 0 {
+		return ret, nil
+	}
+	_, nameMap := mysql.Distinct(nameList)
+	if tokenResp := buffer.GetTokenBuffer().GetCommonTokenResp(); tokenResp != nil {
+		for _, token := range tokenResp.Data {
+			if _, ok := nameMap[token.Name]; ok {
+				ret[token.Name] = token
+			}
+		}
+	}
+	missList := make([]string, 0)
+	for _, name := range nameList {
+		if _, ok := ret[name]; !ok {
+			missList = append(missList, name)
+		}
+	}
+	if len(missList) == 0 {
+		return ret, nil
+	}
+	tokenResp, err := module.QueryTokensByName(missList)
+	if err != nil {
+		return nil, err
+	}
+	for _, token := range tokenResp.Data {
+		ret[token.Name] = token
+	}
+	return ret, nil
+}
+
+//fetchBlocksByNumber 先从缓存中按区间读取，缓存中没有的再一条SQL批量查询
+func fetchBlocksByNumber(numberList []string) (map[string]interface{}, error) {
+	ret := make(map[string]interface{}, len(numberList))
+	if len(numberList) == 0 {
+		return ret, nil
+	}
+	var minID, maxID int64 = -1, -1
+	for _, number := range numberList {
+		id := mysql.ConvertStringToInt64(number, 0)
+		if minID < 0 || id < minID {
+			minID = id
+		}
+		if id > maxID {
+			maxID = id
+		}
+	}
+	if maxID-minID < 200 {
+		blocks, err := buffer.GetBlockBuffer().GetBlocks(context.Background(), maxID, 0, maxID-minID+1)
+		if err != nil {
+			log.Errorf("fetchBlocksByNumber from buffer error:[%v]", err)
+		}
+		for _, block := range blocks {
+			ret[fmt.Sprintf("%v", block.Number)] = block
+		}
+	}
+
+	missList := make([]string, 0)
+	for _, number := range numberList {
+		if _, ok := ret[number]; !ok {
+			missList = append(missList, number)
+		}
+	}
+	if len(missList) == 0 {
+		return ret, nil
+	}
+	strSQL := fmt.Sprintf(`
+			select block_id,block_hash,block_size,create_time,
+			transaction_num,
+			tx_trie_hash,parent_hash,witness_address,confirmed
+			from tron.blocks
+			where 1=1 `)
+	filterSQL := fmt.Sprintf(" and block_id in (%v)", strings.Join(missList, ","))
+	pageSQL := fmt.Sprintf("limit 0, %v", len(missList))
+	blocksResp, err := module.QueryBlocksRealize(context.Background(), strSQL, filterSQL, "", pageSQL)
+	if err != nil {
+		return nil, err
+	}
+	for _, block := range blocksResp.Data {
+		ret[fmt.Sprintf("%v", block.Number)] = block
+	}
+	return ret, nil
+}
+
+//loadWitness 超级代表信息全部在缓存中，直接按地址读取
+func loadWitness(address string) (interface{}, error) {
+	if witness, ok := buffer.GetWitnessBuffer().GetWitnessByAddr(address); ok && witness != nil {
+		return witness, nil
+	}
+	return nil, nil
+}
+
+//fetchBlockTransactions 批量读取区块的交易，已确认且不在缓存中的区块一条SQL读取
+func fetchBlockTransactions(numberList []string) (map[string]interface{}, error) {
+	ret := make(map[string]interface{}, len(numberList))
+	for number, trxs := range buffer.GetBlockBuffer().GetTransactionByBlockIDs(convertBlockNumbers(numberList)) {
+		ret[fmt.Sprintf("%v", number)] = trxs
+	}
+	return ret, nil
+}
+
+//fetchBlockTransfers 批量读取区块的转账
+func fetchBlockTransfers(numberList []string) (map[string]interface{}, error) {
+	ret := make(map[string]interface{}, len(numberList))
+	for number, transfers := range buffer.GetBlockBuffer().GetTransferByBlockIDs(convertBlockNumbers(numberList)) {
+		ret[fmt.Sprintf("%v", number)] = transfers
+	}
+	return ret, nil
+}
+
+func convertBlockNumbers(numberList []string) []int64 {
+	blockIDs := make([]int64, 0, len(numberList))
+	for _, number := range numberList {
+		blockIDs = append(blockIDs, mysql.ConvertStringToInt64(number, 0))
+	}
+	return blockIDs
+}
+
+//fetchAccountTransfers 多个账户的转账，每个账户各自分页，一条SQL查询
+func fetchAccountTransfers(addressList []string, start, limit int64) (map[string]interface{}, error) {
+	strSQL := module.GenGroupSQL(addressList, func(address, groupColumn string) string {
+		return fmt.Sprintf(`
+			select %v,block_id,owner_address,to_address,amount,
+			asset_name,trx_hash,contract_type,confirmed,create_time
+			from tron.contract_transfer
+			where owner_address='%v' or to_address='%v'
+			order by create_time desc limit %v, %v`, groupColumn, address, address, start, limit)
+	})
+	groups, err := module.QueryTransfersGroupRealize(strSQL)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]interface{}, len(addressList))
+	for _, address := range addressList {
+		if ret[address] = groups[address]; groups[address] == nil {
+			ret[address] = make([]*entity.TransferInfo, 0)
+		}
+	}
+	return ret, nil
+}
+
+//fetchAccountTransactions 多个账户的交易，包含转入的转账，与 QueryTransactionsByAddress 相同
+func fetchAccountTransactions(addressList []string, start, limit int64) (map[string]interface{}, error) {
+	strSQL := module.GenGroupSQL(addressList, func(address, groupColumn string) string {
+		return fmt.Sprintf(`
+			select %v,oo.contract_type,oo.trx_hash,oo.create_time from (
+			SELECT contract_type,trx_hash,create_time
+			FROM tron.contract_transfer
+			where to_address='%v'
+			union
+			SELECT contract_type,trx_hash,create_time
+			FROM tron.transactions
+			where owner_address='%v') oo
+			order by create_time desc limit %v, %v`, groupColumn, address, address, start, limit)
+	})
+	groups, err := module.QueryTransactionsGroupRealize(strSQL)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]interface{}, len(addressList))
+	for _, address := range addressList {
+		if ret[address] = groups[address]; groups[address] == nil {
+			ret[address] = make([]*entity.TransactionInfo, 0)
+		}
+	}
+	return ret, nil
+}
+
+//fetchWitnessBlocks 多个超级代表产出的区块
+func fetchWitnessBlocks(addressList []string, start, limit int64) (map[string]interface{}, error) {
+	strSQL := module.GenGroupSQL(addressList, func(address, groupColumn string) string {
+		return fmt.Sprintf(`
+			select %v,block_id,block_hash,block_size,create_time,
+			transaction_num,
+			tx_trie_hash,parent_hash,witness_address,confirmed
+			from tron.blocks
+			where witness_address='%v'
+			order by block_id desc limit %v, %v`, groupColumn, address, start, limit)
+	})
+	groups, err := module.QueryBlocksGroupRealize(strSQL)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]interface{}, len(addressList))
+	for _, address := range addressList {
+		if ret[address] = groups[address]; groups[address] == nil {
+			ret[address] = make([]*entity.BlockInfo, 0)
+		}
+	}
+	return ret, nil
+}
+
+//fetchAccountVotes 多个账户投出的票
+func fetchAccountVotes(addressList []string, start, limit int64) (map[string]interface{}, error) {
+	return fetchVotesGroup("address", addressList, start, limit)
+}
+
+//fetchWitnessVoters 多个超级代表收到的投票
+func fetchWitnessVoters(addressList []string, start, limit int64) (map[string]interface{}, error) {
+	return fetchVotesGroup("to_address", addressList, start, limit)
+}
+
+func fetchVotesGroup(column string, addressList []string, start, limit int64) (map[string]interface{}, error) {
+	strSQL := module.GenGroupSQL(addressList, func(address, groupColumn string) string {
+		return fmt.Sprintf(`
+			select %v,address,to_address,vote from account_vote_result
+			where %v='%v'
+			order by vote desc limit %v, %v`, groupColumn, column, address, start, limit)
+	})
+	groups, err := module.QueryAccountVoteResultGroupRealize(strSQL)
+	if err != nil {
+		return nil, err
+	}
+	ret := make(map[string]interface{}, len(addressList))
+	for _, address := range addressList {
+		ret[address] = newVotesInfos(groups[address])
+	}
+	return ret, nil
+}
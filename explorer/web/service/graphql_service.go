@@ -0,0 +1,682 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+/*
+graphql 查询入口
+根查询复用现有的 Query* 方法和缓存，对象间的关联（区块->出块人，转账->账户/通证，投票->候选人 等）
+通过 graphql_loader.go 中的批量加载器解析
+字段名与entity中的json tag保持一致，前端可以直接复用 REST 接口的字段名
+*/
+
+var graphqlSchema graphql.Schema
+
+//graphqlMaxDepth 查询允许的最大嵌套层数，每层列表最多100条，层数过多时结果成倍增长
+const graphqlMaxDepth = 6
+
+var blockType, transactionType, transferType, accountType, tokenType, witnessType, voteType, tokenBalanceType *graphql.Object
+
+func init() {
+	initGraphqlTypes()
+	schema, err := graphql.NewSchema(graphql.SchemaConfig{
+		Query: graphql.NewObject(graphql.ObjectConfig{
+			Name:   "Query",
+			Fields: graphqlQueryFields(),
+		}),
+	})
+	if err != nil {
+		log.Errorf("init graphql schema error:[%v]", err)
+		return
+	}
+	graphqlSchema = schema
+}
+
+//ExecuteGraphQL 执行graphql查询
+func ExecuteGraphQL(req *entity.GraphQLReq) (*graphql.Result, error) {
+	if req.Query == "" {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	if depth := graphqlQueryDepth(req.Query); depth > graphqlMaxDepth {
+		log.Errorf("ExecuteGraphQL query depth:[%v] exceeds [%v]", depth, graphqlMaxDepth)
+		return nil, util.NewError(util.Error_common_parameter_invalid, fmt.Sprintf("query depth should not exceed %v", graphqlMaxDepth))
+	}
+	result := graphql.Do(graphql.Params{
+		Schema:         graphqlSchema,
+		RequestString:  req.Query,
+		OperationName:  req.OperationName,
+		VariableValues: req.Variables,
+		Context:        withGraphqlLoaders(context.Background()),
+	})
+	if result.HasErrors() {
+		log.Errorf("ExecuteGraphQL error:[%v], query:[%v]", result.Errors, req.Query)
+	}
+	return result, nil
+}
+
+//graphqlQueryDepth 查询中字段的最大嵌套层数，片段展开后计算；语法错误时返回0，由执行器返回错误
+func graphqlQueryDepth(query string) int {
+	doc, err := parser.Parse(parser.ParseParams{Source: query})
+	if err != nil {
+		return 0
+	}
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		if fragment, ok := def.(*ast.FragmentDefinition); ok {
+			fragments[fragment.Name.Value] = fragment
+		}
+	}
+	maxDepth := 0
+	for _, def := range doc.Definitions {
+		if operation, ok := def.(*ast.OperationDefinition); ok {
+			if depth := selectionDepth(operation.SelectionSet, fragments, make(map[string]bool)); depth > maxDepth {
+				maxDepth = depth
+			}
+		}
+	}
+	return maxDepth
+}
+
+//selectionDepth 片段循环引用时按超出最大层数处理
+func selectionDepth(set *ast.SelectionSet, fragments map[string]*ast.FragmentDefinition, visiting map[string]bool) int {
+	if set == nil {
+		return 0
+	}
+	maxDepth := 0
+	for _, selection := range set.Selections {
+		depth := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			depth = 1 + selectionDepth(s.SelectionSet, fragments, visiting)
+		case *ast.InlineFragment:
+			depth = selectionDepth(s.SelectionSet, fragments, visiting)
+		case *ast.FragmentSpread:
+			fragment, ok := fragments[s.Name.Value]
+			if !ok {
+				continue
+			}
+			if visiting[s.Name.Value] {
+				return graphqlMaxDepth + 1
+			}
+			visiting[s.Name.Value] = true
+			depth = selectionDepth(fragment.SelectionSet, fragments, visiting)
+			delete(visiting, s.Name.Value)
+		}
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+	return maxDepth
+}
+
+//checkGraphqlAddress 地址参数会拼接到SQL中，不是合法的地址时返回参数错误
+func checkGraphqlAddress(address string) error {
+	if address != "" && len(utils.Base58DecodeAddr(address)) != 21 {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	return nil
+}
+
+//checkGraphqlHash 交易hash为64位16进制
+func checkGraphqlHash(hash string) error {
+	if data, err := hex.DecodeString(hash); err != nil || len(data) != 32 {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	return nil
+}
+
+func pageArgs() graphql.FieldConfigArgument {
+	return graphql.FieldConfigArgument{
+		"start": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+		"limit": &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 20},
+	}
+}
+
+//getPageArgs 读取分页参数，单次查询最多返回100条
+func getPageArgs(p graphql.ResolveParams) (start, limit int64) {
+	if v, ok := p.Args["start"].(int); ok && v > 0 {
+		start = int64(v)
+	}
+	limit = 20
+	if v, ok := p.Args["limit"].(int); ok && v > 0 {
+		limit = int64(v)
+	}
+	if limit > 100 {
+		limit = 100
+	}
+	return
+}
+
+func getStringArg(p graphql.ResolveParams, name string) string {
+	v, _ := p.Args[name].(string)
+	return v
+}
+
+func loadAccount(p graphql.ResolveParams, address string) (interface{}, error) {
+	if address == "" || checkGraphqlAddress(address) != nil {
+		return nil, nil
+	}
+	return getGraphqlLoaders(p.Context).account.load(address), nil
+}
+
+func loadToken(p graphql.ResolveParams, name string) (interface{}, error) {
+	if name == "" || name == "TRX" {
+		return nil, nil
+	}
+	return getGraphqlLoaders(p.Context).token.load(name), nil
+}
+
+func loadBlock(p graphql.ResolveParams, number int64) (interface{}, error) {
+	return getGraphqlLoaders(p.Context).block.load(fmt.Sprintf("%v", number)), nil
+}
+
+//loadList 带分页参数的列表字段
+func loadList(p graphql.ResolveParams, key string, fetch listFetchFunc) (interface{}, error) {
+	start, limit := getPageArgs(p)
+	field := p.Info.ParentType.Name() + "." + p.Info.FieldName
+	return getGraphqlLoaders(p.Context).list(field, start, limit, fetch).load(key), nil
+}
+
+func initGraphqlTypes() {
+	tokenBalanceType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "TokenBalance",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name":    &graphql.Field{Type: graphql.String},
+				"balance": &graphql.Field{Type: graphql.Float},
+				"token": &graphql.Field{
+					Type: tokenType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						balance := p.Source.(*entity.Balance)
+						return loadToken(p, balance.Name)
+					},
+				},
+			}
+		}),
+	})
+
+	blockType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Block",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"number":         &graphql.Field{Type: graphql.Float},
+				"hash":           &graphql.Field{Type: graphql.String},
+				"size":           &graphql.Field{Type: graphql.Float},
+				"timestamp":      &graphql.Field{Type: graphql.Float},
+				"txTrieRoot":     &graphql.Field{Type: graphql.String},
+				"parentHash":     &graphql.Field{Type: graphql.String},
+				"witnessAddress": &graphql.Field{Type: graphql.String},
+				"nrOfTrx":        &graphql.Field{Type: graphql.Float},
+				"confirmed":      &graphql.Field{Type: graphql.Boolean},
+				"witnessName":    &graphql.Field{Type: graphql.String},
+				"witness": &graphql.Field{
+					Type: witnessType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadWitness(p.Source.(*entity.BlockInfo).WitnessAddress)
+					},
+				},
+				"transactions": &graphql.Field{
+					Type: graphql.NewList(transactionType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						block := p.Source.(*entity.BlockInfo)
+						return getGraphqlLoaders(p.Context).blockTransactions.load(fmt.Sprintf("%v", block.Number)), nil
+					},
+				},
+				"transfers": &graphql.Field{
+					Type: graphql.NewList(transferType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						block := p.Source.(*entity.BlockInfo)
+						return getGraphqlLoaders(p.Context).blockTransfers.load(fmt.Sprintf("%v", block.Number)), nil
+					},
+				},
+			}
+		}),
+	})
+
+	transactionType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transaction",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"hash":         &graphql.Field{Type: graphql.String},
+				"block":        &graphql.Field{Type: graphql.Float},
+				"timestamp":    &graphql.Field{Type: graphql.Float},
+				"ownerAddress": &graphql.Field{Type: graphql.String},
+				"toAddress":    &graphql.Field{Type: graphql.String},
+				"contractType": &graphql.Field{Type: graphql.Int},
+				"confirmed":    &graphql.Field{Type: graphql.Boolean},
+				"blockInfo": &graphql.Field{
+					Type: blockType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadBlock(p, p.Source.(*entity.TransactionInfo).Block)
+					},
+				},
+				"owner": &graphql.Field{
+					Type: accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadAccount(p, p.Source.(*entity.TransactionInfo).OwnerAddress)
+					},
+				},
+				"to": &graphql.Field{
+					Type: accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadAccount(p, p.Source.(*entity.TransactionInfo).ToAddress)
+					},
+				},
+			}
+		}),
+	})
+
+	transferType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Transfer",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"transactionHash":     &graphql.Field{Type: graphql.String},
+				"block":               &graphql.Field{Type: graphql.Float},
+				"timestamp":           &graphql.Field{Type: graphql.Float},
+				"transferFromAddress": &graphql.Field{Type: graphql.String},
+				"transferToAddress":   &graphql.Field{Type: graphql.String},
+				"amount":              &graphql.Field{Type: graphql.Float},
+				"tokenName":           &graphql.Field{Type: graphql.String},
+				"confirmed":           &graphql.Field{Type: graphql.Boolean},
+				"blockInfo": &graphql.Field{
+					Type: blockType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadBlock(p, p.Source.(*entity.TransferInfo).Block)
+					},
+				},
+				"from": &graphql.Field{
+					Type: accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadAccount(p, p.Source.(*entity.TransferInfo).TransferFromAddress)
+					},
+				},
+				"to": &graphql.Field{
+					Type: accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadAccount(p, p.Source.(*entity.TransferInfo).TransferToAddress)
+					},
+				},
+				"token": &graphql.Field{
+					Type: tokenType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadToken(p, p.Source.(*entity.TransferInfo).TokenName)
+					},
+				},
+			}
+		}),
+	})
+
+	accountType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Account",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"address":     &graphql.Field{Type: graphql.String},
+				"name":        &graphql.Field{Type: graphql.String},
+				"balance":     &graphql.Field{Type: graphql.Float},
+				"power":       &graphql.Field{Type: graphql.Float},
+				"dateCreated": &graphql.Field{Type: graphql.Float},
+				"dateUpdated": &graphql.Field{Type: graphql.Float},
+				"tokenBalances": &graphql.Field{
+					Type: graphql.NewList(tokenBalanceType),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						account := p.Source.(*entity.AccountInfo)
+						balances := make([]*entity.Balance, 0, len(account.TokenBalances))
+						for name, balance := range account.TokenBalances {
+							balances = append(balances, &entity.Balance{Name: name, Balance: float64(balance)})
+						}
+						return balances, nil
+					},
+				},
+				"witness": &graphql.Field{
+					Type: witnessType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadWitness(p.Source.(*entity.AccountInfo).Address)
+					},
+				},
+				"stats": &graphql.Field{
+					Type: accountStatsType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return getGraphqlLoaders(p.Context).accountStats.load(p.Source.(*entity.AccountInfo).Address), nil
+					},
+				},
+				"transfers": &graphql.Field{
+					Type: graphql.NewList(transferType),
+					Args: pageArgs(),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadList(p, p.Source.(*entity.AccountInfo).Address, fetchAccountTransfers)
+					},
+				},
+				"transactions": &graphql.Field{
+					Type: graphql.NewList(transactionType),
+					Args: pageArgs(),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadList(p, p.Source.(*entity.AccountInfo).Address, fetchAccountTransactions)
+					},
+				},
+				"votes": &graphql.Field{
+					Type: graphql.NewList(voteType),
+					Args: pageArgs(),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadList(p, p.Source.(*entity.AccountInfo).Address, fetchAccountVotes)
+					},
+				},
+			}
+		}),
+	})
+
+	tokenType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Token",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"name":         &graphql.Field{Type: graphql.String},
+				"abbr":         &graphql.Field{Type: graphql.String},
+				"ownerAddress": &graphql.Field{Type: graphql.String},
+				"totalSupply":  &graphql.Field{Type: graphql.Float},
+				"trxNum":       &graphql.Field{Type: graphql.Float},
+				"num":          &graphql.Field{Type: graphql.Float},
+				"price":        &graphql.Field{Type: graphql.Float},
+				"issued":       &graphql.Field{Type: graphql.Float},
+				"participated": &graphql.Field{Type: graphql.Float},
+				"startTime":    &graphql.Field{Type: graphql.Float},
+				"endTime":      &graphql.Field{Type: graphql.Float},
+				"description":  &graphql.Field{Type: graphql.String},
+				"url":          &graphql.Field{Type: graphql.String},
+				"imgUrl":       &graphql.Field{Type: graphql.String},
+				"website":      &graphql.Field{Type: graphql.String},
+				"owner": &graphql.Field{
+					Type: accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadAccount(p, p.Source.(*entity.TokenInfo).OwnerAddress)
+					},
+				},
+			}
+		}),
+	})
+
+	witnessType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Witness",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"address":           &graphql.Field{Type: graphql.String},
+				"name":              &graphql.Field{Type: graphql.String},
+				"url":               &graphql.Field{Type: graphql.String},
+				"producer":          &graphql.Field{Type: graphql.Boolean},
+				"latestBlockNumber": &graphql.Field{Type: graphql.Float},
+				"latestSlotNumber":  &graphql.Field{Type: graphql.Float},
+				"missedTotal":       &graphql.Field{Type: graphql.Float},
+				"producedTotal":     &graphql.Field{Type: graphql.Float},
+				"votes":             &graphql.Field{Type: graphql.Float},
+				"producePercentage": &graphql.Field{Type: graphql.Float},
+				"votesPercentage":   &graphql.Field{Type: graphql.Float},
+				"account": &graphql.Field{
+					Type: accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadAccount(p, p.Source.(*entity.WitnessInfo).Address)
+					},
+				},
+				"voters": &graphql.Field{
+					Type: graphql.NewList(voteType),
+					Args: pageArgs(),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadList(p, p.Source.(*entity.WitnessInfo).Address, fetchWitnessVoters)
+					},
+				},
+				"blocks": &graphql.Field{
+					Type: graphql.NewList(blockType),
+					Args: pageArgs(),
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadList(p, p.Source.(*entity.WitnessInfo).Address, fetchWitnessBlocks)
+					},
+				},
+			}
+		}),
+	})
+
+	voteType = graphql.NewObject(graphql.ObjectConfig{
+		Name: "Vote",
+		Fields: graphql.FieldsThunk(func() graphql.Fields {
+			return graphql.Fields{
+				"voterAddress":     &graphql.Field{Type: graphql.String},
+				"candidateAddress": &graphql.Field{Type: graphql.String},
+				"votes":            &graphql.Field{Type: graphql.Float},
+				"voter": &graphql.Field{
+					Type: accountType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadAccount(p, p.Source.(*entity.VotesInfo).VoterAddress)
+					},
+				},
+				"candidate": &graphql.Field{
+					Type: witnessType,
+					Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+						return loadWitness(p.Source.(*entity.VotesInfo).CandidateAddress)
+					},
+				},
+			}
+		}),
+	})
+}
+
+var accountStatsType = graphql.NewObject(graphql.ObjectConfig{
+	Name: "AccountStats",
+	Fields: graphql.Fields{
+		"transactions":     &graphql.Field{Type: graphql.Float},
+		"transactions_out": &graphql.Field{Type: graphql.Float},
+		"transactions_in":  &graphql.Field{Type: graphql.Float},
+	},
+})
+
+//queryGraphqlVotes 查询投票记录，候选人和投票人信息交给加载器批量解析，不走 queryVotesSubHandle 的逐条查询
+func queryGraphqlVotes(voter, candidate string, start, limit int64) ([]*entity.VotesInfo, error) {
+	if err := checkGraphqlAddress(voter); err != nil {
+		return nil, err
+	}
+	if err := checkGraphqlAddress(candidate); err != nil {
+		return nil, err
+	}
+	var filterSQL string
+	strSQL := fmt.Sprintf(`
+			select address, to_address, vote from account_vote_result where 1=1 `)
+	if voter != "" {
+		filterSQL += fmt.Sprintf(" and address='%v'", voter)
+	}
+	if candidate != "" {
+		filterSQL += fmt.Sprintf(" and to_address='%v'", candidate)
+	}
+	pageSQL := fmt.Sprintf("limit %v, %v", start, limit)
+	accountVoteResultRes, err := module.QueryAccountVoteResultRealize(strSQL, filterSQL, "order by vote desc", pageSQL)
+	if err != nil {
+		log.Errorf("queryGraphqlVotes list is nil or err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return newVotesInfos(accountVoteResultRes.Data), nil
+}
+
+func newVotesInfos(results []*entity.AccountVoteResult) []*entity.VotesInfo {
+	voteInfos := make([]*entity.VotesInfo, 0, len(results))
+	for _, v := range results {
+		voteInfos = append(voteInfos, &entity.VotesInfo{
+			VoterAddress:     v.Address,
+			CandidateAddress: v.ToAddress,
+			Votes:            v.Vote,
+		})
+	}
+	return voteInfos
+}
+
+func graphqlQueryFields() graphql.Fields {
+	return graphql.Fields{
+		"block": &graphql.Field{
+			Type: blockType,
+			Args: graphql.FieldConfigArgument{
+				"number": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.Float)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				number, _ := p.Args["number"].(float64)
+				return loadBlock(p, int64(number))
+			},
+		},
+		"blocks": &graphql.Field{
+			Type: graphql.NewList(blockType),
+			Args: graphql.FieldConfigArgument{
+				"start":    &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+				"limit":    &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 20},
+				"producer": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if err := checkGraphqlAddress(getStringArg(p, "producer")); err != nil {
+					return nil, err
+				}
+				start, limit := getPageArgs(p)
+				resp, err := QueryBlocksBuffer(p.Context, &entity.Blocks{Producer: getStringArg(p, "producer"), Sort: "-number", Start: start, Limit: limit})
+				if err != nil {
+					return nil, err
+				}
+				return resp.Data, nil
+			},
+		},
+		"transaction": &graphql.Field{
+			Type: transactionType,
+			Args: graphql.FieldConfigArgument{
+				"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if err := checkGraphqlHash(getStringArg(p, "hash")); err != nil {
+					return nil, err
+				}
+				req := &entity.Transactions{Hash: getStringArg(p, "hash")}
+				if trx, _ := QueryTransactionByHashFromBuffer(req); trx != nil {
+					return trx, nil
+				}
+				return QueryTransaction(req)
+			},
+		},
+		"transactions": &graphql.Field{
+			Type: graphql.NewList(transactionType),
+			Args: graphql.FieldConfigArgument{
+				"start":   &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+				"limit":   &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 20},
+				"address": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if err := checkGraphqlAddress(getStringArg(p, "address")); err != nil {
+					return nil, err
+				}
+				start, limit := getPageArgs(p)
+				resp, err := QueryTransactionsBuffer(&entity.Transactions{Address: getStringArg(p, "address"), Sort: "-timestamp", Start: start, Limit: limit})
+				if err != nil {
+					return nil, err
+				}
+				return resp.Data, nil
+			},
+		},
+		"transfer": &graphql.Field{
+			Type: transferType,
+			Args: graphql.FieldConfigArgument{
+				"hash": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if err := checkGraphqlHash(getStringArg(p, "hash")); err != nil {
+					return nil, err
+				}
+				req := &entity.Transfers{Hash: getStringArg(p, "hash")}
+				if transfer, _ := QueryTransferByHashFromBuffer(req); transfer != nil {
+					return transfer, nil
+				}
+				return QueryTransfer(req)
+			},
+		},
+		"transfers": &graphql.Field{
+			Type: graphql.NewList(transferType),
+			Args: graphql.FieldConfigArgument{
+				"start":   &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+				"limit":   &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 20},
+				"address": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				if err := checkGraphqlAddress(getStringArg(p, "address")); err != nil {
+					return nil, err
+				}
+				start, limit := getPageArgs(p)
+				resp, err := QueryTransfersBuffer(&entity.Transfers{Address: getStringArg(p, "address"), Sort: "-timestamp", Start: start, Limit: limit})
+				if err != nil {
+					return nil, err
+				}
+				return resp.Data, nil
+			},
+		},
+		"account": &graphql.Field{
+			Type: accountType,
+			Args: graphql.FieldConfigArgument{
+				"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return loadAccount(p, getStringArg(p, "address"))
+			},
+		},
+		"token": &graphql.Field{
+			Type: tokenType,
+			Args: graphql.FieldConfigArgument{
+				"name": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				token, err := QueryToken(getStringArg(p, "name"))
+				if err != nil || token == nil {
+					return nil, err
+				}
+				return token, nil
+			},
+		},
+		"tokens": &graphql.Field{
+			Type: graphql.NewList(tokenType),
+			Args: pageArgs(),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				start, limit := getPageArgs(p)
+				resp, err := QueryCommonTokensBuffer(&entity.Token{Start: fmt.Sprintf("%v", start), Limit: fmt.Sprintf("%v", limit)})
+				if err != nil {
+					return nil, err
+				}
+				return resp.Data, nil
+			},
+		},
+		"witnesses": &graphql.Field{
+			Type: graphql.NewList(witnessType),
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return QueryWitnessBuffer()
+			},
+		},
+		"witness": &graphql.Field{
+			Type: witnessType,
+			Args: graphql.FieldConfigArgument{
+				"address": &graphql.ArgumentConfig{Type: graphql.NewNonNull(graphql.String)},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				return loadWitness(getStringArg(p, "address"))
+			},
+		},
+		"votes": &graphql.Field{
+			Type: graphql.NewList(voteType),
+			Args: graphql.FieldConfigArgument{
+				"start":     &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 0},
+				"limit":     &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: 20},
+				"voter":     &graphql.ArgumentConfig{Type: graphql.String},
+				"candidate": &graphql.ArgumentConfig{Type: graphql.String},
+			},
+			Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+				start, limit := getPageArgs(p)
+				return queryGraphqlVotes(getStringArg(p, "voter"), getStringArg(p, "candidate"), start, limit)
+			},
+		},
+	}
+}
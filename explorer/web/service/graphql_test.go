@@ -0,0 +1,82 @@
+package service
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestBatchLoader(t *testing.T) {
+	fetchTimes := 0
+	loader := newBatchLoader(func(keys []string) (map[string]interface{}, error) {
+		fetchTimes++
+		ret := make(map[string]interface{}, len(keys))
+		for _, key := range keys {
+			if key != "none" {
+				ret[key] = "val_" + key
+			}
+		}
+		return ret, nil
+	})
+
+	thunks := []func() (interface{}, error){
+		loader.load("a"),
+		loader.load("b"),
+		loader.load("a"),
+		loader.load("none"),
+	}
+	for _, thunk := range thunks {
+		thunk()
+	}
+	if fetchTimes != 1 {
+		t.Errorf("fetch times:%v, want 1", fetchTimes)
+	}
+
+	val, _ := loader.load("b")()
+	if val != "val_b" || fetchTimes != 1 {
+		t.Errorf("cached val:%v, fetch times:%v", val, fetchTimes)
+	}
+	val, _ = loader.load("none")()
+	if val != nil {
+		t.Errorf("missing key val:%v, want nil", val)
+	}
+}
+
+func TestBatchLoaderError(t *testing.T) {
+	fetchTimes := 0
+	loader := newBatchLoader(func(keys []string) (map[string]interface{}, error) {
+		fetchTimes++
+		return nil, errors.New("db error")
+	})
+
+	thunks := []func() (interface{}, error){
+		loader.load("a"),
+		loader.load("b"),
+	}
+	for _, thunk := range thunks {
+		if val, err := thunk(); val != nil || err == nil {
+			t.Errorf("val:%v, err:%v, want error", val, err)
+		}
+	}
+	if _, err := loader.load("a")(); err == nil || fetchTimes != 1 {
+		t.Errorf("failed key err:%v, fetch times:%v", err, fetchTimes)
+	}
+}
+
+func TestGraphqlQueryDepth(t *testing.T) {
+	cases := []struct {
+		query string
+		depth int
+	}{
+		{`{ block(number:1){ number } }`, 2},
+		{`{ account(address:"a"){ transfers{ from{ transfers{ to{ address } } } } } }`, 6},
+		{`query { ...f } fragment f on Query { account(address:"a"){ ...g } } fragment g on Account { votes{ voter{ address } } }`, 4},
+		{`{ account(address:"a"){ ... on Account { witness{ voters{ votes } } } } }`, 4},
+		{`{ ...f } fragment f on Query { ...f }`, graphqlMaxDepth + 1},
+		{`{ block(`, 0},
+	}
+	for _, c := range cases {
+		if depth := graphqlQueryDepth(c.query); depth != c.depth {
+			t.Errorf("query:%v depth:%v, want %v", c.query, depth, c.depth)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/health"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/web/buffer"
+)
+
+//RegisterHealthChecks 注册web服务的就绪检查：mysql、redis、至少一个可用的full node、缓存和db的同步进度
+//	buffer 缓存的最新块落后full node超过 health.maxSyncLag，或最新块时间超过 maxSyncLag 个出块间隔未更新时不可用
+//	sync   db中的确认块落后solidity node超过 health.maxSyncLag 时不可用
+//	收到退出信号后 /readyz 立即返回503，负载均衡不再转发新请求
+func RegisterHealthChecks() {
+	health.Register("shutdown", checkShutdown)
+	health.Register("mysql", checkMysql)
+	health.Register("redis", checkRedis)
+	health.Register("node", checkNode)
+	health.Register("buffer", checkBlockBuffer)
+	health.Register("sync", checkSync)
+}
+
+func checkShutdown() (interface{}, error) {
+	if lifecycle.Stopping() {
+		return nil, fmt.Errorf("service is shutting down")
+	}
+	return nil, nil
+}
+
+func checkMysql() (interface{}, error) {
+	_, err := mysql.GetDatabase()
+	return nil, err
+}
+
+func checkRedis() (interface{}, error) {
+	if config.RedisCli == nil {
+		return nil, fmt.Errorf("redis not initialized")
+	}
+	return nil, config.RedisCli.Ping().Err()
+}
+
+func checkNode() (interface{}, error) {
+	endpoint, err := grpcclient.PingFullNode()
+	if err != nil {
+		return nil, err
+	}
+	return map[string]string{"endpoint": endpoint}, nil
+}
+
+func checkBlockBuffer() (interface{}, error) {
+	blockBuffer := buffer.GetBlockBuffer()
+	maxSyncLag := config.Get().Health.MaxSyncLag
+	detail, err := health.SyncLag(blockBuffer.GetMaxBlockID(), blockBuffer.GetFullNodeMaxBlockID(), maxSyncLag)
+	if err != nil {
+		return detail, err
+	}
+	age := time.Now().UnixNano()/1e6 - blockBuffer.GetMaxBlockTimestamp()
+	if age > maxSyncLag*blockInterval {
+		return detail, fmt.Errorf("latest block in buffer is %vs old", age/1000)
+	}
+	return detail, nil
+}
+
+func checkSync() (interface{}, error) {
+	blockBuffer := buffer.GetBlockBuffer()
+	return health.SyncLag(blockBuffer.GetMaxConfirmedBlockID(), blockBuffer.GetSolidityNodeMaxBlockID(), config.Get().Health.MaxSyncLag)
+}
@@ -0,0 +1,368 @@
+package service
+
+import (
+	"context"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/trace"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+//地址标签分类
+const (
+	LabelCategoryExchange = "exchange"
+	LabelCategoryScam     = "scam"
+	LabelCategoryContract = "contract"
+	LabelCategoryTeam     = "team"
+	LabelCategoryOther    = "other"
+)
+
+//AddressLabelImportMaxRows 一次最多导入的标签数
+const AddressLabelImportMaxRows = 50000
+
+//addressLabelExportPageSize 导出时每次查询的记录数
+const addressLabelExportPageSize = 5000
+
+var addressLabelCSVHeader = []string{"address", "label", "category", "note"}
+
+var labelCategories = map[string]bool{
+	LabelCategoryExchange: true,
+	LabelCategoryScam:     true,
+	LabelCategoryContract: true,
+	LabelCategoryTeam:     true,
+	LabelCategoryOther:    true,
+}
+
+//GetLabelOwnerKey 私有标签归属于有效的api key
+func GetLabelOwnerKey(apiKey string) (string, error) {
+	if apiKey == "" {
+		return "", util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	if _, ok := buffer.GetAPIKeyBuffer().GetAPIKey(apiKey); !ok {
+		return "", util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	return apiKey, nil
+}
+
+//QueryAddressLabel 查询地址标签，api key有该地址的私有标签时优先返回私有标签
+func QueryAddressLabel(address, apiKey string) (*entity.AddressLabel, error) {
+	if len(utils.Base58DecodeAddr(address)) != 21 {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	if ownerKey, err := GetLabelOwnerKey(apiKey); err == nil {
+		labels, err := module.QueryAddressLabelsByAddress(context.Background(), ownerKey, []string{address})
+		if err != nil {
+			return nil, err
+		}
+		if len(labels) > 0 {
+			return labels[0], nil
+		}
+	}
+	if label := buffer.GetLabelBuffer().GetLabel(address); label != nil {
+		return label, nil
+	}
+	return nil, util.NewErrorMsg(util.Error_common_no_data)
+}
+
+//QueryAddressLabels 分页查询标签，ownerKey为空时查询公开标签
+func QueryAddressLabels(ownerKey, category string, start, limit int64) (*entity.AddressLabelsResp, error) {
+	if category != "" && !labelCategories[category] {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := `
+	select id, owner_key, address, label, category, note, update_time
+	from wlcy_address_label
+	where 1=1 `
+	filterSQL = fmt.Sprintf(" and owner_key='%v'", ownerKey)
+	if category != "" {
+		filterSQL = fmt.Sprintf("%v and category='%v'", filterSQL, category)
+	}
+	sortSQL = "order by id desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", start, limit)
+	return module.QueryAddressLabelsRealize(strSQL, filterSQL, sortSQL, pageSQL)
+}
+
+//SaveAddressLabel 新增或修改地址标签
+func SaveAddressLabel(ownerKey string, label *entity.AddressLabel) (*entity.AddressLabel, error) {
+	if err := checkAddressLabel(label); err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	label.Private = ownerKey != ""
+	label.UpdateTime = time.Now().UnixNano() / 1e6
+	if _, err := module.SaveAddressLabels(ownerKey, []*entity.AddressLabel{label}); err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if ownerKey == "" {
+		buffer.GetLabelBuffer().Reload()
+	}
+	return label, nil
+}
+
+//DeleteAddressLabel 删除地址标签
+func DeleteAddressLabel(ownerKey, address string) (interface{}, error) {
+	if len(utils.Base58DecodeAddr(address)) != 21 {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	rows, err := module.DeleteAddressLabel(ownerKey, address)
+	if err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if rows == 0 {
+		return nil, util.NewErrorMsg(util.Error_common_no_data)
+	}
+	if ownerKey == "" {
+		buffer.GetLabelBuffer().Reload()
+	}
+	return "ok", nil
+}
+
+//ImportAddressLabels 从csv导入标签，列为 address,label,category,note，表头可选
+//校验失败的行跳过并返回行号，地址已有标签时覆盖
+func ImportAddressLabels(ownerKey string, r io.Reader) (*entity.AddressLabelImportResp, error) {
+	labels, importResp, err := parseAddressLabelCSV(r, AddressLabelImportMaxRows)
+	if err != nil {
+		log.Errorf("ImportAddressLabels parse csv err:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	now := time.Now().UnixNano() / 1e6
+	for _, label := range labels {
+		label.UpdateTime = now
+	}
+	importResp.Imported, err = module.SaveAddressLabels(ownerKey, labels)
+	if ownerKey == "" && importResp.Imported > 0 {
+		buffer.GetLabelBuffer().Reload()
+	}
+	if err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return importResp, nil
+}
+
+//ExportAddressLabels 按编号分批查询并写入csv，格式与导入相同
+func ExportAddressLabels(ownerKey string, w io.Writer) error {
+	flusher, _ := w.(http.Flusher)
+	csvWriter := csv.NewWriter(w)
+	if err := csvWriter.Write(addressLabelCSVHeader); err != nil {
+		return err
+	}
+	var lastID int64
+	for {
+		strSQL := fmt.Sprintf(`
+		select id, owner_key, address, label, category, note, update_time
+		from wlcy_address_label
+		where owner_key='%v' and id>%v
+		order by id limit %v`, ownerKey, lastID, addressLabelExportPageSize)
+		labels, err := module.QueryLabelsRealize(context.Background(), strSQL)
+		if err != nil {
+			return err
+		}
+		for _, label := range labels {
+			if err := csvWriter.Write([]string{label.Address, label.Label, label.Category, label.Note}); err != nil {
+				return err
+			}
+			lastID = label.ID
+		}
+		csvWriter.Flush()
+		if err := csvWriter.Error(); err != nil {
+			return err
+		}
+		if flusher != nil {
+			flusher.Flush()
+		}
+		if len(labels) < addressLabelExportPageSize {
+			return nil
+		}
+	}
+}
+
+//parseAddressLabelCSV 解析csv，同一地址出现多次时以最后一行为准
+func parseAddressLabelCSV(r io.Reader, maxRows int) ([]*entity.AddressLabel, *entity.AddressLabelImportResp, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	importResp := &entity.AddressLabelImportResp{Errors: make([]*entity.AddressLabelImportError, 0)}
+	labels := make([]*entity.AddressLabel, 0)
+	addressIndex := make(map[string]int)
+	for line := int64(1); ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		if line == 1 && len(record) > 0 && strings.EqualFold(strings.TrimPrefix(record[0], "\ufeff"), "address") {
+			continue
+		}
+		importResp.Total++
+		if importResp.Total > int64(maxRows) {
+			return nil, nil, fmt.Errorf("too many rows, max:[%v]", maxRows)
+		}
+		if len(record) < 2 {
+			importResp.Errors = append(importResp.Errors, &entity.AddressLabelImportError{Line: line, Reason: "address and label required"})
+			continue
+		}
+		label := &entity.AddressLabel{Address: strings.TrimSpace(record[0]), Label: strings.TrimSpace(record[1])}
+		if len(record) > 2 {
+			label.Category = strings.TrimSpace(record[2])
+		}
+		if len(record) > 3 {
+			label.Note = strings.TrimSpace(record[3])
+		}
+		if err := checkAddressLabel(label); err != nil {
+			importResp.Errors = append(importResp.Errors, &entity.AddressLabelImportError{Line: line, Reason: err.Error()})
+			continue
+		}
+		if index, ok := addressIndex[label.Address]; ok {
+			labels[index] = label
+			continue
+		}
+		addressIndex[label.Address] = len(labels)
+		labels = append(labels, label)
+	}
+	return labels, importResp, nil
+}
+
+//checkAddressLabel 校验标签，分类为空时使用other
+func checkAddressLabel(label *entity.AddressLabel) error {
+	if len(utils.Base58DecodeAddr(label.Address)) != 21 {
+		return fmt.Errorf("invalid address")
+	}
+	if label.Label == "" || utf8.RuneCountInString(label.Label) > 100 || !isPrintableLabel(label.Label) {
+		return fmt.Errorf("invalid label")
+	}
+	if label.Category == "" {
+		label.Category = LabelCategoryOther
+	}
+	if !labelCategories[label.Category] {
+		return fmt.Errorf("invalid category")
+	}
+	if utf8.RuneCountInString(label.Note) > 500 || !isPrintableLabel(label.Note) {
+		return fmt.Errorf("invalid note")
+	}
+	return nil
+}
+
+func isPrintableLabel(s string) bool {
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return utf8.ValidString(s)
+}
+
+//getAddressLabelTags 公开标签从缓存读取，api key有效时私有标签覆盖公开标签
+func getAddressLabelTags(ctx context.Context, addresses []string, apiKey string) map[string]*entity.AddressLabelTag {
+	tags := make(map[string]*entity.AddressLabelTag)
+	uniqAddresses := make([]string, 0, len(addresses))
+	labelBuffer := buffer.GetLabelBuffer()
+	for _, address := range addresses {
+		if _, ok := tags[address]; ok || address == "" {
+			continue
+		}
+		tags[address] = nil
+		uniqAddresses = append(uniqAddresses, address)
+		if label := labelBuffer.GetLabel(address); label != nil {
+			tags[address] = getAddressLabelTag(label)
+		}
+	}
+	if ownerKey, err := GetLabelOwnerKey(apiKey); err == nil {
+		labels, err := module.QueryAddressLabelsByAddress(ctx, ownerKey, uniqAddresses)
+		if err != nil {
+			log.Errorf("getAddressLabelTags query private labels err:[%v]", err)
+		}
+		for _, label := range labels {
+			tags[label.Address] = getAddressLabelTag(label)
+		}
+	}
+	return tags
+}
+
+func getAddressLabelTag(label *entity.AddressLabel) *entity.AddressLabelTag {
+	return &entity.AddressLabelTag{Label: label.Label, Category: label.Category, Private: label.Private}
+}
+
+//LabelTransfers 填充转出和转入地址的标签，缓存中的记录是共享的，返回填充后的副本
+func LabelTransfers(transfers []*entity.TransferInfo, apiKey string) []*entity.TransferInfo {
+	addresses := make([]string, 0, len(transfers)*2)
+	for _, transfer := range transfers {
+		if transfer != nil {
+			addresses = append(addresses, transfer.TransferFromAddress, transfer.TransferToAddress)
+		}
+	}
+	return applyTransferLabels(transfers, getAddressLabelTags(context.Background(), addresses, apiKey))
+}
+
+func applyTransferLabels(transfers []*entity.TransferInfo, tags map[string]*entity.AddressLabelTag) []*entity.TransferInfo {
+	labeled := make([]*entity.TransferInfo, 0, len(transfers))
+	for _, transfer := range transfers {
+		if transfer == nil {
+			labeled = append(labeled, transfer)
+			continue
+		}
+		transferCopy := *transfer
+		transferCopy.FromLabel = tags[transfer.TransferFromAddress]
+		transferCopy.ToLabel = tags[transfer.TransferToAddress]
+		labeled = append(labeled, &transferCopy)
+	}
+	return labeled
+}
+
+//LabelTransactions 填充发起和接收地址的标签，返回填充后的副本
+func LabelTransactions(transactions []*entity.TransactionInfo, apiKey string) []*entity.TransactionInfo {
+	addresses := make([]string, 0, len(transactions)*2)
+	for _, transaction := range transactions {
+		if transaction != nil {
+			addresses = append(addresses, transaction.OwnerAddress, transaction.ToAddress)
+		}
+	}
+	tags := getAddressLabelTags(context.Background(), addresses, apiKey)
+	labeled := make([]*entity.TransactionInfo, 0, len(transactions))
+	for _, transaction := range transactions {
+		if transaction == nil {
+			labeled = append(labeled, transaction)
+			continue
+		}
+		transactionCopy := *transaction
+		transactionCopy.OwnerLabel = tags[transaction.OwnerAddress]
+		transactionCopy.ToLabel = tags[transaction.ToAddress]
+		labeled = append(labeled, &transactionCopy)
+	}
+	return labeled
+}
+
+//LabelAccounts 填充账户列表的地址标签，账户列表每次从数据库查询，直接修改
+func LabelAccounts(accounts []*entity.AccountInfo, apiKey string) {
+	addresses := make([]string, 0, len(accounts))
+	for _, account := range accounts {
+		addresses = append(addresses, account.Address)
+	}
+	tags := getAddressLabelTags(context.Background(), addresses, apiKey)
+	for _, account := range accounts {
+		account.Label = tags[account.Address]
+	}
+}
+
+//LabelAccount 填充账户详情的地址标签
+func LabelAccount(ctx context.Context, account *entity.AccountDetail, apiKey string) {
+	if account == nil || account.Address == "" {
+		return
+	}
+	ctx, span := trace.Start(ctx, "service.LabelAccount")
+	defer span.End()
+	account.Label = getAddressLabelTags(ctx, []string{account.Address}, apiKey)[account.Address]
+}
@@ -0,0 +1,77 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestParseAddressLabelCSV(t *testing.T) {
+	data := "\ufeffaddress,label,category,note\n" +
+		"TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3,Exchange A,exchange,hot wallet\n" +
+		"TDh2S3T3whq9FxD8cmTbBjSCvhsLHmqftK,Team,,\n" +
+		"invalid,Bad Address,scam\n" +
+		"TDh2S3T3whq9FxD8cmTbBjSCvhsLHmqftK,Team Wallet,team\n" +
+		"TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3,Exchange A,unknown\n" +
+		"TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3\n"
+	labels, importResp, err := parseAddressLabelCSV(strings.NewReader(data), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if importResp.Total != 6 || len(importResp.Errors) != 3 || len(labels) != 2 {
+		t.Fatalf("parseAddressLabelCSV total:%v errors:%v labels:%v", importResp.Total, len(importResp.Errors), len(labels))
+	}
+	for i, line := range []int64{4, 6, 7} {
+		if importResp.Errors[i].Line != line {
+			t.Errorf("parseAddressLabelCSV error line:%v, want %v", importResp.Errors[i].Line, line)
+		}
+	}
+	if labels[0].Label != "Exchange A" || labels[0].Category != LabelCategoryExchange || labels[0].Note != "hot wallet" {
+		t.Errorf("parseAddressLabelCSV first:%#v", labels[0])
+	}
+	//同一地址以最后一行为准
+	if labels[1].Label != "Team Wallet" || labels[1].Category != LabelCategoryTeam {
+		t.Errorf("parseAddressLabelCSV duplicate:%#v", labels[1])
+	}
+
+	if _, _, err := parseAddressLabelCSV(strings.NewReader(data), 5); err == nil {
+		t.Errorf("parseAddressLabelCSV max rows")
+	}
+}
+
+func TestCheckAddressLabel(t *testing.T) {
+	label := &entity.AddressLabel{Address: "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3", Label: "Exchange A"}
+	if err := checkAddressLabel(label); err != nil || label.Category != LabelCategoryOther {
+		t.Errorf("checkAddressLabel default category:%v %v", err, label.Category)
+	}
+	for _, label := range []*entity.AddressLabel{
+		{Address: "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L4", Label: "Bad Checksum"},
+		{Address: "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3", Label: ""},
+		{Address: "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3", Label: strings.Repeat("a", 101)},
+		{Address: "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3", Label: "line\nbreak"},
+		{Address: "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3", Label: "Exchange A", Category: "unknown"},
+	} {
+		if err := checkAddressLabel(label); err == nil {
+			t.Errorf("checkAddressLabel invalid:%#v", label)
+		}
+	}
+}
+
+func TestApplyTransferLabels(t *testing.T) {
+	transfer := &entity.TransferInfo{TransferFromAddress: "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3", TransferToAddress: "TDh2S3T3whq9FxD8cmTbBjSCvhsLHmqftK"}
+	tags := map[string]*entity.AddressLabelTag{
+		"TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3": {Label: "Exchange A", Category: LabelCategoryExchange},
+	}
+	labeled := applyTransferLabels([]*entity.TransferInfo{transfer, nil}, tags)
+	if len(labeled) != 2 || labeled[1] != nil {
+		t.Fatalf("applyTransferLabels:%v", labeled)
+	}
+	if labeled[0].FromLabel == nil || labeled[0].FromLabel.Label != "Exchange A" || labeled[0].ToLabel != nil {
+		t.Errorf("applyTransferLabels labels:%#v", labeled[0])
+	}
+	//缓存中的记录不能被修改
+	if transfer.FromLabel != nil {
+		t.Errorf("applyTransferLabels modified buffer record")
+	}
+}
@@ -94,15 +94,11 @@ func QueryAuth(req *entity.Auth) (*entity.AuthResp, error) {
 	witnessOwnerAddress := utils.Base58EncodeAddr(witnessUpdateContract.OwnerAddress)
 	log.Debugf("witnessOwnerAddress:[%v],signatureAddress:[%v]", witnessOwnerAddress, signatureAddress)
 	if witnessOwnerAddress == signatureAddress { //验证通过，计算token
-		newToken, err := GenWebToken(signatureAddress)
-		log.Debugf("gen web newToken:[%v],err:[%v]", newToken, err)
-		return &entity.AuthResp{Token: newToken}, err
+		authToken, err := issueAuthToken(signatureAddress)
+		if err != nil {
+			return nil, err
+		}
+		return &entity.AuthResp{Token: authToken.AccessToken, ExpiresIn: authToken.ExpiresIn, RefreshToken: authToken.RefreshToken}, nil
 	}
-	return nil, nil
-}
-
-//QueryTestRequestCoin ...
-func QueryTestRequestCoin() (*entity.TransfersResp, error) {
-
-	return nil, nil
+	return nil, util.NewErrorMsg(util.Error_user_token_invalid)
 }
@@ -0,0 +1,124 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/trace"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+var priceSymbolRegexp = regexp.MustCompile(`^[A-Za-z0-9_.\-]+$`)
+
+//QueryPrices 查询当前聚合后的价格
+func QueryPrices() ([]*entity.PriceInfo, error) {
+	return buffer.GetPriceBuffer().GetPrices(), nil
+}
+
+//QueryPriceHistory 查询历史价格
+func QueryPriceHistory(req *entity.PriceHistory) (*entity.PriceHistoryResp, error) {
+	if !priceSymbolRegexp.MatchString(req.Symbol) || (req.Currency != buffer.PriceCurrencyUSD && req.Currency != buffer.PriceCurrencyTRX) {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := `
+	select symbol, currency, price, sources, price_time
+	from wlcy_price_history
+	where 1=1 `
+	filterSQL = fmt.Sprintf(" and symbol='%v' and currency='%v'", req.Symbol, req.Currency)
+	if req.From > 0 {
+		filterSQL = fmt.Sprintf("%v and price_time>=%v", filterSQL, req.From)
+	}
+	if req.To > 0 {
+		filterSQL = fmt.Sprintf("%v and price_time<%v", filterSQL, req.To)
+	}
+	sortSQL = "order by price_time desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
+
+	historyResp, err := module.QueryPriceHistoryRealize(strSQL, filterSQL, sortSQL, pageSQL)
+	if err != nil {
+		log.Errorf("QueryPriceHistory strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return historyResp, nil
+}
+
+//getAccountValuation 按at时间的价格估算账户的美元价值，at为0时使用当前价格
+func getAccountValuation(ctx context.Context, account *entity.AccountDetail, at int64) (*entity.AccountValuation, error) {
+	ctx, span := trace.Start(ctx, "service.getAccountValuation", "valuation.time", at)
+	defer span.End()
+	symbols := []string{buffer.PriceSymbolTRX}
+	for _, token := range account.TokenBalances {
+		if token.Name != "" {
+			symbols = append(symbols, token.Name)
+		}
+	}
+
+	prices := make(map[string]*entity.PriceInfo, len(symbols))
+	if at == 0 {
+		at = time.Now().UnixNano() / 1e6
+		priceBuffer := buffer.GetPriceBuffer()
+		if price := priceBuffer.GetPrice(buffer.PriceSymbolTRX, buffer.PriceCurrencyUSD); price != nil {
+			prices[buffer.PriceSymbolTRX] = price
+		}
+		for _, symbol := range symbols[1:] {
+			if price := priceBuffer.GetPrice(symbol, buffer.PriceCurrencyTRX); price != nil {
+				prices[symbol] = price
+			}
+		}
+	} else {
+		history, err := module.QueryPricesAt(ctx, symbols, at)
+		if err != nil {
+			return nil, err
+		}
+		for _, price := range history {
+			//TRX只取美元价格，通证只取TRX价格
+			if (price.Symbol == buffer.PriceSymbolTRX) != (price.Currency == buffer.PriceCurrencyUSD) {
+				continue
+			}
+			price.Stale = at-price.Time > config.PriceStaleSeconds*1000
+			prices[price.Symbol] = price
+		}
+	}
+	return calcAccountValuation(account, prices, at), nil
+}
+
+//calcAccountValuation TRX余额含冻结，通证价值为 余额*TRX价格*TRX美元价格，没有价格的按0计算
+func calcAccountValuation(account *entity.AccountDetail, prices map[string]*entity.PriceInfo, at int64) *entity.AccountValuation {
+	valuation := &entity.AccountValuation{}
+	valuation.Time = at
+	valuation.Tokens = make([]*entity.TokenValuation, 0, len(account.TokenBalances))
+
+	trxBalance := account.Balance
+	if account.Frozen != nil {
+		trxBalance += account.Frozen.Total
+	}
+	if trxPrice, ok := prices[buffer.PriceSymbolTRX]; ok {
+		valuation.TrxPrice = trxPrice.Price
+		valuation.Stale = trxPrice.Stale
+	}
+	valuation.TrxValue = float64(trxBalance) / 1e6 * valuation.TrxPrice
+	valuation.TotalValue = valuation.TrxValue
+
+	for _, token := range account.TokenBalances {
+		if token.Name == "" {
+			continue
+		}
+		tokenValuation := &entity.TokenValuation{Name: token.Name, Balance: token.Balance}
+		if tokenPrice, ok := prices[token.Name]; ok {
+			tokenValuation.TrxPrice = tokenPrice.Price
+			tokenValuation.Value = token.Balance * tokenPrice.Price * valuation.TrxPrice
+			valuation.Stale = valuation.Stale || tokenPrice.Stale
+		}
+		valuation.TotalValue += tokenValuation.Value
+		valuation.Tokens = append(valuation.Tokens, tokenValuation)
+	}
+	return valuation
+}
@@ -0,0 +1,31 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestCalcAccountValuation(t *testing.T) {
+	account := &entity.AccountDetail{
+		Balance:       3000000,
+		Frozen:        &entity.Frozen{Total: 1000000},
+		TokenBalances: []*entity.Balance{{Name: "IGG", Balance: 10}, {Name: "NOPRICE", Balance: 5}},
+	}
+	prices := map[string]*entity.PriceInfo{
+		"TRX": {Symbol: "TRX", Currency: "USD", Price: 0.5},
+		"IGG": {Symbol: "IGG", Currency: "TRX", Price: 2, Stale: true},
+	}
+	valuation := calcAccountValuation(account, prices, 1000)
+	if valuation.TrxValue != 2 || valuation.TotalValue != 12 || !valuation.Stale || valuation.Time != 1000 {
+		t.Errorf("calcAccountValuation:%#v", valuation)
+	}
+	if len(valuation.Tokens) != 2 || valuation.Tokens[0].Value != 10 || valuation.Tokens[1].Value != 0 {
+		t.Errorf("calcAccountValuation tokens:%#v", valuation.Tokens)
+	}
+
+	valuation = calcAccountValuation(account, map[string]*entity.PriceInfo{}, 1000)
+	if valuation.TotalValue != 0 || valuation.Stale {
+		t.Errorf("calcAccountValuation no price:%#v", valuation)
+	}
+}
@@ -0,0 +1,357 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+//提议状态，与 core.Proposal_State 的取值一一对应
+const (
+	ProposalStatePending     = "pending"
+	ProposalStateDisapproved = "disapproved"
+	ProposalStateApproved    = "approved"
+	ProposalStateCanceled    = "canceled"
+)
+
+var proposalStates = []string{ProposalStatePending, ProposalStateDisapproved, ProposalStateApproved, ProposalStateCanceled}
+
+//proposalParameterNames 提议参数编号对应的链参数名，顺序与 java-tron 的 ChainParameters 一致
+var proposalParameterNames = []string{
+	"getMaintenanceTimeInterval",
+	"getAccountUpgradeCost",
+	"getCreateAccountFee",
+	"getTransactionFee",
+	"getAssetIssueFee",
+	"getWitnessPayPerBlock",
+	"getWitnessStandbyAllowance",
+	"getCreateNewAccountFeeInSystemContract",
+	"getCreateNewAccountBandwidthRate",
+	"getAllowCreationOfContracts",
+	"getRemoveThePowerOfTheGr",
+	"getEnergyFee",
+	"getExchangeCreateFee",
+	"getMaxCpuTimeOfOneTx",
+	"getAllowUpdateAccountName",
+	"getAllowSameTokenName",
+	"getAllowDelegateResource",
+	"getTotalEnergyLimit",
+	"getAllowTvmTransferTrc10",
+}
+
+var chainParameterNameRegexp = regexp.MustCompile("^[A-Za-z0-9]+$")
+
+const proposalSQL = `
+	select proposal_id, proposer_address, parameters, expiration_time, create_time, approval_count, state
+	from wlcy_proposal
+	where 1=1 `
+
+//QueryProposals 查询提议列表
+func QueryProposals(req *entity.Proposals) (*entity.ProposalsResp, error) {
+	var filterSQL, sortSQL, pageSQL string
+	if req.State != "" {
+		if !isProposalState(req.State) {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		filterSQL = fmt.Sprintf(" and state='%v'", req.State)
+	}
+	sortSQL = "order by proposal_id desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
+
+	proposalsResp, err := module.QueryProposalsRealize(proposalSQL, filterSQL, sortSQL, pageSQL)
+	if err != nil {
+		log.Errorf("QueryProposals strSQL:%v, err:[%v]", proposalSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	for _, proposal := range proposalsResp.Data {
+		fillProposalParameterNames(proposal)
+	}
+	return proposalsResp, nil
+}
+
+//QueryProposal 查询单个提议，包括赞成列表、状态变化和生效后的链参数变化
+func QueryProposal(proposalID int64) (*entity.ProposalInfo, error) {
+	proposalsResp, err := module.QueryProposalsRealize(proposalSQL, fmt.Sprintf(" and proposal_id=%v", proposalID), "", "")
+	if err != nil {
+		log.Errorf("QueryProposal id:%v, err:[%v]", proposalID, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if len(proposalsResp.Data) == 0 {
+		return nil, nil
+	}
+	proposal := proposalsResp.Data[0]
+	fillProposalParameterNames(proposal)
+
+	approvals, err := module.QueryProposalApprovalsRealize(fmt.Sprintf(`
+	select proposal_id, address from wlcy_proposal_approval where proposal_id=%v order by address`, proposalID))
+	if err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	proposal.Approvals = approvals[proposalID]
+
+	proposal.StateChanges, err = module.QueryProposalStateChangesRealize(fmt.Sprintf(`
+	select proposal_id, from_state, to_state, change_time from wlcy_proposal_state where proposal_id=%v order by change_time`, proposalID))
+	if err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+
+	historyResp, err := module.QueryChainParameterHistoryRealize(`
+	select param_key, old_value, new_value, change_time, proposal_id
+	from wlcy_chain_parameter_history
+	where 1=1 `, fmt.Sprintf(" and proposal_id=%v", proposalID), "order by change_time", "")
+	if err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	proposal.ParameterChanges = historyResp.Data
+	return proposal, nil
+}
+
+//QueryChainParameterHistory 查询链参数变化记录
+func QueryChainParameterHistory(req *entity.ChainParameterHistory) (*entity.ChainParameterHistoryResp, error) {
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := fmt.Sprintf(`
+	select param_key, old_value, new_value, change_time, proposal_id
+	from wlcy_chain_parameter_history
+	where 1=1 `)
+	if req.Key != "" {
+		if !chainParameterNameRegexp.MatchString(req.Key) {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		filterSQL = fmt.Sprintf(" and param_key='%v'", req.Key)
+	}
+	sortSQL = "order by change_time desc, id desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
+
+	historyResp, err := module.QueryChainParameterHistoryRealize(strSQL, filterSQL, sortSQL, pageSQL)
+	if err != nil {
+		log.Errorf("QueryChainParameterHistory strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return historyResp, nil
+}
+
+//SyncProposal 同步提议、赞成列表和状态变化，并对比链参数记录变化
+func SyncProposal() error {
+	client := grpcclient.GetRandomWallet()
+	now := time.Now().UnixNano() / 1e6
+
+	storedResp, err := module.QueryProposalsRealize(proposalSQL, "", "", "")
+	if err != nil {
+		log.Errorf("SyncProposal query stored proposals err:[%v]", err)
+		return err
+	}
+	for _, proposal := range storedResp.Data {
+		fillProposalParameterNames(proposal)
+	}
+	proposals, err := client.ListProposals()
+	if err != nil {
+		//节点不支持提议接口时仍然对比链参数
+		log.Errorf("SyncProposal ListProposals err:[%v]", err)
+		syncChainParameters(client, storedResp.Data, now)
+		return err
+	}
+	storedMap := make(map[int64]*entity.ProposalInfo, len(storedResp.Data))
+	for _, proposal := range storedResp.Data {
+		storedMap[proposal.ProposalID] = proposal
+	}
+	//只有进行中的提议的赞成列表会变化
+	storedApprovals, err := module.QueryProposalApprovalsRealize(fmt.Sprintf(`
+	select appr.proposal_id, appr.address
+	from wlcy_proposal_approval appr
+	inner join wlcy_proposal prop on prop.proposal_id=appr.proposal_id
+	where prop.state='%v'
+	order by appr.address`, ProposalStatePending))
+	if err != nil {
+		log.Errorf("SyncProposal query stored approvals err:[%v]", err)
+		return err
+	}
+
+	proposalInfos := make([]*entity.ProposalInfo, 0, len(proposals))
+	for _, proposal := range proposals {
+		if proposal == nil {
+			continue
+		}
+		info := &entity.ProposalInfo{}
+		info.ProposalID = proposal.ProposalId
+		info.Proposer = utils.Base58EncodeAddr(proposal.ProposerAddress)
+		info.ExpirationTime = proposal.ExpirationTime
+		info.CreateTime = proposal.CreateTime
+		info.State = getProposalState(int32(proposal.State))
+		for key, value := range proposal.Parameters {
+			info.Parameters = append(info.Parameters, &entity.ProposalParameter{Key: key, Value: value})
+		}
+		sort.Slice(info.Parameters, func(i, j int) bool { return info.Parameters[i].Key < info.Parameters[j].Key })
+		fillProposalParameterNames(info)
+		approvals := make([]string, 0, len(proposal.Approvals))
+		for _, address := range proposal.Approvals {
+			approvals = append(approvals, utils.Base58EncodeAddr(address))
+		}
+		sort.Strings(approvals)
+		info.ApprovalCount = int64(len(approvals))
+		proposalInfos = append(proposalInfos, info)
+
+		stored, ok := storedMap[info.ProposalID]
+		var stateChange *entity.ProposalStateChange
+		if !ok || stored.State != info.State {
+			stateChange = &entity.ProposalStateChange{ProposalID: info.ProposalID, ToState: info.State, ChangeTime: now}
+			if ok {
+				stateChange.FromState = stored.State
+			}
+		}
+		approvalsChanged := !ok || stored.ApprovalCount != info.ApprovalCount ||
+			(stored.State == ProposalStatePending && !isSameStrings(storedApprovals[info.ProposalID], approvals))
+		if stateChange == nil && !approvalsChanged {
+			continue
+		}
+		if !approvalsChanged {
+			approvals = nil
+		}
+		if err := module.SaveProposal(info, stateChange, approvals); err != nil {
+			return err
+		}
+		if stateChange != nil {
+			log.Infof("SyncProposal proposal:[%v] state:[%v]->[%v]", info.ProposalID, stateChange.FromState, stateChange.ToState)
+		}
+	}
+
+	syncChainParameters(client, proposalInfos, now)
+	return nil
+}
+
+//syncChainParameters 对比上次同步的链参数，记录变化并找出使其生效的提议
+//第一次同步只保存快照，新出现的参数只加入快照，不记录变化
+func syncChainParameters(client *grpcclient.Wallet, proposals []*entity.ProposalInfo, now int64) {
+	chainParams, err := client.GetChainParameters()
+	if err != nil || chainParams == nil {
+		log.Errorf("syncChainParameters GetChainParameters err:[%v]", err)
+		return
+	}
+	params := make(map[string]int64, len(chainParams.ChainParameter))
+	for _, param := range chainParams.ChainParameter {
+		params[param.Key] = param.Value
+	}
+	snapshot, err := module.QueryChainParameterSnapshot()
+	if err != nil {
+		log.Errorf("syncChainParameters query snapshot err:[%v]", err)
+		return
+	}
+
+	changes := diffChainParameters(snapshot, params, now)
+	changedParams := make(map[string]int64)
+	for key, value := range params {
+		if oldValue, ok := snapshot[key]; !ok || oldValue != value {
+			changedParams[key] = value
+		}
+	}
+	if len(changedParams) == 0 {
+		return
+	}
+	for _, change := range changes {
+		change.ProposalID = findActivatingProposal(change.Key, change.NewValue, proposals)
+		log.Infof("syncChainParameters [%v] %v->%v proposal:[%v]", change.Key, change.OldValue, change.NewValue, change.ProposalID)
+	}
+	module.SaveChainParameters(changedParams, changes)
+}
+
+//queryChainParameterChanges 查询发现时间晚于after的链参数变化，按发现时间排序
+func queryChainParameterChanges(after int64, keys ...string) ([]*entity.ChainParameterChange, error) {
+	strSQL := fmt.Sprintf(`
+	select param_key, old_value, new_value, change_time, proposal_id
+	from wlcy_chain_parameter_history
+	where change_time>%v and param_key in ('%v') `, after, strings.Join(keys, "','"))
+	historyResp, err := module.QueryChainParameterHistoryRealize(strSQL, "", "order by change_time, id", "")
+	if err != nil {
+		return nil, err
+	}
+	return historyResp.Data, nil
+}
+
+//getChainParameterAt 参数在at时的值，at之后第一次变化的原值，之后没有变化时为当前值
+//	changes 按发现时间排序
+func getChainParameterAt(changes []*entity.ChainParameterChange, key string, at, current int64) int64 {
+	for _, change := range changes {
+		if change.Key == key && change.ChangeTime > at {
+			return change.OldValue
+		}
+	}
+	return current
+}
+
+//diffChainParameters 上次快照中已有且值发生变化的参数，按参数名排序
+func diffChainParameters(snapshot, params map[string]int64, now int64) []*entity.ChainParameterChange {
+	changes := make([]*entity.ChainParameterChange, 0)
+	for key, value := range params {
+		oldValue, ok := snapshot[key]
+		if !ok || oldValue == value {
+			continue
+		}
+		changes = append(changes, &entity.ChainParameterChange{Key: key, OldValue: oldValue, NewValue: value, ChangeTime: now})
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].Key < changes[j].Key })
+	return changes
+}
+
+//findActivatingProposal 包含该参数新值的已通过提议中编号最大的一个，找不到返回0
+func findActivatingProposal(key string, value int64, proposals []*entity.ProposalInfo) int64 {
+	var proposalID int64
+	for _, proposal := range proposals {
+		if proposal.State != ProposalStateApproved || proposal.ProposalID < proposalID {
+			continue
+		}
+		for _, parameter := range proposal.Parameters {
+			if parameter.Name == key && parameter.Value == value {
+				proposalID = proposal.ProposalID
+			}
+		}
+	}
+	return proposalID
+}
+
+func fillProposalParameterNames(proposal *entity.ProposalInfo) {
+	if proposal.Parameters == nil {
+		proposal.Parameters = make([]*entity.ProposalParameter, 0)
+	}
+	for _, parameter := range proposal.Parameters {
+		if parameter.Key >= 0 && parameter.Key < int64(len(proposalParameterNames)) {
+			parameter.Name = proposalParameterNames[parameter.Key]
+		}
+	}
+}
+
+func getProposalState(state int32) string {
+	if state >= 0 && int(state) < len(proposalStates) {
+		return proposalStates[state]
+	}
+	return fmt.Sprintf("unknown_%v", state)
+}
+
+func isProposalState(state string) bool {
+	for _, value := range proposalStates {
+		if value == state {
+			return true
+		}
+	}
+	return false
+}
+
+//isSameStrings 两个已排序的列表是否相同
+func isSameStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
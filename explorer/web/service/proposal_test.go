@@ -0,0 +1,67 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestDiffChainParameters(t *testing.T) {
+	snapshot := map[string]int64{"getEnergyFee": 100, "getTransactionFee": 10}
+	params := map[string]int64{"getEnergyFee": 140, "getTransactionFee": 10, "getAllowExchange": 1}
+	got := diffChainParameters(snapshot, params, 1000)
+	want := []*entity.ChainParameterChange{{Key: "getEnergyFee", OldValue: 100, NewValue: 140, ChangeTime: 1000}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("diffChainParameters:%v", got)
+	}
+	if got := diffChainParameters(map[string]int64{}, params, 1000); len(got) != 0 {
+		t.Errorf("diffChainParameters empty snapshot:%v", got)
+	}
+}
+
+func TestGetChainParameterAt(t *testing.T) {
+	changes := []*entity.ChainParameterChange{
+		{Key: "getWitnessPayPerBlock", OldValue: 32000000, NewValue: 16000000, ChangeTime: 2000},
+		{Key: "getEnergyFee", OldValue: 100, NewValue: 140, ChangeTime: 2500},
+		{Key: "getWitnessPayPerBlock", OldValue: 16000000, NewValue: 8000000, ChangeTime: 3000},
+	}
+	cases := []struct {
+		at   int64
+		want int64
+	}{
+		{1000, 32000000},
+		{2000, 16000000},
+		{2500, 16000000},
+		{3500, 8000000},
+	}
+	for _, c := range cases {
+		if got := getChainParameterAt(changes, "getWitnessPayPerBlock", c.at, 8000000); got != c.want {
+			t.Errorf("getChainParameterAt(%v) = %v, want %v", c.at, got, c.want)
+		}
+	}
+}
+
+func TestFindActivatingProposal(t *testing.T) {
+	proposals := []*entity.ProposalInfo{
+		{ProposalID: 3, State: ProposalStateApproved, Parameters: []*entity.ProposalParameter{{Name: "getEnergyFee", Value: 140}}},
+		{ProposalID: 5, State: ProposalStateApproved, Parameters: []*entity.ProposalParameter{{Name: "getEnergyFee", Value: 140}}},
+		{ProposalID: 7, State: ProposalStatePending, Parameters: []*entity.ProposalParameter{{Name: "getEnergyFee", Value: 140}}},
+		{ProposalID: 8, State: ProposalStateApproved, Parameters: []*entity.ProposalParameter{{Name: "getEnergyFee", Value: 200}}},
+	}
+	if got := findActivatingProposal("getEnergyFee", 140, proposals); got != 5 {
+		t.Errorf("findActivatingProposal:%v", got)
+	}
+	if got := findActivatingProposal("getTransactionFee", 10, proposals); got != 0 {
+		t.Errorf("findActivatingProposal not found:%v", got)
+	}
+}
+
+func TestGetProposalState(t *testing.T) {
+	cases := map[int32]string{0: ProposalStatePending, 2: ProposalStateApproved, 3: ProposalStateCanceled, 9: "unknown_9"}
+	for state, want := range cases {
+		if got := getProposalState(state); got != want {
+			t.Errorf("getProposalState(%v) = %v, want %v", state, got, want)
+		}
+	}
+}
@@ -0,0 +1,289 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/mysql"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+	"gopkg.in/redis.v4"
+)
+
+/*
+api key 和访问限流
+令牌桶和每日计数都保存在redis中，多个web实例共享同一份限流状态
+有api key的请求按key限流，没有key的请求按IP使用匿名额度限流
+有api key的请求同时按IP使用 keyedIPRate 额度限流，防止同一个IP轮换多个key绕过限流
+redis不可用时放行请求，只记录日志
+*/
+
+//签发api key时的默认额度
+const (
+	defaultAPIKeyRate  = 20
+	defaultAPIKeyBurst = 100
+)
+
+//计数key保留时间，留出同步到数据库的时间
+const rateLimitUsageExpire = 72 * time.Hour
+
+const rateLimitUsageDateFormat = "20060102"
+
+//tokenBucketScript 每日额度和令牌桶，只有放行的请求计入当日用量
+//KEYS[1] 桶的key，KEYS[2] 当日用量的key，ARGV 依次为 每秒令牌数、桶容量、当前毫秒时间、每日上限(0不限)、用量key的过期秒数
+//返回 {是否放行, 剩余令牌数, 恢复一个令牌需要的毫秒数, 当日用量, 是否超出每日上限}
+const tokenBucketScript = `
+local rate = tonumber(ARGV[1])
+local burst = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+local quota = tonumber(ARGV[4])
+local usage = tonumber(redis.call('GET', KEYS[2]) or '0')
+if quota > 0 and usage >= quota then
+	return {0, 0, 0, usage, 1}
+end
+local info = redis.call('HMGET', KEYS[1], 'tokens', 'ts')
+local tokens = tonumber(info[1])
+local ts = tonumber(info[2])
+if tokens == nil or ts == nil then
+	tokens = burst
+	ts = now
+end
+tokens = math.min(burst, tokens + math.max(0, now - ts) * rate / 1000)
+local allowed = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+end
+redis.call('HMSET', KEYS[1], 'tokens', tokens, 'ts', now)
+redis.call('PEXPIRE', KEYS[1], math.ceil(burst * 1000 / rate) + 1000)
+local wait = 0
+if tokens < 1 then
+	wait = math.ceil((1 - tokens) * 1000 / rate)
+end
+if allowed == 1 then
+	usage = redis.call('INCR', KEYS[2])
+	if usage == 1 then
+		redis.call('EXPIRE', KEYS[2], tonumber(ARGV[5]))
+	end
+end
+return {allowed, math.floor(tokens), wait, usage, 0}
+`
+
+func getRateLimitBucketKey(identity string) string {
+	return fmt.Sprintf("ratelimit.bucket.%v", identity)
+}
+
+func getRateLimitUsageKey(identity, date string) string {
+	return fmt.Sprintf("ratelimit.usage.%v.%v", identity, date)
+}
+
+func getRateLimitRejectedKey(identity, date string) string {
+	return fmt.Sprintf("ratelimit.rejected.%v.%v", identity, date)
+}
+
+func getUsageDate(t time.Time) string {
+	return t.UTC().Format(rateLimitUsageDateFormat)
+}
+
+//CheckRateLimit 检查请求是否超出限流和每日额度，apiKey为空时按ip匿名限流
+//	有apiKey时先检查ip的限流，被拒绝时不消耗key的额度
+func CheckRateLimit(apiKey, ip string) (*entity.RateLimitResult, error) {
+	conf := config.Get().RateLimit
+	if apiKey == "" {
+		return checkTokenBucket(fmt.Sprintf("ip.%v", ip), conf.AnonymousRate, conf.AnonymousBurst, conf.AnonymousDailyQuota), nil
+	}
+	info, ok := buffer.GetAPIKeyBuffer().GetAPIKey(apiKey)
+	if !ok {
+		return nil, util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	if ret := checkTokenBucket(fmt.Sprintf("keyip.%v", ip), conf.KeyedIPRate, conf.KeyedIPBurst, 0); !ret.Allowed {
+		return ret, nil
+	}
+	return checkTokenBucket(fmt.Sprintf("key.%v", apiKey), info.Rate, info.Burst, info.DailyQuota), nil
+}
+
+//checkTokenBucket 消耗identity的一个令牌，redis不可用时放行
+func checkTokenBucket(identity string, rate, burst, quota int64) *entity.RateLimitResult {
+	if rate <= 0 {
+		rate = 1
+	}
+	if burst < 1 {
+		burst = 1
+	}
+
+	result := &entity.RateLimitResult{Allowed: true, Limit: burst, Remaining: burst, QuotaLimit: quota, QuotaRemaining: quota}
+	now := time.Now()
+	date := getUsageDate(now)
+
+	ret, err := config.RedisCli.Eval(tokenBucketScript, []string{getRateLimitBucketKey(identity), getRateLimitUsageKey(identity, date)},
+		rate, burst, now.UnixNano()/int64(time.Millisecond), quota, int64(rateLimitUsageExpire/time.Second)).Result()
+	if err != nil {
+		log.Errorf("CheckRateLimit eval token bucket [%v] error:[%v]", identity, err)
+		return result
+	}
+	values, ok := ret.([]interface{})
+	if !ok || len(values) != 5 {
+		log.Errorf("CheckRateLimit token bucket result invalid:[%#v]", ret)
+		return result
+	}
+	result.Allowed = util.ToInt64(values[0]) == 1
+	result.Remaining = util.ToInt64(values[1])
+	result.ResetSeconds = (util.ToInt64(values[2]) + 999) / 1000
+	if quota > 0 {
+		if result.QuotaRemaining = quota - util.ToInt64(values[3]); result.QuotaRemaining < 0 {
+			result.QuotaRemaining = 0
+		}
+	}
+	if util.ToInt64(values[4]) == 1 {
+		result.QuotaExceeded = true
+		tomorrow := time.Date(now.UTC().Year(), now.UTC().Month(), now.UTC().Day()+1, 0, 0, 0, 0, time.UTC)
+		result.ResetSeconds = int64(tomorrow.Sub(now).Seconds()) + 1
+	}
+	if !result.Allowed {
+		incrRateLimitRejected(identity, date)
+	}
+	return result
+}
+
+func incrRateLimitRejected(identity, date string) {
+	rejectedKey := getRateLimitRejectedKey(identity, date)
+	if rejected, err := config.RedisCli.Incr(rejectedKey).Result(); err == nil && rejected == 1 {
+		config.RedisCli.Expire(rejectedKey, rateLimitUsageExpire)
+	}
+}
+
+//isAPIKeyFormat api key为32位小写十六进制
+func isAPIKeyFormat(apiKey string) bool {
+	if len(apiKey) != 32 {
+		return false
+	}
+	_, err := hex.DecodeString(apiKey)
+	return err == nil && strings.ToLower(apiKey) == apiKey
+}
+
+//isUsageDateFormat 日期为 yyyymmdd 格式
+func isUsageDateFormat(date string) bool {
+	_, err := time.Parse(rateLimitUsageDateFormat, date)
+	return err == nil
+}
+
+//genAPIKey 生成32位随机key
+func genAPIKey() (string, error) {
+	buf := make([]byte, 16)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(buf), nil
+}
+
+//CreateAPIKey 签发api key
+func CreateAPIKey(req *entity.APIKeyInfo) (*entity.APIKeyInfo, error) {
+	if req.Name == "" || strings.ContainsAny(req.Name+req.Contact, "'\\") {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	apiKey, err := genAPIKey()
+	if err != nil {
+		log.Errorf("CreateAPIKey gen key error:[%v]", err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	req.APIKey = apiKey
+	req.Status = 1
+	if req.Rate <= 0 {
+		req.Rate = defaultAPIKeyRate
+	}
+	if req.Burst <= 0 {
+		req.Burst = defaultAPIKeyBurst
+	}
+	if req.DailyQuota < 0 {
+		req.DailyQuota = 0
+	}
+	if err := module.InsertAPIKey(req); err != nil {
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	buffer.GetAPIKeyBuffer().Reload()
+	return req, nil
+}
+
+//DisableAPIKey 停用api key
+func DisableAPIKey(apiKey string) error {
+	if !isAPIKeyFormat(apiKey) {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	rows, err := module.UpdateAPIKeyStatus(apiKey, 0)
+	if err != nil {
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if rows == 0 {
+		return util.NewErrorMsg(util.Error_common_no_data)
+	}
+	buffer.GetAPIKeyBuffer().Reload()
+	return nil
+}
+
+//QueryAPIKeys 查询全部api key
+func QueryAPIKeys() ([]*entity.APIKeyInfo, error) {
+	strSQL := fmt.Sprintf(`
+	select api_key, name, contact, rate, burst, daily_quota, status, create_time
+	from wlcy_api_key order by create_time desc`)
+	return module.QueryAPIKeysRealize(strSQL)
+}
+
+//QueryAPIKeyUsage 查询api key每日用量，from/to 为 yyyymmdd 格式的UTC日期，包含首尾
+func QueryAPIKeyUsage(apiKey, from, to string) (*entity.APIKeyUsageResp, error) {
+	if !isAPIKeyFormat(apiKey) || (from != "" && !isUsageDateFormat(from)) || (to != "" && !isUsageDateFormat(to)) {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	var filterSQL string
+	strSQL := fmt.Sprintf(`
+	select api_key, usage_date, request_count, rejected_count
+	from wlcy_api_key_usage
+	where api_key='%v' `, apiKey)
+	if from != "" {
+		filterSQL = fmt.Sprintf("%v and usage_date>='%v'", filterSQL, from)
+	}
+	if to != "" {
+		filterSQL = fmt.Sprintf("%v and usage_date<='%v'", filterSQL, to)
+	}
+	return module.QueryAPIKeyUsageRealize(strSQL + filterSQL + " order by usage_date")
+}
+
+//SyncAPIKeyUsage 将redis中今天和昨天的计数同步到数据库，单个api key失败时继续同步其他api key，返回最后一个错误
+func SyncAPIKeyUsage() error {
+	var lastErr error
+	now := time.Now()
+	dates := []string{getUsageDate(now.Add(-24 * time.Hour)), getUsageDate(now)}
+	for _, info := range buffer.GetAPIKeyBuffer().GetAPIKeys() {
+		identity := fmt.Sprintf("key.%v", info.APIKey)
+		for _, date := range dates {
+			usage := &entity.APIKeyUsage{APIKey: info.APIKey, Date: date}
+			usage.RequestCount = getRateLimitCount(getRateLimitUsageKey(identity, date))
+			usage.RejectedCount = getRateLimitCount(getRateLimitRejectedKey(identity, date))
+			if usage.RequestCount == 0 {
+				continue
+			}
+			if err := module.SaveAPIKeyUsage(usage); err != nil {
+				log.Errorf("SyncAPIKeyUsage save [%v] usage of %v err:[%v]", info.APIKey, date, err)
+				lastErr = err
+			}
+		}
+	}
+	return lastErr
+}
+
+func getRateLimitCount(key string) int64 {
+	value, err := config.RedisCli.Get(key).Result()
+	if err != nil {
+		if err != redis.Nil {
+			log.Errorf("getRateLimitCount [%v] error:[%v]", key, err)
+		}
+		return 0
+	}
+	return mysql.ConvertStringToInt64(value, 0)
+}
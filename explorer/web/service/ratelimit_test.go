@@ -0,0 +1,37 @@
+package service
+
+import (
+	"testing"
+)
+
+func TestGenAPIKey(t *testing.T) {
+	key1, err := genAPIKey()
+	if err != nil || !isAPIKeyFormat(key1) {
+		t.Errorf("genAPIKey:%v, err:%v", key1, err)
+	}
+	key2, _ := genAPIKey()
+	if key1 == key2 {
+		t.Errorf("genAPIKey duplicate:%v", key1)
+	}
+}
+
+func TestIsAPIKeyFormat(t *testing.T) {
+	cases := map[string]bool{
+		"3f7c1e0b9a2d4c6e8f0a1b2c3d4e5f60":  true,
+		"3F7C1E0B9A2D4C6E8F0A1B2C3D4E5F60":  false,
+		"3f7c1e0b9a2d4c6e8f0a1b2c3d4e5f6":   false,
+		"3f7c1e0b9a2d4c6e8f0a1b2c3d4e5f6' ": false,
+		"":                                  false,
+	}
+	for key, want := range cases {
+		if got := isAPIKeyFormat(key); got != want {
+			t.Errorf("isAPIKeyFormat(%v) = %v, want %v", key, got, want)
+		}
+	}
+}
+
+func TestIsUsageDateFormat(t *testing.T) {
+	if !isUsageDateFormat("20181001") || isUsageDateFormat("2018-10-01") || isUsageDateFormat("20181001' or 1=1") {
+		t.Errorf("isUsageDateFormat check fail")
+	}
+}
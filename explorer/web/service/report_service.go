@@ -138,7 +138,8 @@ func SyncInitReport() {
 	SyncCacheTodayReport()
 }
 
-func SyncPersistYesterdayReport() {
+//SyncPersistYesterdayReport 昨天的统计还没有入库时统计并写入数据库和缓存
+func SyncPersistYesterdayReport() error {
 	t := time.Now()
 	t1 := time.Date(t.Year(), t.Month(), t.Day(), 0,0,0,0, time.UTC)
 	t3 := t1.Add(-24 * time.Hour)
@@ -148,8 +149,12 @@ func SyncPersistYesterdayReport() {
 			select date, avg_block_time, avg_block_size, new_block_seen, new_transaction_seen, 
 			new_address_seen, total_block_count, total_transaction, total_address, blockchain_size
 			from wlcy_statistics order by date desc limit 1`)
-	reportOverviews, _:= module.QueryStatistics(strSQL)
-	if reportOverviews[0].Date < dateTime {
+	reportOverviews, err := module.QueryStatistics(strSQL)
+	if err != nil {
+		log.Errorf("SyncPersistYesterdayReport query statistics err:[%v]", err)
+		return err
+	}
+	if len(reportOverviews) == 0 || reportOverviews[0].Date < dateTime {
 		t1 = t1.Add(-24 * time.Hour)
 		t2 := t1.Add(24 * time.Hour)
 		startTime := t1.UnixNano() / 1e6
@@ -159,7 +164,10 @@ func SyncPersistYesterdayReport() {
 		syncReportBetweenTime(startTime, endTime, reportOverview)
 		syncReportByTime(endTime, reportOverview)
 		reportOverview.Date = startTime
-		module.InsertStatistics(reportOverview)
+		if err := module.InsertStatistics(reportOverview); err != nil {
+			log.Errorf("SyncPersistYesterdayReport insert statistics err:[%v]", err)
+			return err
+		}
 
 		historyOverviewValue, err := config.RedisCli.Get(HistoryOverviewKey).Result()
 		if err == redis.Nil {
@@ -167,7 +175,7 @@ func SyncPersistYesterdayReport() {
 			historyOverviewValue, _ = config.RedisCli.Get(HistoryOverviewKey).Result()
 		} else if err != nil {
 			log.Errorf("SyncPersistYesterdayReport historyOverviewValue redis get value error :[%v]\n", err)
-			return
+			return err
 		}
 		if historyOverviewValue == "" {
 			SyncCacheHistoryReport()
@@ -180,10 +188,11 @@ func SyncPersistYesterdayReport() {
 		err = config.RedisCli.Set(HistoryOverviewKey, string(value), 0).Err()
 		if err != nil {
 			log.Errorf("SyncPersistYesterdayReport redis set err:[%v]", err)
+			return err
 		}
 	}
 	log.Info("SyncPersistYesterdayReport handle done")
-
+	return nil
 }
 
 func SyncCacheHistoryReport() {
@@ -207,7 +216,8 @@ func SyncCacheHistoryReport() {
 	log.Info("SyncCacheHistoryReport handle done")
 }
 
-func SyncCacheTodayReport() {
+//SyncCacheTodayReport 统计今天到目前为止的数据并写入缓存
+func SyncCacheTodayReport() error {
 	t := time.Now()
 	t1 := time.Date(t.Year(), t.Month(), t.Day(), 0,0,0,0, time.UTC)
 	t2 := t
@@ -222,12 +232,15 @@ func SyncCacheTodayReport() {
 	value, err := json.Marshal(reportOverview)
 	if err != nil {
 		log.Errorf("SyncCacheTodayReport json.Marshal reportOverview err:[%v]", err)
+		return err
 	}
 
 	err = config.RedisCli.Set(TodayOverviewKey, string(value), 0).Err()
 	if err != nil {
 		log.Errorf("SyncCacheTodayReport set err:[%v]", err)
+		return err
 	}
 
 	log.Info("SyncCacheTodayReport handle done")
+	return nil
 }
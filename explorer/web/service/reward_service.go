@@ -0,0 +1,207 @@
+package service
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+//witnessStandbyCount 参与分配投票奖励的候选人数量
+const witnessStandbyCount = 127
+
+//SyncWitnessReward 结算已结束但还没有计算奖励的轮次，错过的轮次没有票数和排名，不结算
+//每轮使用轮次结束时生效的链参数，由链参数变化记录推算
+func SyncWitnessReward() error {
+	strSQL := fmt.Sprintf(`
+	select cyc.cycle_start, cyc.cycle_end, cyc.total_votes, cyc.witness_count, cyc.voter_count, cyc.snapshot_time, cyc.finished
+	from wlcy_vote_cycle cyc
+	left join wlcy_vote_cycle_reward rew on rew.cycle_start=cyc.cycle_start
+	where cyc.finished=1 and cyc.snapshot_time>0 and rew.cycle_start is null `)
+	cyclesResp, err := module.QueryVoteCyclesRealize(strSQL, "", "order by cyc.cycle_start", "limit 20")
+	if err != nil {
+		log.Errorf("SyncWitnessReward query cycles err:[%v]", err)
+		return err
+	}
+
+	if len(cyclesResp.Data) == 0 {
+		return nil
+	}
+	changes, err := queryChainParameterChanges(cyclesResp.Data[0].CycleEnd,
+		buffer.ChainParamWitnessPayPerBlock, buffer.ChainParamWitnessStandbyAllowance)
+	if err != nil {
+		log.Errorf("SyncWitnessReward query chain parameter changes err:[%v]", err)
+		return err
+	}
+
+	chainParameterBuffer := buffer.GetChainParameterBuffer()
+	currentPayPerBlock := chainParameterBuffer.GetChainParameter(buffer.ChainParamWitnessPayPerBlock)
+	currentStandbyAllowance := chainParameterBuffer.GetChainParameter(buffer.ChainParamWitnessStandbyAllowance)
+	for _, cycle := range cyclesResp.Data {
+		payPerBlock := getChainParameterAt(changes, buffer.ChainParamWitnessPayPerBlock, cycle.CycleEnd, currentPayPerBlock)
+		standbyAllowance := getChainParameterAt(changes, buffer.ChainParamWitnessStandbyAllowance, cycle.CycleEnd, currentStandbyAllowance)
+		witnessSQL := fmt.Sprintf(`
+	select wit.cycle_start, cyc.cycle_end, wit.address, wit.votes, wit.ranking, wit.voter_count,
+		wit.produced_blocks, wit.missed_blocks
+	from wlcy_vote_cycle_witness wit
+	left join wlcy_vote_cycle cyc on cyc.cycle_start=wit.cycle_start
+	where wit.cycle_start=%v `, cycle.CycleStart)
+		witnessResp, err := module.QueryVoteCycleWitnessRealize(witnessSQL, "", "", "")
+		if err != nil {
+			log.Errorf("SyncWitnessReward query cycle:[%v] witness err:[%v]", cycle.CycleStart, err)
+			return err
+		}
+		cycleReward, rewards := calcCycleRewards(cycle.CycleStart, witnessResp.Data, payPerBlock, standbyAllowance)
+		if err := module.InsertVoteCycleReward(cycleReward, rewards); err != nil {
+			return err
+		}
+		log.Infof("SyncWitnessReward cycle:[%v] blockReward:[%v] voteReward:[%v]", cycle.CycleStart,
+			cycleReward.TotalBlockReward, cycleReward.TotalVoteReward)
+	}
+	return nil
+}
+
+//calcCycleRewards 计算一轮的奖励
+//出块奖励为出块数乘以每块奖励，投票奖励按得票占比在排名前127的候选人中分配
+func calcCycleRewards(cycleStart int64, witnesses []*entity.VoteCycleWitness, payPerBlock, standbyAllowance int64) (*entity.VoteCycleReward, []*entity.WitnessReward) {
+	var standbyVotes int64
+	for _, witness := range witnesses {
+		if witness.Ranking > 0 && witness.Ranking <= witnessStandbyCount {
+			standbyVotes += witness.Votes
+		}
+	}
+
+	cycleReward := &entity.VoteCycleReward{}
+	cycleReward.CycleStart = cycleStart
+	cycleReward.WitnessPayPerBlock = payPerBlock
+	cycleReward.WitnessStandbyAllowance = standbyAllowance
+	rewards := make([]*entity.WitnessReward, 0, len(witnesses))
+	for _, witness := range witnesses {
+		reward := &entity.WitnessReward{}
+		reward.CycleStart = cycleStart
+		reward.CycleEnd = witness.CycleEnd
+		reward.Address = witness.Address
+		reward.Votes = witness.Votes
+		reward.Ranking = witness.Ranking
+		reward.ProducedBlocks = witness.ProducedBlocks
+		reward.BlockReward = witness.ProducedBlocks * payPerBlock
+		if standbyVotes > 0 && witness.Ranking > 0 && witness.Ranking <= witnessStandbyCount {
+			reward.VoteReward = int64(float64(standbyAllowance) * float64(witness.Votes) / float64(standbyVotes))
+		}
+		reward.TotalReward = reward.BlockReward + reward.VoteReward
+		cycleReward.TotalBlockReward += reward.BlockReward
+		cycleReward.TotalVoteReward += reward.VoteReward
+		rewards = append(rewards, reward)
+	}
+	return cycleReward, rewards
+}
+
+//QueryWitnessRewards 查询超级代表每一轮的奖励
+func QueryWitnessRewards(req *entity.VoteCycles) (*entity.WitnessRewardResp, error) {
+	if err := checkWitnessCyclesReq(req); err != nil {
+		return nil, err
+	}
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := fmt.Sprintf(`
+	select rew.cycle_start, cyc.cycle_end, rew.address, rew.votes, rew.ranking, rew.produced_blocks,
+		rew.block_reward, rew.vote_reward, rew.total_reward
+	from wlcy_witness_reward rew
+	left join wlcy_vote_cycle cyc on cyc.cycle_start=rew.cycle_start
+	where rew.address='%v' `, req.Address)
+	if req.From > 0 {
+		filterSQL = fmt.Sprintf(" and rew.cycle_start>=%v", req.From)
+	}
+	if req.To > 0 {
+		filterSQL = fmt.Sprintf("%v and rew.cycle_start<%v", filterSQL, req.To)
+	}
+	sortSQL = "order by rew.cycle_start desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
+
+	rewardResp, err := module.QueryWitnessRewardRealize(strSQL, filterSQL, sortSQL, pageSQL)
+	if err != nil {
+		log.Errorf("QueryWitnessRewards strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return rewardResp, nil
+}
+
+//EstimateVoterReward 按最近几轮超级代表的平均奖励和分成比例，估算投票人投给各超级代表的收益
+func EstimateVoterReward(req *entity.RewardEstimateReq) (*entity.RewardEstimateResp, error) {
+	conf := config.Get().Reward
+	if req.Ratio < 0 {
+		req.Ratio = conf.PayoutRatio
+	}
+	if req.Cycles <= 0 {
+		req.Cycles = conf.EstimateCycles
+	}
+	if req.Votes < 0 || req.Ratio > 100 || req.Cycles > 120 {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+
+	strSQL := fmt.Sprintf(`
+	select rew.cycle_start, rew.cycle_start as cycle_end, rew.address, rew.votes, rew.ranking, rew.produced_blocks,
+		rew.block_reward, rew.vote_reward, rew.total_reward
+	from wlcy_witness_reward rew
+	inner join (
+		select cycle_start from wlcy_vote_cycle_reward order by cycle_start desc limit %v
+	) cyc on cyc.cycle_start=rew.cycle_start
+	where 1=1 `, req.Cycles)
+	rewardResp, err := module.QueryWitnessRewardRealize(strSQL, "", "", "")
+	if err != nil {
+		log.Errorf("EstimateVoterReward strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+
+	//某轮没有记录的超级代表按0计入平均值
+	cycleSet := make(map[int64]bool)
+	rewardSum := make(map[string]int64)
+	for _, reward := range rewardResp.Data {
+		cycleSet[reward.CycleStart] = true
+		rewardSum[reward.Address] += reward.TotalReward
+	}
+
+	estimateResp := &entity.RewardEstimateResp{}
+	estimateResp.Votes = req.Votes
+	estimateResp.Ratio = req.Ratio
+	estimateResp.Cycles = int64(len(cycleSet))
+	estimateResp.Data = make([]*entity.RewardEstimate, 0)
+	if len(cycleSet) == 0 {
+		return estimateResp, nil
+	}
+
+	cyclesPerDay := int64(1)
+	if interval := buffer.GetChainParameterBuffer().GetChainParameter(buffer.ChainParamMaintenanceTimeInterval); interval > 0 {
+		cyclesPerDay = 24 * 60 * 60 * 1000 / interval
+	}
+	for index, witness := range buffer.GetWitnessBuffer().GetWitness() {
+		if req.Address != "" && witness.Address != req.Address {
+			continue
+		}
+		estimate := &entity.RewardEstimate{}
+		estimate.Address = witness.Address
+		estimate.Name = witness.Name
+		estimate.Ranking = int32(index + 1)
+		estimate.WitnessVotes = witness.Votes
+		estimate.AvgTotalReward = rewardSum[witness.Address] / int64(len(cycleSet))
+		estimate.VoterReward = calcVoterReward(estimate.AvgTotalReward, witness.Votes, req.Votes, req.Ratio)
+		estimate.VoterRewardDaily = estimate.VoterReward * cyclesPerDay
+		estimateResp.Data = append(estimateResp.Data, estimate)
+	}
+	sort.SliceStable(estimateResp.Data, func(i, j int) bool {
+		return estimateResp.Data[i].VoterReward > estimateResp.Data[j].VoterReward
+	})
+	return estimateResp, nil
+}
+
+//calcVoterReward 超级代表把ratio%的奖励按票数分给投票人，新增的votes计入超级代表的总票数
+func calcVoterReward(witnessReward, witnessVotes, votes, ratio int64) int64 {
+	if votes <= 0 || witnessVotes+votes <= 0 {
+		return 0
+	}
+	return int64(float64(witnessReward) * float64(ratio) / 100 * float64(votes) / float64(witnessVotes+votes))
+}
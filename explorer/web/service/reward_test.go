@@ -0,0 +1,42 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestCalcCycleRewards(t *testing.T) {
+	witnesses := []*entity.VoteCycleWitness{
+		{Address: "A", Votes: 300, Ranking: 1, ProducedBlocks: 10},
+		{Address: "B", Votes: 100, Ranking: 2, ProducedBlocks: 5},
+		{Address: "C", Votes: 50, Ranking: witnessStandbyCount + 1},
+	}
+	cycleReward, rewards := calcCycleRewards(1000, witnesses, 32, 4000)
+	want := map[string][2]int64{"A": {320, 3000}, "B": {160, 1000}, "C": {0, 0}}
+	for _, reward := range rewards {
+		if reward.BlockReward != want[reward.Address][0] || reward.VoteReward != want[reward.Address][1] ||
+			reward.TotalReward != reward.BlockReward+reward.VoteReward || reward.CycleStart != 1000 {
+			t.Errorf("calcCycleRewards %v:%#v", reward.Address, reward)
+		}
+	}
+	if cycleReward.TotalBlockReward != 480 || cycleReward.TotalVoteReward != 4000 {
+		t.Errorf("calcCycleRewards total:%#v", cycleReward)
+	}
+}
+
+func TestCalcVoterReward(t *testing.T) {
+	cases := []struct {
+		witnessReward, witnessVotes, votes, ratio, want int64
+	}{
+		{1000, 900, 100, 80, 80},
+		{1000, 900, 100, 0, 0},
+		{1000, 0, 100, 100, 1000},
+		{1000, 900, 0, 80, 0},
+	}
+	for _, c := range cases {
+		if got := calcVoterReward(c.witnessReward, c.witnessVotes, c.votes, c.ratio); got != c.want {
+			t.Errorf("calcVoterReward(%v, %v, %v, %v) = %v, want %v", c.witnessReward, c.witnessVotes, c.votes, c.ratio, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+//QueryTaskRuns 分页查询定时任务执行记录，job为空时查询全部任务
+func QueryTaskRuns(job string, start, limit int64) (*entity.TaskRunsResp, error) {
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := `
+	select id, job, trigger_type, scheduled_time, start_time, end_time, duration, status, error, instance
+	from wlcy_task_run
+	where 1=1 `
+	if job != "" {
+		filterSQL = fmt.Sprintf(" and job='%v'", job)
+	}
+	sortSQL = "order by id desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", start, limit)
+	return module.QueryTaskRunsRealize(strSQL, filterSQL, sortSQL, pageSQL)
+}
+
+//PruneTaskRuns 删除超过保留天数的执行记录
+func PruneTaskRuns(keepDays int) error {
+	before := time.Now().Add(-time.Duration(keepDays)*24*time.Hour).UnixNano() / 1e6
+	rows, err := module.DeleteTaskRunsBefore(before)
+	if err != nil {
+		return err
+	}
+	log.Infof("PruneTaskRuns deleted:[%v] before:[%v]", rows, before)
+	return nil
+}
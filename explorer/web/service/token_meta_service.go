@@ -0,0 +1,337 @@
+package service
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/draw"
+	_ "image/gif"
+	_ "image/jpeg"
+	"image/png"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/nfnt/resize"
+	"github.com/tronprotocol/grpc-gateway/core"
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/storage"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+//logo原图的边长限制，长宽比不超过2
+const (
+	tokenLogoMinSide = 64
+	tokenLogoMaxSide = 4096
+)
+
+const tokenMetaSQL = `
+	select id, owner_address, description, website, white_paper, github, country, social_media,
+		status, reason, submit_time, review_time
+	from wlcy_token_meta_submission
+	where 1=1 `
+
+//UploadTokenLogo 发行人上传通证logo，校验签名后裁剪缩放为配置的尺寸，按内容保存并立即更新logo地址
+func UploadTokenLogo(req *entity.TokenLogoReq) (*entity.TokenLogo, error) {
+	if req.OwnerAddress == "" || req.ImageData == "" || req.Transaction == "" {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	//传入data格式：data:image/png;base64,iVBORw0KGgoAAAANSUhEUgAAAKAAAACgCAYA...
+	imageData := req.ImageData
+	if index := strings.Index(imageData, ","); index >= 0 {
+		imageData = imageData[index+1:]
+	}
+	if int64(len(imageData)) > int64(base64.StdEncoding.EncodedLen(int(config.TokenLogoMaxBytes))) {
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	data, err := base64.StdEncoding.DecodeString(imageData)
+	if err != nil || int64(len(data)) > config.TokenLogoMaxBytes {
+		log.Errorf("UploadTokenLogo owner:[%v] image size:[%v] err:[%v]", req.OwnerAddress, len(data), err)
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	if err := verifyTokenOwnerSign(req.Transaction, req.OwnerAddress, getSHA256Hex(data)); err != nil {
+		return nil, err
+	}
+	if _, err := queryTokenOwnerAssetName(req.OwnerAddress); err != nil {
+		return nil, err
+	}
+
+	images, err := normalizeTokenLogo(data, config.TokenLogoSizes)
+	if err != nil {
+		log.Errorf("UploadTokenLogo owner:[%v] normalize err:[%v]", req.OwnerAddress, err)
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	tokenLogo := &entity.TokenLogo{}
+	tokenLogo.OwnerAddress = req.OwnerAddress
+	tokenLogo.Images = make([]*entity.TokenLogoImage, 0, len(images))
+	var maxSize int64
+	for index, size := range config.TokenLogoSizes {
+		logoURL, err := config.TokenLogoStorage.Put(storage.ContentKey(fmt.Sprintf("%v", size), images[index], ".png"),
+			images[index], "image/png")
+		if err != nil {
+			log.Errorf("UploadTokenLogo owner:[%v] save size:[%v] err:[%v]", req.OwnerAddress, size, err)
+			return nil, util.NewErrorMsg(util.Error_common_internal_error)
+		}
+		tokenLogo.Images = append(tokenLogo.Images, &entity.TokenLogoImage{Size: size, URL: logoURL})
+		if size > maxSize {
+			maxSize = size
+			tokenLogo.LogoURL = logoURL
+		}
+	}
+	if err := InsertOrUpdateLogo(req.OwnerAddress, tokenLogo.LogoURL); err != nil {
+		return nil, err
+	}
+	return tokenLogo, nil
+}
+
+//SubmitTokenMeta 发行人提交通证资料，审核通过后更新到通证资料
+func SubmitTokenMeta(req *entity.TokenMetaSubmission) (*entity.TokenMetaSubmission, error) {
+	if err := validateTokenMeta(req); err != nil {
+		return nil, err
+	}
+	if err := verifyTokenOwnerSign(req.Transaction, req.OwnerAddress, getTokenMetaDigest(req)); err != nil {
+		return nil, err
+	}
+	if _, err := queryTokenOwnerAssetName(req.OwnerAddress); err != nil {
+		return nil, err
+	}
+	submission := *req
+	submission.Transaction = ""
+	submission.Status = entity.TokenMetaStatusPending
+	submission.Reason = ""
+	submission.SubmitTime = time.Now().UnixNano() / 1e6
+	submission.ReviewTime = 0
+	id, err := module.InsertTokenMetaSubmission(&submission)
+	if err != nil {
+		return nil, err
+	}
+	submission.ID = id
+	return &submission, nil
+}
+
+//QueryTokenMetaSubmissions 查询通证资料提交记录，status小于0时查询全部状态
+func QueryTokenMetaSubmissions(ownerAddress string, status, start, limit int64) (*entity.TokenMetaSubmissionsResp, error) {
+	var filterSQL string
+	if ownerAddress != "" {
+		if len(utils.Base58DecodeAddr(ownerAddress)) != 21 {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		filterSQL = fmt.Sprintf(" and owner_address='%v'", ownerAddress)
+	}
+	if status >= 0 {
+		filterSQL = fmt.Sprintf("%v and status=%v", filterSQL, status)
+	}
+	sortSQL := "order by id desc"
+	pageSQL := fmt.Sprintf("limit %v, %v", start, limit)
+	return module.QueryTokenMetaSubmissionsRealize(tokenMetaSQL, filterSQL, sortSQL, pageSQL)
+}
+
+//ReviewTokenMeta 审核待审核的通证资料，approve为false时拒绝，reason为拒绝原因
+func ReviewTokenMeta(id int64, approve bool, reason string) (*entity.TokenMetaSubmission, error) {
+	submissionsResp, err := module.QueryTokenMetaSubmissionsRealize(tokenMetaSQL, fmt.Sprintf(" and id=%v", id), "", "")
+	if err != nil {
+		return nil, err
+	}
+	if len(submissionsResp.Data) == 0 {
+		return nil, util.NewErrorMsg(util.Error_common_no_data)
+	}
+	submission := submissionsResp.Data[0]
+	if submission.Status != entity.TokenMetaStatusPending {
+		log.Errorf("ReviewTokenMeta id:[%v] status:[%v] not pending", id, submission.Status)
+		return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+
+	reviewTime := time.Now().UnixNano() / 1e6
+	if approve {
+		assetName, err := queryTokenOwnerAssetName(submission.OwnerAddress)
+		if err != nil {
+			return nil, err
+		}
+		if err := module.ApproveTokenMetaSubmission(submission, assetName, reviewTime); err != nil {
+			return nil, err
+		}
+		submission.Status = entity.TokenMetaStatusApproved
+	} else {
+		updated, err := module.RejectTokenMetaSubmission(id, reason, reviewTime)
+		if err != nil {
+			return nil, err
+		}
+		if !updated {
+			return nil, util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		submission.Status = entity.TokenMetaStatusRejected
+		submission.Reason = reason
+	}
+	submission.ReviewTime = reviewTime
+	return submission, nil
+}
+
+//UpdateTokenMeta 管理员直接修改通证资料，立即生效
+func UpdateTokenMeta(meta *entity.TokenMetaSubmission) (*entity.TokenMetaSubmission, error) {
+	if err := validateTokenMetaContent(meta); err != nil {
+		return nil, err
+	}
+	assetName, err := queryTokenOwnerAssetName(meta.OwnerAddress)
+	if err != nil {
+		return nil, err
+	}
+	if err := module.SaveAssetInfo(meta, assetName); err != nil {
+		return nil, err
+	}
+	log.Infof("UpdateTokenMeta owner:[%v] asset:[%v] updated by admin", meta.OwnerAddress, assetName)
+	updated := *meta
+	updated.Transaction = ""
+	return &updated, nil
+}
+
+//queryTokenOwnerAssetName 查询发行人发行的通证名，没有发行通证时返回错误
+func queryTokenOwnerAssetName(ownerAddress string) (string, error) {
+	assetName, err := module.QueryAssetIssueName(ownerAddress)
+	if err != nil {
+		return "", err
+	}
+	if assetName == "" {
+		log.Errorf("address:[%v] has no asset issue", ownerAddress)
+		return "", util.NewErrorMsg(util.Error_common_no_data)
+	}
+	return assetName, nil
+}
+
+//verifyTokenOwnerSign 校验发行人签名的交易：签名地址和交易发起地址都是发行人，交易data为提交内容的digest，交易时间在有效期内
+//交易只用于证明身份，不会广播
+func verifyTokenOwnerSign(transactionHex, ownerAddress, digest string) error {
+	transaction := &core.Transaction{}
+	if err := proto.Unmarshal(utils.HexDecode(transactionHex), transaction); err != nil || transaction.RawData == nil ||
+		len(transaction.RawData.Contract) == 0 || transaction.RawData.Contract[0].Parameter == nil || len(transaction.Signature) == 0 {
+		log.Errorf("verifyTokenOwnerSign owner:[%v] transaction invalid, err:[%v]", ownerAddress, err)
+		return util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	pubKey, err := utils.GetSignedPublicKey(transaction)
+	if err != nil {
+		log.Errorf("verifyTokenOwnerSign owner:[%v] get public key err:[%v]", ownerAddress, err)
+		return util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	signatureAddress, err := utils.GetTronBase58Address(utils.HexEncode(pubKey))
+	contractOwnerAddress, _ := utils.GetContractInfoObj(transaction.RawData.Contract[0])
+	if err != nil || signatureAddress != ownerAddress || contractOwnerAddress != ownerAddress {
+		log.Errorf("verifyTokenOwnerSign owner:[%v] signatureAddress:[%v] contractOwnerAddress:[%v] err:[%v]",
+			ownerAddress, signatureAddress, contractOwnerAddress, err)
+		return util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	if !checkTokenSignData(string(transaction.RawData.Data), transaction.RawData.Timestamp, digest,
+		time.Now().UnixNano()/1e6, config.TokenMetaSignExpireSeconds*1000) {
+		log.Errorf("verifyTokenOwnerSign owner:[%v] data:[%s] timestamp:[%v] digest:[%v] not match",
+			ownerAddress, transaction.RawData.Data, transaction.RawData.Timestamp, digest)
+		return util.NewErrorMsg(util.Error_user_token_invalid)
+	}
+	return nil
+}
+
+//checkTokenSignData 交易data与digest一致（不区分大小写），交易时间与当前时间相差不超过expireMs
+func checkTokenSignData(data string, timestamp int64, digest string, now, expireMs int64) bool {
+	if !strings.EqualFold(strings.TrimSpace(data), digest) {
+		return false
+	}
+	return timestamp > now-expireMs && timestamp < now+expireMs
+}
+
+//getTokenMetaDigest 依次用换行连接 发行人地址、介绍、官网、白皮书、github、国家、社交媒体(名称=地址)，取sha256
+func getTokenMetaDigest(submission *entity.TokenMetaSubmission) string {
+	lines := []string{submission.OwnerAddress, submission.Description, submission.WebSite,
+		submission.WhitePaper, submission.GitHub, submission.Country}
+	for _, media := range submission.SocialMedia {
+		lines = append(lines, media.Name+"="+media.URL)
+	}
+	return getSHA256Hex([]byte(strings.Join(lines, "\n")))
+}
+
+func getSHA256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+//validateTokenMeta 校验发行人提交的资料，需要带签名的交易
+func validateTokenMeta(submission *entity.TokenMetaSubmission) error {
+	if submission.Transaction == "" {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	return validateTokenMetaContent(submission)
+}
+
+//validateTokenMetaContent 链接只允许http和https，社交媒体名称与通证资料一致，微信可以填写公众号名称
+//管理员修改资料时只校验内容
+func validateTokenMetaContent(submission *entity.TokenMetaSubmission) error {
+	if len(utils.Base58DecodeAddr(submission.OwnerAddress)) != 21 ||
+		utf8.RuneCountInString(submission.Description) > 2000 || utf8.RuneCountInString(submission.Country) > 100 ||
+		!isTokenMetaURL(submission.WebSite) || !isTokenMetaURL(submission.WhitePaper) || !isTokenMetaURL(submission.GitHub) {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	names := make(map[string]bool, len(submission.SocialMedia))
+	for _, media := range submission.SocialMedia {
+		if media == nil || !module.IsTokenSocialMedia(media.Name) || names[media.Name] {
+			return util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		if !isTokenMetaURL(media.URL) && !(media.Name == "Wechat" && utf8.RuneCountInString(media.URL) <= 100) {
+			return util.NewErrorMsg(util.Error_common_parameter_invalid)
+		}
+		names[media.Name] = true
+	}
+	return nil
+}
+
+func isTokenMetaURL(rawURL string) bool {
+	if rawURL == "" {
+		return true
+	}
+	if len(rawURL) > 500 {
+		return false
+	}
+	u, err := url.Parse(rawURL)
+	return err == nil && (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+//normalizeTokenLogo 校验图片格式(png jpeg gif)和尺寸，居中裁剪为正方形后缩放为各尺寸的png
+func normalizeTokenLogo(data []byte, sizes []int64) ([][]byte, error) {
+	imgConfig, format, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	width, height := imgConfig.Width, imgConfig.Height
+	if format != "png" && format != "jpeg" && format != "gif" {
+		return nil, fmt.Errorf("image format [%v] not support", format)
+	}
+	if width < tokenLogoMinSide || height < tokenLogoMinSide || width > tokenLogoMaxSide || height > tokenLogoMaxSide ||
+		width > 2*height || height > 2*width {
+		return nil, fmt.Errorf("image size [%vx%v] invalid", width, height)
+	}
+	origin, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	side := width
+	if height < side {
+		side = height
+	}
+	bounds := origin.Bounds()
+	square := image.NewRGBA(image.Rect(0, 0, side, side))
+	draw.Draw(square, square.Bounds(), origin, image.Pt(bounds.Min.X+(width-side)/2, bounds.Min.Y+(height-side)/2), draw.Src)
+
+	images := make([][]byte, 0, len(sizes))
+	for _, size := range sizes {
+		var buf bytes.Buffer
+		if err := png.Encode(&buf, resize.Resize(uint(size), uint(size), square, resize.Lanczos3)); err != nil {
+			return nil, err
+		}
+		images = append(images, buf.Bytes())
+	}
+	return images, nil
+}
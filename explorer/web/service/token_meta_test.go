@@ -0,0 +1,88 @@
+package service
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/png"
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestCheckTokenSignData(t *testing.T) {
+	digest := getSHA256Hex([]byte("logo"))
+	now := int64(1539100800000)
+	if !checkTokenSignData(digest, now-1000, digest, now, 600000) {
+		t.Errorf("checkTokenSignData valid")
+	}
+	if checkTokenSignData(digest, now-600000, digest, now, 600000) {
+		t.Errorf("checkTokenSignData expired")
+	}
+	if checkTokenSignData(getSHA256Hex([]byte("other")), now, digest, now, 600000) {
+		t.Errorf("checkTokenSignData digest not match")
+	}
+}
+
+func TestTokenMetaDigest(t *testing.T) {
+	submission := &entity.TokenMetaSubmission{
+		OwnerAddress: "TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3",
+		WebSite:      "https://example.com",
+		SocialMedia:  []*entity.SocialMediaInfo{{Name: "Twitter", URL: "https://twitter.com/example"}},
+		Transaction:  "0a02",
+	}
+	want := getSHA256Hex([]byte("TVVGvh3DrRrUCuZVy58Ha4QRqF4gGMS7L3\n\nhttps://example.com\n\n\n\nTwitter=https://twitter.com/example"))
+	if got := getTokenMetaDigest(submission); got != want {
+		t.Errorf("getTokenMetaDigest:%v", got)
+	}
+	if err := validateTokenMeta(submission); err != nil {
+		t.Errorf("validateTokenMeta:%v", err)
+	}
+
+	invalids := []*entity.SocialMediaInfo{
+		{Name: "Twitter", URL: "javascript:alert(1)"},
+		{Name: "MySpace", URL: "https://myspace.com/example"},
+	}
+	for _, media := range invalids {
+		submission.SocialMedia = []*entity.SocialMediaInfo{media}
+		if validateTokenMeta(submission) == nil {
+			t.Errorf("validateTokenMeta should reject:%#v", media)
+		}
+	}
+	submission.SocialMedia = []*entity.SocialMediaInfo{{Name: "Wechat", URL: "tron公众号"}}
+	submission.WebSite = "ftp://example.com"
+	if validateTokenMeta(submission) == nil {
+		t.Errorf("validateTokenMeta should reject website")
+	}
+}
+
+func TestNormalizeTokenLogo(t *testing.T) {
+	img := image.NewRGBA(image.Rect(0, 0, 300, 200))
+	for x := 0; x < 300; x++ {
+		for y := 0; y < 200; y++ {
+			img.Set(x, y, color.RGBA{R: uint8(x), G: uint8(y), B: 128, A: 255})
+		}
+	}
+	var buf bytes.Buffer
+	png.Encode(&buf, img)
+
+	images, err := normalizeTokenLogo(buf.Bytes(), []int64{128, 64})
+	if err != nil || len(images) != 2 {
+		t.Fatalf("normalizeTokenLogo:%v %v", len(images), err)
+	}
+	for index, size := range []int{128, 64} {
+		logo, format, err := image.Decode(bytes.NewReader(images[index]))
+		if err != nil || format != "png" || logo.Bounds().Dx() != size || logo.Bounds().Dy() != size {
+			t.Errorf("normalizeTokenLogo size:[%v] format:[%v] bounds:[%v] err:[%v]", size, format, logo.Bounds(), err)
+		}
+	}
+
+	if _, err := normalizeTokenLogo([]byte("<svg></svg>"), []int64{64}); err == nil {
+		t.Errorf("normalizeTokenLogo should reject svg")
+	}
+	buf.Reset()
+	png.Encode(&buf, image.NewRGBA(image.Rect(0, 0, 300, 100)))
+	if _, err := normalizeTokenLogo(buf.Bytes(), []int64{64}); err == nil {
+		t.Errorf("normalizeTokenLogo should reject aspect ratio 3")
+	}
+}
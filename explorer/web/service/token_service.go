@@ -7,17 +7,7 @@ import (
 	"strings"
 	"github.com/wlcy/tron/explorer/lib/log"
 	"github.com/wlcy/tron/explorer/lib/util"
-	"encoding/base64"
-	"bytes"
 	"time"
-	"os"
-	"image"
-	"github.com/nfnt/resize"
-	"image/jpeg"
-	"image/png"
-	"image/gif"
-	"io"
-	"errors"
 	"github.com/wlcy/tron/explorer/web/buffer"
 )
 
@@ -277,73 +267,6 @@ func calculateToken(token *entity.TokenInfo) {
 
 }
 
-//UploadTokenLogo 保存图片
-func UploadTokenLogo(defaultPath, imgURL, imageData, address string) (string, error) {
-	imgData, err := base64.StdEncoding.DecodeString(imageData)
-	if nil != err {
-		return "", err
-	}
-	buffer := bytes.NewBuffer(imgData)
-	tempFileName := fmt.Sprintf("tokenLogo_%v.jpeg", time.Now().Format("20060102150405.000000"))
-
-	dist, err := os.Create(defaultPath + "/" + tempFileName)
-	if err != nil {
-		log.Error(err)
-	}
-	defer dist.Close()
-
-	err = scale(buffer, dist, 0, 0, 0)
-	if err != nil {
-		log.Error(err)
-	}
-	log.Debugf("save file %v ", dist)
-	dst := fmt.Sprintf("%v/%v", imgURL, tempFileName)
-	err = InsertOrUpdateLogo(address, dst)
-
-	return dst, err
-}
-
-
-/*
-* 缩略图生成
-* 入参:
-* 规则: 如果width 或 hight其中有一个为0，则大小不变 如果精度为0则精度保持不变
-* 矩形坐标系起点是左上
-* 返回:error
- */
-func scale(in io.Reader, out io.Writer, width, height, quality int) error {
-	origin, fm, err := image.Decode(in)
-	if err != nil {
-		log.Error(err)
-		return err
-	}
-	if width == 0 || height == 0 {
-		width = origin.Bounds().Max.X
-		height = origin.Bounds().Max.Y
-	}
-	if quality == 0 {
-		quality = 100
-	}
-	canvas := resize.Resize(uint(width), uint(height), origin, resize.Lanczos3)
-
-	//return jpeg.Encode(out, canvas, &jpeg.Options{quality})
-	log.Debugf("fm:%v", fm)
-	switch fm {
-	case "jpeg":
-		return jpeg.Encode(out, canvas, &jpeg.Options{quality})
-	case "png":
-		return png.Encode(out, canvas)
-	case "gif":
-		return gif.Encode(out, canvas, &gif.Options{})
-		/*case "bmp":
-		return bmp.Encode(out, canvas)*/
-	default:
-		return errors.New("ERROR FORMAT")
-	}
-	return nil
-}
-
-
 //InsertOrUpdateLogo 更新或插入logo
 func InsertOrUpdateLogo(address, url string) error {
 	if address == "" || url == "" {
@@ -365,20 +288,34 @@ func InsertOrUpdateLogo(address, url string) error {
 	return err
 }
 
-func SyncAssetIssueParticipated() {
-	assetIssues, _ := module.QueryAllAssetIssue()
+//SyncAssetIssueParticipated 按参与记录更新通证的参与数量，单个通证失败时继续处理其他通证，返回最后一个错误
+func SyncAssetIssueParticipated() error {
+	assetIssues, err := module.QueryAllAssetIssue()
+	if err != nil {
+		log.Errorf("SyncAssetIssueParticipated query asset issue err:[%v]", err)
+		return err
+	}
 	if len(assetIssues) == 0 {
 		log.Info("SyncAssetIssueParticipated len(assetIssues) == 0")
-		return
+		return nil
 	}
+	var lastErr error
 	for index := range assetIssues {
 		assetIssue := assetIssues[index]
-		participateAsset, _ := module.QueryParticipateAsset(assetIssue.OwnerAddress, assetIssue.AssetName)
+		participateAsset, err := module.QueryParticipateAsset(assetIssue.OwnerAddress, assetIssue.AssetName)
+		if err != nil {
+			log.Errorf("SyncAssetIssueParticipated query participate asset [%v] err:[%v]", assetIssue.AssetName, err)
+			lastErr = err
+			continue
+		}
 		if participateAsset.AssetName != "" && participateAsset.TotalAmount > assetIssue.Participated {
-			module.UpdateAssetIssue(assetIssue.OwnerAddress, assetIssue.AssetName, participateAsset.TotalAmount)
+			if err := module.UpdateAssetIssue(assetIssue.OwnerAddress, assetIssue.AssetName, participateAsset.TotalAmount); err != nil {
+				log.Errorf("SyncAssetIssueParticipated update asset issue [%v] err:[%v]", assetIssue.AssetName, err)
+				lastErr = err
+			}
 		}
 	}
-
+	return lastErr
 }
 
 // QueryAssetBalances
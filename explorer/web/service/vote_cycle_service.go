@@ -0,0 +1,350 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/wlcy/tron/explorer/core/utils"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+)
+
+//voteCycleSQL 查询轮次的语句
+const voteCycleSQL = `
+	select cycle_start, cycle_end, total_votes, witness_count, voter_count, snapshot_time, finished
+	from wlcy_vote_cycle
+	where 1=1 `
+
+//voteCycleFinishBatch 每次最多结算的轮次数
+const voteCycleFinishBatch = 20
+
+//voteCycleMaxLimit 轮次相关的列表单次最多返回的记录数
+const voteCycleMaxLimit = 200
+
+//voteCycleDuration 维护周期时长，优先使用链上参数，没有时使用当前网络配置的维护周期
+func voteCycleDuration() int64 {
+	return buffer.GetChainParameterBuffer().GetChainParameter(buffer.ChainParamMaintenanceTimeInterval)
+}
+
+//checkVoteCyclesPage 校验分页参数，limit超过上限时按上限查询
+func checkVoteCyclesPage(req *entity.VoteCycles) error {
+	if req.Start < 0 || req.Limit <= 0 {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	if req.Limit > voteCycleMaxLimit {
+		req.Limit = voteCycleMaxLimit
+	}
+	return nil
+}
+
+//checkWitnessCyclesReq 校验候选人地址和分页参数
+func checkWitnessCyclesReq(req *entity.VoteCycles) error {
+	if len(utils.Base58DecodeAddr(req.Address)) != 21 {
+		return util.NewErrorMsg(util.Error_common_parameter_invalid)
+	}
+	return checkVoteCyclesPage(req)
+}
+
+//QueryVoteCycles 查询投票轮次，detail=true时一并返回每轮的候选人排名
+func QueryVoteCycles(req *entity.VoteCycles) (*entity.VoteCyclesResp, error) {
+	if err := checkVoteCyclesPage(req); err != nil {
+		return nil, err
+	}
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := voteCycleSQL
+	if req.From > 0 {
+		filterSQL = fmt.Sprintf(" and cycle_start>=%v", req.From)
+	}
+	if req.To > 0 {
+		filterSQL = fmt.Sprintf("%v and cycle_start<%v", filterSQL, req.To)
+	}
+	sortSQL = "order by cycle_start desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
+
+	cyclesResp, err := module.QueryVoteCyclesRealize(strSQL, filterSQL, sortSQL, pageSQL)
+	if err != nil {
+		log.Errorf("QueryVoteCycles strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	if !req.Detail || len(cyclesResp.Data) == 0 {
+		return cyclesResp, nil
+	}
+
+	//一次查出本页所有轮次的候选人排名
+	cycleStarts := make([]string, 0, len(cyclesResp.Data))
+	cycleMap := make(map[int64]*entity.VoteCycle, len(cyclesResp.Data))
+	for _, cycle := range cyclesResp.Data {
+		cycle.Witnesses = make([]*entity.VoteCycleWitness, 0)
+		cycleStarts = append(cycleStarts, fmt.Sprintf("%v", cycle.CycleStart))
+		cycleMap[cycle.CycleStart] = cycle
+	}
+	witnessSQL := fmt.Sprintf(`
+	select wit.cycle_start, cyc.cycle_end, wit.address, wit.votes, wit.ranking, wit.voter_count,
+		wit.produced_blocks, wit.missed_blocks
+	from wlcy_vote_cycle_witness wit
+	left join wlcy_vote_cycle cyc on cyc.cycle_start=wit.cycle_start
+	where wit.cycle_start in (%v) `, strings.Join(cycleStarts, ","))
+	witnessResp, err := module.QueryVoteCycleWitnessRealize(witnessSQL, "", "order by wit.cycle_start desc, wit.ranking", "")
+	if err != nil {
+		log.Errorf("QueryVoteCycles witness strSQL:%v, err:[%v]", witnessSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	for _, witness := range witnessResp.Data {
+		if cycle, ok := cycleMap[witness.CycleStart]; ok {
+			cycle.Witnesses = append(cycle.Witnesses, witness)
+		}
+	}
+	return cyclesResp, nil
+}
+
+//QueryWitnessCycles 查询候选人每一轮的票数、排名和出块情况
+func QueryWitnessCycles(req *entity.VoteCycles) (*entity.VoteCycleWitnessResp, error) {
+	if err := checkWitnessCyclesReq(req); err != nil {
+		return nil, err
+	}
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := fmt.Sprintf(`
+	select wit.cycle_start, cyc.cycle_end, wit.address, wit.votes, wit.ranking, wit.voter_count,
+		wit.produced_blocks, wit.missed_blocks
+	from wlcy_vote_cycle_witness wit
+	left join wlcy_vote_cycle cyc on cyc.cycle_start=wit.cycle_start
+	where wit.address='%v' `, req.Address)
+	if req.From > 0 {
+		filterSQL = fmt.Sprintf(" and wit.cycle_start>=%v", req.From)
+	}
+	if req.To > 0 {
+		filterSQL = fmt.Sprintf("%v and wit.cycle_start<%v", filterSQL, req.To)
+	}
+	sortSQL = "order by wit.cycle_start desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
+
+	witnessResp, err := module.QueryVoteCycleWitnessRealize(strSQL, filterSQL, sortSQL, pageSQL)
+	if err != nil {
+		log.Errorf("QueryWitnessCycles strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return witnessResp, nil
+}
+
+//ArchiveVoteCycle 归档投票轮次
+//进入新的维护周期后记录新一轮的票数和排名，服务停止期间错过的轮次只记录时间范围，不补记票数和排名
+//丢块检查越过轮次结束时间后，按区块和丢块记录结算各轮的出块数和丢块数
+func ArchiveVoteCycle() error {
+	nextMaintenanceTime := buffer.GetVoteBuffer().GetNextMaintenanceTime()
+	if nextMaintenanceTime == 0 {
+		log.Errorf("ArchiveVoteCycle nextMaintenanceTime is 0")
+		return errors.New("next maintenance time is 0")
+	}
+	interval := voteCycleDuration()
+	if interval <= 0 {
+		log.Errorf("ArchiveVoteCycle maintenance interval is %v", interval)
+		return fmt.Errorf("maintenance interval is %v", interval)
+	}
+
+	latestResp, err := module.QueryVoteCyclesRealize(voteCycleSQL, "", "order by cycle_start desc", "limit 1")
+	if err != nil {
+		log.Errorf("ArchiveVoteCycle query latest cycle err:[%v]", err)
+		return err
+	}
+	var latest *entity.VoteCycle
+	if len(latestResp.Data) > 0 {
+		latest = latestResp.Data[0]
+	}
+	if latest == nil || latest.CycleEnd < nextMaintenanceTime {
+		if err := archiveVoteCycle(latest, nextMaintenanceTime, interval); err != nil {
+			return err
+		}
+	}
+	return finishVoteCycles()
+}
+
+//archiveVoteCycle 记录上次归档之后错过的轮次，再记录当前轮次的票数和排名
+func archiveVoteCycle(latest *entity.VoteCycle, nextMaintenanceTime, interval int64) error {
+	cycleStart := nextMaintenanceTime - interval
+	if latest != nil && latest.CycleEnd > cycleStart {
+		cycleStart = latest.CycleEnd
+	}
+	if latest != nil {
+		for _, missed := range getMissedVoteCycles(latest.CycleEnd, cycleStart, interval) {
+			if err := module.InsertVoteCycle(missed); err != nil {
+				return err
+			}
+			log.Warnf("ArchiveVoteCycle cycle:[%v-%v] missed, votes and ranking not recorded", missed.CycleStart, missed.CycleEnd)
+		}
+	}
+
+	cycle, err := snapshotVoteCycle(cycleStart, nextMaintenanceTime)
+	if err != nil {
+		return err
+	}
+	if err := module.InsertVoteCycle(cycle); err != nil {
+		return err
+	}
+	log.Infof("ArchiveVoteCycle cycle:[%v-%v] witnessCount:[%v] archived, snapshot delay:[%vms]", cycle.CycleStart, cycle.CycleEnd,
+		cycle.WitnessCount, cycle.SnapshotTime-cycle.CycleStart)
+	return nil
+}
+
+//getMissedVoteCycles 上次归档的轮次结束到当前轮次开始之间错过的轮次，最后一轮不足一个维护周期时截止到当前轮次开始
+func getMissedVoteCycles(from, to, interval int64) []*entity.VoteCycle {
+	cycles := make([]*entity.VoteCycle, 0)
+	for cycleStart := from; cycleStart < to; cycleStart += interval {
+		cycle := &entity.VoteCycle{}
+		cycle.CycleStart = cycleStart
+		cycle.CycleEnd = cycleStart + interval
+		if cycle.CycleEnd > to {
+			cycle.CycleEnd = to
+		}
+		cycles = append(cycles, cycle)
+	}
+	return cycles
+}
+
+//snapshotVoteCycle 记录候选人票数、排名和投票人数
+//票数在维护时统计，本轮内不变；投票人数是记录时的数量，snapshotTime 为记录时间
+func snapshotVoteCycle(cycleStart, cycleEnd int64) (*entity.VoteCycle, error) {
+	witnessSQL := fmt.Sprintf(`
+	select address, vote_count, total_produced, total_missed
+	from witness
+	order by vote_count desc, address`)
+	witnessList, err := module.QueryWitnessRealize(witnessSQL)
+	if err != nil {
+		log.Errorf("snapshotVoteCycle query witness err:[%v]", err)
+		return nil, err
+	}
+
+	voterSQL := fmt.Sprintf(`
+	select to_address as address, count(distinct address) as num
+	from account_vote_result
+	group by to_address`)
+	voterCountMap, err := module.QueryCountByAddress(voterSQL)
+	if err != nil {
+		log.Errorf("snapshotVoteCycle query voter count err:[%v]", err)
+		return nil, err
+	}
+	totalVoterCount, err := module.QueryCountByAddress(`
+	select '' as address, count(distinct address) as num
+	from account_vote_result`)
+	if err != nil {
+		log.Errorf("snapshotVoteCycle query total voter count err:[%v]", err)
+		return nil, err
+	}
+
+	cycle := &entity.VoteCycle{}
+	cycle.CycleStart = cycleStart
+	cycle.CycleEnd = cycleEnd
+	cycle.SnapshotTime = time.Now().UnixNano() / 1e6
+	cycle.VoterCount = totalVoterCount[""]
+	cycle.Witnesses = make([]*entity.VoteCycleWitness, 0, len(witnessList))
+	for index, witness := range witnessList {
+		cycleWitness := &entity.VoteCycleWitness{}
+		cycleWitness.CycleStart = cycleStart
+		cycleWitness.CycleEnd = cycleEnd
+		cycleWitness.Address = witness.Address
+		cycleWitness.Votes = witness.Votes
+		cycleWitness.Ranking = int32(index + 1)
+		cycleWitness.VoterCount = voterCountMap[witness.Address]
+		cycle.TotalVotes += witness.Votes
+		cycle.Witnesses = append(cycle.Witnesses, cycleWitness)
+	}
+	cycle.WitnessCount = int64(len(cycle.Witnesses))
+	return cycle, nil
+}
+
+//finishVoteCycles 结算丢块检查已经越过结束时间的轮次
+func finishVoteCycles() error {
+	checkedTime, err := getWitnessMonitorCheckedTime()
+	if err != nil {
+		return err
+	}
+	filterSQL := fmt.Sprintf(" and finished=0 and cycle_end<=%v", checkedTime)
+	cyclesResp, err := module.QueryVoteCyclesRealize(voteCycleSQL, filterSQL, "order by cycle_start", fmt.Sprintf("limit %v", voteCycleFinishBatch))
+	if err != nil {
+		log.Errorf("finishVoteCycles query cycles err:[%v]", err)
+		return err
+	}
+	for _, cycle := range cyclesResp.Data {
+		if err := finishVoteCycle(cycle); err != nil {
+			return err
+		}
+		log.Infof("finishVoteCycles cycle:[%v-%v] finished", cycle.CycleStart, cycle.CycleEnd)
+	}
+	return nil
+}
+
+//finishVoteCycle 结算轮次内各候选人的出块数和丢块数
+//出块数按区块时间统计，丢块数取自该轮的丢块记录；不在排名中的出块人(如错过的轮次)补充记录，票数和排名为0
+func finishVoteCycle(cycle *entity.VoteCycle) error {
+	witnessSQL := fmt.Sprintf(`
+	select wit.cycle_start, cyc.cycle_end, wit.address, wit.votes, wit.ranking, wit.voter_count,
+		wit.produced_blocks, wit.missed_blocks
+	from wlcy_vote_cycle_witness wit
+	left join wlcy_vote_cycle cyc on cyc.cycle_start=wit.cycle_start
+	where wit.cycle_start=%v `, cycle.CycleStart)
+	witnessResp, err := module.QueryVoteCycleWitnessRealize(witnessSQL, "", "", "")
+	if err != nil {
+		log.Errorf("finishVoteCycle query cycle witness err:[%v]", err)
+		return err
+	}
+
+	producedSQL := fmt.Sprintf(`
+	select witness_address as address, count(block_id) as num
+	from blocks
+	where create_time>=%v and create_time<%v
+	group by witness_address`, cycle.CycleStart, cycle.CycleEnd)
+	producedMap, err := module.QueryCountByAddress(producedSQL)
+	if err != nil {
+		log.Errorf("finishVoteCycle query produced blocks err:[%v]", err)
+		return err
+	}
+
+	missedSQL := fmt.Sprintf(`
+	select address, count(slot_time) as num
+	from wlcy_witness_missed_slot
+	where slot_time>=%v and slot_time<%v and address!=''
+	group by address`, cycle.CycleStart, cycle.CycleEnd)
+	missedMap, err := module.QueryCountByAddress(missedSQL)
+	if err != nil {
+		log.Errorf("finishVoteCycle query missed blocks err:[%v]", err)
+		return err
+	}
+
+	cycle.Witnesses = getFinishedCycleWitnesses(cycle, witnessResp.Data, producedMap, missedMap)
+	return module.FinishVoteCycle(cycle)
+}
+
+//getFinishedCycleWitnesses 填入排名中各候选人的出块数和丢块数，并补充不在排名中的出块人和丢块人
+func getFinishedCycleWitnesses(cycle *entity.VoteCycle, witnesses []*entity.VoteCycleWitness, producedMap, missedMap map[string]int64) []*entity.VoteCycleWitness {
+	ranked := make(map[string]bool, len(witnesses))
+	for _, witness := range witnesses {
+		ranked[witness.Address] = true
+	}
+	addresses := make([]string, 0)
+	for _, countMap := range []map[string]int64{producedMap, missedMap} {
+		for address := range countMap {
+			if !ranked[address] {
+				ranked[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+	}
+	sort.Strings(addresses)
+	for _, address := range addresses {
+		witness := &entity.VoteCycleWitness{}
+		witness.CycleStart = cycle.CycleStart
+		witness.CycleEnd = cycle.CycleEnd
+		witness.Address = address
+		witnesses = append(witnesses, witness)
+	}
+	for _, witness := range witnesses {
+		witness.ProducedBlocks = producedMap[witness.Address]
+		witness.MissedBlocks = missedMap[witness.Address]
+	}
+	return witnesses
+}
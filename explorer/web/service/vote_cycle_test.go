@@ -0,0 +1,64 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/wlcy/tron/explorer/web/entity"
+)
+
+func TestGetMissedVoteCycles(t *testing.T) {
+	//上次记录的轮次在1000结束，当前轮次从4500开始，中间错过两轮半
+	cycles := getMissedVoteCycles(1000, 4500, 1500)
+	want := [][2]int64{{1000, 2500}, {2500, 4000}, {4000, 4500}}
+	if len(cycles) != len(want) {
+		t.Fatalf("getMissedVoteCycles:%v", len(cycles))
+	}
+	for index, cycle := range cycles {
+		if cycle.CycleStart != want[index][0] || cycle.CycleEnd != want[index][1] || cycle.SnapshotTime != 0 {
+			t.Errorf("getMissedVoteCycles %v:%#v", index, cycle)
+		}
+	}
+	if cycles := getMissedVoteCycles(4500, 4500, 1500); len(cycles) != 0 {
+		t.Errorf("getMissedVoteCycles no gap:%v", len(cycles))
+	}
+}
+
+func TestGetFinishedCycleWitnesses(t *testing.T) {
+	cycle := &entity.VoteCycle{CycleStart: 1000, CycleEnd: 2500}
+	witnesses := []*entity.VoteCycleWitness{
+		{CycleStart: 1000, Address: "A", Votes: 300, Ranking: 1},
+		{CycleStart: 1000, Address: "B", Votes: 100, Ranking: 2},
+	}
+	produced := map[string]int64{"A": 10, "C": 3}
+	missed := map[string]int64{"B": 2, "D": 1}
+	witnesses = getFinishedCycleWitnesses(cycle, witnesses, produced, missed)
+	want := map[string][3]int64{"A": {1, 10, 0}, "B": {2, 0, 2}, "C": {0, 3, 0}, "D": {0, 0, 1}}
+	if len(witnesses) != len(want) {
+		t.Fatalf("getFinishedCycleWitnesses:%v", len(witnesses))
+	}
+	for _, witness := range witnesses {
+		if int64(witness.Ranking) != want[witness.Address][0] || witness.ProducedBlocks != want[witness.Address][1] ||
+			witness.MissedBlocks != want[witness.Address][2] || witness.CycleStart != 1000 {
+			t.Errorf("getFinishedCycleWitnesses %v:%#v", witness.Address, witness)
+		}
+	}
+}
+
+func TestCheckWitnessCyclesReq(t *testing.T) {
+	address := "TDh2S3T3whq9FxD8cmTbBjSCvhsLHmqftK"
+	invalid := []*entity.VoteCycles{
+		{Address: address + "' or '1'='1", Limit: 40},
+		{Address: "", Limit: 40},
+		{Address: address, Start: -1, Limit: 40},
+		{Address: address, Limit: 0},
+	}
+	for _, req := range invalid {
+		if err := checkWitnessCyclesReq(req); err == nil {
+			t.Errorf("checkWitnessCyclesReq %#v should fail", req)
+		}
+	}
+	req := &entity.VoteCycles{Address: address, Start: 20, Limit: 100000}
+	if err := checkWitnessCyclesReq(req); err != nil || req.Limit != voteCycleMaxLimit {
+		t.Errorf("checkWitnessCyclesReq err:%v, limit:%v", err, req.Limit)
+	}
+}
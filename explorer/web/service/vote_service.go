@@ -304,14 +304,14 @@ func  getChangeRanking(address string, currentRanking int32, voteWitnessRankingL
 
 
 //syncLatelyCycleVoteWitnessRanking
-func syncLatelyCycleVoteWitnessRanking() {
+func syncLatelyCycleVoteWitnessRanking() error {
 	strSQL := fmt.Sprintf(`select address, vote_count from witness order by vote_count desc `)
 
 	voteWitnessRankingList, err := module.QueryVoteWitnessRanking(strSQL)
 
 	if err != nil {
 		log.Errorf("syncLatelyCycleVoteWitnessRanking strSQL:%v, err:[%v]",strSQL,  err)
-		return
+		return err
 	}
 
 	for index := range voteWitnessRankingList {
@@ -321,44 +321,49 @@ func syncLatelyCycleVoteWitnessRanking() {
 	value, err := json.Marshal(voteWitnessRankingList)
 	if err != nil {
 		log.Errorf("syncLatelyCycleVoteWitnessRanking json.Marshal err:[%v]", err)
-		return
+		return err
 	}
 
 	err = config.RedisCli.Set(lately_cycle_vote_witness_ranking_key, string(value), 0).Err()
 	if err != nil {
 		log.Errorf("syncLatelyCycleVoteWitnessRanking set lately_cycle_vote_witness_ranking_key err:[%v]", err)
 	}
+	return err
 }
 
-// SyncVoteWitnessRanking
-func SyncVoteWitnessRanking() {
+// SyncVoteWitnessRanking 把上一轮的排名保存为日排名，并重新统计本轮的排名
+func SyncVoteWitnessRanking() error {
 	var latelyDayVoteWitnessRankingValue, latelyCycleVoteWitnessRankingValue string
 	var err error
 	latelyDayVoteWitnessRankingValue, err = config.RedisCli.Get(lately_day_vote_witness_ranking_key).Result()
 	if err == redis.Nil {
 		latelyCycleVoteWitnessRankingValue, err = config.RedisCli.Get(lately_cycle_vote_witness_ranking_key).Result()
 		if err == redis.Nil {
-			syncLatelyCycleVoteWitnessRanking()
+			if err := syncLatelyCycleVoteWitnessRanking(); err != nil {
+				return err
+			}
 			latelyCycleVoteWitnessRankingValue, _ = config.RedisCli.Get(lately_cycle_vote_witness_ranking_key).Result()
 		} else if err != nil  {
 			log.Errorf("syncVoteWitnessRanking redis get latelyCycleVoteWitnessRankingValue error :[%v]\n", err)
-			return
+			return err
 		}
 
 		latelyDayVoteWitnessRankingValue = latelyCycleVoteWitnessRankingValue
 
 	} else if err != nil {
 		log.Errorf("syncVoteWitnessRanking redis get latelyDayVoteWitnessRankingValue error :[%v]\n", err)
-		return
+		return err
 	}
 
 	latelyCycleVoteWitnessRankingValue, err = config.RedisCli.Get(lately_cycle_vote_witness_ranking_key).Result()
 	if err == redis.Nil {
-		syncLatelyCycleVoteWitnessRanking()
+		if err := syncLatelyCycleVoteWitnessRanking(); err != nil {
+			return err
+		}
 		latelyCycleVoteWitnessRankingValue, _ = config.RedisCli.Get(lately_cycle_vote_witness_ranking_key).Result()
 	} else if err != nil {
 		log.Errorf("syncVoteWitnessRanking redis get latelyCycleVoteWitnessRankingValue error :[%v]\n", err)
-		return
+		return err
 	}
 
 	latelyDayVoteWitnessRankingValue = latelyCycleVoteWitnessRankingValue
@@ -366,9 +371,9 @@ func SyncVoteWitnessRanking() {
 	err = config.RedisCli.Set(lately_day_vote_witness_ranking_key, string(latelyDayVoteWitnessRankingValue), 0).Err()
 	if err != nil {
 		log.Errorf("syncVoteWitnessRanking set lately_day_vote_witness_ranking_key err:[%v]", err)
+		return err
 	}
-	syncLatelyCycleVoteWitnessRanking()
-
+	return syncLatelyCycleVoteWitnessRanking()
 }
 
 // QueryVoteWitnessDetail
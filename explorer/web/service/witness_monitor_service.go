@@ -0,0 +1,310 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/parnurzeal/gorequest"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/buffer"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+	"gopkg.in/redis.v4"
+)
+
+/*
+出块监控
+出块顺序由超级代表在本轮的位置决定：slot = 区块时间/3000，应出块人为 slot % 超级代表数量 位置上的超级代表
+每轮的位置表由该轮实际出块的区块推出，相邻两个区块之间空出的slot即为丢块，维护周期后固定跳过的slot不计入
+*/
+
+const witnessMonitorCheckpointKey = "witness.monitor.checkpoint"
+
+//blockInterval 出块间隔，单位ms
+const blockInterval = 3000
+
+//activeWitnessCount 出块的超级代表数量
+const activeWitnessCount = 27
+
+//witnessMonitorBatch 每次最多检查的区块数
+const witnessMonitorBatch = 2000
+
+//witnessMonitorLookback 每次额外读取的已检查区块数，用于重建本轮的出块位置表
+const witnessMonitorLookback = 100
+
+var _witnessMonitor = &witnessMonitor{
+	schedules:    make(map[int64]map[int64]string),
+	missedStreak: make(map[string]int64),
+	alerted:      make(map[string]bool),
+}
+
+type witnessMonitor struct {
+	sync.Mutex
+
+	schedules    map[int64]map[int64]string // 轮次开始时间 -> 出块位置 -> 超级代表
+	missedStreak map[string]int64           // 超级代表当前的连续丢块数
+	alerted      map[string]bool            // 已发送连续丢块告警的超级代表
+}
+
+//QueryWitnessMissed 查询超级代表的丢块记录
+func QueryWitnessMissed(req *entity.VoteCycles) (*entity.WitnessMissedResp, error) {
+	if err := checkWitnessCyclesReq(req); err != nil {
+		return nil, err
+	}
+	var filterSQL, sortSQL, pageSQL string
+	strSQL := fmt.Sprintf(`
+	select slot_time, address, cycle_start, prev_block_id
+	from wlcy_witness_missed_slot
+	where address='%v' `, req.Address)
+	if req.From > 0 {
+		filterSQL = fmt.Sprintf(" and slot_time>=%v", req.From)
+	}
+	if req.To > 0 {
+		filterSQL = fmt.Sprintf("%v and slot_time<%v", filterSQL, req.To)
+	}
+	sortSQL = "order by slot_time desc"
+	pageSQL = fmt.Sprintf("limit %v, %v", req.Start, req.Limit)
+
+	missedResp, err := module.QueryWitnessMissedRealize(strSQL, filterSQL, sortSQL, pageSQL)
+	if err != nil {
+		log.Errorf("QueryWitnessMissed strSQL:%v, err:[%v]", strSQL, err)
+		return nil, util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	return missedResp, nil
+}
+
+//SyncWitnessMissedSlot 从上次检查的区块开始查找丢块
+func SyncWitnessMissedSlot() error {
+	nextMaintenanceTime := buffer.GetVoteBuffer().GetNextMaintenanceTime()
+	if nextMaintenanceTime == 0 {
+		log.Errorf("SyncWitnessMissedSlot nextMaintenanceTime is 0")
+		return errors.New("next maintenance time is 0")
+	}
+	chainParameterBuffer := buffer.GetChainParameterBuffer()
+	interval := chainParameterBuffer.GetChainParameter(buffer.ChainParamMaintenanceTimeInterval)
+	skipSlots := chainParameterBuffer.GetChainParameter(buffer.ChainParamMaintenanceSkipSlots)
+
+	checkpoint, err := config.RedisCli.Get(witnessMonitorCheckpointKey).Int64()
+	if err == redis.Nil {
+		//第一次运行只从最近的区块开始检查，不回补历史
+		checkpoint = buffer.GetBlockBuffer().GetMaxBlockID() - witnessMonitorLookback
+	} else if err != nil {
+		log.Errorf("SyncWitnessMissedSlot redis get checkpoint err:[%v]", err)
+		return err
+	}
+	if checkpoint <= 0 {
+		return nil
+	}
+
+	strSQL := fmt.Sprintf(`
+	select block_id, create_time, witness_address
+	from blocks
+	where block_id>%v and block_id<=%v
+	order by block_id`, checkpoint-witnessMonitorLookback, checkpoint+witnessMonitorBatch)
+	blockSlots, err := module.QueryBlockSlotsRealize(strSQL)
+	if err != nil {
+		log.Errorf("SyncWitnessMissedSlot query blocks err:[%v]", err)
+		return err
+	}
+
+	witnessCount := int64(len(buffer.GetWitnessBuffer().GetWitness()))
+	if witnessCount == 0 || witnessCount > activeWitnessCount {
+		witnessCount = activeWitnessCount
+	}
+	monitor := _witnessMonitor
+	monitor.Lock()
+	defer monitor.Unlock()
+
+	for _, blockSlot := range blockSlots {
+		cycleStart := getCycleStart(blockSlot.CreateTime, nextMaintenanceTime, interval)
+		monitor.setSchedule(cycleStart, blockSlot.CreateTime/blockInterval%witnessCount, blockSlot.WitnessAddress)
+	}
+	monitor.expireSchedules(getCycleStart(time.Now().UnixNano()/1e6, nextMaintenanceTime, interval) - interval)
+
+	missedSlots := make([]*entity.WitnessMissedSlot, 0)
+	lastBlockID := checkpoint
+	for index := 1; index < len(blockSlots); index++ {
+		prev, cur := blockSlots[index-1], blockSlots[index]
+		if cur.BlockID <= checkpoint {
+			continue
+		}
+		if cur.BlockID != prev.BlockID+1 {
+			//数据库中区块不连续，等待补齐
+			log.Errorf("SyncWitnessMissedSlot block [%v] not found, stop at [%v]", prev.BlockID+1, prev.BlockID)
+			break
+		}
+		//维护周期后的第一个区块之后固定跳过skipSlots个slot
+		prevSkipSlots := int64(0)
+		if index >= 2 && getCycleStart(prev.CreateTime, nextMaintenanceTime, interval) !=
+			getCycleStart(blockSlots[index-2].CreateTime, nextMaintenanceTime, interval) {
+			prevSkipSlots = skipSlots
+		}
+		for _, slotTime := range getMissedSlotTimes(prev.CreateTime, cur.CreateTime, prevSkipSlots) {
+			cycleStart := getCycleStart(slotTime, nextMaintenanceTime, interval)
+			missedSlot := &entity.WitnessMissedSlot{}
+			missedSlot.SlotTime = slotTime
+			missedSlot.Address = monitor.getScheduledWitness(cycleStart, slotTime/blockInterval%witnessCount)
+			missedSlot.CycleStart = cycleStart
+			missedSlot.PrevBlockID = prev.BlockID
+			missedSlots = append(missedSlots, missedSlot)
+			monitor.onMissed(missedSlot)
+		}
+		monitor.onProduced(cur)
+		lastBlockID = cur.BlockID
+	}
+
+	if err := module.InsertWitnessMissedSlots(missedSlots); err != nil {
+		return err
+	}
+	if lastBlockID > checkpoint {
+		if err := config.RedisCli.Set(witnessMonitorCheckpointKey, lastBlockID, 0).Err(); err != nil {
+			log.Errorf("SyncWitnessMissedSlot set checkpoint err:[%v]", err)
+		}
+	}
+	log.Infof("SyncWitnessMissedSlot block:[%v-%v] missed:[%v]", checkpoint, lastBlockID, len(missedSlots))
+	return nil
+}
+
+//getWitnessMonitorCheckedTime 丢块检查已经检查到的区块时间，还没有开始检查时返回0
+func getWitnessMonitorCheckedTime() (int64, error) {
+	checkpoint, err := config.RedisCli.Get(witnessMonitorCheckpointKey).Int64()
+	if err == redis.Nil {
+		return 0, nil
+	}
+	if err != nil {
+		log.Errorf("getWitnessMonitorCheckedTime redis get checkpoint err:[%v]", err)
+		return 0, err
+	}
+	blockSlots, err := module.QueryBlockSlotsRealize(fmt.Sprintf(`
+	select block_id, create_time, witness_address
+	from blocks
+	where block_id=%v`, checkpoint))
+	if err != nil {
+		log.Errorf("getWitnessMonitorCheckedTime query block err:[%v]", err)
+		return 0, err
+	}
+	if len(blockSlots) == 0 {
+		return 0, nil
+	}
+	return blockSlots[0].CreateTime, nil
+}
+
+//getCycleStart 区块时间所在轮次的开始时间
+func getCycleStart(timestamp, nextMaintenanceTime, interval int64) int64 {
+	offset := (timestamp - nextMaintenanceTime) % interval
+	if offset < 0 {
+		offset += interval
+	}
+	return timestamp - offset
+}
+
+//getMissedSlotTimes 两个相邻区块之间空出的slot时间，skipSlots为前一个区块之后固定跳过的slot数
+func getMissedSlotTimes(prevTime, curTime, skipSlots int64) []int64 {
+	slotTimes := make([]int64, 0)
+	for slotTime := prevTime + blockInterval*(1+skipSlots); slotTime < curTime; slotTime += blockInterval {
+		slotTimes = append(slotTimes, slotTime)
+	}
+	return slotTimes
+}
+
+func (m *witnessMonitor) setSchedule(cycleStart, position int64, address string) {
+	if m.schedules[cycleStart] == nil {
+		m.schedules[cycleStart] = make(map[int64]string)
+	}
+	m.schedules[cycleStart][position] = address
+}
+
+//expireSchedules 删除早于before的轮次
+func (m *witnessMonitor) expireSchedules(before int64) {
+	for cycleStart := range m.schedules {
+		if cycleStart < before {
+			delete(m.schedules, cycleStart)
+		}
+	}
+}
+
+//getScheduledWitness 应在该位置出块的超级代表
+//本轮一直没有出块的超级代表不在位置表中，只有一个空位时由得票排名推出，否则返回空
+func (m *witnessMonitor) getScheduledWitness(cycleStart, position int64) string {
+	schedule := m.schedules[cycleStart]
+	if address, ok := schedule[position]; ok {
+		return address
+	}
+	scheduled := make(map[string]bool, len(schedule))
+	for _, address := range schedule {
+		scheduled[address] = true
+	}
+	candidates := make([]string, 0)
+	for index, witness := range buffer.GetWitnessBuffer().GetWitness() {
+		if index >= activeWitnessCount {
+			break
+		}
+		if !scheduled[witness.Address] {
+			candidates = append(candidates, witness.Address)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0]
+	}
+	return ""
+}
+
+func (m *witnessMonitor) onMissed(missedSlot *entity.WitnessMissedSlot) {
+	if missedSlot.Address == "" {
+		return
+	}
+	m.missedStreak[missedSlot.Address]++
+	if !isWatchedWitness(missedSlot.Address) {
+		return
+	}
+	if m.missedStreak[missedSlot.Address] >= config.Get().Monitor.MissedThreshold && !m.alerted[missedSlot.Address] {
+		m.alerted[missedSlot.Address] = true
+		sendWitnessMissedAlert("missed", missedSlot.Address, m.missedStreak[missedSlot.Address], missedSlot.SlotTime)
+	}
+}
+
+func (m *witnessMonitor) onProduced(blockSlot *entity.BlockSlot) {
+	if m.alerted[blockSlot.WitnessAddress] {
+		sendWitnessMissedAlert("recovered", blockSlot.WitnessAddress, m.missedStreak[blockSlot.WitnessAddress], blockSlot.CreateTime)
+	}
+	delete(m.missedStreak, blockSlot.WitnessAddress)
+	delete(m.alerted, blockSlot.WitnessAddress)
+}
+
+func isWatchedWitness(address string) bool {
+	for _, watched := range config.Get().Monitor.WatchWitness {
+		if watched == address {
+			return true
+		}
+	}
+	return false
+}
+
+//sendWitnessMissedAlert 写告警日志，配置了webhook时异步发送
+func sendWitnessMissedAlert(event, address string, missedCount, slotTime int64) {
+	alert := &entity.WitnessMissedAlert{}
+	alert.Event = event
+	alert.Address = address
+	alert.Name, _ = buffer.GetWitnessBuffer().GetWitnessNameByAddr(address)
+	alert.MissedCount = missedCount
+	alert.LastSlotTime = slotTime
+	log.Errorf("witness alert event:[%v] address:[%v] name:[%v] missedCount:[%v] slotTime:[%v]",
+		event, address, alert.Name, missedCount, slotTime)
+
+	webhook := config.Get().Monitor.Webhook
+	if webhook == "" {
+		return
+	}
+	go func() {
+		resp, _, errs := gorequest.New().Timeout(5 * time.Second).Post(webhook).Send(alert).End()
+		if len(errs) > 0 {
+			log.Errorf("sendWitnessMissedAlert webhook err:%v", errs)
+		} else if resp.StatusCode >= 300 {
+			log.Errorf("sendWitnessMissedAlert webhook status:[%v]", resp.StatusCode)
+		}
+	}()
+}
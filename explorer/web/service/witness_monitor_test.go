@@ -0,0 +1,54 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestGetCycleStart(t *testing.T) {
+	const interval = 6 * 60 * 60 * 1000
+	next := int64(1536062400000)
+	cases := map[int64]int64{
+		next - 1:            next - interval,
+		next - interval:     next - interval,
+		next - interval - 1: next - 2*interval,
+		next:                next,
+		next + 1:            next,
+	}
+	for timestamp, want := range cases {
+		if got := getCycleStart(timestamp, next, interval); got != want {
+			t.Errorf("getCycleStart(%v) = %v, want %v", timestamp, got, want)
+		}
+	}
+}
+
+func TestGetMissedSlotTimes(t *testing.T) {
+	if got := getMissedSlotTimes(3000, 6000, 0); len(got) != 0 {
+		t.Errorf("getMissedSlotTimes no gap:%v", got)
+	}
+	if got := getMissedSlotTimes(3000, 12000, 0); !reflect.DeepEqual(got, []int64{6000, 9000}) {
+		t.Errorf("getMissedSlotTimes gap:%v", got)
+	}
+	if got := getMissedSlotTimes(3000, 12000, 2); len(got) != 0 {
+		t.Errorf("getMissedSlotTimes maintenance skip:%v", got)
+	}
+	if got := getMissedSlotTimes(3000, 15000, 2); !reflect.DeepEqual(got, []int64{12000}) {
+		t.Errorf("getMissedSlotTimes maintenance skip gap:%v", got)
+	}
+}
+
+func TestWitnessMonitorSchedule(t *testing.T) {
+	monitor := &witnessMonitor{schedules: make(map[int64]map[int64]string)}
+	monitor.setSchedule(100, 3, "A")
+	monitor.setSchedule(200, 3, "B")
+	if got := monitor.getScheduledWitness(100, 3); got != "A" {
+		t.Errorf("getScheduledWitness:%v", got)
+	}
+	monitor.expireSchedules(200)
+	if _, ok := monitor.schedules[100]; ok {
+		t.Errorf("expireSchedules keep expired cycle")
+	}
+	if got := monitor.getScheduledWitness(200, 3); got != "B" {
+		t.Errorf("getScheduledWitness after expire:%v", got)
+	}
+}
@@ -126,3 +126,12 @@ func getMaintenanceTimeStamp() (int64, error) {
 	curMaintenanceTime := nextMaintenanceTime - 6*60*60*1000 //6小时
 	return curMaintenanceTime, nil
 }
+
+//QueryWitnessByAddrBuffer 从buffer中获取单个超级代表信息
+func QueryWitnessByAddrBuffer(address string) (*entity.WitnessInfo, error) {
+	witness, ok := buffer.GetWitnessBuffer().GetWitnessByAddr(address)
+	if !ok {
+		return nil, nil
+	}
+	return witness, nil
+}
@@ -10,33 +10,64 @@ package main
 
 import (
 	"flag"
+	"os"
+	"time"
 
+	"github.com/wlcy/tron/explorer/core/grpcclient"
 	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
 	"github.com/wlcy/tron/explorer/lib/log"
+	"github.com/wlcy/tron/explorer/lib/trace"
 	"github.com/wlcy/tron/explorer/web/router"
+	"github.com/wlcy/tron/explorer/web/service"
 	"github.com/wlcy/tron/explorer/web/task"
 	"github.com/wlcy/tron/explorer/web/buffer"
 )
 
 // config file
 var configfile = flag.String("cfgfile", "config.toml", "the config file path when running.")
-var gLogFile = flag.String("log", "appLog", "set log base file, default is \"appLog\"")
+var gLogFile = flag.String("log", "", "log file, e.g. \"log/appLog.log\", default is stderr")
+var gLogMaxSize = flag.Int64("logMaxSize", 512, "rotate log file when size exceeds, in MB")
+var gLogMaxAge = flag.Int("logMaxAge", 7, "days to keep rotated log files, 0 means forever")
+var gLogMaxBackups = flag.Int("logMaxBackups", 50, "max count of rotated log files, 0 means no limit")
 var gDebug = flag.String("debug", "false", "debug flag default is \"false\"")
 var gLogLevel = flag.String("logLevel", "info", "debug level default is Debug")
+var gLogFormat = flag.String("logFormat", "console", "log format, console or json")
+var gLogPackages = flag.String("logPackages", "", "log level override by package, e.g. \"web/service=debug,web/buffer=warn\"")
+var gConfigWatch = flag.Duration("cfgWatch", 10*time.Second, "interval to check config file changes, 0 means reload only on SIGHUP")
+var gConfigOverrides config.Overrides
+
+func init() {
+	flag.Var(&gConfigOverrides, "set", "override config item, can repeat, e.g. -set mysql.host=127.0.0.1 -set task.exchange=30s")
+}
 
 func main() {
 
 	flag.Parse()
-	log.ChangeLogLevel(log.Str2Level(*gLogLevel))
-
-	//初始化db redis
-	config.LoadConfig(*configfile)
+	if err := log.Setup(*gLogLevel, *gLogFormat, *gLogPackages); err != nil {
+		log.Fatalf("init log failed:[%v]", err)
+	}
+	if *gLogFile != "" {
+		//每天零点和超过大小时滚动，滚动后压缩
+		_, err := log.StartRotate(&log.RotateOptions{Filename: *gLogFile, MaxSize: *gLogMaxSize << 20, Interval: 24 * time.Hour,
+			MaxAge: time.Duration(*gLogMaxAge) * 24 * time.Hour, MaxBackups: *gLogMaxBackups, Compress: true})
+		if err != nil {
+			log.Fatalf("open log file failed:[%v]", err)
+		}
+	}
 
-	//获取服务启动参数或其他参数
-	var conf config.ConfigServer
-	if 0 != conf.Populate(*configfile) {
-		return
+	//加载配置，初始化db redis，密码等敏感配置通过环境变量 EXPLORER_分组_名称 设置
+	if err := config.LoadConfig(*configfile, gConfigOverrides...); err != nil {
+		log.Fatalf("load config failed:[%v]", err)
 	}
+	config.Watch(*configfile, *gConfigWatch, gConfigOverrides...)
+	conf := config.Get()
+	if err := grpcclient.CheckGenesisBlock(); err != nil {
+		log.Fatalf("check network [%v] failed:[%v]", conf.Common.NetType, err)
+	}
+
+	//链路追踪在http服务停止后输出剩余的span
+	lifecycle.Append(lifecycle.Hook{Name: "trace", Stop: trace.Shutdown})
 
 	//初始化buffer
 	buffer.GetBlockBuffer()
@@ -45,25 +76,31 @@ func main() {
 	buffer.GetVoteBuffer()
 	buffer.GetAccountTokenBuffer()
 	buffer.GetTokenBuffer()
+	buffer.GetAPIKeyBuffer()
+	buffer.GetPriceBuffer()
+	buffer.GetLabelBuffer()
 
+	//就绪检查，/readyz 依赖的mysql、redis、节点和同步进度
+	service.RegisterHealthChecks()
 
 
-	/* 数据库和redis初始化也可以用这种方式， but i don't like it
-	redisCli = redis.NewClient(conf.Redis.Host, conf.Redis.Pass, conf.Redis.Index, conf.Redis.Poolsize)
-	mysql.Initialize(conf.Mysql.Host, conf.Mysql.Port, conf.Mysql.Schema,
-		conf.Mysql.User, conf.Mysql.Pass)
-	*/
 
-	go task.SyncCacheTodayReport()
-
-	go task.SyncPersistYesterdayReport()
+	//定时任务按cron表达式执行，多个实例时每次计划只有一个实例执行
+	if err := task.Setup(); err != nil {
+		log.Fatalf("init task scheduler failed:[%v]", err)
+	}
+	lifecycle.Go("scheduler", task.Run)
 
-	go task.SyncAssetIssueParticipated()
+	//节点发现更新的是本实例的节点池，每个实例都执行
+	lifecycle.Go("nodeDiscovery", task.SyncNodeDiscovery)
 
-	go task.SyncVoteWitnessRanking()
 
 
+	router.Start(conf.Server.Address, conf.Server.Objectpool)
+	//管理接口单独监听，刷新缓存、触发定时任务、修改日志级别等
+	router.StartAdmin(conf.Server.AdminAddress)
 
-	router.Start(conf.Address, conf.Objectpool)
+	//收到SIGTERM后停止定时任务，等待处理中的请求完成后退出
+	os.Exit(lifecycle.Run(conf.Server.ShutdownTimeout))
 
 }
@@ -1,5 +1,5 @@
-rm appLog*.log
-logName=`date +%Y%m%d%H%M%S`
+mkdir -p log
 killall explorerService > /dev/null 2>&1
 sleep 1
-nohup ./explorerService -logLevel all > "appLog$logName.log" 2>&1 &
+# 日志写入 log/appLog.log，每天和超过大小时滚动压缩，保留7天；标准输出只保留panic等信息
+nohup ./explorerService -logLevel all -log log/appLog.log > log/stderr.log 2>&1 &
@@ -0,0 +1,12 @@
+package task
+
+import (
+	"context"
+
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+//SyncAPIKeyUsage 把redis中的api key用量同步到数据库，服务退出时再同步一次
+func SyncAPIKeyUsage(ctx context.Context) error {
+	return service.SyncAPIKeyUsage()
+}
@@ -0,0 +1,12 @@
+package task
+
+import (
+	"context"
+
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+//SyncExchange 同步交易对成交记录和K线
+func SyncExchange(ctx context.Context) error {
+	return service.SyncExchange()
+}
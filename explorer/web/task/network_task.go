@@ -0,0 +1,32 @@
+package task
+
+import (
+	"context"
+	"time"
+
+	"github.com/wlcy/tron/explorer/core/grpcclient"
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/lifecycle"
+	"github.com/wlcy/tron/explorer/lib/log"
+)
+
+//SyncNodeDiscovery 当前网络配置了discover时，定期从 Wallet.ListNodes 发现新的full node
+func SyncNodeDiscovery(ctx context.Context) {
+	network := config.Get().CurrentNetwork()
+	if network == nil || !network.Discover {
+		return
+	}
+	for {
+		start := time.Now()
+		log.Info("SyncNodeDiscovery start")
+		added, err := grpcclient.DiscoverFullNodes(network.DiscoverMaxProbe)
+		if err != nil {
+			log.Errorf("SyncNodeDiscovery list nodes err:[%v]", err)
+		}
+		cost := time.Since(start)
+		log.Infof("SyncNodeDiscovery end, added:%v, costTime=%v", added, cost)
+		if !lifecycle.Wait(ctx, network.DiscoverInterval) {
+			return
+		}
+	}
+}
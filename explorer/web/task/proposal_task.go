@@ -0,0 +1,12 @@
+package task
+
+import (
+	"context"
+
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+//SyncProposal 同步提议和链参数
+func SyncProposal(ctx context.Context) error {
+	return service.SyncProposal()
+}
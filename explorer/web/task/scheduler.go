@@ -0,0 +1,141 @@
+package task
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/wlcy/tron/explorer/lib/config"
+	"github.com/wlcy/tron/explorer/lib/cron"
+	"github.com/wlcy/tron/explorer/lib/util"
+	"github.com/wlcy/tron/explorer/web/entity"
+	"github.com/wlcy/tron/explorer/web/module"
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+//taskHistorySpec 每天清理过期的执行记录
+const taskHistorySpec = "0 30 0 * * *"
+
+//maxRunErrorLen 保存的失败原因的最大字符数
+const maxRunErrorLen = 1000
+
+var scheduler *cron.Scheduler
+
+//taskStore 执行记录保存在 wlcy_task_run
+type taskStore struct{}
+
+func (taskStore) SaveRun(run *cron.Run) error {
+	return module.InsertTaskRun(toTaskRun(run))
+}
+
+func (taskStore) LastScheduled(job string) (int64, error) {
+	return module.QueryTaskLastScheduled(job)
+}
+
+//Setup 按配置的cron表达式注册定时任务，多个实例通过redis锁保证每次计划只执行一次
+func Setup() error {
+	root := config.Get()
+	conf := root.Task
+	loc, err := time.LoadLocation(conf.Timezone)
+	if err != nil {
+		return err
+	}
+	s := cron.New(cron.Options{
+		Locker:   cron.NewRedisLocker(config.RedisCli),
+		Store:    taskStore{},
+		Location: loc,
+		LockTTL:  conf.LockTTL,
+	})
+	for _, job := range []cron.Job{
+		{Name: "todayReport", Spec: conf.TodayReport, Run: SyncCacheTodayReport},
+		{Name: "yesterdayReport", Spec: conf.YesterdayReport, Run: SyncPersistYesterdayReport},
+		{Name: "assetIssueParticipated", Spec: conf.AssetIssueParticipated, Run: SyncAssetIssueParticipated},
+		{Name: "voteWitnessRanking", Spec: root.VoteWitnessRankingSpec(), Run: SyncVoteWitnessRanking},
+		{Name: "voteCycleArchive", Spec: conf.VoteCycleArchive, Run: SyncVoteCycleArchive, Quiet: true},
+		{Name: "witnessReward", Spec: conf.WitnessReward, Run: SyncWitnessReward},
+		{Name: "witnessMissedSlot", Spec: conf.WitnessMissedSlot, Run: SyncWitnessMissedSlot},
+		{Name: "proposal", Spec: conf.Proposal, Run: SyncProposal},
+		{Name: "exchange", Spec: conf.Exchange, Run: SyncExchange},
+		{Name: "apiKeyUsage", Spec: conf.APIKeyUsage, Run: SyncAPIKeyUsage, OnStop: true},
+		{Name: "taskHistory", Spec: taskHistorySpec, Run: PruneTaskHistory},
+	} {
+		if err := s.Add(job); err != nil {
+			return err
+		}
+	}
+	scheduler = s
+	return nil
+}
+
+//Run 按计划执行定时任务，服务退出时等待执行中的任务结束
+func Run(ctx context.Context) {
+	scheduler.Run(ctx)
+}
+
+//PruneTaskHistory 删除超过保留天数的执行记录
+func PruneTaskHistory(ctx context.Context) error {
+	return service.PruneTaskRuns(config.Get().Task.HistoryKeepDays)
+}
+
+//QueryTasks 全部定时任务在本实例的状态
+func QueryTasks() *entity.TaskStatusResp {
+	resp := &entity.TaskStatusResp{Data: make([]*entity.TaskStatus, 0)}
+	if scheduler == nil {
+		return resp
+	}
+	for _, job := range scheduler.Jobs() {
+		status := &entity.TaskStatus{Name: job.Name, Spec: job.Spec, Next: job.Next, Running: job.Running}
+		if job.LastRun != nil {
+			status.LastRun = toTaskRun(job.LastRun)
+		}
+		resp.Data = append(resp.Data, status)
+	}
+	resp.Total = int64(len(resp.Data))
+	return resp
+}
+
+//HasTask 是否有该名称的定时任务
+func HasTask(name string) bool {
+	for _, status := range QueryTasks().Data {
+		if status.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+//TriggerTask 手动触发定时任务，在后台执行，结果记录在执行记录中
+func TriggerTask(name string) error {
+	if scheduler == nil {
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+	switch err := scheduler.Trigger(name); err {
+	case nil:
+		return nil
+	case cron.ErrUnknownJob:
+		return util.NewErrorMsg(util.Error_common_no_data)
+	case cron.ErrJobRunning, cron.ErrJobLocked:
+		return util.NewErrorMsg(util.Error_common_task_running)
+	default:
+		return util.NewErrorMsg(util.Error_common_internal_error)
+	}
+}
+
+func toTaskRun(run *cron.Run) *entity.TaskRun {
+	taskRun := &entity.TaskRun{
+		ID:            run.ID,
+		Job:           run.Job,
+		Trigger:       run.Trigger,
+		ScheduledTime: run.ScheduledTime,
+		StartTime:     run.StartTime,
+		EndTime:       run.EndTime,
+		Duration:      run.Duration,
+		Status:        run.Status,
+		Error:         run.Error,
+		Instance:      run.Instance,
+	}
+	if utf8.RuneCountInString(taskRun.Error) > maxRunErrorLen {
+		taskRun.Error = string([]rune(taskRun.Error)[:maxRunErrorLen])
+	}
+	return taskRun
+}
@@ -1,18 +1,11 @@
 package task
 
 import (
-	"time"
+	"context"
 	"github.com/wlcy/tron/explorer/web/service"
-	"github.com/wlcy/tron/explorer/lib/log"
 )
 
-func SyncAssetIssueParticipated() {
-	for range time.Tick(30 * time.Minute) {
-		start := time.Now()
-		log.Info("SyncAssetIssueParticipated start")
-		service.SyncAssetIssueParticipated()
-		cost := time.Since(start)
-		log.Infof("SyncAssetIssueParticipated end, costTime=%v", cost)
-	}
+func SyncAssetIssueParticipated(ctx context.Context) error {
+	return service.SyncAssetIssueParticipated()
 }
 
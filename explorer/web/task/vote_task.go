@@ -1,23 +1,20 @@
 package task
 
 import (
-	"time"
+	"context"
 	"github.com/wlcy/tron/explorer/web/service"
-	"github.com/wlcy/tron/explorer/lib/log"
 )
 
-func SyncVoteWitnessRanking() {
-	now := time.Now()
-	next := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-	for {
-		start := time.Now()
-		log.Info("SyncVoteWitnessRanking start")
-		next = next.Add(6 * time.Hour).Add( 1 * time.Minute)
-		log.Infof("SyncVoteWitnessRanking nextTime:%v, timestamp:%v", next, next.UnixNano()/1e6)
-		t := time.NewTimer(next.Sub(now))
-		<-t.C
-		service.SyncVoteWitnessRanking()
-		cost := time.Since(start)
-		log.Infof("SyncVoteWitnessRanking end, costTime=%v", cost)
-	}
-}
\ No newline at end of file
+func SyncVoteWitnessRanking(ctx context.Context) error {
+	return service.SyncVoteWitnessRanking()
+}
+
+//SyncVoteCycleArchive 检查是否进入新的维护周期，归档投票轮次
+func SyncVoteCycleArchive(ctx context.Context) error {
+	return service.ArchiveVoteCycle()
+}
+
+//SyncWitnessReward 结算已结束轮次的超级代表奖励
+func SyncWitnessReward(ctx context.Context) error {
+	return service.SyncWitnessReward()
+}
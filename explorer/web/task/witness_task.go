@@ -0,0 +1,12 @@
+package task
+
+import (
+	"context"
+
+	"github.com/wlcy/tron/explorer/web/service"
+)
+
+//SyncWitnessMissedSlot 检查新的区块，记录丢块并告警
+func SyncWitnessMissedSlot(ctx context.Context) error {
+	return service.SyncWitnessMissedSlot()
+}